@@ -0,0 +1,220 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/codegen"
+)
+
+// completionShells are the shells -completion writes a script for.
+var completionShells = []string{"bash", "zsh", "fish"}
+
+// explainConfig describes the config options starting with one of the prefixes, or all of them without prefixes.
+func explainConfig(w io.Writer, prefixes []string) error {
+	found := false
+	for _, opt := range codegen.ConfigOptions() {
+		if len(prefixes) > 0 && !hasAnyPrefix(opt.Path, prefixes) {
+			continue
+		}
+		found = true
+
+		header := opt.Path + " (" + opt.Type
+		if opt.Default != "" {
+			header += ", default: " + opt.Default
+		}
+		if _, err := fmt.Fprintf(w, "%s)\n", header); err != nil {
+			return err
+		}
+		for line := range strings.SplitSeq(opt.Description, "\n") {
+			if _, err := fmt.Fprintf(w, "    %s\n", line); err != nil {
+				return err
+			}
+		}
+		if _, err := fmt.Fprintln(w); err != nil {
+			return err
+		}
+	}
+	if !found {
+		return fmt.Errorf("no config option starts with %s", strings.Join(prefixes, " or "))
+	}
+	return nil
+}
+
+// hasAnyPrefix reports whether path starts with one of the prefixes.
+func hasAnyPrefix(path string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// completionFlag is a flag of the CLI, as completed by the shells.
+type completionFlag struct {
+	name  string
+	usage string
+
+	// values are the values of the flag, or nil when any file is.
+	values []string
+
+	// isBool is true for flags without value.
+	isBool bool
+
+	// isFree is true for flags whose values aren't completed.
+	isFree bool
+}
+
+// completionFlags returns the flags of the CLI, sorted by name.
+func completionFlags() []completionFlag {
+	var res []completionFlag
+	flag.VisitAll(func(f *flag.Flag) {
+		cf := completionFlag{name: f.Name, usage: f.Usage}
+		if b, ok := f.Value.(interface{ IsBoolFlag() bool }); ok && b.IsBoolFlag() {
+			cf.isBool = true
+		}
+		switch f.Name {
+		case "completion":
+			cf.values = completionShells
+		case "handler-type", "set":
+			cf.isFree = true
+		}
+		res = append(res, cf)
+	})
+	return res
+}
+
+// configOptionPaths returns the paths of the config options, completed after -explain-config.
+func configOptionPaths() []string {
+	var res []string
+	for _, opt := range codegen.ConfigOptions() {
+		res = append(res, opt.Path)
+	}
+	return res
+}
+
+// writeCompletion writes the completion script of the shell.
+func writeCompletion(w io.Writer, shell string) error {
+	var script string
+	switch shell {
+	case "bash":
+		script = bashCompletion(completionFlags(), configOptionPaths())
+	case "zsh":
+		script = zshCompletion(completionFlags(), configOptionPaths())
+	case "fish":
+		script = fishCompletion(completionFlags(), configOptionPaths())
+	default:
+		return fmt.Errorf("unsupported shell %q, use one of %s", shell, strings.Join(completionShells, ", "))
+	}
+	_, err := io.WriteString(w, script)
+	return err
+}
+
+func bashCompletion(flags []completionFlag, options []string) string {
+	var names, valueCases []string
+	for _, f := range flags {
+		names = append(names, "-"+f.name)
+		switch {
+		case f.isBool:
+		case f.values != nil:
+			valueCases = append(valueCases, fmt.Sprintf(`        -%s) COMPREPLY=($(compgen -W %q -- "$cur")); return ;;`, f.name, strings.Join(f.values, " ")))
+		case f.isFree:
+			valueCases = append(valueCases, fmt.Sprintf(`        -%s) return ;;`, f.name))
+		default:
+			valueCases = append(valueCases, fmt.Sprintf(`        -%s) COMPREPLY=($(compgen -f -- "$cur")); return ;;`, f.name))
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString("# bash completion for oapi-codegen, load with: source <(oapi-codegen -completion bash)\n")
+	b.WriteString("_oapi_codegen() {\n")
+	b.WriteString("    local cur=\"${COMP_WORDS[COMP_CWORD]}\" prev=\"${COMP_WORDS[COMP_CWORD-1]}\"\n")
+	b.WriteString("    prev=\"${prev/#--/-}\"\n")
+	b.WriteString("    case \"$prev\" in\n")
+	b.WriteString(strings.Join(valueCases, "\n") + "\n")
+	b.WriteString("    esac\n")
+	b.WriteString("    if [[ \"$cur\" == -* ]]; then\n")
+	fmt.Fprintf(&b, "        COMPREPLY=($(compgen -W %q -- \"$cur\"))\n", strings.Join(names, " "))
+	b.WriteString("    elif [[ \" ${COMP_WORDS[*]} \" == *\" -explain-config \"* || \" ${COMP_WORDS[*]} \" == *\" --explain-config \"* ]]; then\n")
+	fmt.Fprintf(&b, "        COMPREPLY=($(compgen -W %q -- \"$cur\"))\n", strings.Join(options, " "))
+	b.WriteString("    else\n")
+	b.WriteString("        COMPREPLY=($(compgen -f -- \"$cur\"))\n")
+	b.WriteString("    fi\n")
+	b.WriteString("}\n")
+	b.WriteString("complete -o filenames -F _oapi_codegen oapi-codegen\n")
+	return b.String()
+}
+
+func zshCompletion(flags []completionFlag, options []string) string {
+	var b strings.Builder
+	b.WriteString("#compdef oapi-codegen\n")
+	b.WriteString("# zsh completion for oapi-codegen, load with: source <(oapi-codegen -completion zsh)\n\n")
+	b.WriteString("_oapi_codegen() {\n")
+	b.WriteString("    local state\n")
+	b.WriteString("    _arguments \\\n")
+	for _, f := range flags {
+		spec := "-" + f.name + "[" + zshEscape(f.usage) + "]"
+		switch {
+		case f.isBool:
+		case f.values != nil:
+			spec += ":" + f.name + ":(" + strings.Join(f.values, " ") + ")"
+		case f.isFree:
+			spec += ":" + f.name + ": "
+		default:
+			spec += ":" + f.name + ":_files"
+		}
+		if f.name == "config" {
+			spec = "*" + spec
+		}
+		fmt.Fprintf(&b, "        '%s' \\\n", spec)
+	}
+	b.WriteString("        '*:argument:->args'\n")
+	b.WriteString("    if [[ $state == args ]]; then\n")
+	b.WriteString("        if (( ${words[(I)(-|--)explain-config]} )); then\n")
+	fmt.Fprintf(&b, "            compadd -- '%s'\n", strings.Join(options, "' '"))
+	b.WriteString("        else\n")
+	b.WriteString("            _files\n")
+	b.WriteString("        fi\n")
+	b.WriteString("    fi\n")
+	b.WriteString("}\n\n")
+	b.WriteString("compdef _oapi_codegen oapi-codegen\n")
+	return b.String()
+}
+
+// zshEscape escapes the characters of a flag description with a special meaning in _arguments specs.
+func zshEscape(s string) string {
+	return strings.NewReplacer("'", `'\''`, "[", `\[`, "]", `\]`, ":", `\:`).Replace(s)
+}
+
+func fishCompletion(flags []completionFlag, options []string) string {
+	var b strings.Builder
+	b.WriteString("# fish completion for oapi-codegen, load with: oapi-codegen -completion fish | source\n")
+	for _, f := range flags {
+		line := "complete -c oapi-codegen -o " + f.name
+		switch {
+		case f.isBool:
+		case f.values != nil:
+			line += " -x -a '" + strings.Join(f.values, " ") + "'"
+		case f.isFree:
+			line += " -x"
+		default:
+			line += " -r -F"
+		}
+		fmt.Fprintf(&b, "%s -d '%s'\n", line, strings.ReplaceAll(f.usage, "'", `\'`))
+	}
+	fmt.Fprintf(&b, "complete -c oapi-codegen -n '__fish_contains_opt -o explain-config' -x -a '%s'\n", strings.Join(options, " "))
+	return b.String()
+}
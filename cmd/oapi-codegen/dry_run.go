@@ -0,0 +1,80 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package main
+
+import (
+	"errors"
+	"io"
+	"maps"
+	"os"
+	"slices"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// writeDiffs writes the unified diffs between the files on disk and their generated contents,
+// with missing and removed files diffed against /dev/null, and returns the paths of the files that would change.
+func writeDiffs(w io.Writer, files map[string]string, removed []string) ([]string, error) {
+	var changed []string
+	for _, name := range slices.Sorted(maps.Keys(files)) {
+		fromFile := name
+		// #nosec G304 -- CLI tool intentionally reads the files it generates
+		contents, err := os.ReadFile(name)
+		if errors.Is(err, os.ErrNotExist) {
+			fromFile = os.DevNull
+		} else if err != nil {
+			return nil, err
+		}
+		if err == nil && string(contents) == files[name] {
+			continue
+		}
+		changed = append(changed, name)
+
+		diff := difflib.UnifiedDiff{
+			A:        splitLines(string(contents)),
+			FromFile: fromFile,
+			B:        splitLines(files[name]),
+			ToFile:   name,
+			Context:  3,
+		}
+		if err = difflib.WriteUnifiedDiff(w, diff); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, name := range removed {
+		// #nosec G304 -- CLI tool intentionally reads the files it generated
+		contents, err := os.ReadFile(name)
+		if err != nil {
+			return nil, err
+		}
+		changed = append(changed, name)
+
+		diff := difflib.UnifiedDiff{
+			A:        splitLines(string(contents)),
+			FromFile: name,
+			ToFile:   os.DevNull,
+			Context:  3,
+		}
+		if err = difflib.WriteUnifiedDiff(w, diff); err != nil {
+			return nil, err
+		}
+	}
+	return changed, nil
+}
+
+// splitLines splits s into lines keeping their line endings, with none for empty contents.
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return difflib.SplitLines(s)
+}
@@ -11,13 +11,22 @@
 package main
 
 import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
 	"io"
+	"log/slog"
+	"maps"
 	"net/http"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/codegen"
 	"go.yaml.in/yaml/v4"
@@ -27,16 +36,37 @@ const (
 	// File and directory permissions for generated code
 	generatedDirPerm  = 0755
 	generatedFilePerm = 0644
+
+	// cliPackage is the package of this CLI, run by the //go:generate directives of the generated code.
+	cliPackage = "github.com/doordash-oss/oapi-codegen-dd/v3/cmd/oapi-codegen"
 )
 
 var (
-	flagConfigFile string
-	flagPrintUsage bool
+	flagConfigFiles       configFiles
+	flagPrintUsage        bool
+	flagEmitProcessedSpec string
+	flagUpdateHandlers    string
+	flagHandlerType       string
+	flagMetrics           string
+	flagVerify            bool
+	flagExplainConfig     bool
+	flagCompletion        string
+	flagSet               configOverrides
+	flagDryRun            bool
 )
 
 func main() {
-	flag.StringVar(&flagConfigFile, "config", "", "A YAML config file that controls oapi-codegen behavior.")
+	flag.Var(&flagConfigFiles, "config", "A YAML config file that controls oapi-codegen behavior. Repeat it to generate several targets from the same spec.")
 	flag.BoolVar(&flagPrintUsage, "help", false, "Show this help and exit.")
+	flag.StringVar(&flagEmitProcessedSpec, "emit-processed-spec", "", "Also write the filtered and pruned spec to this file.")
+	flag.StringVar(&flagUpdateHandlers, "update-handlers", "", "Instead of generating code, add stubs for the new operations to this handler implementation file.")
+	flag.StringVar(&flagHandlerType, "handler-type", "Handler", "The handler type of the -update-handlers file.")
+	flag.StringVar(&flagMetrics, "metrics", "", "Also write a JSON summary of the generation to this file, or to stdout with -.")
+	flag.BoolVar(&flagVerify, "verify", false, "Instead of writing the generated code, exit with an error if the files on disk differ from it.")
+	flag.BoolVar(&flagDryRun, "dry-run", false, "Instead of writing the generated code, print the unified diff of the files on disk to it.")
+	flag.BoolVar(&flagExplainConfig, "explain-config", false, "Describe the config options, or the ones starting with the arguments, e.g. output, and exit.")
+	flag.Var(&flagSet, "set", "Override a config option with key=value, e.g. output.directory=gen. Repeat it to override several options.")
+	flag.StringVar(&flagCompletion, "completion", "", "Print the completion script of this shell (bash, zsh or fish) and exit.")
 
 	flag.Parse()
 
@@ -45,6 +75,19 @@ func main() {
 		os.Exit(0)
 	}
 
+	if flagExplainConfig {
+		if err := explainConfig(os.Stdout, flag.Args()); err != nil {
+			errExit("Error explaining config: %v", err)
+		}
+		os.Exit(0)
+	}
+	if flagCompletion != "" {
+		if err := writeCompletion(os.Stdout, flagCompletion); err != nil {
+			errExit("Error writing completion: %v", err)
+		}
+		os.Exit(0)
+	}
+
 	if flag.NArg() < 1 {
 		errExit("Please specify a path to a OpenAPI spec file")
 	} else if flag.NArg() > 1 {
@@ -58,71 +101,564 @@ func main() {
 		errExit("Error reading spec: %v", err)
 	}
 
+	if len(flagConfigFiles) > 1 && (flagUpdateHandlers != "" || flagEmitProcessedSpec != "") {
+		errExit("-update-handlers and -emit-processed-spec accept a single -config")
+	}
+	if flagVerify && flagUpdateHandlers != "" {
+		errExit("-verify can't be combined with -update-handlers")
+	}
+	if flagDryRun && (flagVerify || flagUpdateHandlers != "") {
+		errExit("-dry-run can't be combined with -verify or -update-handlers")
+	}
+
+	var metrics *metricsWriter
+	if flagMetrics != "" {
+		if metrics, err = newMetricsWriter(flagMetrics); err != nil {
+			errExit("Error creating metrics file: %v", err)
+		}
+		defer func() { _ = metrics.Close() }()
+	}
+
+	// Every config generates its own target from the same document,
+	// loaded, filtered and pruned once for all of them.
+	shared := codegen.NewSharedDocument(specContents)
+	var targets []*target
+	if len(flagConfigFiles) == 0 {
+		targets = append(targets, generateTarget(shared, specPath, "", metrics))
+	}
+	for _, configFile := range flagConfigFiles {
+		targets = append(targets, generateTarget(shared, specPath, configFile, metrics))
+	}
+	targets = slices.DeleteFunc(targets, func(t *target) bool { return t == nil })
+
+	// Stale files are only listed once every target is generated: targets sharing a directory
+	// don't remove the files of each other.
+	if err = listStaleGeneratedFiles(targets); err != nil {
+		errExit("Error listing stale generated files: %v", err)
+	}
+	var stale []string
+	for _, t := range targets {
+		stale = append(stale, writeTarget(t)...)
+	}
+
+	if flagDryRun {
+		if len(stale) == 0 {
+			_, _ = fmt.Fprintln(os.Stderr, "No files would change")
+		} else {
+			_, _ = fmt.Fprintf(os.Stderr, "Files that would change:\n  %s\n", strings.Join(stale, "\n  "))
+		}
+		return
+	}
+	if len(stale) > 0 {
+		errExit("Generated code is out of date, regenerate it:\n  %s", strings.Join(stale, "\n  "))
+	}
+}
+
+// target is the code generated for a config, written once all the targets of the run are generated.
+type target struct {
+	cfg      codegen.Configuration
+	code     codegen.GeneratedCode
+	destDir  string
+	destFile string
+	// files maps the paths of the files to write to their contents.
+	files map[string]string
+	// removed are the generated files of earlier runs that no target generates anymore, removed with output.remove-stale.
+	removed []string
+}
+
+// generateTarget generates the code of a config file, or of the default config when it is empty,
+// and writes its summary to metrics when set.
+// It returns nil with -update-handlers, which updates the handlers file instead.
+func generateTarget(shared *codegen.SharedDocument, specPath, configFile string, metrics *metricsWriter) *target {
 	// Read the config file
-	cfg := codegen.Configuration{}
-	hasConfigFile := flagConfigFile != ""
+	var cfgContents []byte
+	hasConfigFile := configFile != ""
 	if hasConfigFile {
 		// #nosec G304 -- CLI tool intentionally reads user-specified config files
-		cfgContents, err := os.ReadFile(flagConfigFile)
+		contents, err := os.ReadFile(configFile)
 		if err != nil {
 			errExit("Error reading config file: %v", err)
 		}
+		cfgContents = contents
+	}
 
-		err = yaml.Unmarshal(cfgContents, &cfg)
-		if err != nil {
-			errExit("Error parsing config file: %v", err)
-		}
+	// The OAPI_CODEGEN_* environment variables override the config file, and -set overrides them.
+	overrides := append(codegen.EnvConfigOverrides(os.Environ()), flagSet...)
+	cfgContents, err := codegen.ApplyConfigOverrides(cfgContents, overrides)
+	if err != nil {
+		errExit("Error overriding config options: %v", err)
+	}
+
+	cfg := codegen.Configuration{}
+	if err = yaml.Unmarshal(cfgContents, &cfg); err != nil {
+		errExit("Error parsing config file: %v", err)
 	}
 
 	cfg = cfg.WithDefaults()
 
 	// If no config file was provided and input is a URL, output to stdout
 	// For local files without config, keep default behavior (write to gen.go)
-	if !hasConfigFile && (strings.HasPrefix(specPath, "http://") || strings.HasPrefix(specPath, "https://")) {
+	if !hasConfigFile && !overridesOutput(overrides) && (strings.HasPrefix(specPath, "http://") || strings.HasPrefix(specPath, "https://")) {
 		cfg.Output = nil
 	}
 
-	code, err := codegen.Generate(specContents, cfg)
+	if cfg.Output != nil && cfg.Output.GoGenerate && cfg.Output.GoGenerateCommand == "" {
+		destDir, _ := outputPaths(cfg)
+		command, err := goGenerateCommand(destDir, specPath, configFile)
+		if err != nil {
+			errExit("Error composing the go:generate command: %v", err)
+		}
+		cfg.Output.GoGenerateCommand = command
+	}
+
+	if err := cfg.Validate(); err != nil {
+		errExit("Error in config %s: %v", cmp.Or(configFile, "(default)"), err)
+	}
+	if err := registerGoPlugins(&cfg); err != nil {
+		errExit("Error loading plugins: %v", err)
+	}
+
+	if flagUpdateHandlers != "" {
+		if err := updateHandlers(flagUpdateHandlers, flagHandlerType, shared, cfg); err != nil {
+			errExit("Error updating handlers: %v", err)
+		}
+		return nil
+	}
+
+	start := time.Now()
+	parseCtx, errs := shared.CreateParseContext(cfg)
+	if errs != nil {
+		errExit("Error generating code: error creating parse context: %v", errs[0])
+	}
+	if parseCtx == nil {
+		errExit("Error generating code: %v", codegen.ErrEmptySchema)
+	}
+	if cfg.Generate.AlignFields {
+		logFieldAlignments(parseCtx.FieldAlignments)
+	}
+	parser, err := codegen.NewParser(cfg, parseCtx)
+	if err != nil {
+		errExit("Error generating code: error creating parser: %v", err)
+	}
+	code, err := parser.Parse()
 	if err != nil {
 		errExit("Error generating code: %v", err)
 	}
 
-	destDir := ""
-	destFile := ""
-	if cfg.Output != nil {
-		destDir = cfg.Output.Directory
-		if destDir != "" {
-			err = os.MkdirAll(destDir, generatedDirPerm)
-			if err != nil {
-				errExit("Error creating directory: %v", err)
-			}
+	if metrics != nil {
+		if err = metrics.Write(codegen.NewGenerationMetrics(cfg, parseCtx, code, time.Since(start))); err != nil {
+			errExit("Error writing metrics: %v", err)
 		}
-		if cfg.Output.UseSingleFile {
-			destFile = filepath.Join(destDir, cfg.Output.Filename)
-		} else {
-			destDir = filepath.Join(destDir, cfg.PackageName)
-			err = os.MkdirAll(destDir, generatedDirPerm)
-			if err != nil {
-				errExit("Error creating directory: %v", err)
-			}
+	}
+
+	destDir, destFile := outputPaths(cfg)
+	files := outputFiles(destDir, destFile, code)
+	if flagEmitProcessedSpec != "" {
+		spec, err := shared.RenderProcessedSpec(cfg)
+		if err != nil {
+			errExit("Error rendering processed spec: %v", err)
 		}
+		files[flagEmitProcessedSpec] = string(spec)
 	}
 
-	if destFile != "" {
-		err = os.WriteFile(destFile, []byte(code.GetCombined()), generatedFilePerm)
+	return &target{cfg: cfg, code: code, destDir: destDir, destFile: destFile, files: files}
+}
+
+// writeTarget writes the generated code of the target and removes its stale generated files.
+// With -verify, nothing is written and the files differing from the generated code are returned instead,
+// with the stale generated files output.remove-stale would remove.
+// With -dry-run, the diffs of these files are printed instead of writing them.
+func writeTarget(t *target) []string {
+	var err error
+
+	if flagVerify {
+		if t.destDir == "" && t.destFile == "" {
+			errExit("-verify needs a config writing the generated code to files")
+		}
+		stale, err := staleFiles(t.files)
 		if err != nil {
-			errExit("Error writing file: %v", err)
+			errExit("Error verifying generated code: %v", err)
+		}
+		return append(stale, t.removed...)
+	}
+	if flagDryRun {
+		if t.destDir == "" && t.destFile == "" {
+			errExit("-dry-run needs a config writing the generated code to files")
+		}
+		changed, err := writeDiffs(os.Stdout, t.files, t.removed)
+		if err != nil {
+			errExit("Error diffing generated code: %v", err)
+		}
+		return changed
+	}
+
+	if t.cfg.Output != nil && t.cfg.Output.Changelog != "" {
+		if err = writeChangelog(t.cfg.Output.Changelog, t.destFile, t.destDir, t.code); err != nil {
+			errExit("Error writing changelog: %v", err)
+		}
+	}
+
+	if t.destDir == "" && t.destFile == "" {
+		fmt.Print(t.code.GetCombined())
+	}
+	if err = writeFiles(t.files); err != nil {
+		errExit("Error writing files: %v", err)
+	}
+	if len(t.removed) > 0 {
+		if err = removeFiles(cmp.Or(t.destDir, filepath.Dir(t.destFile)), t.removed); err != nil {
+			errExit("Error removing stale generated files: %v", err)
+		}
+	}
+
+	if t.cfg.Output != nil && len(t.cfg.Output.Implementations) > 0 && (t.destFile != "" || t.destDir != "") {
+		if err = writeImplementationStubs(t.cfg, t.destFile, t.destDir, t.code); err != nil {
+			errExit("Error writing implementation stubs: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// outputPaths returns the directory and, for single file output, the file the generated code is written to.
+// Both are empty when the code is printed to stdout.
+func outputPaths(cfg codegen.Configuration) (destDir, destFile string) {
+	if cfg.Output == nil {
+		return "", ""
+	}
+	destDir = cfg.Output.Directory
+	if cfg.Output.UseSingleFile {
+		return destDir, filepath.Join(destDir, cfg.Output.Filename)
+	}
+	return filepath.Join(destDir, cfg.PackageName), ""
+}
+
+// outputFiles maps the paths of the files to write to their contents.
+func outputFiles(destDir, destFile string, code codegen.GeneratedCode) map[string]string {
+	files := make(map[string]string)
+	if destFile != "" {
+		files[destFile] = code.GetCombined()
+		for name, contents := range code.GetExtraFiles() {
+			files[filepath.Join(filepath.Dir(destFile), name)] = contents
 		}
 	} else if destDir != "" {
 		for name, contents := range code {
-			err = os.WriteFile(filepath.Join(destDir, name+".go"), []byte(contents), generatedFilePerm)
+			if filepath.Ext(name) == "" {
+				name += ".go"
+			}
+			files[filepath.Join(destDir, name)] = contents
+		}
+	}
+	return files
+}
+
+// goGenerateCommand returns the invocation of the CLI generating a target, run by go generate in the directory
+// of the generated package, with the config and spec paths relative to it.
+func goGenerateCommand(dir, specPath, configFile string) (string, error) {
+	args := []string{"go", "run", cliPackage}
+	if configFile != "" {
+		rel, err := relativePath(dir, configFile)
+		if err != nil {
+			return "", err
+		}
+		args = append(args, "-config", rel)
+	}
+	if !strings.HasPrefix(specPath, "http://") && !strings.HasPrefix(specPath, "https://") {
+		var err error
+		if specPath, err = relativePath(dir, specPath); err != nil {
+			return "", err
+		}
+	}
+	return strings.Join(append(args, specPath), " "), nil
+}
+
+// relativePath returns the path of name relative to dir, with forward slashes.
+func relativePath(dir, name string) (string, error) {
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	absName, err := filepath.Abs(name)
+	if err != nil {
+		return "", err
+	}
+	rel, err := filepath.Rel(absDir, absName)
+	if err != nil {
+		return "", err
+	}
+	return filepath.ToSlash(rel), nil
+}
+
+// staleFiles returns the sorted paths of the files whose contents on disk differ from the generated ones,
+// including missing files.
+func staleFiles(files map[string]string) ([]string, error) {
+	var stale []string
+	for _, name := range slices.Sorted(maps.Keys(files)) {
+		// #nosec G304 -- CLI tool intentionally reads the files it generates
+		contents, err := os.ReadFile(name)
+		if errors.Is(err, os.ErrNotExist) {
+			stale = append(stale, name)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if string(contents) != files[name] {
+			stale = append(stale, name)
+		}
+	}
+	return stale, nil
+}
+
+// writeChangelog summarizes the changes between the existing output and the generated code.
+// Nothing is written on the first run, when there is no previous output.
+func writeChangelog(name, destFile, destDir string, code codegen.GeneratedCode) error {
+	previous := make(map[string]string)
+	current := make(map[string]string)
+	for name, contents := range code {
+		// Go files are named without extension, except for the files of the output split by concern,
+		// where only the ones of the generated package are compared
+		isGoFile := filepath.Ext(name) == "" ||
+			(filepath.Ext(name) == ".go" && !strings.HasSuffix(name, "_test.go") && !strings.Contains(name, "/"))
+		if isGoFile {
+			current[name] = contents
+		}
+	}
+	if destFile != "" {
+		destDir = filepath.Dir(destFile)
+		current = map[string]string{filepath.Base(destFile): code.GetCombined()}
+		// #nosec G304 -- CLI tool intentionally reads its own output file
+		contents, err := os.ReadFile(destFile)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		if err == nil {
+			previous[filepath.Base(destFile)] = string(contents)
+		}
+	} else {
+		files, err := filepath.Glob(filepath.Join(destDir, "*.go"))
+		if err != nil {
+			return err
+		}
+		for _, file := range files {
+			if strings.HasSuffix(file, "_test.go") {
+				continue
+			}
+			// #nosec G304 -- CLI tool intentionally reads its own output files
+			contents, err := os.ReadFile(file)
 			if err != nil {
-				errExit("Error writing file: %v", err)
+				return err
+			}
+			// only compare generated files, hand-written files in the package are left out
+			if _, ok := code[strings.TrimSuffix(filepath.Base(file), ".go")]; ok || strings.Contains(string(contents), "DO NOT EDIT") {
+				previous[filepath.Base(file)] = string(contents)
 			}
 		}
+	}
+
+	if len(previous) == 0 {
+		return nil
+	}
+
+	changes, err := codegen.DiffGeneratedCode(previous, current)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(filepath.Join(destDir, name), []byte(changes.Markdown()))
+}
+
+// writeImplementationStubs adds stubs of the missing client methods of the configured implementations
+// to their files, next to the generated code.
+func writeImplementationStubs(cfg codegen.Configuration, destFile, destDir string, code codegen.GeneratedCode) error {
+	generated := make(map[string]string)
+	if destFile != "" {
+		destDir = filepath.Dir(destFile)
+		generated[filepath.Base(destFile)] = code.GetCombined()
 	} else {
-		fmt.Print(code.GetCombined())
+		for name, contents := range code {
+			if filepath.Ext(name) == "" {
+				generated[name+".go"] = contents
+			}
+		}
+	}
+
+	entries, err := os.ReadDir(destDir)
+	if err != nil {
+		return err
+	}
+	existing := make(map[string]string)
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || filepath.Ext(name) != ".go" || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		if _, ok := generated[name]; ok {
+			continue
+		}
+		contents, err := os.ReadFile(filepath.Join(destDir, name))
+		if err != nil {
+			return err
+		}
+		existing[name] = string(contents)
+	}
+
+	for _, typeName := range slices.Sorted(maps.Keys(cfg.Output.Implementations)) {
+		filename := cfg.Output.Implementations[typeName]
+		contents, err := codegen.ImplementationStubs(cfg, typeName, generated, existing)
+		if err != nil {
+			return err
+		}
+		if contents == "" || contents == existing[filename] {
+			continue
+		}
+		if err = writeFileAtomic(filepath.Join(destDir, filename), []byte(contents)); err != nil {
+			return err
+		}
+		existing[filename] = contents
+	}
+	return nil
+}
+
+// updateHandlers adds stubs of the operations missing from the handler type to its file.
+func updateHandlers(filename, typeName string, shared *codegen.SharedDocument, cfg codegen.Configuration) error {
+	// #nosec G304 -- CLI tool intentionally reads user-specified handler files
+	src, err := os.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+
+	ctx, errs := shared.CreateParseContext(cfg)
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	contents, err := codegen.HandlerStubs(string(src), typeName, cfg, ctx.Operations)
+	if err != nil {
+		return err
+	}
+	if contents == string(src) {
+		return nil
+	}
+	return writeFileAtomic(filename, []byte(contents))
+}
+
+// logFieldAlignments logs the bytes saved by reordering struct fields.
+func logFieldAlignments(alignments []codegen.FieldAlignment) {
+	var total int64
+	for _, a := range alignments {
+		slog.Info("reordered struct fields", "type", a.TypeName, "before", a.Before, "after", a.After, "saved", a.Saved())
+		total += a.Saved()
+	}
+	slog.Info("struct field alignment", "types", len(alignments), "saved", total)
+}
+
+// metricsWriter writes the generation metrics of every target as a line of JSON,
+// with the messages of the warnings logged while generating it.
+type metricsWriter struct {
+	out      io.WriteCloser
+	warnings *warningsHandler
+}
+
+func newMetricsWriter(path string) (*metricsWriter, error) {
+	var out io.WriteCloser = os.Stdout
+	if path != "-" {
+		f, err := os.Create(path)
+		if err != nil {
+			return nil, err
+		}
+		out = f
+	}
+
+	warnings := &warningsHandler{Handler: slog.NewTextHandler(os.Stderr, nil), messages: new([]string), mu: new(sync.Mutex)}
+	slog.SetDefault(slog.New(warnings))
+
+	return &metricsWriter{out: out, warnings: warnings}, nil
+}
+
+func (m *metricsWriter) Write(metrics codegen.GenerationMetrics) error {
+	metrics.Warnings = append(metrics.Warnings, m.warnings.take()...)
+	return json.NewEncoder(m.out).Encode(metrics)
+}
+
+func (m *metricsWriter) Close() error {
+	if m.out == os.Stdout {
+		return nil
+	}
+	return m.out.Close()
+}
+
+// warningsHandler collects the messages of the warnings logged with slog.
+type warningsHandler struct {
+	slog.Handler
+	messages *[]string
+	mu       *sync.Mutex
+}
+
+func (h *warningsHandler) Handle(ctx context.Context, r slog.Record) error {
+	if r.Level >= slog.LevelWarn {
+		h.mu.Lock()
+		*h.messages = append(*h.messages, r.Message)
+		h.mu.Unlock()
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h *warningsHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &warningsHandler{Handler: h.Handler.WithAttrs(attrs), messages: h.messages, mu: h.mu}
+}
+
+func (h *warningsHandler) WithGroup(name string) slog.Handler {
+	return &warningsHandler{Handler: h.Handler.WithGroup(name), messages: h.messages, mu: h.mu}
+}
+
+// take returns the collected messages and clears them.
+func (h *warningsHandler) take() []string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	messages := *h.messages
+	*h.messages = nil
+	return messages
+}
+
+// configFiles collects the config files of repeated -config flags.
+type configFiles []string
+
+func (c *configFiles) String() string {
+	return strings.Join(*c, ",")
+}
+
+func (c *configFiles) Set(value string) error {
+	*c = append(*c, value)
+	return nil
+}
+
+// configOverrides collects the config options of repeated -set flags.
+type configOverrides []codegen.ConfigOverride
+
+func (c *configOverrides) String() string {
+	var res []string
+	for _, o := range *c {
+		res = append(res, o.Path+"="+o.Value)
+	}
+	return strings.Join(res, ",")
+}
+
+func (c *configOverrides) Set(value string) error {
+	o, err := codegen.ParseConfigOverride(value)
+	if err != nil {
+		return err
+	}
+	*c = append(*c, o)
+	return nil
+}
+
+// overridesOutput reports whether one of the overrides sets an output option.
+func overridesOutput(overrides []codegen.ConfigOverride) bool {
+	for _, o := range overrides {
+		if strings.HasPrefix(o.Path, "output.") {
+			return true
+		}
 	}
+	return false
 }
 
 func errExit(msg string, args ...any) {
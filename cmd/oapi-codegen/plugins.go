@@ -0,0 +1,85 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"plugin"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/codegen"
+)
+
+// goPlugins maps the paths of the Go plugins opened to the names they are registered with,
+// so the configs sharing a plugin open it once.
+var goPlugins = make(map[string]string)
+
+// registerGoPlugins opens the Go plugins of the config, registers them with codegen.RegisterPlugin
+// and enables them by name instead of path.
+func registerGoPlugins(cfg *codegen.Configuration) error {
+	for i, pc := range cfg.Plugins {
+		if pc.Path == "" {
+			continue
+		}
+		name, ok := goPlugins[pc.Path]
+		if !ok {
+			p, err := openPlugin(pc.Path)
+			if err != nil {
+				return err
+			}
+			if err = registerPlugin(p); err != nil {
+				return fmt.Errorf("error loading plugin %s: %w", pc.Path, err)
+			}
+			name = p.Name()
+			goPlugins[pc.Path] = name
+		}
+		cfg.Plugins[i].Name, cfg.Plugins[i].Path = name, ""
+	}
+	return nil
+}
+
+// registerPlugin registers the plugin, failing instead of panicking when its name is taken.
+func registerPlugin(p codegen.Plugin) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	codegen.RegisterPlugin(p)
+	return nil
+}
+
+// openPlugin loads the `Plugin` variable of a Go plugin.
+// Go plugins are only supported on some platforms, and must be built with the same
+// Go version and dependencies as the generator.
+func openPlugin(path string) (codegen.Plugin, error) {
+	lib, err := plugin.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("error opening plugin %s: %w", path, err)
+	}
+	sym, err := lib.Lookup("Plugin")
+	if err != nil {
+		return nil, fmt.Errorf("error loading plugin %s: %w", path, err)
+	}
+	return pluginFromSymbol(path, sym)
+}
+
+// pluginFromSymbol returns the Plugin of the exported symbol, which is a pointer for variables.
+func pluginFromSymbol(path string, sym any) (codegen.Plugin, error) {
+	switch v := sym.(type) {
+	case *codegen.Plugin:
+		if *v != nil {
+			return *v, nil
+		}
+	case codegen.Plugin:
+		return v, nil
+	}
+	return nil, fmt.Errorf("error loading plugin %s: Plugin does not implement codegen.Plugin", path)
+}
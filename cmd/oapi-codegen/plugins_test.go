@@ -0,0 +1,77 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/codegen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type namedPlugin string
+
+func (p namedPlugin) Name() string { return string(p) }
+
+func TestRegisterGoPlugins(t *testing.T) {
+	cfg := codegen.Configuration{Plugins: []codegen.PluginConfig{{Name: "registered"}}}
+	require.NoError(t, registerGoPlugins(&cfg))
+	assert.Equal(t, []codegen.PluginConfig{{Name: "registered"}}, cfg.Plugins)
+
+	t.Run("opened once", func(t *testing.T) {
+		goPlugins["testdata/audit.so"] = "audit"
+		t.Cleanup(func() { delete(goPlugins, "testdata/audit.so") })
+
+		cfg := codegen.Configuration{Plugins: []codegen.PluginConfig{{Path: "testdata/audit.so"}}}
+		require.NoError(t, registerGoPlugins(&cfg))
+		assert.Equal(t, []codegen.PluginConfig{{Name: "audit"}}, cfg.Plugins)
+	})
+
+	t.Run("invalid path", func(t *testing.T) {
+		cfg := codegen.Configuration{Plugins: []codegen.PluginConfig{{Path: "testdata/missing.so"}}}
+		assert.ErrorContains(t, registerGoPlugins(&cfg), "error opening plugin testdata/missing.so")
+	})
+}
+
+func TestRegisterPlugin(t *testing.T) {
+	require.NoError(t, registerPlugin(namedPlugin("test-register")))
+	assert.EqualError(t, registerPlugin(namedPlugin("test-register")), `codegen: plugin "test-register" registered twice`)
+}
+
+func TestPluginFromSymbol(t *testing.T) {
+	var p codegen.Plugin = namedPlugin("symbol")
+	var nilPlugin codegen.Plugin
+
+	tests := []struct {
+		name     string
+		sym      any
+		expected codegen.Plugin
+	}{
+		{name: "variable", sym: &p, expected: p},
+		{name: "value", sym: p, expected: p},
+		{name: "nil variable", sym: &nilPlugin},
+		{name: "other type", sym: fmt.Sprintf},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := pluginFromSymbol("p.so", tt.sym)
+			if tt.expected == nil {
+				assert.EqualError(t, err, "error loading plugin p.so: Plugin does not implement codegen.Plugin")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, res)
+		})
+	}
+}
@@ -0,0 +1,258 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package main
+
+import (
+	"bufio"
+	"cmp"
+	"errors"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// defaultGeneratedHeader starts the files generated without a copyright header.
+const defaultGeneratedHeader = "Code generated by oapi-codegen. DO NOT EDIT."
+
+// generatedHeader returns the comment line starting the files generated with the copyright header,
+// the first line of the header when it spans several.
+func generatedHeader(copyrightHeader string) string {
+	first, _, _ := strings.Cut(cmp.Or(copyrightHeader, defaultGeneratedHeader), "\n")
+	return "// " + strings.TrimSpace(first)
+}
+
+// rename renames the files, replaced in tests to make it fail.
+var rename = os.Rename
+
+// writeFiles writes the files in two steps: their contents are written to temporary files next to them,
+// which are renamed over them once all of them are written, so a failure doesn't leave a mix of old and new files.
+// The files replaced are moved aside while renaming and restored if a rename fails.
+// The temporary files, and the directories created for them, are removed on failure.
+func writeFiles(files map[string]string) (err error) {
+	var created, temps, renamed, backups []string
+	defer func() {
+		if err == nil {
+			return
+		}
+		for _, temp := range temps {
+			_ = os.Remove(temp)
+		}
+		for i, name := range renamed {
+			_ = os.Remove(name)
+			if backups[i] != "" {
+				_ = os.Rename(backups[i], name)
+			}
+		}
+		// the deepest directories are removed first, only if they are empty
+		for _, dir := range slices.Backward(created) {
+			_ = os.Remove(dir)
+		}
+	}()
+
+	names := slices.Sorted(maps.Keys(files))
+	for _, name := range names {
+		dirs, err := createDirs(filepath.Dir(name))
+		created = append(created, dirs...)
+		if err != nil {
+			return err
+		}
+		temp, err := writeTempFile(name, []byte(files[name]))
+		if err != nil {
+			return err
+		}
+		temps = append(temps, temp)
+	}
+
+	for len(temps) > 0 {
+		name := names[len(renamed)]
+		backup, err := moveAside(name, temps[0])
+		if err != nil {
+			return err
+		}
+		if err = rename(temps[0], name); err != nil {
+			if backup != "" {
+				_ = os.Rename(backup, name)
+			}
+			return err
+		}
+		renamed, backups, temps = append(renamed, name), append(backups, backup), temps[1:]
+	}
+	for _, backup := range backups {
+		if backup != "" {
+			_ = os.Remove(backup)
+		}
+	}
+	return nil
+}
+
+// moveAside renames the existing file name next to the temporary file replacing it, and returns its new path.
+// It returns an empty path when the file doesn't exist.
+func moveAside(name, temp string) (string, error) {
+	if _, err := os.Lstat(name); errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	}
+	backup := temp + ".old"
+	if err := rename(name, backup); err != nil {
+		return "", err
+	}
+	return backup, nil
+}
+
+// writeFileAtomic writes the contents to a temporary file next to name, then renames it over name.
+func writeFileAtomic(name string, contents []byte) error {
+	temp, err := writeTempFile(name, contents)
+	if err != nil {
+		return err
+	}
+	if err = os.Rename(temp, name); err != nil {
+		_ = os.Remove(temp)
+		return err
+	}
+	return nil
+}
+
+// writeTempFile writes the contents to a new hidden file in the directory of name and returns its path.
+func writeTempFile(name string, contents []byte) (string, error) {
+	f, err := os.CreateTemp(filepath.Dir(name), "."+filepath.Base(name)+".*.tmp")
+	if err != nil {
+		return "", err
+	}
+	_, err = f.Write(contents)
+	err = errors.Join(err, f.Chmod(generatedFilePerm), f.Close())
+	if err != nil {
+		_ = os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
+
+// createDirs creates dir and its missing parents, and returns the directories it created, parents first.
+func createDirs(dir string) ([]string, error) {
+	var missing []string
+	for d := dir; ; d = filepath.Dir(d) {
+		if _, err := os.Stat(d); err == nil || !errors.Is(err, os.ErrNotExist) {
+			break
+		}
+		missing = append(missing, d)
+		if filepath.Dir(d) == d {
+			break
+		}
+	}
+	if len(missing) == 0 {
+		return nil, nil
+	}
+	slices.Reverse(missing)
+	return missing, os.MkdirAll(dir, generatedDirPerm)
+}
+
+// staleGeneratedFiles returns the sorted paths of the generated files of earlier runs missing from files:
+// in the directory of destFile for the single file output, or anywhere under destDir otherwise.
+// Generated files are the Go files starting with header, see generatedHeader.
+func staleGeneratedFiles(destDir, destFile, header string, files map[string]string) ([]string, error) {
+	root, recursive := destDir, true
+	if destFile != "" {
+		root, recursive = filepath.Dir(destFile), false
+	}
+
+	var stale []string
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if errors.Is(err, os.ErrNotExist) && path == root {
+			return fs.SkipAll
+		}
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path != root && !recursive {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if _, ok := files[path]; ok || filepath.Ext(path) != ".go" {
+			return nil
+		}
+		generated, err := isGeneratedFile(path, header)
+		if err != nil {
+			return err
+		}
+		if generated {
+			stale = append(stale, path)
+		}
+		return nil
+	})
+	return stale, err
+}
+
+// listStaleGeneratedFiles sets the stale generated files of the targets with output.remove-stale:
+// the files with their generated header that no target of the run writes.
+// A stale file in the directory of several targets is only removed by the first one.
+func listStaleGeneratedFiles(targets []*target) error {
+	written := make(map[string]string)
+	for _, t := range targets {
+		maps.Copy(written, t.files)
+	}
+
+	for _, t := range targets {
+		if t.cfg.Output == nil || !t.cfg.Output.RemoveStale || (t.destDir == "" && t.destFile == "") {
+			continue
+		}
+		removed, err := staleGeneratedFiles(t.destDir, t.destFile, generatedHeader(t.cfg.CopyrightHeader), written)
+		if err != nil {
+			return err
+		}
+		for _, name := range removed {
+			written[name] = ""
+		}
+		t.removed = removed
+	}
+	return nil
+}
+
+// isGeneratedFile tells whether the file starts with header, after the build constraint of output.build-tags.
+// The name of the file doesn't matter: other generators write *.gen.go files too.
+func isGeneratedFile(name, header string) (bool, error) {
+	// #nosec G304 -- CLI tool intentionally reads the files of its output directory
+	f, err := os.Open(name)
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = f.Close() }()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "//go:build") {
+			continue
+		}
+		return line == header, nil
+	}
+	return false, scanner.Err()
+}
+
+// removeFiles removes the files, and the directories they leave empty up to root.
+func removeFiles(root string, names []string) error {
+	root = filepath.Clean(root)
+	for _, name := range names {
+		if err := os.Remove(name); err != nil {
+			return err
+		}
+		for dir := filepath.Dir(name); dir != root && strings.HasPrefix(dir, root); dir = filepath.Dir(dir) {
+			// fails for directories that aren't empty
+			if os.Remove(dir) != nil {
+				break
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package main
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/codegen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const generatedCode = "// Code generated by oapi-codegen. DO NOT EDIT.\n\npackage api\n"
+
+func writeTestFiles(t *testing.T, files map[string]string) {
+	t.Helper()
+	for name, contents := range files {
+		require.NoError(t, os.MkdirAll(filepath.Dir(name), 0o755))
+		require.NoError(t, os.WriteFile(name, []byte(contents), 0o644))
+	}
+}
+
+func readTestFile(t *testing.T, name string) string {
+	t.Helper()
+	contents, err := os.ReadFile(name)
+	require.NoError(t, err)
+	return string(contents)
+}
+
+// dirEntries returns the names of the files and directories under dir, relative to it.
+func dirEntries(t *testing.T, dir string) []string {
+	t.Helper()
+	var names []string
+	err := filepath.WalkDir(dir, func(path string, _ os.DirEntry, err error) error {
+		if err != nil || path == dir {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		names = append(names, filepath.ToSlash(rel))
+		return err
+	})
+	require.NoError(t, err)
+	return names
+}
+
+func TestWriteFiles(t *testing.T) {
+	t.Run("writes new files and replaces existing ones", func(t *testing.T) {
+		dir := t.TempDir()
+		existing := filepath.Join(dir, "types.go")
+		writeTestFiles(t, map[string]string{existing: "old"})
+
+		err := writeFiles(map[string]string{
+			existing:                             "new",
+			filepath.Join(dir, "client", "c.go"): "client",
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "new", readTestFile(t, existing))
+		assert.Equal(t, "client", readTestFile(t, filepath.Join(dir, "client", "c.go")))
+		assert.ElementsMatch(t, []string{"types.go", "client", "client/c.go"}, dirEntries(t, dir))
+	})
+
+	t.Run("rolls back when a rename fails", func(t *testing.T) {
+		dir := t.TempDir()
+		first, last := filepath.Join(dir, "a.go"), filepath.Join(dir, "z.go")
+		writeTestFiles(t, map[string]string{first: "old a", last: "old z"})
+
+		renameErr := errors.New("rename failed")
+		rename = func(from, to string) error {
+			if to == last {
+				return renameErr
+			}
+			return os.Rename(from, to)
+		}
+		t.Cleanup(func() { rename = os.Rename })
+
+		err := writeFiles(map[string]string{
+			first:                             "new a",
+			filepath.Join(dir, "new", "b.go"): "new b",
+			last:                              "new z",
+		})
+		require.ErrorIs(t, err, renameErr)
+
+		assert.Equal(t, "old a", readTestFile(t, first))
+		assert.Equal(t, "old z", readTestFile(t, last))
+		assert.ElementsMatch(t, []string{"a.go", "z.go"}, dirEntries(t, dir))
+	})
+}
+
+func TestStaleGeneratedFiles(t *testing.T) {
+	header := generatedHeader("")
+
+	t.Run("removes stale generated files and the directories they empty", func(t *testing.T) {
+		dir := t.TempDir()
+		current := filepath.Join(dir, "types.go")
+		writeTestFiles(t, map[string]string{
+			current:                                       generatedCode,
+			filepath.Join(dir, "stale.go"):                generatedCode,
+			filepath.Join(dir, "pets", "pets.go"):         "//go:build integration\n\n" + generatedCode,
+			filepath.Join(dir, "users", "v1", "users.go"): generatedCode,
+			filepath.Join(dir, "users", "handler.go"):     "package users\n",
+		})
+
+		stale, err := staleGeneratedFiles(dir, "", header, map[string]string{current: generatedCode})
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			filepath.Join(dir, "pets", "pets.go"),
+			filepath.Join(dir, "stale.go"),
+			filepath.Join(dir, "users", "v1", "users.go"),
+		}, stale)
+
+		require.NoError(t, removeFiles(dir, stale))
+		assert.ElementsMatch(t, []string{"types.go", "users", "users/handler.go"}, dirEntries(t, dir))
+	})
+
+	t.Run("keeps the files of other generators and hand-written files", func(t *testing.T) {
+		dir := t.TempDir()
+		writeTestFiles(t, map[string]string{
+			filepath.Join(dir, "mocks.gen.go"): "// Code generated by MockGen. DO NOT EDIT.\n\npackage api\n",
+			filepath.Join(dir, "server.go"):    "package api\n",
+			filepath.Join(dir, "notes.gen.go"): "package api\n",
+			filepath.Join(dir, "CHANGELOG.md"): generatedCode,
+		})
+
+		stale, err := staleGeneratedFiles(dir, "", header, nil)
+		require.NoError(t, err)
+		assert.Empty(t, stale)
+	})
+
+	t.Run("matches the copyright header", func(t *testing.T) {
+		dir := t.TempDir()
+		custom := generatedHeader("Copyright 2025 Acme, Inc. DO NOT EDIT.\nSecond line")
+		writeTestFiles(t, map[string]string{
+			filepath.Join(dir, "types.go"):   "// Copyright 2025 Acme, Inc. DO NOT EDIT.\n\npackage api\n",
+			filepath.Join(dir, "default.go"): generatedCode,
+		})
+
+		stale, err := staleGeneratedFiles(dir, "", custom, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{filepath.Join(dir, "types.go")}, stale)
+	})
+
+	t.Run("only looks next to the single file output", func(t *testing.T) {
+		dir := t.TempDir()
+		destFile := filepath.Join(dir, "api.go")
+		writeTestFiles(t, map[string]string{
+			destFile:                             generatedCode,
+			filepath.Join(dir, "old.go"):         generatedCode,
+			filepath.Join(dir, "nested", "n.go"): generatedCode,
+		})
+
+		stale, err := staleGeneratedFiles("", destFile, header, map[string]string{destFile: generatedCode})
+		require.NoError(t, err)
+		assert.Equal(t, []string{filepath.Join(dir, "old.go")}, stale)
+	})
+
+	t.Run("ignores a missing output directory", func(t *testing.T) {
+		stale, err := staleGeneratedFiles(filepath.Join(t.TempDir(), "missing"), "", header, nil)
+		require.NoError(t, err)
+		assert.Empty(t, stale)
+	})
+}
+
+func TestListStaleGeneratedFiles(t *testing.T) {
+	dir := t.TempDir()
+	models, client, old := filepath.Join(dir, "models.gen.go"), filepath.Join(dir, "client.gen.go"), filepath.Join(dir, "old.gen.go")
+	writeTestFiles(t, map[string]string{models: generatedCode, client: generatedCode, old: generatedCode})
+
+	newTarget := func(destFile string) *target {
+		return &target{
+			cfg:      codegen.Configuration{Output: &codegen.Output{UseSingleFile: true, RemoveStale: true}},
+			destDir:  dir,
+			destFile: destFile,
+			files:    map[string]string{destFile: generatedCode},
+		}
+	}
+	targets := []*target{newTarget(models), newTarget(client)}
+
+	require.NoError(t, listStaleGeneratedFiles(targets))
+	assert.Equal(t, []string{old}, targets[0].removed)
+	assert.Empty(t, targets[1].removed)
+}
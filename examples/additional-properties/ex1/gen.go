@@ -6,6 +6,8 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
 
 	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
 	"github.com/go-playground/validator/v10"
@@ -106,24 +108,23 @@ func (r *ReferenceWithRequiredExtra) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// Override default JSON handling for ReferenceWithRequiredExtra to handle AdditionalProperties
+// Override default JSON handling for ReferenceWithRequiredExtra to handle AdditionalProperties.
+// Fields are written directly, additional properties replace the declared ones with the same name.
 func (r ReferenceWithRequiredExtra) MarshalJSON() ([]byte, error) {
-	var err error
-	object := make(map[string]json.RawMessage)
-
-	if r.Index != nil {
-		object["index"], err = json.Marshal(r.Index)
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'index': %w", err)
+	var object runtime.JSONObjectWriter
+	if _, ok := r.AdditionalProperties["index"]; !ok {
+		if r.Index != nil {
+			if err := object.WriteField("index", r.Index); err != nil {
+				return nil, fmt.Errorf("error marshaling 'index': %w", err)
+			}
 		}
 	}
-	for fieldName, field := range r.AdditionalProperties {
-		object[fieldName], err = json.Marshal(field)
-		if err != nil {
+	for _, fieldName := range slices.Sorted(maps.Keys(r.AdditionalProperties)) {
+		if err := object.WriteField(fieldName, r.AdditionalProperties[fieldName]); err != nil {
 			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
 		}
 	}
-	return json.Marshal(object)
+	return object.Bytes(), nil
 }
 
 type RouteWithOptionalExtra struct {
@@ -174,24 +175,23 @@ func (r *RouteWithOptionalExtra) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// Override default JSON handling for RouteWithOptionalExtra to handle AdditionalProperties
+// Override default JSON handling for RouteWithOptionalExtra to handle AdditionalProperties.
+// Fields are written directly, additional properties replace the declared ones with the same name.
 func (r RouteWithOptionalExtra) MarshalJSON() ([]byte, error) {
-	var err error
-	object := make(map[string]json.RawMessage)
-
-	if r.Index != nil {
-		object["index"], err = json.Marshal(r.Index)
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'index': %w", err)
+	var object runtime.JSONObjectWriter
+	if _, ok := r.AdditionalProperties["index"]; !ok {
+		if r.Index != nil {
+			if err := object.WriteField("index", r.Index); err != nil {
+				return nil, fmt.Errorf("error marshaling 'index': %w", err)
+			}
 		}
 	}
-	for fieldName, field := range r.AdditionalProperties {
-		object[fieldName], err = json.Marshal(field)
-		if err != nil {
+	for _, fieldName := range slices.Sorted(maps.Keys(r.AdditionalProperties)) {
+		if err := object.WriteField(fieldName, r.AdditionalProperties[fieldName]); err != nil {
 			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
 		}
 	}
-	return json.Marshal(object)
+	return object.Bytes(), nil
 }
 
 type Route = string
@@ -411,4 +411,5 @@ var typesValidator *validator.Validate
 func init() {
 	typesValidator = validator.New(validator.WithRequiredStructEnabled())
 	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
 }
@@ -1,10 +1,12 @@
 package gen
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAlwaysValidates(t *testing.T) {
@@ -31,6 +33,38 @@ func TestReferenceWithRequiredExtra_NoValidation(t *testing.T) {
 		}
 		assert.NotNil(t, obj)
 	})
+
+	t.Run("marshals declared properties before sorted additional ones", func(t *testing.T) {
+		ref := "foo"
+		obj := ReferenceWithRequiredExtra{
+			Index: &ref,
+			AdditionalProperties: map[string]string{
+				"zed":   "z",
+				"alpha": "a",
+			},
+		}
+
+		data, err := json.Marshal(obj)
+		require.NoError(t, err)
+		assert.Equal(t, `{"index":"foo","alpha":"a","zed":"z"}`, string(data))
+
+		var decoded ReferenceWithRequiredExtra
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, "foo", *decoded.Index)
+		assert.Equal(t, map[string]string{"alpha": "a", "zed": "z"}, decoded.AdditionalProperties)
+	})
+
+	t.Run("additional properties override declared ones", func(t *testing.T) {
+		ref := "foo"
+		obj := ReferenceWithRequiredExtra{
+			Index:                &ref,
+			AdditionalProperties: map[string]string{"index": "bar", "zed": "z"},
+		}
+
+		data, err := json.Marshal(obj)
+		require.NoError(t, err)
+		assert.Equal(t, `{"index":"bar","zed":"z"}`, string(data))
+	})
 }
 
 func TestConfigWithMinProps_Validate(t *testing.T) {
@@ -28,9 +28,13 @@ type File struct {
 func (f File) Validate() error {
 	var errors runtime.ValidationErrors
 	for k, v := range f.Metadata {
-		if validator, ok := any(v).(runtime.Validator); ok {
-			if err := validator.Validate(); err != nil {
-				errors = errors.Append(fmt.Sprintf("Metadata[%s]", k), err)
+		for k0, v0 := range v {
+			for k1, v1 := range v0 {
+				if val, ok := any(v1).(runtime.Validator); ok {
+					if err := val.Validate(); err != nil {
+						errors = errors.Append(fmt.Sprintf("Metadata[%s][%s][%s]", k, k0, k1), err)
+					}
+				}
 			}
 		}
 	}
@@ -52,4 +56,5 @@ var typesValidator *validator.Validate
 func init() {
 	typesValidator = validator.New(validator.WithRequiredStructEnabled())
 	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
 }
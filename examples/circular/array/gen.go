@@ -9,16 +9,16 @@ import (
 	"github.com/go-playground/validator/v10"
 )
 
-type GetNodesIDPath struct {
+type GetNodesByIDPath struct {
 	// ID The ID of the node
 	ID int `json:"id" validate:"required"`
 }
 
-func (g GetNodesIDPath) Validate() error {
+func (g GetNodesByIDPath) Validate() error {
 	return runtime.ConvertValidatorError(typesValidator.Struct(g))
 }
 
-type GetNodesIDResponse = Node
+type GetNodesByIDResponse = Node
 
 type Node struct {
 	ID       *int    `json:"id,omitempty"`
@@ -46,4 +46,5 @@ var typesValidator *validator.Validate
 func init() {
 	typesValidator = validator.New(validator.WithRequiredStructEnabled())
 	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
 }
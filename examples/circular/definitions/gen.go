@@ -9,16 +9,16 @@ import (
 	"github.com/go-playground/validator/v10"
 )
 
-type GetReportsIDPath struct {
+type GetReportsByIDPath struct {
 	// ID The ID of the report
 	ID int `json:"id" validate:"required"`
 }
 
-func (g GetReportsIDPath) Validate() error {
+func (g GetReportsByIDPath) Validate() error {
 	return runtime.ConvertValidatorError(typesValidator.Struct(g))
 }
 
-type GetReportsIDResponse = Report
+type GetReportsByIDResponse = Report
 
 type Report struct {
 	ReportData *Report_ReportData `json:"reportData,omitempty"`
@@ -135,4 +135,5 @@ var typesValidator *validator.Validate
 func init() {
 	typesValidator = validator.New(validator.WithRequiredStructEnabled())
 	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
 }
@@ -24,6 +24,7 @@ func NewClient(apiClient runtime.APIClient) *Client {
 
 // NewDefaultClient creates a new instance of the Client client with default api client.
 func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithUserAgent("Files/1.0.0 oapi-codegen-dd/v3.63.4")}, opts...)
 	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("error creating API client: %w", err)
@@ -45,19 +46,18 @@ func (c *Client) GetFiles(ctx context.Context, reqEditors ...runtime.RequestEdit
 
 	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
 	if err != nil {
-		return nil, fmt.Errorf("error creating request: %w", err)
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
 	}
 
 	responseParser := func(ctx context.Context, resp *runtime.Response) (*GetFilesResponse, error) {
 		bodyBytes := resp.Content
 		if resp.StatusCode != 200 {
-			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+			return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
 				runtime.WithStatusCode(resp.StatusCode))
 		}
 		target := new(GetFilesResponse)
 		if err = json.Unmarshal(bodyBytes, target); err != nil {
-			err = fmt.Errorf("error decoding response: %w", err)
-			return nil, err
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
 		}
 		return target, nil
 	}
@@ -87,6 +87,68 @@ func (f FileObject) Validate() error {
 	}
 }
 
+// fileObjectNames maps FileObject values to their names.
+var fileObjectNames = map[FileObject]string{
+	FileObjectFile: "File",
+}
+
+// fileObjectValues maps names to FileObject values.
+var fileObjectValues = map[string]FileObject{
+	"File": FileObjectFile,
+}
+
+// String returns the wire value of the FileObject.
+func (f FileObject) String() string {
+	return string(f)
+}
+
+// IsValid reports whether the FileObject value is defined in the spec.
+func (f FileObject) IsValid() bool {
+	_, ok := fileObjectNames[f]
+	return ok
+}
+
+// Values returns all the FileObject values defined in the spec.
+func (FileObject) Values() []FileObject {
+	return []FileObject{
+		FileObjectFile,
+	}
+}
+
+// FileObjectPtr returns a pointer to v, to set the optional and nullable FileObject properties.
+func FileObjectPtr(v FileObject) *FileObject {
+	return &v
+}
+
+// Name returns the name of the FileObject value, or an empty string for unknown values.
+func (f FileObject) Name() string {
+	return fileObjectNames[f]
+}
+
+// ParseFileObject returns the FileObject matching s by wire value or by name.
+func ParseFileObject(s string) (FileObject, error) {
+	if _, ok := fileObjectNames[FileObject(s)]; ok {
+		return FileObject(s), nil
+	}
+	if v, ok := fileObjectValues[s]; ok {
+		return v, nil
+	}
+	var zero FileObject
+	return zero, fmt.Errorf("%w for FileObject: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (f FileObject) MarshalText() ([]byte, error) {
+	return []byte(f), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseFileObject.
+// Unknown values are kept as-is and reported by Validate.
+func (f *FileObject) UnmarshalText(text []byte) error {
+	*f = FileObject(text)
+	return nil
+}
+
 type FilePurpose string
 
 const (
@@ -105,6 +167,74 @@ func (f FilePurpose) Validate() error {
 	}
 }
 
+// filePurposeNames maps FilePurpose values to their names.
+var filePurposeNames = map[FilePurpose]string{
+	AccountRequirement:     "AccountRequirement",
+	AdditionalVerification: "AdditionalVerification",
+	BusinessIcon:           "BusinessIcon",
+}
+
+// filePurposeValues maps names to FilePurpose values.
+var filePurposeValues = map[string]FilePurpose{
+	"AccountRequirement":     AccountRequirement,
+	"AdditionalVerification": AdditionalVerification,
+	"BusinessIcon":           BusinessIcon,
+}
+
+// String returns the wire value of the FilePurpose.
+func (f FilePurpose) String() string {
+	return string(f)
+}
+
+// IsValid reports whether the FilePurpose value is defined in the spec.
+func (f FilePurpose) IsValid() bool {
+	_, ok := filePurposeNames[f]
+	return ok
+}
+
+// Values returns all the FilePurpose values defined in the spec.
+func (FilePurpose) Values() []FilePurpose {
+	return []FilePurpose{
+		AccountRequirement,
+		AdditionalVerification,
+		BusinessIcon,
+	}
+}
+
+// FilePurposePtr returns a pointer to v, to set the optional and nullable FilePurpose properties.
+func FilePurposePtr(v FilePurpose) *FilePurpose {
+	return &v
+}
+
+// Name returns the name of the FilePurpose value, or an empty string for unknown values.
+func (f FilePurpose) Name() string {
+	return filePurposeNames[f]
+}
+
+// ParseFilePurpose returns the FilePurpose matching s by wire value or by name.
+func ParseFilePurpose(s string) (FilePurpose, error) {
+	if _, ok := filePurposeNames[FilePurpose(s)]; ok {
+		return FilePurpose(s), nil
+	}
+	if v, ok := filePurposeValues[s]; ok {
+		return v, nil
+	}
+	var zero FilePurpose
+	return zero, fmt.Errorf("%w for FilePurpose: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (f FilePurpose) MarshalText() ([]byte, error) {
+	return []byte(f), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseFilePurpose.
+// Unknown values are kept as-is and reported by Validate.
+func (f *FilePurpose) UnmarshalText(text []byte) error {
+	*f = FilePurpose(text)
+	return nil
+}
+
 type FileLinksObject string
 
 const (
@@ -121,6 +251,68 @@ func (f FileLinksObject) Validate() error {
 	}
 }
 
+// fileLinksObjectNames maps FileLinksObject values to their names.
+var fileLinksObjectNames = map[FileLinksObject]string{
+	List: "List",
+}
+
+// fileLinksObjectValues maps names to FileLinksObject values.
+var fileLinksObjectValues = map[string]FileLinksObject{
+	"List": List,
+}
+
+// String returns the wire value of the FileLinksObject.
+func (f FileLinksObject) String() string {
+	return string(f)
+}
+
+// IsValid reports whether the FileLinksObject value is defined in the spec.
+func (f FileLinksObject) IsValid() bool {
+	_, ok := fileLinksObjectNames[f]
+	return ok
+}
+
+// Values returns all the FileLinksObject values defined in the spec.
+func (FileLinksObject) Values() []FileLinksObject {
+	return []FileLinksObject{
+		List,
+	}
+}
+
+// FileLinksObjectPtr returns a pointer to v, to set the optional and nullable FileLinksObject properties.
+func FileLinksObjectPtr(v FileLinksObject) *FileLinksObject {
+	return &v
+}
+
+// Name returns the name of the FileLinksObject value, or an empty string for unknown values.
+func (f FileLinksObject) Name() string {
+	return fileLinksObjectNames[f]
+}
+
+// ParseFileLinksObject returns the FileLinksObject matching s by wire value or by name.
+func ParseFileLinksObject(s string) (FileLinksObject, error) {
+	if _, ok := fileLinksObjectNames[FileLinksObject(s)]; ok {
+		return FileLinksObject(s), nil
+	}
+	if v, ok := fileLinksObjectValues[s]; ok {
+		return v, nil
+	}
+	var zero FileLinksObject
+	return zero, fmt.Errorf("%w for FileLinksObject: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (f FileLinksObject) MarshalText() ([]byte, error) {
+	return []byte(f), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseFileLinksObject.
+// Unknown values are kept as-is and reported by Validate.
+func (f *FileLinksObject) UnmarshalText(text []byte) error {
+	*f = FileLinksObject(text)
+	return nil
+}
+
 type FileLinkObject string
 
 const (
@@ -137,6 +329,68 @@ func (f FileLinkObject) Validate() error {
 	}
 }
 
+// fileLinkObjectNames maps FileLinkObject values to their names.
+var fileLinkObjectNames = map[FileLinkObject]string{
+	FileLinkObjectFileLink: "FileLink",
+}
+
+// fileLinkObjectValues maps names to FileLinkObject values.
+var fileLinkObjectValues = map[string]FileLinkObject{
+	"FileLink": FileLinkObjectFileLink,
+}
+
+// String returns the wire value of the FileLinkObject.
+func (f FileLinkObject) String() string {
+	return string(f)
+}
+
+// IsValid reports whether the FileLinkObject value is defined in the spec.
+func (f FileLinkObject) IsValid() bool {
+	_, ok := fileLinkObjectNames[f]
+	return ok
+}
+
+// Values returns all the FileLinkObject values defined in the spec.
+func (FileLinkObject) Values() []FileLinkObject {
+	return []FileLinkObject{
+		FileLinkObjectFileLink,
+	}
+}
+
+// FileLinkObjectPtr returns a pointer to v, to set the optional and nullable FileLinkObject properties.
+func FileLinkObjectPtr(v FileLinkObject) *FileLinkObject {
+	return &v
+}
+
+// Name returns the name of the FileLinkObject value, or an empty string for unknown values.
+func (f FileLinkObject) Name() string {
+	return fileLinkObjectNames[f]
+}
+
+// ParseFileLinkObject returns the FileLinkObject matching s by wire value or by name.
+func ParseFileLinkObject(s string) (FileLinkObject, error) {
+	if _, ok := fileLinkObjectNames[FileLinkObject(s)]; ok {
+		return FileLinkObject(s), nil
+	}
+	if v, ok := fileLinkObjectValues[s]; ok {
+		return v, nil
+	}
+	var zero FileLinkObject
+	return zero, fmt.Errorf("%w for FileLinkObject: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (f FileLinkObject) MarshalText() ([]byte, error) {
+	return []byte(f), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseFileLinkObject.
+// Unknown values are kept as-is and reported by Validate.
+func (f *FileLinkObject) UnmarshalText(text []byte) error {
+	*f = FileLinkObject(text)
+	return nil
+}
+
 type GetFilesResponse GetFiles_Response
 
 type File struct {
@@ -601,9 +855,26 @@ func (g *GetFiles_Response_OneOf) Validate() error {
 	return nil
 }
 
+// UnmarshalJSON holds the variant whose required properties best match the data,
+// or a *runtime.AmbiguousUnionError if several match equally well.
+func (g *GetFiles_Response_OneOf) UnmarshalJSON(data []byte) error {
+	n, err := runtime.MatchRequiredFields(data,
+		runtime.UnionVariant{Name: "string"},
+		runtime.UnionVariant{Name: "File", Required: []string{"created", "id", "object", "purpose", "size"}, Properties: []string{"filename", "id", "author", "links", "object", "purpose", "size", "title"}},
+	)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return g.Either.UnmarshalJSON(data)
+	}
+	return g.UnmarshalVariant(n, data)
+}
+
 var typesValidator *validator.Validate
 
 func init() {
 	typesValidator = validator.New(validator.WithRequiredStructEnabled())
 	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
 }
@@ -28,6 +28,74 @@ func (o OrgModelType) Validate() error {
 	}
 }
 
+// orgModelTypeNames maps OrgModelType values to their names.
+var orgModelTypeNames = map[OrgModelType]string{
+	Department:   "Department",
+	Division:     "Division",
+	Organization: "Organization",
+}
+
+// orgModelTypeValues maps names to OrgModelType values.
+var orgModelTypeValues = map[string]OrgModelType{
+	"Department":   Department,
+	"Division":     Division,
+	"Organization": Organization,
+}
+
+// String returns the wire value of the OrgModelType.
+func (o OrgModelType) String() string {
+	return string(o)
+}
+
+// IsValid reports whether the OrgModelType value is defined in the spec.
+func (o OrgModelType) IsValid() bool {
+	_, ok := orgModelTypeNames[o]
+	return ok
+}
+
+// Values returns all the OrgModelType values defined in the spec.
+func (OrgModelType) Values() []OrgModelType {
+	return []OrgModelType{
+		Department,
+		Division,
+		Organization,
+	}
+}
+
+// OrgModelTypePtr returns a pointer to v, to set the optional and nullable OrgModelType properties.
+func OrgModelTypePtr(v OrgModelType) *OrgModelType {
+	return &v
+}
+
+// Name returns the name of the OrgModelType value, or an empty string for unknown values.
+func (o OrgModelType) Name() string {
+	return orgModelTypeNames[o]
+}
+
+// ParseOrgModelType returns the OrgModelType matching s by wire value or by name.
+func ParseOrgModelType(s string) (OrgModelType, error) {
+	if _, ok := orgModelTypeNames[OrgModelType(s)]; ok {
+		return OrgModelType(s), nil
+	}
+	if v, ok := orgModelTypeValues[s]; ok {
+		return v, nil
+	}
+	var zero OrgModelType
+	return zero, fmt.Errorf("%w for OrgModelType: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (o OrgModelType) MarshalText() ([]byte, error) {
+	return []byte(o), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseOrgModelType.
+// Unknown values are kept as-is and reported by Validate.
+func (o *OrgModelType) UnmarshalText(text []byte) error {
+	*o = OrgModelType(text)
+	return nil
+}
+
 type AcctstructureResponse = OrgByIDResponseWrapperModel
 
 type OrgByIDResponseWrapperModel struct {
@@ -91,4 +159,5 @@ var typesValidator *validator.Validate
 func init() {
 	typesValidator = validator.New(validator.WithRequiredStructEnabled())
 	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
 }
@@ -22,6 +22,7 @@ func NewClient(apiClient runtime.APIClient) *Client {
 
 // NewDefaultClient creates a new instance of the Client client with default api client.
 func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithUserAgent("Generate-models/1.0.0 oapi-codegen-dd/v3.63.4")}, opts...)
 	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("error creating API client: %w", err)
@@ -41,12 +42,14 @@ func (c *Client) GetClient(ctx context.Context, options *GetClientRequestOptions
 	reqParams := runtime.RequestOptionsParameters{
 		RequestURL: c.apiClient.GetBaseURL() + "/client",
 		Method:     "GET",
-		Options:    options,
+	}
+	if options != nil {
+		reqParams.Options = options
 	}
 
 	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
 	if err != nil {
-		return nil, fmt.Errorf("error creating request: %w", err)
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
 	}
 
 	responseParser := func(ctx context.Context, resp *runtime.Response) (*GetClientResponse, error) {
@@ -55,7 +58,7 @@ func (c *Client) GetClient(ctx context.Context, options *GetClientRequestOptions
 			target := new(GetClientErrorResponse)
 			err = json.Unmarshal(bodyBytes, target)
 			if err != nil {
-				return nil, fmt.Errorf("error decoding response: %w", err)
+				return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
 			}
 
 			if errTarget, ok := any(*target).(error); ok {
@@ -66,8 +69,7 @@ func (c *Client) GetClient(ctx context.Context, options *GetClientRequestOptions
 		}
 		target := new(GetClientResponse)
 		if err = json.Unmarshal(bodyBytes, target); err != nil {
-			err = fmt.Errorf("error decoding response: %w", err)
-			return nil, err
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
 		}
 		return target, nil
 	}
@@ -81,16 +83,28 @@ func (c *Client) GetClient(ctx context.Context, options *GetClientRequestOptions
 
 func (c *Client) UpdateClient(ctx context.Context, options *UpdateClientRequestOptions, reqEditors ...runtime.RequestEditorFn) (*struct{}, error) {
 	var err error
+	if options == nil || options.Body == nil {
+		return nil, fmt.Errorf("error creating request body: %w", runtime.ErrMissingValue)
+	}
+	if options != nil && options.Body != nil {
+		if v, ok := any(options.Body).(runtime.Validator); ok {
+			if err = v.Validate(); err != nil {
+				return nil, fmt.Errorf("error validating request body: %w", err)
+			}
+		}
+	}
 	reqParams := runtime.RequestOptionsParameters{
 		RequestURL:  c.apiClient.GetBaseURL() + "/client",
 		Method:      "PUT",
-		Options:     options,
 		ContentType: "application/x-www-form-urlencoded",
 	}
+	if options != nil {
+		reqParams.Options = options
+	}
 
 	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
 	if err != nil {
-		return nil, fmt.Errorf("error creating request: %w", err)
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
 	}
 
 	responseParser := func(ctx context.Context, resp *runtime.Response) (*struct{}, error) {
@@ -99,7 +113,7 @@ func (c *Client) UpdateClient(ctx context.Context, options *UpdateClientRequestO
 			target := new(UpdateClientErrorResponseJSON)
 			err = json.Unmarshal(bodyBytes, target)
 			if err != nil {
-				return nil, fmt.Errorf("error decoding response: %w", err)
+				return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
 			}
 
 			if errTarget, ok := any(*target).(error); ok {
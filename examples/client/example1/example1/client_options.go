@@ -40,7 +40,8 @@ func (o *GetClientRequestOptions) GetQuery() (map[string]any, error) {
 	return nil, nil
 }
 
-// GetBody returns the payload in any type that can be marshalled to JSON by the client.
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
 func (o *GetClientRequestOptions) GetBody() any {
 	return nil
 }
@@ -93,8 +94,12 @@ func (o *UpdateClientRequestOptions) GetQuery() (map[string]any, error) {
 	return nil, nil
 }
 
-// GetBody returns the payload in any type that can be marshalled to JSON by the client.
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
 func (o *UpdateClientRequestOptions) GetBody() any {
+	if o.Body == nil {
+		return nil
+	}
 	return o.Body
 }
 
@@ -18,6 +18,8 @@ func (r GetClientErrorResponse) Error() string {
 	return res1
 }
 
+type GetClientDefaultResponse = Error
+
 type UpdateClientErrorResponseJSON struct {
 	Code    *ErrorCode `json:"code,omitempty"`
 	Message *string    `json:"message,omitempty"`
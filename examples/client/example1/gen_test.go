@@ -1,10 +1,15 @@
 package example1_test
 
 import (
+	"context"
+	"errors"
+	"net/http"
 	"testing"
 
 	"github.com/doordash-oss/oapi-codegen-dd/v3/examples/client/example1/example1"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestEnumPrefixes(t *testing.T) {
@@ -72,3 +77,27 @@ func TestResponsesAreNotAliases(t *testing.T) {
 	}
 	assert.Equal(t, &msg, errResp.Message)
 }
+
+// unreachableDoer fails the test if a request is sent
+type unreachableDoer struct {
+	t *testing.T
+}
+
+func (d unreachableDoer) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	d.t.Fatalf("unexpected request to %s", req.URL)
+	return nil, errors.New("unreachable")
+}
+
+func TestRequestBodyIsValidatedBeforeSend(t *testing.T) {
+	client, err := example1.NewDefaultClient("https://example.com", runtime.WithHTTPClient(unreachableDoer{t: t}))
+	require.NoError(t, err)
+
+	_, err = client.UpdateClient(context.Background(), &example1.UpdateClientRequestOptions{
+		Body: &example1.UpdateClientBody{},
+	})
+
+	var validationErrs runtime.ValidationErrors
+	require.ErrorAs(t, err, &validationErrs)
+	require.Len(t, validationErrs, 1)
+	assert.Equal(t, "Name", validationErrs[0].Field)
+}
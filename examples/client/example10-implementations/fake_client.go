@@ -0,0 +1,32 @@
+package example10
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+// FakeClient is an in-memory ClientInterface for tests.
+// Stubs of the methods it is missing are added when the code is generated.
+type FakeClient struct {
+	Pets map[string]Pet
+}
+
+// ListPets implements ClientInterface.
+func (f *FakeClient) ListPets(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (*ListPetsResponse, error) {
+	res := make(ListPetsResponse, 0, len(f.Pets))
+	for _, pet := range f.Pets {
+		res = append(res, pet)
+	}
+	return &res, nil
+}
+
+// GetPet implements ClientInterface.
+func (f *FakeClient) GetPet(ctx context.Context, options *GetPetRequestOptions, reqEditors ...runtime.RequestEditorFn) (*GetPetResponse, error) {
+	pet, ok := f.Pets[options.PathParams.ID]
+	if !ok {
+		return nil, fmt.Errorf("pet %s not found", options.PathParams.ID)
+	}
+	return &pet, nil
+}
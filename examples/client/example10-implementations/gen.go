@@ -0,0 +1,182 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package example10
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/go-playground/validator/v10"
+)
+
+// Client is the client for the API implementing the Client interface.
+type Client struct {
+	apiClient runtime.APIClient
+}
+
+// NewClient creates a new instance of the Client client.
+func NewClient(apiClient runtime.APIClient) *Client {
+	return &Client{apiClient: apiClient}
+}
+
+// NewDefaultClient creates a new instance of the Client client with default api client.
+func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithUserAgent("Pets/1.0.0 oapi-codegen-dd/v3.63.4")}, opts...)
+	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API client: %w", err)
+	}
+	return &Client{apiClient: apiClient}, nil
+}
+
+// ClientInterface is the interface for the API client.
+type ClientInterface interface {
+	ListPets(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (*ListPetsResponse, error)
+
+	GetPet(ctx context.Context, options *GetPetRequestOptions, reqEditors ...runtime.RequestEditorFn) (*GetPetResponse, error)
+}
+
+func (c *Client) ListPets(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (*ListPetsResponse, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/pets",
+		Method:     "GET",
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*ListPetsResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(ListPetsResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/pets")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+func (c *Client) GetPet(ctx context.Context, options *GetPetRequestOptions, reqEditors ...runtime.RequestEditorFn) (*GetPetResponse, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/pets/{id}",
+		Method:     "GET",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*GetPetResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(GetPetResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/pets/{id}")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+var _ ClientInterface = (*Client)(nil)
+var _ ClientInterface = (*FakeClient)(nil)
+
+// GetPetRequestOptions is the options needed to make a request to GetPet.
+type GetPetRequestOptions struct {
+	PathParams *GetPetPath
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *GetPetRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *GetPetRequestOptions) GetPathParams() (map[string]any, error) {
+	return runtime.AsMap[any](o.PathParams)
+}
+
+// GetQuery returns the query params as a map.
+func (o *GetPetRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
+func (o *GetPetRequestOptions) GetBody() any {
+	return nil
+}
+
+// GetHeader returns the headers as a map.
+func (o *GetPetRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+type GetPetPath struct {
+	ID string `json:"id" validate:"required"`
+}
+
+func (g GetPetPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(g))
+}
+
+type ListPetsResponse []Pet
+
+type GetPetResponse = Pet
+
+type Pet struct {
+	Name string `json:"name" validate:"required"`
+}
+
+func (p Pet) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(p))
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
+}
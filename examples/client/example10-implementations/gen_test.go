@@ -0,0 +1,26 @@
+package example10_test
+
+import (
+	"context"
+	"testing"
+
+	example10 "github.com/doordash-oss/oapi-codegen-dd/v3/examples/client/example10-implementations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFakeClient(t *testing.T) {
+	var client example10.ClientInterface = &example10.FakeClient{
+		Pets: map[string]example10.Pet{"1": {Name: "Rex"}},
+	}
+
+	pet, err := client.GetPet(context.Background(), &example10.GetPetRequestOptions{
+		PathParams: &example10.GetPetPath{ID: "1"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Rex", pet.Name)
+
+	pets, err := client.ListPets(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, *pets, 1)
+}
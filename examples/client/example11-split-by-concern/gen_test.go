@@ -0,0 +1,77 @@
+package example11_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/examples/client/example11-split-by-concern/petstore"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/examples/client/example11-split-by-concern/petstore/petclient"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// httpClientAdapter wraps http.Client to implement runtime.HttpRequestDoer
+type httpClientAdapter struct {
+	client *http.Client
+}
+
+func (a *httpClientAdapter) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return a.client.Do(req.WithContext(ctx))
+}
+
+func newClient(t *testing.T, handler http.HandlerFunc) *petclient.Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	apiClient, err := runtime.NewAPIClient(server.URL, runtime.WithHTTPClient(&httpClientAdapter{client: server.Client()}))
+	require.NoError(t, err)
+	return petclient.NewClient(apiClient)
+}
+
+func TestCreatePet(t *testing.T) {
+	client := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var pet petstore.NewPet
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&pet))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(petstore.Pet{ID: "1", Name: pet.Name, Kind: pet.Kind})
+	})
+
+	pet, err := client.CreatePet(context.Background(), &petclient.CreatePetRequestOptions{
+		Body: &petstore.CreatePetBody{Name: "Tom", Kind: petstore.Cat},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, petstore.Pet{ID: "1", Name: "Tom", Kind: petstore.Cat}, *pet)
+}
+
+func TestCreatePetValidatesBody(t *testing.T) {
+	client := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("invalid body sent")
+	})
+
+	_, err := client.CreatePet(context.Background(), &petclient.CreatePetRequestOptions{
+		Body: &petstore.CreatePetBody{Kind: "bird"},
+	})
+	assert.ErrorContains(t, err, "error validating request body")
+}
+
+func TestGetPetError(t *testing.T) {
+	client := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/pets/2", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"not found"}`))
+	})
+
+	_, err := client.GetPet(context.Background(), &petclient.GetPetRequestOptions{
+		PathParams: &petstore.GetPetPath{ID: "2"},
+	})
+
+	var apiErr petstore.Error
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "not found", apiErr.Message)
+}
@@ -0,0 +1,224 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package petclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/examples/client/example11-split-by-concern/petstore"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+// Client is the client for the API implementing the Client interface.
+type Client struct {
+	apiClient runtime.APIClient
+}
+
+// NewClient creates a new instance of the Client client.
+func NewClient(apiClient runtime.APIClient) *Client {
+	return &Client{apiClient: apiClient}
+}
+
+// NewDefaultClient creates a new instance of the Client client with default api client.
+func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithUserAgent("Pet-Store/1.0.0 oapi-codegen-dd/v3.63.4")}, opts...)
+	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API client: %w", err)
+	}
+	return &Client{apiClient: apiClient}, nil
+}
+
+// ClientInterface is the interface for the API client.
+type ClientInterface interface {
+	CreatePet(ctx context.Context, options *CreatePetRequestOptions, reqEditors ...runtime.RequestEditorFn) (*petstore.CreatePetResponse, error)
+
+	GetPet(ctx context.Context, options *GetPetRequestOptions, reqEditors ...runtime.RequestEditorFn) (*petstore.GetPetResponse, error)
+}
+
+func (c *Client) CreatePet(ctx context.Context, options *CreatePetRequestOptions, reqEditors ...runtime.RequestEditorFn) (*petstore.CreatePetResponse, error) {
+	var err error
+	if options == nil || options.Body == nil {
+		return nil, fmt.Errorf("error creating request body: %w", runtime.ErrMissingValue)
+	}
+	if options != nil && options.Body != nil {
+		if v, ok := any(options.Body).(runtime.Validator); ok {
+			if err = v.Validate(); err != nil {
+				return nil, fmt.Errorf("error validating request body: %w", err)
+			}
+		}
+	}
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL:  c.apiClient.GetBaseURL() + "/pets",
+		Method:      "POST",
+		ContentType: "application/json",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*petstore.CreatePetResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 201 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(petstore.CreatePetResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/pets")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+func (c *Client) GetPet(ctx context.Context, options *GetPetRequestOptions, reqEditors ...runtime.RequestEditorFn) (*petstore.GetPetResponse, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/pets/{id}",
+		Method:     "GET",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*petstore.GetPetResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			target := new(petstore.GetPetErrorResponse)
+			err = json.Unmarshal(bodyBytes, target)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+			}
+
+			if errTarget, ok := any(*target).(error); ok {
+				return nil, runtime.NewClientAPIError(errTarget, runtime.WithStatusCode(resp.StatusCode))
+			}
+			return nil, runtime.NewClientAPIError(fmt.Errorf("API error (status %d): %v", resp.StatusCode, *target),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(petstore.GetPetResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/pets/{id}")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+var _ ClientInterface = (*Client)(nil)
+
+// CreatePetRequestOptions is the options needed to make a request to CreatePet.
+type CreatePetRequestOptions struct {
+	Body *petstore.CreatePetBody
+}
+
+// GetPathParams returns the path params as a map.
+func (o *CreatePetRequestOptions) GetPathParams() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetQuery returns the query params as a map.
+func (o *CreatePetRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
+func (o *CreatePetRequestOptions) GetBody() any {
+	if o.Body == nil {
+		return nil
+	}
+	return o.Body
+}
+
+// GetHeader returns the headers as a map.
+func (o *CreatePetRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+// GetPetRequestOptions is the options needed to make a request to GetPet.
+type GetPetRequestOptions struct {
+	PathParams *petstore.GetPetPath
+}
+
+// GetPathParams returns the path params as a map.
+func (o *GetPetRequestOptions) GetPathParams() (map[string]any, error) {
+	return runtime.AsMap[any](o.PathParams)
+}
+
+// GetQuery returns the query params as a map.
+func (o *GetPetRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
+func (o *GetPetRequestOptions) GetBody() any {
+	return nil
+}
+
+// GetHeader returns the headers as a map.
+func (o *GetPetRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *CreatePetRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *GetPetRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
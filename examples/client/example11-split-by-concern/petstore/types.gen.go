@@ -0,0 +1,112 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package petstore
+
+import (
+	"fmt"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+type Kind string
+
+const (
+	Cat Kind = "cat"
+	Dog Kind = "dog"
+)
+
+// kindNames maps Kind values to their names.
+var kindNames = map[Kind]string{
+	Cat: "Cat",
+	Dog: "Dog",
+}
+
+// kindValues maps names to Kind values.
+var kindValues = map[string]Kind{
+	"Cat": Cat,
+	"Dog": Dog,
+}
+
+// String returns the wire value of the Kind.
+func (k Kind) String() string {
+	return string(k)
+}
+
+// IsValid reports whether the Kind value is defined in the spec.
+func (k Kind) IsValid() bool {
+	_, ok := kindNames[k]
+	return ok
+}
+
+// Values returns all the Kind values defined in the spec.
+func (Kind) Values() []Kind {
+	return []Kind{
+		Cat,
+		Dog,
+	}
+}
+
+// KindPtr returns a pointer to v, to set the optional and nullable Kind properties.
+func KindPtr(v Kind) *Kind {
+	return &v
+}
+
+// Name returns the name of the Kind value, or an empty string for unknown values.
+func (k Kind) Name() string {
+	return kindNames[k]
+}
+
+// ParseKind returns the Kind matching s by wire value or by name.
+func ParseKind(s string) (Kind, error) {
+	if _, ok := kindNames[Kind(s)]; ok {
+		return Kind(s), nil
+	}
+	if v, ok := kindValues[s]; ok {
+		return v, nil
+	}
+	var zero Kind
+	return zero, fmt.Errorf("%w for Kind: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (k Kind) MarshalText() ([]byte, error) {
+	return []byte(k), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseKind.
+// Unknown values are kept as-is and reported by Validate.
+func (k *Kind) UnmarshalText(text []byte) error {
+	*k = Kind(text)
+	return nil
+}
+
+type GetPetPath struct {
+	ID string `json:"id" validate:"required"`
+}
+
+type CreatePetBody = NewPet
+
+type CreatePetResponse = Pet
+
+type GetPetResponse = Pet
+
+type GetPetErrorResponse = Error
+
+type NewPet struct {
+	Name string `json:"name" validate:"required,min=1"`
+	Kind Kind   `json:"kind" validate:"required"`
+}
+
+type Pet struct {
+	Name string `json:"name" validate:"required,min=1"`
+	Kind Kind   `json:"kind" validate:"required"`
+	ID   string `json:"id" validate:"required"`
+}
+
+type Error struct {
+	Message string `json:"message" validate:"required"`
+}
+
+func (s Error) Error() string {
+	return "unmapped client error"
+}
@@ -0,0 +1,71 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package petstore
+
+import (
+	"fmt"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/go-playground/validator/v10"
+)
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
+}
+
+// Validate checks if the Kind value is valid
+func (k Kind) Validate() error {
+	switch k {
+	case Cat, Dog:
+		return nil
+	default:
+		return runtime.NewValidationErrorsFromString("Enum", fmt.Sprintf("must be a valid Kind value, got: %v", k))
+	}
+}
+
+func (g GetPetPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(g))
+}
+
+func (n NewPet) Validate() error {
+	var errors runtime.ValidationErrors
+	if err := typesValidator.Var(n.Name, "required,min=1"); err != nil {
+		errors = errors.Append("Name", err)
+	}
+	if v, ok := any(n.Kind).(runtime.Validator); ok {
+		if err := v.Validate(); err != nil {
+			errors = errors.Append("Kind", err)
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+func (p Pet) Validate() error {
+	var errors runtime.ValidationErrors
+	if err := typesValidator.Var(p.Name, "required,min=1"); err != nil {
+		errors = errors.Append("Name", err)
+	}
+	if v, ok := any(p.Kind).(runtime.Validator); ok {
+		if err := v.Validate(); err != nil {
+			errors = errors.Append("Kind", err)
+		}
+	}
+	if err := typesValidator.Var(p.ID, "required"); err != nil {
+		errors = errors.Append("ID", err)
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+func (e Error) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(e))
+}
@@ -0,0 +1,92 @@
+package example12_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/examples/client/example12-split-by-tag/platform"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/examples/client/example12-split-by-tag/platform/orders"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/examples/client/example12-split-by-tag/platform/payments"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// httpClientAdapter wraps http.Client to implement runtime.HttpRequestDoer
+type httpClientAdapter struct {
+	client *http.Client
+}
+
+func (a *httpClientAdapter) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return a.client.Do(req.WithContext(ctx))
+}
+
+func newAPIClient(t *testing.T, handler http.HandlerFunc) runtime.APIClient {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	apiClient, err := runtime.NewAPIClient(server.URL, runtime.WithHTTPClient(&httpClientAdapter{client: server.Client()}))
+	require.NoError(t, err)
+	return apiClient
+}
+
+func TestCreatePayment(t *testing.T) {
+	client := payments.NewClient(newAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/payments", r.URL.Path)
+		var payment platform.NewPayment
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payment))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(platform.Payment{ID: "p1", OrderID: payment.OrderID, Total: payment.Total})
+	}))
+
+	total := platform.Money{Amount: 1200, Currency: "USD"}
+	payment, err := client.CreatePayment(context.Background(), &payments.CreatePaymentRequestOptions{
+		Body: &platform.CreatePaymentBody{OrderID: "o1", Total: total},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, platform.Payment{ID: "p1", OrderID: "o1", Total: total}, *payment)
+}
+
+func TestCreatePaymentValidatesBody(t *testing.T) {
+	client := payments.NewClient(newAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("invalid body sent")
+	}))
+
+	_, err := client.CreatePayment(context.Background(), &payments.CreatePaymentRequestOptions{
+		Body: &platform.CreatePaymentBody{OrderID: "o1", Total: platform.Money{Amount: 0, Currency: "US"}},
+	})
+	assert.ErrorContains(t, err, "error validating request body")
+}
+
+func TestGetOrderError(t *testing.T) {
+	client := orders.NewClient(newAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/orders/o2", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"not found"}`))
+	}))
+
+	_, err := client.GetOrder(context.Background(), &orders.GetOrderRequestOptions{
+		PathParams: &platform.GetOrderPath{ID: "o2"},
+	})
+
+	var apiErr platform.Error
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "not found", apiErr.Message)
+}
+
+func TestUntaggedOperation(t *testing.T) {
+	client := platform.NewClient(newAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/health", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"ok"}`))
+	}))
+
+	health, err := client.GetHealth(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "ok", *health.Status)
+}
@@ -0,0 +1,70 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package platform
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+// Client is the client for the API implementing the Client interface.
+type Client struct {
+	apiClient runtime.APIClient
+}
+
+// NewClient creates a new instance of the Client client.
+func NewClient(apiClient runtime.APIClient) *Client {
+	return &Client{apiClient: apiClient}
+}
+
+// NewDefaultClient creates a new instance of the Client client with default api client.
+func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithUserAgent("Platform/1.0.0 oapi-codegen-dd/v3.63.4")}, opts...)
+	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API client: %w", err)
+	}
+	return &Client{apiClient: apiClient}, nil
+}
+
+// ClientInterface is the interface for the API client.
+type ClientInterface interface {
+	GetHealth(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (*GetHealthResponse, error)
+}
+
+func (c *Client) GetHealth(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (*GetHealthResponse, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/health",
+		Method:     "GET",
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*GetHealthResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(GetHealthResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/health")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+var _ ClientInterface = (*Client)(nil)
@@ -0,0 +1,16 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package platform
+
+import (
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/go-playground/validator/v10"
+)
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
+}
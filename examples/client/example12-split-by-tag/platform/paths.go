@@ -0,0 +1,15 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package platform
+
+import (
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+type GetOrderPath struct {
+	ID string `json:"id" validate:"required"`
+}
+
+func (g GetOrderPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(g))
+}
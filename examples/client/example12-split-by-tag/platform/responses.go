@@ -0,0 +1,15 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package platform
+
+type CreatePaymentResponse = Payment
+
+type CreatePaymentErrorResponse = Error
+
+type GetOrderResponse = Order
+
+type GetOrderErrorResponse = Error
+
+type GetHealthResponse struct {
+	Status *string `json:"status,omitempty"`
+}
@@ -0,0 +1,95 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package platform
+
+import (
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+type Money struct {
+	Amount   int    `json:"amount" validate:"required,gte=1"`
+	Currency string `json:"currency" validate:"required,max=3,min=3"`
+}
+
+func (m Money) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(m))
+}
+
+type NewPayment struct {
+	OrderID string `json:"orderId" validate:"required"`
+	Total   Money  `json:"total"`
+}
+
+func (n NewPayment) Validate() error {
+	var errors runtime.ValidationErrors
+	if err := typesValidator.Var(n.OrderID, "required"); err != nil {
+		errors = errors.Append("OrderID", err)
+	}
+	if v, ok := any(n.Total).(runtime.Validator); ok {
+		if err := v.Validate(); err != nil {
+			errors = errors.Append("Total", err)
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+type Payment struct {
+	OrderID string `json:"orderId" validate:"required"`
+	Total   Money  `json:"total"`
+	ID      string `json:"id" validate:"required"`
+}
+
+func (p Payment) Validate() error {
+	var errors runtime.ValidationErrors
+	if err := typesValidator.Var(p.OrderID, "required"); err != nil {
+		errors = errors.Append("OrderID", err)
+	}
+	if v, ok := any(p.Total).(runtime.Validator); ok {
+		if err := v.Validate(); err != nil {
+			errors = errors.Append("Total", err)
+		}
+	}
+	if err := typesValidator.Var(p.ID, "required"); err != nil {
+		errors = errors.Append("ID", err)
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+type Order struct {
+	ID    string `json:"id" validate:"required"`
+	Total Money  `json:"total"`
+}
+
+func (o Order) Validate() error {
+	var errors runtime.ValidationErrors
+	if err := typesValidator.Var(o.ID, "required"); err != nil {
+		errors = errors.Append("ID", err)
+	}
+	if v, ok := any(o.Total).(runtime.Validator); ok {
+		if err := v.Validate(); err != nil {
+			errors = errors.Append("Total", err)
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+type Error struct {
+	Message string `json:"message" validate:"required"`
+}
+
+func (e Error) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(e))
+}
+
+func (s Error) Error() string {
+	return "unmapped client error"
+}
@@ -0,0 +1,464 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package example13
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/go-playground/validator/v10"
+)
+
+// Client is the client for the API implementing the Client interface.
+type Client struct {
+	apiClient runtime.APIClient
+}
+
+// NewClient creates a new instance of the Client client.
+func NewClient(apiClient runtime.APIClient) *Client {
+	return &Client{apiClient: apiClient}
+}
+
+// NewDefaultClient creates a new instance of the Client client with default api client.
+func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithUserAgent("Array-of-enum-query-parameters/1.0.0 oapi-codegen-dd/v3.63.4")}, opts...)
+	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API client: %w", err)
+	}
+	return &Client{apiClient: apiClient}, nil
+}
+
+// ClientInterface is the interface for the API client.
+type ClientInterface interface {
+	ListPets(ctx context.Context, options *ListPetsRequestOptions, reqEditors ...runtime.RequestEditorFn) (*ListPetsResponse, error)
+}
+
+func (c *Client) ListPets(ctx context.Context, options *ListPetsRequestOptions, reqEditors ...runtime.RequestEditorFn) (*ListPetsResponse, error) {
+	var err error
+	if options != nil && options.Query != nil {
+		if err = runtime.ValidateEach(options.Query.Colors); err != nil {
+			return nil, fmt.Errorf("error validating query parameter 'colors': %w", err)
+		}
+		if err = runtime.ValidateEach(options.Query.Status); err != nil {
+			return nil, fmt.Errorf("error validating query parameter 'status': %w", err)
+		}
+		if err = runtime.ValidateEach(options.Query.Kind); err != nil {
+			return nil, fmt.Errorf("error validating query parameter 'kind': %w", err)
+		}
+	}
+
+	queryEncoding := map[string]runtime.QueryEncoding{
+		"status": {Style: "form", Explode: &[]bool{false}[0]},
+	}
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL:    c.apiClient.GetBaseURL() + "/pets",
+		Method:        "GET",
+		QueryEncoding: queryEncoding,
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*ListPetsResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(ListPetsResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/pets")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+var _ ClientInterface = (*Client)(nil)
+
+// ListPetsRequestOptions is the options needed to make a request to ListPets.
+type ListPetsRequestOptions struct {
+	Query *ListPetsQuery
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *ListPetsRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Query != nil {
+		if v, ok := any(o.Query).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Query", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *ListPetsRequestOptions) GetPathParams() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetQuery returns the query params as a map.
+func (o *ListPetsRequestOptions) GetQuery() (map[string]any, error) {
+	return runtime.AsMap[any](o.Query)
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
+func (o *ListPetsRequestOptions) GetBody() any {
+	return nil
+}
+
+// GetHeader returns the headers as a map.
+func (o *ListPetsRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+type ColorsItem string
+
+const (
+	Green ColorsItem = "green"
+	Red   ColorsItem = "red"
+)
+
+// Validate checks if the ColorsItem value is valid
+func (c ColorsItem) Validate() error {
+	switch c {
+	case Green, Red:
+		return nil
+	default:
+		return runtime.NewValidationErrorsFromString("Enum", fmt.Sprintf("must be a valid ColorsItem value, got: %v", c))
+	}
+}
+
+// colorsItemNames maps ColorsItem values to their names.
+var colorsItemNames = map[ColorsItem]string{
+	Green: "Green",
+	Red:   "Red",
+}
+
+// colorsItemValues maps names to ColorsItem values.
+var colorsItemValues = map[string]ColorsItem{
+	"Green": Green,
+	"Red":   Red,
+}
+
+// String returns the wire value of the ColorsItem.
+func (c ColorsItem) String() string {
+	return string(c)
+}
+
+// IsValid reports whether the ColorsItem value is defined in the spec.
+func (c ColorsItem) IsValid() bool {
+	_, ok := colorsItemNames[c]
+	return ok
+}
+
+// Values returns all the ColorsItem values defined in the spec.
+func (ColorsItem) Values() []ColorsItem {
+	return []ColorsItem{
+		Green,
+		Red,
+	}
+}
+
+// ColorsItemPtr returns a pointer to v, to set the optional and nullable ColorsItem properties.
+func ColorsItemPtr(v ColorsItem) *ColorsItem {
+	return &v
+}
+
+// Name returns the name of the ColorsItem value, or an empty string for unknown values.
+func (c ColorsItem) Name() string {
+	return colorsItemNames[c]
+}
+
+// ParseColorsItem returns the ColorsItem matching s by wire value or by name.
+func ParseColorsItem(s string) (ColorsItem, error) {
+	if _, ok := colorsItemNames[ColorsItem(s)]; ok {
+		return ColorsItem(s), nil
+	}
+	if v, ok := colorsItemValues[s]; ok {
+		return v, nil
+	}
+	var zero ColorsItem
+	return zero, fmt.Errorf("%w for ColorsItem: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (c ColorsItem) MarshalText() ([]byte, error) {
+	return []byte(c), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseColorsItem.
+// Unknown values are kept as-is and reported by Validate.
+func (c *ColorsItem) UnmarshalText(text []byte) error {
+	*c = ColorsItem(text)
+	return nil
+}
+
+type Status string
+
+const (
+	Available Status = "available"
+	Sold      Status = "sold"
+)
+
+// Validate checks if the Status value is valid
+func (s Status) Validate() error {
+	switch s {
+	case Available, Sold:
+		return nil
+	default:
+		return runtime.NewValidationErrorsFromString("Enum", fmt.Sprintf("must be a valid Status value, got: %v", s))
+	}
+}
+
+// statusNames maps Status values to their names.
+var statusNames = map[Status]string{
+	Available: "Available",
+	Sold:      "Sold",
+}
+
+// statusValues maps names to Status values.
+var statusValues = map[string]Status{
+	"Available": Available,
+	"Sold":      Sold,
+}
+
+// String returns the wire value of the Status.
+func (s Status) String() string {
+	return string(s)
+}
+
+// IsValid reports whether the Status value is defined in the spec.
+func (s Status) IsValid() bool {
+	_, ok := statusNames[s]
+	return ok
+}
+
+// Values returns all the Status values defined in the spec.
+func (Status) Values() []Status {
+	return []Status{
+		Available,
+		Sold,
+	}
+}
+
+// StatusPtr returns a pointer to v, to set the optional and nullable Status properties.
+func StatusPtr(v Status) *Status {
+	return &v
+}
+
+// Name returns the name of the Status value, or an empty string for unknown values.
+func (s Status) Name() string {
+	return statusNames[s]
+}
+
+// ParseStatus returns the Status matching s by wire value or by name.
+func ParseStatus(s string) (Status, error) {
+	if _, ok := statusNames[Status(s)]; ok {
+		return Status(s), nil
+	}
+	if v, ok := statusValues[s]; ok {
+		return v, nil
+	}
+	var zero Status
+	return zero, fmt.Errorf("%w for Status: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (s Status) MarshalText() ([]byte, error) {
+	return []byte(s), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseStatus.
+// Unknown values are kept as-is and reported by Validate.
+func (s *Status) UnmarshalText(text []byte) error {
+	*s = Status(text)
+	return nil
+}
+
+type ListPetsQueryKind string
+
+const (
+	Cat ListPetsQueryKind = "cat"
+	Dog ListPetsQueryKind = "dog"
+)
+
+// Validate checks if the ListPetsQueryKind value is valid
+func (l ListPetsQueryKind) Validate() error {
+	switch l {
+	case Cat, Dog:
+		return nil
+	default:
+		return runtime.NewValidationErrorsFromString("Enum", fmt.Sprintf("must be a valid ListPetsQueryKind value, got: %v", l))
+	}
+}
+
+// listPetsQueryKindNames maps ListPetsQueryKind values to their names.
+var listPetsQueryKindNames = map[ListPetsQueryKind]string{
+	Cat: "Cat",
+	Dog: "Dog",
+}
+
+// listPetsQueryKindValues maps names to ListPetsQueryKind values.
+var listPetsQueryKindValues = map[string]ListPetsQueryKind{
+	"Cat": Cat,
+	"Dog": Dog,
+}
+
+// String returns the wire value of the ListPetsQueryKind.
+func (l ListPetsQueryKind) String() string {
+	return string(l)
+}
+
+// IsValid reports whether the ListPetsQueryKind value is defined in the spec.
+func (l ListPetsQueryKind) IsValid() bool {
+	_, ok := listPetsQueryKindNames[l]
+	return ok
+}
+
+// Values returns all the ListPetsQueryKind values defined in the spec.
+func (ListPetsQueryKind) Values() []ListPetsQueryKind {
+	return []ListPetsQueryKind{
+		Cat,
+		Dog,
+	}
+}
+
+// ListPetsQueryKindPtr returns a pointer to v, to set the optional and nullable ListPetsQueryKind properties.
+func ListPetsQueryKindPtr(v ListPetsQueryKind) *ListPetsQueryKind {
+	return &v
+}
+
+// Name returns the name of the ListPetsQueryKind value, or an empty string for unknown values.
+func (l ListPetsQueryKind) Name() string {
+	return listPetsQueryKindNames[l]
+}
+
+// ParseListPetsQueryKind returns the ListPetsQueryKind matching s by wire value or by name.
+func ParseListPetsQueryKind(s string) (ListPetsQueryKind, error) {
+	if _, ok := listPetsQueryKindNames[ListPetsQueryKind(s)]; ok {
+		return ListPetsQueryKind(s), nil
+	}
+	if v, ok := listPetsQueryKindValues[s]; ok {
+		return v, nil
+	}
+	var zero ListPetsQueryKind
+	return zero, fmt.Errorf("%w for ListPetsQueryKind: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (l ListPetsQueryKind) MarshalText() ([]byte, error) {
+	return []byte(l), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseListPetsQueryKind.
+// Unknown values are kept as-is and reported by Validate.
+func (l *ListPetsQueryKind) UnmarshalText(text []byte) error {
+	*l = ListPetsQueryKind(text)
+	return nil
+}
+
+type Colors []ColorsItem
+
+func (c Colors) Validate() error {
+	if c == nil {
+		return nil
+	}
+	var errors runtime.ValidationErrors
+	for i, item := range c {
+		if v, ok := any(item).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append(fmt.Sprintf("[%d]", i), err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+type ListPetsQuery struct {
+	Colors Colors              `json:"colors,omitempty"`
+	Status []Status            `json:"status,omitempty"`
+	Kind   []ListPetsQueryKind `json:"kind,omitempty"`
+}
+
+func (l ListPetsQuery) Validate() error {
+	var errors runtime.ValidationErrors
+	if v, ok := any(l.Colors).(runtime.Validator); ok && v != nil {
+		if err := v.Validate(); err != nil {
+			errors = errors.Append("Colors", err)
+		}
+	}
+	for i, item := range l.Status {
+		if v, ok := any(item).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append(fmt.Sprintf("Status[%d]", i), err)
+			}
+		}
+	}
+	for i, item := range l.Kind {
+		if v, ok := any(item).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append(fmt.Sprintf("Kind[%d]", i), err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+type ListPetsResponse []Pet
+
+type Pet struct {
+	Name   *string `json:"name,omitempty"`
+	Status *Status `json:"status,omitempty"`
+}
+
+func (p Pet) Validate() error {
+	var errors runtime.ValidationErrors
+	if p.Status != nil {
+		if v, ok := any(p.Status).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Status", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
+}
@@ -0,0 +1,72 @@
+package example13_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	example13 "github.com/doordash-oss/oapi-codegen-dd/v3/examples/client/example13-enum-array-query"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// httpClientAdapter wraps http.Client to implement runtime.HttpRequestDoer
+type httpClientAdapter struct {
+	client *http.Client
+}
+
+func (a *httpClientAdapter) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return a.client.Do(req.WithContext(ctx))
+}
+
+func newClient(t *testing.T, capturedQuery *string) *example13.Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*capturedQuery = r.URL.RawQuery
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	t.Cleanup(server.Close)
+
+	apiClient, err := runtime.NewAPIClient(server.URL, runtime.WithHTTPClient(&httpClientAdapter{client: server.Client()}))
+	require.NoError(t, err)
+	return example13.NewClient(apiClient)
+}
+
+func TestEnumArrayQuery(t *testing.T) {
+	t.Run("items are encoded with their constants", func(t *testing.T) {
+		var capturedQuery string
+		client := newClient(t, &capturedQuery)
+
+		_, err := client.ListPets(context.Background(), &example13.ListPetsRequestOptions{
+			Query: &example13.ListPetsQuery{
+				Colors: example13.Colors{example13.Red, example13.Green},
+				Status: []example13.Status{example13.Available, example13.Sold},
+				Kind:   []example13.ListPetsQueryKind{example13.Cat},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "colors=green&colors=red&kind=cat&status=available,sold", capturedQuery)
+	})
+
+	t.Run("unknown items are rejected before the request is sent", func(t *testing.T) {
+		var capturedQuery string
+		client := newClient(t, &capturedQuery)
+
+		_, err := client.ListPets(context.Background(), &example13.ListPetsRequestOptions{
+			Query: &example13.ListPetsQuery{
+				Status: []example13.Status{example13.Available, "lost"},
+			},
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "error validating query parameter 'status'")
+		assert.Contains(t, err.Error(), "[1]")
+		assert.Empty(t, capturedQuery)
+	})
+
+	t.Run("query validates the items", func(t *testing.T) {
+		query := example13.ListPetsQuery{Colors: example13.Colors{"blue"}}
+		assert.Error(t, query.Validate())
+	})
+}
@@ -0,0 +1,3 @@
+package example13
+
+//go:generate go run ../../../cmd/oapi-codegen -config cfg.yaml api.yaml
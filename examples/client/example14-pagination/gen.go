@@ -0,0 +1,790 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package example14
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/go-playground/validator/v10"
+)
+
+// Client is the client for the API implementing the Client interface.
+type Client struct {
+	apiClient runtime.APIClient
+}
+
+// NewClient creates a new instance of the Client client.
+func NewClient(apiClient runtime.APIClient) *Client {
+	return &Client{apiClient: apiClient}
+}
+
+// NewDefaultClient creates a new instance of the Client client with default api client.
+func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithUserAgent("Pet-store/1.0.0 oapi-codegen-dd/v3.63.4")}, opts...)
+	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API client: %w", err)
+	}
+	return &Client{apiClient: apiClient}, nil
+}
+
+// ClientInterface is the interface for the API client.
+type ClientInterface interface {
+	// ListPets Lists the pets, detected as paginated by cursor.
+	ListPets(ctx context.Context, options *ListPetsRequestOptions, reqEditors ...runtime.RequestEditorFn) (*ListPetsResponse, error)
+	ListPetsPages(ctx context.Context, options *ListPetsRequestOptions, reqEditors ...runtime.RequestEditorFn) iter.Seq2[*ListPetsResponse, error]
+	ListPetsAll(ctx context.Context, options *ListPetsRequestOptions, reqEditors ...runtime.RequestEditorFn) iter.Seq2[Pet, error]
+
+	// ListOrders Lists the orders, paginated by offset with x-pagination.
+	ListOrders(ctx context.Context, options *ListOrdersRequestOptions, reqEditors ...runtime.RequestEditorFn) (*ListOrdersResponse, error)
+	ListOrdersPages(ctx context.Context, options *ListOrdersRequestOptions, reqEditors ...runtime.RequestEditorFn) iter.Seq2[*ListOrdersResponse, error]
+	ListOrdersAll(ctx context.Context, options *ListOrdersRequestOptions, reqEditors ...runtime.RequestEditorFn) iter.Seq2[string, error]
+
+	// ListToys Lists the toys, paginated by page number in the configuration.
+	ListToys(ctx context.Context, options *ListToysRequestOptions, reqEditors ...runtime.RequestEditorFn) (*ListToysResponse, error)
+	ListToysPages(ctx context.Context, options *ListToysRequestOptions, reqEditors ...runtime.RequestEditorFn) iter.Seq2[*ListToysResponse, error]
+	ListToysAll(ctx context.Context, options *ListToysRequestOptions, reqEditors ...runtime.RequestEditorFn) iter.Seq2[string, error]
+
+	// ListOwners Lists the owners, detected as paginated by offset but turned off in the configuration.
+	ListOwners(ctx context.Context, options *ListOwnersRequestOptions, reqEditors ...runtime.RequestEditorFn) (*ListOwnersResponse, error)
+
+	// ListRepos Lists the repositories, detected as paginated by the Link header.
+	ListRepos(ctx context.Context, options *ListReposRequestOptions, reqEditors ...runtime.RequestEditorFn) (*ListReposResponse, error)
+	ListReposPages(ctx context.Context, options *ListReposRequestOptions, reqEditors ...runtime.RequestEditorFn) iter.Seq2[*ListReposResponse, error]
+	ListReposAll(ctx context.Context, options *ListReposRequestOptions, reqEditors ...runtime.RequestEditorFn) iter.Seq2[Repo, error]
+}
+
+// ListPets Lists the pets, detected as paginated by cursor.
+func (c *Client) ListPets(ctx context.Context, options *ListPetsRequestOptions, reqEditors ...runtime.RequestEditorFn) (*ListPetsResponse, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/pets",
+		Method:     "GET",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*ListPetsResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(ListPetsResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/pets")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+// ListPetsPages calls ListPets for every page from the one requested by options, and yields the responses
+// until nextPageToken is empty, requesting the next page with it as the pageToken query parameter.
+// Iteration stops at the first error.
+func (c *Client) ListPetsPages(ctx context.Context, options *ListPetsRequestOptions, reqEditors ...runtime.RequestEditorFn) iter.Seq2[*ListPetsResponse, error] {
+	return func(yield func(*ListPetsResponse, error) bool) {
+		opts := ListPetsRequestOptions{}
+		if options != nil {
+			opts = *options
+		}
+		query := ListPetsQuery{}
+		if opts.Query != nil {
+			query = *opts.Query
+		}
+		opts.Query = &query
+
+		for {
+			page, err := c.ListPets(ctx, &opts, reqEditors...)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if !yield(page, nil) {
+				return
+			}
+			if page.NextPageToken == nil || *page.NextPageToken == "" {
+				return
+			}
+			next := *page.NextPageToken
+			query.PageToken = &next
+		}
+	}
+}
+
+// ListPetsAll yields the results of every page of ListPetsPages, in pets.
+// Iteration stops at the first error.
+func (c *Client) ListPetsAll(ctx context.Context, options *ListPetsRequestOptions, reqEditors ...runtime.RequestEditorFn) iter.Seq2[Pet, error] {
+	return func(yield func(Pet, error) bool) {
+		for page, err := range c.ListPetsPages(ctx, options, reqEditors...) {
+			if err != nil {
+				var zero Pet
+				yield(zero, err)
+				return
+			}
+			for _, item := range page.Pets {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// ListOrders Lists the orders, paginated by offset with x-pagination.
+func (c *Client) ListOrders(ctx context.Context, options *ListOrdersRequestOptions, reqEditors ...runtime.RequestEditorFn) (*ListOrdersResponse, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/orders",
+		Method:     "GET",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*ListOrdersResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(ListOrdersResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/orders")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+// ListOrdersPages calls ListOrders for every page from the one requested by options, and yields the responses
+// until orders is empty, advancing the start query parameter by the number of results.
+// Iteration stops at the first error.
+func (c *Client) ListOrdersPages(ctx context.Context, options *ListOrdersRequestOptions, reqEditors ...runtime.RequestEditorFn) iter.Seq2[*ListOrdersResponse, error] {
+	return func(yield func(*ListOrdersResponse, error) bool) {
+		opts := ListOrdersRequestOptions{}
+		if options != nil {
+			opts = *options
+		}
+		query := ListOrdersQuery{}
+		if opts.Query != nil {
+			query = *opts.Query
+		}
+		opts.Query = &query
+
+		for {
+			page, err := c.ListOrders(ctx, &opts, reqEditors...)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if !yield(page, nil) {
+				return
+			}
+			if len(page.Orders) == 0 {
+				return
+			}
+			var next int
+			if query.Start != nil {
+				next = *query.Start
+			}
+			next += len(page.Orders)
+			query.Start = &next
+		}
+	}
+}
+
+// ListOrdersAll yields the results of every page of ListOrdersPages, in orders.
+// Iteration stops at the first error.
+func (c *Client) ListOrdersAll(ctx context.Context, options *ListOrdersRequestOptions, reqEditors ...runtime.RequestEditorFn) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		for page, err := range c.ListOrdersPages(ctx, options, reqEditors...) {
+			if err != nil {
+				var zero string
+				yield(zero, err)
+				return
+			}
+			for _, item := range page.Orders {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// ListToys Lists the toys, paginated by page number in the configuration.
+func (c *Client) ListToys(ctx context.Context, options *ListToysRequestOptions, reqEditors ...runtime.RequestEditorFn) (*ListToysResponse, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/toys",
+		Method:     "GET",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*ListToysResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(ListToysResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/toys")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+// ListToysPages calls ListToys for every page from the one requested by options, and yields the responses
+// until toys is empty, incrementing the p query parameter, 1 if not set.
+// Iteration stops at the first error.
+func (c *Client) ListToysPages(ctx context.Context, options *ListToysRequestOptions, reqEditors ...runtime.RequestEditorFn) iter.Seq2[*ListToysResponse, error] {
+	return func(yield func(*ListToysResponse, error) bool) {
+		opts := ListToysRequestOptions{}
+		if options != nil {
+			opts = *options
+		}
+		query := ListToysQuery{}
+		if opts.Query != nil {
+			query = *opts.Query
+		}
+		opts.Query = &query
+
+		for {
+			page, err := c.ListToys(ctx, &opts, reqEditors...)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if !yield(page, nil) {
+				return
+			}
+			if len(page.Toys) == 0 {
+				return
+			}
+			next := 1
+			if query.P != nil {
+				next = *query.P
+			}
+			next++
+			query.P = &next
+		}
+	}
+}
+
+// ListToysAll yields the results of every page of ListToysPages, in toys.
+// Iteration stops at the first error.
+func (c *Client) ListToysAll(ctx context.Context, options *ListToysRequestOptions, reqEditors ...runtime.RequestEditorFn) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		for page, err := range c.ListToysPages(ctx, options, reqEditors...) {
+			if err != nil {
+				var zero string
+				yield(zero, err)
+				return
+			}
+			for _, item := range page.Toys {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// ListOwners Lists the owners, detected as paginated by offset but turned off in the configuration.
+func (c *Client) ListOwners(ctx context.Context, options *ListOwnersRequestOptions, reqEditors ...runtime.RequestEditorFn) (*ListOwnersResponse, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/owners",
+		Method:     "GET",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*ListOwnersResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(ListOwnersResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/owners")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+// ListRepos Lists the repositories, detected as paginated by the Link header.
+func (c *Client) ListRepos(ctx context.Context, options *ListReposRequestOptions, reqEditors ...runtime.RequestEditorFn) (*ListReposResponse, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/repos",
+		Method:     "GET",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*ListReposResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(ListReposResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/repos")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+// ListReposPages calls ListRepos for every page from the one requested by options, and yields the responses
+// until the response has no Link header with the next relation type, requesting the next page from its URL.
+// Iteration stops at the first error.
+func (c *Client) ListReposPages(ctx context.Context, options *ListReposRequestOptions, reqEditors ...runtime.RequestEditorFn) iter.Seq2[*ListReposResponse, error] {
+	return func(yield func(*ListReposResponse, error) bool) {
+		fetch := func(reqEditors ...runtime.RequestEditorFn) (*ListReposResponse, string, error) {
+			var err error
+			reqParams := runtime.RequestOptionsParameters{
+				RequestURL: c.apiClient.GetBaseURL() + "/repos",
+				Method:     "GET",
+			}
+			if options != nil {
+				reqParams.Options = options
+			}
+
+			req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+			if err != nil {
+				return nil, "", fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+			}
+
+			responseParser := func(ctx context.Context, resp *runtime.Response) (*ListReposResponse, error) {
+				bodyBytes := resp.Content
+				if resp.StatusCode != 200 {
+					return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
+						runtime.WithStatusCode(resp.StatusCode))
+				}
+				target := new(ListReposResponse)
+				if err = json.Unmarshal(bodyBytes, target); err != nil {
+					return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+				}
+				return target, nil
+			}
+
+			resp, err := c.apiClient.ExecuteRequest(ctx, req, "/repos")
+			if err != nil {
+				return nil, "", fmt.Errorf("error executing request: %w", err)
+			}
+			page, err := responseParser(ctx, resp)
+			if err != nil {
+				return nil, "", err
+			}
+			return page, runtime.NextLink(req.URL, resp.Headers), nil
+		}
+
+		editors := reqEditors
+		for {
+			page, next, err := fetch(editors...)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if !yield(page, nil) || next == "" {
+				return
+			}
+			editors = append(reqEditors[:len(reqEditors):len(reqEditors)], runtime.WithRequestURL(next))
+		}
+	}
+}
+
+// ListReposAll yields the results of every page of ListReposPages.
+// Iteration stops at the first error.
+func (c *Client) ListReposAll(ctx context.Context, options *ListReposRequestOptions, reqEditors ...runtime.RequestEditorFn) iter.Seq2[Repo, error] {
+	return func(yield func(Repo, error) bool) {
+		for page, err := range c.ListReposPages(ctx, options, reqEditors...) {
+			if err != nil {
+				var zero Repo
+				yield(zero, err)
+				return
+			}
+			for _, item := range *page {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+var _ ClientInterface = (*Client)(nil)
+
+// ListPetsRequestOptions is the options needed to make a request to ListPets.
+type ListPetsRequestOptions struct {
+	Query *ListPetsQuery
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *ListPetsRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Query != nil {
+		if v, ok := any(o.Query).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Query", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *ListPetsRequestOptions) GetPathParams() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetQuery returns the query params as a map.
+func (o *ListPetsRequestOptions) GetQuery() (map[string]any, error) {
+	return runtime.AsMap[any](o.Query)
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
+func (o *ListPetsRequestOptions) GetBody() any {
+	return nil
+}
+
+// GetHeader returns the headers as a map.
+func (o *ListPetsRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+// ListOrdersRequestOptions is the options needed to make a request to ListOrders.
+type ListOrdersRequestOptions struct {
+	Query *ListOrdersQuery
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *ListOrdersRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Query != nil {
+		if v, ok := any(o.Query).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Query", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *ListOrdersRequestOptions) GetPathParams() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetQuery returns the query params as a map.
+func (o *ListOrdersRequestOptions) GetQuery() (map[string]any, error) {
+	return runtime.AsMap[any](o.Query)
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
+func (o *ListOrdersRequestOptions) GetBody() any {
+	return nil
+}
+
+// GetHeader returns the headers as a map.
+func (o *ListOrdersRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+// ListToysRequestOptions is the options needed to make a request to ListToys.
+type ListToysRequestOptions struct {
+	Query *ListToysQuery
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *ListToysRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Query != nil {
+		if v, ok := any(o.Query).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Query", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *ListToysRequestOptions) GetPathParams() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetQuery returns the query params as a map.
+func (o *ListToysRequestOptions) GetQuery() (map[string]any, error) {
+	return runtime.AsMap[any](o.Query)
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
+func (o *ListToysRequestOptions) GetBody() any {
+	return nil
+}
+
+// GetHeader returns the headers as a map.
+func (o *ListToysRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+// ListOwnersRequestOptions is the options needed to make a request to ListOwners.
+type ListOwnersRequestOptions struct {
+	Query *ListOwnersQuery
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *ListOwnersRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Query != nil {
+		if v, ok := any(o.Query).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Query", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *ListOwnersRequestOptions) GetPathParams() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetQuery returns the query params as a map.
+func (o *ListOwnersRequestOptions) GetQuery() (map[string]any, error) {
+	return runtime.AsMap[any](o.Query)
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
+func (o *ListOwnersRequestOptions) GetBody() any {
+	return nil
+}
+
+// GetHeader returns the headers as a map.
+func (o *ListOwnersRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+// ListReposRequestOptions is the options needed to make a request to ListRepos.
+type ListReposRequestOptions struct {
+	Query *ListReposQuery
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *ListReposRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Query != nil {
+		if v, ok := any(o.Query).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Query", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *ListReposRequestOptions) GetPathParams() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetQuery returns the query params as a map.
+func (o *ListReposRequestOptions) GetQuery() (map[string]any, error) {
+	return runtime.AsMap[any](o.Query)
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
+func (o *ListReposRequestOptions) GetBody() any {
+	return nil
+}
+
+// GetHeader returns the headers as a map.
+func (o *ListReposRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+type ListPetsQuery struct {
+	PageToken *string `json:"pageToken,omitempty"`
+	Limit     *int    `json:"limit,omitempty"`
+}
+
+type ListOrdersQuery struct {
+	Start *int `json:"start,omitempty"`
+}
+
+type ListToysQuery struct {
+	P *int `json:"p,omitempty"`
+}
+
+type ListOwnersQuery struct {
+	Offset *int `json:"offset,omitempty"`
+}
+
+type ListReposQuery struct {
+	PerPage *int `json:"perPage,omitempty"`
+}
+
+type ListPetsResponse = PetPage
+
+type ListOrdersResponse struct {
+	Orders []string `json:"orders" validate:"required"`
+}
+
+type ListToysResponse struct {
+	Toys     []string `json:"toys,omitempty"`
+	Featured []string `json:"featured,omitempty"`
+}
+
+type ListOwnersResponse struct {
+	Data []string `json:"data,omitempty"`
+}
+
+type ListReposResponse []Repo
+
+type PetPage struct {
+	Pets          []Pet   `json:"pets" validate:"required"`
+	NextPageToken *string `json:"nextPageToken,omitempty"`
+}
+
+func (p PetPage) Validate() error {
+	var errors runtime.ValidationErrors
+	for i, item := range p.Pets {
+		if v, ok := any(item).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append(fmt.Sprintf("Pets[%d]", i), err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+type Pet struct {
+	Name string `json:"name" validate:"required"`
+}
+
+func (p Pet) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(p))
+}
+
+type Repo struct {
+	Name string `json:"name" validate:"required"`
+}
+
+func (r Repo) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(r))
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
+}
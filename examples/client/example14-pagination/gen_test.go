@@ -0,0 +1,165 @@
+package example14_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	example14 "github.com/doordash-oss/oapi-codegen-dd/v3/examples/client/example14-pagination"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// httpClientAdapter wraps http.Client to implement runtime.HttpRequestDoer
+type httpClientAdapter struct {
+	client *http.Client
+}
+
+func (a *httpClientAdapter) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return a.client.Do(req.WithContext(ctx))
+}
+
+func newClient(t *testing.T, handler http.HandlerFunc) *example14.Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	apiClient, err := runtime.NewAPIClient(server.URL, runtime.WithHTTPClient(&httpClientAdapter{client: server.Client()}))
+	require.NoError(t, err)
+	return example14.NewClient(apiClient)
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func TestListPetsPages(t *testing.T) {
+	pages := map[string]map[string]any{
+		"":   {"pets": []map[string]string{{"name": "Rex"}}, "nextPageToken": "t2"},
+		"t2": {"pets": []map[string]string{{"name": "Tom"}}, "nextPageToken": "t3"},
+		"t3": {"pets": []map[string]string{{"name": "Kit"}}},
+	}
+	var limits []string
+	client := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+		limits = append(limits, r.URL.Query().Get("limit"))
+		writeJSON(w, pages[r.URL.Query().Get("pageToken")])
+	})
+
+	var names []string
+	for page, err := range client.ListPetsPages(context.Background(), &example14.ListPetsRequestOptions{
+		Query: &example14.ListPetsQuery{Limit: runtime.Ptr(1)},
+	}) {
+		require.NoError(t, err)
+		for _, pet := range page.Pets {
+			names = append(names, pet.Name)
+		}
+	}
+
+	assert.Equal(t, []string{"Rex", "Tom", "Kit"}, names)
+	assert.Equal(t, []string{"1", "1", "1"}, limits)
+}
+
+func TestListPetsAll(t *testing.T) {
+	pages := map[string]map[string]any{
+		"":   {"pets": []map[string]string{{"name": "Rex"}, {"name": "Tom"}}, "nextPageToken": "t2"},
+		"t2": {"pets": []map[string]string{{"name": "Kit"}}},
+	}
+	client := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, pages[r.URL.Query().Get("pageToken")])
+	})
+
+	var names []string
+	for pet, err := range client.ListPetsAll(context.Background(), nil) {
+		require.NoError(t, err)
+		names = append(names, pet.Name)
+	}
+
+	assert.Equal(t, []string{"Rex", "Tom", "Kit"}, names)
+}
+
+func TestListOrdersPages(t *testing.T) {
+	orders := []string{"a", "b", "c", "d", "e"}
+	var starts []string
+	client := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+		starts = append(starts, r.URL.Query().Get("start"))
+		start, _ := strconv.Atoi(r.URL.Query().Get("start"))
+		end := min(start+2, len(orders))
+		writeJSON(w, map[string]any{"orders": orders[min(start, end):end]})
+	})
+
+	var got []string
+	for page, err := range client.ListOrdersPages(context.Background(), nil) {
+		require.NoError(t, err)
+		got = append(got, page.Orders...)
+	}
+
+	assert.Equal(t, orders, got)
+	assert.Equal(t, []string{"", "2", "4", "5"}, starts)
+}
+
+func TestListToysPages(t *testing.T) {
+	t.Run("stops with the caller", func(t *testing.T) {
+		var pages []string
+		client := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+			pages = append(pages, r.URL.Query().Get("p"))
+			writeJSON(w, map[string]any{"toys": []string{"ball"}})
+		})
+
+		count := 0
+		for _, err := range client.ListToysPages(context.Background(), nil) {
+			require.NoError(t, err)
+			if count++; count == 3 {
+				break
+			}
+		}
+
+		assert.Equal(t, []string{"", "2", "3"}, pages)
+	})
+
+	t.Run("stops at the first error", func(t *testing.T) {
+		client := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		})
+
+		var errs []error
+		for page, err := range client.ListToysPages(context.Background(), nil) {
+			assert.Nil(t, page)
+			errs = append(errs, err)
+		}
+
+		require.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], runtime.ErrUnexpectedStatus)
+	})
+}
+
+func TestListReposPages(t *testing.T) {
+	var requests []string
+	client := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.RequestURI()+" "+r.Header.Get("X-Request-ID"))
+		switch r.URL.Query().Get("page") {
+		case "":
+			w.Header().Set("Link", `</repos?page=2&perPage=2>; rel="next", </repos?page=3&perPage=2>; rel="last"`)
+			writeJSON(w, []map[string]string{{"name": "api"}, {"name": "web"}})
+		case "2":
+			w.Header().Set("Link", `</repos?page=3&perPage=2>; rel="next"`)
+			writeJSON(w, []map[string]string{{"name": "cli"}, {"name": "docs"}})
+		default:
+			writeJSON(w, []map[string]string{{"name": "infra"}})
+		}
+	})
+
+	var names []string
+	for repo, err := range client.ListReposAll(context.Background(), &example14.ListReposRequestOptions{
+		Query: &example14.ListReposQuery{PerPage: runtime.Ptr(2)},
+	}, runtime.WithHeader("X-Request-ID", "1")) {
+		require.NoError(t, err)
+		names = append(names, repo.Name)
+	}
+
+	assert.Equal(t, []string{"api", "web", "cli", "docs", "infra"}, names)
+	assert.Equal(t, []string{"/repos?perPage=2 1", "/repos?page=2&perPage=2 1", "/repos?page=3&perPage=2 1"}, requests)
+}
@@ -0,0 +1,211 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package example15
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/go-playground/validator/v10"
+)
+
+// Client is the client for the API implementing the Client interface.
+type Client struct {
+	apiClient runtime.APIClient
+}
+
+// NewClient creates a new instance of the Client client.
+func NewClient(apiClient runtime.APIClient) *Client {
+	return &Client{apiClient: apiClient}
+}
+
+// NewDefaultClient creates a new instance of the Client client with default api client.
+func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithUserAgent("Pet-store-over-JSON-RPC/1.0.0 oapi-codegen-dd/v3.63.4")}, opts...)
+	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API client: %w", err)
+	}
+	return &Client{apiClient: apiClient}, nil
+}
+
+// ClientInterface is the interface for the API client.
+type ClientInterface interface {
+	GetPet(ctx context.Context, params *GetPetBody, reqEditors ...runtime.RequestEditorFn) (*GetPetResponse, error)
+
+	CountPets(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (*CountPetsResponse, error)
+}
+
+// GetPet calls the pets.get JSON-RPC method.
+// Error objects returned by the server are a *runtime.JSONRPCError wrapped in a *runtime.ClientAPIError,
+// with their data decoded in Details as a *GetPetErrorResponse.
+func (c *Client) GetPet(ctx context.Context, params *GetPetBody, reqEditors ...runtime.RequestEditorFn) (*GetPetResponse, error) {
+	call := runtime.NewJSONRPCRequest("pets.get", nil)
+	if params != nil {
+		if v, ok := any(params).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				return nil, fmt.Errorf("error validating request body: %w", err)
+			}
+		}
+		call.Params = params
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, runtime.RequestOptionsParameters{
+		RequestURL:  c.apiClient.GetBaseURL() + "/rpc",
+		Method:      "POST",
+		Options:     call,
+		ContentType: "application/json",
+	}, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/rpc")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	res, err := runtime.ParseJSONRPCResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	if res.Error != nil {
+		if len(res.Error.Data) > 0 {
+			details := new(GetPetErrorResponse)
+			if json.Unmarshal(res.Error.Data, details) == nil {
+				res.Error.Details = details
+			}
+		}
+		return nil, runtime.NewClientAPIError(res.Error, runtime.WithStatusCode(resp.StatusCode))
+	}
+
+	result := new(GetPetResponse)
+	if err = json.Unmarshal(res.Result, result); err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+	}
+	return result, nil
+}
+
+// CountPets calls the pets.count JSON-RPC method.
+// Error objects returned by the server are a *runtime.JSONRPCError wrapped in a *runtime.ClientAPIError.
+func (c *Client) CountPets(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (*CountPetsResponse, error) {
+	call := runtime.NewJSONRPCRequest("pets.count", nil)
+
+	req, err := c.apiClient.CreateRequest(ctx, runtime.RequestOptionsParameters{
+		RequestURL:  c.apiClient.GetBaseURL() + "/rpc",
+		Method:      "POST",
+		Options:     call,
+		ContentType: "application/json",
+	}, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/rpc")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	res, err := runtime.ParseJSONRPCResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	if res.Error != nil {
+		return nil, runtime.NewClientAPIError(res.Error, runtime.WithStatusCode(resp.StatusCode))
+	}
+
+	result := new(CountPetsResponse)
+	if err = json.Unmarshal(res.Result, result); err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+	}
+	return result, nil
+}
+
+var _ ClientInterface = (*Client)(nil)
+
+// GetPetRequestOptions is the options needed to make a request to GetPet.
+type GetPetRequestOptions struct {
+	Body *GetPetBody
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *GetPetRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *GetPetRequestOptions) GetPathParams() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetQuery returns the query params as a map.
+func (o *GetPetRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
+func (o *GetPetRequestOptions) GetBody() any {
+	if o.Body == nil {
+		return nil
+	}
+	return o.Body
+}
+
+// GetHeader returns the headers as a map.
+func (o *GetPetRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+type GetPetBody struct {
+	ID int64 `json:"id" validate:"required,gte=1"`
+}
+
+func (g GetPetBody) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(g))
+}
+
+type GetPetResponse = Pet
+
+type GetPetErrorResponse = ErrorData
+
+type CountPetsResponse = int
+
+type Pet struct {
+	ID   int64  `json:"id" validate:"required"`
+	Name string `json:"name" validate:"required"`
+}
+
+func (p Pet) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(p))
+}
+
+type ErrorData struct {
+	Reason *string `json:"reason,omitempty"`
+}
+
+func (s ErrorData) Error() string {
+	return "unmapped client error"
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
+}
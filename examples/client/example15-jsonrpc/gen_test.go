@@ -0,0 +1,109 @@
+package example15_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	example15 "github.com/doordash-oss/oapi-codegen-dd/v3/examples/client/example15-jsonrpc"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// httpClientAdapter wraps http.Client to implement runtime.HttpRequestDoer
+type httpClientAdapter struct {
+	client *http.Client
+}
+
+func (a *httpClientAdapter) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return a.client.Do(req.WithContext(ctx))
+}
+
+// rpcRequest is the request object received by the server.
+type rpcRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+	ID      json.RawMessage `json:"id"`
+}
+
+// newClient returns a client of a JSON-RPC server on /rpc, answering the requests with the result
+// or the error object returned by handle.
+func newClient(t *testing.T, handle func(req rpcRequest) (result any, rpcErr map[string]any)) *example15.Client {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /rpc", func(w http.ResponseWriter, r *http.Request) {
+		var req rpcRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "2.0", req.JSONRPC)
+
+		res := map[string]any{"jsonrpc": "2.0", "id": req.ID}
+		result, rpcErr := handle(req)
+		if rpcErr != nil {
+			res["error"] = rpcErr
+		} else {
+			res["result"] = result
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(res)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	apiClient, err := runtime.NewAPIClient(server.URL, runtime.WithHTTPClient(&httpClientAdapter{client: server.Client()}))
+	require.NoError(t, err)
+	return example15.NewClient(apiClient)
+}
+
+func TestGetPet(t *testing.T) {
+	client := newClient(t, func(req rpcRequest) (any, map[string]any) {
+		assert.Equal(t, "pets.get", req.Method)
+		assert.JSONEq(t, `{"id":7}`, string(req.Params))
+		return map[string]any{"id": 7, "name": "Rex"}, nil
+	})
+
+	pet, err := client.GetPet(context.Background(), &example15.GetPetBody{ID: 7})
+	require.NoError(t, err)
+	assert.Equal(t, &example15.GetPetResponse{ID: 7, Name: "Rex"}, pet)
+}
+
+func TestGetPetError(t *testing.T) {
+	client := newClient(t, func(req rpcRequest) (any, map[string]any) {
+		return nil, map[string]any{"code": -32004, "message": "Pet not found", "data": map[string]any{"reason": "adopted"}}
+	})
+
+	_, err := client.GetPet(context.Background(), &example15.GetPetBody{ID: 7})
+
+	var rpcErr *runtime.JSONRPCError
+	require.True(t, errors.As(err, &rpcErr), err)
+	assert.Equal(t, -32004, rpcErr.Code)
+	assert.Equal(t, "Pet not found", rpcErr.Message)
+	details, ok := rpcErr.Details.(*example15.GetPetErrorResponse)
+	require.True(t, ok)
+	assert.Equal(t, "adopted", *details.Reason)
+}
+
+func TestGetPetInvalidParams(t *testing.T) {
+	client := newClient(t, func(req rpcRequest) (any, map[string]any) {
+		t.Fatal("invalid params must not be sent")
+		return nil, nil
+	})
+
+	_, err := client.GetPet(context.Background(), &example15.GetPetBody{ID: 0})
+	assert.ErrorContains(t, err, "error validating request body")
+}
+
+func TestCountPets(t *testing.T) {
+	client := newClient(t, func(req rpcRequest) (any, map[string]any) {
+		assert.Equal(t, "pets.count", req.Method)
+		assert.Empty(t, req.Params)
+		return 3, nil
+	})
+
+	count, err := client.CountPets(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 3, *count)
+}
@@ -0,0 +1,594 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package example16
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/go-playground/validator/v10"
+)
+
+// Client is the client for the API implementing the Client interface.
+type Client struct {
+	apiClient runtime.APIClient
+}
+
+// NewClient creates a new instance of the Client client.
+func NewClient(apiClient runtime.APIClient) *Client {
+	return &Client{apiClient: apiClient}
+}
+
+// NewDefaultClient creates a new instance of the Client client with default api client.
+func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithUserAgent("Pet-store/1.0.0 oapi-codegen-dd/v3.63.4")}, opts...)
+	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API client: %w", err)
+	}
+	return &Client{apiClient: apiClient}, nil
+}
+
+// ClientInterface is the interface for the API client.
+type ClientInterface interface {
+	// CreatePet Creates a pet, the body is required.
+	CreatePet(ctx context.Context, options *CreatePetRequestOptions, reqEditors ...runtime.RequestEditorFn) (*struct{}, error)
+
+	// WalkPet Walks a pet, with optional preferences.
+	WalkPet(ctx context.Context, options *WalkPetRequestOptions, reqEditors ...runtime.RequestEditorFn) (*struct{}, error)
+
+	// FeedPets Feeds the pets, with an optional menu.
+	FeedPets(ctx context.Context, options *FeedPetsRequestOptions, reqEditors ...runtime.RequestEditorFn) (*struct{}, error)
+}
+
+// CreatePet Creates a pet, the body is required.
+func (c *Client) CreatePet(ctx context.Context, options *CreatePetRequestOptions, reqEditors ...runtime.RequestEditorFn) (*struct{}, error) {
+	var err error
+	if options == nil || options.Body == nil {
+		return nil, fmt.Errorf("error creating request body: %w", runtime.ErrMissingValue)
+	}
+	if options != nil && options.Body != nil {
+		if v, ok := any(options.Body).(runtime.Validator); ok {
+			if err = v.Validate(); err != nil {
+				return nil, fmt.Errorf("error validating request body: %w", err)
+			}
+		}
+	}
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL:  c.apiClient.GetBaseURL() + "/pets",
+		Method:      "POST",
+		ContentType: "application/json",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*struct{}, error) {
+		if resp.StatusCode != 204 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		return nil, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/pets")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+// WalkPet Walks a pet, with optional preferences.
+func (c *Client) WalkPet(ctx context.Context, options *WalkPetRequestOptions, reqEditors ...runtime.RequestEditorFn) (*struct{}, error) {
+	var err error
+	if options != nil && options.Body != nil {
+		if v, ok := any(options.Body).(runtime.Validator); ok {
+			if err = v.Validate(); err != nil {
+				return nil, fmt.Errorf("error validating request body: %w", err)
+			}
+		}
+	}
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL:  c.apiClient.GetBaseURL() + "/pets/{id}/walk",
+		Method:      "POST",
+		ContentType: "application/json",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*struct{}, error) {
+		if resp.StatusCode != 204 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		return nil, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/pets/{id}/walk")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+// FeedPets Feeds the pets, with an optional menu.
+func (c *Client) FeedPets(ctx context.Context, options *FeedPetsRequestOptions, reqEditors ...runtime.RequestEditorFn) (*struct{}, error) {
+	var err error
+	if options != nil && options.Body != nil {
+		if v, ok := any(options.Body).(runtime.Validator); ok {
+			if err = v.Validate(); err != nil {
+				return nil, fmt.Errorf("error validating request body: %w", err)
+			}
+		}
+	}
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL:  c.apiClient.GetBaseURL() + "/pets/feed",
+		Method:      "POST",
+		ContentType: "application/json",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*struct{}, error) {
+		if resp.StatusCode != 204 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		return nil, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/pets/feed")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+var _ ClientInterface = (*Client)(nil)
+
+// CreatePetRequestOptions is the options needed to make a request to CreatePet.
+type CreatePetRequestOptions struct {
+	Body *CreatePetBody
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *CreatePetRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *CreatePetRequestOptions) GetPathParams() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetQuery returns the query params as a map.
+func (o *CreatePetRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
+func (o *CreatePetRequestOptions) GetBody() any {
+	if o.Body == nil {
+		return nil
+	}
+	return o.Body
+}
+
+// GetHeader returns the headers as a map.
+func (o *CreatePetRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+// WalkPetRequestOptions is the options needed to make a request to WalkPet.
+type WalkPetRequestOptions struct {
+	PathParams *WalkPetPath
+	Body       *WalkPetBody
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *WalkPetRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *WalkPetRequestOptions) GetPathParams() (map[string]any, error) {
+	return runtime.AsMap[any](o.PathParams)
+}
+
+// GetQuery returns the query params as a map.
+func (o *WalkPetRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
+func (o *WalkPetRequestOptions) GetBody() any {
+	if o.Body == nil {
+		return nil
+	}
+	return o.Body
+}
+
+// GetHeader returns the headers as a map.
+func (o *WalkPetRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+// FeedPetsRequestOptions is the options needed to make a request to FeedPets.
+type FeedPetsRequestOptions struct {
+	Body *FeedPetsBody
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *FeedPetsRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *FeedPetsRequestOptions) GetPathParams() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetQuery returns the query params as a map.
+func (o *FeedPetsRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
+func (o *FeedPetsRequestOptions) GetBody() any {
+	if o.Body == nil {
+		return nil
+	}
+	return o.Body
+}
+
+// GetHeader returns the headers as a map.
+func (o *FeedPetsRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+type WalkPetPath struct {
+	ID int `json:"id" validate:"required"`
+}
+
+func (w WalkPetPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(w))
+}
+
+type CreatePetBody = Pet
+
+type WalkPetBody struct {
+	Minutes *int `json:"minutes,omitempty"`
+}
+
+type FeedPetsBody struct {
+	Food *string `json:"food,omitempty"`
+}
+
+// CreatePetRequest is a request to CreatePet, read from an *http.Request with BindCreatePetRequest.
+type CreatePetRequest struct {
+	Body *CreatePetBody
+}
+
+// Validate validates all the fields of the request.
+func (o *CreatePetRequest) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// BindCreatePetRequest reads the request to POST /pets and validates it.
+// Path parameters are read with r.PathValue, set by the router matching the request.
+// Parameters and bodies that can't be read are returned as a *runtime.BindError,
+// and invalid requests as runtime.ValidationErrors.
+func BindCreatePetRequest(r *http.Request) (*CreatePetRequest, error) {
+	req := &CreatePetRequest{}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, &runtime.BindError{In: "body", Err: err}
+	}
+	if !runtime.IsEmptyBody(body) {
+		req.Body = &CreatePetBody{}
+		if err = json.Unmarshal(body, req.Body); err != nil {
+			return nil, &runtime.BindError{In: "body", Err: err}
+		}
+	} else {
+		return nil, &runtime.BindError{In: "body", Err: runtime.ErrMissingValue}
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// CreatePetHandler returns the http.HandlerFunc of POST /pets, calling handle with the request
+// read by BindCreatePetRequest. Requests failing to bind are passed to onError instead,
+// runtime.DefaultBindErrorHandler if nil.
+func CreatePetHandler(handle func(w http.ResponseWriter, r *http.Request, req *CreatePetRequest), onError runtime.BindErrorHandler) http.HandlerFunc {
+	if onError == nil {
+		onError = runtime.DefaultBindErrorHandler
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		req, err := BindCreatePetRequest(r)
+		if err != nil {
+			onError(w, r, err)
+			return
+		}
+		handle(w, r, req)
+	}
+}
+
+// WalkPetRequest is a request to WalkPet, read from an *http.Request with BindWalkPetRequest.
+type WalkPetRequest struct {
+	PathParams *WalkPetPath
+	Body       *WalkPetBody
+}
+
+// Validate validates all the fields of the request.
+func (o *WalkPetRequest) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// BindWalkPetRequest reads the request to POST /pets/{id}/walk and validates it.
+// Path parameters are read with r.PathValue, set by the router matching the request.
+// Parameters and bodies that can't be read are returned as a *runtime.BindError,
+// and invalid requests as runtime.ValidationErrors.
+func BindWalkPetRequest(r *http.Request) (*WalkPetRequest, error) {
+	req := &WalkPetRequest{}
+
+	req.PathParams = &WalkPetPath{}
+	if err := runtime.BindPathParams(r, req.PathParams, map[string]runtime.ParameterBinding{
+		"id": {Required: true},
+	}); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, &runtime.BindError{In: "body", Err: err}
+	}
+	if !runtime.IsEmptyBody(body) {
+		req.Body = &WalkPetBody{}
+		if err = json.Unmarshal(body, req.Body); err != nil {
+			return nil, &runtime.BindError{In: "body", Err: err}
+		}
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// WalkPetHandler returns the http.HandlerFunc of POST /pets/{id}/walk, calling handle with the request
+// read by BindWalkPetRequest. Requests failing to bind are passed to onError instead,
+// runtime.DefaultBindErrorHandler if nil.
+func WalkPetHandler(handle func(w http.ResponseWriter, r *http.Request, req *WalkPetRequest), onError runtime.BindErrorHandler) http.HandlerFunc {
+	if onError == nil {
+		onError = runtime.DefaultBindErrorHandler
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		req, err := BindWalkPetRequest(r)
+		if err != nil {
+			onError(w, r, err)
+			return
+		}
+		handle(w, r, req)
+	}
+}
+
+// FeedPetsRequest is a request to FeedPets, read from an *http.Request with BindFeedPetsRequest.
+type FeedPetsRequest struct {
+	Body *FeedPetsBody
+}
+
+// Validate validates all the fields of the request.
+func (o *FeedPetsRequest) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// BindFeedPetsRequest reads the request to POST /pets/feed and validates it.
+// Path parameters are read with r.PathValue, set by the router matching the request.
+// Parameters and bodies that can't be read are returned as a *runtime.BindError,
+// and invalid requests as runtime.ValidationErrors.
+func BindFeedPetsRequest(r *http.Request) (*FeedPetsRequest, error) {
+	req := &FeedPetsRequest{}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, &runtime.BindError{In: "body", Err: err}
+	}
+	if !runtime.IsEmptyBody(body) {
+		req.Body = &FeedPetsBody{}
+		if err = json.Unmarshal(body, req.Body); err != nil {
+			return nil, &runtime.BindError{In: "body", Err: err}
+		}
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// FeedPetsHandler returns the http.HandlerFunc of POST /pets/feed, calling handle with the request
+// read by BindFeedPetsRequest. Requests failing to bind are passed to onError instead,
+// runtime.DefaultBindErrorHandler if nil.
+func FeedPetsHandler(handle func(w http.ResponseWriter, r *http.Request, req *FeedPetsRequest), onError runtime.BindErrorHandler) http.HandlerFunc {
+	if onError == nil {
+		onError = runtime.DefaultBindErrorHandler
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		req, err := BindFeedPetsRequest(r)
+		if err != nil {
+			onError(w, r, err)
+			return
+		}
+		handle(w, r, req)
+	}
+}
+
+// OperationValidators validates the requests of the operations with their Bind functions, and the JSON bodies
+// of their responses, by http.ServeMux pattern. Use it with runtime.ValidationMiddleware.
+var OperationValidators = map[string]runtime.OperationValidator{
+	"POST /pets": {
+		Request: func(r *http.Request) error {
+			_, err := BindCreatePetRequest(r)
+			return err
+		},
+	},
+	"POST /pets/{id}/walk": {
+		Request: func(r *http.Request) error {
+			_, err := BindWalkPetRequest(r)
+			return err
+		},
+	},
+	"POST /pets/feed": {
+		Request: func(r *http.Request) error {
+			_, err := BindFeedPetsRequest(r)
+			return err
+		},
+	},
+}
+
+type Pet struct {
+	Name string `json:"name" validate:"required"`
+}
+
+func (p Pet) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(p))
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
+}
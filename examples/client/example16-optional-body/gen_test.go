@@ -0,0 +1,90 @@
+package example16_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	example16 "github.com/doordash-oss/oapi-codegen-dd/v3/examples/client/example16-optional-body"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// httpClientAdapter wraps http.Client to implement runtime.HttpRequestDoer
+type httpClientAdapter struct {
+	client *http.Client
+}
+
+func (a *httpClientAdapter) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return a.client.Do(req.WithContext(ctx))
+}
+
+// newClient returns a client of a server recording the bodies it receives.
+func newClient(t *testing.T) (*example16.Client, *[]string) {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		bodies = append(bodies, string(body))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+
+	apiClient, err := runtime.NewAPIClient(server.URL, runtime.WithHTTPClient(&httpClientAdapter{client: server.Client()}))
+	require.NoError(t, err)
+	return example16.NewClient(apiClient), &bodies
+}
+
+func TestOptionalBody(t *testing.T) {
+	client, bodies := newClient(t)
+
+	_, err := client.FeedPets(context.Background(), nil)
+	require.NoError(t, err)
+	_, err = client.FeedPets(context.Background(), &example16.FeedPetsRequestOptions{})
+	require.NoError(t, err)
+	_, err = client.FeedPets(context.Background(), &example16.FeedPetsRequestOptions{Body: &example16.FeedPetsBody{Food: runtime.Ptr("fish")}})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"", "", `{"food":"fish"}`}, *bodies)
+}
+
+func TestRequiredBody(t *testing.T) {
+	client, bodies := newClient(t)
+
+	_, err := client.CreatePet(context.Background(), nil)
+	require.ErrorIs(t, err, runtime.ErrMissingValue)
+	_, err = client.CreatePet(context.Background(), &example16.CreatePetRequestOptions{})
+	require.ErrorIs(t, err, runtime.ErrMissingValue)
+	assert.Empty(t, *bodies, "requests without the required body are not sent")
+
+	_, err = client.CreatePet(context.Background(), &example16.CreatePetRequestOptions{Body: &example16.CreatePetBody{Name: "Rex"}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{`{"name":"Rex"}`}, *bodies)
+}
+
+func TestBindOptionalBody(t *testing.T) {
+	for _, body := range []string{"", " \n", "null"} {
+		req, err := example16.BindFeedPetsRequest(httptest.NewRequest(http.MethodPost, "/pets/feed", strings.NewReader(body)))
+		require.NoError(t, err)
+		assert.Nil(t, req.Body, "body %q", body)
+	}
+
+	req, err := example16.BindFeedPetsRequest(httptest.NewRequest(http.MethodPost, "/pets/feed", strings.NewReader(`{"food":"fish"}`)))
+	require.NoError(t, err)
+	assert.Equal(t, "fish", *req.Body.Food)
+}
+
+func TestBindRequiredBody(t *testing.T) {
+	for _, body := range []string{"", "null"} {
+		_, err := example16.BindCreatePetRequest(httptest.NewRequest(http.MethodPost, "/pets", strings.NewReader(body)))
+
+		var bindErr *runtime.BindError
+		require.ErrorAs(t, err, &bindErr)
+		assert.Equal(t, "body", bindErr.In)
+		assert.ErrorIs(t, err, runtime.ErrMissingValue)
+	}
+}
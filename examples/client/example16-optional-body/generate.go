@@ -0,0 +1,3 @@
+package example16
+
+//go:generate go run ../../../cmd/oapi-codegen -config cfg.yaml api.yaml
@@ -0,0 +1,385 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package example17
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/go-playground/validator/v10"
+)
+
+// Client is the client for the API implementing the Client interface.
+type Client struct {
+	apiClient runtime.APIClient
+}
+
+// NewClient creates a new instance of the Client client.
+func NewClient(apiClient runtime.APIClient) *Client {
+	return &Client{apiClient: apiClient}
+}
+
+// NewDefaultClient creates a new instance of the Client client with default api client.
+func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithUserAgent("Links/1.0.0 oapi-codegen-dd/v3.63.4")}, opts...)
+	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API client: %w", err)
+	}
+	return &Client{apiClient: apiClient}, nil
+}
+
+// ClientInterface is the interface for the API client.
+type ClientInterface interface {
+	CreateUser(ctx context.Context, options *CreateUserRequestOptions, reqEditors ...runtime.RequestEditorFn) (*CreateUserResponse, error)
+
+	GetUser(ctx context.Context, options *GetUserRequestOptions, reqEditors ...runtime.RequestEditorFn) (*GetUserResponse, error)
+
+	ListPets(ctx context.Context, options *ListPetsRequestOptions, reqEditors ...runtime.RequestEditorFn) (*ListPetsResponse, error)
+}
+
+func (c *Client) CreateUser(ctx context.Context, options *CreateUserRequestOptions, reqEditors ...runtime.RequestEditorFn) (*CreateUserResponse, error) {
+	var err error
+	if options == nil || options.Body == nil {
+		return nil, fmt.Errorf("error creating request body: %w", runtime.ErrMissingValue)
+	}
+	if options != nil && options.Body != nil {
+		if v, ok := any(options.Body).(runtime.Validator); ok {
+			if err = v.Validate(); err != nil {
+				return nil, fmt.Errorf("error validating request body: %w", err)
+			}
+		}
+	}
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL:  c.apiClient.GetBaseURL() + "/users",
+		Method:      "POST",
+		ContentType: "application/json",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*CreateUserResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 201 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(CreateUserResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/users")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+// LinkGetUserByUserID returns the options of the GetUser request following the GetUserByUserID link of the response,
+// with the values set by the link.
+//
+// The created user.
+func (r *CreateUserResponse) LinkGetUserByUserID() (*GetUserRequestOptions, error) {
+	options := &GetUserRequestOptions{}
+	if err := runtime.ResolveLinkParameters(r, "{\"userId\":\"$response.body#/id\"}", &options.PathParams); err != nil {
+		return nil, fmt.Errorf("error resolving link GetUserByUserID: %w", err)
+	}
+	return options, nil
+}
+
+func (c *Client) GetUser(ctx context.Context, options *GetUserRequestOptions, reqEditors ...runtime.RequestEditorFn) (*GetUserResponse, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/users/{userId}",
+		Method:     "GET",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*GetUserResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(GetUserResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/users/{userId}")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+// LinkManager returns the options of the GetUser request following the Manager link of the response,
+// with the values set by the link.
+func (r *GetUserResponse) LinkManager() (*GetUserRequestOptions, error) {
+	options := &GetUserRequestOptions{}
+	if err := runtime.ResolveLinkParameters(r, "{\"userId\":\"$response.body#/managerId\"}", &options.PathParams); err != nil {
+		return nil, fmt.Errorf("error resolving link Manager: %w", err)
+	}
+	return options, nil
+}
+
+// LinkPets returns the options of the ListPets request following the Pets link of the response,
+// with the values set by the link.
+func (r *GetUserResponse) LinkPets() (*ListPetsRequestOptions, error) {
+	options := &ListPetsRequestOptions{}
+	if err := runtime.ResolveLinkParameters(r, "{\"limit\":10,\"owner\":\"$response.body#/id\"}", &options.Query); err != nil {
+		return nil, fmt.Errorf("error resolving link Pets: %w", err)
+	}
+	return options, nil
+}
+
+func (c *Client) ListPets(ctx context.Context, options *ListPetsRequestOptions, reqEditors ...runtime.RequestEditorFn) (*ListPetsResponse, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/pets",
+		Method:     "GET",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*ListPetsResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(ListPetsResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/pets")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+var _ ClientInterface = (*Client)(nil)
+
+// CreateUserRequestOptions is the options needed to make a request to CreateUser.
+type CreateUserRequestOptions struct {
+	Body *CreateUserBody
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *CreateUserRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *CreateUserRequestOptions) GetPathParams() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetQuery returns the query params as a map.
+func (o *CreateUserRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
+func (o *CreateUserRequestOptions) GetBody() any {
+	if o.Body == nil {
+		return nil
+	}
+	return o.Body
+}
+
+// GetHeader returns the headers as a map.
+func (o *CreateUserRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+// GetUserRequestOptions is the options needed to make a request to GetUser.
+type GetUserRequestOptions struct {
+	PathParams *GetUserPath
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *GetUserRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *GetUserRequestOptions) GetPathParams() (map[string]any, error) {
+	return runtime.AsMap[any](o.PathParams)
+}
+
+// GetQuery returns the query params as a map.
+func (o *GetUserRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
+func (o *GetUserRequestOptions) GetBody() any {
+	return nil
+}
+
+// GetHeader returns the headers as a map.
+func (o *GetUserRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+// ListPetsRequestOptions is the options needed to make a request to ListPets.
+type ListPetsRequestOptions struct {
+	Query *ListPetsQuery
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *ListPetsRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Query != nil {
+		if v, ok := any(o.Query).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Query", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *ListPetsRequestOptions) GetPathParams() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetQuery returns the query params as a map.
+func (o *ListPetsRequestOptions) GetQuery() (map[string]any, error) {
+	return runtime.AsMap[any](o.Query)
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
+func (o *ListPetsRequestOptions) GetBody() any {
+	return nil
+}
+
+// GetHeader returns the headers as a map.
+func (o *ListPetsRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+type GetUserPath struct {
+	UserID string `json:"userId" validate:"required"`
+}
+
+func (g GetUserPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(g))
+}
+
+type CreateUserBody = NewUser
+
+type ListPetsQuery struct {
+	Owner *string `json:"owner,omitempty"`
+	Limit *int    `json:"limit,omitempty"`
+}
+
+type CreateUserResponse struct {
+	ID        string  `json:"id" validate:"required"`
+	Name      string  `json:"name" validate:"required"`
+	ManagerID *string `json:"managerId,omitempty"`
+}
+
+type GetUserResponse struct {
+	ID        string  `json:"id" validate:"required"`
+	Name      string  `json:"name" validate:"required"`
+	ManagerID *string `json:"managerId,omitempty"`
+}
+
+type ListPetsResponse []string
+
+type NewUser struct {
+	Name      string  `json:"name" validate:"required"`
+	ManagerID *string `json:"managerId,omitempty"`
+}
+
+func (n NewUser) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(n))
+}
+
+type User struct {
+	ID        string  `json:"id" validate:"required"`
+	Name      string  `json:"name" validate:"required"`
+	ManagerID *string `json:"managerId,omitempty"`
+}
+
+func (u User) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(u))
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
+}
@@ -0,0 +1,103 @@
+package example17_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	example17 "github.com/doordash-oss/oapi-codegen-dd/v3/examples/client/example17-links"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// httpClientAdapter wraps http.Client to implement runtime.HttpRequestDoer
+type httpClientAdapter struct {
+	client *http.Client
+}
+
+func (a *httpClientAdapter) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return a.client.Do(req.WithContext(ctx))
+}
+
+func newClient(t *testing.T) *example17.Client {
+	users := map[string]example17.User{
+		"ann": {ID: "ann", Name: "Ann"},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /users", func(w http.ResponseWriter, r *http.Request) {
+		var user example17.NewUser
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&user))
+		created := example17.User{ID: "bob", Name: user.Name, ManagerID: user.ManagerID}
+		users[created.ID] = created
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(created)
+	})
+	mux.HandleFunc("GET /users/{userId}", func(w http.ResponseWriter, r *http.Request) {
+		user, ok := users[r.PathValue("userId")]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(user)
+	})
+	mux.HandleFunc("GET /pets", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode([]string{r.URL.Query().Get("owner") + "'s cat", "limit " + r.URL.Query().Get("limit")})
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	apiClient, err := runtime.NewAPIClient(server.URL, runtime.WithHTTPClient(&httpClientAdapter{client: server.Client()}))
+	require.NoError(t, err)
+	return example17.NewClient(apiClient)
+}
+
+func TestFollowLinks(t *testing.T) {
+	ctx := context.Background()
+	client := newClient(t)
+
+	created, err := client.CreateUser(ctx, &example17.CreateUserRequestOptions{
+		Body: &example17.CreateUserBody{Name: "Bob", ManagerID: runtime.Ptr("ann")},
+	})
+	require.NoError(t, err)
+
+	options, err := created.LinkGetUserByUserID()
+	require.NoError(t, err)
+	assert.Equal(t, "bob", options.PathParams.UserID)
+
+	user, err := client.GetUser(ctx, options)
+	require.NoError(t, err)
+	assert.Equal(t, "Bob", user.Name)
+
+	options, err = user.LinkManager()
+	require.NoError(t, err)
+	manager, err := client.GetUser(ctx, options)
+	require.NoError(t, err)
+	assert.Equal(t, "Ann", manager.Name)
+
+	petsOptions, err := user.LinkPets()
+	require.NoError(t, err)
+	assert.Equal(t, &example17.ListPetsQuery{Owner: runtime.Ptr("bob"), Limit: runtime.Ptr(10)}, petsOptions.Query)
+
+	pets, err := client.ListPets(ctx, petsOptions)
+	require.NoError(t, err)
+	assert.Equal(t, example17.ListPetsResponse{"bob's cat", "limit 10"}, *pets)
+}
+
+func TestFollowLinkMissingValue(t *testing.T) {
+	client := newClient(t)
+
+	manager, err := client.GetUser(context.Background(), &example17.GetUserRequestOptions{
+		PathParams: &example17.GetUserPath{UserID: "ann"},
+	})
+	require.NoError(t, err)
+
+	_, err = manager.LinkManager()
+	assert.ErrorIs(t, err, runtime.ErrMissingValue)
+}
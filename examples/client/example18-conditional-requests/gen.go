@@ -0,0 +1,511 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package example18
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/go-playground/validator/v10"
+)
+
+// Client is the client for the API implementing the Client interface.
+type Client struct {
+	apiClient runtime.APIClient
+}
+
+// NewClient creates a new instance of the Client client.
+func NewClient(apiClient runtime.APIClient) *Client {
+	return &Client{apiClient: apiClient}
+}
+
+// NewDefaultClient creates a new instance of the Client client with default api client.
+func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithUserAgent("Conditional-requests/1.0.0 oapi-codegen-dd/v3.63.4")}, opts...)
+	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API client: %w", err)
+	}
+	return &Client{apiClient: apiClient}, nil
+}
+
+// ClientInterface is the interface for the API client.
+type ClientInterface interface {
+	GetPet(ctx context.Context, options *GetPetRequestOptions, reqEditors ...runtime.RequestEditorFn) (*GetPetResponse, error)
+	GetPetResult(ctx context.Context, options *GetPetRequestOptions, reqEditors ...runtime.RequestEditorFn) (GetPetResult, error)
+
+	UpdatePet(ctx context.Context, options *UpdatePetRequestOptions, reqEditors ...runtime.RequestEditorFn) (*UpdatePetResponse, error)
+	UpdatePetResult(ctx context.Context, options *UpdatePetRequestOptions, reqEditors ...runtime.RequestEditorFn) (UpdatePetResult, error)
+}
+
+func (c *Client) GetPet(ctx context.Context, options *GetPetRequestOptions, reqEditors ...runtime.RequestEditorFn) (*GetPetResponse, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/pets/{id}",
+		Method:     "GET",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*GetPetResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			target := new(GetPetErrorResponse)
+			err = json.Unmarshal(bodyBytes, target)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+			}
+
+			if errTarget, ok := any(*target).(error); ok {
+				return nil, runtime.NewClientAPIError(errTarget, runtime.WithStatusCode(resp.StatusCode))
+			}
+			return nil, runtime.NewClientAPIError(fmt.Errorf("API error (status %d): %v", resp.StatusCode, *target),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(GetPetResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/pets/{id}")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+// GetPetResult is implemented by every response of GetPet.
+// Use Visit with a GetPetResultVisitor to handle all of them.
+type GetPetResult interface {
+	StatusCode() int
+	Visit(v GetPetResultVisitor) error
+	isGetPetResult()
+}
+
+// GetPetResultVisitor handles every response of GetPet.
+// A new status code in the spec adds a method, so the compiler flags visitors that don't handle it.
+type GetPetResultVisitor interface {
+	Visit200(res *GetPetResult200) error
+	Visit404(res *GetPetResult404) error
+}
+
+// GetPetResult200 is the 200 response of GetPet.
+type GetPetResult200 struct {
+	Body    *GetPetResponse
+	Headers http.Header
+}
+
+func (r *GetPetResult200) StatusCode() int {
+	return 200
+}
+
+// ETag returns the ETag header of the response, the version to send in If-Match or If-None-Match.
+func (r *GetPetResult200) ETag() string {
+	return r.Headers.Get("ETag")
+}
+
+func (r *GetPetResult200) Visit(v GetPetResultVisitor) error {
+	return v.Visit200(r)
+}
+
+func (r *GetPetResult200) isGetPetResult() {}
+
+// GetPetResult404 is the 404 response of GetPet.
+type GetPetResult404 struct {
+	Body    *GetPetErrorResponse
+	Headers http.Header
+}
+
+func (r *GetPetResult404) StatusCode() int {
+	return 404
+}
+
+func (r *GetPetResult404) Visit(v GetPetResultVisitor) error {
+	return v.Visit404(r)
+}
+
+func (r *GetPetResult404) isGetPetResult() {}
+
+// GetPetResult calls GetPet and returns the response matching the status code as GetPetResult.
+// Status codes that are not in the spec are returned as runtime.ClientAPIError.
+func (c *Client) GetPetResult(ctx context.Context, options *GetPetRequestOptions, reqEditors ...runtime.RequestEditorFn) (GetPetResult, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/pets/{id}",
+		Method:     "GET",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/pets/{id}")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	switch {
+	case resp.StatusCode == 200:
+		res := &GetPetResult200{Headers: resp.Headers}
+		bodyBytes := resp.Content
+		res.Body = new(GetPetResponse)
+		if err = json.Unmarshal(bodyBytes, res.Body); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return res, nil
+	case resp.StatusCode == 404:
+		res := &GetPetResult404{Headers: resp.Headers}
+		bodyBytes := resp.Content
+		res.Body = new(GetPetErrorResponse)
+		if err = json.Unmarshal(bodyBytes, res.Body); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return res, nil
+	}
+
+	return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
+		runtime.WithStatusCode(resp.StatusCode))
+}
+
+func (c *Client) UpdatePet(ctx context.Context, options *UpdatePetRequestOptions, reqEditors ...runtime.RequestEditorFn) (*UpdatePetResponse, error) {
+	var err error
+	if options == nil || options.Body == nil {
+		return nil, fmt.Errorf("error creating request body: %w", runtime.ErrMissingValue)
+	}
+	if options != nil && options.Body != nil {
+		if v, ok := any(options.Body).(runtime.Validator); ok {
+			if err = v.Validate(); err != nil {
+				return nil, fmt.Errorf("error validating request body: %w", err)
+			}
+		}
+	}
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL:  c.apiClient.GetBaseURL() + "/pets/{id}",
+		Method:      "PUT",
+		ContentType: "application/json",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*UpdatePetResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			target := new(UpdatePetErrorResponse)
+			err = json.Unmarshal(bodyBytes, target)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+			}
+
+			if errTarget, ok := any(*target).(error); ok {
+				return nil, runtime.NewClientAPIError(errTarget, runtime.WithStatusCode(resp.StatusCode))
+			}
+			return nil, runtime.NewClientAPIError(fmt.Errorf("API error (status %d): %v", resp.StatusCode, *target),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(UpdatePetResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/pets/{id}")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+// UpdatePetResult is implemented by every response of UpdatePet.
+// Use Visit with a UpdatePetResultVisitor to handle all of them.
+type UpdatePetResult interface {
+	StatusCode() int
+	Visit(v UpdatePetResultVisitor) error
+	isUpdatePetResult()
+}
+
+// UpdatePetResultVisitor handles every response of UpdatePet.
+// A new status code in the spec adds a method, so the compiler flags visitors that don't handle it.
+type UpdatePetResultVisitor interface {
+	Visit200(res *UpdatePetResult200) error
+	Visit412(res *UpdatePetResult412) error
+}
+
+// UpdatePetResult200 is the 200 response of UpdatePet.
+type UpdatePetResult200 struct {
+	Body    *UpdatePetResponse
+	Headers http.Header
+}
+
+func (r *UpdatePetResult200) StatusCode() int {
+	return 200
+}
+
+// ETag returns the ETag header of the response, the version to send in If-Match or If-None-Match.
+func (r *UpdatePetResult200) ETag() string {
+	return r.Headers.Get("ETag")
+}
+
+func (r *UpdatePetResult200) Visit(v UpdatePetResultVisitor) error {
+	return v.Visit200(r)
+}
+
+func (r *UpdatePetResult200) isUpdatePetResult() {}
+
+// UpdatePetResult412 is the 412 response of UpdatePet.
+type UpdatePetResult412 struct {
+	Body    *UpdatePetErrorResponse
+	Headers http.Header
+}
+
+func (r *UpdatePetResult412) StatusCode() int {
+	return 412
+}
+
+func (r *UpdatePetResult412) Visit(v UpdatePetResultVisitor) error {
+	return v.Visit412(r)
+}
+
+func (r *UpdatePetResult412) isUpdatePetResult() {}
+
+// UpdatePetResult calls UpdatePet and returns the response matching the status code as UpdatePetResult.
+// Status codes that are not in the spec are returned as runtime.ClientAPIError.
+func (c *Client) UpdatePetResult(ctx context.Context, options *UpdatePetRequestOptions, reqEditors ...runtime.RequestEditorFn) (UpdatePetResult, error) {
+	var err error
+	if options == nil || options.Body == nil {
+		return nil, fmt.Errorf("error creating request body: %w", runtime.ErrMissingValue)
+	}
+	if options != nil && options.Body != nil {
+		if v, ok := any(options.Body).(runtime.Validator); ok {
+			if err = v.Validate(); err != nil {
+				return nil, fmt.Errorf("error validating request body: %w", err)
+			}
+		}
+	}
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL:  c.apiClient.GetBaseURL() + "/pets/{id}",
+		Method:      "PUT",
+		ContentType: "application/json",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/pets/{id}")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	switch {
+	case resp.StatusCode == 200:
+		res := &UpdatePetResult200{Headers: resp.Headers}
+		bodyBytes := resp.Content
+		res.Body = new(UpdatePetResponse)
+		if err = json.Unmarshal(bodyBytes, res.Body); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return res, nil
+	case resp.StatusCode == 412:
+		res := &UpdatePetResult412{Headers: resp.Headers}
+		bodyBytes := resp.Content
+		res.Body = new(UpdatePetErrorResponse)
+		if err = json.Unmarshal(bodyBytes, res.Body); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return res, nil
+	}
+
+	return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
+		runtime.WithStatusCode(resp.StatusCode))
+}
+
+var _ ClientInterface = (*Client)(nil)
+
+// GetPetRequestOptions is the options needed to make a request to GetPet.
+type GetPetRequestOptions struct {
+	PathParams *GetPetPath
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *GetPetRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *GetPetRequestOptions) GetPathParams() (map[string]any, error) {
+	return runtime.AsMap[any](o.PathParams)
+}
+
+// GetQuery returns the query params as a map.
+func (o *GetPetRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
+func (o *GetPetRequestOptions) GetBody() any {
+	return nil
+}
+
+// GetHeader returns the headers as a map.
+func (o *GetPetRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+// UpdatePetRequestOptions is the options needed to make a request to UpdatePet.
+type UpdatePetRequestOptions struct {
+	PathParams *UpdatePetPath
+	Body       *UpdatePetBody
+	// IfMatch is sent as the If-Match header, e.g. the ETag of the version of the resource to change.
+	IfMatch string
+	// IfNoneMatch is sent as the If-None-Match header, e.g. * to only create the resource if it doesn't exist.
+	IfNoneMatch string
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *UpdatePetRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *UpdatePetRequestOptions) GetPathParams() (map[string]any, error) {
+	return runtime.AsMap[any](o.PathParams)
+}
+
+// GetQuery returns the query params as a map.
+func (o *UpdatePetRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
+func (o *UpdatePetRequestOptions) GetBody() any {
+	if o.Body == nil {
+		return nil
+	}
+	return o.Body
+}
+
+// GetHeader returns the headers as a map.
+func (o *UpdatePetRequestOptions) GetHeader() (map[string]string, error) {
+	header := map[string]string{}
+	if o.IfMatch != "" {
+		header["If-Match"] = o.IfMatch
+	}
+	if o.IfNoneMatch != "" {
+		header["If-None-Match"] = o.IfNoneMatch
+	}
+	return header, nil
+}
+
+type GetPetPath struct {
+	ID string `json:"id" validate:"required"`
+}
+
+func (g GetPetPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(g))
+}
+
+type UpdatePetPath struct {
+	ID string `json:"id" validate:"required"`
+}
+
+func (u UpdatePetPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(u))
+}
+
+type UpdatePetBody = Pet
+
+type GetPetResponse = Pet
+
+type GetPetErrorResponse = Error
+
+type UpdatePetResponse = Pet
+
+type UpdatePetErrorResponse = Error
+
+type Pet struct {
+	Name string `json:"name" validate:"required"`
+}
+
+func (p Pet) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(p))
+}
+
+type Error struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (s Error) Error() string {
+	return "unmapped client error"
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
+}
@@ -0,0 +1,126 @@
+package example18_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	example18 "github.com/doordash-oss/oapi-codegen-dd/v3/examples/client/example18-conditional-requests"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// httpClientAdapter wraps http.Client to implement runtime.HttpRequestDoer
+type httpClientAdapter struct {
+	client *http.Client
+}
+
+func (a *httpClientAdapter) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return a.client.Do(req.WithContext(ctx))
+}
+
+// petServer serves a pet versioned with an ETag, counting the 304 Not Modified responses.
+type petServer struct {
+	pet         example18.Pet
+	version     int
+	notModified int
+}
+
+func (s *petServer) etag() string {
+	return fmt.Sprintf(`"v%d"`, s.version)
+}
+
+func (s *petServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	switch r.Method {
+	case http.MethodGet:
+		if r.Header.Get("If-None-Match") == s.etag() {
+			s.notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+	case http.MethodPut:
+		if r.Header.Get("If-Match") != s.etag() {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			_ = json.NewEncoder(w).Encode(example18.Error{Message: runtime.Ptr("the pet was changed")})
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&s.pet); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		s.version++
+	}
+	w.Header().Set("ETag", s.etag())
+	_ = json.NewEncoder(w).Encode(s.pet)
+}
+
+func newClient(t *testing.T, opts ...runtime.APIClientOption) (*example18.Client, *petServer) {
+	pets := &petServer{pet: example18.Pet{Name: "Rex"}, version: 1}
+	server := httptest.NewServer(pets)
+	t.Cleanup(server.Close)
+
+	opts = append([]runtime.APIClientOption{runtime.WithHTTPClient(&httpClientAdapter{client: server.Client()})}, opts...)
+	apiClient, err := runtime.NewAPIClient(server.URL, opts...)
+	require.NoError(t, err)
+	return example18.NewClient(apiClient), pets
+}
+
+func TestIfMatch(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newClient(t)
+	path := &example18.GetPetPath{ID: "rex"}
+
+	res, err := client.GetPetResult(ctx, &example18.GetPetRequestOptions{PathParams: path})
+	require.NoError(t, err)
+	pet, ok := res.(*example18.GetPetResult200)
+	require.True(t, ok)
+	assert.Equal(t, `"v1"`, pet.ETag())
+
+	update := func(etag string) example18.UpdatePetResult {
+		res, err := client.UpdatePetResult(ctx, &example18.UpdatePetRequestOptions{
+			PathParams: &example18.UpdatePetPath{ID: "rex"},
+			Body:       &example18.Pet{Name: "Max"},
+			IfMatch:    etag,
+		})
+		require.NoError(t, err)
+		return res
+	}
+
+	updated, ok := update(pet.ETag()).(*example18.UpdatePetResult200)
+	require.True(t, ok)
+	assert.Equal(t, `"v2"`, updated.ETag())
+	assert.Equal(t, "Max", updated.Body.Name)
+
+	_, ok = update(pet.ETag()).(*example18.UpdatePetResult412)
+	assert.True(t, ok)
+}
+
+func TestETagCache(t *testing.T) {
+	ctx := context.Background()
+	client, pets := newClient(t, runtime.WithETagCache(runtime.NewMemoryETagCache()))
+	options := &example18.GetPetRequestOptions{PathParams: &example18.GetPetPath{ID: "rex"}}
+
+	for range 2 {
+		pet, err := client.GetPet(ctx, options)
+		require.NoError(t, err)
+		assert.Equal(t, "Rex", pet.Name)
+	}
+	assert.Equal(t, 1, pets.notModified)
+
+	_, err := client.UpdatePet(ctx, &example18.UpdatePetRequestOptions{
+		PathParams: &example18.UpdatePetPath{ID: "rex"},
+		Body:       &example18.Pet{Name: "Max"},
+		IfMatch:    `"v1"`,
+	})
+	require.NoError(t, err)
+
+	pet, err := client.GetPet(ctx, options)
+	require.NoError(t, err)
+	assert.Equal(t, "Max", pet.Name)
+	assert.Equal(t, 1, pets.notModified)
+}
@@ -0,0 +1,3 @@
+package example18
+
+//go:generate go run ../../../cmd/oapi-codegen -config cfg.yaml api.yaml
@@ -0,0 +1,523 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package example19
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/go-playground/validator/v10"
+)
+
+// Client is the client for the API implementing the Client interface.
+type Client struct {
+	apiClient runtime.APIClient
+}
+
+// NewClient creates a new instance of the Client client.
+func NewClient(apiClient runtime.APIClient) *Client {
+	return &Client{apiClient: apiClient}
+}
+
+// NewDefaultClient creates a new instance of the Client client with default api client.
+func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithUserAgent("No-content-responses/1.0.0 oapi-codegen-dd/v3.63.4")}, opts...)
+	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API client: %w", err)
+	}
+	return &Client{apiClient: apiClient}, nil
+}
+
+// ClientInterface is the interface for the API client.
+type ClientInterface interface {
+	ResetForm(ctx context.Context, options *ResetFormRequestOptions, reqEditors ...runtime.RequestEditorFn) (*struct{}, error)
+	ResetFormResult(ctx context.Context, options *ResetFormRequestOptions, reqEditors ...runtime.RequestEditorFn) (ResetFormResult, error)
+
+	StartJob(ctx context.Context, options *StartJobRequestOptions, reqEditors ...runtime.RequestEditorFn) (*struct{}, error)
+
+	CancelJob(ctx context.Context, options *CancelJobRequestOptions, reqEditors ...runtime.RequestEditorFn) (*struct{}, error)
+	CancelJobResult(ctx context.Context, options *CancelJobRequestOptions, reqEditors ...runtime.RequestEditorFn) (CancelJobResult, error)
+}
+
+func (c *Client) ResetForm(ctx context.Context, options *ResetFormRequestOptions, reqEditors ...runtime.RequestEditorFn) (*struct{}, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/forms/{id}/reset",
+		Method:     "POST",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*struct{}, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 205 {
+			target := new(ResetFormErrorResponse)
+			err = json.Unmarshal(bodyBytes, target)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+			}
+
+			if errTarget, ok := any(*target).(error); ok {
+				return nil, runtime.NewClientAPIError(errTarget, runtime.WithStatusCode(resp.StatusCode))
+			}
+			return nil, runtime.NewClientAPIError(fmt.Errorf("API error (status %d): %v", resp.StatusCode, *target),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		return nil, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/forms/{id}/reset")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+// ResetFormResult is implemented by every response of ResetForm.
+// Use Visit with a ResetFormResultVisitor to handle all of them.
+type ResetFormResult interface {
+	StatusCode() int
+	Visit(v ResetFormResultVisitor) error
+	isResetFormResult()
+}
+
+// ResetFormResultVisitor handles every response of ResetForm.
+// A new status code in the spec adds a method, so the compiler flags visitors that don't handle it.
+type ResetFormResultVisitor interface {
+	Visit205(res *ResetFormResult205) error
+	Visit404(res *ResetFormResult404) error
+}
+
+// ResetFormResult205 is the 205 response of ResetForm.
+type ResetFormResult205 struct {
+	Headers http.Header
+}
+
+func (r *ResetFormResult205) StatusCode() int {
+	return 205
+}
+
+func (r *ResetFormResult205) Visit(v ResetFormResultVisitor) error {
+	return v.Visit205(r)
+}
+
+func (r *ResetFormResult205) isResetFormResult() {}
+
+// ResetFormResult404 is the 404 response of ResetForm.
+type ResetFormResult404 struct {
+	Body    *ResetFormErrorResponse
+	Headers http.Header
+}
+
+func (r *ResetFormResult404) StatusCode() int {
+	return 404
+}
+
+func (r *ResetFormResult404) Visit(v ResetFormResultVisitor) error {
+	return v.Visit404(r)
+}
+
+func (r *ResetFormResult404) isResetFormResult() {}
+
+// ResetFormResult calls ResetForm and returns the response matching the status code as ResetFormResult.
+// Status codes that are not in the spec are returned as runtime.ClientAPIError.
+func (c *Client) ResetFormResult(ctx context.Context, options *ResetFormRequestOptions, reqEditors ...runtime.RequestEditorFn) (ResetFormResult, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/forms/{id}/reset",
+		Method:     "POST",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/forms/{id}/reset")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	switch {
+	case resp.StatusCode == 205:
+		res := &ResetFormResult205{Headers: resp.Headers}
+		return res, nil
+	case resp.StatusCode == 404:
+		res := &ResetFormResult404{Headers: resp.Headers}
+		bodyBytes := resp.Content
+		res.Body = new(ResetFormErrorResponse)
+		if err = json.Unmarshal(bodyBytes, res.Body); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return res, nil
+	}
+
+	return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
+		runtime.WithStatusCode(resp.StatusCode))
+}
+
+func (c *Client) StartJob(ctx context.Context, options *StartJobRequestOptions, reqEditors ...runtime.RequestEditorFn) (*struct{}, error) {
+	var err error
+	if options == nil || options.Body == nil {
+		return nil, fmt.Errorf("error creating request body: %w", runtime.ErrMissingValue)
+	}
+	if options != nil && options.Body != nil {
+		if v, ok := any(options.Body).(runtime.Validator); ok {
+			if err = v.Validate(); err != nil {
+				return nil, fmt.Errorf("error validating request body: %w", err)
+			}
+		}
+	}
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL:  c.apiClient.GetBaseURL() + "/jobs",
+		Method:      "POST",
+		ContentType: "application/json",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*struct{}, error) {
+		if resp.StatusCode != 202 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		return nil, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/jobs")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+func (c *Client) CancelJob(ctx context.Context, options *CancelJobRequestOptions, reqEditors ...runtime.RequestEditorFn) (*struct{}, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/jobs/{id}",
+		Method:     "DELETE",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*struct{}, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 204 {
+			target := new(CancelJobErrorResponse)
+			err = json.Unmarshal(bodyBytes, target)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+			}
+
+			if errTarget, ok := any(*target).(error); ok {
+				return nil, runtime.NewClientAPIError(errTarget, runtime.WithStatusCode(resp.StatusCode))
+			}
+			return nil, runtime.NewClientAPIError(fmt.Errorf("API error (status %d): %v", resp.StatusCode, *target),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		return nil, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/jobs/{id}")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+// CancelJobResult is implemented by every response of CancelJob.
+// Use Visit with a CancelJobResultVisitor to handle all of them.
+type CancelJobResult interface {
+	StatusCode() int
+	Visit(v CancelJobResultVisitor) error
+	isCancelJobResult()
+}
+
+// CancelJobResultVisitor handles every response of CancelJob.
+// A new status code in the spec adds a method, so the compiler flags visitors that don't handle it.
+type CancelJobResultVisitor interface {
+	Visit204(res *CancelJobResult204) error
+	Visit404(res *CancelJobResult404) error
+}
+
+// CancelJobResult204 is the 204 response of CancelJob.
+type CancelJobResult204 struct {
+	Headers http.Header
+}
+
+func (r *CancelJobResult204) StatusCode() int {
+	return 204
+}
+
+func (r *CancelJobResult204) Visit(v CancelJobResultVisitor) error {
+	return v.Visit204(r)
+}
+
+func (r *CancelJobResult204) isCancelJobResult() {}
+
+// CancelJobResult404 is the 404 response of CancelJob.
+type CancelJobResult404 struct {
+	Body    *CancelJobErrorResponse
+	Headers http.Header
+}
+
+func (r *CancelJobResult404) StatusCode() int {
+	return 404
+}
+
+func (r *CancelJobResult404) Visit(v CancelJobResultVisitor) error {
+	return v.Visit404(r)
+}
+
+func (r *CancelJobResult404) isCancelJobResult() {}
+
+// CancelJobResult calls CancelJob and returns the response matching the status code as CancelJobResult.
+// Status codes that are not in the spec are returned as runtime.ClientAPIError.
+func (c *Client) CancelJobResult(ctx context.Context, options *CancelJobRequestOptions, reqEditors ...runtime.RequestEditorFn) (CancelJobResult, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/jobs/{id}",
+		Method:     "DELETE",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/jobs/{id}")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	switch {
+	case resp.StatusCode == 204:
+		res := &CancelJobResult204{Headers: resp.Headers}
+		return res, nil
+	case resp.StatusCode == 404:
+		res := &CancelJobResult404{Headers: resp.Headers}
+		bodyBytes := resp.Content
+		res.Body = new(CancelJobErrorResponse)
+		if err = json.Unmarshal(bodyBytes, res.Body); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return res, nil
+	}
+
+	return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
+		runtime.WithStatusCode(resp.StatusCode))
+}
+
+var _ ClientInterface = (*Client)(nil)
+
+// ResetFormRequestOptions is the options needed to make a request to ResetForm.
+type ResetFormRequestOptions struct {
+	PathParams *ResetFormPath
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *ResetFormRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *ResetFormRequestOptions) GetPathParams() (map[string]any, error) {
+	return runtime.AsMap[any](o.PathParams)
+}
+
+// GetQuery returns the query params as a map.
+func (o *ResetFormRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
+func (o *ResetFormRequestOptions) GetBody() any {
+	return nil
+}
+
+// GetHeader returns the headers as a map.
+func (o *ResetFormRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+// StartJobRequestOptions is the options needed to make a request to StartJob.
+type StartJobRequestOptions struct {
+	Body *StartJobBody
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *StartJobRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *StartJobRequestOptions) GetPathParams() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetQuery returns the query params as a map.
+func (o *StartJobRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
+func (o *StartJobRequestOptions) GetBody() any {
+	if o.Body == nil {
+		return nil
+	}
+	return o.Body
+}
+
+// GetHeader returns the headers as a map.
+func (o *StartJobRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+// CancelJobRequestOptions is the options needed to make a request to CancelJob.
+type CancelJobRequestOptions struct {
+	PathParams *CancelJobPath
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *CancelJobRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *CancelJobRequestOptions) GetPathParams() (map[string]any, error) {
+	return runtime.AsMap[any](o.PathParams)
+}
+
+// GetQuery returns the query params as a map.
+func (o *CancelJobRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
+func (o *CancelJobRequestOptions) GetBody() any {
+	return nil
+}
+
+// GetHeader returns the headers as a map.
+func (o *CancelJobRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+type ResetFormPath struct {
+	ID string `json:"id" validate:"required"`
+}
+
+func (r ResetFormPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(r))
+}
+
+type CancelJobPath struct {
+	ID string `json:"id" validate:"required"`
+}
+
+func (c CancelJobPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(c))
+}
+
+type StartJobBody = Job
+
+type ResetFormErrorResponse = Error
+
+type CancelJobErrorResponse = Error
+
+type Job struct {
+	Name string `json:"name" validate:"required"`
+}
+
+func (j Job) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(j))
+}
+
+type Error struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (s Error) Error() string {
+	return "unmapped client error"
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
+}
@@ -0,0 +1,81 @@
+package example19_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	example19 "github.com/doordash-oss/oapi-codegen-dd/v3/examples/client/example19-no-content"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// httpClientAdapter wraps http.Client to implement runtime.HttpRequestDoer
+type httpClientAdapter struct {
+	client *http.Client
+}
+
+func (a *httpClientAdapter) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return a.client.Do(req.WithContext(ctx))
+}
+
+// resetBody is sent by the server with its 205 Reset Content responses, which should have none.
+const resetBody = `{"reset":true}`
+
+func newClient(t *testing.T, opts ...runtime.APIClientOption) *example19.Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			if r.URL.Path == "/jobs" {
+				w.WriteHeader(http.StatusAccepted)
+				return
+			}
+			w.WriteHeader(http.StatusResetContent)
+			_, _ = w.Write([]byte(resetBody))
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	opts = append([]runtime.APIClientOption{runtime.WithHTTPClient(&httpClientAdapter{client: server.Client()})}, opts...)
+	apiClient, err := runtime.NewAPIClient(server.URL, opts...)
+	require.NoError(t, err)
+	return example19.NewClient(apiClient)
+}
+
+func TestNoContent(t *testing.T) {
+	ctx := context.Background()
+	client := newClient(t)
+
+	res, err := client.ResetForm(ctx, &example19.ResetFormRequestOptions{PathParams: &example19.ResetFormPath{ID: "signup"}})
+	require.NoError(t, err)
+	assert.Nil(t, res)
+
+	res, err = client.StartJob(ctx, &example19.StartJobRequestOptions{Body: &example19.Job{Name: "backup"}})
+	require.NoError(t, err)
+	assert.Nil(t, res)
+
+	result, err := client.CancelJobResult(ctx, &example19.CancelJobRequestOptions{PathParams: &example19.CancelJobPath{ID: "backup"}})
+	require.NoError(t, err)
+	_, ok := result.(*example19.CancelJobResult204)
+	assert.True(t, ok)
+
+	reset, err := client.ResetFormResult(ctx, &example19.ResetFormRequestOptions{PathParams: &example19.ResetFormPath{ID: "signup"}})
+	require.NoError(t, err)
+	_, ok = reset.(*example19.ResetFormResult205)
+	assert.True(t, ok)
+}
+
+func TestStrictNoContent(t *testing.T) {
+	ctx := context.Background()
+	client := newClient(t, runtime.WithStrictNoContent())
+
+	_, err := client.ResetForm(ctx, &example19.ResetFormRequestOptions{PathParams: &example19.ResetFormPath{ID: "signup"}})
+	require.ErrorIs(t, err, runtime.ErrUnexpectedBody)
+
+	_, err = client.CancelJob(ctx, &example19.CancelJobRequestOptions{PathParams: &example19.CancelJobPath{ID: "backup"}})
+	require.NoError(t, err)
+}
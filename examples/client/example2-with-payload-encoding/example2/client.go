@@ -22,6 +22,7 @@ func NewClient(apiClient runtime.APIClient) *Client {
 
 // NewDefaultClient creates a new instance of the Client client with default api client.
 func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithUserAgent("Generate-models/1.0.0 oapi-codegen-dd/v3.63.4")}, opts...)
 	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("error creating API client: %w", err)
@@ -36,6 +37,13 @@ type ClientInterface interface {
 
 func (c *Client) CreateOrder(ctx context.Context, options *CreateOrderRequestOptions, reqEditors ...runtime.RequestEditorFn) (*CreateOrderResponse, error) {
 	var err error
+	if options != nil && options.Body != nil {
+		if v, ok := any(options.Body).(runtime.Validator); ok {
+			if err = v.Validate(); err != nil {
+				return nil, fmt.Errorf("error validating request body: %w", err)
+			}
+		}
+	}
 	bodyEncoding := make(map[string]runtime.FieldEncoding)
 	bodyEncoding["client_type"] = runtime.FieldEncoding{
 		ContentType: "",
@@ -45,26 +53,27 @@ func (c *Client) CreateOrder(ctx context.Context, options *CreateOrderRequestOpt
 	reqParams := runtime.RequestOptionsParameters{
 		RequestURL:   c.apiClient.GetBaseURL() + "/order",
 		Method:       "POST",
-		Options:      options,
 		ContentType:  "application/x-www-form-urlencoded",
 		BodyEncoding: bodyEncoding,
 	}
+	if options != nil {
+		reqParams.Options = options
+	}
 
 	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
 	if err != nil {
-		return nil, fmt.Errorf("error creating request: %w", err)
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
 	}
 
 	responseParser := func(ctx context.Context, resp *runtime.Response) (*CreateOrderResponse, error) {
 		bodyBytes := resp.Content
 		if resp.StatusCode != 200 {
-			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+			return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
 				runtime.WithStatusCode(resp.StatusCode))
 		}
 		target := new(CreateOrderResponse)
 		if err = json.Unmarshal(bodyBytes, target); err != nil {
-			err = fmt.Errorf("error decoding response: %w", err)
-			return nil, err
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
 		}
 		return target, nil
 	}
@@ -40,8 +40,12 @@ func (o *CreateOrderRequestOptions) GetQuery() (map[string]any, error) {
 	return nil, nil
 }
 
-// GetBody returns the payload in any type that can be marshalled to JSON by the client.
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
 func (o *CreateOrderRequestOptions) GetBody() any {
+	if o.Body == nil {
+		return nil
+	}
 	return o.Body
 }
 
@@ -24,3 +24,68 @@ func (c ClientTypeType) Validate() error {
 		return runtime.NewValidationErrorsFromString("Enum", fmt.Sprintf("must be a valid ClientTypeType value, got: %v", c))
 	}
 }
+
+// clientTypeTypeNames maps ClientTypeType values to their names.
+var clientTypeTypeNames = map[ClientTypeType]string{
+	Company:    "Company",
+	Individual: "Individual",
+}
+
+// clientTypeTypeValues maps names to ClientTypeType values.
+var clientTypeTypeValues = map[string]ClientTypeType{
+	"Company":    Company,
+	"Individual": Individual,
+}
+
+// String returns the wire value of the ClientTypeType.
+func (c ClientTypeType) String() string {
+	return string(c)
+}
+
+// IsValid reports whether the ClientTypeType value is defined in the spec.
+func (c ClientTypeType) IsValid() bool {
+	_, ok := clientTypeTypeNames[c]
+	return ok
+}
+
+// Values returns all the ClientTypeType values defined in the spec.
+func (ClientTypeType) Values() []ClientTypeType {
+	return []ClientTypeType{
+		Company,
+		Individual,
+	}
+}
+
+// ClientTypeTypePtr returns a pointer to v, to set the optional and nullable ClientTypeType properties.
+func ClientTypeTypePtr(v ClientTypeType) *ClientTypeType {
+	return &v
+}
+
+// Name returns the name of the ClientTypeType value, or an empty string for unknown values.
+func (c ClientTypeType) Name() string {
+	return clientTypeTypeNames[c]
+}
+
+// ParseClientTypeType returns the ClientTypeType matching s by wire value or by name.
+func ParseClientTypeType(s string) (ClientTypeType, error) {
+	if _, ok := clientTypeTypeNames[ClientTypeType(s)]; ok {
+		return ClientTypeType(s), nil
+	}
+	if v, ok := clientTypeTypeValues[s]; ok {
+		return v, nil
+	}
+	var zero ClientTypeType
+	return zero, fmt.Errorf("%w for ClientTypeType: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (c ClientTypeType) MarshalText() ([]byte, error) {
+	return []byte(c), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseClientTypeType.
+// Unknown values are kept as-is and reported by Validate.
+func (c *ClientTypeType) UnmarshalText(text []byte) error {
+	*c = ClientTypeType(text)
+	return nil
+}
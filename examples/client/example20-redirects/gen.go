@@ -0,0 +1,444 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package example20
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/go-playground/validator/v10"
+)
+
+// Client is the client for the API implementing the Client interface.
+type Client struct {
+	apiClient runtime.APIClient
+}
+
+// NewClient creates a new instance of the Client client.
+func NewClient(apiClient runtime.APIClient) *Client {
+	return &Client{apiClient: apiClient}
+}
+
+// NewDefaultClient creates a new instance of the Client client with default api client.
+func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithUserAgent("Redirects/1.0.0 oapi-codegen-dd/v3.63.4")}, opts...)
+	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API client: %w", err)
+	}
+	return &Client{apiClient: apiClient}, nil
+}
+
+// ClientInterface is the interface for the API client.
+type ClientInterface interface {
+	Authorize(ctx context.Context, options *AuthorizeRequestOptions, reqEditors ...runtime.RequestEditorFn) (*struct{}, error)
+	AuthorizeResult(ctx context.Context, options *AuthorizeRequestOptions, reqEditors ...runtime.RequestEditorFn) (AuthorizeResult, error)
+
+	GetPet(ctx context.Context, options *GetPetRequestOptions, reqEditors ...runtime.RequestEditorFn) (*GetPetResponse, error)
+	GetPetResult(ctx context.Context, options *GetPetRequestOptions, reqEditors ...runtime.RequestEditorFn) (GetPetResult, error)
+}
+
+func (c *Client) Authorize(ctx context.Context, options *AuthorizeRequestOptions, reqEditors ...runtime.RequestEditorFn) (*struct{}, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/oauth/authorize",
+		Method:     "GET",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*struct{}, error) {
+		if resp.StatusCode != 204 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		return nil, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/oauth/authorize")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+// AuthorizeResult is implemented by every response of Authorize.
+// Use Visit with a AuthorizeResultVisitor to handle all of them.
+type AuthorizeResult interface {
+	StatusCode() int
+	Visit(v AuthorizeResultVisitor) error
+	isAuthorizeResult()
+}
+
+// AuthorizeResultVisitor handles every response of Authorize.
+// A new status code in the spec adds a method, so the compiler flags visitors that don't handle it.
+type AuthorizeResultVisitor interface {
+	Visit204(res *AuthorizeResult204) error
+	Visit302(res *AuthorizeResult302) error
+	Visit400(res *AuthorizeResult400) error
+}
+
+// AuthorizeResult204 is the 204 response of Authorize.
+type AuthorizeResult204 struct {
+	Headers http.Header
+}
+
+func (r *AuthorizeResult204) StatusCode() int {
+	return 204
+}
+
+func (r *AuthorizeResult204) Visit(v AuthorizeResultVisitor) error {
+	return v.Visit204(r)
+}
+
+func (r *AuthorizeResult204) isAuthorizeResult() {}
+
+// AuthorizeResult302 is the 302 response of Authorize.
+// Redirects are only returned when they are not followed, see runtime.WithoutRedirects.
+type AuthorizeResult302 struct {
+	Headers http.Header
+	// Location is the Location header resolved against the request URL, nil if not set.
+	Location *url.URL
+}
+
+func (r *AuthorizeResult302) StatusCode() int {
+	return 302
+}
+
+func (r *AuthorizeResult302) Visit(v AuthorizeResultVisitor) error {
+	return v.Visit302(r)
+}
+
+func (r *AuthorizeResult302) isAuthorizeResult() {}
+
+// AuthorizeResult400 is the 400 response of Authorize.
+type AuthorizeResult400 struct {
+	Body    *AuthorizeErrorResponse
+	Headers http.Header
+}
+
+func (r *AuthorizeResult400) StatusCode() int {
+	return 400
+}
+
+func (r *AuthorizeResult400) Visit(v AuthorizeResultVisitor) error {
+	return v.Visit400(r)
+}
+
+func (r *AuthorizeResult400) isAuthorizeResult() {}
+
+// AuthorizeResult calls Authorize and returns the response matching the status code as AuthorizeResult.
+// Status codes that are not in the spec are returned as runtime.ClientAPIError.
+func (c *Client) AuthorizeResult(ctx context.Context, options *AuthorizeRequestOptions, reqEditors ...runtime.RequestEditorFn) (AuthorizeResult, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/oauth/authorize",
+		Method:     "GET",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/oauth/authorize")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	switch {
+	case resp.StatusCode == 204:
+		res := &AuthorizeResult204{Headers: resp.Headers}
+		return res, nil
+	case resp.StatusCode == 302:
+		res := &AuthorizeResult302{Headers: resp.Headers}
+		if res.Location, err = resp.Location(); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return res, nil
+	case resp.StatusCode == 400:
+		res := &AuthorizeResult400{Headers: resp.Headers}
+		bodyBytes := resp.Content
+		res.Body = new(AuthorizeErrorResponse)
+		if err = json.Unmarshal(bodyBytes, res.Body); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return res, nil
+	}
+
+	return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
+		runtime.WithStatusCode(resp.StatusCode))
+}
+
+func (c *Client) GetPet(ctx context.Context, options *GetPetRequestOptions, reqEditors ...runtime.RequestEditorFn) (*GetPetResponse, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/pets/{id}",
+		Method:     "GET",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*GetPetResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(GetPetResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/pets/{id}")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+// GetPetResult is implemented by every response of GetPet.
+// Use Visit with a GetPetResultVisitor to handle all of them.
+type GetPetResult interface {
+	StatusCode() int
+	Visit(v GetPetResultVisitor) error
+	isGetPetResult()
+}
+
+// GetPetResultVisitor handles every response of GetPet.
+// A new status code in the spec adds a method, so the compiler flags visitors that don't handle it.
+type GetPetResultVisitor interface {
+	Visit200(res *GetPetResult200) error
+	Visit301(res *GetPetResult301) error
+}
+
+// GetPetResult200 is the 200 response of GetPet.
+type GetPetResult200 struct {
+	Body    *GetPetResponse
+	Headers http.Header
+}
+
+func (r *GetPetResult200) StatusCode() int {
+	return 200
+}
+
+func (r *GetPetResult200) Visit(v GetPetResultVisitor) error {
+	return v.Visit200(r)
+}
+
+func (r *GetPetResult200) isGetPetResult() {}
+
+// GetPetResult301 is the 301 response of GetPet.
+// Redirects are only returned when they are not followed, see runtime.WithoutRedirects.
+type GetPetResult301 struct {
+	Headers http.Header
+	// Location is the Location header resolved against the request URL, nil if not set.
+	Location *url.URL
+}
+
+func (r *GetPetResult301) StatusCode() int {
+	return 301
+}
+
+func (r *GetPetResult301) Visit(v GetPetResultVisitor) error {
+	return v.Visit301(r)
+}
+
+func (r *GetPetResult301) isGetPetResult() {}
+
+// GetPetResult calls GetPet and returns the response matching the status code as GetPetResult.
+// Status codes that are not in the spec are returned as runtime.ClientAPIError.
+func (c *Client) GetPetResult(ctx context.Context, options *GetPetRequestOptions, reqEditors ...runtime.RequestEditorFn) (GetPetResult, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/pets/{id}",
+		Method:     "GET",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/pets/{id}")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	switch {
+	case resp.StatusCode == 200:
+		res := &GetPetResult200{Headers: resp.Headers}
+		bodyBytes := resp.Content
+		res.Body = new(GetPetResponse)
+		if err = json.Unmarshal(bodyBytes, res.Body); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return res, nil
+	case resp.StatusCode == 301:
+		res := &GetPetResult301{Headers: resp.Headers}
+		if res.Location, err = resp.Location(); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return res, nil
+	}
+
+	return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
+		runtime.WithStatusCode(resp.StatusCode))
+}
+
+var _ ClientInterface = (*Client)(nil)
+
+// AuthorizeRequestOptions is the options needed to make a request to Authorize.
+type AuthorizeRequestOptions struct {
+	Query *AuthorizeQuery
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *AuthorizeRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Query != nil {
+		if v, ok := any(o.Query).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Query", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *AuthorizeRequestOptions) GetPathParams() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetQuery returns the query params as a map.
+func (o *AuthorizeRequestOptions) GetQuery() (map[string]any, error) {
+	return runtime.AsMap[any](o.Query)
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
+func (o *AuthorizeRequestOptions) GetBody() any {
+	return nil
+}
+
+// GetHeader returns the headers as a map.
+func (o *AuthorizeRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+// GetPetRequestOptions is the options needed to make a request to GetPet.
+type GetPetRequestOptions struct {
+	PathParams *GetPetPath
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *GetPetRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *GetPetRequestOptions) GetPathParams() (map[string]any, error) {
+	return runtime.AsMap[any](o.PathParams)
+}
+
+// GetQuery returns the query params as a map.
+func (o *GetPetRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
+func (o *GetPetRequestOptions) GetBody() any {
+	return nil
+}
+
+// GetHeader returns the headers as a map.
+func (o *GetPetRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+type GetPetPath struct {
+	ID string `json:"id" validate:"required"`
+}
+
+func (g GetPetPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(g))
+}
+
+type AuthorizeQuery struct {
+	ClientID    string `json:"client_id" validate:"required"`
+	RedirectURI string `json:"redirect_uri" validate:"required"`
+}
+
+func (a AuthorizeQuery) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(a))
+}
+
+type AuthorizeErrorResponse = Error
+
+type GetPetResponse = Pet
+
+type Pet struct {
+	Name string `json:"name" validate:"required"`
+}
+
+func (p Pet) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(p))
+}
+
+type Error struct {
+	Message *string `json:"message,omitempty"`
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
+}
@@ -0,0 +1,83 @@
+package example20_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	example20 "github.com/doordash-oss/oapi-codegen-dd/v3/examples/client/example20-redirects"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// httpClientAdapter wraps http.Client to implement runtime.HttpRequestDoer
+type httpClientAdapter struct {
+	client *http.Client
+}
+
+func (a *httpClientAdapter) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return a.client.Do(req.WithContext(ctx))
+}
+
+func newClient(t *testing.T, opts ...runtime.APIClientOption) *example20.Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/authorize":
+			http.Redirect(w, r, r.URL.Query().Get("redirect_uri")+"?code=abc", http.StatusFound)
+		case "/pets/rex":
+			http.Redirect(w, r, "/pets/max", http.StatusMovedPermanently)
+		case "/pets/max":
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(example20.Pet{Name: "Max"})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	httpClient := server.Client()
+	httpClient.CheckRedirect = runtime.CheckRedirect
+	opts = append([]runtime.APIClientOption{runtime.WithHTTPClient(&httpClientAdapter{client: httpClient})}, opts...)
+	apiClient, err := runtime.NewAPIClient(server.URL, opts...)
+	require.NoError(t, err)
+	return example20.NewClient(apiClient)
+}
+
+func TestWithoutRedirectsFor(t *testing.T) {
+	ctx := context.Background()
+	client := newClient(t, runtime.WithoutRedirectsFor("/oauth/authorize"))
+
+	res, err := client.AuthorizeResult(ctx, &example20.AuthorizeRequestOptions{
+		Query: &example20.AuthorizeQuery{ClientID: "app", RedirectURI: "https://app.example.com/callback"},
+	})
+	require.NoError(t, err)
+	redirect, ok := res.(*example20.AuthorizeResult302)
+	require.True(t, ok)
+	assert.Equal(t, "https://app.example.com/callback?code=abc", redirect.Location.String())
+	assert.Equal(t, "abc", redirect.Location.Query().Get("code"))
+
+	pet, err := client.GetPet(ctx, &example20.GetPetRequestOptions{PathParams: &example20.GetPetPath{ID: "rex"}})
+	require.NoError(t, err)
+	assert.Equal(t, "Max", pet.Name)
+}
+
+func TestWithoutRedirects(t *testing.T) {
+	ctx := context.Background()
+	client := newClient(t)
+	options := &example20.GetPetRequestOptions{PathParams: &example20.GetPetPath{ID: "rex"}}
+
+	res, err := client.GetPetResult(ctx, options, runtime.WithoutRedirects())
+	require.NoError(t, err)
+	moved, ok := res.(*example20.GetPetResult301)
+	require.True(t, ok)
+	assert.Equal(t, "/pets/max", moved.Location.Path)
+
+	res, err = client.GetPetResult(ctx, options)
+	require.NoError(t, err)
+	pet, ok := res.(*example20.GetPetResult200)
+	require.True(t, ok)
+	assert.Equal(t, "Max", pet.Body.Name)
+}
@@ -0,0 +1,225 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package example21
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/go-playground/validator/v10"
+)
+
+// Client is the client for the API implementing the Client interface.
+type Client struct {
+	apiClient runtime.APIClient
+}
+
+// NewClient creates a new instance of the Client client.
+func NewClient(apiClient runtime.APIClient) *Client {
+	return &Client{apiClient: apiClient}
+}
+
+// NewDefaultClient creates a new instance of the Client client with default api client.
+func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithUserAgent("Response-headers/1.0.0 oapi-codegen-dd/v3.63.4")}, opts...)
+	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API client: %w", err)
+	}
+	return &Client{apiClient: apiClient}, nil
+}
+
+// ClientInterface is the interface for the API client.
+type ClientInterface interface {
+	ListPets(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (*ListPetsResponse, error)
+	ListPetsResult(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (ListPetsResult, error)
+}
+
+func (c *Client) ListPets(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (*ListPetsResponse, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/pets",
+		Method:     "GET",
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*ListPetsResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			target := new(ListPetsErrorResponse)
+			err = json.Unmarshal(bodyBytes, target)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+			}
+
+			if errTarget, ok := any(*target).(error); ok {
+				return nil, runtime.NewClientAPIError(errTarget, runtime.WithStatusCode(resp.StatusCode))
+			}
+			return nil, runtime.NewClientAPIError(fmt.Errorf("API error (status %d): %v", resp.StatusCode, *target),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(ListPetsResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/pets")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+// ListPetsResult is implemented by every response of ListPets.
+// Use Visit with a ListPetsResultVisitor to handle all of them.
+type ListPetsResult interface {
+	StatusCode() int
+	Visit(v ListPetsResultVisitor) error
+	isListPetsResult()
+}
+
+// ListPetsResultVisitor handles every response of ListPets.
+// A new status code in the spec adds a method, so the compiler flags visitors that don't handle it.
+type ListPetsResultVisitor interface {
+	Visit200(res *ListPetsResult200) error
+	Visit429(res *ListPetsResult429) error
+}
+
+// ListPetsResult200 is the 200 response of ListPets.
+type ListPetsResult200 struct {
+	Body    *ListPetsResponse
+	Headers http.Header
+}
+
+func (r *ListPetsResult200) StatusCode() int {
+	return 200
+}
+
+// ETag returns the ETag header of the response, the version to send in If-Match or If-None-Match.
+func (r *ListPetsResult200) ETag() string {
+	return r.Headers.Get("ETag")
+}
+
+// XExpiresOn returns the X-Expires-On header of the response as runtime.Date.
+// It fails with runtime.ErrMissingValue if the header is not set.
+func (r *ListPetsResult200) XExpiresOn() (runtime.Date, error) {
+	return runtime.ParseResponseHeader[runtime.Date](r.Headers, "X-Expires-On", "")
+}
+
+// XRateLimitRemaining returns the X-Rate-Limit-Remaining header of the response as int.
+// It fails with runtime.ErrMissingValue if the header is not set.
+func (r *ListPetsResult200) XRateLimitRemaining() (int, error) {
+	return runtime.ParseResponseHeader[int](r.Headers, "X-Rate-Limit-Remaining", "gte=0")
+}
+
+// XRateLimitReset returns the X-Rate-Limit-Reset header of the response as time.Time.
+// It fails with runtime.ErrMissingValue if the header is not set.
+func (r *ListPetsResult200) XRateLimitReset() (time.Time, error) {
+	return runtime.ParseResponseHeader[time.Time](r.Headers, "X-Rate-Limit-Reset", "")
+}
+
+// XRequestID returns the X-Request-ID header of the response as string.
+// It fails with runtime.ErrMissingValue if the header is not set.
+func (r *ListPetsResult200) XRequestID() (string, error) {
+	return runtime.ParseResponseHeader[string](r.Headers, "X-Request-ID", "")
+}
+
+func (r *ListPetsResult200) Visit(v ListPetsResultVisitor) error {
+	return v.Visit200(r)
+}
+
+func (r *ListPetsResult200) isListPetsResult() {}
+
+// ListPetsResult429 is the 429 response of ListPets.
+type ListPetsResult429 struct {
+	Body    *ListPetsErrorResponse
+	Headers http.Header
+}
+
+func (r *ListPetsResult429) StatusCode() int {
+	return 429
+}
+
+// RetryAfter returns the Retry-After header of the response as int64.
+// It fails with runtime.ErrMissingValue if the header is not set.
+func (r *ListPetsResult429) RetryAfter() (int64, error) {
+	return runtime.ParseResponseHeader[int64](r.Headers, "Retry-After", "")
+}
+
+func (r *ListPetsResult429) Visit(v ListPetsResultVisitor) error {
+	return v.Visit429(r)
+}
+
+func (r *ListPetsResult429) isListPetsResult() {}
+
+// ListPetsResult calls ListPets and returns the response matching the status code as ListPetsResult.
+// Status codes that are not in the spec are returned as runtime.ClientAPIError.
+func (c *Client) ListPetsResult(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (ListPetsResult, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/pets",
+		Method:     "GET",
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/pets")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	switch {
+	case resp.StatusCode == 200:
+		res := &ListPetsResult200{Headers: resp.Headers}
+		bodyBytes := resp.Content
+		res.Body = new(ListPetsResponse)
+		if err = json.Unmarshal(bodyBytes, res.Body); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return res, nil
+	case resp.StatusCode == 429:
+		res := &ListPetsResult429{Headers: resp.Headers}
+		bodyBytes := resp.Content
+		res.Body = new(ListPetsErrorResponse)
+		if err = json.Unmarshal(bodyBytes, res.Body); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return res, nil
+	}
+
+	return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
+		runtime.WithStatusCode(resp.StatusCode))
+}
+
+var _ ClientInterface = (*Client)(nil)
+
+type ListPetsResponse []string
+
+type ListPetsErrorResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (r ListPetsErrorResponse) Error() string {
+	return "unmapped client error"
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
+}
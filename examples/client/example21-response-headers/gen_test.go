@@ -0,0 +1,88 @@
+package example21_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	example21 "github.com/doordash-oss/oapi-codegen-dd/v3/examples/client/example21-response-headers"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// httpClientAdapter wraps http.Client to implement runtime.HttpRequestDoer
+type httpClientAdapter struct {
+	client *http.Client
+}
+
+func (a *httpClientAdapter) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return a.client.Do(req.WithContext(ctx))
+}
+
+func newClient(t *testing.T, handler http.HandlerFunc) *example21.Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	apiClient, err := runtime.NewAPIClient(server.URL, runtime.WithHTTPClient(&httpClientAdapter{client: server.Client()}))
+	require.NoError(t, err)
+	return example21.NewClient(apiClient)
+}
+
+func TestHeaderAccessors(t *testing.T) {
+	ctx := context.Background()
+	client := newClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Rate-Limit-Remaining", "41")
+		w.Header().Set("X-Rate-Limit-Reset", "2025-01-02T03:04:05Z")
+		w.Header().Set("X-Expires-On", "2025-02-01")
+		_, _ = w.Write([]byte(`["rex"]`))
+	})
+
+	res, err := client.ListPetsResult(ctx)
+	require.NoError(t, err)
+	pets, ok := res.(*example21.ListPetsResult200)
+	require.True(t, ok)
+
+	remaining, err := pets.XRateLimitRemaining()
+	require.NoError(t, err)
+	assert.Equal(t, 41, remaining)
+
+	reset, err := pets.XRateLimitReset()
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC), reset)
+
+	expires, err := pets.XExpiresOn()
+	require.NoError(t, err)
+	assert.Equal(t, "2025-02-01", expires.String())
+
+	_, err = pets.XRequestID()
+	assert.ErrorIs(t, err, runtime.ErrMissingValue)
+}
+
+func TestHeaderAccessorValidation(t *testing.T) {
+	ctx := context.Background()
+	client := newClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = w.Write([]byte(`{"message":"slow down"}`))
+	})
+
+	res, err := client.ListPetsResult(ctx)
+	require.NoError(t, err)
+	limited, ok := res.(*example21.ListPetsResult429)
+	require.True(t, ok)
+
+	retryAfter, err := limited.RetryAfter()
+	require.NoError(t, err)
+	assert.Equal(t, int64(30), retryAfter)
+
+	// the 200 response validates its headers against the spec
+	pets := &example21.ListPetsResult200{Headers: http.Header{"X-Rate-Limit-Remaining": {"-1"}}}
+	_, err = pets.XRateLimitRemaining()
+	var validationErrors runtime.ValidationErrors
+	assert.ErrorAs(t, err, &validationErrors)
+}
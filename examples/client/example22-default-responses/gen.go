@@ -0,0 +1,578 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package example22
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/go-playground/validator/v10"
+)
+
+// Client is the client for the API implementing the Client interface.
+type Client struct {
+	apiClient runtime.APIClient
+}
+
+// NewClient creates a new instance of the Client client.
+func NewClient(apiClient runtime.APIClient) *Client {
+	return &Client{apiClient: apiClient}
+}
+
+// NewDefaultClient creates a new instance of the Client client with default api client.
+func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithUserAgent("Default-responses/1.0.0 oapi-codegen-dd/v3.63.4")}, opts...)
+	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API client: %w", err)
+	}
+	return &Client{apiClient: apiClient}, nil
+}
+
+// ClientInterface is the interface for the API client.
+type ClientInterface interface {
+	ListPets(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (*ListPetsResponse, error)
+
+	CreatePet(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (*CreatePetResponse, error)
+	CreatePetResult(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (CreatePetResult, error)
+
+	UpdatePets(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (*UpdatePetsResponseJSON, error)
+	UpdatePetsResult(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (UpdatePetsResult, error)
+
+	DeletePet(ctx context.Context, options *DeletePetRequestOptions, reqEditors ...runtime.RequestEditorFn) (*struct{}, error)
+	DeletePetResult(ctx context.Context, options *DeletePetRequestOptions, reqEditors ...runtime.RequestEditorFn) (DeletePetResult, error)
+}
+
+func (c *Client) ListPets(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (*ListPetsResponse, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/pets",
+		Method:     "GET",
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*ListPetsResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(ListPetsResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/pets")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+func (c *Client) CreatePet(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (*CreatePetResponse, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/pets",
+		Method:     "POST",
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*CreatePetResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 201 {
+			target := new(CreatePetErrorResponse)
+			err = json.Unmarshal(bodyBytes, target)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+			}
+
+			if errTarget, ok := any(*target).(error); ok {
+				return nil, runtime.NewClientAPIError(errTarget, runtime.WithStatusCode(resp.StatusCode))
+			}
+			return nil, runtime.NewClientAPIError(fmt.Errorf("API error (status %d): %v", resp.StatusCode, *target),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(CreatePetResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/pets")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+// CreatePetResult is implemented by every response of CreatePet.
+// Use Visit with a CreatePetResultVisitor to handle all of them.
+type CreatePetResult interface {
+	StatusCode() int
+	Visit(v CreatePetResultVisitor) error
+	isCreatePetResult()
+}
+
+// CreatePetResultVisitor handles every response of CreatePet.
+// A new status code in the spec adds a method, so the compiler flags visitors that don't handle it.
+type CreatePetResultVisitor interface {
+	Visit201(res *CreatePetResult201) error
+	Visit400(res *CreatePetResult400) error
+	VisitDefault(res *CreatePetResultDefault) error
+}
+
+// CreatePetResult201 is the 201 response of CreatePet.
+type CreatePetResult201 struct {
+	Body    *CreatePetResponse
+	Headers http.Header
+}
+
+func (r *CreatePetResult201) StatusCode() int {
+	return 201
+}
+
+func (r *CreatePetResult201) Visit(v CreatePetResultVisitor) error {
+	return v.Visit201(r)
+}
+
+func (r *CreatePetResult201) isCreatePetResult() {}
+
+// CreatePetResult400 is the 400 response of CreatePet.
+type CreatePetResult400 struct {
+	Body    *CreatePetErrorResponse
+	Headers http.Header
+}
+
+func (r *CreatePetResult400) StatusCode() int {
+	return 400
+}
+
+func (r *CreatePetResult400) Visit(v CreatePetResultVisitor) error {
+	return v.Visit400(r)
+}
+
+func (r *CreatePetResult400) isCreatePetResult() {}
+
+// CreatePetResultDefault is the default response of CreatePet,
+// for status codes without a response of their own.
+type CreatePetResultDefault struct {
+	Body    *CreatePetDefaultResponse
+	Headers http.Header
+	Status  int
+}
+
+func (r *CreatePetResultDefault) StatusCode() int {
+	return r.Status
+}
+
+func (r *CreatePetResultDefault) Visit(v CreatePetResultVisitor) error {
+	return v.VisitDefault(r)
+}
+
+func (r *CreatePetResultDefault) isCreatePetResult() {}
+
+// CreatePetResult calls CreatePet and returns the response matching the status code as CreatePetResult.
+func (c *Client) CreatePetResult(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (CreatePetResult, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/pets",
+		Method:     "POST",
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/pets")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	switch {
+	case resp.StatusCode == 201:
+		res := &CreatePetResult201{Headers: resp.Headers}
+		bodyBytes := resp.Content
+		res.Body = new(CreatePetResponse)
+		if err = json.Unmarshal(bodyBytes, res.Body); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return res, nil
+	case resp.StatusCode == 400:
+		res := &CreatePetResult400{Headers: resp.Headers}
+		bodyBytes := resp.Content
+		res.Body = new(CreatePetErrorResponse)
+		if err = json.Unmarshal(bodyBytes, res.Body); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return res, nil
+	default:
+		res := &CreatePetResultDefault{Headers: resp.Headers, Status: resp.StatusCode}
+		bodyBytes := resp.Content
+		res.Body = new(CreatePetDefaultResponse)
+		if err = json.Unmarshal(bodyBytes, res.Body); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return res, nil
+	}
+}
+
+func (c *Client) UpdatePets(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (*UpdatePetsResponseJSON, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/pets",
+		Method:     "PUT",
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*UpdatePetsResponseJSON, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 207 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(UpdatePetsResponseJSON)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/pets")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+// UpdatePetsResult is implemented by every response of UpdatePets.
+// Use Visit with a UpdatePetsResultVisitor to handle all of them.
+type UpdatePetsResult interface {
+	StatusCode() int
+	Visit(v UpdatePetsResultVisitor) error
+	isUpdatePetsResult()
+}
+
+// UpdatePetsResultVisitor handles every response of UpdatePets.
+// A new status code in the spec adds a method, so the compiler flags visitors that don't handle it.
+type UpdatePetsResultVisitor interface {
+	Visit200(res *UpdatePetsResult200) error
+	Visit207(res *UpdatePetsResult207) error
+}
+
+// UpdatePetsResult200 is the 200 response of UpdatePets.
+type UpdatePetsResult200 struct {
+	Body    *UpdatePetsResponse
+	Headers http.Header
+}
+
+func (r *UpdatePetsResult200) StatusCode() int {
+	return 200
+}
+
+func (r *UpdatePetsResult200) Visit(v UpdatePetsResultVisitor) error {
+	return v.Visit200(r)
+}
+
+func (r *UpdatePetsResult200) isUpdatePetsResult() {}
+
+// UpdatePetsResult207 is the 207 response of UpdatePets.
+type UpdatePetsResult207 struct {
+	Body    *UpdatePetsResponseJSON
+	Headers http.Header
+}
+
+func (r *UpdatePetsResult207) StatusCode() int {
+	return 207
+}
+
+func (r *UpdatePetsResult207) Visit(v UpdatePetsResultVisitor) error {
+	return v.Visit207(r)
+}
+
+func (r *UpdatePetsResult207) isUpdatePetsResult() {}
+
+// UpdatePetsResult calls UpdatePets and returns the response matching the status code as UpdatePetsResult.
+// Status codes that are not in the spec are returned as runtime.ClientAPIError.
+func (c *Client) UpdatePetsResult(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (UpdatePetsResult, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/pets",
+		Method:     "PUT",
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/pets")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	switch {
+	case resp.StatusCode == 200:
+		res := &UpdatePetsResult200{Headers: resp.Headers}
+		bodyBytes := resp.Content
+		res.Body = new(UpdatePetsResponse)
+		if err = json.Unmarshal(bodyBytes, res.Body); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return res, nil
+	case resp.StatusCode == 207:
+		res := &UpdatePetsResult207{Headers: resp.Headers}
+		bodyBytes := resp.Content
+		res.Body = new(UpdatePetsResponseJSON)
+		if err = json.Unmarshal(bodyBytes, res.Body); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return res, nil
+	}
+
+	return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
+		runtime.WithStatusCode(resp.StatusCode))
+}
+
+func (c *Client) DeletePet(ctx context.Context, options *DeletePetRequestOptions, reqEditors ...runtime.RequestEditorFn) (*struct{}, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/pets/{id}",
+		Method:     "DELETE",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*struct{}, error) {
+		if resp.StatusCode != 204 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		return nil, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/pets/{id}")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+// DeletePetResult is implemented by every response of DeletePet.
+// Use Visit with a DeletePetResultVisitor to handle all of them.
+type DeletePetResult interface {
+	StatusCode() int
+	Visit(v DeletePetResultVisitor) error
+	isDeletePetResult()
+}
+
+// DeletePetResultVisitor handles every response of DeletePet.
+// A new status code in the spec adds a method, so the compiler flags visitors that don't handle it.
+type DeletePetResultVisitor interface {
+	Visit204(res *DeletePetResult204) error
+	VisitDefault(res *DeletePetResultDefault) error
+}
+
+// DeletePetResult204 is the 204 response of DeletePet.
+type DeletePetResult204 struct {
+	Headers http.Header
+}
+
+func (r *DeletePetResult204) StatusCode() int {
+	return 204
+}
+
+func (r *DeletePetResult204) Visit(v DeletePetResultVisitor) error {
+	return v.Visit204(r)
+}
+
+func (r *DeletePetResult204) isDeletePetResult() {}
+
+// DeletePetResultDefault is the default response of DeletePet,
+// for status codes without a response of their own.
+type DeletePetResultDefault struct {
+	Headers http.Header
+	Status  int
+}
+
+func (r *DeletePetResultDefault) StatusCode() int {
+	return r.Status
+}
+
+func (r *DeletePetResultDefault) Visit(v DeletePetResultVisitor) error {
+	return v.VisitDefault(r)
+}
+
+func (r *DeletePetResultDefault) isDeletePetResult() {}
+
+// DeletePetResult calls DeletePet and returns the response matching the status code as DeletePetResult.
+func (c *Client) DeletePetResult(ctx context.Context, options *DeletePetRequestOptions, reqEditors ...runtime.RequestEditorFn) (DeletePetResult, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/pets/{id}",
+		Method:     "DELETE",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/pets/{id}")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	switch {
+	case resp.StatusCode == 204:
+		res := &DeletePetResult204{Headers: resp.Headers}
+		return res, nil
+	default:
+		res := &DeletePetResultDefault{Headers: resp.Headers, Status: resp.StatusCode}
+		return res, nil
+	}
+}
+
+var _ ClientInterface = (*Client)(nil)
+
+// DeletePetRequestOptions is the options needed to make a request to DeletePet.
+type DeletePetRequestOptions struct {
+	PathParams *DeletePetPath
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *DeletePetRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *DeletePetRequestOptions) GetPathParams() (map[string]any, error) {
+	return runtime.AsMap[any](o.PathParams)
+}
+
+// GetQuery returns the query params as a map.
+func (o *DeletePetRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
+func (o *DeletePetRequestOptions) GetBody() any {
+	return nil
+}
+
+// GetHeader returns the headers as a map.
+func (o *DeletePetRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+type DeletePetPath struct {
+	ID string `json:"id" validate:"required"`
+}
+
+func (d DeletePetPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(d))
+}
+
+type ListPetsResponse []Pet
+
+type CreatePetResponse = Pet
+
+type CreatePetErrorResponse = Problem
+
+type CreatePetDefaultResponse = Problem
+
+type UpdatePetsResponse []Pet
+
+type UpdatePetsResponseJSON = MultiStatus
+
+type Pet struct {
+	Name string `json:"name" validate:"required"`
+}
+
+func (p Pet) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(p))
+}
+
+type Problem struct {
+	Title *string `json:"title,omitempty"`
+}
+
+func (s Problem) Error() string {
+	return "unmapped client error"
+}
+
+type MultiStatus struct {
+	Statuses *MultiStatus_Statuses `json:"statuses,omitempty"`
+}
+
+func (m MultiStatus) Validate() error {
+	var errors runtime.ValidationErrors
+	if m.Statuses != nil {
+		if v, ok := any(m.Statuses).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Statuses", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+type MultiStatus_Statuses []MultiStatus_Statuses_Item
+
+type MultiStatus_Statuses_Item struct {
+	Name   *string `json:"name,omitempty"`
+	Status *int    `json:"status,omitempty"`
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
+}
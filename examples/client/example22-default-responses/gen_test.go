@@ -0,0 +1,89 @@
+package example22_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	example22 "github.com/doordash-oss/oapi-codegen-dd/v3/examples/client/example22-default-responses"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// httpClientAdapter wraps http.Client to implement runtime.HttpRequestDoer
+type httpClientAdapter struct {
+	client *http.Client
+}
+
+func (a *httpClientAdapter) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return a.client.Do(req.WithContext(ctx))
+}
+
+func newClient(t *testing.T, status int, body any) *example22.Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		if body != nil {
+			_ = json.NewEncoder(w).Encode(body)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	apiClient, err := runtime.NewAPIClient(server.URL, runtime.WithHTTPClient(&httpClientAdapter{client: server.Client()}))
+	require.NoError(t, err)
+	return example22.NewClient(apiClient)
+}
+
+func TestDefaultSuccess(t *testing.T) {
+	ctx := context.Background()
+
+	for _, status := range []int{http.StatusOK, http.StatusPartialContent} {
+		client := newClient(t, status, []example22.Pet{{Name: "Rex"}})
+		pets, err := client.ListPets(ctx)
+		require.NoError(t, err)
+		require.Len(t, *pets, 1)
+		assert.Equal(t, "Rex", (*pets)[0].Name)
+	}
+
+	client := newClient(t, http.StatusInternalServerError, nil)
+	_, err := client.ListPets(ctx)
+	var apiErr *runtime.ClientAPIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusInternalServerError, apiErr.StatusCode())
+}
+
+func TestDefaultCase(t *testing.T) {
+	ctx := context.Background()
+	client := newClient(t, http.StatusConflict, example22.Problem{Title: runtime.Ptr("already exists")})
+
+	res, err := client.CreatePetResult(ctx)
+	require.NoError(t, err)
+	conflict, ok := res.(*example22.CreatePetResultDefault)
+	require.True(t, ok)
+	assert.Equal(t, http.StatusConflict, conflict.StatusCode())
+	assert.Equal(t, "already exists", *conflict.Body.Title)
+
+	client = newClient(t, http.StatusAccepted, nil)
+	deleted, err := client.DeletePetResult(ctx, &example22.DeletePetRequestOptions{PathParams: &example22.DeletePetPath{ID: "rex"}})
+	require.NoError(t, err)
+	accepted, ok := deleted.(*example22.DeletePetResultDefault)
+	require.True(t, ok)
+	assert.Equal(t, http.StatusAccepted, accepted.StatusCode())
+}
+
+func TestMultiStatus(t *testing.T) {
+	ctx := context.Background()
+	client := newClient(t, http.StatusMultiStatus, map[string]any{
+		"statuses": []map[string]any{{"name": "Rex", "status": 200}, {"name": "Max", "status": 404}},
+	})
+
+	res, err := client.UpdatePetsResult(ctx)
+	require.NoError(t, err)
+	multi, ok := res.(*example22.UpdatePetsResult207)
+	require.True(t, ok)
+	require.NotNil(t, multi.Body.Statuses)
+	assert.Len(t, *multi.Body.Statuses, 2)
+}
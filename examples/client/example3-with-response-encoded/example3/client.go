@@ -22,6 +22,7 @@ func NewClient(apiClient runtime.APIClient) *Client {
 
 // NewDefaultClient creates a new instance of the Client client with default api client.
 func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithUserAgent("Generate-models/1.0.0 oapi-codegen-dd/v3.63.4")}, opts...)
 	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("error creating API client: %w", err)
@@ -49,21 +50,23 @@ func (c *Client) GetUserSingle(ctx context.Context, reqEditors ...runtime.Reques
 
 	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
 	if err != nil {
-		return nil, fmt.Errorf("error creating request: %w", err)
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
 	}
 
 	responseParser := func(ctx context.Context, resp *runtime.Response) (*GetUserSingleResponse, error) {
 		bodyBytes := resp.Content
 		if resp.StatusCode != 200 {
-			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+			return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
 				runtime.WithStatusCode(resp.StatusCode))
 		}
 		target := new(GetUserSingleResponse)
 
 		bodyBytes, err = runtime.ConvertFormFields(bodyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
 		if err = json.Unmarshal(bodyBytes, target); err != nil {
-			err = fmt.Errorf("error decoding response: %w", err)
-			return nil, err
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
 		}
 		return target, nil
 	}
@@ -84,21 +87,23 @@ func (c *Client) GetUserUnion1(ctx context.Context, reqEditors ...runtime.Reques
 
 	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
 	if err != nil {
-		return nil, fmt.Errorf("error creating request: %w", err)
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
 	}
 
 	responseParser := func(ctx context.Context, resp *runtime.Response) (*GetUserUnion1Response, error) {
 		bodyBytes := resp.Content
 		if resp.StatusCode != 200 {
-			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+			return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
 				runtime.WithStatusCode(resp.StatusCode))
 		}
 		target := new(GetUserUnion1Response)
 
 		bodyBytes, err = runtime.ConvertFormFields(bodyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
 		if err = json.Unmarshal(bodyBytes, target); err != nil {
-			err = fmt.Errorf("error decoding response: %w", err)
-			return nil, err
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
 		}
 		return target, nil
 	}
@@ -119,21 +124,23 @@ func (c *Client) GetUserUnion2(ctx context.Context, reqEditors ...runtime.Reques
 
 	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
 	if err != nil {
-		return nil, fmt.Errorf("error creating request: %w", err)
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
 	}
 
 	responseParser := func(ctx context.Context, resp *runtime.Response) (*GetUserUnion2Response, error) {
 		bodyBytes := resp.Content
 		if resp.StatusCode != 200 {
-			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+			return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
 				runtime.WithStatusCode(resp.StatusCode))
 		}
 		target := new(GetUserUnion2Response)
 
 		bodyBytes, err = runtime.ConvertFormFields(bodyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
 		if err = json.Unmarshal(bodyBytes, target); err != nil {
-			err = fmt.Errorf("error decoding response: %w", err)
-			return nil, err
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
 		}
 		return target, nil
 	}
@@ -154,21 +161,23 @@ func (c *Client) GetUserUnion3(ctx context.Context, reqEditors ...runtime.Reques
 
 	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
 	if err != nil {
-		return nil, fmt.Errorf("error creating request: %w", err)
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
 	}
 
 	responseParser := func(ctx context.Context, resp *runtime.Response) (*GetUserUnion3Response, error) {
 		bodyBytes := resp.Content
 		if resp.StatusCode != 200 {
-			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+			return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
 				runtime.WithStatusCode(resp.StatusCode))
 		}
 		target := new(GetUserUnion3Response)
 
 		bodyBytes, err = runtime.ConvertFormFields(bodyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
 		if err = json.Unmarshal(bodyBytes, target); err != nil {
-			err = fmt.Errorf("error decoding response: %w", err)
-			return nil, err
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
 		}
 		return target, nil
 	}
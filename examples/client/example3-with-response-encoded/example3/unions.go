@@ -26,6 +26,22 @@ func (g *GetUserUnion2_Response_OneOf) Validate() error {
 	return nil
 }
 
+// UnmarshalJSON holds the variant whose required properties best match the data,
+// or a *runtime.AmbiguousUnionError if several match equally well.
+func (g *GetUserUnion2_Response_OneOf) UnmarshalJSON(data []byte) error {
+	n, err := runtime.MatchRequiredFields(data,
+		runtime.UnionVariant{Name: "User", Required: []string{"id"}, Properties: []string{"id", "name", "address"}},
+		runtime.UnionVariant{Name: "string"},
+	)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return g.Either.UnmarshalJSON(data)
+	}
+	return g.UnmarshalVariant(n, data)
+}
+
 type GetUserUnion3_Response_OneOf struct {
 	union json.RawMessage
 }
@@ -72,6 +88,20 @@ func (g *GetUserUnion3_Response_OneOf) FromUser(val User) error {
 	return err
 }
 
+// MergeUser merges the provided User into the union data inside the GetUserUnion3_Response_OneOf
+func (g *GetUserUnion3_Response_OneOf) MergeUser(val User) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(g.union, bts)
+	if err != nil {
+		return err
+	}
+	g.union = merged
+	return nil
+}
+
 // AsString returns the union data inside the GetUserUnion3_Response_OneOf as a string
 func (g *GetUserUnion3_Response_OneOf) AsString() (string, error) {
 	return runtime.UnmarshalAs[string](g.union)
@@ -102,6 +132,20 @@ func (g *GetUserUnion3_Response_OneOf) FromString(val string) error {
 	return err
 }
 
+// MergeString merges the provided string into the union data inside the GetUserUnion3_Response_OneOf
+func (g *GetUserUnion3_Response_OneOf) MergeString(val string) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(g.union, bts)
+	if err != nil {
+		return err
+	}
+	g.union = merged
+	return nil
+}
+
 // AsInt returns the union data inside the GetUserUnion3_Response_OneOf as a int
 func (g *GetUserUnion3_Response_OneOf) AsInt() (int, error) {
 	return runtime.UnmarshalAs[int](g.union)
@@ -132,6 +176,20 @@ func (g *GetUserUnion3_Response_OneOf) FromInt(val int) error {
 	return err
 }
 
+// MergeInt merges the provided int into the union data inside the GetUserUnion3_Response_OneOf
+func (g *GetUserUnion3_Response_OneOf) MergeInt(val int) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(g.union, bts)
+	if err != nil {
+		return err
+	}
+	g.union = merged
+	return nil
+}
+
 // validateUser validates a User value
 func (g *GetUserUnion3_Response_OneOf) validateUser(val User) error {
 	if v, ok := any(val).(runtime.Validator); ok {
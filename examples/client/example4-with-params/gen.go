@@ -23,6 +23,7 @@ func NewClient(apiClient runtime.APIClient) *Client {
 
 // NewDefaultClient creates a new instance of the Client client with default api client.
 func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithUserAgent("Generate-models/1.0.0 oapi-codegen-dd/v3.63.4")}, opts...)
 	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("error creating API client: %w", err)
@@ -44,25 +45,26 @@ func (c *Client) GetOrder(ctx context.Context, options *GetOrderRequestOptions,
 	reqParams := runtime.RequestOptionsParameters{
 		RequestURL:    c.apiClient.GetBaseURL() + "/order/{id}",
 		Method:        "GET",
-		Options:       options,
 		QueryEncoding: queryEncoding,
 	}
+	if options != nil {
+		reqParams.Options = options
+	}
 
 	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
 	if err != nil {
-		return nil, fmt.Errorf("error creating request: %w", err)
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
 	}
 
 	responseParser := func(ctx context.Context, resp *runtime.Response) (*GetOrderResponse, error) {
 		bodyBytes := resp.Content
 		if resp.StatusCode != 200 {
-			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+			return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
 				runtime.WithStatusCode(resp.StatusCode))
 		}
 		target := new(GetOrderResponse)
 		if err = json.Unmarshal(bodyBytes, target); err != nil {
-			err = fmt.Errorf("error decoding response: %w", err)
-			return nil, err
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
 		}
 		return target, nil
 	}
@@ -119,7 +121,8 @@ func (o *GetOrderRequestOptions) GetQuery() (map[string]any, error) {
 	return runtime.AsMap[any](o.Query)
 }
 
-// GetBody returns the payload in any type that can be marshalled to JSON by the client.
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
 func (o *GetOrderRequestOptions) GetBody() any {
 	return nil
 }
@@ -149,4 +152,5 @@ var typesValidator *validator.Validate
 func init() {
 	typesValidator = validator.New(validator.WithRequiredStructEnabled())
 	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
 }
@@ -0,0 +1,58 @@
+package example4_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	example4 "github.com/doordash-oss/oapi-codegen-dd/v3/examples/client/example4-with-params"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// httpClientAdapter wraps http.Client to implement runtime.HttpRequestDoer
+type httpClientAdapter struct {
+	client *http.Client
+}
+
+func (a *httpClientAdapter) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return a.client.Do(req.WithContext(ctx))
+}
+
+func TestRequestValidation(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client, err := example4.NewDefaultClient(server.URL,
+		runtime.WithHTTPClient(&httpClientAdapter{client: server.Client()}),
+		runtime.WithRequestValidation(),
+	)
+	require.NoError(t, err)
+
+	t.Run("invalid request is not sent", func(t *testing.T) {
+		_, err := client.GetOrder(context.Background(), &example4.GetOrderRequestOptions{
+			PathParams: &example4.GetOrderPath{},
+		})
+
+		var validationErrs runtime.ValidationErrors
+		require.ErrorAs(t, err, &validationErrs)
+		assert.Equal(t, "PathParams.ID", validationErrs[0].Field)
+		assert.Equal(t, 0, calls)
+	})
+
+	t.Run("valid request is sent", func(t *testing.T) {
+		_, err := client.GetOrder(context.Background(), &example4.GetOrderRequestOptions{
+			PathParams: &example4.GetOrderPath{ID: "ord_123"},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, calls)
+	})
+}
@@ -0,0 +1,583 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package example6
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/go-playground/validator/v10"
+)
+
+// Client is the client for the API implementing the Client interface.
+type Client struct {
+	apiClient runtime.APIClient
+}
+
+// NewClient creates a new instance of the Client client.
+func NewClient(apiClient runtime.APIClient) *Client {
+	return &Client{apiClient: apiClient}
+}
+
+// NewDefaultClient creates a new instance of the Client client with default api client.
+func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithUserAgent("Pet-Store/1.0.0 oapi-codegen-dd/v3.63.4")}, opts...)
+	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API client: %w", err)
+	}
+	return &Client{apiClient: apiClient}, nil
+}
+
+// ClientInterface is the interface for the API client.
+type ClientInterface interface {
+	GetPet(ctx context.Context, options *GetPetRequestOptions, reqEditors ...runtime.RequestEditorFn) (*GetPetResponse, error)
+	GetPetResult(ctx context.Context, options *GetPetRequestOptions, reqEditors ...runtime.RequestEditorFn) (GetPetResult, error)
+
+	DeletePet(ctx context.Context, options *DeletePetRequestOptions, reqEditors ...runtime.RequestEditorFn) (*struct{}, error)
+
+	CreatePet(ctx context.Context, options *CreatePetRequestOptions, reqEditors ...runtime.RequestEditorFn) (*CreatePetResponse, error)
+	CreatePetResult(ctx context.Context, options *CreatePetRequestOptions, reqEditors ...runtime.RequestEditorFn) (CreatePetResult, error)
+}
+
+func (c *Client) GetPet(ctx context.Context, options *GetPetRequestOptions, reqEditors ...runtime.RequestEditorFn) (*GetPetResponse, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/pets/{id}",
+		Method:     "GET",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*GetPetResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			target := new(GetPetErrorResponse)
+			err = json.Unmarshal(bodyBytes, target)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+			}
+
+			if errTarget, ok := any(*target).(error); ok {
+				return nil, runtime.NewClientAPIError(errTarget, runtime.WithStatusCode(resp.StatusCode))
+			}
+			return nil, runtime.NewClientAPIError(fmt.Errorf("API error (status %d): %v", resp.StatusCode, *target),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(GetPetResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/pets/{id}")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+// GetPetResult is implemented by every response of GetPet.
+// Use Visit with a GetPetResultVisitor to handle all of them.
+type GetPetResult interface {
+	StatusCode() int
+	Visit(v GetPetResultVisitor) error
+	isGetPetResult()
+}
+
+// GetPetResultVisitor handles every response of GetPet.
+// A new status code in the spec adds a method, so the compiler flags visitors that don't handle it.
+type GetPetResultVisitor interface {
+	Visit200(res *GetPetResult200) error
+	Visit404(res *GetPetResult404) error
+	Visit500(res *GetPetResult500) error
+}
+
+// GetPetResult200 is the 200 response of GetPet.
+type GetPetResult200 struct {
+	Body    *GetPetResponse
+	Headers http.Header
+}
+
+func (r *GetPetResult200) StatusCode() int {
+	return 200
+}
+
+func (r *GetPetResult200) Visit(v GetPetResultVisitor) error {
+	return v.Visit200(r)
+}
+
+func (r *GetPetResult200) isGetPetResult() {}
+
+// GetPetResult404 is the 404 response of GetPet.
+type GetPetResult404 struct {
+	Body    *GetPetErrorResponse
+	Headers http.Header
+}
+
+func (r *GetPetResult404) StatusCode() int {
+	return 404
+}
+
+func (r *GetPetResult404) Visit(v GetPetResultVisitor) error {
+	return v.Visit404(r)
+}
+
+func (r *GetPetResult404) isGetPetResult() {}
+
+// GetPetResult500 is the 500 response of GetPet.
+type GetPetResult500 struct {
+	Body    *GetPetErrorResponseJSON
+	Headers http.Header
+}
+
+func (r *GetPetResult500) StatusCode() int {
+	return 500
+}
+
+func (r *GetPetResult500) Visit(v GetPetResultVisitor) error {
+	return v.Visit500(r)
+}
+
+func (r *GetPetResult500) isGetPetResult() {}
+
+// GetPetResult calls GetPet and returns the response matching the status code as GetPetResult.
+// Status codes that are not in the spec are returned as runtime.ClientAPIError.
+func (c *Client) GetPetResult(ctx context.Context, options *GetPetRequestOptions, reqEditors ...runtime.RequestEditorFn) (GetPetResult, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/pets/{id}",
+		Method:     "GET",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/pets/{id}")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	switch {
+	case resp.StatusCode == 200:
+		res := &GetPetResult200{Headers: resp.Headers}
+		bodyBytes := resp.Content
+		res.Body = new(GetPetResponse)
+		if err = json.Unmarshal(bodyBytes, res.Body); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return res, nil
+	case resp.StatusCode == 404:
+		res := &GetPetResult404{Headers: resp.Headers}
+		bodyBytes := resp.Content
+		res.Body = new(GetPetErrorResponse)
+		if err = json.Unmarshal(bodyBytes, res.Body); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return res, nil
+	case resp.StatusCode == 500:
+		res := &GetPetResult500{Headers: resp.Headers}
+		bodyBytes := resp.Content
+		res.Body = new(GetPetErrorResponseJSON)
+		if err = json.Unmarshal(bodyBytes, res.Body); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return res, nil
+	}
+
+	return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
+		runtime.WithStatusCode(resp.StatusCode))
+}
+
+func (c *Client) DeletePet(ctx context.Context, options *DeletePetRequestOptions, reqEditors ...runtime.RequestEditorFn) (*struct{}, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/pets/{id}",
+		Method:     "DELETE",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*struct{}, error) {
+		if resp.StatusCode != 204 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		return nil, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/pets/{id}")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+func (c *Client) CreatePet(ctx context.Context, options *CreatePetRequestOptions, reqEditors ...runtime.RequestEditorFn) (*CreatePetResponse, error) {
+	var err error
+	if options == nil || options.Body == nil {
+		return nil, fmt.Errorf("error creating request body: %w", runtime.ErrMissingValue)
+	}
+	if options != nil && options.Body != nil {
+		if v, ok := any(options.Body).(runtime.Validator); ok {
+			if err = v.Validate(); err != nil {
+				return nil, fmt.Errorf("error validating request body: %w", err)
+			}
+		}
+	}
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL:  c.apiClient.GetBaseURL() + "/pets",
+		Method:      "POST",
+		ContentType: "application/json",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*CreatePetResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 201 {
+			target := new(CreatePetErrorResponse)
+			err = json.Unmarshal(bodyBytes, target)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+			}
+
+			if errTarget, ok := any(*target).(error); ok {
+				return nil, runtime.NewClientAPIError(errTarget, runtime.WithStatusCode(resp.StatusCode))
+			}
+			return nil, runtime.NewClientAPIError(fmt.Errorf("API error (status %d): %v", resp.StatusCode, *target),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(CreatePetResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/pets")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+// CreatePetResult is implemented by every response of CreatePet.
+// Use Visit with a CreatePetResultVisitor to handle all of them.
+type CreatePetResult interface {
+	StatusCode() int
+	Visit(v CreatePetResultVisitor) error
+	isCreatePetResult()
+}
+
+// CreatePetResultVisitor handles every response of CreatePet.
+// A new status code in the spec adds a method, so the compiler flags visitors that don't handle it.
+type CreatePetResultVisitor interface {
+	Visit201(res *CreatePetResult201) error
+	VisitDefault(res *CreatePetResultDefault) error
+}
+
+// CreatePetResult201 is the 201 response of CreatePet.
+type CreatePetResult201 struct {
+	Body    *CreatePetResponse
+	Headers http.Header
+}
+
+func (r *CreatePetResult201) StatusCode() int {
+	return 201
+}
+
+func (r *CreatePetResult201) Visit(v CreatePetResultVisitor) error {
+	return v.Visit201(r)
+}
+
+func (r *CreatePetResult201) isCreatePetResult() {}
+
+// CreatePetResultDefault is the default response of CreatePet,
+// for status codes without a response of their own.
+type CreatePetResultDefault struct {
+	Body    *CreatePetErrorResponse
+	Headers http.Header
+	Status  int
+}
+
+func (r *CreatePetResultDefault) StatusCode() int {
+	return r.Status
+}
+
+func (r *CreatePetResultDefault) Visit(v CreatePetResultVisitor) error {
+	return v.VisitDefault(r)
+}
+
+func (r *CreatePetResultDefault) isCreatePetResult() {}
+
+// CreatePetResult calls CreatePet and returns the response matching the status code as CreatePetResult.
+func (c *Client) CreatePetResult(ctx context.Context, options *CreatePetRequestOptions, reqEditors ...runtime.RequestEditorFn) (CreatePetResult, error) {
+	var err error
+	if options == nil || options.Body == nil {
+		return nil, fmt.Errorf("error creating request body: %w", runtime.ErrMissingValue)
+	}
+	if options != nil && options.Body != nil {
+		if v, ok := any(options.Body).(runtime.Validator); ok {
+			if err = v.Validate(); err != nil {
+				return nil, fmt.Errorf("error validating request body: %w", err)
+			}
+		}
+	}
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL:  c.apiClient.GetBaseURL() + "/pets",
+		Method:      "POST",
+		ContentType: "application/json",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/pets")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	switch {
+	case resp.StatusCode == 201:
+		res := &CreatePetResult201{Headers: resp.Headers}
+		bodyBytes := resp.Content
+		res.Body = new(CreatePetResponse)
+		if err = json.Unmarshal(bodyBytes, res.Body); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return res, nil
+	default:
+		res := &CreatePetResultDefault{Headers: resp.Headers, Status: resp.StatusCode}
+		bodyBytes := resp.Content
+		res.Body = new(CreatePetErrorResponse)
+		if err = json.Unmarshal(bodyBytes, res.Body); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return res, nil
+	}
+}
+
+var _ ClientInterface = (*Client)(nil)
+
+// GetPetRequestOptions is the options needed to make a request to GetPet.
+type GetPetRequestOptions struct {
+	PathParams *GetPetPath
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *GetPetRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *GetPetRequestOptions) GetPathParams() (map[string]any, error) {
+	return runtime.AsMap[any](o.PathParams)
+}
+
+// GetQuery returns the query params as a map.
+func (o *GetPetRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
+func (o *GetPetRequestOptions) GetBody() any {
+	return nil
+}
+
+// GetHeader returns the headers as a map.
+func (o *GetPetRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+// DeletePetRequestOptions is the options needed to make a request to DeletePet.
+type DeletePetRequestOptions struct {
+	PathParams *DeletePetPath
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *DeletePetRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *DeletePetRequestOptions) GetPathParams() (map[string]any, error) {
+	return runtime.AsMap[any](o.PathParams)
+}
+
+// GetQuery returns the query params as a map.
+func (o *DeletePetRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
+func (o *DeletePetRequestOptions) GetBody() any {
+	return nil
+}
+
+// GetHeader returns the headers as a map.
+func (o *DeletePetRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+// CreatePetRequestOptions is the options needed to make a request to CreatePet.
+type CreatePetRequestOptions struct {
+	Body *CreatePetBody
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *CreatePetRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *CreatePetRequestOptions) GetPathParams() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetQuery returns the query params as a map.
+func (o *CreatePetRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
+func (o *CreatePetRequestOptions) GetBody() any {
+	if o.Body == nil {
+		return nil
+	}
+	return o.Body
+}
+
+// GetHeader returns the headers as a map.
+func (o *CreatePetRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+type GetPetPath struct {
+	ID string `json:"id" validate:"required"`
+}
+
+func (g GetPetPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(g))
+}
+
+type DeletePetPath struct {
+	ID string `json:"id" validate:"required"`
+}
+
+func (d DeletePetPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(d))
+}
+
+type CreatePetBody = Pet
+
+type GetPetResponse = Pet
+
+type GetPetErrorResponse = Error
+
+type GetPetErrorResponseJSON = Error
+
+type CreatePetResponse = Pet
+
+type CreatePetErrorResponse = Error
+
+type Pet struct {
+	Name string `json:"name" validate:"required"`
+}
+
+func (p Pet) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(p))
+}
+
+type Error struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (s Error) Error() string {
+	return "unmapped client error"
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
+}
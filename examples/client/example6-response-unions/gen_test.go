@@ -0,0 +1,130 @@
+package example6_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	example6 "github.com/doordash-oss/oapi-codegen-dd/v3/examples/client/example6-response-unions"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// httpClientAdapter wraps http.Client to implement runtime.HttpRequestDoer
+type httpClientAdapter struct {
+	client *http.Client
+}
+
+func (a *httpClientAdapter) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return a.client.Do(req.WithContext(ctx))
+}
+
+// petVisitor must implement a method for every status code of getPet.
+type petVisitor struct {
+	got string
+}
+
+func (v *petVisitor) Visit200(res *example6.GetPetResult200) error {
+	v.got = "pet " + res.Body.Name
+	return nil
+}
+
+func (v *petVisitor) Visit404(res *example6.GetPetResult404) error {
+	v.got = "not found: " + *res.Body.Message
+	return nil
+}
+
+func (v *petVisitor) Visit500(res *example6.GetPetResult500) error {
+	return errors.New(*res.Body.Message)
+}
+
+var _ example6.GetPetResultVisitor = (*petVisitor)(nil)
+
+func newClient(t *testing.T, status int, body string) *example6.Client {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	apiClient, err := runtime.NewAPIClient(server.URL, runtime.WithHTTPClient(&httpClientAdapter{client: server.Client()}))
+	require.NoError(t, err)
+	return example6.NewClient(apiClient)
+}
+
+func TestGetPetResult(t *testing.T) {
+	opts := &example6.GetPetRequestOptions{PathParams: &example6.GetPetPath{ID: "1"}}
+
+	t.Run("visits the matching status code", func(t *testing.T) {
+		tests := []struct {
+			status   int
+			body     string
+			expected string
+		}{
+			{status: http.StatusOK, body: `{"name":"Rex"}`, expected: "pet Rex"},
+			{status: http.StatusNotFound, body: `{"message":"no such pet"}`, expected: "not found: no such pet"},
+		}
+
+		for _, tt := range tests {
+			res, err := newClient(t, tt.status, tt.body).GetPetResult(context.Background(), opts)
+			require.NoError(t, err)
+			assert.Equal(t, tt.status, res.StatusCode())
+
+			v := &petVisitor{}
+			require.NoError(t, res.Visit(v))
+			assert.Equal(t, tt.expected, v.got)
+		}
+	})
+
+	t.Run("visitor error is returned", func(t *testing.T) {
+		res, err := newClient(t, http.StatusInternalServerError, `{"message":"boom"}`).GetPetResult(context.Background(), opts)
+		require.NoError(t, err)
+
+		_, ok := res.(*example6.GetPetResult500)
+		assert.True(t, ok)
+		assert.EqualError(t, res.Visit(&petVisitor{}), "boom")
+	})
+
+	t.Run("unknown status code", func(t *testing.T) {
+		_, err := newClient(t, http.StatusTeapot, `{}`).GetPetResult(context.Background(), opts)
+
+		var apiErr *runtime.ClientAPIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusTeapot, apiErr.StatusCode())
+		assert.ErrorIs(t, err, runtime.ErrUnexpectedStatus)
+		assert.EqualError(t, err, "unexpected status code: 418")
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		_, err := newClient(t, http.StatusOK, `{"id":`).GetPetResult(context.Background(), opts)
+		assert.ErrorIs(t, err, runtime.ErrDecodeResponse)
+	})
+}
+
+func TestCreatePetResult(t *testing.T) {
+	opts := &example6.CreatePetRequestOptions{Body: &example6.CreatePetBody{Name: "Rex"}}
+
+	t.Run("created", func(t *testing.T) {
+		res, err := newClient(t, http.StatusCreated, `{"name":"Rex"}`).CreatePetResult(context.Background(), opts)
+		require.NoError(t, err)
+
+		created, ok := res.(*example6.CreatePetResult201)
+		require.True(t, ok)
+		assert.Equal(t, "Rex", created.Body.Name)
+	})
+
+	t.Run("default response matches other status codes", func(t *testing.T) {
+		res, err := newClient(t, http.StatusServiceUnavailable, `{"message":"try later"}`).CreatePetResult(context.Background(), opts)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, res.StatusCode())
+
+		failed, ok := res.(*example6.CreatePetResultDefault)
+		require.True(t, ok)
+		assert.Equal(t, "try later", *failed.Body.Message)
+	})
+}
@@ -0,0 +1,331 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package example7
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/go-playground/validator/v10"
+)
+
+// Client is the client for the API implementing the Client interface.
+type Client struct {
+	apiClient runtime.APIClient
+}
+
+// NewClient creates a new instance of the Client client.
+func NewClient(apiClient runtime.APIClient) *Client {
+	return &Client{apiClient: apiClient}
+}
+
+// NewDefaultClient creates a new instance of the Client client with default api client.
+func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithUserAgent("Orders/1.0.0 oapi-codegen-dd/v3.63.4")}, opts...)
+	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API client: %w", err)
+	}
+	return &Client{apiClient: apiClient}, nil
+}
+
+// ClientInterface is the interface for the API client.
+type ClientInterface interface {
+	CreateOrder(ctx context.Context, options *CreateOrderRequestOptions, reqEditors ...runtime.RequestEditorFn) (*CreateOrderResponse, error)
+	CreateOrderRequestHash(ctx context.Context, options *CreateOrderRequestOptions, reqEditors ...runtime.RequestEditorFn) (string, error)
+
+	ListOrders(ctx context.Context, options *ListOrdersRequestOptions, reqEditors ...runtime.RequestEditorFn) (*ListOrdersResponse, error)
+}
+
+func (c *Client) CreateOrder(ctx context.Context, options *CreateOrderRequestOptions, reqEditors ...runtime.RequestEditorFn) (*CreateOrderResponse, error) {
+	var err error
+	if options == nil || options.Body == nil {
+		return nil, fmt.Errorf("error creating request body: %w", runtime.ErrMissingValue)
+	}
+	if options != nil && options.Body != nil {
+		if v, ok := any(options.Body).(runtime.Validator); ok {
+			if err = v.Validate(); err != nil {
+				return nil, fmt.Errorf("error validating request body: %w", err)
+			}
+		}
+	}
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL:           c.apiClient.GetBaseURL() + "/stores/{storeId}/orders",
+		Method:               "POST",
+		ContentType:          "application/json",
+		IdempotencyKeyHeader: "Idempotency-Key",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*CreateOrderResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 201 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(CreateOrderResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/stores/{storeId}/orders")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+// CreateOrderRequestHash builds the CreateOrder request without sending it and returns its canonical hash, see runtime.HashRequest.
+// Requests with the same hash are duplicate submissions, which callers and middleware can suppress.
+func (c *Client) CreateOrderRequestHash(ctx context.Context, options *CreateOrderRequestOptions, reqEditors ...runtime.RequestEditorFn) (string, error) {
+	var err error
+	if options == nil || options.Body == nil {
+		return "", fmt.Errorf("error creating request body: %w", runtime.ErrMissingValue)
+	}
+	if options != nil && options.Body != nil {
+		if v, ok := any(options.Body).(runtime.Validator); ok {
+			if err = v.Validate(); err != nil {
+				return "", fmt.Errorf("error validating request body: %w", err)
+			}
+		}
+	}
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL:           c.apiClient.GetBaseURL() + "/stores/{storeId}/orders",
+		Method:               "POST",
+		ContentType:          "application/json",
+		IdempotencyKeyHeader: "Idempotency-Key",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	return runtime.HashRequest(req, "Idempotency-Key")
+}
+
+func (c *Client) ListOrders(ctx context.Context, options *ListOrdersRequestOptions, reqEditors ...runtime.RequestEditorFn) (*ListOrdersResponse, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/stores/{storeId}/orders",
+		Method:     "GET",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*ListOrdersResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(ListOrdersResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/stores/{storeId}/orders")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+var _ ClientInterface = (*Client)(nil)
+
+// CreateOrderRequestOptions is the options needed to make a request to CreateOrder.
+type CreateOrderRequestOptions struct {
+	PathParams *CreateOrderPath
+	Query      *CreateOrderQuery
+	Body       *CreateOrderBody
+	Header     *CreateOrderHeaders
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *CreateOrderRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+
+	if o.Query != nil {
+		if v, ok := any(o.Query).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Query", err)
+			}
+		}
+	}
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+
+	if o.Header != nil {
+		if v, ok := any(o.Header).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Header", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *CreateOrderRequestOptions) GetPathParams() (map[string]any, error) {
+	return runtime.AsMap[any](o.PathParams)
+}
+
+// GetQuery returns the query params as a map.
+func (o *CreateOrderRequestOptions) GetQuery() (map[string]any, error) {
+	return runtime.AsMap[any](o.Query)
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
+func (o *CreateOrderRequestOptions) GetBody() any {
+	if o.Body == nil {
+		return nil
+	}
+	return o.Body
+}
+
+// GetHeader returns the headers as a map.
+func (o *CreateOrderRequestOptions) GetHeader() (map[string]string, error) {
+	return runtime.AsMap[string](o.Header)
+}
+
+// ListOrdersRequestOptions is the options needed to make a request to ListOrders.
+type ListOrdersRequestOptions struct {
+	PathParams *ListOrdersPath
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *ListOrdersRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *ListOrdersRequestOptions) GetPathParams() (map[string]any, error) {
+	return runtime.AsMap[any](o.PathParams)
+}
+
+// GetQuery returns the query params as a map.
+func (o *ListOrdersRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
+func (o *ListOrdersRequestOptions) GetBody() any {
+	return nil
+}
+
+// GetHeader returns the headers as a map.
+func (o *ListOrdersRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+type CreateOrderHeaders struct {
+	XChannel *string `json:"X-Channel,omitempty"`
+}
+
+type CreateOrderPath struct {
+	StoreID string `json:"storeId" validate:"required"`
+}
+
+func (c CreateOrderPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(c))
+}
+
+type ListOrdersPath struct {
+	StoreID string `json:"storeId" validate:"required"`
+}
+
+func (l ListOrdersPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(l))
+}
+
+type CreateOrderBody = NewOrder
+
+type CreateOrderQuery struct {
+	Priority *string `json:"priority,omitempty"`
+}
+
+type CreateOrderResponse = Order
+
+type ListOrdersResponse []Order
+
+type NewOrder struct {
+	Item     string `json:"item" validate:"required"`
+	Quantity int    `json:"quantity" validate:"required"`
+}
+
+func (n NewOrder) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(n))
+}
+
+type Order struct {
+	ID string `json:"id" validate:"required"`
+}
+
+func (o Order) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(o))
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
+}
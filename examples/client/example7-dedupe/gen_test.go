@@ -0,0 +1,113 @@
+package example7_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	example7 "github.com/doordash-oss/oapi-codegen-dd/v3/examples/client/example7-dedupe"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// dedupeDoer sends each distinct request once and rejects duplicates, as a deduplicating middleware would.
+type dedupeDoer struct {
+	client *http.Client
+
+	mu     sync.Mutex
+	seen   map[string]bool
+	hashes []string
+}
+
+func (d *dedupeDoer) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	hash, err := runtime.HashRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	d.mu.Lock()
+	duplicate := d.seen[hash]
+	d.seen[hash] = true
+	d.hashes = append(d.hashes, hash)
+	d.mu.Unlock()
+
+	if duplicate {
+		rec := httptest.NewRecorder()
+		rec.WriteHeader(http.StatusConflict)
+		return rec.Result(), nil
+	}
+	return d.client.Do(req.WithContext(ctx))
+}
+
+func newClient(t *testing.T) (*example7.Client, *dedupeDoer, *int) {
+	t.Helper()
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":"order-1"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	doer := &dedupeDoer{client: server.Client(), seen: map[string]bool{}}
+	apiClient, err := runtime.NewAPIClient(server.URL, runtime.WithHTTPClient(doer))
+	require.NoError(t, err)
+	return example7.NewClient(apiClient), doer, &calls
+}
+
+func newOptions(quantity int) *example7.CreateOrderRequestOptions {
+	return &example7.CreateOrderRequestOptions{
+		PathParams: &example7.CreateOrderPath{StoreID: "store-1"},
+		Query:      &example7.CreateOrderQuery{Priority: runtime.Ptr("high")},
+		Header:     &example7.CreateOrderHeaders{XChannel: runtime.Ptr("web")},
+		Body:       &example7.CreateOrderBody{Item: "coffee", Quantity: quantity},
+	}
+}
+
+func TestCreateOrderRequestHash(t *testing.T) {
+	client, doer, calls := newClient(t)
+	ctx := context.Background()
+
+	hash, err := client.CreateOrderRequestHash(ctx, newOptions(2))
+	require.NoError(t, err)
+
+	again, err := client.CreateOrderRequestHash(ctx, newOptions(2))
+	require.NoError(t, err)
+	assert.Equal(t, hash, again, "the generated idempotency key is not part of the hash")
+
+	other, err := client.CreateOrderRequestHash(ctx, newOptions(3))
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, other)
+
+	t.Run("matches the sent request", func(t *testing.T) {
+		res, err := client.CreateOrder(ctx, newOptions(2))
+		require.NoError(t, err)
+		assert.Equal(t, "order-1", res.ID)
+		require.Len(t, doer.hashes, 1)
+		assert.Equal(t, hash, doer.hashes[0])
+	})
+
+	t.Run("duplicate is suppressed", func(t *testing.T) {
+		_, err := client.CreateOrder(ctx, newOptions(2))
+		var apiErr *runtime.ClientAPIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusConflict, apiErr.StatusCode())
+		assert.Equal(t, 1, *calls)
+
+		_, err = client.CreateOrder(ctx, newOptions(3))
+		require.NoError(t, err)
+		assert.Equal(t, 2, *calls)
+	})
+
+	t.Run("invalid options", func(t *testing.T) {
+		_, err := client.CreateOrderRequestHash(ctx, &example7.CreateOrderRequestOptions{
+			PathParams: &example7.CreateOrderPath{StoreID: "store-1"},
+			Body:       &example7.CreateOrderBody{Item: "coffee"},
+		})
+		require.Error(t, err)
+	})
+}
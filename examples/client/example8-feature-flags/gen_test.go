@@ -0,0 +1,102 @@
+package example8_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	example8 "github.com/doordash-oss/oapi-codegen-dd/v3/examples/client/example8-feature-flags"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// httpClientAdapter wraps http.Client to implement runtime.HttpRequestDoer
+type httpClientAdapter struct {
+	client *http.Client
+}
+
+func (a *httpClientAdapter) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return a.client.Do(req.WithContext(ctx))
+}
+
+// flags is a FlagChecker backed by a map, shared by the client and the server.
+type flags map[string]bool
+
+func (f flags) IsEnabled(_ context.Context, flag string) bool {
+	return f[flag]
+}
+
+func newServer(t *testing.T, checker runtime.FlagChecker) (*httptest.Server, *int) {
+	t.Helper()
+	calls := 0
+	mux := http.NewServeMux()
+	checkout := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.Handle("POST /checkout", runtime.RequireFeatureFlag(checker, example8.CheckoutFeatureFlag, http.StatusNotFound)(checkout))
+	mux.HandleFunc("GET /cart", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"items":2}`))
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func newClient(t *testing.T, server *httptest.Server, opts ...runtime.APIClientOption) *example8.Client {
+	t.Helper()
+	opts = append([]runtime.APIClientOption{runtime.WithHTTPClient(&httpClientAdapter{client: server.Client()})}, opts...)
+	apiClient, err := runtime.NewAPIClient(server.URL, opts...)
+	require.NoError(t, err)
+	return example8.NewClient(apiClient)
+}
+
+func TestFeatureFlags(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, "new-checkout", example8.CheckoutFeatureFlag)
+
+	t.Run("enabled", func(t *testing.T) {
+		checker := flags{"new-checkout": true}
+		server, calls := newServer(t, checker)
+		client := newClient(t, server, runtime.WithFlagChecker(checker))
+
+		assert.True(t, client.CheckoutIsEnabled(ctx))
+		_, err := client.Checkout(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, *calls)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		checker := flags{}
+		server, calls := newServer(t, checker)
+		client := newClient(t, server, runtime.WithFlagChecker(checker))
+
+		assert.False(t, client.CheckoutIsEnabled(ctx))
+		_, err := client.Checkout(ctx)
+		require.ErrorIs(t, err, runtime.ErrFeatureDisabled)
+		var disabled *runtime.FeatureDisabledError
+		require.ErrorAs(t, err, &disabled)
+		assert.Equal(t, "new-checkout", disabled.Flag)
+		assert.Equal(t, 0, *calls, "the request is not sent")
+
+		cart, err := client.GetCart(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 2, *cart.Items)
+	})
+
+	t.Run("disabled on the server only", func(t *testing.T) {
+		server, calls := newServer(t, flags{})
+		client := newClient(t, server)
+
+		assert.True(t, client.CheckoutIsEnabled(ctx))
+		_, err := client.Checkout(ctx)
+		var apiErr *runtime.ClientAPIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusNotFound, apiErr.StatusCode())
+		assert.Equal(t, 0, *calls)
+	})
+}
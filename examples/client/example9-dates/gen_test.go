@@ -0,0 +1,58 @@
+package example9_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	example9 "github.com/doordash-oss/oapi-codegen-dd/v3/examples/client/example9-dates"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// httpClientAdapter wraps http.Client to implement runtime.HttpRequestDoer
+type httpClientAdapter struct {
+	client *http.Client
+}
+
+func (a *httpClientAdapter) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return a.client.Do(req.WithContext(ctx))
+}
+
+func TestGetReport(t *testing.T) {
+	var requestURI string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestURI = r.URL.RequestURI()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"day": "2025-04-01", "window": "P1DT6H"}`))
+	}))
+	defer server.Close()
+
+	client, err := example9.NewDefaultClient(server.URL,
+		runtime.WithHTTPClient(&httpClientAdapter{client: server.Client()}),
+	)
+	require.NoError(t, err)
+
+	res, err := client.GetReport(context.Background(), &example9.GetReportRequestOptions{
+		PathParams: &example9.GetReportPath{Day: runtime.Date{Time: time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)}},
+		Query:      &example9.GetReportQuery{Window: &runtime.Duration{Duration: 90 * time.Minute}},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "/reports/2025-04-01?window=PT1H30M", requestURI)
+	assert.Equal(t, "2025-04-01", res.Day.String())
+	assert.Equal(t, 30*time.Hour, res.Window.Duration)
+}
+
+func TestReport_UnmarshalJSON(t *testing.T) {
+	var report example9.Report
+	err := json.Unmarshal([]byte(`{"day": "2025-04-01", "window": "5 minutes"}`), &report)
+	assert.ErrorIs(t, err, runtime.ErrInvalidDuration)
+
+	err = json.Unmarshal([]byte(`{"day": "04/01/2025", "window": "PT5M"}`), &report)
+	assert.Error(t, err)
+}
@@ -28,6 +28,74 @@ func (e EmailActivityResponseCommonFieldsStatus) Validate() error {
 	}
 }
 
+// emailActivityResponseCommonFieldsStatusNames maps EmailActivityResponseCommonFieldsStatus values to their names.
+var emailActivityResponseCommonFieldsStatusNames = map[EmailActivityResponseCommonFieldsStatus]string{
+	Delivered:    "Delivered",
+	NotDelivered: "NotDelivered",
+	Processed:    "Processed",
+}
+
+// emailActivityResponseCommonFieldsStatusValues maps names to EmailActivityResponseCommonFieldsStatus values.
+var emailActivityResponseCommonFieldsStatusValues = map[string]EmailActivityResponseCommonFieldsStatus{
+	"Delivered":    Delivered,
+	"NotDelivered": NotDelivered,
+	"Processed":    Processed,
+}
+
+// String returns the wire value of the EmailActivityResponseCommonFieldsStatus.
+func (e EmailActivityResponseCommonFieldsStatus) String() string {
+	return string(e)
+}
+
+// IsValid reports whether the EmailActivityResponseCommonFieldsStatus value is defined in the spec.
+func (e EmailActivityResponseCommonFieldsStatus) IsValid() bool {
+	_, ok := emailActivityResponseCommonFieldsStatusNames[e]
+	return ok
+}
+
+// Values returns all the EmailActivityResponseCommonFieldsStatus values defined in the spec.
+func (EmailActivityResponseCommonFieldsStatus) Values() []EmailActivityResponseCommonFieldsStatus {
+	return []EmailActivityResponseCommonFieldsStatus{
+		Delivered,
+		NotDelivered,
+		Processed,
+	}
+}
+
+// EmailActivityResponseCommonFieldsStatusPtr returns a pointer to v, to set the optional and nullable EmailActivityResponseCommonFieldsStatus properties.
+func EmailActivityResponseCommonFieldsStatusPtr(v EmailActivityResponseCommonFieldsStatus) *EmailActivityResponseCommonFieldsStatus {
+	return &v
+}
+
+// Name returns the name of the EmailActivityResponseCommonFieldsStatus value, or an empty string for unknown values.
+func (e EmailActivityResponseCommonFieldsStatus) Name() string {
+	return emailActivityResponseCommonFieldsStatusNames[e]
+}
+
+// ParseEmailActivityResponseCommonFieldsStatus returns the EmailActivityResponseCommonFieldsStatus matching s by wire value or by name.
+func ParseEmailActivityResponseCommonFieldsStatus(s string) (EmailActivityResponseCommonFieldsStatus, error) {
+	if _, ok := emailActivityResponseCommonFieldsStatusNames[EmailActivityResponseCommonFieldsStatus(s)]; ok {
+		return EmailActivityResponseCommonFieldsStatus(s), nil
+	}
+	if v, ok := emailActivityResponseCommonFieldsStatusValues[s]; ok {
+		return v, nil
+	}
+	var zero EmailActivityResponseCommonFieldsStatus
+	return zero, fmt.Errorf("%w for EmailActivityResponseCommonFieldsStatus: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (e EmailActivityResponseCommonFieldsStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseEmailActivityResponseCommonFieldsStatus.
+// Unknown values are kept as-is and reported by Validate.
+func (e *EmailActivityResponseCommonFieldsStatus) UnmarshalText(text []byte) error {
+	*e = EmailActivityResponseCommonFieldsStatus(text)
+	return nil
+}
+
 // GetMsgIDResponseStatus0 Quick summary of the status of a message
 type GetMsgIDResponseStatus0 string
 
@@ -47,6 +115,74 @@ func (g GetMsgIDResponseStatus0) Validate() error {
 	}
 }
 
+// getMsgIDResponseStatus0Names maps GetMsgIDResponseStatus0 values to their names.
+var getMsgIDResponseStatus0Names = map[GetMsgIDResponseStatus0]string{
+	GetMsgIDResponseStatus0Delivered:    "Delivered",
+	GetMsgIDResponseStatus0NotDelivered: "NotDelivered",
+	GetMsgIDResponseStatus0Processed:    "Processed",
+}
+
+// getMsgIDResponseStatus0Values maps names to GetMsgIDResponseStatus0 values.
+var getMsgIDResponseStatus0Values = map[string]GetMsgIDResponseStatus0{
+	"Delivered":    GetMsgIDResponseStatus0Delivered,
+	"NotDelivered": GetMsgIDResponseStatus0NotDelivered,
+	"Processed":    GetMsgIDResponseStatus0Processed,
+}
+
+// String returns the wire value of the GetMsgIDResponseStatus0.
+func (g GetMsgIDResponseStatus0) String() string {
+	return string(g)
+}
+
+// IsValid reports whether the GetMsgIDResponseStatus0 value is defined in the spec.
+func (g GetMsgIDResponseStatus0) IsValid() bool {
+	_, ok := getMsgIDResponseStatus0Names[g]
+	return ok
+}
+
+// Values returns all the GetMsgIDResponseStatus0 values defined in the spec.
+func (GetMsgIDResponseStatus0) Values() []GetMsgIDResponseStatus0 {
+	return []GetMsgIDResponseStatus0{
+		GetMsgIDResponseStatus0Delivered,
+		GetMsgIDResponseStatus0NotDelivered,
+		GetMsgIDResponseStatus0Processed,
+	}
+}
+
+// GetMsgIDResponseStatus0Ptr returns a pointer to v, to set the optional and nullable GetMsgIDResponseStatus0 properties.
+func GetMsgIDResponseStatus0Ptr(v GetMsgIDResponseStatus0) *GetMsgIDResponseStatus0 {
+	return &v
+}
+
+// Name returns the name of the GetMsgIDResponseStatus0 value, or an empty string for unknown values.
+func (g GetMsgIDResponseStatus0) Name() string {
+	return getMsgIDResponseStatus0Names[g]
+}
+
+// ParseGetMsgIDResponseStatus0 returns the GetMsgIDResponseStatus0 matching s by wire value or by name.
+func ParseGetMsgIDResponseStatus0(s string) (GetMsgIDResponseStatus0, error) {
+	if _, ok := getMsgIDResponseStatus0Names[GetMsgIDResponseStatus0(s)]; ok {
+		return GetMsgIDResponseStatus0(s), nil
+	}
+	if v, ok := getMsgIDResponseStatus0Values[s]; ok {
+		return v, nil
+	}
+	var zero GetMsgIDResponseStatus0
+	return zero, fmt.Errorf("%w for GetMsgIDResponseStatus0: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (g GetMsgIDResponseStatus0) MarshalText() ([]byte, error) {
+	return []byte(g), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseGetMsgIDResponseStatus0.
+// Unknown values are kept as-is and reported by Validate.
+func (g *GetMsgIDResponseStatus0) UnmarshalText(text []byte) error {
+	*g = GetMsgIDResponseStatus0(text)
+	return nil
+}
+
 // GetMsgIDResponseStatus The message's status.
 type GetMsgIDResponseStatus string
 
@@ -66,6 +202,74 @@ func (g GetMsgIDResponseStatus) Validate() error {
 	}
 }
 
+// getMsgIDResponseStatusNames maps GetMsgIDResponseStatus values to their names.
+var getMsgIDResponseStatusNames = map[GetMsgIDResponseStatus]string{
+	GetMsgIDResponseStatusDelivered:    "Delivered",
+	GetMsgIDResponseStatusNotDelivered: "NotDelivered",
+	GetMsgIDResponseStatusProcessed:    "Processed",
+}
+
+// getMsgIDResponseStatusValues maps names to GetMsgIDResponseStatus values.
+var getMsgIDResponseStatusValues = map[string]GetMsgIDResponseStatus{
+	"Delivered":    GetMsgIDResponseStatusDelivered,
+	"NotDelivered": GetMsgIDResponseStatusNotDelivered,
+	"Processed":    GetMsgIDResponseStatusProcessed,
+}
+
+// String returns the wire value of the GetMsgIDResponseStatus.
+func (g GetMsgIDResponseStatus) String() string {
+	return string(g)
+}
+
+// IsValid reports whether the GetMsgIDResponseStatus value is defined in the spec.
+func (g GetMsgIDResponseStatus) IsValid() bool {
+	_, ok := getMsgIDResponseStatusNames[g]
+	return ok
+}
+
+// Values returns all the GetMsgIDResponseStatus values defined in the spec.
+func (GetMsgIDResponseStatus) Values() []GetMsgIDResponseStatus {
+	return []GetMsgIDResponseStatus{
+		GetMsgIDResponseStatusDelivered,
+		GetMsgIDResponseStatusNotDelivered,
+		GetMsgIDResponseStatusProcessed,
+	}
+}
+
+// GetMsgIDResponseStatusPtr returns a pointer to v, to set the optional and nullable GetMsgIDResponseStatus properties.
+func GetMsgIDResponseStatusPtr(v GetMsgIDResponseStatus) *GetMsgIDResponseStatus {
+	return &v
+}
+
+// Name returns the name of the GetMsgIDResponseStatus value, or an empty string for unknown values.
+func (g GetMsgIDResponseStatus) Name() string {
+	return getMsgIDResponseStatusNames[g]
+}
+
+// ParseGetMsgIDResponseStatus returns the GetMsgIDResponseStatus matching s by wire value or by name.
+func ParseGetMsgIDResponseStatus(s string) (GetMsgIDResponseStatus, error) {
+	if _, ok := getMsgIDResponseStatusNames[GetMsgIDResponseStatus(s)]; ok {
+		return GetMsgIDResponseStatus(s), nil
+	}
+	if v, ok := getMsgIDResponseStatusValues[s]; ok {
+		return v, nil
+	}
+	var zero GetMsgIDResponseStatus
+	return zero, fmt.Errorf("%w for GetMsgIDResponseStatus: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (g GetMsgIDResponseStatus) MarshalText() ([]byte, error) {
+	return []byte(g), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseGetMsgIDResponseStatus.
+// Unknown values are kept as-is and reported by Validate.
+func (g *GetMsgIDResponseStatus) UnmarshalText(text []byte) error {
+	*g = GetMsgIDResponseStatus(text)
+	return nil
+}
+
 // GetMsgIDResponseEventsBounceType0 Use to distinguish between types of bounces
 type GetMsgIDResponseEventsBounceType0 string
 
@@ -85,6 +289,74 @@ func (g GetMsgIDResponseEventsBounceType0) Validate() error {
 	}
 }
 
+// getMsgIDResponseEventsBounceType0Names maps GetMsgIDResponseEventsBounceType0 values to their names.
+var getMsgIDResponseEventsBounceType0Names = map[GetMsgIDResponseEventsBounceType0]string{
+	Blocked: "Blocked",
+	Bounced: "Bounced",
+	Expired: "Expired",
+}
+
+// getMsgIDResponseEventsBounceType0Values maps names to GetMsgIDResponseEventsBounceType0 values.
+var getMsgIDResponseEventsBounceType0Values = map[string]GetMsgIDResponseEventsBounceType0{
+	"Blocked": Blocked,
+	"Bounced": Bounced,
+	"Expired": Expired,
+}
+
+// String returns the wire value of the GetMsgIDResponseEventsBounceType0.
+func (g GetMsgIDResponseEventsBounceType0) String() string {
+	return string(g)
+}
+
+// IsValid reports whether the GetMsgIDResponseEventsBounceType0 value is defined in the spec.
+func (g GetMsgIDResponseEventsBounceType0) IsValid() bool {
+	_, ok := getMsgIDResponseEventsBounceType0Names[g]
+	return ok
+}
+
+// Values returns all the GetMsgIDResponseEventsBounceType0 values defined in the spec.
+func (GetMsgIDResponseEventsBounceType0) Values() []GetMsgIDResponseEventsBounceType0 {
+	return []GetMsgIDResponseEventsBounceType0{
+		Blocked,
+		Bounced,
+		Expired,
+	}
+}
+
+// GetMsgIDResponseEventsBounceType0Ptr returns a pointer to v, to set the optional and nullable GetMsgIDResponseEventsBounceType0 properties.
+func GetMsgIDResponseEventsBounceType0Ptr(v GetMsgIDResponseEventsBounceType0) *GetMsgIDResponseEventsBounceType0 {
+	return &v
+}
+
+// Name returns the name of the GetMsgIDResponseEventsBounceType0 value, or an empty string for unknown values.
+func (g GetMsgIDResponseEventsBounceType0) Name() string {
+	return getMsgIDResponseEventsBounceType0Names[g]
+}
+
+// ParseGetMsgIDResponseEventsBounceType0 returns the GetMsgIDResponseEventsBounceType0 matching s by wire value or by name.
+func ParseGetMsgIDResponseEventsBounceType0(s string) (GetMsgIDResponseEventsBounceType0, error) {
+	if _, ok := getMsgIDResponseEventsBounceType0Names[GetMsgIDResponseEventsBounceType0(s)]; ok {
+		return GetMsgIDResponseEventsBounceType0(s), nil
+	}
+	if v, ok := getMsgIDResponseEventsBounceType0Values[s]; ok {
+		return v, nil
+	}
+	var zero GetMsgIDResponseEventsBounceType0
+	return zero, fmt.Errorf("%w for GetMsgIDResponseEventsBounceType0: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (g GetMsgIDResponseEventsBounceType0) MarshalText() ([]byte, error) {
+	return []byte(g), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseGetMsgIDResponseEventsBounceType0.
+// Unknown values are kept as-is and reported by Validate.
+func (g *GetMsgIDResponseEventsBounceType0) UnmarshalText(text []byte) error {
+	*g = GetMsgIDResponseEventsBounceType0(text)
+	return nil
+}
+
 // GetMsgIDResponseEventsBounceType Use to distinguish between types of bounces
 type GetMsgIDResponseEventsBounceType string
 
@@ -103,6 +375,71 @@ func (g GetMsgIDResponseEventsBounceType) Validate() error {
 	}
 }
 
+// getMsgIDResponseEventsBounceTypeNames maps GetMsgIDResponseEventsBounceType values to their names.
+var getMsgIDResponseEventsBounceTypeNames = map[GetMsgIDResponseEventsBounceType]string{
+	Hard: "Hard",
+	Soft: "Soft",
+}
+
+// getMsgIDResponseEventsBounceTypeValues maps names to GetMsgIDResponseEventsBounceType values.
+var getMsgIDResponseEventsBounceTypeValues = map[string]GetMsgIDResponseEventsBounceType{
+	"Hard": Hard,
+	"Soft": Soft,
+}
+
+// String returns the wire value of the GetMsgIDResponseEventsBounceType.
+func (g GetMsgIDResponseEventsBounceType) String() string {
+	return string(g)
+}
+
+// IsValid reports whether the GetMsgIDResponseEventsBounceType value is defined in the spec.
+func (g GetMsgIDResponseEventsBounceType) IsValid() bool {
+	_, ok := getMsgIDResponseEventsBounceTypeNames[g]
+	return ok
+}
+
+// Values returns all the GetMsgIDResponseEventsBounceType values defined in the spec.
+func (GetMsgIDResponseEventsBounceType) Values() []GetMsgIDResponseEventsBounceType {
+	return []GetMsgIDResponseEventsBounceType{
+		Hard,
+		Soft,
+	}
+}
+
+// GetMsgIDResponseEventsBounceTypePtr returns a pointer to v, to set the optional and nullable GetMsgIDResponseEventsBounceType properties.
+func GetMsgIDResponseEventsBounceTypePtr(v GetMsgIDResponseEventsBounceType) *GetMsgIDResponseEventsBounceType {
+	return &v
+}
+
+// Name returns the name of the GetMsgIDResponseEventsBounceType value, or an empty string for unknown values.
+func (g GetMsgIDResponseEventsBounceType) Name() string {
+	return getMsgIDResponseEventsBounceTypeNames[g]
+}
+
+// ParseGetMsgIDResponseEventsBounceType returns the GetMsgIDResponseEventsBounceType matching s by wire value or by name.
+func ParseGetMsgIDResponseEventsBounceType(s string) (GetMsgIDResponseEventsBounceType, error) {
+	if _, ok := getMsgIDResponseEventsBounceTypeNames[GetMsgIDResponseEventsBounceType(s)]; ok {
+		return GetMsgIDResponseEventsBounceType(s), nil
+	}
+	if v, ok := getMsgIDResponseEventsBounceTypeValues[s]; ok {
+		return v, nil
+	}
+	var zero GetMsgIDResponseEventsBounceType
+	return zero, fmt.Errorf("%w for GetMsgIDResponseEventsBounceType: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (g GetMsgIDResponseEventsBounceType) MarshalText() ([]byte, error) {
+	return []byte(g), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseGetMsgIDResponseEventsBounceType.
+// Unknown values are kept as-is and reported by Validate.
+func (g *GetMsgIDResponseEventsBounceType) UnmarshalText(text []byte) error {
+	*g = GetMsgIDResponseEventsBounceType(text)
+	return nil
+}
+
 type GetMsgIDResponse struct {
 	// Status The message's status.
 	Status *GetMsgIDResponseStatus `json:"status,omitempty"`
@@ -218,4 +555,5 @@ var typesValidator *validator.Validate
 func init() {
 	typesValidator = validator.New(validator.WithRequiredStructEnabled())
 	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
 }
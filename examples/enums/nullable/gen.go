@@ -0,0 +1,143 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package nullable
+
+import (
+	"fmt"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/go-playground/validator/v10"
+)
+
+type Status string
+
+const (
+	Active   Status = "active"
+	Inactive Status = "inactive"
+)
+
+// Validate checks if the Status value is valid
+func (s Status) Validate() error {
+	switch s {
+	case Active, Inactive:
+		return nil
+	default:
+		return runtime.NewValidationErrorsFromString("Enum", fmt.Sprintf("must be a valid Status value, got: %v", s))
+	}
+}
+
+// statusNames maps Status values to their names.
+var statusNames = map[Status]string{
+	Active:   "Active",
+	Inactive: "Inactive",
+}
+
+// statusValues maps names to Status values.
+var statusValues = map[string]Status{
+	"Active":   Active,
+	"Inactive": Inactive,
+}
+
+// String returns the wire value of the Status.
+func (s Status) String() string {
+	return string(s)
+}
+
+// IsValid reports whether the Status value is defined in the spec.
+func (s Status) IsValid() bool {
+	_, ok := statusNames[s]
+	return ok
+}
+
+// Values returns all the Status values defined in the spec.
+func (Status) Values() []Status {
+	return []Status{
+		Active,
+		Inactive,
+	}
+}
+
+// StatusPtr returns a pointer to v, to set the optional and nullable Status properties.
+func StatusPtr(v Status) *Status {
+	return &v
+}
+
+// Name returns the name of the Status value, or an empty string for unknown values.
+func (s Status) Name() string {
+	return statusNames[s]
+}
+
+// ParseStatus returns the Status matching s by wire value or by name.
+func ParseStatus(s string) (Status, error) {
+	if _, ok := statusNames[Status(s)]; ok {
+		return Status(s), nil
+	}
+	if v, ok := statusValues[s]; ok {
+		return v, nil
+	}
+	var zero Status
+	return zero, fmt.Errorf("%w for Status: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (s Status) MarshalText() ([]byte, error) {
+	return []byte(s), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseStatus.
+// Unknown values are kept as-is and reported by Validate.
+func (s *Status) UnmarshalText(text []byte) error {
+	*s = Status(text)
+	return nil
+}
+
+type Pet struct {
+	Status         *Status            `json:"status"`
+	PreviousStatus *Status            `json:"previousStatus,omitempty"`
+	History        []*Status          `json:"history,omitempty"`
+	ByOwner        map[string]*Status `json:"byOwner,omitempty"`
+}
+
+func (p Pet) Validate() error {
+	var errors runtime.ValidationErrors
+	if p.Status != nil {
+		if v, ok := any(p.Status).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Status", err)
+			}
+		}
+	}
+	if p.PreviousStatus != nil {
+		if v, ok := any(p.PreviousStatus).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PreviousStatus", err)
+			}
+		}
+	}
+	for i, item := range p.History {
+		if v, ok := any(item).(runtime.Validator); ok && item != nil {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append(fmt.Sprintf("History[%d]", i), err)
+			}
+		}
+	}
+	for k, v := range p.ByOwner {
+		if validator, ok := any(v).(runtime.Validator); ok && v != nil {
+			if err := validator.Validate(); err != nil {
+				errors = errors.Append(fmt.Sprintf("ByOwner[%s]", k), err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
+}
@@ -0,0 +1,43 @@
+package nullable
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNullableEnumPointers(t *testing.T) {
+	pet := Pet{
+		Status:  StatusPtr(Active),
+		History: []*Status{StatusPtr(Inactive), nil},
+		ByOwner: map[string]*Status{"alice": nil},
+	}
+	assert.NoError(t, pet.Validate())
+
+	pet.History = append(pet.History, StatusPtr("lost"))
+	assert.Error(t, pet.Validate())
+}
+
+func TestNullableEnumOmitEmpty(t *testing.T) {
+	t.Run("required nil is sent as null", func(t *testing.T) {
+		data, err := json.Marshal(Pet{})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"status":null}`, string(data))
+	})
+
+	t.Run("optional nil is left out", func(t *testing.T) {
+		data, err := json.Marshal(Pet{Status: StatusPtr(Active)})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"status":"active"}`, string(data))
+	})
+
+	t.Run("null is valid", func(t *testing.T) {
+		var pet Pet
+		require.NoError(t, json.Unmarshal([]byte(`{"status":null,"previousStatus":null,"history":[null]}`), &pet))
+		assert.Nil(t, pet.Status)
+		assert.Equal(t, []*Status{nil}, pet.History)
+		assert.NoError(t, pet.Validate())
+	})
+}
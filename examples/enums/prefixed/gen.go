@@ -27,6 +27,74 @@ func (p ProductVariations) Validate() error {
 	}
 }
 
+// productVariationsNames maps ProductVariations values to their names.
+var productVariationsNames = map[ProductVariations]string{
+	ProductVariationsA: "A",
+	ProductVariationsB: "B",
+	ProductVariationsC: "C",
+}
+
+// productVariationsValues maps names to ProductVariations values.
+var productVariationsValues = map[string]ProductVariations{
+	"A": ProductVariationsA,
+	"B": ProductVariationsB,
+	"C": ProductVariationsC,
+}
+
+// String returns the wire value of the ProductVariations.
+func (p ProductVariations) String() string {
+	return string(p)
+}
+
+// IsValid reports whether the ProductVariations value is defined in the spec.
+func (p ProductVariations) IsValid() bool {
+	_, ok := productVariationsNames[p]
+	return ok
+}
+
+// Values returns all the ProductVariations values defined in the spec.
+func (ProductVariations) Values() []ProductVariations {
+	return []ProductVariations{
+		ProductVariationsA,
+		ProductVariationsB,
+		ProductVariationsC,
+	}
+}
+
+// ProductVariationsPtr returns a pointer to v, to set the optional and nullable ProductVariations properties.
+func ProductVariationsPtr(v ProductVariations) *ProductVariations {
+	return &v
+}
+
+// Name returns the name of the ProductVariations value, or an empty string for unknown values.
+func (p ProductVariations) Name() string {
+	return productVariationsNames[p]
+}
+
+// ParseProductVariations returns the ProductVariations matching s by wire value or by name.
+func ParseProductVariations(s string) (ProductVariations, error) {
+	if _, ok := productVariationsNames[ProductVariations(s)]; ok {
+		return ProductVariations(s), nil
+	}
+	if v, ok := productVariationsValues[s]; ok {
+		return v, nil
+	}
+	var zero ProductVariations
+	return zero, fmt.Errorf("%w for ProductVariations: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (p ProductVariations) MarshalText() ([]byte, error) {
+	return []byte(p), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseProductVariations.
+// Unknown values are kept as-is and reported by Validate.
+func (p *ProductVariations) UnmarshalText(text []byte) error {
+	*p = ProductVariations(text)
+	return nil
+}
+
 type Product struct {
 	Variations *ProductVariations `json:"variations,omitempty"`
 }
@@ -51,4 +119,5 @@ var typesValidator *validator.Validate
 func init() {
 	typesValidator = validator.New(validator.WithRequiredStructEnabled())
 	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
 }
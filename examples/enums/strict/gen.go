@@ -0,0 +1,495 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package strict
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/go-playground/validator/v10"
+)
+
+// Client is the client for the API implementing the Client interface.
+type Client struct {
+	apiClient runtime.APIClient
+}
+
+// NewClient creates a new instance of the Client client.
+func NewClient(apiClient runtime.APIClient) *Client {
+	return &Client{apiClient: apiClient}
+}
+
+// NewDefaultClient creates a new instance of the Client client with default api client.
+func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithUserAgent("Strict-enum-values/1.0.0 oapi-codegen-dd/v3.63.4")}, opts...)
+	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API client: %w", err)
+	}
+	return &Client{apiClient: apiClient}, nil
+}
+
+// ClientInterface is the interface for the API client.
+type ClientInterface interface {
+	CreateOrder(ctx context.Context, options *CreateOrderRequestOptions, reqEditors ...runtime.RequestEditorFn) (*CreateOrderResponse, error)
+}
+
+func (c *Client) CreateOrder(ctx context.Context, options *CreateOrderRequestOptions, reqEditors ...runtime.RequestEditorFn) (*CreateOrderResponse, error) {
+	var err error
+	if options == nil || options.Body == nil {
+		return nil, fmt.Errorf("error creating request body: %w", runtime.ErrMissingValue)
+	}
+	if err = runtime.CheckEnums(options); err != nil {
+		return nil, fmt.Errorf("error validating request: %w", err)
+	}
+	if options != nil && options.Body != nil {
+		if v, ok := any(options.Body).(runtime.Validator); ok {
+			if err = v.Validate(); err != nil {
+				return nil, fmt.Errorf("error validating request body: %w", err)
+			}
+		}
+	}
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL:  c.apiClient.GetBaseURL() + "/orders",
+		Method:      "POST",
+		ContentType: "application/json",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*CreateOrderResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(CreateOrderResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/orders")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+var _ ClientInterface = (*Client)(nil)
+
+// CreateOrderRequestOptions is the options needed to make a request to CreateOrder.
+type CreateOrderRequestOptions struct {
+	Query *CreateOrderQuery
+	Body  *CreateOrderBody
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *CreateOrderRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Query != nil {
+		if v, ok := any(o.Query).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Query", err)
+			}
+		}
+	}
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *CreateOrderRequestOptions) GetPathParams() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetQuery returns the query params as a map.
+func (o *CreateOrderRequestOptions) GetQuery() (map[string]any, error) {
+	return runtime.AsMap[any](o.Query)
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
+func (o *CreateOrderRequestOptions) GetBody() any {
+	if o.Body == nil {
+		return nil
+	}
+	return o.Body
+}
+
+// GetHeader returns the headers as a map.
+func (o *CreateOrderRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+type Status string
+
+const (
+	Pending Status = "pending"
+	Shipped Status = "shipped"
+)
+
+// Validate checks if the Status value is valid
+func (s Status) Validate() error {
+	switch s {
+	case Pending, Shipped:
+		return nil
+	default:
+		return runtime.NewValidationErrorsFromString("Enum", fmt.Sprintf("must be a valid Status value, got: %v", s))
+	}
+}
+
+// statusNames maps Status values to their names.
+var statusNames = map[Status]string{
+	Pending: "Pending",
+	Shipped: "Shipped",
+}
+
+// statusValues maps names to Status values.
+var statusValues = map[string]Status{
+	"Pending": Pending,
+	"Shipped": Shipped,
+}
+
+// String returns the wire value of the Status.
+func (s Status) String() string {
+	return string(s)
+}
+
+// IsValid reports whether the Status value is defined in the spec.
+func (s Status) IsValid() bool {
+	_, ok := statusNames[s]
+	return ok
+}
+
+// Values returns all the Status values defined in the spec.
+func (Status) Values() []Status {
+	return []Status{
+		Pending,
+		Shipped,
+	}
+}
+
+// StatusPtr returns a pointer to v, to set the optional and nullable Status properties.
+func StatusPtr(v Status) *Status {
+	return &v
+}
+
+// Name returns the name of the Status value, or an empty string for unknown values.
+func (s Status) Name() string {
+	return statusNames[s]
+}
+
+// ParseStatus returns the Status matching s by wire value or by name.
+func ParseStatus(s string) (Status, error) {
+	if _, ok := statusNames[Status(s)]; ok {
+		return Status(s), nil
+	}
+	if v, ok := statusValues[s]; ok {
+		return v, nil
+	}
+	var zero Status
+	return zero, fmt.Errorf("%w for Status: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (s Status) MarshalText() ([]byte, error) {
+	return []byte(s), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseStatus.
+// Unknown values are kept as-is and reported by Validate.
+func (s *Status) UnmarshalText(text []byte) error {
+	*s = Status(text)
+	return nil
+}
+
+type Priority int
+
+const (
+	N1 Priority = 1
+	N2 Priority = 2
+)
+
+// Validate checks if the Priority value is valid
+func (p Priority) Validate() error {
+	switch p {
+	case N1, N2:
+		return nil
+	default:
+		return runtime.NewValidationErrorsFromString("Enum", fmt.Sprintf("must be a valid Priority value, got: %v", p))
+	}
+}
+
+// priorityNames maps Priority values to their names.
+var priorityNames = map[Priority]string{
+	N1: "N1",
+	N2: "N2",
+}
+
+// priorityValues maps names to Priority values.
+var priorityValues = map[string]Priority{
+	"N1": N1,
+	"N2": N2,
+}
+
+// String returns the wire value of the Priority.
+func (p Priority) String() string {
+	return fmt.Sprint(int(p))
+}
+
+// IsValid reports whether the Priority value is defined in the spec.
+func (p Priority) IsValid() bool {
+	_, ok := priorityNames[p]
+	return ok
+}
+
+// Values returns all the Priority values defined in the spec.
+func (Priority) Values() []Priority {
+	return []Priority{
+		N1,
+		N2,
+	}
+}
+
+// PriorityPtr returns a pointer to v, to set the optional and nullable Priority properties.
+func PriorityPtr(v Priority) *Priority {
+	return &v
+}
+
+// Name returns the name of the Priority value, or an empty string for unknown values.
+func (p Priority) Name() string {
+	return priorityNames[p]
+}
+
+// ParsePriority returns the Priority matching s by wire value or by name.
+func ParsePriority(s string) (Priority, error) {
+	switch s {
+	case "1":
+		return N1, nil
+	case "2":
+		return N2, nil
+	}
+	if v, ok := priorityValues[s]; ok {
+		return v, nil
+	}
+	var zero Priority
+	return zero, fmt.Errorf("%w for Priority: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value, e.g. for map keys and parameters.
+func (p Priority) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParsePriority.
+// Unknown values are kept as-is and reported by Validate.
+func (p *Priority) UnmarshalText(text []byte) error {
+	return runtime.UnmarshalEnumText(text, p)
+}
+
+// MarshalJSON writes the Priority as a JSON number rather than the string of MarshalText.
+func (p Priority) MarshalJSON() ([]byte, error) {
+	return json.Marshal(int(p))
+}
+
+// UnmarshalJSON reads the Priority from a JSON number rather than the string of UnmarshalText.
+func (p *Priority) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, (*int)(p))
+}
+
+type CreateOrderBody = Order
+
+type CreateOrderQuery struct {
+	Priority *Priority `json:"priority,omitempty"`
+}
+
+func (c CreateOrderQuery) Validate() error {
+	var errors runtime.ValidationErrors
+	if c.Priority != nil {
+		if v, ok := any(c.Priority).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Priority", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+type CreateOrderResponse = Order
+
+// CreateOrderRequest is a request to CreateOrder, read from an *http.Request with BindCreateOrderRequest.
+type CreateOrderRequest struct {
+	Query *CreateOrderQuery
+	Body  *CreateOrderBody
+}
+
+// Validate validates all the fields of the request.
+func (o *CreateOrderRequest) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Query != nil {
+		if v, ok := any(o.Query).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Query", err)
+			}
+		}
+	}
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// BindCreateOrderRequest reads the request to POST /orders and validates it.
+// Path parameters are read with r.PathValue, set by the router matching the request.
+// Parameters and bodies that can't be read are returned as a *runtime.BindError,
+// and invalid requests as runtime.ValidationErrors.
+// Enum values missing from the spec are returned as a *runtime.BindError wrapping runtime.ErrUnknownEnumValue.
+func BindCreateOrderRequest(r *http.Request) (*CreateOrderRequest, error) {
+	req := &CreateOrderRequest{}
+
+	req.Query = &CreateOrderQuery{}
+	if err := runtime.BindQuery(r, req.Query, nil); err != nil {
+		return nil, err
+	}
+	if err := runtime.CheckEnums(req.Query); err != nil {
+		return nil, &runtime.BindError{In: "query", Err: err}
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, &runtime.BindError{In: "body", Err: err}
+	}
+	if !runtime.IsEmptyBody(body) {
+		req.Body = &CreateOrderBody{}
+		if err = json.Unmarshal(body, req.Body); err != nil {
+			return nil, &runtime.BindError{In: "body", Err: err}
+		}
+		if err = runtime.CheckEnums(req.Body); err != nil {
+			return nil, &runtime.BindError{In: "body", Err: err}
+		}
+	} else {
+		return nil, &runtime.BindError{In: "body", Err: runtime.ErrMissingValue}
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// CreateOrderHandler returns the http.HandlerFunc of POST /orders, calling handle with the request
+// read by BindCreateOrderRequest. Requests failing to bind are passed to onError instead,
+// runtime.DefaultBindErrorHandler if nil.
+func CreateOrderHandler(handle func(w http.ResponseWriter, r *http.Request, req *CreateOrderRequest), onError runtime.BindErrorHandler) http.HandlerFunc {
+	if onError == nil {
+		onError = runtime.DefaultBindErrorHandler
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		req, err := BindCreateOrderRequest(r)
+		if err != nil {
+			onError(w, r, err)
+			return
+		}
+		handle(w, r, req)
+	}
+}
+
+// OperationValidators validates the requests of the operations with their Bind functions, and the JSON bodies
+// of their responses, by http.ServeMux pattern. Use it with runtime.ValidationMiddleware.
+var OperationValidators = map[string]runtime.OperationValidator{
+	"POST /orders": {
+		Request: func(r *http.Request) error {
+			_, err := BindCreateOrderRequest(r)
+			return err
+		},
+		Response: func(status int, body []byte) error {
+			var res any
+			switch {
+			case status == 200:
+				res = new(CreateOrderResponse)
+			default:
+				return nil
+			}
+			if err := json.Unmarshal(body, res); err != nil {
+				return err
+			}
+			if v, ok := res.(runtime.Validator); ok {
+				return v.Validate()
+			}
+			return nil
+		},
+	},
+}
+
+type Order struct {
+	Status   Status    `json:"status" validate:"required"`
+	Priority *Priority `json:"priority,omitempty"`
+}
+
+func (o Order) Validate() error {
+	var errors runtime.ValidationErrors
+	if v, ok := any(o.Status).(runtime.Validator); ok {
+		if err := v.Validate(); err != nil {
+			errors = errors.Append("Status", err)
+		}
+	}
+	if o.Priority != nil {
+		if v, ok := any(o.Priority).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Priority", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
+}
@@ -0,0 +1,93 @@
+package strict
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnknownEnumValuesDecoded(t *testing.T) {
+	var order Order
+	require.NoError(t, json.Unmarshal([]byte(`{"status":"returned","priority":3}`), &order))
+
+	assert.Equal(t, Status("returned"), order.Status)
+	assert.False(t, order.Status.IsValid())
+	assert.Equal(t, Priority(3), *order.Priority)
+	assert.False(t, order.Priority.IsValid())
+	assert.Error(t, order.Validate())
+
+	data, err := json.Marshal(order)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"status":"returned","priority":3}`, string(data))
+}
+
+func TestBindCreateOrderRequest(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		body     string
+		expected string
+	}{
+		{name: "known values", target: "/orders?priority=1", body: `{"status":"shipped","priority":2}`},
+		{name: "unknown query value", target: "/orders?priority=3", body: `{"status":"shipped"}`, expected: "invalid query: unknown enum value for 'priority': 3"},
+		{name: "unknown body value", target: "/orders", body: `{"status":"returned"}`, expected: "invalid body: unknown enum value for 'status': returned"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, tt.target, strings.NewReader(tt.body))
+			_, err := BindCreateOrderRequest(r)
+			if tt.expected == "" {
+				require.NoError(t, err)
+				return
+			}
+			var bindErr *runtime.BindError
+			require.ErrorAs(t, err, &bindErr)
+			assert.ErrorIs(t, err, runtime.ErrUnknownEnumValue)
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
+}
+
+// httpClientAdapter wraps http.Client to implement runtime.HttpRequestDoer
+type httpClientAdapter struct {
+	client *http.Client
+}
+
+func (a *httpClientAdapter) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return a.client.Do(req.WithContext(ctx))
+}
+
+func TestCreateOrder(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"returned"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := NewDefaultClient(server.URL, runtime.WithHTTPClient(&httpClientAdapter{client: server.Client()}))
+	require.NoError(t, err)
+
+	_, err = client.CreateOrder(context.Background(), &CreateOrderRequestOptions{Body: &CreateOrderBody{Status: "returned"}})
+	assert.ErrorIs(t, err, runtime.ErrUnknownEnumValue)
+	_, err = client.CreateOrder(context.Background(), &CreateOrderRequestOptions{
+		Query: &CreateOrderQuery{Priority: PriorityPtr(3)},
+		Body:  &CreateOrderBody{Status: Shipped},
+	})
+	assert.EqualError(t, err, "error validating request: unknown enum value for 'Query.priority': 3")
+	assert.Zero(t, calls)
+
+	res, err := client.CreateOrder(context.Background(), &CreateOrderRequestOptions{Body: &CreateOrderBody{Status: Shipped}})
+	require.NoError(t, err)
+	assert.Equal(t, Status("returned"), res.Status)
+	assert.Equal(t, 1, calls)
+}
@@ -3,6 +3,7 @@
 package gen
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
@@ -26,6 +27,71 @@ func (o OrderDirection) Validate() error {
 	}
 }
 
+// orderDirectionNames maps OrderDirection values to their names.
+var orderDirectionNames = map[OrderDirection]string{
+	Asc:  "Asc",
+	Desc: "Desc",
+}
+
+// orderDirectionValues maps names to OrderDirection values.
+var orderDirectionValues = map[string]OrderDirection{
+	"Asc":  Asc,
+	"Desc": Desc,
+}
+
+// String returns the wire value of the OrderDirection.
+func (o OrderDirection) String() string {
+	return string(o)
+}
+
+// IsValid reports whether the OrderDirection value is defined in the spec.
+func (o OrderDirection) IsValid() bool {
+	_, ok := orderDirectionNames[o]
+	return ok
+}
+
+// Values returns all the OrderDirection values defined in the spec.
+func (OrderDirection) Values() []OrderDirection {
+	return []OrderDirection{
+		Asc,
+		Desc,
+	}
+}
+
+// OrderDirectionPtr returns a pointer to v, to set the optional and nullable OrderDirection properties.
+func OrderDirectionPtr(v OrderDirection) *OrderDirection {
+	return &v
+}
+
+// Name returns the name of the OrderDirection value, or an empty string for unknown values.
+func (o OrderDirection) Name() string {
+	return orderDirectionNames[o]
+}
+
+// ParseOrderDirection returns the OrderDirection matching s by wire value or by name.
+func ParseOrderDirection(s string) (OrderDirection, error) {
+	if _, ok := orderDirectionNames[OrderDirection(s)]; ok {
+		return OrderDirection(s), nil
+	}
+	if v, ok := orderDirectionValues[s]; ok {
+		return v, nil
+	}
+	var zero OrderDirection
+	return zero, fmt.Errorf("%w for OrderDirection: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (o OrderDirection) MarshalText() ([]byte, error) {
+	return []byte(o), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseOrderDirection.
+// Unknown values are kept as-is and reported by Validate.
+func (o *OrderDirection) UnmarshalText(text []byte) error {
+	*o = OrderDirection(text)
+	return nil
+}
+
 type Priority string
 
 const (
@@ -44,6 +110,74 @@ func (p Priority) Validate() error {
 	}
 }
 
+// priorityNames maps Priority values to their names.
+var priorityNames = map[Priority]string{
+	High:   "High",
+	Low:    "Low",
+	Medium: "Medium",
+}
+
+// priorityValues maps names to Priority values.
+var priorityValues = map[string]Priority{
+	"High":   High,
+	"Low":    Low,
+	"Medium": Medium,
+}
+
+// String returns the wire value of the Priority.
+func (p Priority) String() string {
+	return string(p)
+}
+
+// IsValid reports whether the Priority value is defined in the spec.
+func (p Priority) IsValid() bool {
+	_, ok := priorityNames[p]
+	return ok
+}
+
+// Values returns all the Priority values defined in the spec.
+func (Priority) Values() []Priority {
+	return []Priority{
+		High,
+		Low,
+		Medium,
+	}
+}
+
+// PriorityPtr returns a pointer to v, to set the optional and nullable Priority properties.
+func PriorityPtr(v Priority) *Priority {
+	return &v
+}
+
+// Name returns the name of the Priority value, or an empty string for unknown values.
+func (p Priority) Name() string {
+	return priorityNames[p]
+}
+
+// ParsePriority returns the Priority matching s by wire value or by name.
+func ParsePriority(s string) (Priority, error) {
+	if _, ok := priorityNames[Priority(s)]; ok {
+		return Priority(s), nil
+	}
+	if v, ok := priorityValues[s]; ok {
+		return v, nil
+	}
+	var zero Priority
+	return zero, fmt.Errorf("%w for Priority: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (p Priority) MarshalText() ([]byte, error) {
+	return []byte(p), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParsePriority.
+// Unknown values are kept as-is and reported by Validate.
+func (p *Priority) UnmarshalText(text []byte) error {
+	*p = Priority(text)
+	return nil
+}
+
 type StatusCode int
 
 const (
@@ -62,6 +196,88 @@ func (s StatusCode) Validate() error {
 	}
 }
 
+// statusCodeNames maps StatusCode values to their names.
+var statusCodeNames = map[StatusCode]string{
+	N200: "N200",
+	N404: "N404",
+	N500: "N500",
+}
+
+// statusCodeValues maps names to StatusCode values.
+var statusCodeValues = map[string]StatusCode{
+	"N200": N200,
+	"N404": N404,
+	"N500": N500,
+}
+
+// String returns the wire value of the StatusCode.
+func (s StatusCode) String() string {
+	return fmt.Sprint(int(s))
+}
+
+// IsValid reports whether the StatusCode value is defined in the spec.
+func (s StatusCode) IsValid() bool {
+	_, ok := statusCodeNames[s]
+	return ok
+}
+
+// Values returns all the StatusCode values defined in the spec.
+func (StatusCode) Values() []StatusCode {
+	return []StatusCode{
+		N200,
+		N404,
+		N500,
+	}
+}
+
+// StatusCodePtr returns a pointer to v, to set the optional and nullable StatusCode properties.
+func StatusCodePtr(v StatusCode) *StatusCode {
+	return &v
+}
+
+// Name returns the name of the StatusCode value, or an empty string for unknown values.
+func (s StatusCode) Name() string {
+	return statusCodeNames[s]
+}
+
+// ParseStatusCode returns the StatusCode matching s by wire value or by name.
+func ParseStatusCode(s string) (StatusCode, error) {
+	switch s {
+	case "200":
+		return N200, nil
+	case "404":
+		return N404, nil
+	case "500":
+		return N500, nil
+	}
+	if v, ok := statusCodeValues[s]; ok {
+		return v, nil
+	}
+	var zero StatusCode
+	return zero, fmt.Errorf("%w for StatusCode: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value, e.g. for map keys and parameters.
+func (s StatusCode) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseStatusCode.
+// Unknown values are kept as-is and reported by Validate.
+func (s *StatusCode) UnmarshalText(text []byte) error {
+	return runtime.UnmarshalEnumText(text, s)
+}
+
+// MarshalJSON writes the StatusCode as a JSON number rather than the string of MarshalText.
+func (s StatusCode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(int(s))
+}
+
+// UnmarshalJSON reads the StatusCode from a JSON number rather than the string of UnmarshalText.
+func (s *StatusCode) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, (*int)(s))
+}
+
 type Color string
 
 const (
@@ -80,6 +296,74 @@ func (c Color) Validate() error {
 	}
 }
 
+// colorNames maps Color values to their names.
+var colorNames = map[Color]string{
+	Blue:  "Blue",
+	Green: "Green",
+	Red:   "Red",
+}
+
+// colorValues maps names to Color values.
+var colorValues = map[string]Color{
+	"Blue":  Blue,
+	"Green": Green,
+	"Red":   Red,
+}
+
+// String returns the wire value of the Color.
+func (c Color) String() string {
+	return string(c)
+}
+
+// IsValid reports whether the Color value is defined in the spec.
+func (c Color) IsValid() bool {
+	_, ok := colorNames[c]
+	return ok
+}
+
+// Values returns all the Color values defined in the spec.
+func (Color) Values() []Color {
+	return []Color{
+		Blue,
+		Green,
+		Red,
+	}
+}
+
+// ColorPtr returns a pointer to v, to set the optional and nullable Color properties.
+func ColorPtr(v Color) *Color {
+	return &v
+}
+
+// Name returns the name of the Color value, or an empty string for unknown values.
+func (c Color) Name() string {
+	return colorNames[c]
+}
+
+// ParseColor returns the Color matching s by wire value or by name.
+func ParseColor(s string) (Color, error) {
+	if _, ok := colorNames[Color(s)]; ok {
+		return Color(s), nil
+	}
+	if v, ok := colorValues[s]; ok {
+		return v, nil
+	}
+	var zero Color
+	return zero, fmt.Errorf("%w for Color: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (c Color) MarshalText() ([]byte, error) {
+	return []byte(c), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseColor.
+// Unknown values are kept as-is and reported by Validate.
+func (c *Color) UnmarshalText(text []byte) error {
+	*c = Color(text)
+	return nil
+}
+
 type TestObject struct {
 	OrderDirection *OrderDirection `json:"orderDirection,omitempty"`
 	Priority       *Priority       `json:"priority,omitempty"`
@@ -128,4 +412,5 @@ var typesValidator *validator.Validate
 func init() {
 	typesValidator = validator.New(validator.WithRequiredStructEnabled())
 	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
 }
@@ -3,6 +3,7 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
@@ -27,6 +28,88 @@ func (s StatusCode) Validate() error {
 	}
 }
 
+// statusCodeNames maps StatusCode values to their names.
+var statusCodeNames = map[StatusCode]string{
+	N200: "N200",
+	N404: "N404",
+	N500: "N500",
+}
+
+// statusCodeValues maps names to StatusCode values.
+var statusCodeValues = map[string]StatusCode{
+	"N200": N200,
+	"N404": N404,
+	"N500": N500,
+}
+
+// String returns the wire value of the StatusCode.
+func (s StatusCode) String() string {
+	return fmt.Sprint(int(s))
+}
+
+// IsValid reports whether the StatusCode value is defined in the spec.
+func (s StatusCode) IsValid() bool {
+	_, ok := statusCodeNames[s]
+	return ok
+}
+
+// Values returns all the StatusCode values defined in the spec.
+func (StatusCode) Values() []StatusCode {
+	return []StatusCode{
+		N200,
+		N404,
+		N500,
+	}
+}
+
+// StatusCodePtr returns a pointer to v, to set the optional and nullable StatusCode properties.
+func StatusCodePtr(v StatusCode) *StatusCode {
+	return &v
+}
+
+// Name returns the name of the StatusCode value, or an empty string for unknown values.
+func (s StatusCode) Name() string {
+	return statusCodeNames[s]
+}
+
+// ParseStatusCode returns the StatusCode matching s by wire value or by name.
+func ParseStatusCode(s string) (StatusCode, error) {
+	switch s {
+	case "200":
+		return N200, nil
+	case "404":
+		return N404, nil
+	case "500":
+		return N500, nil
+	}
+	if v, ok := statusCodeValues[s]; ok {
+		return v, nil
+	}
+	var zero StatusCode
+	return zero, fmt.Errorf("%w for StatusCode: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value, e.g. for map keys and parameters.
+func (s StatusCode) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseStatusCode.
+// Unknown values are kept as-is and reported by Validate.
+func (s *StatusCode) UnmarshalText(text []byte) error {
+	return runtime.UnmarshalEnumText(text, s)
+}
+
+// MarshalJSON writes the StatusCode as a JSON number rather than the string of MarshalText.
+func (s StatusCode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(int(s))
+}
+
+// UnmarshalJSON reads the StatusCode from a JSON number rather than the string of UnmarshalText.
+func (s *StatusCode) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, (*int)(s))
+}
+
 type Priority float32
 
 const (
@@ -45,6 +128,88 @@ func (p Priority) Validate() error {
 	}
 }
 
+// priorityNames maps Priority values to their names.
+var priorityNames = map[Priority]string{
+	N10: "N10",
+	N25: "N25",
+	N50: "N50",
+}
+
+// priorityValues maps names to Priority values.
+var priorityValues = map[string]Priority{
+	"N10": N10,
+	"N25": N25,
+	"N50": N50,
+}
+
+// String returns the wire value of the Priority.
+func (p Priority) String() string {
+	return fmt.Sprint(float32(p))
+}
+
+// IsValid reports whether the Priority value is defined in the spec.
+func (p Priority) IsValid() bool {
+	_, ok := priorityNames[p]
+	return ok
+}
+
+// Values returns all the Priority values defined in the spec.
+func (Priority) Values() []Priority {
+	return []Priority{
+		N10,
+		N25,
+		N50,
+	}
+}
+
+// PriorityPtr returns a pointer to v, to set the optional and nullable Priority properties.
+func PriorityPtr(v Priority) *Priority {
+	return &v
+}
+
+// Name returns the name of the Priority value, or an empty string for unknown values.
+func (p Priority) Name() string {
+	return priorityNames[p]
+}
+
+// ParsePriority returns the Priority matching s by wire value or by name.
+func ParsePriority(s string) (Priority, error) {
+	switch s {
+	case "1.0":
+		return N10, nil
+	case "2.5":
+		return N25, nil
+	case "5.0":
+		return N50, nil
+	}
+	if v, ok := priorityValues[s]; ok {
+		return v, nil
+	}
+	var zero Priority
+	return zero, fmt.Errorf("%w for Priority: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value, e.g. for map keys and parameters.
+func (p Priority) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParsePriority.
+// Unknown values are kept as-is and reported by Validate.
+func (p *Priority) UnmarshalText(text []byte) error {
+	return runtime.UnmarshalEnumText(text, p)
+}
+
+// MarshalJSON writes the Priority as a JSON number rather than the string of MarshalText.
+func (p Priority) MarshalJSON() ([]byte, error) {
+	return json.Marshal(float32(p))
+}
+
+// UnmarshalJSON reads the Priority from a JSON number rather than the string of UnmarshalText.
+func (p *Priority) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, (*float32)(p))
+}
+
 type Color string
 
 const (
@@ -63,6 +228,74 @@ func (c Color) Validate() error {
 	}
 }
 
+// colorNames maps Color values to their names.
+var colorNames = map[Color]string{
+	Blue:  "Blue",
+	Green: "Green",
+	Red:   "Red",
+}
+
+// colorValues maps names to Color values.
+var colorValues = map[string]Color{
+	"Blue":  Blue,
+	"Green": Green,
+	"Red":   Red,
+}
+
+// String returns the wire value of the Color.
+func (c Color) String() string {
+	return string(c)
+}
+
+// IsValid reports whether the Color value is defined in the spec.
+func (c Color) IsValid() bool {
+	_, ok := colorNames[c]
+	return ok
+}
+
+// Values returns all the Color values defined in the spec.
+func (Color) Values() []Color {
+	return []Color{
+		Blue,
+		Green,
+		Red,
+	}
+}
+
+// ColorPtr returns a pointer to v, to set the optional and nullable Color properties.
+func ColorPtr(v Color) *Color {
+	return &v
+}
+
+// Name returns the name of the Color value, or an empty string for unknown values.
+func (c Color) Name() string {
+	return colorNames[c]
+}
+
+// ParseColor returns the Color matching s by wire value or by name.
+func ParseColor(s string) (Color, error) {
+	if _, ok := colorNames[Color(s)]; ok {
+		return Color(s), nil
+	}
+	if v, ok := colorValues[s]; ok {
+		return v, nil
+	}
+	var zero Color
+	return zero, fmt.Errorf("%w for Color: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (c Color) MarshalText() ([]byte, error) {
+	return []byte(c), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseColor.
+// Unknown values are kept as-is and reported by Validate.
+func (c *Color) UnmarshalText(text []byte) error {
+	*c = Color(text)
+	return nil
+}
+
 type TestObject struct {
 	Status   *StatusCode `json:"status,omitempty"`
 	Priority *Priority   `json:"priority,omitempty"`
@@ -130,4 +363,5 @@ var typesValidator *validator.Validate
 func init() {
 	typesValidator = validator.New(validator.WithRequiredStructEnabled())
 	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
 }
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
@@ -153,3 +154,65 @@ func TestEnum_Validate_ReturnsValidationErrors(t *testing.T) {
 		assert.Contains(t, validationErrs[0].Message, "must be a valid Color value")
 	})
 }
+
+func TestEnum_Helpers(t *testing.T) {
+	assert.True(t, Red.IsValid())
+	assert.False(t, Color("yellow").IsValid())
+	assert.Equal(t, []Color{Blue, Green, Red}, Color("").Values())
+
+	assert.True(t, N404.IsValid())
+	assert.False(t, StatusCode(418).IsValid())
+	assert.Equal(t, []StatusCode{N200, N404, N500}, StatusCode(0).Values())
+	assert.Equal(t, "404", N404.String())
+
+	status, err := ParseStatusCode("500")
+	require.NoError(t, err)
+	assert.Equal(t, N500, status)
+
+	_, err = ParseStatusCode("418")
+	assert.ErrorIs(t, err, runtime.ErrUnknownEnumValue)
+}
+
+func TestEnum_UnmarshalUnknown(t *testing.T) {
+	var obj TestObject
+	require.NoError(t, json.Unmarshal([]byte(`{"status":404,"color":"red"}`), &obj))
+	assert.Equal(t, N404, *obj.Status)
+	assert.Equal(t, Red, obj.Color)
+
+	require.NoError(t, json.Unmarshal([]byte(`{"status":418,"color":"yellow"}`), &obj))
+	assert.Equal(t, StatusCode(418), *obj.Status)
+	assert.False(t, obj.Status.IsValid())
+	assert.Equal(t, Color("yellow"), obj.Color)
+	assert.False(t, obj.Color.IsValid())
+}
+
+func TestEnum_Text(t *testing.T) {
+	text, err := N404.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "404", string(text))
+
+	var status StatusCode
+	require.NoError(t, status.UnmarshalText([]byte("418")))
+	assert.Equal(t, StatusCode(418), status)
+	assert.False(t, status.IsValid())
+	assert.Error(t, status.UnmarshalText([]byte("teapot")))
+
+	var priority Priority
+	require.NoError(t, priority.UnmarshalText([]byte("2.5")))
+	assert.Equal(t, Priority(2.5), priority)
+
+	t.Run("json keeps numbers", func(t *testing.T) {
+		data, err := json.Marshal(map[StatusCode]Priority{N404: Priority(2.5)})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"404":2.5}`, string(data))
+
+		var obj TestObjectRequired
+		require.NoError(t, json.Unmarshal([]byte(`{"status":418,"priority":7.5,"color":"red"}`), &obj))
+		assert.Equal(t, StatusCode(418), obj.Status)
+		assert.Equal(t, Priority(7.5), obj.Priority)
+
+		data, err = json.Marshal(obj)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"status":418,"priority":7.5,"color":"red"}`, string(data))
+	})
+}
@@ -0,0 +1,295 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package xcaptureunknown
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/go-playground/validator/v10"
+)
+
+// Customer Fields added to the API later are kept, so they are sent back unchanged.
+type Customer struct {
+	ID    string  `json:"id" validate:"required"`
+	Email *string `json:"email,omitempty" sensitive:""`
+
+	// Address Additional properties are strings, the card number is masked.
+	Address              Address                    `json:"address,omitempty"`
+	AdditionalProperties map[string]json.RawMessage `json:"-"`
+}
+
+func (c Customer) Validate() error {
+	var errors runtime.ValidationErrors
+	if err := typesValidator.Var(c.ID, "required"); err != nil {
+		errors = errors.Append("ID", err)
+	}
+	if v, ok := any(c.Address).(runtime.Validator); ok && v != nil {
+		if err := v.Validate(); err != nil {
+			errors = errors.Append("Address", err)
+		}
+	}
+	for k, v := range c.AdditionalProperties {
+		if val, ok := any(v).(runtime.Validator); ok {
+			if err := val.Validate(); err != nil {
+				errors = errors.Append(k, err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+// Getter for additional properties for Customer. Returns the specified
+// element and whether it was found
+func (c Customer) Get(fieldName string) (value json.RawMessage, found bool) {
+	if c.AdditionalProperties != nil {
+		value, found = c.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for Customer
+func (c *Customer) Set(fieldName string, value json.RawMessage) {
+	if c.AdditionalProperties == nil {
+		c.AdditionalProperties = make(map[string]json.RawMessage)
+	}
+	c.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for Customer to handle AdditionalProperties
+func (c *Customer) UnmarshalJSON(data []byte) error {
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(data, &object); err != nil {
+		return err
+	}
+
+	if raw, found := object["id"]; found {
+		if err := json.Unmarshal(raw, &c.ID); err != nil {
+			return fmt.Errorf("error reading 'id': %w", err)
+		}
+		delete(object, "id")
+	}
+	if raw, found := object["email"]; found {
+		if err := json.Unmarshal(raw, &c.Email); err != nil {
+			return fmt.Errorf("error reading 'email': %w", err)
+		}
+		delete(object, "email")
+	}
+	if raw, found := object["address"]; found {
+		if err := json.Unmarshal(raw, &c.Address); err != nil {
+			return fmt.Errorf("error reading 'address': %w", err)
+		}
+		delete(object, "address")
+	}
+	if len(object) != 0 {
+		c.AdditionalProperties = make(map[string]json.RawMessage)
+		for fieldName, fieldBuf := range object {
+			var fieldVal json.RawMessage
+			if err := json.Unmarshal(fieldBuf, &fieldVal); err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			c.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for Customer to handle AdditionalProperties.
+// Fields are written directly, additional properties replace the declared ones with the same name.
+func (c Customer) MarshalJSON() ([]byte, error) {
+	var object runtime.JSONObjectWriter
+	if _, ok := c.AdditionalProperties["id"]; !ok {
+
+		if err := object.WriteField("id", c.ID); err != nil {
+			return nil, fmt.Errorf("error marshaling 'id': %w", err)
+		}
+
+	}
+	if _, ok := c.AdditionalProperties["email"]; !ok {
+		if c.Email != nil {
+			if err := object.WriteField("email", runtime.MaskSensitiveValue(*c.Email, runtime.SensitiveDataConfig{
+				Type:       runtime.MaskTypeFull,
+				Pattern:    "",
+				Algorithm:  "",
+				KeepPrefix: 0,
+				KeepSuffix: 0,
+			})); err != nil {
+				return nil, fmt.Errorf("error marshaling 'email': %w", err)
+			}
+		}
+	}
+	if _, ok := c.AdditionalProperties["address"]; !ok {
+
+		if err := object.WriteField("address", c.Address); err != nil {
+			return nil, fmt.Errorf("error marshaling 'address': %w", err)
+		}
+
+	}
+	for _, fieldName := range slices.Sorted(maps.Keys(c.AdditionalProperties)) {
+		if err := object.WriteField(fieldName, c.AdditionalProperties[fieldName]); err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return object.Bytes(), nil
+}
+
+// MarshalJSONUnmasked marshals Customer like MarshalJSON, with its sensitive properties unmasked.
+// See runtime.MarshalUnmasked.
+func (c Customer) MarshalJSONUnmasked() ([]byte, error) {
+	var object runtime.JSONObjectWriter
+	if _, ok := c.AdditionalProperties["id"]; !ok {
+
+		if err := object.WriteField("id", c.ID); err != nil {
+			return nil, fmt.Errorf("error marshaling 'id': %w", err)
+		}
+
+	}
+	if _, ok := c.AdditionalProperties["email"]; !ok {
+		if c.Email != nil {
+			if err := object.WriteField("email", c.Email); err != nil {
+				return nil, fmt.Errorf("error marshaling 'email': %w", err)
+			}
+		}
+	}
+	if _, ok := c.AdditionalProperties["address"]; !ok {
+
+		if err := object.WriteField("address", c.Address); err != nil {
+			return nil, fmt.Errorf("error marshaling 'address': %w", err)
+		}
+
+	}
+	for _, fieldName := range slices.Sorted(maps.Keys(c.AdditionalProperties)) {
+		if err := object.WriteField(fieldName, c.AdditionalProperties[fieldName]); err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return object.Bytes(), nil
+}
+
+// Address Additional properties are strings, the card number is masked.
+type Address struct {
+	City                 *string           `json:"city,omitempty"`
+	CardNumber           *string           `json:"cardNumber,omitempty" sensitive:""`
+	AdditionalProperties map[string]string `json:"-"`
+}
+
+// Getter for additional properties for Address. Returns the specified
+// element and whether it was found
+func (a Address) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for Address
+func (a *Address) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for Address to handle AdditionalProperties
+func (a *Address) UnmarshalJSON(data []byte) error {
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(data, &object); err != nil {
+		return err
+	}
+
+	if raw, found := object["city"]; found {
+		if err := json.Unmarshal(raw, &a.City); err != nil {
+			return fmt.Errorf("error reading 'city': %w", err)
+		}
+		delete(object, "city")
+	}
+	if raw, found := object["cardNumber"]; found {
+		if err := json.Unmarshal(raw, &a.CardNumber); err != nil {
+			return fmt.Errorf("error reading 'cardNumber': %w", err)
+		}
+		delete(object, "cardNumber")
+	}
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			if err := json.Unmarshal(fieldBuf, &fieldVal); err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for Address to handle AdditionalProperties.
+// Fields are written directly, additional properties replace the declared ones with the same name.
+func (a Address) MarshalJSON() ([]byte, error) {
+	var object runtime.JSONObjectWriter
+	if _, ok := a.AdditionalProperties["city"]; !ok {
+		if a.City != nil {
+			if err := object.WriteField("city", a.City); err != nil {
+				return nil, fmt.Errorf("error marshaling 'city': %w", err)
+			}
+		}
+	}
+	if _, ok := a.AdditionalProperties["cardNumber"]; !ok {
+		if a.CardNumber != nil {
+			if err := object.WriteField("cardNumber", runtime.MaskSensitiveValue(*a.CardNumber, runtime.SensitiveDataConfig{
+				Type:       runtime.MaskTypePartial,
+				Pattern:    "",
+				Algorithm:  "",
+				KeepPrefix: 0,
+				KeepSuffix: 4,
+			})); err != nil {
+				return nil, fmt.Errorf("error marshaling 'cardNumber': %w", err)
+			}
+		}
+	}
+	for _, fieldName := range slices.Sorted(maps.Keys(a.AdditionalProperties)) {
+		if err := object.WriteField(fieldName, a.AdditionalProperties[fieldName]); err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return object.Bytes(), nil
+}
+
+// MarshalJSONUnmasked marshals Address like MarshalJSON, with its sensitive properties unmasked.
+// See runtime.MarshalUnmasked.
+func (a Address) MarshalJSONUnmasked() ([]byte, error) {
+	var object runtime.JSONObjectWriter
+	if _, ok := a.AdditionalProperties["city"]; !ok {
+		if a.City != nil {
+			if err := object.WriteField("city", a.City); err != nil {
+				return nil, fmt.Errorf("error marshaling 'city': %w", err)
+			}
+		}
+	}
+	if _, ok := a.AdditionalProperties["cardNumber"]; !ok {
+		if a.CardNumber != nil {
+			if err := object.WriteField("cardNumber", a.CardNumber); err != nil {
+				return nil, fmt.Errorf("error marshaling 'cardNumber': %w", err)
+			}
+		}
+	}
+	for _, fieldName := range slices.Sorted(maps.Keys(a.AdditionalProperties)) {
+		if err := object.WriteField(fieldName, a.AdditionalProperties[fieldName]); err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return object.Bytes(), nil
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
+}
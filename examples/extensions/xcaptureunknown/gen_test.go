@@ -0,0 +1,53 @@
+package xcaptureunknown
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCaptureUnknown(t *testing.T) {
+	data := `{"id":"c1","tier":"gold","limits":{"daily":100},"address":{"city":"Berlin","zip":"10115"}}`
+
+	var customer Customer
+	require.NoError(t, json.Unmarshal([]byte(data), &customer))
+
+	assert.Equal(t, "c1", customer.ID)
+	tier, found := customer.Get("tier")
+	require.True(t, found)
+	assert.JSONEq(t, `"gold"`, string(tier))
+	zip, _ := customer.Address.Get("zip")
+	assert.Equal(t, "10115", zip)
+
+	res, err := json.Marshal(customer)
+	require.NoError(t, err)
+	assert.JSONEq(t, data, string(res))
+}
+
+func TestCaptureUnknownMasksSensitiveData(t *testing.T) {
+	customer := Customer{
+		ID:    "c1",
+		Email: ptr("jane@example.com"),
+		Address: Address{
+			City:                 ptr("Berlin"),
+			CardNumber:           ptr("4111111111111111"),
+			AdditionalProperties: map[string]string{"zip": "10115"},
+		},
+		AdditionalProperties: map[string]json.RawMessage{"tier": json.RawMessage(`"gold"`)},
+	}
+
+	res, err := json.Marshal(customer)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"id": "c1",
+		"email": "********",
+		"address": {"city": "Berlin", "cardNumber": "********1111", "zip": "10115"},
+		"tier": "gold"
+	}`, string(res))
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
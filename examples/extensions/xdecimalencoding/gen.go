@@ -0,0 +1,65 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package xdecimalencoding
+
+import (
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+)
+
+type Payment struct {
+	// Amount Encoded as a JSON string, like the schema type.
+	Amount decimal.Decimal `json:"amount" validate:"required"`
+
+	// Fee Encoded as a JSON number, like the schema type.
+	Fee *runtime.DecimalNumber[decimal.Decimal] `json:"fee,omitempty"`
+
+	// ExchangeRate A string schema encoded as a JSON number.
+	ExchangeRate *runtime.DecimalNumber[decimal.Decimal] `json:"exchangeRate,omitempty"`
+
+	// Discount A decimal regardless of the decimal-type configuration.
+	Discount *decimal.Decimal `json:"discount,omitempty"`
+}
+
+func (p Payment) Validate() error {
+	var errors runtime.ValidationErrors
+	if v, ok := any(p.Amount).(runtime.Validator); ok {
+		if err := v.Validate(); err != nil {
+			errors = errors.Append("Amount", err)
+		}
+	}
+	if p.Fee != nil {
+		if v, ok := any(p.Fee).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Fee", err)
+			}
+		}
+	}
+	if p.ExchangeRate != nil {
+		if v, ok := any(p.ExchangeRate).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("ExchangeRate", err)
+			}
+		}
+	}
+	if p.Discount != nil {
+		if v, ok := any(p.Discount).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Discount", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
+}
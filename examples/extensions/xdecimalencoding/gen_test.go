@@ -0,0 +1,34 @@
+package xdecimalencoding
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecimalEncoding(t *testing.T) {
+	payment := Payment{
+		Amount:       decimal.RequireFromString("19.99"),
+		Fee:          &runtime.DecimalNumber[decimal.Decimal]{Decimal: decimal.RequireFromString("0.30")},
+		ExchangeRate: &runtime.DecimalNumber[decimal.Decimal]{Decimal: decimal.RequireFromString("1.0857")},
+	}
+
+	res, err := json.Marshal(payment)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"amount":"19.99","fee":0.3,"exchangeRate":1.0857}`, string(res))
+}
+
+func TestDecimalPrecision(t *testing.T) {
+	var payment Payment
+	err := json.Unmarshal([]byte(`{"amount":"0.1","fee":0.2,"discount":"12345678901234567890.000000000001"}`), &payment)
+	require.NoError(t, err)
+
+	sum := payment.Amount.Add(payment.Fee.Decimal)
+	assert.Equal(t, "0.3", sum.String())
+	assert.Equal(t, "12345678901234567890.000000000001", payment.Discount.String())
+	require.NoError(t, payment.Validate())
+}
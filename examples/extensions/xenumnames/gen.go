@@ -26,6 +26,71 @@ func (c ClientType) Validate() error {
 	}
 }
 
+// clientTypeNames maps ClientType values to their names.
+var clientTypeNames = map[ClientType]string{
+	ACT: "ACT",
+	EXP: "EXP",
+}
+
+// clientTypeValues maps names to ClientType values.
+var clientTypeValues = map[string]ClientType{
+	"ACT": ACT,
+	"EXP": EXP,
+}
+
+// String returns the wire value of the ClientType.
+func (c ClientType) String() string {
+	return string(c)
+}
+
+// IsValid reports whether the ClientType value is defined in the spec.
+func (c ClientType) IsValid() bool {
+	_, ok := clientTypeNames[c]
+	return ok
+}
+
+// Values returns all the ClientType values defined in the spec.
+func (ClientType) Values() []ClientType {
+	return []ClientType{
+		ACT,
+		EXP,
+	}
+}
+
+// ClientTypePtr returns a pointer to v, to set the optional and nullable ClientType properties.
+func ClientTypePtr(v ClientType) *ClientType {
+	return &v
+}
+
+// Name returns the name of the ClientType value, or an empty string for unknown values.
+func (c ClientType) Name() string {
+	return clientTypeNames[c]
+}
+
+// ParseClientType returns the ClientType matching s by wire value or by name.
+func ParseClientType(s string) (ClientType, error) {
+	if _, ok := clientTypeNames[ClientType(s)]; ok {
+		return ClientType(s), nil
+	}
+	if v, ok := clientTypeValues[s]; ok {
+		return v, nil
+	}
+	var zero ClientType
+	return zero, fmt.Errorf("%w for ClientType: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (c ClientType) MarshalText() ([]byte, error) {
+	return []byte(c), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseClientType.
+// Unknown values are kept as-is and reported by Validate.
+func (c *ClientType) UnmarshalText(text []byte) error {
+	*c = ClientType(text)
+	return nil
+}
+
 type ClientTypeWithNamesExtension string
 
 const (
@@ -43,9 +108,75 @@ func (c ClientTypeWithNamesExtension) Validate() error {
 	}
 }
 
+// clientTypeWithNamesExtensionNames maps ClientTypeWithNamesExtension values to their names.
+var clientTypeWithNamesExtensionNames = map[ClientTypeWithNamesExtension]string{
+	Active:  "Active",
+	Expired: "Expired",
+}
+
+// clientTypeWithNamesExtensionValues maps names to ClientTypeWithNamesExtension values.
+var clientTypeWithNamesExtensionValues = map[string]ClientTypeWithNamesExtension{
+	"Active":  Active,
+	"Expired": Expired,
+}
+
+// String returns the wire value of the ClientTypeWithNamesExtension.
+func (c ClientTypeWithNamesExtension) String() string {
+	return string(c)
+}
+
+// IsValid reports whether the ClientTypeWithNamesExtension value is defined in the spec.
+func (c ClientTypeWithNamesExtension) IsValid() bool {
+	_, ok := clientTypeWithNamesExtensionNames[c]
+	return ok
+}
+
+// Values returns all the ClientTypeWithNamesExtension values defined in the spec.
+func (ClientTypeWithNamesExtension) Values() []ClientTypeWithNamesExtension {
+	return []ClientTypeWithNamesExtension{
+		Active,
+		Expired,
+	}
+}
+
+// ClientTypeWithNamesExtensionPtr returns a pointer to v, to set the optional and nullable ClientTypeWithNamesExtension properties.
+func ClientTypeWithNamesExtensionPtr(v ClientTypeWithNamesExtension) *ClientTypeWithNamesExtension {
+	return &v
+}
+
+// Name returns the name of the ClientTypeWithNamesExtension value, or an empty string for unknown values.
+func (c ClientTypeWithNamesExtension) Name() string {
+	return clientTypeWithNamesExtensionNames[c]
+}
+
+// ParseClientTypeWithNamesExtension returns the ClientTypeWithNamesExtension matching s by wire value or by name.
+func ParseClientTypeWithNamesExtension(s string) (ClientTypeWithNamesExtension, error) {
+	if _, ok := clientTypeWithNamesExtensionNames[ClientTypeWithNamesExtension(s)]; ok {
+		return ClientTypeWithNamesExtension(s), nil
+	}
+	if v, ok := clientTypeWithNamesExtensionValues[s]; ok {
+		return v, nil
+	}
+	var zero ClientTypeWithNamesExtension
+	return zero, fmt.Errorf("%w for ClientTypeWithNamesExtension: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (c ClientTypeWithNamesExtension) MarshalText() ([]byte, error) {
+	return []byte(c), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseClientTypeWithNamesExtension.
+// Unknown values are kept as-is and reported by Validate.
+func (c *ClientTypeWithNamesExtension) UnmarshalText(text []byte) error {
+	*c = ClientTypeWithNamesExtension(text)
+	return nil
+}
+
 var typesValidator *validator.Validate
 
 func init() {
 	typesValidator = validator.New(validator.WithRequiredStructEnabled())
 	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
 }
@@ -74,3 +74,64 @@ func TestClientTypeWithNamesExtension_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestClientTypeWithNamesExtension_Names(t *testing.T) {
+	if got := Active.String(); got != "ACT" {
+		t.Errorf("Active.String() = %q, want %q", got, "ACT")
+	}
+	if got := Active.Name(); got != "Active" {
+		t.Errorf("Active.Name() = %q, want %q", got, "Active")
+	}
+	if got := ClientTypeWithNamesExtension("INVALID").Name(); got != "" {
+		t.Errorf("unknown Name() = %q, want empty", got)
+	}
+
+	for _, s := range []string{"EXP", "Expired"} {
+		got, err := ParseClientTypeWithNamesExtension(s)
+		if err != nil || got != Expired {
+			t.Errorf("ParseClientTypeWithNamesExtension(%q) = %q, %v, want %q", s, got, err, Expired)
+		}
+	}
+	if _, err := ParseClientTypeWithNamesExtension("INVALID"); err == nil {
+		t.Error("ParseClientTypeWithNamesExtension(INVALID) expected an error")
+	}
+}
+
+func TestClientTypeWithNamesExtension_Text(t *testing.T) {
+	text, err := Expired.MarshalText()
+	if err != nil || string(text) != "EXP" {
+		t.Errorf("Expired.MarshalText() = %q, %v, want %q", text, err, "EXP")
+	}
+
+	tests := []struct {
+		text string
+		want ClientTypeWithNamesExtension
+	}{
+		{text: "ACT", want: Active},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			var got ClientTypeWithNamesExtension
+			if err := got.UnmarshalText([]byte(tt.text)); err != nil {
+				t.Fatalf("UnmarshalText(%q) error = %v", tt.text, err)
+			}
+			if got != tt.want {
+				t.Errorf("UnmarshalText(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+
+	// names are only parsed by ParseClientTypeWithNamesExtension, not on the wire
+	for _, text := range []string{"NEW", "Active"} {
+		t.Run(text, func(t *testing.T) {
+			var got ClientTypeWithNamesExtension
+			if err := got.UnmarshalText([]byte(text)); err != nil {
+				t.Fatalf("UnmarshalText(%q) error = %v", text, err)
+			}
+			if got.IsValid() {
+				t.Errorf("UnmarshalText(%q) = %q, want an unknown value", text, got)
+			}
+		})
+	}
+}
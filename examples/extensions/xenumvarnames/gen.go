@@ -0,0 +1,122 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package xenumvarnames
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/go-playground/validator/v10"
+)
+
+type Status int
+
+const (
+	// StatusActive Approved and in use
+	StatusActive Status = 2
+	// StatusClosed No longer in use
+	StatusClosed Status = 3
+	// StatusPending Waiting for approval
+	StatusPending Status = 1
+)
+
+// Validate checks if the Status value is valid
+func (s Status) Validate() error {
+	switch s {
+	case StatusActive, StatusClosed, StatusPending:
+		return nil
+	default:
+		return runtime.NewValidationErrorsFromString("Enum", fmt.Sprintf("must be a valid Status value, got: %v", s))
+	}
+}
+
+// statusNames maps Status values to their names.
+var statusNames = map[Status]string{
+	StatusActive:  "StatusActive",
+	StatusClosed:  "StatusClosed",
+	StatusPending: "StatusPending",
+}
+
+// statusValues maps names to Status values.
+var statusValues = map[string]Status{
+	"StatusActive":  StatusActive,
+	"StatusClosed":  StatusClosed,
+	"StatusPending": StatusPending,
+}
+
+// String returns the wire value of the Status.
+func (s Status) String() string {
+	return fmt.Sprint(int(s))
+}
+
+// IsValid reports whether the Status value is defined in the spec.
+func (s Status) IsValid() bool {
+	_, ok := statusNames[s]
+	return ok
+}
+
+// Values returns all the Status values defined in the spec.
+func (Status) Values() []Status {
+	return []Status{
+		StatusActive,
+		StatusClosed,
+		StatusPending,
+	}
+}
+
+// StatusPtr returns a pointer to v, to set the optional and nullable Status properties.
+func StatusPtr(v Status) *Status {
+	return &v
+}
+
+// Name returns the name of the Status value, or an empty string for unknown values.
+func (s Status) Name() string {
+	return statusNames[s]
+}
+
+// ParseStatus returns the Status matching s by wire value or by name.
+func ParseStatus(s string) (Status, error) {
+	switch s {
+	case "2":
+		return StatusActive, nil
+	case "3":
+		return StatusClosed, nil
+	case "1":
+		return StatusPending, nil
+	}
+	if v, ok := statusValues[s]; ok {
+		return v, nil
+	}
+	var zero Status
+	return zero, fmt.Errorf("%w for Status: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value, e.g. for map keys and parameters.
+func (s Status) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseStatus.
+// Unknown values are kept as-is and reported by Validate.
+func (s *Status) UnmarshalText(text []byte) error {
+	return runtime.UnmarshalEnumText(text, s)
+}
+
+// MarshalJSON writes the Status as a JSON number rather than the string of MarshalText.
+func (s Status) MarshalJSON() ([]byte, error) {
+	return json.Marshal(int(s))
+}
+
+// UnmarshalJSON reads the Status from a JSON number rather than the string of UnmarshalText.
+func (s *Status) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, (*int)(s))
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
+}
@@ -0,0 +1,51 @@
+package xenumvarnames
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestStatus_VarNames(t *testing.T) {
+	tests := []struct {
+		value Status
+		name  string
+		raw   string
+	}{
+		{value: StatusPending, name: "StatusPending", raw: "1"},
+		{value: StatusActive, name: "StatusActive", raw: "2"},
+		{value: StatusClosed, name: "StatusClosed", raw: "3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.value.Name(); got != tt.name {
+				t.Errorf("Status.Name() = %q, want %q", got, tt.name)
+			}
+
+			var s Status
+			if err := json.Unmarshal([]byte(tt.raw), &s); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+			if s != tt.value {
+				t.Errorf("json.Unmarshal() = %v, want %v", s, tt.value)
+			}
+
+			parsed, err := ParseStatus(tt.name)
+			if err != nil {
+				t.Fatalf("ParseStatus() error = %v", err)
+			}
+			if parsed != tt.value {
+				t.Errorf("ParseStatus() = %v, want %v", parsed, tt.value)
+			}
+		})
+	}
+}
+
+func TestStatus_Validate(t *testing.T) {
+	if err := StatusActive.Validate(); err != nil {
+		t.Errorf("Status.Validate() error = %v", err)
+	}
+	if err := Status(4).Validate(); err == nil {
+		t.Error("Status.Validate() expected an error for an unknown value")
+	}
+}
@@ -23,6 +23,7 @@ func NewCustomClientName(apiClient runtime.APIClient) *CustomClientName {
 
 // NewDefaultCustomClientName creates a new instance of the CustomClientName client with default api client.
 func NewDefaultCustomClientName(baseURL string, opts ...runtime.APIClientOption) (*CustomClientName, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithUserAgent("x-go-name/1.0.0 oapi-codegen-dd/v3.63.4")}, opts...)
 	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("error creating API client: %w", err)
@@ -37,28 +38,36 @@ type CustomClientNameInterface interface {
 
 func (c *CustomClientName) CreateClient(ctx context.Context, options *CreateClientRequestOptions, reqEditors ...runtime.RequestEditorFn) (*CreateClientResponse, error) {
 	var err error
+	if options != nil && options.Body != nil {
+		if v, ok := any(options.Body).(runtime.Validator); ok {
+			if err = v.Validate(); err != nil {
+				return nil, fmt.Errorf("error validating request body: %w", err)
+			}
+		}
+	}
 	reqParams := runtime.RequestOptionsParameters{
 		RequestURL:  c.apiClient.GetBaseURL() + "/clients",
 		Method:      "POST",
-		Options:     options,
 		ContentType: "application/json",
 	}
+	if options != nil {
+		reqParams.Options = options
+	}
 
 	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
 	if err != nil {
-		return nil, fmt.Errorf("error creating request: %w", err)
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
 	}
 
 	responseParser := func(ctx context.Context, resp *runtime.Response) (*CreateClientResponse, error) {
 		bodyBytes := resp.Content
 		if resp.StatusCode != 200 {
-			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+			return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
 				runtime.WithStatusCode(resp.StatusCode))
 		}
 		target := new(CreateClientResponse)
 		if err = json.Unmarshal(bodyBytes, target); err != nil {
-			err = fmt.Errorf("error decoding response: %w", err)
-			return nil, err
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
 		}
 		return target, nil
 	}
@@ -106,8 +115,12 @@ func (o *CreateClientRequestOptions) GetQuery() (map[string]any, error) {
 	return nil, nil
 }
 
-// GetBody returns the payload in any type that can be marshalled to JSON by the client.
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
 func (o *CreateClientRequestOptions) GetBody() any {
+	if o.Body == nil {
+		return nil
+	}
 	return o.Body
 }
 
@@ -143,4 +156,5 @@ var typesValidator *validator.Validate
 func init() {
 	typesValidator = validator.New(validator.WithRequiredStructEnabled())
 	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
 }
@@ -0,0 +1,68 @@
+package xgoomitvalidation_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/examples/extensions/xgoomitvalidation"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// httpClientAdapter wraps http.Client to implement runtime.HttpRequestDoer
+type httpClientAdapter struct {
+	client *http.Client
+}
+
+func (a *httpClientAdapter) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return a.client.Do(req.WithContext(ctx))
+}
+
+func TestOmittedTypesHaveNoValidate(t *testing.T) {
+	assert.NotImplements(t, (*runtime.Validator)(nil), xgoomitvalidation.VendorPayload{})
+	assert.NotImplements(t, (*runtime.Validator)(nil), xgoomitvalidation.ProxyEventBody{})
+	assert.NotImplements(t, (*runtime.Validator)(nil), xgoomitvalidation.ProxyEventQuery{})
+	assert.NotImplements(t, (*runtime.Validator)(nil), &xgoomitvalidation.ProxyEventRequestOptions{})
+
+	assert.Implements(t, (*runtime.Validator)(nil), xgoomitvalidation.Order{})
+	assert.Implements(t, (*runtime.Validator)(nil), &xgoomitvalidation.CreateOrderRequestOptions{})
+}
+
+func TestOrderSkipsVendorValidation(t *testing.T) {
+	order := xgoomitvalidation.Order{
+		ID:     "o-1",
+		Vendor: xgoomitvalidation.VendorPayload{Name: "longer than five"},
+	}
+	assert.NoError(t, order.Validate())
+
+	order.ID = ""
+	assert.Error(t, order.Validate())
+}
+
+func TestProxyEventSendsUnvalidatedPayload(t *testing.T) {
+	var body, query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+		query = r.URL.RawQuery
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	apiClient, err := runtime.NewAPIClient(server.URL, runtime.WithHTTPClient(&httpClientAdapter{client: server.Client()}))
+	require.NoError(t, err)
+	client := xgoomitvalidation.NewClient(apiClient)
+
+	_, err = client.ProxyEvent(context.Background(), &xgoomitvalidation.ProxyEventRequestOptions{
+		Query: &xgoomitvalidation.ProxyEventQuery{Source: "x"},
+		Body:  &xgoomitvalidation.ProxyEventBody{Payload: "much longer than ten characters"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "source=x", query)
+	assert.JSONEq(t, `{"payload":"much longer than ten characters"}`, body)
+}
@@ -0,0 +1,50 @@
+package xgotimeformat_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/examples/extensions/xgotimeformat"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// httpClientAdapter wraps http.Client to implement runtime.HttpRequestDoer
+type httpClientAdapter struct {
+	client *http.Client
+}
+
+func (a *httpClientAdapter) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return a.client.Do(req.WithContext(ctx))
+}
+
+func TestListEventsQuery(t *testing.T) {
+	var query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	apiClient, err := runtime.NewAPIClient(server.URL, runtime.WithHTTPClient(&httpClientAdapter{client: server.Client()}))
+	require.NoError(t, err)
+	client := xgotimeformat.NewClient(apiClient)
+
+	since := time.Date(2025, 3, 4, 5, 6, 7, 0, time.UTC)
+	until := since.Add(36 * time.Hour)
+	_, err = client.ListEvents(context.Background(), &xgotimeformat.ListEventsRequestOptions{
+		Query: &xgotimeformat.ListEventsQuery{
+			Since:        &since,
+			Until:        &until,
+			Days:         []time.Time{since, until},
+			CreatedAfter: &since,
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "createdAfter=2025-03-04T05%3A06%3A07Z&days=2025-03-04,2025-03-05&since=1741064767&until=2025-03-05T17%3A06", query)
+}
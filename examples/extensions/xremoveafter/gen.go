@@ -0,0 +1,27 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package xremoveafter
+
+import (
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/go-playground/validator/v10"
+)
+
+type Client struct {
+	Name string `json:"name" validate:"required"`
+	// Deprecated: Use name instead
+	// To be removed after 2999-12-01.
+	Nickname *string `json:"nickname,omitempty"`
+}
+
+func (c Client) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(c))
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
+}
@@ -17,8 +17,8 @@ type User struct {
 	ID         int64   `json:"id" validate:"required"`
 	Username   string  `json:"username" validate:"required"`
 	Email      *string `json:"email,omitempty" sensitive:""`
-	Ssn        *string `json:"ssn,omitempty" sensitive:""`
-	CreditCard *string `json:"creditCard,omitempty" sensitive:""`
+	Ssn        *string `json:"ssn,omitempty" sensitive:"" validate:"omitempty,max=11,min=11"`
+	CreditCard *string `json:"creditCard,omitempty" sensitive:"" validate:"omitempty,max=19"`
 	APIKey     *string `json:"apiKey,omitempty" sensitive:""`
 }
 
@@ -98,6 +98,15 @@ func (u User) MarshalJSON() ([]byte, error) {
 	return json.Marshal(masked)
 }
 
+// MarshalJSONUnmasked marshals User with its sensitive properties unmasked, for trusted code persisting it.
+// See runtime.MarshalUnmasked.
+func (u User) MarshalJSONUnmasked() ([]byte, error) {
+	type _Alias_User User
+	unmasked := _Alias_User(u)
+
+	return json.Marshal(unmasked)
+}
+
 func (u *User) UnmarshalJSON(data []byte) error {
 	trim := bytes.TrimSpace(data)
 	if bytes.Equal(trim, []byte("null")) {
@@ -124,4 +133,5 @@ var typesValidator *validator.Validate
 func init() {
 	typesValidator = validator.New(validator.WithRequiredStructEnabled())
 	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
 }
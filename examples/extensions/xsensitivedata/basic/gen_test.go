@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"strings"
 	"testing"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
 )
 
 func TestUserMarshalJSON_SensitiveData(t *testing.T) {
@@ -110,3 +112,34 @@ func TestUserUnmarshalJSON(t *testing.T) {
 		t.Errorf("Expected email 'user@example.com', got %v", user.Email)
 	}
 }
+
+func TestUserMarshalJSONUnmasked(t *testing.T) {
+	email := "user@example.com"
+	ssn := "123-45-6789"
+	user := User{ID: 1, Username: "testuser", Email: &email, Ssn: &ssn}
+
+	data, err := runtime.MarshalUnmasked(user)
+	if err != nil {
+		t.Fatalf("Failed to marshal user: %v", err)
+	}
+
+	var decoded User
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Failed to unmarshal user: %v", err)
+	}
+	if decoded.Email == nil || *decoded.Email != email {
+		t.Errorf("Expected email %q, got %v", email, decoded.Email)
+	}
+	if decoded.Ssn == nil || *decoded.Ssn != ssn {
+		t.Errorf("Expected SSN %q, got %v", ssn, decoded.Ssn)
+	}
+
+	// public marshaling stays masked
+	data, err = json.Marshal(user)
+	if err != nil {
+		t.Fatalf("Failed to marshal user: %v", err)
+	}
+	if strings.Contains(string(data), email) {
+		t.Error("Email should be masked but found in JSON")
+	}
+}
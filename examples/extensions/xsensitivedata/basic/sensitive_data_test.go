@@ -0,0 +1,55 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package xsensitivedata
+
+import (
+	"encoding/json"
+	"testing"
+	"unicode/utf8"
+)
+
+// TestUser_SensitiveDataWireFormat checks that the masked JSON of User still matches its schema.
+func TestUser_SensitiveDataWireFormat(t *testing.T) {
+	var v User
+	{
+		sample := "1234567890123456"
+		v.Email = &sample
+	}
+	{
+		sample := "12345678901"
+		v.Ssn = &sample
+	}
+	{
+		sample := "1234567890123456"
+		v.CreditCard = &sample
+	}
+	{
+		sample := "1234567890123456"
+		v.APIKey = &sample
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("error marshaling User: %v", err)
+	}
+
+	var masked User
+	if err := json.Unmarshal(data, &masked); err != nil {
+		t.Fatalf("error unmarshaling masked User %s: %v", data, err)
+	}
+	if masked.Ssn != nil {
+		n := utf8.RuneCountInString(*masked.Ssn)
+		if n < 11 {
+			t.Errorf("masked User.Ssn has %d characters, fewer than the minLength of 11", n)
+		}
+		if n > 11 {
+			t.Errorf("masked User.Ssn has %d characters, more than the maxLength of 11", n)
+		}
+	}
+	if masked.CreditCard != nil {
+		n := utf8.RuneCountInString(*masked.CreditCard)
+		if n > 19 {
+			t.Errorf("masked User.CreditCard has %d characters, more than the maxLength of 19", n)
+		}
+	}
+}
@@ -27,6 +27,68 @@ func (c CreditCardPaymentType) Validate() error {
 	}
 }
 
+// creditCardPaymentTypeNames maps CreditCardPaymentType values to their names.
+var creditCardPaymentTypeNames = map[CreditCardPaymentType]string{
+	CreditCard: "CreditCard",
+}
+
+// creditCardPaymentTypeValues maps names to CreditCardPaymentType values.
+var creditCardPaymentTypeValues = map[string]CreditCardPaymentType{
+	"CreditCard": CreditCard,
+}
+
+// String returns the wire value of the CreditCardPaymentType.
+func (c CreditCardPaymentType) String() string {
+	return string(c)
+}
+
+// IsValid reports whether the CreditCardPaymentType value is defined in the spec.
+func (c CreditCardPaymentType) IsValid() bool {
+	_, ok := creditCardPaymentTypeNames[c]
+	return ok
+}
+
+// Values returns all the CreditCardPaymentType values defined in the spec.
+func (CreditCardPaymentType) Values() []CreditCardPaymentType {
+	return []CreditCardPaymentType{
+		CreditCard,
+	}
+}
+
+// CreditCardPaymentTypePtr returns a pointer to v, to set the optional and nullable CreditCardPaymentType properties.
+func CreditCardPaymentTypePtr(v CreditCardPaymentType) *CreditCardPaymentType {
+	return &v
+}
+
+// Name returns the name of the CreditCardPaymentType value, or an empty string for unknown values.
+func (c CreditCardPaymentType) Name() string {
+	return creditCardPaymentTypeNames[c]
+}
+
+// ParseCreditCardPaymentType returns the CreditCardPaymentType matching s by wire value or by name.
+func ParseCreditCardPaymentType(s string) (CreditCardPaymentType, error) {
+	if _, ok := creditCardPaymentTypeNames[CreditCardPaymentType(s)]; ok {
+		return CreditCardPaymentType(s), nil
+	}
+	if v, ok := creditCardPaymentTypeValues[s]; ok {
+		return v, nil
+	}
+	var zero CreditCardPaymentType
+	return zero, fmt.Errorf("%w for CreditCardPaymentType: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (c CreditCardPaymentType) MarshalText() ([]byte, error) {
+	return []byte(c), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseCreditCardPaymentType.
+// Unknown values are kept as-is and reported by Validate.
+func (c *CreditCardPaymentType) UnmarshalText(text []byte) error {
+	*c = CreditCardPaymentType(text)
+	return nil
+}
+
 type BankTransferPaymentType string
 
 const (
@@ -43,6 +105,68 @@ func (b BankTransferPaymentType) Validate() error {
 	}
 }
 
+// bankTransferPaymentTypeNames maps BankTransferPaymentType values to their names.
+var bankTransferPaymentTypeNames = map[BankTransferPaymentType]string{
+	BankTransfer: "BankTransfer",
+}
+
+// bankTransferPaymentTypeValues maps names to BankTransferPaymentType values.
+var bankTransferPaymentTypeValues = map[string]BankTransferPaymentType{
+	"BankTransfer": BankTransfer,
+}
+
+// String returns the wire value of the BankTransferPaymentType.
+func (b BankTransferPaymentType) String() string {
+	return string(b)
+}
+
+// IsValid reports whether the BankTransferPaymentType value is defined in the spec.
+func (b BankTransferPaymentType) IsValid() bool {
+	_, ok := bankTransferPaymentTypeNames[b]
+	return ok
+}
+
+// Values returns all the BankTransferPaymentType values defined in the spec.
+func (BankTransferPaymentType) Values() []BankTransferPaymentType {
+	return []BankTransferPaymentType{
+		BankTransfer,
+	}
+}
+
+// BankTransferPaymentTypePtr returns a pointer to v, to set the optional and nullable BankTransferPaymentType properties.
+func BankTransferPaymentTypePtr(v BankTransferPaymentType) *BankTransferPaymentType {
+	return &v
+}
+
+// Name returns the name of the BankTransferPaymentType value, or an empty string for unknown values.
+func (b BankTransferPaymentType) Name() string {
+	return bankTransferPaymentTypeNames[b]
+}
+
+// ParseBankTransferPaymentType returns the BankTransferPaymentType matching s by wire value or by name.
+func ParseBankTransferPaymentType(s string) (BankTransferPaymentType, error) {
+	if _, ok := bankTransferPaymentTypeNames[BankTransferPaymentType(s)]; ok {
+		return BankTransferPaymentType(s), nil
+	}
+	if v, ok := bankTransferPaymentTypeValues[s]; ok {
+		return v, nil
+	}
+	var zero BankTransferPaymentType
+	return zero, fmt.Errorf("%w for BankTransferPaymentType: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (b BankTransferPaymentType) MarshalText() ([]byte, error) {
+	return []byte(b), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseBankTransferPaymentType.
+// Unknown values are kept as-is and reported by Validate.
+func (b *BankTransferPaymentType) UnmarshalText(text []byte) error {
+	*b = BankTransferPaymentType(text)
+	return nil
+}
+
 type DomesticAccountAccountType string
 
 const (
@@ -59,6 +183,68 @@ func (d DomesticAccountAccountType) Validate() error {
 	}
 }
 
+// domesticAccountAccountTypeNames maps DomesticAccountAccountType values to their names.
+var domesticAccountAccountTypeNames = map[DomesticAccountAccountType]string{
+	Domestic: "Domestic",
+}
+
+// domesticAccountAccountTypeValues maps names to DomesticAccountAccountType values.
+var domesticAccountAccountTypeValues = map[string]DomesticAccountAccountType{
+	"Domestic": Domestic,
+}
+
+// String returns the wire value of the DomesticAccountAccountType.
+func (d DomesticAccountAccountType) String() string {
+	return string(d)
+}
+
+// IsValid reports whether the DomesticAccountAccountType value is defined in the spec.
+func (d DomesticAccountAccountType) IsValid() bool {
+	_, ok := domesticAccountAccountTypeNames[d]
+	return ok
+}
+
+// Values returns all the DomesticAccountAccountType values defined in the spec.
+func (DomesticAccountAccountType) Values() []DomesticAccountAccountType {
+	return []DomesticAccountAccountType{
+		Domestic,
+	}
+}
+
+// DomesticAccountAccountTypePtr returns a pointer to v, to set the optional and nullable DomesticAccountAccountType properties.
+func DomesticAccountAccountTypePtr(v DomesticAccountAccountType) *DomesticAccountAccountType {
+	return &v
+}
+
+// Name returns the name of the DomesticAccountAccountType value, or an empty string for unknown values.
+func (d DomesticAccountAccountType) Name() string {
+	return domesticAccountAccountTypeNames[d]
+}
+
+// ParseDomesticAccountAccountType returns the DomesticAccountAccountType matching s by wire value or by name.
+func ParseDomesticAccountAccountType(s string) (DomesticAccountAccountType, error) {
+	if _, ok := domesticAccountAccountTypeNames[DomesticAccountAccountType(s)]; ok {
+		return DomesticAccountAccountType(s), nil
+	}
+	if v, ok := domesticAccountAccountTypeValues[s]; ok {
+		return v, nil
+	}
+	var zero DomesticAccountAccountType
+	return zero, fmt.Errorf("%w for DomesticAccountAccountType: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (d DomesticAccountAccountType) MarshalText() ([]byte, error) {
+	return []byte(d), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseDomesticAccountAccountType.
+// Unknown values are kept as-is and reported by Validate.
+func (d *DomesticAccountAccountType) UnmarshalText(text []byte) error {
+	*d = DomesticAccountAccountType(text)
+	return nil
+}
+
 type InternationalAccountAccountType string
 
 const (
@@ -75,6 +261,68 @@ func (i InternationalAccountAccountType) Validate() error {
 	}
 }
 
+// internationalAccountAccountTypeNames maps InternationalAccountAccountType values to their names.
+var internationalAccountAccountTypeNames = map[InternationalAccountAccountType]string{
+	International: "International",
+}
+
+// internationalAccountAccountTypeValues maps names to InternationalAccountAccountType values.
+var internationalAccountAccountTypeValues = map[string]InternationalAccountAccountType{
+	"International": International,
+}
+
+// String returns the wire value of the InternationalAccountAccountType.
+func (i InternationalAccountAccountType) String() string {
+	return string(i)
+}
+
+// IsValid reports whether the InternationalAccountAccountType value is defined in the spec.
+func (i InternationalAccountAccountType) IsValid() bool {
+	_, ok := internationalAccountAccountTypeNames[i]
+	return ok
+}
+
+// Values returns all the InternationalAccountAccountType values defined in the spec.
+func (InternationalAccountAccountType) Values() []InternationalAccountAccountType {
+	return []InternationalAccountAccountType{
+		International,
+	}
+}
+
+// InternationalAccountAccountTypePtr returns a pointer to v, to set the optional and nullable InternationalAccountAccountType properties.
+func InternationalAccountAccountTypePtr(v InternationalAccountAccountType) *InternationalAccountAccountType {
+	return &v
+}
+
+// Name returns the name of the InternationalAccountAccountType value, or an empty string for unknown values.
+func (i InternationalAccountAccountType) Name() string {
+	return internationalAccountAccountTypeNames[i]
+}
+
+// ParseInternationalAccountAccountType returns the InternationalAccountAccountType matching s by wire value or by name.
+func ParseInternationalAccountAccountType(s string) (InternationalAccountAccountType, error) {
+	if _, ok := internationalAccountAccountTypeNames[InternationalAccountAccountType(s)]; ok {
+		return InternationalAccountAccountType(s), nil
+	}
+	if v, ok := internationalAccountAccountTypeValues[s]; ok {
+		return v, nil
+	}
+	var zero InternationalAccountAccountType
+	return zero, fmt.Errorf("%w for InternationalAccountAccountType: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (i InternationalAccountAccountType) MarshalText() ([]byte, error) {
+	return []byte(i), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseInternationalAccountAccountType.
+// Unknown values are kept as-is and reported by Validate.
+func (i *InternationalAccountAccountType) UnmarshalText(text []byte) error {
+	*i = InternationalAccountAccountType(text)
+	return nil
+}
+
 type PersonalBeneficiaryBeneficiaryType string
 
 const (
@@ -91,6 +339,68 @@ func (p PersonalBeneficiaryBeneficiaryType) Validate() error {
 	}
 }
 
+// personalBeneficiaryBeneficiaryTypeNames maps PersonalBeneficiaryBeneficiaryType values to their names.
+var personalBeneficiaryBeneficiaryTypeNames = map[PersonalBeneficiaryBeneficiaryType]string{
+	Personal: "Personal",
+}
+
+// personalBeneficiaryBeneficiaryTypeValues maps names to PersonalBeneficiaryBeneficiaryType values.
+var personalBeneficiaryBeneficiaryTypeValues = map[string]PersonalBeneficiaryBeneficiaryType{
+	"Personal": Personal,
+}
+
+// String returns the wire value of the PersonalBeneficiaryBeneficiaryType.
+func (p PersonalBeneficiaryBeneficiaryType) String() string {
+	return string(p)
+}
+
+// IsValid reports whether the PersonalBeneficiaryBeneficiaryType value is defined in the spec.
+func (p PersonalBeneficiaryBeneficiaryType) IsValid() bool {
+	_, ok := personalBeneficiaryBeneficiaryTypeNames[p]
+	return ok
+}
+
+// Values returns all the PersonalBeneficiaryBeneficiaryType values defined in the spec.
+func (PersonalBeneficiaryBeneficiaryType) Values() []PersonalBeneficiaryBeneficiaryType {
+	return []PersonalBeneficiaryBeneficiaryType{
+		Personal,
+	}
+}
+
+// PersonalBeneficiaryBeneficiaryTypePtr returns a pointer to v, to set the optional and nullable PersonalBeneficiaryBeneficiaryType properties.
+func PersonalBeneficiaryBeneficiaryTypePtr(v PersonalBeneficiaryBeneficiaryType) *PersonalBeneficiaryBeneficiaryType {
+	return &v
+}
+
+// Name returns the name of the PersonalBeneficiaryBeneficiaryType value, or an empty string for unknown values.
+func (p PersonalBeneficiaryBeneficiaryType) Name() string {
+	return personalBeneficiaryBeneficiaryTypeNames[p]
+}
+
+// ParsePersonalBeneficiaryBeneficiaryType returns the PersonalBeneficiaryBeneficiaryType matching s by wire value or by name.
+func ParsePersonalBeneficiaryBeneficiaryType(s string) (PersonalBeneficiaryBeneficiaryType, error) {
+	if _, ok := personalBeneficiaryBeneficiaryTypeNames[PersonalBeneficiaryBeneficiaryType(s)]; ok {
+		return PersonalBeneficiaryBeneficiaryType(s), nil
+	}
+	if v, ok := personalBeneficiaryBeneficiaryTypeValues[s]; ok {
+		return v, nil
+	}
+	var zero PersonalBeneficiaryBeneficiaryType
+	return zero, fmt.Errorf("%w for PersonalBeneficiaryBeneficiaryType: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (p PersonalBeneficiaryBeneficiaryType) MarshalText() ([]byte, error) {
+	return []byte(p), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParsePersonalBeneficiaryBeneficiaryType.
+// Unknown values are kept as-is and reported by Validate.
+func (p *PersonalBeneficiaryBeneficiaryType) UnmarshalText(text []byte) error {
+	*p = PersonalBeneficiaryBeneficiaryType(text)
+	return nil
+}
+
 type BusinessBeneficiaryBeneficiaryType string
 
 const (
@@ -107,6 +417,68 @@ func (b BusinessBeneficiaryBeneficiaryType) Validate() error {
 	}
 }
 
+// businessBeneficiaryBeneficiaryTypeNames maps BusinessBeneficiaryBeneficiaryType values to their names.
+var businessBeneficiaryBeneficiaryTypeNames = map[BusinessBeneficiaryBeneficiaryType]string{
+	Business: "Business",
+}
+
+// businessBeneficiaryBeneficiaryTypeValues maps names to BusinessBeneficiaryBeneficiaryType values.
+var businessBeneficiaryBeneficiaryTypeValues = map[string]BusinessBeneficiaryBeneficiaryType{
+	"Business": Business,
+}
+
+// String returns the wire value of the BusinessBeneficiaryBeneficiaryType.
+func (b BusinessBeneficiaryBeneficiaryType) String() string {
+	return string(b)
+}
+
+// IsValid reports whether the BusinessBeneficiaryBeneficiaryType value is defined in the spec.
+func (b BusinessBeneficiaryBeneficiaryType) IsValid() bool {
+	_, ok := businessBeneficiaryBeneficiaryTypeNames[b]
+	return ok
+}
+
+// Values returns all the BusinessBeneficiaryBeneficiaryType values defined in the spec.
+func (BusinessBeneficiaryBeneficiaryType) Values() []BusinessBeneficiaryBeneficiaryType {
+	return []BusinessBeneficiaryBeneficiaryType{
+		Business,
+	}
+}
+
+// BusinessBeneficiaryBeneficiaryTypePtr returns a pointer to v, to set the optional and nullable BusinessBeneficiaryBeneficiaryType properties.
+func BusinessBeneficiaryBeneficiaryTypePtr(v BusinessBeneficiaryBeneficiaryType) *BusinessBeneficiaryBeneficiaryType {
+	return &v
+}
+
+// Name returns the name of the BusinessBeneficiaryBeneficiaryType value, or an empty string for unknown values.
+func (b BusinessBeneficiaryBeneficiaryType) Name() string {
+	return businessBeneficiaryBeneficiaryTypeNames[b]
+}
+
+// ParseBusinessBeneficiaryBeneficiaryType returns the BusinessBeneficiaryBeneficiaryType matching s by wire value or by name.
+func ParseBusinessBeneficiaryBeneficiaryType(s string) (BusinessBeneficiaryBeneficiaryType, error) {
+	if _, ok := businessBeneficiaryBeneficiaryTypeNames[BusinessBeneficiaryBeneficiaryType(s)]; ok {
+		return BusinessBeneficiaryBeneficiaryType(s), nil
+	}
+	if v, ok := businessBeneficiaryBeneficiaryTypeValues[s]; ok {
+		return v, nil
+	}
+	var zero BusinessBeneficiaryBeneficiaryType
+	return zero, fmt.Errorf("%w for BusinessBeneficiaryBeneficiaryType: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (b BusinessBeneficiaryBeneficiaryType) MarshalText() ([]byte, error) {
+	return []byte(b), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseBusinessBeneficiaryBeneficiaryType.
+// Unknown values are kept as-is and reported by Validate.
+func (b *BusinessBeneficiaryBeneficiaryType) UnmarshalText(text []byte) error {
+	*b = BusinessBeneficiaryBeneficiaryType(text)
+	return nil
+}
+
 type DigitalWalletPaymentType string
 
 const (
@@ -123,6 +495,68 @@ func (d DigitalWalletPaymentType) Validate() error {
 	}
 }
 
+// digitalWalletPaymentTypeNames maps DigitalWalletPaymentType values to their names.
+var digitalWalletPaymentTypeNames = map[DigitalWalletPaymentType]string{
+	DigitalWallet: "DigitalWallet",
+}
+
+// digitalWalletPaymentTypeValues maps names to DigitalWalletPaymentType values.
+var digitalWalletPaymentTypeValues = map[string]DigitalWalletPaymentType{
+	"DigitalWallet": DigitalWallet,
+}
+
+// String returns the wire value of the DigitalWalletPaymentType.
+func (d DigitalWalletPaymentType) String() string {
+	return string(d)
+}
+
+// IsValid reports whether the DigitalWalletPaymentType value is defined in the spec.
+func (d DigitalWalletPaymentType) IsValid() bool {
+	_, ok := digitalWalletPaymentTypeNames[d]
+	return ok
+}
+
+// Values returns all the DigitalWalletPaymentType values defined in the spec.
+func (DigitalWalletPaymentType) Values() []DigitalWalletPaymentType {
+	return []DigitalWalletPaymentType{
+		DigitalWallet,
+	}
+}
+
+// DigitalWalletPaymentTypePtr returns a pointer to v, to set the optional and nullable DigitalWalletPaymentType properties.
+func DigitalWalletPaymentTypePtr(v DigitalWalletPaymentType) *DigitalWalletPaymentType {
+	return &v
+}
+
+// Name returns the name of the DigitalWalletPaymentType value, or an empty string for unknown values.
+func (d DigitalWalletPaymentType) Name() string {
+	return digitalWalletPaymentTypeNames[d]
+}
+
+// ParseDigitalWalletPaymentType returns the DigitalWalletPaymentType matching s by wire value or by name.
+func ParseDigitalWalletPaymentType(s string) (DigitalWalletPaymentType, error) {
+	if _, ok := digitalWalletPaymentTypeNames[DigitalWalletPaymentType(s)]; ok {
+		return DigitalWalletPaymentType(s), nil
+	}
+	if v, ok := digitalWalletPaymentTypeValues[s]; ok {
+		return v, nil
+	}
+	var zero DigitalWalletPaymentType
+	return zero, fmt.Errorf("%w for DigitalWalletPaymentType: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (d DigitalWalletPaymentType) MarshalText() ([]byte, error) {
+	return []byte(d), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseDigitalWalletPaymentType.
+// Unknown values are kept as-is and reported by Validate.
+func (d *DigitalWalletPaymentType) UnmarshalText(text []byte) error {
+	*d = DigitalWalletPaymentType(text)
+	return nil
+}
+
 type PaymentMethod struct {
 	PaymentMethod_AnyOf *PaymentMethod_AnyOf `json:"-"`
 }
@@ -241,6 +675,15 @@ func (c CreditCardPayment) MarshalJSON() ([]byte, error) {
 	return json.Marshal(masked)
 }
 
+// MarshalJSONUnmasked marshals CreditCardPayment with its sensitive properties unmasked, for trusted code persisting it.
+// See runtime.MarshalUnmasked.
+func (c CreditCardPayment) MarshalJSONUnmasked() ([]byte, error) {
+	type _Alias_CreditCardPayment CreditCardPayment
+	unmasked := _Alias_CreditCardPayment(c)
+
+	return json.Marshal(unmasked)
+}
+
 func (c *CreditCardPayment) UnmarshalJSON(data []byte) error {
 	trim := bytes.TrimSpace(data)
 	if bytes.Equal(trim, []byte("null")) {
@@ -401,6 +844,15 @@ func (d DomesticAccount) MarshalJSON() ([]byte, error) {
 	return json.Marshal(masked)
 }
 
+// MarshalJSONUnmasked marshals DomesticAccount with its sensitive properties unmasked, for trusted code persisting it.
+// See runtime.MarshalUnmasked.
+func (d DomesticAccount) MarshalJSONUnmasked() ([]byte, error) {
+	type _Alias_DomesticAccount DomesticAccount
+	unmasked := _Alias_DomesticAccount(d)
+
+	return json.Marshal(unmasked)
+}
+
 func (d *DomesticAccount) UnmarshalJSON(data []byte) error {
 	trim := bytes.TrimSpace(data)
 	if bytes.Equal(trim, []byte("null")) {
@@ -493,6 +945,15 @@ func (i InternationalAccount) MarshalJSON() ([]byte, error) {
 	return json.Marshal(masked)
 }
 
+// MarshalJSONUnmasked marshals InternationalAccount with its sensitive properties unmasked, for trusted code persisting it.
+// See runtime.MarshalUnmasked.
+func (i InternationalAccount) MarshalJSONUnmasked() ([]byte, error) {
+	type _Alias_InternationalAccount InternationalAccount
+	unmasked := _Alias_InternationalAccount(i)
+
+	return json.Marshal(unmasked)
+}
+
 func (i *InternationalAccount) UnmarshalJSON(data []byte) error {
 	trim := bytes.TrimSpace(data)
 	if bytes.Equal(trim, []byte("null")) {
@@ -655,6 +1116,15 @@ func (p PersonalBeneficiary) MarshalJSON() ([]byte, error) {
 	return json.Marshal(masked)
 }
 
+// MarshalJSONUnmasked marshals PersonalBeneficiary with its sensitive properties unmasked, for trusted code persisting it.
+// See runtime.MarshalUnmasked.
+func (p PersonalBeneficiary) MarshalJSONUnmasked() ([]byte, error) {
+	type _Alias_PersonalBeneficiary PersonalBeneficiary
+	unmasked := _Alias_PersonalBeneficiary(p)
+
+	return json.Marshal(unmasked)
+}
+
 func (p *PersonalBeneficiary) UnmarshalJSON(data []byte) error {
 	trim := bytes.TrimSpace(data)
 	if bytes.Equal(trim, []byte("null")) {
@@ -722,6 +1192,15 @@ func (b BusinessBeneficiary) MarshalJSON() ([]byte, error) {
 	return json.Marshal(masked)
 }
 
+// MarshalJSONUnmasked marshals BusinessBeneficiary with its sensitive properties unmasked, for trusted code persisting it.
+// See runtime.MarshalUnmasked.
+func (b BusinessBeneficiary) MarshalJSONUnmasked() ([]byte, error) {
+	type _Alias_BusinessBeneficiary BusinessBeneficiary
+	unmasked := _Alias_BusinessBeneficiary(b)
+
+	return json.Marshal(unmasked)
+}
+
 func (b *BusinessBeneficiary) UnmarshalJSON(data []byte) error {
 	trim := bytes.TrimSpace(data)
 	if bytes.Equal(trim, []byte("null")) {
@@ -783,6 +1262,15 @@ func (d DigitalWalletPayment) MarshalJSON() ([]byte, error) {
 	return json.Marshal(masked)
 }
 
+// MarshalJSONUnmasked marshals DigitalWalletPayment with its sensitive properties unmasked, for trusted code persisting it.
+// See runtime.MarshalUnmasked.
+func (d DigitalWalletPayment) MarshalJSONUnmasked() ([]byte, error) {
+	type _Alias_DigitalWalletPayment DigitalWalletPayment
+	unmasked := _Alias_DigitalWalletPayment(d)
+
+	return json.Marshal(unmasked)
+}
+
 func (d *DigitalWalletPayment) UnmarshalJSON(data []byte) error {
 	trim := bytes.TrimSpace(data)
 	if bytes.Equal(trim, []byte("null")) {
@@ -839,6 +1327,15 @@ func (a AccountHolder) MarshalJSON() ([]byte, error) {
 	return json.Marshal(masked)
 }
 
+// MarshalJSONUnmasked marshals AccountHolder with its sensitive properties unmasked, for trusted code persisting it.
+// See runtime.MarshalUnmasked.
+func (a AccountHolder) MarshalJSONUnmasked() ([]byte, error) {
+	type _Alias_AccountHolder AccountHolder
+	unmasked := _Alias_AccountHolder(a)
+
+	return json.Marshal(unmasked)
+}
+
 func (a *AccountHolder) UnmarshalJSON(data []byte) error {
 	trim := bytes.TrimSpace(data)
 	if bytes.Equal(trim, []byte("null")) {
@@ -906,6 +1403,20 @@ func (p *PaymentMethod_AnyOf) FromCreditCardPayment(val CreditCardPayment) error
 	return err
 }
 
+// MergeCreditCardPayment merges the provided CreditCardPayment into the union data inside the PaymentMethod_AnyOf
+func (p *PaymentMethod_AnyOf) MergeCreditCardPayment(val CreditCardPayment) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(p.union, bts)
+	if err != nil {
+		return err
+	}
+	p.union = merged
+	return nil
+}
+
 // AsBankTransferPayment returns the union data inside the PaymentMethod_AnyOf as a BankTransferPayment
 func (p *PaymentMethod_AnyOf) AsBankTransferPayment() (BankTransferPayment, error) {
 	return runtime.UnmarshalAs[BankTransferPayment](p.union)
@@ -936,6 +1447,20 @@ func (p *PaymentMethod_AnyOf) FromBankTransferPayment(val BankTransferPayment) e
 	return err
 }
 
+// MergeBankTransferPayment merges the provided BankTransferPayment into the union data inside the PaymentMethod_AnyOf
+func (p *PaymentMethod_AnyOf) MergeBankTransferPayment(val BankTransferPayment) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(p.union, bts)
+	if err != nil {
+		return err
+	}
+	p.union = merged
+	return nil
+}
+
 // AsDigitalWalletPayment returns the union data inside the PaymentMethod_AnyOf as a DigitalWalletPayment
 func (p *PaymentMethod_AnyOf) AsDigitalWalletPayment() (DigitalWalletPayment, error) {
 	return runtime.UnmarshalAs[DigitalWalletPayment](p.union)
@@ -966,6 +1491,20 @@ func (p *PaymentMethod_AnyOf) FromDigitalWalletPayment(val DigitalWalletPayment)
 	return err
 }
 
+// MergeDigitalWalletPayment merges the provided DigitalWalletPayment into the union data inside the PaymentMethod_AnyOf
+func (p *PaymentMethod_AnyOf) MergeDigitalWalletPayment(val DigitalWalletPayment) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(p.union, bts)
+	if err != nil {
+		return err
+	}
+	p.union = merged
+	return nil
+}
+
 // validateCreditCardPayment validates a CreditCardPayment value
 func (p *PaymentMethod_AnyOf) validateCreditCardPayment(val CreditCardPayment) error {
 	if v, ok := any(val).(runtime.Validator); ok {
@@ -1043,4 +1582,5 @@ var typesValidator *validator.Validate
 func init() {
 	typesValidator = validator.New(validator.WithRequiredStructEnabled())
 	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
 }
@@ -0,0 +1,53 @@
+package xvalidateskiponinput_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/examples/extensions/xvalidateskiponinput"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// httpClientAdapter wraps http.Client to implement runtime.HttpRequestDoer
+type httpClientAdapter struct {
+	client *http.Client
+}
+
+func (a *httpClientAdapter) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return a.client.Do(req.WithContext(ctx))
+}
+
+func TestUserValidate(t *testing.T) {
+	assert.NoError(t, xvalidateskiponinput.User{Name: "Jane"}.Validate())
+	assert.Error(t, xvalidateskiponinput.User{}.Validate())
+}
+
+func TestCreateUserWithoutID(t *testing.T) {
+	var body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":"7f2c3c4e-9d1a-4b8e-8f0a-2b9c6d5e4f3a","name":"Jane"}`))
+	}))
+	defer server.Close()
+
+	apiClient, err := runtime.NewAPIClient(server.URL, runtime.WithHTTPClient(&httpClientAdapter{client: server.Client()}))
+	require.NoError(t, err)
+	client := xvalidateskiponinput.NewClient(apiClient)
+
+	user, err := client.CreateUser(context.Background(), &xvalidateskiponinput.CreateUserRequestOptions{
+		Body: &xvalidateskiponinput.CreateUserBody{Name: "Jane"},
+	})
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{"name":"Jane"}`, body)
+	require.NotNil(t, user.ID)
+	assert.Equal(t, "7f2c3c4e-9d1a-4b8e-8f0a-2b9c6d5e4f3a", user.ID.String())
+}
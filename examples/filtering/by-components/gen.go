@@ -23,6 +23,7 @@ func NewClient(apiClient runtime.APIClient) *Client {
 
 // NewDefaultClient creates a new instance of the Client client with default api client.
 func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithUserAgent("Component-Filtering-Example/1.0.0 oapi-codegen-dd/v3.63.4")}, opts...)
 	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("error creating API client: %w", err)
@@ -37,16 +38,25 @@ type ClientInterface interface {
 
 func (c *Client) CreateOrder(ctx context.Context, options *CreateOrderRequestOptions, reqEditors ...runtime.RequestEditorFn) (*CreateOrderResponse, error) {
 	var err error
+	if options != nil && options.Body != nil {
+		if v, ok := any(options.Body).(runtime.Validator); ok {
+			if err = v.Validate(); err != nil {
+				return nil, fmt.Errorf("error validating request body: %w", err)
+			}
+		}
+	}
 	reqParams := runtime.RequestOptionsParameters{
 		RequestURL:  c.apiClient.GetBaseURL() + "/orders",
 		Method:      "POST",
-		Options:     options,
 		ContentType: "application/json",
 	}
+	if options != nil {
+		reqParams.Options = options
+	}
 
 	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
 	if err != nil {
-		return nil, fmt.Errorf("error creating request: %w", err)
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
 	}
 
 	responseParser := func(ctx context.Context, resp *runtime.Response) (*CreateOrderResponse, error) {
@@ -55,7 +65,7 @@ func (c *Client) CreateOrder(ctx context.Context, options *CreateOrderRequestOpt
 			target := new(CreateOrderErrorResponse)
 			err = json.Unmarshal(bodyBytes, target)
 			if err != nil {
-				return nil, fmt.Errorf("error decoding response: %w", err)
+				return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
 			}
 
 			if errTarget, ok := any(*target).(error); ok {
@@ -66,8 +76,7 @@ func (c *Client) CreateOrder(ctx context.Context, options *CreateOrderRequestOpt
 		}
 		target := new(CreateOrderResponse)
 		if err = json.Unmarshal(bodyBytes, target); err != nil {
-			err = fmt.Errorf("error decoding response: %w", err)
-			return nil, err
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
 		}
 		return target, nil
 	}
@@ -124,8 +133,12 @@ func (o *CreateOrderRequestOptions) GetQuery() (map[string]any, error) {
 	return nil, nil
 }
 
-// GetBody returns the payload in any type that can be marshalled to JSON by the client.
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
 func (o *CreateOrderRequestOptions) GetBody() any {
+	if o.Body == nil {
+		return nil
+	}
 	return o.Body
 }
 
@@ -168,4 +181,5 @@ var typesValidator *validator.Validate
 func init() {
 	typesValidator = validator.New(validator.WithRequiredStructEnabled())
 	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
 }
@@ -23,6 +23,7 @@ func NewClient(apiClient runtime.APIClient) *Client {
 
 // NewDefaultClient creates a new instance of the Client client with default api client.
 func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithUserAgent("Property-Filtering-Example/1.0.0 oapi-codegen-dd/v3.63.4")}, opts...)
 	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("error creating API client: %w", err)
@@ -52,19 +53,18 @@ func (c *Client) GetUsers(ctx context.Context, reqEditors ...runtime.RequestEdit
 
 	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
 	if err != nil {
-		return nil, fmt.Errorf("error creating request: %w", err)
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
 	}
 
 	responseParser := func(ctx context.Context, resp *runtime.Response) (*GetUsersResponse, error) {
 		bodyBytes := resp.Content
 		if resp.StatusCode != 200 {
-			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+			return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
 				runtime.WithStatusCode(resp.StatusCode))
 		}
 		target := new(GetUsersResponse)
 		if err = json.Unmarshal(bodyBytes, target); err != nil {
-			err = fmt.Errorf("error decoding response: %w", err)
-			return nil, err
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
 		}
 		return target, nil
 	}
@@ -79,16 +79,28 @@ func (c *Client) GetUsers(ctx context.Context, reqEditors ...runtime.RequestEdit
 // CreateUser Create a user
 func (c *Client) CreateUser(ctx context.Context, options *CreateUserRequestOptions, reqEditors ...runtime.RequestEditorFn) (*CreateUserResponse, error) {
 	var err error
+	if options == nil || options.Body == nil {
+		return nil, fmt.Errorf("error creating request body: %w", runtime.ErrMissingValue)
+	}
+	if options != nil && options.Body != nil {
+		if v, ok := any(options.Body).(runtime.Validator); ok {
+			if err = v.Validate(); err != nil {
+				return nil, fmt.Errorf("error validating request body: %w", err)
+			}
+		}
+	}
 	reqParams := runtime.RequestOptionsParameters{
 		RequestURL:  c.apiClient.GetBaseURL() + "/users",
 		Method:      "POST",
-		Options:     options,
 		ContentType: "application/json",
 	}
+	if options != nil {
+		reqParams.Options = options
+	}
 
 	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
 	if err != nil {
-		return nil, fmt.Errorf("error creating request: %w", err)
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
 	}
 
 	responseParser := func(ctx context.Context, resp *runtime.Response) (*CreateUserResponse, error) {
@@ -97,7 +109,7 @@ func (c *Client) CreateUser(ctx context.Context, options *CreateUserRequestOptio
 			target := new(CreateUserErrorResponse)
 			err = json.Unmarshal(bodyBytes, target)
 			if err != nil {
-				return nil, fmt.Errorf("error decoding response: %w", err)
+				return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
 			}
 
 			if errTarget, ok := any(*target).(error); ok {
@@ -108,8 +120,7 @@ func (c *Client) CreateUser(ctx context.Context, options *CreateUserRequestOptio
 		}
 		target := new(CreateUserResponse)
 		if err = json.Unmarshal(bodyBytes, target); err != nil {
-			err = fmt.Errorf("error decoding response: %w", err)
-			return nil, err
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
 		}
 		return target, nil
 	}
@@ -127,24 +138,25 @@ func (c *Client) GetUser(ctx context.Context, options *GetUserRequestOptions, re
 	reqParams := runtime.RequestOptionsParameters{
 		RequestURL: c.apiClient.GetBaseURL() + "/users/{id}",
 		Method:     "GET",
-		Options:    options,
+	}
+	if options != nil {
+		reqParams.Options = options
 	}
 
 	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
 	if err != nil {
-		return nil, fmt.Errorf("error creating request: %w", err)
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
 	}
 
 	responseParser := func(ctx context.Context, resp *runtime.Response) (*GetUserResponse, error) {
 		bodyBytes := resp.Content
 		if resp.StatusCode != 200 {
-			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+			return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
 				runtime.WithStatusCode(resp.StatusCode))
 		}
 		target := new(GetUserResponse)
 		if err = json.Unmarshal(bodyBytes, target); err != nil {
-			err = fmt.Errorf("error decoding response: %w", err)
-			return nil, err
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
 		}
 		return target, nil
 	}
@@ -192,8 +204,12 @@ func (o *CreateUserRequestOptions) GetQuery() (map[string]any, error) {
 	return nil, nil
 }
 
-// GetBody returns the payload in any type that can be marshalled to JSON by the client.
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
 func (o *CreateUserRequestOptions) GetBody() any {
+	if o.Body == nil {
+		return nil
+	}
 	return o.Body
 }
 
@@ -236,7 +252,8 @@ func (o *GetUserRequestOptions) GetQuery() (map[string]any, error) {
 	return nil, nil
 }
 
-// GetBody returns the payload in any type that can be marshalled to JSON by the client.
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
 func (o *GetUserRequestOptions) GetBody() any {
 	return nil
 }
@@ -254,6 +271,74 @@ const (
 	Pro        OrganizationPlan = "pro"
 )
 
+// organizationPlanNames maps OrganizationPlan values to their names.
+var organizationPlanNames = map[OrganizationPlan]string{
+	Enterprise: "Enterprise",
+	Free:       "Free",
+	Pro:        "Pro",
+}
+
+// organizationPlanValues maps names to OrganizationPlan values.
+var organizationPlanValues = map[string]OrganizationPlan{
+	"Enterprise": Enterprise,
+	"Free":       Free,
+	"Pro":        Pro,
+}
+
+// String returns the wire value of the OrganizationPlan.
+func (o OrganizationPlan) String() string {
+	return string(o)
+}
+
+// IsValid reports whether the OrganizationPlan value is defined in the spec.
+func (o OrganizationPlan) IsValid() bool {
+	_, ok := organizationPlanNames[o]
+	return ok
+}
+
+// Values returns all the OrganizationPlan values defined in the spec.
+func (OrganizationPlan) Values() []OrganizationPlan {
+	return []OrganizationPlan{
+		Enterprise,
+		Free,
+		Pro,
+	}
+}
+
+// OrganizationPlanPtr returns a pointer to v, to set the optional and nullable OrganizationPlan properties.
+func OrganizationPlanPtr(v OrganizationPlan) *OrganizationPlan {
+	return &v
+}
+
+// Name returns the name of the OrganizationPlan value, or an empty string for unknown values.
+func (o OrganizationPlan) Name() string {
+	return organizationPlanNames[o]
+}
+
+// ParseOrganizationPlan returns the OrganizationPlan matching s by wire value or by name.
+func ParseOrganizationPlan(s string) (OrganizationPlan, error) {
+	if _, ok := organizationPlanNames[OrganizationPlan(s)]; ok {
+		return OrganizationPlan(s), nil
+	}
+	if v, ok := organizationPlanValues[s]; ok {
+		return v, nil
+	}
+	var zero OrganizationPlan
+	return zero, fmt.Errorf("%w for OrganizationPlan: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (o OrganizationPlan) MarshalText() ([]byte, error) {
+	return []byte(o), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseOrganizationPlan.
+// Unknown values are kept as-is, see IsValid.
+func (o *OrganizationPlan) UnmarshalText(text []byte) error {
+	*o = OrganizationPlan(text)
+	return nil
+}
+
 type GetUserPath struct {
 	ID string `json:"id" validate:"required"`
 }
@@ -284,7 +369,7 @@ type GetUserResponse = User
 type User struct {
 	// ID Unique identifier
 	ID    string        `json:"id" validate:"required"`
-	Email runtime.Email `json:"email" validate:"required"`
+	Email runtime.Email `json:"email" validate:"required,email"`
 	Name  *string       `json:"name,omitempty"`
 
 	// Organization Organization that a user belongs to.
@@ -323,4 +408,5 @@ var typesValidator *validator.Validate
 func init() {
 	typesValidator = validator.New(validator.WithRequiredStructEnabled())
 	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
 }
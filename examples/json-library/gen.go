@@ -0,0 +1,465 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package jsonlibrary
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/go-playground/validator/v10"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Client is the client for the API implementing the Client interface.
+type Client struct {
+	apiClient runtime.APIClient
+}
+
+// NewClient creates a new instance of the Client client.
+func NewClient(apiClient runtime.APIClient) *Client {
+	return &Client{apiClient: apiClient}
+}
+
+// NewDefaultClient creates a new instance of the Client client with default api client.
+func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithUserAgent("JSON-library/1.0.0 oapi-codegen-dd/v3.63.4"), runtime.WithJSONCodec(jsonCodec{})}, opts...)
+	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API client: %w", err)
+	}
+	return &Client{apiClient: apiClient}, nil
+}
+
+// ClientInterface is the interface for the API client.
+type ClientInterface interface {
+	CreatePet(ctx context.Context, options *CreatePetRequestOptions, reqEditors ...runtime.RequestEditorFn) (*CreatePetResponse, error)
+}
+
+func (c *Client) CreatePet(ctx context.Context, options *CreatePetRequestOptions, reqEditors ...runtime.RequestEditorFn) (*CreatePetResponse, error) {
+	var err error
+	if options == nil || options.Body == nil {
+		return nil, fmt.Errorf("error creating request body: %w", runtime.ErrMissingValue)
+	}
+	if options != nil && options.Body != nil {
+		if v, ok := any(options.Body).(runtime.Validator); ok {
+			if err = v.Validate(); err != nil {
+				return nil, fmt.Errorf("error validating request body: %w", err)
+			}
+		}
+	}
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL:  c.apiClient.GetBaseURL() + "/pets",
+		Method:      "POST",
+		ContentType: "application/json",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*CreatePetResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(CreatePetResponse)
+		if err = jsonUnmarshal(bodyBytes, target); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/pets")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+var _ ClientInterface = (*Client)(nil)
+
+// CreatePetRequestOptions is the options needed to make a request to CreatePet.
+type CreatePetRequestOptions struct {
+	Body *CreatePetBody
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *CreatePetRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *CreatePetRequestOptions) GetPathParams() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetQuery returns the query params as a map.
+func (o *CreatePetRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
+func (o *CreatePetRequestOptions) GetBody() any {
+	if o.Body == nil {
+		return nil
+	}
+	return o.Body
+}
+
+// GetHeader returns the headers as a map.
+func (o *CreatePetRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+type PetKind string
+
+const (
+	Cat PetKind = "cat"
+	Dog PetKind = "dog"
+)
+
+// Validate checks if the PetKind value is valid
+func (p PetKind) Validate() error {
+	switch p {
+	case Cat, Dog:
+		return nil
+	default:
+		return runtime.NewValidationErrorsFromString("Enum", fmt.Sprintf("must be a valid PetKind value, got: %v", p))
+	}
+}
+
+// petKindNames maps PetKind values to their names.
+var petKindNames = map[PetKind]string{
+	Cat: "Cat",
+	Dog: "Dog",
+}
+
+// petKindValues maps names to PetKind values.
+var petKindValues = map[string]PetKind{
+	"Cat": Cat,
+	"Dog": Dog,
+}
+
+// String returns the wire value of the PetKind.
+func (p PetKind) String() string {
+	return string(p)
+}
+
+// IsValid reports whether the PetKind value is defined in the spec.
+func (p PetKind) IsValid() bool {
+	_, ok := petKindNames[p]
+	return ok
+}
+
+// Values returns all the PetKind values defined in the spec.
+func (PetKind) Values() []PetKind {
+	return []PetKind{
+		Cat,
+		Dog,
+	}
+}
+
+// PetKindPtr returns a pointer to v, to set the optional and nullable PetKind properties.
+func PetKindPtr(v PetKind) *PetKind {
+	return &v
+}
+
+// Name returns the name of the PetKind value, or an empty string for unknown values.
+func (p PetKind) Name() string {
+	return petKindNames[p]
+}
+
+// ParsePetKind returns the PetKind matching s by wire value or by name.
+func ParsePetKind(s string) (PetKind, error) {
+	if _, ok := petKindNames[PetKind(s)]; ok {
+		return PetKind(s), nil
+	}
+	if v, ok := petKindValues[s]; ok {
+		return v, nil
+	}
+	var zero PetKind
+	return zero, fmt.Errorf("%w for PetKind: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (p PetKind) MarshalText() ([]byte, error) {
+	return []byte(p), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParsePetKind.
+// Unknown values are kept as-is and reported by Validate.
+func (p *PetKind) UnmarshalText(text []byte) error {
+	*p = PetKind(text)
+	return nil
+}
+
+// jsonMarshal marshals the generated types with jsoniter, the json-library of the generator configuration.
+func jsonMarshal(v any) ([]byte, error) {
+	return jsoniter.ConfigCompatibleWithStandardLibrary.Marshal(v)
+}
+
+// jsonUnmarshal unmarshals the generated types with jsoniter.
+func jsonUnmarshal(data []byte, v any) error {
+	return jsoniter.ConfigCompatibleWithStandardLibrary.Unmarshal(data, v)
+}
+
+// jsonCodec is the runtime.JSONCodec of jsoniter, e.g. for the default client.
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v any) ([]byte, error) {
+	return jsonMarshal(v)
+}
+
+func (jsonCodec) Unmarshal(data []byte, v any) error {
+	return jsonUnmarshal(data, v)
+}
+
+type CreatePetBody = Pet
+
+type CreatePetResponse = Pet
+
+type Pet struct {
+	Name                 string            `json:"name" validate:"required"`
+	Kind                 *PetKind          `json:"kind,omitempty"`
+	Owner                *Pet_Owner        `json:"owner,omitempty"`
+	Labels               map[string]string `json:"labels,omitempty"`
+	AdditionalProperties map[string]int    `json:"-"`
+}
+
+func (p Pet) Validate() error {
+	var errors runtime.ValidationErrors
+	if err := typesValidator.Var(p.Name, "required"); err != nil {
+		errors = errors.Append("Name", err)
+	}
+	if p.Kind != nil {
+		if v, ok := any(p.Kind).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Kind", err)
+			}
+		}
+	}
+	if p.Owner != nil {
+		if v, ok := any(p.Owner).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Owner", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+// Getter for additional properties for Pet. Returns the specified
+// element and whether it was found
+func (p Pet) Get(fieldName string) (value int, found bool) {
+	if p.AdditionalProperties != nil {
+		value, found = p.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for Pet
+func (p *Pet) Set(fieldName string, value int) {
+	if p.AdditionalProperties == nil {
+		p.AdditionalProperties = make(map[string]int)
+	}
+	p.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for Pet to handle AdditionalProperties
+func (p *Pet) UnmarshalJSON(data []byte) error {
+	object := make(map[string]json.RawMessage)
+	if err := jsonUnmarshal(data, &object); err != nil {
+		return err
+	}
+
+	if raw, found := object["name"]; found {
+		if err := jsonUnmarshal(raw, &p.Name); err != nil {
+			return fmt.Errorf("error reading 'name': %w", err)
+		}
+		delete(object, "name")
+	}
+	if raw, found := object["kind"]; found {
+		if err := jsonUnmarshal(raw, &p.Kind); err != nil {
+			return fmt.Errorf("error reading 'kind': %w", err)
+		}
+		delete(object, "kind")
+	}
+	if raw, found := object["owner"]; found {
+		if err := jsonUnmarshal(raw, &p.Owner); err != nil {
+			return fmt.Errorf("error reading 'owner': %w", err)
+		}
+		delete(object, "owner")
+	}
+	if raw, found := object["labels"]; found {
+		if err := jsonUnmarshal(raw, &p.Labels); err != nil {
+			return fmt.Errorf("error reading 'labels': %w", err)
+		}
+		delete(object, "labels")
+	}
+	if len(object) != 0 {
+		p.AdditionalProperties = make(map[string]int)
+		for fieldName, fieldBuf := range object {
+			var fieldVal int
+			if err := jsonUnmarshal(fieldBuf, &fieldVal); err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			p.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for Pet to handle AdditionalProperties.
+// Fields are written directly, additional properties replace the declared ones with the same name.
+func (p Pet) MarshalJSON() ([]byte, error) {
+	object := runtime.NewJSONObjectWriter(jsonCodec{})
+	if _, ok := p.AdditionalProperties["name"]; !ok {
+
+		if err := object.WriteField("name", p.Name); err != nil {
+			return nil, fmt.Errorf("error marshaling 'name': %w", err)
+		}
+
+	}
+	if _, ok := p.AdditionalProperties["kind"]; !ok {
+		if p.Kind != nil {
+			if err := object.WriteField("kind", p.Kind); err != nil {
+				return nil, fmt.Errorf("error marshaling 'kind': %w", err)
+			}
+		}
+	}
+	if _, ok := p.AdditionalProperties["owner"]; !ok {
+		if p.Owner != nil {
+			if err := object.WriteField("owner", p.Owner); err != nil {
+				return nil, fmt.Errorf("error marshaling 'owner': %w", err)
+			}
+		}
+	}
+	if _, ok := p.AdditionalProperties["labels"]; !ok {
+
+		if err := object.WriteField("labels", p.Labels); err != nil {
+			return nil, fmt.Errorf("error marshaling 'labels': %w", err)
+		}
+
+	}
+	for _, fieldName := range slices.Sorted(maps.Keys(p.AdditionalProperties)) {
+		if err := object.WriteField(fieldName, p.AdditionalProperties[fieldName]); err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return object.Bytes(), nil
+}
+
+type Pet_Owner struct {
+	Pet_Owner_OneOf *Pet_Owner_OneOf `json:"-"`
+}
+
+func (p Pet_Owner) Validate() error {
+	var errors runtime.ValidationErrors
+	if p.Pet_Owner_OneOf != nil {
+		if v, ok := any(p.Pet_Owner_OneOf).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Pet_Owner_OneOf", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+func (p Pet_Owner) MarshalJSON() ([]byte, error) {
+	var parts []json.RawMessage
+
+	{
+		b, err := runtime.MarshalJSON(p.Pet_Owner_OneOf)
+		if err != nil {
+			return nil, fmt.Errorf("Pet_Owner_OneOf marshal: %w", err)
+		}
+		parts = append(parts, b)
+	}
+
+	return runtime.CoalesceOrMerge(parts...)
+}
+
+func (p *Pet_Owner) UnmarshalJSON(data []byte) error {
+	trim := bytes.TrimSpace(data)
+	if bytes.Equal(trim, []byte("null")) {
+		return nil
+	}
+	if len(trim) == 0 {
+		return fmt.Errorf("empty JSON input")
+	}
+
+	if p.Pet_Owner_OneOf == nil {
+		p.Pet_Owner_OneOf = &Pet_Owner_OneOf{}
+	}
+
+	if err := runtime.UnmarshalJSON(data, p.Pet_Owner_OneOf); err != nil {
+		return fmt.Errorf("Pet_Owner_OneOf unmarshal: %w", err)
+	}
+
+	return nil
+}
+
+type Person struct {
+	FirstName *string `json:"firstName,omitempty"`
+}
+
+type Company struct {
+	LegalName *string `json:"legalName,omitempty"`
+}
+
+type Pet_Owner_OneOf struct {
+	runtime.Either[Person, Company]
+}
+
+func (p *Pet_Owner_OneOf) Validate() error {
+	if p.IsA() {
+		if v, ok := any(p.A).(runtime.Validator); ok {
+			return v.Validate()
+		}
+	}
+	if p.IsB() {
+		if v, ok := any(p.B).(runtime.Validator); ok {
+			return v.Validate()
+		}
+	}
+	return nil
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
+}
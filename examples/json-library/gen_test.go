@@ -0,0 +1,113 @@
+package jsonlibrary
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// httpClientAdapter wraps http.Client to implement runtime.HttpRequestDoer
+type httpClientAdapter struct {
+	client *http.Client
+}
+
+func (a *httpClientAdapter) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return a.client.Do(req.WithContext(ctx))
+}
+
+func newPet() Pet {
+	return Pet{
+		Name:                 "Tom",
+		Kind:                 runtime.Ptr(Cat),
+		Owner:                &Pet_Owner{Pet_Owner_OneOf: &Pet_Owner_OneOf{Either: runtime.NewEitherFromA[Person, Company](Person{FirstName: runtime.Ptr("Jane")})}},
+		Labels:               map[string]string{"color": "grey"},
+		AdditionalProperties: map[string]int{"age": 3},
+	}
+}
+
+func TestCreatePet(t *testing.T) {
+	var requestBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		requestBody = string(b)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(b)
+	}))
+	defer server.Close()
+
+	client, err := NewDefaultClient(server.URL, runtime.WithHTTPClient(&httpClientAdapter{client: server.Client()}))
+	require.NoError(t, err)
+
+	pet := newPet()
+	res, err := client.CreatePet(context.Background(), &CreatePetRequestOptions{Body: &pet})
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{"name":"Tom","kind":"cat","owner":{"firstName":"Jane"},"labels":{"color":"grey"},"age":3}`, requestBody)
+	assert.Equal(t, "Tom", res.Name)
+	assert.Equal(t, map[string]int{"age": 3}, res.AdditionalProperties)
+	assert.Equal(t, "Jane", *res.Owner.Pet_Owner_OneOf.A.FirstName)
+}
+
+func TestJSONLibraryMatchesEncodingJSON(t *testing.T) {
+	pet := newPet()
+	want, err := json.Marshal(pet)
+	require.NoError(t, err)
+
+	got, err := jsonMarshal(pet)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(want), string(got))
+}
+
+func BenchmarkMarshal(b *testing.B) {
+	people := make([]Person, 100)
+	for i := range people {
+		people[i] = Person{FirstName: runtime.Ptr("Jane")}
+	}
+
+	for _, bm := range []struct {
+		name    string
+		marshal func(any) ([]byte, error)
+	}{
+		{name: "encoding/json", marshal: json.Marshal},
+		{name: "jsoniter", marshal: jsonMarshal},
+	} {
+		b.Run(bm.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				if _, err := bm.marshal(people); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkUnmarshal(b *testing.B) {
+	data, err := json.Marshal(newPet())
+	require.NoError(b, err)
+
+	for _, bm := range []struct {
+		name      string
+		unmarshal func([]byte, any) error
+	}{
+		{name: "encoding/json", unmarshal: json.Unmarshal},
+		{name: "jsoniter", unmarshal: jsonUnmarshal},
+	} {
+		b.Run(bm.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				var pet Pet
+				if err := bm.unmarshal(data, &pet); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
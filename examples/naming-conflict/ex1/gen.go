@@ -26,6 +26,71 @@ func (t TypeQuery) Validate() error {
 	}
 }
 
+// typeQueryNames maps TypeQuery values to their names.
+var typeQueryNames = map[TypeQuery]string{
+	Invalid: "Invalid",
+	Valid:   "Valid",
+}
+
+// typeQueryValues maps names to TypeQuery values.
+var typeQueryValues = map[string]TypeQuery{
+	"Invalid": Invalid,
+	"Valid":   Valid,
+}
+
+// String returns the wire value of the TypeQuery.
+func (t TypeQuery) String() string {
+	return string(t)
+}
+
+// IsValid reports whether the TypeQuery value is defined in the spec.
+func (t TypeQuery) IsValid() bool {
+	_, ok := typeQueryNames[t]
+	return ok
+}
+
+// Values returns all the TypeQuery values defined in the spec.
+func (TypeQuery) Values() []TypeQuery {
+	return []TypeQuery{
+		Invalid,
+		Valid,
+	}
+}
+
+// TypeQueryPtr returns a pointer to v, to set the optional and nullable TypeQuery properties.
+func TypeQueryPtr(v TypeQuery) *TypeQuery {
+	return &v
+}
+
+// Name returns the name of the TypeQuery value, or an empty string for unknown values.
+func (t TypeQuery) Name() string {
+	return typeQueryNames[t]
+}
+
+// ParseTypeQuery returns the TypeQuery matching s by wire value or by name.
+func ParseTypeQuery(s string) (TypeQuery, error) {
+	if _, ok := typeQueryNames[TypeQuery(s)]; ok {
+		return TypeQuery(s), nil
+	}
+	if v, ok := typeQueryValues[s]; ok {
+		return v, nil
+	}
+	var zero TypeQuery
+	return zero, fmt.Errorf("%w for TypeQuery: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (t TypeQuery) MarshalText() ([]byte, error) {
+	return []byte(t), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseTypeQuery.
+// Unknown values are kept as-is and reported by Validate.
+func (t *TypeQuery) UnmarshalText(text []byte) error {
+	*t = TypeQuery(text)
+	return nil
+}
+
 type Type string
 
 const (
@@ -43,6 +108,71 @@ func (t Type) Validate() error {
 	}
 }
 
+// typeNames maps Type values to their names.
+var typeNames = map[Type]string{
+	Debit:          "Debit",
+	TypeSourceType: "SourceType",
+}
+
+// typeValues maps names to Type values.
+var typeValues = map[string]Type{
+	"Debit":      Debit,
+	"SourceType": TypeSourceType,
+}
+
+// String returns the wire value of the Type.
+func (t Type) String() string {
+	return string(t)
+}
+
+// IsValid reports whether the Type value is defined in the spec.
+func (t Type) IsValid() bool {
+	_, ok := typeNames[t]
+	return ok
+}
+
+// Values returns all the Type values defined in the spec.
+func (Type) Values() []Type {
+	return []Type{
+		Debit,
+		TypeSourceType,
+	}
+}
+
+// TypePtr returns a pointer to v, to set the optional and nullable Type properties.
+func TypePtr(v Type) *Type {
+	return &v
+}
+
+// Name returns the name of the Type value, or an empty string for unknown values.
+func (t Type) Name() string {
+	return typeNames[t]
+}
+
+// ParseType returns the Type matching s by wire value or by name.
+func ParseType(s string) (Type, error) {
+	if _, ok := typeNames[Type(s)]; ok {
+		return Type(s), nil
+	}
+	if v, ok := typeValues[s]; ok {
+		return v, nil
+	}
+	var zero Type
+	return zero, fmt.Errorf("%w for Type: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (t Type) MarshalText() ([]byte, error) {
+	return []byte(t), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseType.
+// Unknown values are kept as-is and reported by Validate.
+func (t *Type) UnmarshalText(text []byte) error {
+	*t = Type(text)
+	return nil
+}
+
 type Status string
 
 const (
@@ -60,6 +190,71 @@ func (s Status) Validate() error {
 	}
 }
 
+// statusNames maps Status values to their names.
+var statusNames = map[Status]string{
+	ActiveSchema: "Active",
+	Inactive:     "Inactive",
+}
+
+// statusValues maps names to Status values.
+var statusValues = map[string]Status{
+	"Active":   ActiveSchema,
+	"Inactive": Inactive,
+}
+
+// String returns the wire value of the Status.
+func (s Status) String() string {
+	return string(s)
+}
+
+// IsValid reports whether the Status value is defined in the spec.
+func (s Status) IsValid() bool {
+	_, ok := statusNames[s]
+	return ok
+}
+
+// Values returns all the Status values defined in the spec.
+func (Status) Values() []Status {
+	return []Status{
+		ActiveSchema,
+		Inactive,
+	}
+}
+
+// StatusPtr returns a pointer to v, to set the optional and nullable Status properties.
+func StatusPtr(v Status) *Status {
+	return &v
+}
+
+// Name returns the name of the Status value, or an empty string for unknown values.
+func (s Status) Name() string {
+	return statusNames[s]
+}
+
+// ParseStatus returns the Status matching s by wire value or by name.
+func ParseStatus(s string) (Status, error) {
+	if _, ok := statusNames[Status(s)]; ok {
+		return Status(s), nil
+	}
+	if v, ok := statusValues[s]; ok {
+		return v, nil
+	}
+	var zero Status
+	return zero, fmt.Errorf("%w for Status: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (s Status) MarshalText() ([]byte, error) {
+	return []byte(s), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseStatus.
+// Unknown values are kept as-is and reported by Validate.
+func (s *Status) UnmarshalText(text []byte) error {
+	*s = Status(text)
+	return nil
+}
+
 type Source struct {
 	CreditTransfer *SourceType `json:"credit_transfer,omitempty"`
 }
@@ -97,4 +292,5 @@ var typesValidator *validator.Validate
 func init() {
 	typesValidator = validator.New(validator.WithRequiredStructEnabled())
 	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
 }
@@ -26,6 +26,71 @@ func (s SourceType) Validate() error {
 	}
 }
 
+// sourceTypeNames maps SourceType values to their names.
+var sourceTypeNames = map[SourceType]string{
+	ACHCreditTransfer: "ACHCreditTransfer",
+	Alipay:            "Alipay",
+}
+
+// sourceTypeValues maps names to SourceType values.
+var sourceTypeValues = map[string]SourceType{
+	"ACHCreditTransfer": ACHCreditTransfer,
+	"Alipay":            Alipay,
+}
+
+// String returns the wire value of the SourceType.
+func (s SourceType) String() string {
+	return string(s)
+}
+
+// IsValid reports whether the SourceType value is defined in the spec.
+func (s SourceType) IsValid() bool {
+	_, ok := sourceTypeNames[s]
+	return ok
+}
+
+// Values returns all the SourceType values defined in the spec.
+func (SourceType) Values() []SourceType {
+	return []SourceType{
+		ACHCreditTransfer,
+		Alipay,
+	}
+}
+
+// SourceTypePtr returns a pointer to v, to set the optional and nullable SourceType properties.
+func SourceTypePtr(v SourceType) *SourceType {
+	return &v
+}
+
+// Name returns the name of the SourceType value, or an empty string for unknown values.
+func (s SourceType) Name() string {
+	return sourceTypeNames[s]
+}
+
+// ParseSourceType returns the SourceType matching s by wire value or by name.
+func ParseSourceType(s string) (SourceType, error) {
+	if _, ok := sourceTypeNames[SourceType(s)]; ok {
+		return SourceType(s), nil
+	}
+	if v, ok := sourceTypeValues[s]; ok {
+		return v, nil
+	}
+	var zero SourceType
+	return zero, fmt.Errorf("%w for SourceType: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (s SourceType) MarshalText() ([]byte, error) {
+	return []byte(s), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseSourceType.
+// Unknown values are kept as-is and reported by Validate.
+func (s *SourceType) UnmarshalText(text []byte) error {
+	*s = SourceType(text)
+	return nil
+}
+
 type PaymentSourceType string
 
 const (
@@ -43,6 +108,71 @@ func (p PaymentSourceType) Validate() error {
 	}
 }
 
+// paymentSourceTypeNames maps PaymentSourceType values to their names.
+var paymentSourceTypeNames = map[PaymentSourceType]string{
+	PaymentSourceTypeACHCreditTransfer: "ACHCreditTransfer",
+	PaymentSourceTypeAlipay:            "Alipay",
+}
+
+// paymentSourceTypeValues maps names to PaymentSourceType values.
+var paymentSourceTypeValues = map[string]PaymentSourceType{
+	"ACHCreditTransfer": PaymentSourceTypeACHCreditTransfer,
+	"Alipay":            PaymentSourceTypeAlipay,
+}
+
+// String returns the wire value of the PaymentSourceType.
+func (p PaymentSourceType) String() string {
+	return string(p)
+}
+
+// IsValid reports whether the PaymentSourceType value is defined in the spec.
+func (p PaymentSourceType) IsValid() bool {
+	_, ok := paymentSourceTypeNames[p]
+	return ok
+}
+
+// Values returns all the PaymentSourceType values defined in the spec.
+func (PaymentSourceType) Values() []PaymentSourceType {
+	return []PaymentSourceType{
+		PaymentSourceTypeACHCreditTransfer,
+		PaymentSourceTypeAlipay,
+	}
+}
+
+// PaymentSourceTypePtr returns a pointer to v, to set the optional and nullable PaymentSourceType properties.
+func PaymentSourceTypePtr(v PaymentSourceType) *PaymentSourceType {
+	return &v
+}
+
+// Name returns the name of the PaymentSourceType value, or an empty string for unknown values.
+func (p PaymentSourceType) Name() string {
+	return paymentSourceTypeNames[p]
+}
+
+// ParsePaymentSourceType returns the PaymentSourceType matching s by wire value or by name.
+func ParsePaymentSourceType(s string) (PaymentSourceType, error) {
+	if _, ok := paymentSourceTypeNames[PaymentSourceType(s)]; ok {
+		return PaymentSourceType(s), nil
+	}
+	if v, ok := paymentSourceTypeValues[s]; ok {
+		return v, nil
+	}
+	var zero PaymentSourceType
+	return zero, fmt.Errorf("%w for PaymentSourceType: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (p PaymentSourceType) MarshalText() ([]byte, error) {
+	return []byte(p), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParsePaymentSourceType.
+// Unknown values are kept as-is and reported by Validate.
+func (p *PaymentSourceType) UnmarshalText(text []byte) error {
+	*p = PaymentSourceType(text)
+	return nil
+}
+
 type Payment struct {
 	Source *Payment_Source `json:"source,omitempty"`
 }
@@ -94,4 +224,5 @@ var typesValidator *validator.Validate
 func init() {
 	typesValidator = validator.New(validator.WithRequiredStructEnabled())
 	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
 }
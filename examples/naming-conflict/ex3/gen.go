@@ -31,6 +31,83 @@ func (p ProductName) Validate() error {
 	}
 }
 
+// productNameNames maps ProductName values to their names.
+var productNameNames = map[ProductName]string{
+	ADVANCEDVAULTING: "ADVANCEDVAULTING",
+	EXPRESSCHECKOUT:  "EXPRESSCHECKOUT",
+	PAYMENTMETHODS:   "PAYMENTMETHODS",
+	PPCP:             "PPCP",
+	PPPLUS:           "PPPLUS",
+	WPPRO:            "WPPRO",
+}
+
+// productNameValues maps names to ProductName values.
+var productNameValues = map[string]ProductName{
+	"ADVANCEDVAULTING": ADVANCEDVAULTING,
+	"EXPRESSCHECKOUT":  EXPRESSCHECKOUT,
+	"PAYMENTMETHODS":   PAYMENTMETHODS,
+	"PPCP":             PPCP,
+	"PPPLUS":           PPPLUS,
+	"WPPRO":            WPPRO,
+}
+
+// String returns the wire value of the ProductName.
+func (p ProductName) String() string {
+	return string(p)
+}
+
+// IsValid reports whether the ProductName value is defined in the spec.
+func (p ProductName) IsValid() bool {
+	_, ok := productNameNames[p]
+	return ok
+}
+
+// Values returns all the ProductName values defined in the spec.
+func (ProductName) Values() []ProductName {
+	return []ProductName{
+		ADVANCEDVAULTING,
+		EXPRESSCHECKOUT,
+		PAYMENTMETHODS,
+		PPCP,
+		PPPLUS,
+		WPPRO,
+	}
+}
+
+// ProductNamePtr returns a pointer to v, to set the optional and nullable ProductName properties.
+func ProductNamePtr(v ProductName) *ProductName {
+	return &v
+}
+
+// Name returns the name of the ProductName value, or an empty string for unknown values.
+func (p ProductName) Name() string {
+	return productNameNames[p]
+}
+
+// ParseProductName returns the ProductName matching s by wire value or by name.
+func ParseProductName(s string) (ProductName, error) {
+	if _, ok := productNameNames[ProductName(s)]; ok {
+		return ProductName(s), nil
+	}
+	if v, ok := productNameValues[s]; ok {
+		return v, nil
+	}
+	var zero ProductName
+	return zero, fmt.Errorf("%w for ProductName: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (p ProductName) MarshalText() ([]byte, error) {
+	return []byte(p), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseProductName.
+// Unknown values are kept as-is and reported by Validate.
+func (p *ProductName) UnmarshalText(text []byte) error {
+	*p = ProductName(text)
+	return nil
+}
+
 // ProductName0 The name of the product.
 type ProductName0 string
 
@@ -72,6 +149,140 @@ func (p ProductName0) Validate() error {
 	}
 }
 
+// productName0Names maps ProductName0 values to their names.
+var productName0Names = map[ProductName0]string{
+	BILLMELATER:                  "BILLMELATER",
+	EBAYCHECKOUT:                 "EBAYCHECKOUT",
+	EMAILPAYMENTS:                "EMAILPAYMENTS",
+	ENHANCEDRECURRINGPAYMENTS:    "ENHANCEDRECURRINGPAYMENTS",
+	HOSTEDSOLESOLUTION:           "HOSTEDSOLESOLUTION",
+	MASSPAYMENT:                  "MASSPAYMENT",
+	MOBILEEXPRESSCHECKOUT:        "MOBILEEXPRESSCHECKOUT",
+	MOBILEINSTORE:                "MOBILEINSTORE",
+	MOBILEPAYMENTACCEPTANCE:      "MOBILEPAYMENTACCEPTANCE",
+	MOBILEPAYPALSTANDARD:         "MOBILEPAYPALSTANDARD",
+	PAYFLOWLINK:                  "PAYFLOWLINK",
+	PAYFLOWPRO:                   "PAYFLOWPRO",
+	PAYPALADVANCED:               "PAYPALADVANCED",
+	PAYPALHERE:                   "PAYPALHERE",
+	PAYPALPRO:                    "PAYPALPRO",
+	PAYPALSTANDARD:               "PAYPALSTANDARD",
+	PPCPCUSTOM:                   "PPCPCUSTOM",
+	PPCPSTANDARD:                 "PPCPSTANDARD",
+	ProductName0ADVANCEDVAULTING: "ADVANCEDVAULTING",
+	ProductName0EXPRESSCHECKOUT:  "EXPRESSCHECKOUT",
+	ProductName0PAYMENTMETHODS:   "PAYMENTMETHODS",
+	VIRTUALTERMINAL:              "VIRTUALTERMINAL",
+	WEBSITEPAYMENTSPRO20:         "WEBSITEPAYMENTSPRO20",
+	WEBSITEPAYMENTSPRO30:         "WEBSITEPAYMENTSPRO30",
+	WEBSITEPAYMENTSSTANDARD:      "WEBSITEPAYMENTSSTANDARD",
+}
+
+// productName0Values maps names to ProductName0 values.
+var productName0Values = map[string]ProductName0{
+	"BILLMELATER":               BILLMELATER,
+	"EBAYCHECKOUT":              EBAYCHECKOUT,
+	"EMAILPAYMENTS":             EMAILPAYMENTS,
+	"ENHANCEDRECURRINGPAYMENTS": ENHANCEDRECURRINGPAYMENTS,
+	"HOSTEDSOLESOLUTION":        HOSTEDSOLESOLUTION,
+	"MASSPAYMENT":               MASSPAYMENT,
+	"MOBILEEXPRESSCHECKOUT":     MOBILEEXPRESSCHECKOUT,
+	"MOBILEINSTORE":             MOBILEINSTORE,
+	"MOBILEPAYMENTACCEPTANCE":   MOBILEPAYMENTACCEPTANCE,
+	"MOBILEPAYPALSTANDARD":      MOBILEPAYPALSTANDARD,
+	"PAYFLOWLINK":               PAYFLOWLINK,
+	"PAYFLOWPRO":                PAYFLOWPRO,
+	"PAYPALADVANCED":            PAYPALADVANCED,
+	"PAYPALHERE":                PAYPALHERE,
+	"PAYPALPRO":                 PAYPALPRO,
+	"PAYPALSTANDARD":            PAYPALSTANDARD,
+	"PPCPCUSTOM":                PPCPCUSTOM,
+	"PPCPSTANDARD":              PPCPSTANDARD,
+	"ADVANCEDVAULTING":          ProductName0ADVANCEDVAULTING,
+	"EXPRESSCHECKOUT":           ProductName0EXPRESSCHECKOUT,
+	"PAYMENTMETHODS":            ProductName0PAYMENTMETHODS,
+	"VIRTUALTERMINAL":           VIRTUALTERMINAL,
+	"WEBSITEPAYMENTSPRO20":      WEBSITEPAYMENTSPRO20,
+	"WEBSITEPAYMENTSPRO30":      WEBSITEPAYMENTSPRO30,
+	"WEBSITEPAYMENTSSTANDARD":   WEBSITEPAYMENTSSTANDARD,
+}
+
+// String returns the wire value of the ProductName0.
+func (p ProductName0) String() string {
+	return string(p)
+}
+
+// IsValid reports whether the ProductName0 value is defined in the spec.
+func (p ProductName0) IsValid() bool {
+	_, ok := productName0Names[p]
+	return ok
+}
+
+// Values returns all the ProductName0 values defined in the spec.
+func (ProductName0) Values() []ProductName0 {
+	return []ProductName0{
+		BILLMELATER,
+		EBAYCHECKOUT,
+		EMAILPAYMENTS,
+		ENHANCEDRECURRINGPAYMENTS,
+		HOSTEDSOLESOLUTION,
+		MASSPAYMENT,
+		MOBILEEXPRESSCHECKOUT,
+		MOBILEINSTORE,
+		MOBILEPAYMENTACCEPTANCE,
+		MOBILEPAYPALSTANDARD,
+		PAYFLOWLINK,
+		PAYFLOWPRO,
+		PAYPALADVANCED,
+		PAYPALHERE,
+		PAYPALPRO,
+		PAYPALSTANDARD,
+		PPCPCUSTOM,
+		PPCPSTANDARD,
+		ProductName0ADVANCEDVAULTING,
+		ProductName0EXPRESSCHECKOUT,
+		ProductName0PAYMENTMETHODS,
+		VIRTUALTERMINAL,
+		WEBSITEPAYMENTSPRO20,
+		WEBSITEPAYMENTSPRO30,
+		WEBSITEPAYMENTSSTANDARD,
+	}
+}
+
+// ProductName0Ptr returns a pointer to v, to set the optional and nullable ProductName0 properties.
+func ProductName0Ptr(v ProductName0) *ProductName0 {
+	return &v
+}
+
+// Name returns the name of the ProductName0 value, or an empty string for unknown values.
+func (p ProductName0) Name() string {
+	return productName0Names[p]
+}
+
+// ParseProductName0 returns the ProductName0 matching s by wire value or by name.
+func ParseProductName0(s string) (ProductName0, error) {
+	if _, ok := productName0Names[ProductName0(s)]; ok {
+		return ProductName0(s), nil
+	}
+	if v, ok := productName0Values[s]; ok {
+		return v, nil
+	}
+	var zero ProductName0
+	return zero, fmt.Errorf("%w for ProductName0: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (p ProductName0) MarshalText() ([]byte, error) {
+	return []byte(p), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseProductName0.
+// Unknown values are kept as-is and reported by Validate.
+func (p *ProductName0) UnmarshalText(text []byte) error {
+	*p = ProductName0(text)
+	return nil
+}
+
 type ProductStatus string
 
 const (
@@ -90,6 +301,74 @@ func (p ProductStatus) Validate() error {
 	}
 }
 
+// productStatusNames maps ProductStatus values to their names.
+var productStatusNames = map[ProductStatus]string{
+	ACTIVE:   "ACTIVE",
+	INACTIVE: "INACTIVE",
+	PENDING:  "PENDING",
+}
+
+// productStatusValues maps names to ProductStatus values.
+var productStatusValues = map[string]ProductStatus{
+	"ACTIVE":   ACTIVE,
+	"INACTIVE": INACTIVE,
+	"PENDING":  PENDING,
+}
+
+// String returns the wire value of the ProductStatus.
+func (p ProductStatus) String() string {
+	return string(p)
+}
+
+// IsValid reports whether the ProductStatus value is defined in the spec.
+func (p ProductStatus) IsValid() bool {
+	_, ok := productStatusNames[p]
+	return ok
+}
+
+// Values returns all the ProductStatus values defined in the spec.
+func (ProductStatus) Values() []ProductStatus {
+	return []ProductStatus{
+		ACTIVE,
+		INACTIVE,
+		PENDING,
+	}
+}
+
+// ProductStatusPtr returns a pointer to v, to set the optional and nullable ProductStatus properties.
+func ProductStatusPtr(v ProductStatus) *ProductStatus {
+	return &v
+}
+
+// Name returns the name of the ProductStatus value, or an empty string for unknown values.
+func (p ProductStatus) Name() string {
+	return productStatusNames[p]
+}
+
+// ParseProductStatus returns the ProductStatus matching s by wire value or by name.
+func ParseProductStatus(s string) (ProductStatus, error) {
+	if _, ok := productStatusNames[ProductStatus(s)]; ok {
+		return ProductStatus(s), nil
+	}
+	if v, ok := productStatusValues[s]; ok {
+		return v, nil
+	}
+	var zero ProductStatus
+	return zero, fmt.Errorf("%w for ProductStatus: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (p ProductStatus) MarshalText() ([]byte, error) {
+	return []byte(p), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseProductStatus.
+// Unknown values are kept as-is and reported by Validate.
+func (p *ProductStatus) UnmarshalText(text []byte) error {
+	*p = ProductStatus(text)
+	return nil
+}
+
 type GetReferralsResponse struct {
 	Products []ProductName `json:"products,omitempty"`
 }
@@ -131,4 +410,5 @@ var typesValidator *validator.Validate
 func init() {
 	typesValidator = validator.New(validator.WithRequiredStructEnabled())
 	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
 }
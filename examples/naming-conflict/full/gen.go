@@ -27,6 +27,71 @@ func (s StatusQuery) Validate() error {
 	}
 }
 
+// statusQueryNames maps StatusQuery values to their names.
+var statusQueryNames = map[StatusQuery]string{
+	Active:  "Active",
+	Pending: "Pending",
+}
+
+// statusQueryValues maps names to StatusQuery values.
+var statusQueryValues = map[string]StatusQuery{
+	"Active":  Active,
+	"Pending": Pending,
+}
+
+// String returns the wire value of the StatusQuery.
+func (s StatusQuery) String() string {
+	return string(s)
+}
+
+// IsValid reports whether the StatusQuery value is defined in the spec.
+func (s StatusQuery) IsValid() bool {
+	_, ok := statusQueryNames[s]
+	return ok
+}
+
+// Values returns all the StatusQuery values defined in the spec.
+func (StatusQuery) Values() []StatusQuery {
+	return []StatusQuery{
+		Active,
+		Pending,
+	}
+}
+
+// StatusQueryPtr returns a pointer to v, to set the optional and nullable StatusQuery properties.
+func StatusQueryPtr(v StatusQuery) *StatusQuery {
+	return &v
+}
+
+// Name returns the name of the StatusQuery value, or an empty string for unknown values.
+func (s StatusQuery) Name() string {
+	return statusQueryNames[s]
+}
+
+// ParseStatusQuery returns the StatusQuery matching s by wire value or by name.
+func ParseStatusQuery(s string) (StatusQuery, error) {
+	if _, ok := statusQueryNames[StatusQuery(s)]; ok {
+		return StatusQuery(s), nil
+	}
+	if v, ok := statusQueryValues[s]; ok {
+		return v, nil
+	}
+	var zero StatusQuery
+	return zero, fmt.Errorf("%w for StatusQuery: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (s StatusQuery) MarshalText() ([]byte, error) {
+	return []byte(s), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseStatusQuery.
+// Unknown values are kept as-is and reported by Validate.
+func (s *StatusQuery) UnmarshalText(text []byte) error {
+	*s = StatusQuery(text)
+	return nil
+}
+
 type Category string
 
 const (
@@ -45,6 +110,74 @@ func (c Category) Validate() error {
 	}
 }
 
+// categoryNames maps Category values to their names.
+var categoryNames = map[Category]string{
+	Clothing:    "Clothing",
+	Electronics: "Electronics",
+	Food:        "Food",
+}
+
+// categoryValues maps names to Category values.
+var categoryValues = map[string]Category{
+	"Clothing":    Clothing,
+	"Electronics": Electronics,
+	"Food":        Food,
+}
+
+// String returns the wire value of the Category.
+func (c Category) String() string {
+	return string(c)
+}
+
+// IsValid reports whether the Category value is defined in the spec.
+func (c Category) IsValid() bool {
+	_, ok := categoryNames[c]
+	return ok
+}
+
+// Values returns all the Category values defined in the spec.
+func (Category) Values() []Category {
+	return []Category{
+		Clothing,
+		Electronics,
+		Food,
+	}
+}
+
+// CategoryPtr returns a pointer to v, to set the optional and nullable Category properties.
+func CategoryPtr(v Category) *Category {
+	return &v
+}
+
+// Name returns the name of the Category value, or an empty string for unknown values.
+func (c Category) Name() string {
+	return categoryNames[c]
+}
+
+// ParseCategory returns the Category matching s by wire value or by name.
+func ParseCategory(s string) (Category, error) {
+	if _, ok := categoryNames[Category(s)]; ok {
+		return Category(s), nil
+	}
+	if v, ok := categoryValues[s]; ok {
+		return v, nil
+	}
+	var zero Category
+	return zero, fmt.Errorf("%w for Category: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (c Category) MarshalText() ([]byte, error) {
+	return []byte(c), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseCategory.
+// Unknown values are kept as-is and reported by Validate.
+func (c *Category) UnmarshalText(text []byte) error {
+	*c = Category(text)
+	return nil
+}
+
 type Status string
 
 const (
@@ -63,6 +196,74 @@ func (s Status) Validate() error {
 	}
 }
 
+// statusNames maps Status values to their names.
+var statusNames = map[Status]string{
+	Archived:  "Archived",
+	Draft:     "Draft",
+	Published: "Published",
+}
+
+// statusValues maps names to Status values.
+var statusValues = map[string]Status{
+	"Archived":  Archived,
+	"Draft":     Draft,
+	"Published": Published,
+}
+
+// String returns the wire value of the Status.
+func (s Status) String() string {
+	return string(s)
+}
+
+// IsValid reports whether the Status value is defined in the spec.
+func (s Status) IsValid() bool {
+	_, ok := statusNames[s]
+	return ok
+}
+
+// Values returns all the Status values defined in the spec.
+func (Status) Values() []Status {
+	return []Status{
+		Archived,
+		Draft,
+		Published,
+	}
+}
+
+// StatusPtr returns a pointer to v, to set the optional and nullable Status properties.
+func StatusPtr(v Status) *Status {
+	return &v
+}
+
+// Name returns the name of the Status value, or an empty string for unknown values.
+func (s Status) Name() string {
+	return statusNames[s]
+}
+
+// ParseStatus returns the Status matching s by wire value or by name.
+func ParseStatus(s string) (Status, error) {
+	if _, ok := statusNames[Status(s)]; ok {
+		return Status(s), nil
+	}
+	if v, ok := statusValues[s]; ok {
+		return v, nil
+	}
+	var zero Status
+	return zero, fmt.Errorf("%w for Status: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (s Status) MarshalText() ([]byte, error) {
+	return []byte(s), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseStatus.
+// Unknown values are kept as-is and reported by Validate.
+func (s *Status) UnmarshalText(text []byte) error {
+	*s = Status(text)
+	return nil
+}
+
 type ItemType string
 
 const (
@@ -81,6 +282,74 @@ func (i ItemType) Validate() error {
 	}
 }
 
+// itemTypeNames maps ItemType values to their names.
+var itemTypeNames = map[ItemType]string{
+	ItemTypeCategory: "Category",
+	ItemTypeItem:     "Item",
+	ItemTypeLabel:    "Label",
+}
+
+// itemTypeValues maps names to ItemType values.
+var itemTypeValues = map[string]ItemType{
+	"Category": ItemTypeCategory,
+	"Item":     ItemTypeItem,
+	"Label":    ItemTypeLabel,
+}
+
+// String returns the wire value of the ItemType.
+func (i ItemType) String() string {
+	return string(i)
+}
+
+// IsValid reports whether the ItemType value is defined in the spec.
+func (i ItemType) IsValid() bool {
+	_, ok := itemTypeNames[i]
+	return ok
+}
+
+// Values returns all the ItemType values defined in the spec.
+func (ItemType) Values() []ItemType {
+	return []ItemType{
+		ItemTypeCategory,
+		ItemTypeItem,
+		ItemTypeLabel,
+	}
+}
+
+// ItemTypePtr returns a pointer to v, to set the optional and nullable ItemType properties.
+func ItemTypePtr(v ItemType) *ItemType {
+	return &v
+}
+
+// Name returns the name of the ItemType value, or an empty string for unknown values.
+func (i ItemType) Name() string {
+	return itemTypeNames[i]
+}
+
+// ParseItemType returns the ItemType matching s by wire value or by name.
+func ParseItemType(s string) (ItemType, error) {
+	if _, ok := itemTypeNames[ItemType(s)]; ok {
+		return ItemType(s), nil
+	}
+	if v, ok := itemTypeValues[s]; ok {
+		return v, nil
+	}
+	var zero ItemType
+	return zero, fmt.Errorf("%w for ItemType: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (i ItemType) MarshalText() ([]byte, error) {
+	return []byte(i), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseItemType.
+// Unknown values are kept as-is and reported by Validate.
+func (i *ItemType) UnmarshalText(text []byte) error {
+	*i = ItemType(text)
+	return nil
+}
+
 type ProductType string
 
 const (
@@ -99,6 +368,74 @@ func (p ProductType) Validate() error {
 	}
 }
 
+// productTypeNames maps ProductType values to their names.
+var productTypeNames = map[ProductType]string{
+	Digital:  "Digital",
+	Physical: "Physical",
+	Service:  "Service",
+}
+
+// productTypeValues maps names to ProductType values.
+var productTypeValues = map[string]ProductType{
+	"Digital":  Digital,
+	"Physical": Physical,
+	"Service":  Service,
+}
+
+// String returns the wire value of the ProductType.
+func (p ProductType) String() string {
+	return string(p)
+}
+
+// IsValid reports whether the ProductType value is defined in the spec.
+func (p ProductType) IsValid() bool {
+	_, ok := productTypeNames[p]
+	return ok
+}
+
+// Values returns all the ProductType values defined in the spec.
+func (ProductType) Values() []ProductType {
+	return []ProductType{
+		Digital,
+		Physical,
+		Service,
+	}
+}
+
+// ProductTypePtr returns a pointer to v, to set the optional and nullable ProductType properties.
+func ProductTypePtr(v ProductType) *ProductType {
+	return &v
+}
+
+// Name returns the name of the ProductType value, or an empty string for unknown values.
+func (p ProductType) Name() string {
+	return productTypeNames[p]
+}
+
+// ParseProductType returns the ProductType matching s by wire value or by name.
+func ParseProductType(s string) (ProductType, error) {
+	if _, ok := productTypeNames[ProductType(s)]; ok {
+		return ProductType(s), nil
+	}
+	if v, ok := productTypeValues[s]; ok {
+		return v, nil
+	}
+	var zero ProductType
+	return zero, fmt.Errorf("%w for ProductType: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (p ProductType) MarshalText() ([]byte, error) {
+	return []byte(p), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseProductType.
+// Unknown values are kept as-is and reported by Validate.
+func (p *ProductType) UnmarshalText(text []byte) error {
+	*p = ProductType(text)
+	return nil
+}
+
 type TokenBody struct {
 	// Token The token value
 	Token string `json:"token" validate:"required"`
@@ -275,4 +612,5 @@ var typesValidator *validator.Validate
 func init() {
 	typesValidator = validator.New(validator.WithRequiredStructEnabled())
 	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
 }
@@ -0,0 +1,372 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package nullable
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/go-playground/validator/v10"
+)
+
+// Client is the client for the API implementing the Client interface.
+type Client struct {
+	apiClient runtime.APIClient
+}
+
+// NewClient creates a new instance of the Client client.
+func NewClient(apiClient runtime.APIClient) *Client {
+	return &Client{apiClient: apiClient}
+}
+
+// NewDefaultClient creates a new instance of the Client client with default api client.
+func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithUserAgent("Nullable-Properties/1.0.0 oapi-codegen-dd/v3.63.4")}, opts...)
+	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API client: %w", err)
+	}
+	return &Client{apiClient: apiClient}, nil
+}
+
+// ClientInterface is the interface for the API client.
+type ClientInterface interface {
+	UpdateUser(ctx context.Context, options *UpdateUserRequestOptions, reqEditors ...runtime.RequestEditorFn) (*UpdateUserResponse, error)
+}
+
+func (c *Client) UpdateUser(ctx context.Context, options *UpdateUserRequestOptions, reqEditors ...runtime.RequestEditorFn) (*UpdateUserResponse, error) {
+	var err error
+	if options == nil || options.Body == nil {
+		return nil, fmt.Errorf("error creating request body: %w", runtime.ErrMissingValue)
+	}
+	if options != nil && options.Body != nil {
+		if v, ok := any(options.Body).(runtime.Validator); ok {
+			if err = v.Validate(); err != nil {
+				return nil, fmt.Errorf("error validating request body: %w", err)
+			}
+		}
+	}
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL:  c.apiClient.GetBaseURL() + "/users/{id}",
+		Method:      "PATCH",
+		ContentType: "application/json",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*UpdateUserResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(UpdateUserResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/users/{id}")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+var _ ClientInterface = (*Client)(nil)
+
+// UpdateUserRequestOptions is the options needed to make a request to UpdateUser.
+type UpdateUserRequestOptions struct {
+	PathParams *UpdateUserPath
+	Body       *UpdateUserBody
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *UpdateUserRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *UpdateUserRequestOptions) GetPathParams() (map[string]any, error) {
+	return runtime.AsMap[any](o.PathParams)
+}
+
+// GetQuery returns the query params as a map.
+func (o *UpdateUserRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
+func (o *UpdateUserRequestOptions) GetBody() any {
+	if o.Body == nil {
+		return nil
+	}
+	return o.Body
+}
+
+// GetHeader returns the headers as a map.
+func (o *UpdateUserRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+type Role string
+
+const (
+	Admin  Role = "admin"
+	Member Role = "member"
+)
+
+// Validate checks if the Role value is valid
+func (r Role) Validate() error {
+	switch r {
+	case Admin, Member:
+		return nil
+	default:
+		return runtime.NewValidationErrorsFromString("Enum", fmt.Sprintf("must be a valid Role value, got: %v", r))
+	}
+}
+
+// roleNames maps Role values to their names.
+var roleNames = map[Role]string{
+	Admin:  "Admin",
+	Member: "Member",
+}
+
+// roleValues maps names to Role values.
+var roleValues = map[string]Role{
+	"Admin":  Admin,
+	"Member": Member,
+}
+
+// String returns the wire value of the Role.
+func (r Role) String() string {
+	return string(r)
+}
+
+// IsValid reports whether the Role value is defined in the spec.
+func (r Role) IsValid() bool {
+	_, ok := roleNames[r]
+	return ok
+}
+
+// Values returns all the Role values defined in the spec.
+func (Role) Values() []Role {
+	return []Role{
+		Admin,
+		Member,
+	}
+}
+
+// RolePtr returns a pointer to v, to set the optional and nullable Role properties.
+func RolePtr(v Role) *Role {
+	return &v
+}
+
+// Name returns the name of the Role value, or an empty string for unknown values.
+func (r Role) Name() string {
+	return roleNames[r]
+}
+
+// ParseRole returns the Role matching s by wire value or by name.
+func ParseRole(s string) (Role, error) {
+	if _, ok := roleNames[Role(s)]; ok {
+		return Role(s), nil
+	}
+	if v, ok := roleValues[s]; ok {
+		return v, nil
+	}
+	var zero Role
+	return zero, fmt.Errorf("%w for Role: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (r Role) MarshalText() ([]byte, error) {
+	return []byte(r), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseRole.
+// Unknown values are kept as-is and reported by Validate.
+func (r *Role) UnmarshalText(text []byte) error {
+	*r = Role(text)
+	return nil
+}
+
+type UpdateUserPath struct {
+	ID string `json:"id" validate:"required"`
+}
+
+func (u UpdateUserPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(u))
+}
+
+type UpdateUserBody = UserPatch
+
+type UpdateUserResponse = UserPatch
+
+type UserPatch struct {
+	ID       string                     `json:"id" validate:"required"`
+	Nickname runtime.Nullable[string]   `json:"nickname,omitzero"`
+	Age      runtime.Nullable[int]      `json:"age,omitzero"`
+	Role     runtime.Nullable[Role]     `json:"role,omitzero"`
+	Address  runtime.Nullable[Address]  `json:"address,omitzero"`
+	Tags     runtime.Nullable[[]string] `json:"tags,omitzero"`
+	Bio      *string                    `json:"bio,omitempty"`
+	Settings Settings                   `json:"settings,omitempty"`
+}
+
+func (u UserPatch) Validate() error {
+	var errors runtime.ValidationErrors
+	if err := typesValidator.Var(u.ID, "required"); err != nil {
+		errors = errors.Append("ID", err)
+	}
+	if value, ok := u.Nickname.Get(); ok {
+		if err := typesValidator.Var(value, "omitempty,max=10"); err != nil {
+			errors = errors.Append("Nickname", err)
+		}
+	}
+	if value, ok := u.Age.Get(); ok {
+		if err := typesValidator.Var(value, "omitempty,gte=0"); err != nil {
+			errors = errors.Append("Age", err)
+		}
+	}
+	if value, ok := u.Role.Get(); ok {
+		if val, ok := any(value).(runtime.Validator); ok {
+			if err := val.Validate(); err != nil {
+				errors = errors.Append("Role", err)
+			}
+		}
+	}
+	if value, ok := u.Address.Get(); ok {
+		if val, ok := any(value).(runtime.Validator); ok {
+			if err := val.Validate(); err != nil {
+				errors = errors.Append("Address", err)
+			}
+		}
+	}
+	if v, ok := any(u.Settings).(runtime.Validator); ok && v != nil {
+		if err := v.Validate(); err != nil {
+			errors = errors.Append("Settings", err)
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+type Address struct {
+	City string `json:"city" validate:"required,min=1"`
+}
+
+func (a Address) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(a))
+}
+
+type Settings struct {
+	Theme                runtime.Nullable[string] `json:"theme,omitzero"`
+	AdditionalProperties map[string]string        `json:"-"`
+}
+
+// Getter for additional properties for Settings. Returns the specified
+// element and whether it was found
+func (s Settings) Get(fieldName string) (value string, found bool) {
+	if s.AdditionalProperties != nil {
+		value, found = s.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for Settings
+func (s *Settings) Set(fieldName string, value string) {
+	if s.AdditionalProperties == nil {
+		s.AdditionalProperties = make(map[string]string)
+	}
+	s.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for Settings to handle AdditionalProperties
+func (s *Settings) UnmarshalJSON(data []byte) error {
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(data, &object); err != nil {
+		return err
+	}
+
+	if raw, found := object["theme"]; found {
+		if err := json.Unmarshal(raw, &s.Theme); err != nil {
+			return fmt.Errorf("error reading 'theme': %w", err)
+		}
+		delete(object, "theme")
+	}
+	if len(object) != 0 {
+		s.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			if err := json.Unmarshal(fieldBuf, &fieldVal); err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			s.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for Settings to handle AdditionalProperties.
+// Fields are written directly, additional properties replace the declared ones with the same name.
+func (s Settings) MarshalJSON() ([]byte, error) {
+	var object runtime.JSONObjectWriter
+	if _, ok := s.AdditionalProperties["theme"]; !ok {
+		if s.Theme.IsSpecified() {
+			if err := object.WriteField("theme", s.Theme); err != nil {
+				return nil, fmt.Errorf("error marshaling 'theme': %w", err)
+			}
+		}
+	}
+	for _, fieldName := range slices.Sorted(maps.Keys(s.AdditionalProperties)) {
+		if err := object.WriteField(fieldName, s.AdditionalProperties[fieldName]); err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return object.Bytes(), nil
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
+}
@@ -0,0 +1,101 @@
+package nullable
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type httpClientAdapter struct {
+	client *http.Client
+}
+
+func (a *httpClientAdapter) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return a.client.Do(req.WithContext(ctx))
+}
+
+func TestUserPatch_Marshal(t *testing.T) {
+	patch := UserPatch{
+		ID:       "1",
+		Nickname: runtime.NewNullable("Ann"),
+		Age:      runtime.Null[int](),
+		Address:  runtime.NewNullable(Address{City: "Paris"}),
+	}
+
+	data, err := json.Marshal(patch)
+	require.NoError(t, err)
+
+	// absent properties are left out, null ones are sent
+	assert.JSONEq(t, `{"id":"1","nickname":"Ann","age":null,"address":{"city":"Paris"},"settings":{}}`, string(data))
+}
+
+func TestUserPatch_Unmarshal(t *testing.T) {
+	var patch UserPatch
+	require.NoError(t, json.Unmarshal([]byte(`{"id":"1","nickname":null,"tags":["a"]}`), &patch))
+
+	assert.True(t, patch.Nickname.IsNull())
+	assert.False(t, patch.Age.IsSpecified())
+	tags, ok := patch.Tags.Get()
+	assert.True(t, ok)
+	assert.Equal(t, []string{"a"}, tags)
+}
+
+func TestUserPatch_Validate(t *testing.T) {
+	valid := UserPatch{ID: "1", Nickname: runtime.Null[string](), Role: runtime.NewNullable(Admin)}
+	require.NoError(t, valid.Validate())
+
+	invalid := UserPatch{
+		ID:       "1",
+		Nickname: runtime.NewNullable("much too long"),
+		Age:      runtime.NewNullable(-1),
+		Role:     runtime.NewNullable(Role("owner")),
+		Address:  runtime.NewNullable(Address{}),
+	}
+	var errs runtime.ValidationErrors
+	require.ErrorAs(t, invalid.Validate(), &errs)
+	assert.Len(t, errs, 4)
+}
+
+func TestSettings_MarshalJSON(t *testing.T) {
+	data, err := json.Marshal(Settings{Theme: runtime.Null[string](), AdditionalProperties: map[string]string{"lang": "fr"}})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"theme":null,"lang":"fr"}`, string(data))
+
+	data, err = json.Marshal(Settings{})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{}`, string(data))
+
+	var settings Settings
+	require.NoError(t, json.Unmarshal([]byte(`{"theme":null}`), &settings))
+	assert.True(t, settings.Theme.IsNull())
+}
+
+func TestClient_SendsExplicitNull(t *testing.T) {
+	var sent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		sent = string(body)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"1","nickname":null}`))
+	}))
+	defer server.Close()
+
+	client, err := NewDefaultClient(server.URL, runtime.WithHTTPClient(&httpClientAdapter{client: server.Client()}))
+	require.NoError(t, err)
+
+	res, err := client.UpdateUser(context.Background(), &UpdateUserRequestOptions{
+		PathParams: &UpdateUserPath{ID: "1"},
+		Body:       &UpdateUserBody{ID: "1", Nickname: runtime.Null[string]()},
+	})
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{"id":"1","nickname":null,"settings":{}}`, sent)
+	assert.True(t, res.Nickname.IsNull())
+}
@@ -0,0 +1,345 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package patch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/go-playground/validator/v10"
+)
+
+// Client is the client for the API implementing the Client interface.
+type Client struct {
+	apiClient runtime.APIClient
+}
+
+// NewClient creates a new instance of the Client client.
+func NewClient(apiClient runtime.APIClient) *Client {
+	return &Client{apiClient: apiClient}
+}
+
+// NewDefaultClient creates a new instance of the Client client with default api client.
+func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithUserAgent("Pets/1.0.0 oapi-codegen-dd/v3.63.4")}, opts...)
+	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API client: %w", err)
+	}
+	return &Client{apiClient: apiClient}, nil
+}
+
+// ClientInterface is the interface for the API client.
+type ClientInterface interface {
+	UpdatePet(ctx context.Context, options *UpdatePetRequestOptions, reqEditors ...runtime.RequestEditorFn) (*UpdatePetResponse, error)
+
+	PatchPet(ctx context.Context, options *PatchPetRequestOptions, reqEditors ...runtime.RequestEditorFn) (*PatchPetResponse, error)
+}
+
+func (c *Client) UpdatePet(ctx context.Context, options *UpdatePetRequestOptions, reqEditors ...runtime.RequestEditorFn) (*UpdatePetResponse, error) {
+	var err error
+	if options == nil || options.Body == nil {
+		return nil, fmt.Errorf("error creating request body: %w", runtime.ErrMissingValue)
+	}
+	if options != nil && options.Body != nil {
+		if v, ok := any(options.Body).(runtime.Validator); ok {
+			if err = v.Validate(); err != nil {
+				return nil, fmt.Errorf("error validating request body: %w", err)
+			}
+		}
+	}
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL:  c.apiClient.GetBaseURL() + "/pets/{id}",
+		Method:      "PATCH",
+		ContentType: "application/merge-patch+json",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*UpdatePetResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(UpdatePetResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/pets/{id}")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+func (c *Client) PatchPet(ctx context.Context, options *PatchPetRequestOptions, reqEditors ...runtime.RequestEditorFn) (*PatchPetResponse, error) {
+	var err error
+	if options == nil || options.Body == nil {
+		return nil, fmt.Errorf("error creating request body: %w", runtime.ErrMissingValue)
+	}
+	if options != nil && options.Body != nil {
+		if v, ok := any(options.Body).(runtime.Validator); ok {
+			if err = v.Validate(); err != nil {
+				return nil, fmt.Errorf("error validating request body: %w", err)
+			}
+		}
+	}
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL:  c.apiClient.GetBaseURL() + "/pets/{id}/ops",
+		Method:      "PATCH",
+		ContentType: "application/json-patch+json",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*PatchPetResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(PatchPetResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/pets/{id}/ops")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+var _ ClientInterface = (*Client)(nil)
+
+// UpdatePetRequestOptions is the options needed to make a request to UpdatePet.
+type UpdatePetRequestOptions struct {
+	PathParams *UpdatePetPath
+	Body       *UpdatePetBody
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *UpdatePetRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *UpdatePetRequestOptions) GetPathParams() (map[string]any, error) {
+	return runtime.AsMap[any](o.PathParams)
+}
+
+// GetQuery returns the query params as a map.
+func (o *UpdatePetRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
+func (o *UpdatePetRequestOptions) GetBody() any {
+	if o.Body == nil {
+		return nil
+	}
+	return o.Body
+}
+
+// GetHeader returns the headers as a map.
+func (o *UpdatePetRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+// PatchPetRequestOptions is the options needed to make a request to PatchPet.
+type PatchPetRequestOptions struct {
+	PathParams *PatchPetPath
+	Body       *PatchPetBody
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *PatchPetRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *PatchPetRequestOptions) GetPathParams() (map[string]any, error) {
+	return runtime.AsMap[any](o.PathParams)
+}
+
+// GetQuery returns the query params as a map.
+func (o *PatchPetRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
+func (o *PatchPetRequestOptions) GetBody() any {
+	if o.Body == nil {
+		return nil
+	}
+	return o.Body
+}
+
+// GetHeader returns the headers as a map.
+func (o *PatchPetRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+type UpdatePetPath struct {
+	ID string `json:"id" validate:"required"`
+}
+
+func (u UpdatePetPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(u))
+}
+
+type PatchPetPath struct {
+	ID string `json:"id" validate:"required"`
+}
+
+func (p PatchPetPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(p))
+}
+
+type UpdatePetBody struct {
+	Name    runtime.Nullable[string]   `json:"name,omitzero"`
+	Tag     runtime.Nullable[string]   `json:"tag,omitzero"`
+	Address runtime.Nullable[Address]  `json:"address,omitzero"`
+	Tags    runtime.Nullable[[]string] `json:"tags,omitzero"`
+}
+
+func (u UpdatePetBody) Validate() error {
+	var errors runtime.ValidationErrors
+	if value, ok := u.Name.Get(); ok {
+		if err := typesValidator.Var(value, "omitempty,max=20"); err != nil {
+			errors = errors.Append("Name", err)
+		}
+	}
+	if value, ok := u.Address.Get(); ok {
+		if val, ok := any(value).(runtime.Validator); ok {
+			if err := val.Validate(); err != nil {
+				errors = errors.Append("Address", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+// NewUpdatePetBody returns the merge patch turning from into to.
+// Changed nested objects are sent in full, see runtime.NewMergePatch.
+func NewUpdatePetBody(from, to Pet) (*UpdatePetBody, error) {
+	return runtime.NewMergePatch[UpdatePetBody](from, to)
+}
+
+type PatchPetBody = runtime.JSONPatch
+
+type UpdatePetResponse = Pet
+
+type PatchPetResponse = Pet
+
+type Pet struct {
+	ID      *string  `json:"id,omitempty"`
+	Name    string   `json:"name" validate:"required,max=20"`
+	Tag     *string  `json:"tag,omitempty"`
+	Address *Address `json:"address,omitempty"`
+	Tags    []string `json:"tags,omitempty"`
+}
+
+func (p Pet) Validate() error {
+	var errors runtime.ValidationErrors
+	if err := typesValidator.Var(p.Name, "required,max=20"); err != nil {
+		errors = errors.Append("Name", err)
+	}
+	if p.Address != nil {
+		if v, ok := any(p.Address).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Address", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+type Address struct {
+	City   string  `json:"city" validate:"required"`
+	Street *string `json:"street,omitempty"`
+}
+
+func (a Address) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(a))
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
+}
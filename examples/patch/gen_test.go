@@ -0,0 +1,107 @@
+package patch
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type httpClientAdapter struct {
+	client *http.Client
+}
+
+func (a *httpClientAdapter) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return a.client.Do(req.WithContext(ctx))
+}
+
+// newRecordingClient returns a client whose requests are recorded in req and body.
+func newRecordingClient(t *testing.T, req **http.Request, body *string) *Client {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		*req, *body = r, string(data)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"1","name":"Max"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := NewDefaultClient(server.URL, runtime.WithHTTPClient(&httpClientAdapter{client: server.Client()}))
+	require.NoError(t, err)
+	return client
+}
+
+func TestNewUpdatePetBody(t *testing.T) {
+	from := Pet{ID: ptr("1"), Name: "Rex", Tag: ptr("dog"), Address: &Address{City: "Berlin"}}
+	to := Pet{ID: ptr("1"), Name: "Max", Address: &Address{City: "Paris"}, Tags: []string{"a"}}
+
+	patch, err := NewUpdatePetBody(from, to)
+	require.NoError(t, err)
+
+	assert.Equal(t, runtime.NewNullable("Max"), patch.Name)
+	assert.True(t, patch.Tag.IsNull())
+	assert.Equal(t, runtime.NewNullable(Address{City: "Paris"}), patch.Address)
+	assert.Equal(t, runtime.NewNullable([]string{"a"}), patch.Tags)
+}
+
+func TestUpdatePetBody_Validate(t *testing.T) {
+	assert.NoError(t, UpdatePetBody{}.Validate())
+	assert.Error(t, UpdatePetBody{Name: runtime.NewNullable("a name that is much too long")}.Validate())
+}
+
+func TestClient_SendsMergePatch(t *testing.T) {
+	var (
+		req  *http.Request
+		sent string
+	)
+	client := newRecordingClient(t, &req, &sent)
+
+	patch, err := NewUpdatePetBody(Pet{Name: "Rex", Tag: ptr("dog")}, Pet{Name: "Max"})
+	require.NoError(t, err)
+
+	res, err := client.UpdatePet(context.Background(), &UpdatePetRequestOptions{
+		PathParams: &UpdatePetPath{ID: "1"},
+		Body:       patch,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "application/merge-patch+json", req.Header.Get("Content-Type"))
+	assert.JSONEq(t, `{"name":"Max","tag":null}`, sent)
+	assert.Equal(t, "Max", res.Name)
+}
+
+func TestClient_SendsJSONPatch(t *testing.T) {
+	var (
+		req  *http.Request
+		sent string
+	)
+	client := newRecordingClient(t, &req, &sent)
+
+	patch, err := runtime.CreateJSONPatch(Pet{Name: "Rex", Tags: []string{"a"}}, Pet{Name: "Max", Tags: []string{"b"}})
+	require.NoError(t, err)
+
+	_, err = client.PatchPet(context.Background(), &PatchPetRequestOptions{
+		PathParams: &PatchPetPath{ID: "1"},
+		Body:       &patch,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "application/json-patch+json", req.Header.Get("Content-Type"))
+	assert.JSONEq(t, `[{"op":"replace","path":"/name","value":"Max"},{"op":"replace","path":"/tags/0","value":"b"}]`, sent)
+
+	_, err = client.PatchPet(context.Background(), &PatchPetRequestOptions{
+		PathParams: &PatchPetPath{ID: "1"},
+		Body:       &PatchPetBody{{Op: "rename", Path: "/name"}},
+	})
+	var errs runtime.ValidationErrors
+	assert.ErrorAs(t, err, &errs)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
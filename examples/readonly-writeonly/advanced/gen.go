@@ -35,7 +35,7 @@ type CreateUserBody struct {
 	Name string `json:"name" validate:"required"`
 
 	// Email User's email address (regular required field)
-	Email runtime.Email `json:"email" validate:"required"`
+	Email runtime.Email `json:"email" validate:"required,email"`
 
 	// Password User's password. This is writeOnly AND required.
 	// - In request bodies (POST, PATCH): should be required
@@ -85,7 +85,7 @@ type UpdateUserBody struct {
 	Name string `json:"name" validate:"required"`
 
 	// Email User's email address (regular required field)
-	Email runtime.Email `json:"email" validate:"required"`
+	Email runtime.Email `json:"email" validate:"required,email"`
 
 	// Password User's password. This is writeOnly AND required.
 	// - In request bodies (POST, PATCH): should be required
@@ -143,7 +143,7 @@ type User struct {
 	Name string `json:"name" validate:"required"`
 
 	// Email User's email address (regular required field)
-	Email runtime.Email `json:"email" validate:"required"`
+	Email runtime.Email `json:"email" validate:"required,email"`
 
 	// Password User's password. This is writeOnly AND required.
 	// - In request bodies (POST, PATCH): should be required
@@ -188,4 +188,5 @@ var typesValidator *validator.Validate
 func init() {
 	typesValidator = validator.New(validator.WithRequiredStructEnabled())
 	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
 }
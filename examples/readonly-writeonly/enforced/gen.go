@@ -0,0 +1,247 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/go-playground/validator/v10"
+)
+
+// Client is the client for the API implementing the Client interface.
+type Client struct {
+	apiClient runtime.APIClient
+}
+
+// NewClient creates a new instance of the Client client.
+func NewClient(apiClient runtime.APIClient) *Client {
+	return &Client{apiClient: apiClient}
+}
+
+// NewDefaultClient creates a new instance of the Client client with default api client.
+func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithUserAgent("ReadOnly-WriteOnly-Enforced-Marshaling/1.0.0 oapi-codegen-dd/v3.63.4")}, opts...)
+	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API client: %w", err)
+	}
+	return &Client{apiClient: apiClient}, nil
+}
+
+// ClientInterface is the interface for the API client.
+type ClientInterface interface {
+	CreateTeam(ctx context.Context, options *CreateTeamRequestOptions, reqEditors ...runtime.RequestEditorFn) (*CreateTeamResponse, error)
+}
+
+func (c *Client) CreateTeam(ctx context.Context, options *CreateTeamRequestOptions, reqEditors ...runtime.RequestEditorFn) (*CreateTeamResponse, error) {
+	var err error
+	if options == nil || options.Body == nil {
+		return nil, fmt.Errorf("error creating request body: %w", runtime.ErrMissingValue)
+	}
+	if options != nil && options.Body != nil {
+		if v, ok := any(options.Body).(runtime.Validator); ok {
+			if err = v.Validate(); err != nil {
+				return nil, fmt.Errorf("error validating request body: %w", err)
+			}
+		}
+	}
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL:  c.apiClient.GetBaseURL() + "/teams",
+		Method:      "POST",
+		ContentType: "application/json",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*CreateTeamResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 201 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(CreateTeamResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/teams")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+var _ ClientInterface = (*Client)(nil)
+
+// CreateTeamRequestOptions is the options needed to make a request to CreateTeam.
+type CreateTeamRequestOptions struct {
+	Body *CreateTeamBody
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *CreateTeamRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *CreateTeamRequestOptions) GetPathParams() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetQuery returns the query params as a map.
+func (o *CreateTeamRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
+func (o *CreateTeamRequestOptions) GetBody() any {
+	if o.Body == nil {
+		return nil
+	}
+	return o.Body
+}
+
+// GetHeader returns the headers as a map.
+func (o *CreateTeamRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+type CreateTeamBody struct {
+	ID      *string  `json:"id,omitempty"`
+	Name    string   `json:"name" validate:"required"`
+	Lead    *Member  `json:"lead,omitempty"`
+	Members []Member `json:"members,omitempty"`
+}
+
+func (c CreateTeamBody) Validate() error {
+	var errors runtime.ValidationErrors
+	if err := typesValidator.Var(c.Name, "required"); err != nil {
+		errors = errors.Append("Name", err)
+	}
+	if c.Lead != nil {
+		if v, ok := any(c.Lead).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Lead", err)
+			}
+		}
+	}
+	for i, item := range c.Members {
+		if v, ok := any(item).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append(fmt.Sprintf("Members[%d]", i), err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+// MarshalJSONForRequest marshals CreateTeamBody as a request body, omitting readOnly properties.
+func (c CreateTeamBody) MarshalJSONForRequest() ([]byte, error) {
+	return runtime.MarshalJSONOmitting(c, []string{"id"}, map[string]any{"lead": c.Lead, "members": c.Members}, runtime.MarshalJSONForRequest)
+}
+
+// MarshalJSONForResponse marshals CreateTeamBody as a response body, omitting writeOnly properties.
+func (c CreateTeamBody) MarshalJSONForResponse() ([]byte, error) {
+	return runtime.MarshalJSONOmitting(c, nil, map[string]any{"lead": c.Lead, "members": c.Members}, runtime.MarshalJSONForResponse)
+}
+
+type CreateTeamResponse = Team
+
+type Team struct {
+	ID      *string  `json:"id,omitempty"`
+	Name    string   `json:"name" validate:"required"`
+	Lead    *Member  `json:"lead,omitempty"`
+	Members []Member `json:"members,omitempty"`
+}
+
+func (t Team) Validate() error {
+	var errors runtime.ValidationErrors
+	if err := typesValidator.Var(t.Name, "required"); err != nil {
+		errors = errors.Append("Name", err)
+	}
+	if t.Lead != nil {
+		if v, ok := any(t.Lead).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Lead", err)
+			}
+		}
+	}
+	for i, item := range t.Members {
+		if v, ok := any(item).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append(fmt.Sprintf("Members[%d]", i), err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+// MarshalJSONForRequest marshals Team as a request body, omitting readOnly properties.
+func (t Team) MarshalJSONForRequest() ([]byte, error) {
+	return runtime.MarshalJSONOmitting(t, []string{"id"}, map[string]any{"lead": t.Lead, "members": t.Members}, runtime.MarshalJSONForRequest)
+}
+
+// MarshalJSONForResponse marshals Team as a response body, omitting writeOnly properties.
+func (t Team) MarshalJSONForResponse() ([]byte, error) {
+	return runtime.MarshalJSONOmitting(t, nil, map[string]any{"lead": t.Lead, "members": t.Members}, runtime.MarshalJSONForResponse)
+}
+
+type Member struct {
+	ID       *string `json:"id,omitempty"`
+	Email    string  `json:"email" validate:"required"`
+	Password *string `json:"password,omitempty"`
+}
+
+func (m Member) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(m))
+}
+
+// MarshalJSONForRequest marshals Member as a request body, omitting readOnly properties.
+func (m Member) MarshalJSONForRequest() ([]byte, error) {
+	return runtime.MarshalJSONOmitting(m, []string{"id"}, nil, runtime.MarshalJSONForRequest)
+}
+
+// MarshalJSONForResponse marshals Member as a response body, omitting writeOnly properties.
+func (m Member) MarshalJSONForResponse() ([]byte, error) {
+	return runtime.MarshalJSONOmitting(m, []string{"password"}, nil, runtime.MarshalJSONForResponse)
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
+}
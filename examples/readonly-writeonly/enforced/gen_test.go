@@ -0,0 +1,88 @@
+package gen
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type httpClientAdapter struct {
+	client *http.Client
+}
+
+func (a *httpClientAdapter) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return a.client.Do(req.WithContext(ctx))
+}
+
+func newTeam() Team {
+	lead := Member{ID: ptr("m1"), Email: "lead@example.com", Password: ptr("secret")}
+	return Team{
+		ID:      ptr("t1"),
+		Name:    "Core",
+		Lead:    &lead,
+		Members: []Member{lead, {Email: "dev@example.com", Password: ptr("hunter2")}},
+	}
+}
+
+func TestTeam_MarshalJSONForRequest(t *testing.T) {
+	data, err := newTeam().MarshalJSONForRequest()
+	require.NoError(t, err)
+
+	// readOnly ids are omitted at every level, writeOnly passwords are sent
+	assert.JSONEq(t, `{
+		"name": "Core",
+		"lead": {"email": "lead@example.com", "password": "secret"},
+		"members": [
+			{"email": "lead@example.com", "password": "secret"},
+			{"email": "dev@example.com", "password": "hunter2"}
+		]
+	}`, string(data))
+}
+
+func TestTeam_MarshalJSONForResponse(t *testing.T) {
+	data, err := runtime.MarshalJSONForResponse([]Team{newTeam()})
+	require.NoError(t, err)
+
+	// writeOnly passwords are omitted at every level, readOnly ids are returned
+	assert.JSONEq(t, `[{
+		"id": "t1",
+		"name": "Core",
+		"lead": {"id": "m1", "email": "lead@example.com"},
+		"members": [
+			{"id": "m1", "email": "lead@example.com"},
+			{"email": "dev@example.com"}
+		]
+	}]`, string(data))
+}
+
+func TestClient_OmitsReadOnlyProperties(t *testing.T) {
+	var sent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		sent = string(body)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": "t1", "name": "Core"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewDefaultClient(server.URL, runtime.WithHTTPClient(&httpClientAdapter{client: server.Client()}))
+	require.NoError(t, err)
+
+	body := CreateTeamBody{ID: ptr("t1"), Name: "Core", Members: []Member{{ID: ptr("m1"), Email: "dev@example.com"}}}
+	res, err := client.CreateTeam(context.Background(), &CreateTeamRequestOptions{Body: &body})
+	require.NoError(t, err)
+
+	assert.Equal(t, "t1", *res.ID)
+	assert.JSONEq(t, `{"name": "Core", "members": [{"email": "dev@example.com"}]}`, sent)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -336,6 +336,20 @@ func (p *ProcessPaymentBody_D_AllOf0_OneOf_0_AnyOf) FromBool(val bool) error {
 	return err
 }
 
+// MergeBool merges the provided bool into the union data inside the ProcessPaymentBody_D_AllOf0_OneOf_0_AnyOf
+func (p *ProcessPaymentBody_D_AllOf0_OneOf_0_AnyOf) MergeBool(val bool) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(p.union, bts)
+	if err != nil {
+		return err
+	}
+	p.union = merged
+	return nil
+}
+
 // AsFloat32 returns the union data inside the ProcessPaymentBody_D_AllOf0_OneOf_0_AnyOf as a float32
 func (p *ProcessPaymentBody_D_AllOf0_OneOf_0_AnyOf) AsFloat32() (float32, error) {
 	return runtime.UnmarshalAs[float32](p.union)
@@ -366,6 +380,20 @@ func (p *ProcessPaymentBody_D_AllOf0_OneOf_0_AnyOf) FromFloat32(val float32) err
 	return err
 }
 
+// MergeFloat32 merges the provided float32 into the union data inside the ProcessPaymentBody_D_AllOf0_OneOf_0_AnyOf
+func (p *ProcessPaymentBody_D_AllOf0_OneOf_0_AnyOf) MergeFloat32(val float32) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(p.union, bts)
+	if err != nil {
+		return err
+	}
+	p.union = merged
+	return nil
+}
+
 // AsString returns the union data inside the ProcessPaymentBody_D_AllOf0_OneOf_0_AnyOf as a string
 func (p *ProcessPaymentBody_D_AllOf0_OneOf_0_AnyOf) AsString() (string, error) {
 	return runtime.UnmarshalAs[string](p.union)
@@ -396,6 +424,20 @@ func (p *ProcessPaymentBody_D_AllOf0_OneOf_0_AnyOf) FromString(val string) error
 	return err
 }
 
+// MergeString merges the provided string into the union data inside the ProcessPaymentBody_D_AllOf0_OneOf_0_AnyOf
+func (p *ProcessPaymentBody_D_AllOf0_OneOf_0_AnyOf) MergeString(val string) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(p.union, bts)
+	if err != nil {
+		return err
+	}
+	p.union = merged
+	return nil
+}
+
 // validateBool validates a bool value
 func (p *ProcessPaymentBody_D_AllOf0_OneOf_0_AnyOf) validateBool(val bool) error {
 	if v, ok := any(val).(runtime.Validator); ok {
@@ -437,4 +479,5 @@ var typesValidator *validator.Validate
 func init() {
 	typesValidator = validator.New(validator.WithRequiredStructEnabled())
 	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
 }
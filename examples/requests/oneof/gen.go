@@ -122,6 +122,20 @@ func (p *ProcessPaymentBody_OneOf) FromPayloadA(val PayloadA) error {
 	return err
 }
 
+// MergePayloadA merges the provided PayloadA into the union data inside the ProcessPaymentBody_OneOf
+func (p *ProcessPaymentBody_OneOf) MergePayloadA(val PayloadA) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(p.union, bts)
+	if err != nil {
+		return err
+	}
+	p.union = merged
+	return nil
+}
+
 // AsPayloadB returns the union data inside the ProcessPaymentBody_OneOf as a PayloadB
 func (p *ProcessPaymentBody_OneOf) AsPayloadB() (PayloadB, error) {
 	return runtime.UnmarshalAs[PayloadB](p.union)
@@ -152,6 +166,20 @@ func (p *ProcessPaymentBody_OneOf) FromPayloadB(val PayloadB) error {
 	return err
 }
 
+// MergePayloadB merges the provided PayloadB into the union data inside the ProcessPaymentBody_OneOf
+func (p *ProcessPaymentBody_OneOf) MergePayloadB(val PayloadB) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(p.union, bts)
+	if err != nil {
+		return err
+	}
+	p.union = merged
+	return nil
+}
+
 // AsPayloadC returns the union data inside the ProcessPaymentBody_OneOf as a PayloadC
 func (p *ProcessPaymentBody_OneOf) AsPayloadC() (PayloadC, error) {
 	return runtime.UnmarshalAs[PayloadC](p.union)
@@ -182,6 +210,20 @@ func (p *ProcessPaymentBody_OneOf) FromPayloadC(val PayloadC) error {
 	return err
 }
 
+// MergePayloadC merges the provided PayloadC into the union data inside the ProcessPaymentBody_OneOf
+func (p *ProcessPaymentBody_OneOf) MergePayloadC(val PayloadC) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(p.union, bts)
+	if err != nil {
+		return err
+	}
+	p.union = merged
+	return nil
+}
+
 // validatePayloadA validates a PayloadA value
 func (p *ProcessPaymentBody_OneOf) validatePayloadA(val PayloadA) error {
 	if v, ok := any(val).(runtime.Validator); ok {
@@ -223,4 +265,5 @@ var typesValidator *validator.Validate
 func init() {
 	typesValidator = validator.New(validator.WithRequiredStructEnabled())
 	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
 }
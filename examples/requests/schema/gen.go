@@ -124,6 +124,20 @@ func (p *Payload_OneOf) FromPayloadA(val PayloadA) error {
 	return err
 }
 
+// MergePayloadA merges the provided PayloadA into the union data inside the Payload_OneOf
+func (p *Payload_OneOf) MergePayloadA(val PayloadA) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(p.union, bts)
+	if err != nil {
+		return err
+	}
+	p.union = merged
+	return nil
+}
+
 // AsPayloadB returns the union data inside the Payload_OneOf as a PayloadB
 func (p *Payload_OneOf) AsPayloadB() (PayloadB, error) {
 	return runtime.UnmarshalAs[PayloadB](p.union)
@@ -154,6 +168,20 @@ func (p *Payload_OneOf) FromPayloadB(val PayloadB) error {
 	return err
 }
 
+// MergePayloadB merges the provided PayloadB into the union data inside the Payload_OneOf
+func (p *Payload_OneOf) MergePayloadB(val PayloadB) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(p.union, bts)
+	if err != nil {
+		return err
+	}
+	p.union = merged
+	return nil
+}
+
 // AsPayloadC returns the union data inside the Payload_OneOf as a PayloadC
 func (p *Payload_OneOf) AsPayloadC() (PayloadC, error) {
 	return runtime.UnmarshalAs[PayloadC](p.union)
@@ -184,6 +212,20 @@ func (p *Payload_OneOf) FromPayloadC(val PayloadC) error {
 	return err
 }
 
+// MergePayloadC merges the provided PayloadC into the union data inside the Payload_OneOf
+func (p *Payload_OneOf) MergePayloadC(val PayloadC) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(p.union, bts)
+	if err != nil {
+		return err
+	}
+	p.union = merged
+	return nil
+}
+
 // validatePayloadA validates a PayloadA value
 func (p *Payload_OneOf) validatePayloadA(val PayloadA) error {
 	if v, ok := any(val).(runtime.Validator); ok {
@@ -225,4 +267,5 @@ var typesValidator *validator.Validate
 func init() {
 	typesValidator = validator.New(validator.WithRequiredStructEnabled())
 	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
 }
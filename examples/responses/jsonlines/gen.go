@@ -0,0 +1,311 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package jsonlines
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"iter"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/go-playground/validator/v10"
+)
+
+// Client is the client for the API implementing the Client interface.
+type Client struct {
+	apiClient runtime.APIClient
+}
+
+// NewClient creates a new instance of the Client client.
+func NewClient(apiClient runtime.APIClient) *Client {
+	return &Client{apiClient: apiClient}
+}
+
+// NewDefaultClient creates a new instance of the Client client with default api client.
+func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithUserAgent("Bulk-API/1.0.0 oapi-codegen-dd/v3.63.4")}, opts...)
+	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API client: %w", err)
+	}
+	return &Client{apiClient: apiClient}, nil
+}
+
+// ClientInterface is the interface for the API client.
+type ClientInterface interface {
+	BulkCreateUsers(ctx context.Context, options *BulkCreateUsersRequestOptions, reqEditors ...runtime.RequestEditorFn) (io.ReadCloser, error)
+	BulkCreateUsersLines(ctx context.Context, options *BulkCreateUsersRequestOptions, reqEditors ...runtime.RequestEditorFn) iter.Seq2[BulkCreateUsersResponse, error]
+}
+
+func (c *Client) BulkCreateUsers(ctx context.Context, options *BulkCreateUsersRequestOptions, reqEditors ...runtime.RequestEditorFn) (io.ReadCloser, error) {
+	var err error
+	if options == nil || options.Body == nil {
+		return nil, fmt.Errorf("error creating request body: %w", runtime.ErrMissingValue)
+	}
+	if options != nil && options.Body != nil {
+		if v, ok := any(options.Body).(runtime.Validator); ok {
+			if err = v.Validate(); err != nil {
+				return nil, fmt.Errorf("error validating request body: %w", err)
+			}
+		}
+	}
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL:  c.apiClient.GetBaseURL() + "/users/bulk",
+		Method:      "POST",
+		ContentType: "application/json",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	resp, err := runtime.ExecuteStreamRequest(ctx, c.apiClient, req, "/users/bulk")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	if resp.StatusCode != 200 {
+		defer func() { _ = resp.Close() }()
+		bodyBytes, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("error reading response body: %w", err)
+		}
+		target := new(BulkCreateUsersErrorResponse)
+		err = json.Unmarshal(bodyBytes, target)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+
+		if errTarget, ok := any(*target).(error); ok {
+			return nil, runtime.NewClientAPIError(errTarget, runtime.WithStatusCode(resp.StatusCode))
+		}
+		return nil, runtime.NewClientAPIError(fmt.Errorf("API error (status %d): %v", resp.StatusCode, *target),
+			runtime.WithStatusCode(resp.StatusCode))
+	}
+	return resp.Body, nil
+}
+
+// BulkCreateUsersLines calls BulkCreateUsers and yields every line of the response decoded as BulkCreateUsersResponse.
+// The response body is closed when the stream ends or the caller stops iterating.
+func (c *Client) BulkCreateUsersLines(ctx context.Context, options *BulkCreateUsersRequestOptions, reqEditors ...runtime.RequestEditorFn) iter.Seq2[BulkCreateUsersResponse, error] {
+	return runtime.JSONLines[BulkCreateUsersResponse](func() (io.ReadCloser, error) {
+		return c.BulkCreateUsers(ctx, options, reqEditors...)
+	}, runtime.ClientJSONCodec(c.apiClient, nil))
+}
+
+var _ ClientInterface = (*Client)(nil)
+
+// BulkCreateUsersRequestOptions is the options needed to make a request to BulkCreateUsers.
+type BulkCreateUsersRequestOptions struct {
+	Body *BulkCreateUsersBody
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *BulkCreateUsersRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *BulkCreateUsersRequestOptions) GetPathParams() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetQuery returns the query params as a map.
+func (o *BulkCreateUsersRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
+func (o *BulkCreateUsersRequestOptions) GetBody() any {
+	if o.Body == nil {
+		return nil
+	}
+	return o.Body
+}
+
+// GetHeader returns the headers as a map.
+func (o *BulkCreateUsersRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+type BulkCreateUsersBody []NewUser
+
+func (b BulkCreateUsersBody) Validate() error {
+	if b == nil {
+		return nil
+	}
+	var errors runtime.ValidationErrors
+	for i, item := range b {
+		if v, ok := any(item).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append(fmt.Sprintf("[%d]", i), err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+type BulkCreateUsersResponse = BulkResult
+
+type BulkCreateUsersErrorResponse = LineError
+
+type BulkResult struct {
+	BulkResult_OneOf *BulkResult_OneOf `json:"-"`
+}
+
+func (b BulkResult) Validate() error {
+	var errors runtime.ValidationErrors
+	if b.BulkResult_OneOf != nil {
+		if v, ok := any(b.BulkResult_OneOf).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("BulkResult_OneOf", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+func (b BulkResult) MarshalJSON() ([]byte, error) {
+	var parts []json.RawMessage
+
+	{
+		b, err := runtime.MarshalJSON(b.BulkResult_OneOf)
+		if err != nil {
+			return nil, fmt.Errorf("BulkResult_OneOf marshal: %w", err)
+		}
+		parts = append(parts, b)
+	}
+
+	return runtime.CoalesceOrMerge(parts...)
+}
+
+func (b *BulkResult) UnmarshalJSON(data []byte) error {
+	trim := bytes.TrimSpace(data)
+	if bytes.Equal(trim, []byte("null")) {
+		return nil
+	}
+	if len(trim) == 0 {
+		return fmt.Errorf("empty JSON input")
+	}
+
+	if b.BulkResult_OneOf == nil {
+		b.BulkResult_OneOf = &BulkResult_OneOf{}
+	}
+
+	if err := runtime.UnmarshalJSON(data, b.BulkResult_OneOf); err != nil {
+		return fmt.Errorf("BulkResult_OneOf unmarshal: %w", err)
+	}
+
+	return nil
+}
+
+type NewUser struct {
+	Name string `json:"name" validate:"required"`
+}
+
+func (n NewUser) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(n))
+}
+
+type User struct {
+	ID   int    `json:"id" validate:"required"`
+	Name string `json:"name" validate:"required"`
+}
+
+func (u User) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(u))
+}
+
+// KnownFields returns the JSON fields of User, which doesn't allow additional properties,
+// so unions only pick it for objects without other fields. See runtime.ClosedObject.
+func (u User) KnownFields() []string {
+	return []string{"id", "name"}
+}
+
+type LineError struct {
+	Code    string `json:"code" validate:"required"`
+	Message string `json:"message" validate:"required"`
+}
+
+func (l LineError) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(l))
+}
+
+func (s LineError) Error() string {
+	return "unmapped client error"
+}
+
+// KnownFields returns the JSON fields of LineError, which doesn't allow additional properties,
+// so unions only pick it for objects without other fields. See runtime.ClosedObject.
+func (l LineError) KnownFields() []string {
+	return []string{"code", "message"}
+}
+
+type BulkResult_OneOf struct {
+	runtime.Either[User, LineError]
+}
+
+func (b *BulkResult_OneOf) Validate() error {
+	if b.IsA() {
+		if v, ok := any(b.A).(runtime.Validator); ok {
+			return v.Validate()
+		}
+	}
+	if b.IsB() {
+		if v, ok := any(b.B).(runtime.Validator); ok {
+			return v.Validate()
+		}
+	}
+	return nil
+}
+
+// UnmarshalJSON holds the variant whose required properties best match the data,
+// or a *runtime.AmbiguousUnionError if several match equally well.
+func (b *BulkResult_OneOf) UnmarshalJSON(data []byte) error {
+	n, err := runtime.MatchRequiredFields(data,
+		runtime.UnionVariant{Name: "User", Required: []string{"id", "name"}, Properties: []string{"id", "name"}},
+		runtime.UnionVariant{Name: "LineError", Required: []string{"code", "message"}, Properties: []string{"code", "message"}},
+	)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return b.Either.UnmarshalJSON(data)
+	}
+	return b.UnmarshalVariant(n, data)
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
+}
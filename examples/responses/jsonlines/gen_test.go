@@ -0,0 +1,92 @@
+package jsonlines_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/examples/responses/jsonlines"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// httpClientAdapter wraps http.Client to implement runtime.HttpRequestDoer
+type httpClientAdapter struct {
+	client *http.Client
+}
+
+func (a *httpClientAdapter) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return a.client.Do(req.WithContext(ctx))
+}
+
+func newClient(t *testing.T, handler http.HandlerFunc) *jsonlines.Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	apiClient, err := runtime.NewAPIClient(server.URL, runtime.WithHTTPClient(&httpClientAdapter{client: server.Client()}))
+	require.NoError(t, err)
+	return jsonlines.NewClient(apiClient)
+}
+
+func TestBulkCreateUsersLines(t *testing.T) {
+	opts := &jsonlines.BulkCreateUsersRequestOptions{
+		Body: &jsonlines.BulkCreateUsersBody{{Name: "alice"}, {Name: "alice"}, {Name: "bob"}},
+	}
+
+	t.Run("yields results and line errors", func(t *testing.T) {
+		client := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/x-ndjson")
+			_, _ = w.Write([]byte(`{"id":1,"name":"alice"}` + "\n"))
+			_, _ = w.Write([]byte(`{"code":"duplicate","message":"alice already exists"}` + "\n"))
+			_, _ = w.Write([]byte(`{"id":2,"name":"bob"}` + "\n"))
+		})
+
+		var users []jsonlines.User
+		var lineErrors []jsonlines.LineError
+		for line, err := range client.BulkCreateUsersLines(context.Background(), opts) {
+			require.NoError(t, err)
+			switch result := line.BulkResult_OneOf; {
+			case result.IsA():
+				users = append(users, result.A)
+			case result.IsB():
+				lineErrors = append(lineErrors, result.B)
+			}
+		}
+
+		assert.Equal(t, []jsonlines.User{{ID: 1, Name: "alice"}, {ID: 2, Name: "bob"}}, users)
+		assert.Equal(t, []jsonlines.LineError{{Code: "duplicate", Message: "alice already exists"}}, lineErrors)
+	})
+
+	t.Run("malformed line", func(t *testing.T) {
+		client := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte("{\"id\":1,\"name\":\"alice\"}\n{\"id\":\n"))
+		})
+
+		var errs []error
+		for _, err := range client.BulkCreateUsersLines(context.Background(), opts) {
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
+
+		require.Len(t, errs, 1)
+		assert.ErrorContains(t, errs[0], "error decoding line 2")
+	})
+
+	t.Run("error response", func(t *testing.T) {
+		client := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"code":"empty","message":"no users"}`))
+		})
+
+		for _, err := range client.BulkCreateUsersLines(context.Background(), opts) {
+			var lineErr jsonlines.LineError
+			require.ErrorAs(t, err, &lineErr)
+			assert.Equal(t, "empty", lineErr.Code)
+		}
+	})
+}
@@ -8,7 +8,11 @@ import (
 )
 
 type LinksSelf struct {
-	Self *string `json:"self,omitempty"`
+	Self *string `json:"self,omitempty" validate:"omitempty,uri"`
+}
+
+func (l LinksSelf) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(l))
 }
 
 type Problem struct {
@@ -109,6 +109,20 @@ func (p *ProcessPayment_Response_OneOf) FromResponseA(val ResponseA) error {
 	return err
 }
 
+// MergeResponseA merges the provided ResponseA into the union data inside the ProcessPayment_Response_OneOf
+func (p *ProcessPayment_Response_OneOf) MergeResponseA(val ResponseA) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(p.union, bts)
+	if err != nil {
+		return err
+	}
+	p.union = merged
+	return nil
+}
+
 // AsResponseB returns the union data inside the ProcessPayment_Response_OneOf as a ResponseB
 func (p *ProcessPayment_Response_OneOf) AsResponseB() (ResponseB, error) {
 	return runtime.UnmarshalAs[ResponseB](p.union)
@@ -139,6 +153,20 @@ func (p *ProcessPayment_Response_OneOf) FromResponseB(val ResponseB) error {
 	return err
 }
 
+// MergeResponseB merges the provided ResponseB into the union data inside the ProcessPayment_Response_OneOf
+func (p *ProcessPayment_Response_OneOf) MergeResponseB(val ResponseB) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(p.union, bts)
+	if err != nil {
+		return err
+	}
+	p.union = merged
+	return nil
+}
+
 // AsResponseC returns the union data inside the ProcessPayment_Response_OneOf as a ResponseC
 func (p *ProcessPayment_Response_OneOf) AsResponseC() (ResponseC, error) {
 	return runtime.UnmarshalAs[ResponseC](p.union)
@@ -169,6 +197,20 @@ func (p *ProcessPayment_Response_OneOf) FromResponseC(val ResponseC) error {
 	return err
 }
 
+// MergeResponseC merges the provided ResponseC into the union data inside the ProcessPayment_Response_OneOf
+func (p *ProcessPayment_Response_OneOf) MergeResponseC(val ResponseC) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(p.union, bts)
+	if err != nil {
+		return err
+	}
+	p.union = merged
+	return nil
+}
+
 // validateResponseA validates a ResponseA value
 func (p *ProcessPayment_Response_OneOf) validateResponseA(val ResponseA) error {
 	if v, ok := any(val).(runtime.Validator); ok {
@@ -210,4 +252,5 @@ var typesValidator *validator.Validate
 func init() {
 	typesValidator = validator.New(validator.WithRequiredStructEnabled())
 	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
 }
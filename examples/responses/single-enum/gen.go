@@ -25,6 +25,68 @@ func (p ProcessPaymentErrorResponseText) Validate() error {
 	}
 }
 
+// processPaymentErrorResponseTextNames maps ProcessPaymentErrorResponseText values to their names.
+var processPaymentErrorResponseTextNames = map[ProcessPaymentErrorResponseText]string{
+	InternalServerError: "InternalServerError",
+}
+
+// processPaymentErrorResponseTextValues maps names to ProcessPaymentErrorResponseText values.
+var processPaymentErrorResponseTextValues = map[string]ProcessPaymentErrorResponseText{
+	"InternalServerError": InternalServerError,
+}
+
+// String returns the wire value of the ProcessPaymentErrorResponseText.
+func (p ProcessPaymentErrorResponseText) String() string {
+	return string(p)
+}
+
+// IsValid reports whether the ProcessPaymentErrorResponseText value is defined in the spec.
+func (p ProcessPaymentErrorResponseText) IsValid() bool {
+	_, ok := processPaymentErrorResponseTextNames[p]
+	return ok
+}
+
+// Values returns all the ProcessPaymentErrorResponseText values defined in the spec.
+func (ProcessPaymentErrorResponseText) Values() []ProcessPaymentErrorResponseText {
+	return []ProcessPaymentErrorResponseText{
+		InternalServerError,
+	}
+}
+
+// ProcessPaymentErrorResponseTextPtr returns a pointer to v, to set the optional and nullable ProcessPaymentErrorResponseText properties.
+func ProcessPaymentErrorResponseTextPtr(v ProcessPaymentErrorResponseText) *ProcessPaymentErrorResponseText {
+	return &v
+}
+
+// Name returns the name of the ProcessPaymentErrorResponseText value, or an empty string for unknown values.
+func (p ProcessPaymentErrorResponseText) Name() string {
+	return processPaymentErrorResponseTextNames[p]
+}
+
+// ParseProcessPaymentErrorResponseText returns the ProcessPaymentErrorResponseText matching s by wire value or by name.
+func ParseProcessPaymentErrorResponseText(s string) (ProcessPaymentErrorResponseText, error) {
+	if _, ok := processPaymentErrorResponseTextNames[ProcessPaymentErrorResponseText(s)]; ok {
+		return ProcessPaymentErrorResponseText(s), nil
+	}
+	if v, ok := processPaymentErrorResponseTextValues[s]; ok {
+		return v, nil
+	}
+	var zero ProcessPaymentErrorResponseText
+	return zero, fmt.Errorf("%w for ProcessPaymentErrorResponseText: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (p ProcessPaymentErrorResponseText) MarshalText() ([]byte, error) {
+	return []byte(p), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseProcessPaymentErrorResponseText.
+// Unknown values are kept as-is and reported by Validate.
+func (p *ProcessPaymentErrorResponseText) UnmarshalText(text []byte) error {
+	*p = ProcessPaymentErrorResponseText(text)
+	return nil
+}
+
 type ProcessPaymentErrorResponse string
 
 const (
@@ -41,6 +103,68 @@ func (p ProcessPaymentErrorResponse) Validate() error {
 	}
 }
 
+// processPaymentErrorResponseNames maps ProcessPaymentErrorResponse values to their names.
+var processPaymentErrorResponseNames = map[ProcessPaymentErrorResponse]string{
+	ProcessPaymentErrorResponseInternalServerError: "InternalServerError",
+}
+
+// processPaymentErrorResponseValues maps names to ProcessPaymentErrorResponse values.
+var processPaymentErrorResponseValues = map[string]ProcessPaymentErrorResponse{
+	"InternalServerError": ProcessPaymentErrorResponseInternalServerError,
+}
+
+// String returns the wire value of the ProcessPaymentErrorResponse.
+func (p ProcessPaymentErrorResponse) String() string {
+	return string(p)
+}
+
+// IsValid reports whether the ProcessPaymentErrorResponse value is defined in the spec.
+func (p ProcessPaymentErrorResponse) IsValid() bool {
+	_, ok := processPaymentErrorResponseNames[p]
+	return ok
+}
+
+// Values returns all the ProcessPaymentErrorResponse values defined in the spec.
+func (ProcessPaymentErrorResponse) Values() []ProcessPaymentErrorResponse {
+	return []ProcessPaymentErrorResponse{
+		ProcessPaymentErrorResponseInternalServerError,
+	}
+}
+
+// ProcessPaymentErrorResponsePtr returns a pointer to v, to set the optional and nullable ProcessPaymentErrorResponse properties.
+func ProcessPaymentErrorResponsePtr(v ProcessPaymentErrorResponse) *ProcessPaymentErrorResponse {
+	return &v
+}
+
+// Name returns the name of the ProcessPaymentErrorResponse value, or an empty string for unknown values.
+func (p ProcessPaymentErrorResponse) Name() string {
+	return processPaymentErrorResponseNames[p]
+}
+
+// ParseProcessPaymentErrorResponse returns the ProcessPaymentErrorResponse matching s by wire value or by name.
+func ParseProcessPaymentErrorResponse(s string) (ProcessPaymentErrorResponse, error) {
+	if _, ok := processPaymentErrorResponseNames[ProcessPaymentErrorResponse(s)]; ok {
+		return ProcessPaymentErrorResponse(s), nil
+	}
+	if v, ok := processPaymentErrorResponseValues[s]; ok {
+		return v, nil
+	}
+	var zero ProcessPaymentErrorResponse
+	return zero, fmt.Errorf("%w for ProcessPaymentErrorResponse: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (p ProcessPaymentErrorResponse) MarshalText() ([]byte, error) {
+	return []byte(p), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseProcessPaymentErrorResponse.
+// Unknown values are kept as-is and reported by Validate.
+func (p *ProcessPaymentErrorResponse) UnmarshalText(text []byte) error {
+	*p = ProcessPaymentErrorResponse(text)
+	return nil
+}
+
 type ProcessPaymentBody = map[string]any
 
 var typesValidator *validator.Validate
@@ -48,4 +172,5 @@ var typesValidator *validator.Validate
 func init() {
 	typesValidator = validator.New(validator.WithRequiredStructEnabled())
 	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
 }
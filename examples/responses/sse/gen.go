@@ -0,0 +1,268 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package sse
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/go-playground/validator/v10"
+)
+
+// Client is the client for the API implementing the Client interface.
+type Client struct {
+	apiClient runtime.APIClient
+}
+
+// NewClient creates a new instance of the Client client.
+func NewClient(apiClient runtime.APIClient) *Client {
+	return &Client{apiClient: apiClient}
+}
+
+// NewDefaultClient creates a new instance of the Client client with default api client.
+func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithUserAgent("Chat-API/1.0.0 oapi-codegen-dd/v3.63.4")}, opts...)
+	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API client: %w", err)
+	}
+	return &Client{apiClient: apiClient}, nil
+}
+
+// ClientInterface is the interface for the API client.
+type ClientInterface interface {
+	StreamMessages(ctx context.Context, options *StreamMessagesRequestOptions, reqEditors ...runtime.RequestEditorFn) (io.ReadCloser, error)
+	StreamMessagesEvents(ctx context.Context, options *StreamMessagesRequestOptions, reqEditors ...runtime.RequestEditorFn) iter.Seq2[StreamMessagesResponse, error]
+}
+
+func (c *Client) StreamMessages(ctx context.Context, options *StreamMessagesRequestOptions, reqEditors ...runtime.RequestEditorFn) (io.ReadCloser, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/chats/{id}/messages",
+		Method:     "GET",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	resp, err := runtime.ExecuteStreamRequest(ctx, c.apiClient, req, "/chats/{id}/messages")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	if resp.StatusCode != 200 {
+		defer func() { _ = resp.Close() }()
+		bodyBytes, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("error reading response body: %w", err)
+		}
+		target := new(StreamMessagesErrorResponse)
+		err = json.Unmarshal(bodyBytes, target)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+
+		if errTarget, ok := any(*target).(error); ok {
+			return nil, runtime.NewClientAPIError(errTarget, runtime.WithStatusCode(resp.StatusCode))
+		}
+		return nil, runtime.NewClientAPIError(fmt.Errorf("API error (status %d): %v", resp.StatusCode, *target),
+			runtime.WithStatusCode(resp.StatusCode))
+	}
+	return resp.Body, nil
+}
+
+// StreamMessagesEvents calls StreamMessages and yields every server-sent event decoded as StreamMessagesResponse.
+// The response body is closed when the stream ends or the caller stops iterating.
+func (c *Client) StreamMessagesEvents(ctx context.Context, options *StreamMessagesRequestOptions, reqEditors ...runtime.RequestEditorFn) iter.Seq2[StreamMessagesResponse, error] {
+	return runtime.SSEEvents[StreamMessagesResponse](func() (io.ReadCloser, error) {
+		return c.StreamMessages(ctx, options, reqEditors...)
+	}, runtime.ClientJSONCodec(c.apiClient, nil))
+}
+
+var _ ClientInterface = (*Client)(nil)
+
+// StreamMessagesRequestOptions is the options needed to make a request to StreamMessages.
+type StreamMessagesRequestOptions struct {
+	PathParams *StreamMessagesPath
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *StreamMessagesRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *StreamMessagesRequestOptions) GetPathParams() (map[string]any, error) {
+	return runtime.AsMap[any](o.PathParams)
+}
+
+// GetQuery returns the query params as a map.
+func (o *StreamMessagesRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
+func (o *StreamMessagesRequestOptions) GetBody() any {
+	return nil
+}
+
+// GetHeader returns the headers as a map.
+func (o *StreamMessagesRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+type StreamMessagesPath struct {
+	ID string `json:"id" validate:"required"`
+}
+
+func (s StreamMessagesPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(s))
+}
+
+type StreamMessagesResponse = Message
+
+type StreamMessagesErrorResponse = Error
+
+// StreamMessagesRequest is a request to StreamMessages, read from an *http.Request with BindStreamMessagesRequest.
+type StreamMessagesRequest struct {
+	PathParams *StreamMessagesPath
+}
+
+// Validate validates all the fields of the request.
+func (o *StreamMessagesRequest) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// BindStreamMessagesRequest reads the request to GET /chats/{id}/messages and validates it.
+// Path parameters are read with r.PathValue, set by the router matching the request.
+// Parameters and bodies that can't be read are returned as a *runtime.BindError,
+// and invalid requests as runtime.ValidationErrors.
+func BindStreamMessagesRequest(r *http.Request) (*StreamMessagesRequest, error) {
+	req := &StreamMessagesRequest{}
+
+	req.PathParams = &StreamMessagesPath{}
+	if err := runtime.BindPathParams(r, req.PathParams, map[string]runtime.ParameterBinding{
+		"id": {Required: true},
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// StreamMessagesHandler returns the http.HandlerFunc of GET /chats/{id}/messages, calling handle with the request
+// read by BindStreamMessagesRequest. Requests failing to bind are passed to onError instead,
+// runtime.DefaultBindErrorHandler if nil.
+func StreamMessagesHandler(handle func(w http.ResponseWriter, r *http.Request, req *StreamMessagesRequest), onError runtime.BindErrorHandler) http.HandlerFunc {
+	if onError == nil {
+		onError = runtime.DefaultBindErrorHandler
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		req, err := BindStreamMessagesRequest(r)
+		if err != nil {
+			onError(w, r, err)
+			return
+		}
+		handle(w, r, req)
+	}
+}
+
+// NewStreamMessagesEventWriter writes the text/event-stream response of StreamMessages to w, one StreamMessagesResponse per event.
+// Close must be called before the handler returns.
+func NewStreamMessagesEventWriter(w http.ResponseWriter, opts ...runtime.EventWriterOption) *runtime.EventWriter[StreamMessagesResponse] {
+	return runtime.NewEventWriter[StreamMessagesResponse](w, opts...)
+}
+
+// OperationValidators validates the requests of the operations with their Bind functions, and the JSON bodies
+// of their responses, by http.ServeMux pattern. Use it with runtime.ValidationMiddleware.
+var OperationValidators = map[string]runtime.OperationValidator{
+	"GET /chats/{id}/messages": {
+		Request: func(r *http.Request) error {
+			_, err := BindStreamMessagesRequest(r)
+			return err
+		},
+		Response: func(status int, body []byte) error {
+			var res any
+			switch {
+			case status == 404:
+				res = new(StreamMessagesErrorResponse)
+			default:
+				return nil
+			}
+			if err := json.Unmarshal(body, res); err != nil {
+				return err
+			}
+			if v, ok := res.(runtime.Validator); ok {
+				return v.Validate()
+			}
+			return nil
+		},
+	},
+}
+
+type Message struct {
+	Author string `json:"author" validate:"required"`
+	Text   string `json:"text" validate:"required"`
+}
+
+func (m Message) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(m))
+}
+
+type Error struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (s Error) Error() string {
+	return "unmapped client error"
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
+}
@@ -0,0 +1,97 @@
+package sse_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/examples/responses/sse"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// httpClientAdapter wraps http.Client to implement runtime.HttpRequestDoer
+type httpClientAdapter struct {
+	client *http.Client
+}
+
+func (a *httpClientAdapter) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return a.client.Do(req.WithContext(ctx))
+}
+
+func newClient(t *testing.T, handler http.HandlerFunc) *sse.Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	apiClient, err := runtime.NewAPIClient(server.URL, runtime.WithHTTPClient(&httpClientAdapter{client: server.Client()}))
+	require.NoError(t, err)
+	return sse.NewClient(apiClient)
+}
+
+func TestStreamMessagesEvents(t *testing.T) {
+	opts := &sse.StreamMessagesRequestOptions{PathParams: &sse.StreamMessagesPath{ID: "1"}}
+
+	t.Run("yields decoded events", func(t *testing.T) {
+		client := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/event-stream")
+			_, _ = w.Write([]byte(": connected\n\n"))
+			_, _ = w.Write([]byte("event: message\ndata: {\"author\":\"alice\",\"text\":\"hi\"}\n\n"))
+			_, _ = w.Write([]byte("event: message\ndata: {\"author\":\"bob\",\"text\":\"hello\"}\n\n"))
+		})
+
+		var messages []sse.StreamMessagesResponse
+		for msg, err := range client.StreamMessagesEvents(context.Background(), opts) {
+			require.NoError(t, err)
+			messages = append(messages, msg)
+		}
+
+		require.Len(t, messages, 2)
+		assert.Equal(t, "alice", messages[0].Author)
+		assert.Equal(t, "hello", messages[1].Text)
+	})
+
+	t.Run("reads events sent with the generated EventWriter", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.Handle("GET /chats/{id}/messages", sse.StreamMessagesHandler(func(w http.ResponseWriter, r *http.Request, req *sse.StreamMessagesRequest) {
+			events := sse.NewStreamMessagesEventWriter(w, runtime.WithKeepAlive(time.Second))
+			defer func() { _ = events.Close() }()
+
+			_ = events.Send(sse.StreamMessagesResponse{Author: req.PathParams.ID, Text: "hi"})
+			_ = events.SendEvent(runtime.SSEEvent{ID: "2", Event: "message"}, sse.StreamMessagesResponse{Author: "bob", Text: "hello"})
+		}, nil))
+		client := newClient(t, mux.ServeHTTP)
+
+		var messages []sse.StreamMessagesResponse
+		for msg, err := range client.StreamMessagesEvents(context.Background(), opts) {
+			require.NoError(t, err)
+			messages = append(messages, msg)
+		}
+
+		require.Len(t, messages, 2)
+		assert.Equal(t, "1", messages[0].Author)
+		assert.Equal(t, "hi", messages[0].Text)
+		assert.Equal(t, "bob", messages[1].Author)
+	})
+
+	t.Run("yields the error response", func(t *testing.T) {
+		client := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"no such chat"}`))
+		})
+
+		var errs []error
+		for _, err := range client.StreamMessagesEvents(context.Background(), opts) {
+			errs = append(errs, err)
+		}
+
+		require.Len(t, errs, 1)
+		var apiErr *runtime.ClientAPIError
+		require.ErrorAs(t, errs[0], &apiErr)
+		assert.Equal(t, http.StatusNotFound, apiErr.StatusCode())
+	})
+}
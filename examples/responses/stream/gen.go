@@ -0,0 +1,263 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/go-playground/validator/v10"
+)
+
+// Client is the client for the API implementing the Client interface.
+type Client struct {
+	apiClient runtime.APIClient
+}
+
+// NewClient creates a new instance of the Client client.
+func NewClient(apiClient runtime.APIClient) *Client {
+	return &Client{apiClient: apiClient}
+}
+
+// NewDefaultClient creates a new instance of the Client client with default api client.
+func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithUserAgent("Files-API/1.0.0 oapi-codegen-dd/v3.63.4")}, opts...)
+	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API client: %w", err)
+	}
+	return &Client{apiClient: apiClient}, nil
+}
+
+// ClientInterface is the interface for the API client.
+type ClientInterface interface {
+	DownloadFile(ctx context.Context, options *DownloadFileRequestOptions, reqEditors ...runtime.RequestEditorFn) (io.ReadCloser, error)
+	DownloadFileResume(ctx context.Context, options *DownloadFileRequestOptions, dst io.WriterAt, offset int64, reqEditors ...runtime.RequestEditorFn) (int64, error)
+
+	DownloadExport(ctx context.Context, options *DownloadExportRequestOptions, reqEditors ...runtime.RequestEditorFn) (io.ReadCloser, error)
+	DownloadExportResume(ctx context.Context, options *DownloadExportRequestOptions, dst io.WriterAt, offset int64, reqEditors ...runtime.RequestEditorFn) (int64, error)
+}
+
+func (c *Client) DownloadFile(ctx context.Context, options *DownloadFileRequestOptions, reqEditors ...runtime.RequestEditorFn) (io.ReadCloser, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/files/{id}",
+		Method:     "GET",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	resp, err := runtime.ExecuteStreamRequest(ctx, c.apiClient, req, "/files/{id}")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	if resp.StatusCode == 416 {
+		_ = resp.Close()
+		return nil, runtime.NewClientAPIError(fmt.Errorf("range not satisfiable"), runtime.WithStatusCode(resp.StatusCode))
+	}
+	if resp.StatusCode != 200 && resp.StatusCode != 206 {
+		defer func() { _ = resp.Close() }()
+		bodyBytes, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("error reading response body: %w", err)
+		}
+		target := new(DownloadFileErrorResponse)
+		err = json.Unmarshal(bodyBytes, target)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrDecodeResponse, err)
+		}
+
+		if errTarget, ok := any(*target).(error); ok {
+			return nil, runtime.NewClientAPIError(errTarget, runtime.WithStatusCode(resp.StatusCode))
+		}
+		return nil, runtime.NewClientAPIError(fmt.Errorf("API error (status %d): %v", resp.StatusCode, *target),
+			runtime.WithStatusCode(resp.StatusCode))
+	}
+	return resp.Body, nil
+}
+
+// DownloadFileResume downloads DownloadFile into dst from offset, requesting the remaining bytes with a Range header.
+// It returns the offset to resume from, so an interrupted download can be continued with another call.
+func (c *Client) DownloadFileResume(ctx context.Context, options *DownloadFileRequestOptions, dst io.WriterAt, offset int64, reqEditors ...runtime.RequestEditorFn) (int64, error) {
+	return runtime.ResumeDownload(dst, offset, func(rangeEditor runtime.RequestEditorFn) (io.ReadCloser, error) {
+		return c.DownloadFile(ctx, options, append([]runtime.RequestEditorFn{rangeEditor}, reqEditors...)...)
+	})
+}
+
+func (c *Client) DownloadExport(ctx context.Context, options *DownloadExportRequestOptions, reqEditors ...runtime.RequestEditorFn) (io.ReadCloser, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/exports/{id}",
+		Method:     "GET",
+	}
+	if options != nil {
+		reqParams.Options = options
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", runtime.ErrEncodeRequest, err)
+	}
+
+	resp, err := runtime.ExecuteStreamRequest(ctx, c.apiClient, req, "/exports/{id}")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	if resp.StatusCode == 416 {
+		_ = resp.Close()
+		return nil, runtime.NewClientAPIError(fmt.Errorf("range not satisfiable"), runtime.WithStatusCode(resp.StatusCode))
+	}
+	if resp.StatusCode != 200 && resp.StatusCode != 206 {
+		defer func() { _ = resp.Close() }()
+		return nil, runtime.NewClientAPIError(fmt.Errorf("%w: %d", runtime.ErrUnexpectedStatus, resp.StatusCode),
+			runtime.WithStatusCode(resp.StatusCode))
+	}
+	return resp.Body, nil
+}
+
+// DownloadExportResume downloads DownloadExport into dst from offset, requesting the remaining bytes with a Range header.
+// It returns the offset to resume from, so an interrupted download can be continued with another call.
+func (c *Client) DownloadExportResume(ctx context.Context, options *DownloadExportRequestOptions, dst io.WriterAt, offset int64, reqEditors ...runtime.RequestEditorFn) (int64, error) {
+	return runtime.ResumeDownload(dst, offset, func(rangeEditor runtime.RequestEditorFn) (io.ReadCloser, error) {
+		return c.DownloadExport(ctx, options, append([]runtime.RequestEditorFn{rangeEditor}, reqEditors...)...)
+	})
+}
+
+var _ ClientInterface = (*Client)(nil)
+
+// DownloadFileRequestOptions is the options needed to make a request to DownloadFile.
+type DownloadFileRequestOptions struct {
+	PathParams *DownloadFilePath
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *DownloadFileRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *DownloadFileRequestOptions) GetPathParams() (map[string]any, error) {
+	return runtime.AsMap[any](o.PathParams)
+}
+
+// GetQuery returns the query params as a map.
+func (o *DownloadFileRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
+func (o *DownloadFileRequestOptions) GetBody() any {
+	return nil
+}
+
+// GetHeader returns the headers as a map.
+func (o *DownloadFileRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+// DownloadExportRequestOptions is the options needed to make a request to DownloadExport.
+type DownloadExportRequestOptions struct {
+	PathParams *DownloadExportPath
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *DownloadExportRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *DownloadExportRequestOptions) GetPathParams() (map[string]any, error) {
+	return runtime.AsMap[any](o.PathParams)
+}
+
+// GetQuery returns the query params as a map.
+func (o *DownloadExportRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client,
+// nil to send no body.
+func (o *DownloadExportRequestOptions) GetBody() any {
+	return nil
+}
+
+// GetHeader returns the headers as a map.
+func (o *DownloadExportRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+type DownloadFilePath struct {
+	ID string `json:"id" validate:"required"`
+}
+
+func (d DownloadFilePath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(d))
+}
+
+type DownloadExportPath struct {
+	ID string `json:"id" validate:"required"`
+}
+
+func (d DownloadExportPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(d))
+}
+
+type DownloadFileResponse = runtime.File
+
+type DownloadFileErrorResponse = Error
+
+type DownloadExportResponse = string
+
+type Error struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (s Error) Error() string {
+	return "unmapped client error"
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
+}
@@ -0,0 +1,123 @@
+package stream_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/examples/responses/stream"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// httpClientAdapter wraps http.Client to implement runtime.HttpRequestDoer
+type httpClientAdapter struct {
+	client *http.Client
+}
+
+func (a *httpClientAdapter) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return a.client.Do(req.WithContext(ctx))
+}
+
+func newClient(t *testing.T, handler http.HandlerFunc) *stream.Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	apiClient, err := runtime.NewAPIClient(server.URL, runtime.WithHTTPClient(&httpClientAdapter{client: server.Client()}))
+	require.NoError(t, err)
+	return stream.NewClient(apiClient)
+}
+
+func TestDownloadFile(t *testing.T) {
+	opts := &stream.DownloadFileRequestOptions{PathParams: &stream.DownloadFilePath{ID: "1"}}
+
+	t.Run("returns the unread body", func(t *testing.T) {
+		client := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/octet-stream")
+			_, _ = w.Write([]byte{0x00, 0x01, 0x02})
+		})
+
+		body, err := client.DownloadFile(context.Background(), opts)
+		require.NoError(t, err)
+		defer func() { _ = body.Close() }()
+
+		content, err := io.ReadAll(body)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{0x00, 0x01, 0x02}, content)
+	})
+
+	t.Run("decodes the error response", func(t *testing.T) {
+		client := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"no such file"}`))
+		})
+
+		body, err := client.DownloadFile(context.Background(), opts)
+		assert.Nil(t, body)
+
+		var apiErr *runtime.ClientAPIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusNotFound, apiErr.StatusCode())
+	})
+}
+
+func TestDownloadExport(t *testing.T) {
+	client := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/csv")
+		_, _ = w.Write([]byte("id,name\n1,foo\n"))
+	})
+
+	body, err := client.DownloadExport(context.Background(), &stream.DownloadExportRequestOptions{
+		PathParams: &stream.DownloadExportPath{ID: "1"},
+	})
+	require.NoError(t, err)
+	defer func() { _ = body.Close() }()
+
+	content, err := io.ReadAll(body)
+	require.NoError(t, err)
+	assert.Equal(t, "id,name\n1,foo\n", string(content))
+}
+
+func TestDownloadFileResume(t *testing.T) {
+	const content = "0123456789abcdefghij"
+	opts := &stream.DownloadFileRequestOptions{PathParams: &stream.DownloadFilePath{ID: "1"}}
+
+	var ranges []string
+	client := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+		ranges = append(ranges, r.Header.Get("Range"))
+		w.Header().Set("Content-Type", "application/octet-stream")
+		http.ServeContent(w, r, "file.bin", time.Time{}, strings.NewReader(content))
+	})
+
+	dst, err := os.Create(filepath.Join(t.TempDir(), "file.bin"))
+	require.NoError(t, err)
+	defer func() { _ = dst.Close() }()
+
+	// A previous attempt stopped after the first 8 bytes.
+	_, err = dst.WriteAt([]byte(content[:8]), 0)
+	require.NoError(t, err)
+
+	next, err := client.DownloadFileResume(context.Background(), opts, dst, 8)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(content)), next)
+
+	// Nothing is left to download.
+	next, err = client.DownloadFileResume(context.Background(), opts, dst, next)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(content)), next)
+
+	assert.Equal(t, []string{"bytes=8-", "bytes=20-"}, ranges)
+
+	written, err := os.ReadFile(dst.Name())
+	require.NoError(t, err)
+	assert.Equal(t, content, string(written))
+}
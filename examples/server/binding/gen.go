@@ -0,0 +1,309 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package binding
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/go-playground/validator/v10"
+)
+
+type UpdatePetCookies struct {
+	Session *string `json:"session,omitempty"`
+}
+
+type PetKind string
+
+const (
+	Cat PetKind = "cat"
+	Dog PetKind = "dog"
+)
+
+// Validate checks if the PetKind value is valid
+func (p PetKind) Validate() error {
+	switch p {
+	case Cat, Dog:
+		return nil
+	default:
+		return runtime.NewValidationErrorsFromString("Enum", fmt.Sprintf("must be a valid PetKind value, got: %v", p))
+	}
+}
+
+// petKindNames maps PetKind values to their names.
+var petKindNames = map[PetKind]string{
+	Cat: "Cat",
+	Dog: "Dog",
+}
+
+// petKindValues maps names to PetKind values.
+var petKindValues = map[string]PetKind{
+	"Cat": Cat,
+	"Dog": Dog,
+}
+
+// String returns the wire value of the PetKind.
+func (p PetKind) String() string {
+	return string(p)
+}
+
+// IsValid reports whether the PetKind value is defined in the spec.
+func (p PetKind) IsValid() bool {
+	_, ok := petKindNames[p]
+	return ok
+}
+
+// Values returns all the PetKind values defined in the spec.
+func (PetKind) Values() []PetKind {
+	return []PetKind{
+		Cat,
+		Dog,
+	}
+}
+
+// PetKindPtr returns a pointer to v, to set the optional and nullable PetKind properties.
+func PetKindPtr(v PetKind) *PetKind {
+	return &v
+}
+
+// Name returns the name of the PetKind value, or an empty string for unknown values.
+func (p PetKind) Name() string {
+	return petKindNames[p]
+}
+
+// ParsePetKind returns the PetKind matching s by wire value or by name.
+func ParsePetKind(s string) (PetKind, error) {
+	if _, ok := petKindNames[PetKind(s)]; ok {
+		return PetKind(s), nil
+	}
+	if v, ok := petKindValues[s]; ok {
+		return v, nil
+	}
+	var zero PetKind
+	return zero, fmt.Errorf("%w for PetKind: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (p PetKind) MarshalText() ([]byte, error) {
+	return []byte(p), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParsePetKind.
+// Unknown values are kept as-is and reported by Validate.
+func (p *PetKind) UnmarshalText(text []byte) error {
+	*p = PetKind(text)
+	return nil
+}
+
+type UpdatePetHeaders struct {
+	XRequestID string `json:"X-Request-Id" validate:"required"`
+}
+
+func (u UpdatePetHeaders) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(u))
+}
+
+type UpdatePetPath struct {
+	PetID int64 `json:"petId" validate:"required,gte=1"`
+}
+
+func (u UpdatePetPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(u))
+}
+
+type UpdatePetBody = Pet
+
+type UpdatePetQuery struct {
+	Tags []string `json:"tags,omitempty"`
+}
+
+type UpdatePetResponse = Pet
+
+// UpdatePetRequest is a request to UpdatePet, read from an *http.Request with BindUpdatePetRequest.
+type UpdatePetRequest struct {
+	PathParams *UpdatePetPath
+	Query      *UpdatePetQuery
+	Body       *UpdatePetBody
+	Header     *UpdatePetHeaders
+	Cookies    *UpdatePetCookies
+}
+
+// Validate validates all the fields of the request.
+func (o *UpdatePetRequest) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+
+	if o.Query != nil {
+		if v, ok := any(o.Query).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Query", err)
+			}
+		}
+	}
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+
+	if o.Header != nil {
+		if v, ok := any(o.Header).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Header", err)
+			}
+		}
+	}
+
+	if o.Cookies != nil {
+		if v, ok := any(o.Cookies).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Cookies", err)
+			}
+		}
+	}
+
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// BindUpdatePetRequest reads the request to PUT /pets/{petId} and validates it.
+// Path parameters are read with r.PathValue, set by the router matching the request.
+// Parameters and bodies that can't be read are returned as a *runtime.BindError,
+// and invalid requests as runtime.ValidationErrors.
+func BindUpdatePetRequest(r *http.Request) (*UpdatePetRequest, error) {
+	req := &UpdatePetRequest{}
+
+	req.PathParams = &UpdatePetPath{}
+	if err := runtime.BindPathParams(r, req.PathParams, map[string]runtime.ParameterBinding{
+		"petId": {Required: true},
+	}); err != nil {
+		return nil, err
+	}
+
+	req.Query = &UpdatePetQuery{}
+	if err := runtime.BindQuery(r, req.Query, map[string]runtime.ParameterBinding{
+		"tags": {Style: "form", Explode: runtime.Ptr(false)},
+	}); err != nil {
+		return nil, err
+	}
+
+	req.Header = &UpdatePetHeaders{}
+	if err := runtime.BindHeader(r, req.Header, map[string]runtime.ParameterBinding{
+		"X-Request-Id": {Required: true},
+	}); err != nil {
+		return nil, err
+	}
+
+	req.Cookies = &UpdatePetCookies{}
+	if err := runtime.BindCookies(r, req.Cookies, nil); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, &runtime.BindError{In: "body", Err: err}
+	}
+	if !runtime.IsEmptyBody(body) {
+		req.Body = &UpdatePetBody{}
+		if err = json.Unmarshal(body, req.Body); err != nil {
+			return nil, &runtime.BindError{In: "body", Err: err}
+		}
+	} else {
+		return nil, &runtime.BindError{In: "body", Err: runtime.ErrMissingValue}
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// UpdatePetHandler returns the http.HandlerFunc of PUT /pets/{petId}, calling handle with the request
+// read by BindUpdatePetRequest. Requests failing to bind are passed to onError instead,
+// runtime.DefaultBindErrorHandler if nil.
+func UpdatePetHandler(handle func(w http.ResponseWriter, r *http.Request, req *UpdatePetRequest), onError runtime.BindErrorHandler) http.HandlerFunc {
+	if onError == nil {
+		onError = runtime.DefaultBindErrorHandler
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		req, err := BindUpdatePetRequest(r)
+		if err != nil {
+			onError(w, r, err)
+			return
+		}
+		handle(w, r, req)
+	}
+}
+
+// OperationValidators validates the requests of the operations with their Bind functions, and the JSON bodies
+// of their responses, by http.ServeMux pattern. Use it with runtime.ValidationMiddleware.
+var OperationValidators = map[string]runtime.OperationValidator{
+	"PUT /pets/{petId}": {
+		Request: func(r *http.Request) error {
+			_, err := BindUpdatePetRequest(r)
+			return err
+		},
+		Response: func(status int, body []byte) error {
+			var res any
+			switch {
+			case status == 200:
+				res = new(UpdatePetResponse)
+			default:
+				return nil
+			}
+			if err := json.Unmarshal(body, res); err != nil {
+				return err
+			}
+			if v, ok := res.(runtime.Validator); ok {
+				return v.Validate()
+			}
+			return nil
+		},
+	},
+}
+
+type Pet struct {
+	Name string  `json:"name" validate:"required,min=1"`
+	Kind PetKind `json:"kind" validate:"required"`
+}
+
+func (p Pet) Validate() error {
+	var errors runtime.ValidationErrors
+	if err := typesValidator.Var(p.Name, "required,min=1"); err != nil {
+		errors = errors.Append("Name", err)
+	}
+	if v, ok := any(p.Kind).(runtime.Validator); ok {
+		if err := v.Validate(); err != nil {
+			errors = errors.Append("Kind", err)
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
+}
@@ -0,0 +1,106 @@
+package binding
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newServer(onError runtime.BindErrorHandler) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("PUT /pets/{petId}", UpdatePetHandler(func(w http.ResponseWriter, r *http.Request, req *UpdatePetRequest) {
+		w.Header().Set("X-Pet-Id", r.PathValue("petId"))
+		w.Header().Set("X-Tags", strings.Join(req.Query.Tags, "|"))
+		w.Header().Set("X-Session", *req.Cookies.Session)
+		_ = json.NewEncoder(w).Encode(req.Body)
+	}, onError))
+	return mux
+}
+
+func TestUpdatePetHandler(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPut, "/pets/42?tags=a,b", strings.NewReader(`{"name":"Rex","kind":"dog"}`))
+	r.Header.Set("X-Request-Id", "req-1")
+	r.AddCookie(&http.Cookie{Name: "session", Value: "s1"})
+	w := httptest.NewRecorder()
+
+	newServer(nil).ServeHTTP(w, r)
+
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Equal(t, "42", w.Header().Get("X-Pet-Id"))
+	assert.Equal(t, "a|b", w.Header().Get("X-Tags"))
+	assert.Equal(t, "s1", w.Header().Get("X-Session"))
+	assert.JSONEq(t, `{"name":"Rex","kind":"dog"}`, w.Body.String())
+}
+
+func TestBindUpdatePetRequest(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPut, "/pets/7", strings.NewReader(`{"name":"Tom","kind":"cat"}`))
+	r.SetPathValue("petId", "7")
+	r.Header.Set("X-Request-Id", "req-2")
+
+	req, err := BindUpdatePetRequest(r)
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), req.PathParams.PetID)
+	assert.Equal(t, "req-2", req.Header.XRequestID)
+	assert.Nil(t, req.Cookies.Session)
+	assert.Equal(t, Cat, req.Body.Kind)
+}
+
+func TestUpdatePetHandler_errors(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		body string
+		// header sets X-Request-Id
+		header bool
+		want   string
+	}{
+		{name: "invalid path parameter", path: "/pets/abc", body: `{"name":"Rex","kind":"dog"}`, header: true, want: `invalid path parameter "petId"`},
+		{name: "missing header", path: "/pets/1", body: `{"name":"Rex","kind":"dog"}`, want: `invalid header parameter "X-Request-Id": missing required value`},
+		{name: "missing body", path: "/pets/1", header: true, want: "invalid body: missing required value"},
+		{name: "malformed body", path: "/pets/1", body: `{"name":`, header: true, want: "invalid body: unexpected end of JSON input"},
+		{name: "unknown enum value", path: "/pets/1", body: `{"name":"Rex","kind":"bird"}`, header: true, want: "Body.Kind.Enum must be a valid PetKind value, got: bird"},
+		{name: "invalid body", path: "/pets/1", body: `{"name":"","kind":"dog"}`, header: true, want: "Body.Name"},
+		{name: "invalid path value", path: "/pets/0", body: `{"name":"Rex","kind":"dog"}`, header: true, want: "PathParams.PetID"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPut, tc.path, strings.NewReader(tc.body))
+			if tc.header {
+				r.Header.Set("X-Request-Id", "req-1")
+			}
+			w := httptest.NewRecorder()
+
+			newServer(nil).ServeHTTP(w, r)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			assert.Contains(t, w.Body.String(), tc.want)
+		})
+	}
+
+	t.Run("custom error handler", func(t *testing.T) {
+		onError := func(w http.ResponseWriter, r *http.Request, err error) {
+			var validationErrs runtime.ValidationErrors
+			if errors.As(err, &validationErrs) {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				return
+			}
+			runtime.DefaultBindErrorHandler(w, r, err)
+		}
+
+		r := httptest.NewRequest(http.MethodPut, "/pets/1", strings.NewReader(`{"name":"","kind":"dog"}`))
+		r.Header.Set("X-Request-Id", "req-1")
+		w := httptest.NewRecorder()
+
+		newServer(onError).ServeHTTP(w, r)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+}
@@ -0,0 +1,395 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package router
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/go-playground/validator/v10"
+)
+
+type DeletePetPath struct {
+	PetID int64 `json:"petId" sensitive:"" validate:"required"`
+}
+
+func (d DeletePetPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(d))
+}
+
+type ListPetsQuery struct {
+	Limit *int `json:"limit,omitempty" validate:"omitempty,gte=1"`
+}
+
+func (l ListPetsQuery) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(l))
+}
+
+type ListPetsResponse []Pet
+
+// ListPetsRequest is a request to ListPets, read from an *http.Request with BindListPetsRequest.
+type ListPetsRequest struct {
+	Query *ListPetsQuery
+}
+
+// Validate validates all the fields of the request.
+func (o *ListPetsRequest) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Query != nil {
+		if v, ok := any(o.Query).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Query", err)
+			}
+		}
+	}
+
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// BindListPetsRequest reads the request to GET /pets and validates it.
+// Path parameters are read with r.PathValue, set by the router matching the request.
+// Parameters and bodies that can't be read are returned as a *runtime.BindError,
+// and invalid requests as runtime.ValidationErrors.
+func BindListPetsRequest(r *http.Request) (*ListPetsRequest, error) {
+	req := &ListPetsRequest{}
+
+	req.Query = &ListPetsQuery{}
+	if err := runtime.BindQuery(r, req.Query, nil); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// ListPetsHandler returns the http.HandlerFunc of GET /pets, calling handle with the request
+// read by BindListPetsRequest. Requests failing to bind are passed to onError instead,
+// runtime.DefaultBindErrorHandler if nil.
+func ListPetsHandler(handle func(w http.ResponseWriter, r *http.Request, req *ListPetsRequest), onError runtime.BindErrorHandler) http.HandlerFunc {
+	if onError == nil {
+		onError = runtime.DefaultBindErrorHandler
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		req, err := BindListPetsRequest(r)
+		if err != nil {
+			onError(w, r, err)
+			return
+		}
+		handle(w, r, req)
+	}
+}
+
+// DeletePetRequest is a request to DeletePet, read from an *http.Request with BindDeletePetRequest.
+type DeletePetRequest struct {
+	PathParams *DeletePetPath
+}
+
+// Validate validates all the fields of the request.
+func (o *DeletePetRequest) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// BindDeletePetRequest reads the request to DELETE /pets/{petId} and validates it.
+// Path parameters are read with r.PathValue, set by the router matching the request.
+// Parameters and bodies that can't be read are returned as a *runtime.BindError,
+// and invalid requests as runtime.ValidationErrors.
+func BindDeletePetRequest(r *http.Request) (*DeletePetRequest, error) {
+	req := &DeletePetRequest{}
+
+	req.PathParams = &DeletePetPath{}
+	if err := runtime.BindPathParams(r, req.PathParams, map[string]runtime.ParameterBinding{
+		"petId": {Required: true},
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// DeletePetHandler returns the http.HandlerFunc of DELETE /pets/{petId}, calling handle with the request
+// read by BindDeletePetRequest. Requests failing to bind are passed to onError instead,
+// runtime.DefaultBindErrorHandler if nil.
+func DeletePetHandler(handle func(w http.ResponseWriter, r *http.Request, req *DeletePetRequest), onError runtime.BindErrorHandler) http.HandlerFunc {
+	if onError == nil {
+		onError = runtime.DefaultBindErrorHandler
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		req, err := BindDeletePetRequest(r)
+		if err != nil {
+			onError(w, r, err)
+			return
+		}
+		handle(w, r, req)
+	}
+}
+
+// GetHealthRequest is a request to GetHealth, read from an *http.Request with BindGetHealthRequest.
+type GetHealthRequest struct {
+}
+
+// BindGetHealthRequest reads the request to GET /health.
+// Path parameters are read with r.PathValue, set by the router matching the request.
+// Parameters and bodies that can't be read are returned as a *runtime.BindError.
+func BindGetHealthRequest(r *http.Request) (*GetHealthRequest, error) {
+	req := &GetHealthRequest{}
+
+	return req, nil
+}
+
+// GetHealthHandler returns the http.HandlerFunc of GET /health, calling handle with the request
+// read by BindGetHealthRequest. Requests failing to bind are passed to onError instead,
+// runtime.DefaultBindErrorHandler if nil.
+func GetHealthHandler(handle func(w http.ResponseWriter, r *http.Request, req *GetHealthRequest), onError runtime.BindErrorHandler) http.HandlerFunc {
+	if onError == nil {
+		onError = runtime.DefaultBindErrorHandler
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		req, err := BindGetHealthRequest(r)
+		if err != nil {
+			onError(w, r, err)
+			return
+		}
+		handle(w, r, req)
+	}
+}
+
+// OperationValidators validates the requests of the operations with their Bind functions, and the JSON bodies
+// of their responses, by http.ServeMux pattern. Use it with runtime.ValidationMiddleware.
+var OperationValidators = map[string]runtime.OperationValidator{
+	"GET /pets": {
+		Request: func(r *http.Request) error {
+			_, err := BindListPetsRequest(r)
+			return err
+		},
+		Response: func(status int, body []byte) error {
+			var res any
+			switch {
+			case status == 200:
+				res = new(ListPetsResponse)
+			default:
+				return nil
+			}
+			if err := json.Unmarshal(body, res); err != nil {
+				return err
+			}
+			if v, ok := res.(runtime.Validator); ok {
+				return v.Validate()
+			}
+			return nil
+		},
+	},
+	"DELETE /pets/{petId}": {
+		Request: func(r *http.Request) error {
+			_, err := BindDeletePetRequest(r)
+			return err
+		},
+	},
+	"GET /health": {
+		Request: func(r *http.Request) error {
+			_, err := BindGetHealthRequest(r)
+			return err
+		},
+	},
+}
+
+// ServerInterface handles the requests routed by HandlerWithOptions, one method per operation.
+type ServerInterface interface {
+	// ListPets handles GET /pets.
+	ListPets(w http.ResponseWriter, r *http.Request, req *ListPetsRequest)
+	// DeletePet handles DELETE /pets/{petId}.
+	DeletePet(w http.ResponseWriter, r *http.Request, req *DeletePetRequest)
+	// GetHealth handles GET /health.
+	GetHealth(w http.ResponseWriter, r *http.Request, req *GetHealthRequest)
+}
+
+type serverContextKey string
+
+// OperationIDContextKey is the context key of the operationId of the routed request, set before the middlewares run.
+// Operations without an operationId in the spec use their generated name.
+const OperationIDContextKey serverContextKey = "operationId"
+
+// OperationIDFromContext returns the operationId of the request routed by HandlerWithOptions,
+// or an empty string outside of it.
+func OperationIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(OperationIDContextKey).(string)
+	return id
+}
+
+// ServerOptions configures the handler returned by HandlerWithOptions.
+type ServerOptions struct {
+	// BaseRouter is the mux the operations are registered on, a new one if nil.
+	BaseRouter *http.ServeMux
+
+	// Middlewares wrap every operation, the first one outermost.
+	Middlewares []runtime.Middleware
+
+	// TagMiddlewares wrap the operations with the tag, after the global middlewares.
+	TagMiddlewares map[string][]runtime.Middleware
+
+	// OperationMiddlewares wrap the operation with the operationId, after the tag middlewares.
+	OperationMiddlewares map[string][]runtime.Middleware
+
+	// ErrorHandler handles the requests failing to bind, runtime.DefaultBindErrorHandler if nil.
+	ErrorHandler runtime.BindErrorHandler
+
+	// FlagChecker gates the operations with x-feature-flag, which are all enabled if nil.
+	FlagChecker runtime.FlagChecker
+
+	// FeatureDisabledStatus answers the requests to operations whose feature flag is disabled,
+	// http.StatusNotFound if zero, e.g. http.StatusServiceUnavailable.
+	FeatureDisabledStatus int
+
+	// RequestLogger is passed the requests once served, sampled at the rate of the operations with x-log-sample-rate,
+	// with their sensitive parameters masked. Nothing is logged if nil.
+	RequestLogger runtime.RequestLogger
+}
+
+// serverRoute is an operation registered by HandlerWithOptions.
+type serverRoute struct {
+	operationID         string
+	tags                []string
+	featureFlag         string
+	logSampleRate       float64
+	sensitiveParameters runtime.SensitiveParameters
+}
+
+// Handler returns an http.Handler routing the operations to si.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ServerOptions{})
+}
+
+// HandlerWithOptions returns an http.Handler routing the operations to si, wrapped by the middlewares of options.
+func HandlerWithOptions(si ServerInterface, options ServerOptions) http.Handler {
+	mux := options.BaseRouter
+	if mux == nil {
+		mux = http.NewServeMux()
+	}
+
+	mux.Handle("GET /pets", options.wrap(serverRoute{
+		operationID: "listPets",
+		tags:        []string{"pets"},
+	}, ListPetsHandler(si.ListPets, options.ErrorHandler)))
+	mux.Handle("DELETE /pets/{petId}", options.wrap(serverRoute{
+		operationID:         "deletePet",
+		tags:                []string{"pets", "admin"},
+		featureFlag:         DeletePetFeatureFlag,
+		sensitiveParameters: DeletePetSensitiveParameters,
+	}, DeletePetHandler(si.DeletePet, options.ErrorHandler)))
+	mux.Handle("GET /health", options.wrap(serverRoute{
+		operationID:   "getHealth",
+		logSampleRate: GetHealthLogSampleRate,
+	}, GetHealthHandler(si.GetHealth, options.ErrorHandler)))
+
+	return mux
+}
+
+// wrap chains the request logger, the feature flag gate and the global, tag and operation middlewares around h,
+// and sets the operationId in the request context before they run.
+func (o ServerOptions) wrap(route serverRoute, h http.Handler) http.Handler {
+	var middlewares []runtime.Middleware
+	if o.RequestLogger != nil {
+		rate := route.logSampleRate
+		if rate == 0 {
+			rate = 1
+		}
+		middlewares = append(middlewares, runtime.SampleRequestLogs(runtime.MaskRequestLogs(o.RequestLogger, route.sensitiveParameters), rate))
+	}
+	if route.featureFlag != "" && o.FlagChecker != nil {
+		status := o.FeatureDisabledStatus
+		if status == 0 {
+			status = http.StatusNotFound
+		}
+		middlewares = append(middlewares, runtime.RequireFeatureFlag(o.FlagChecker, route.featureFlag, status))
+	}
+	middlewares = append(middlewares, o.Middlewares...)
+	for _, tag := range route.tags {
+		middlewares = append(middlewares, o.TagMiddlewares[tag]...)
+	}
+	middlewares = append(middlewares, o.OperationMiddlewares[route.operationID]...)
+
+	h = runtime.ChainMiddlewares(h, middlewares...)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), OperationIDContextKey, route.operationID)
+		h.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// Feature flags gating operations, set with x-feature-flag.
+// Serve the operations with runtime.RequireFeatureFlag to keep servers consistent with generated clients.
+const (
+	// DeletePetFeatureFlag is the feature flag gating DeletePet.
+	DeletePetFeatureFlag = "pet-deletion"
+)
+
+// Fractions of the requests of operations that are logged, set with x-log-sample-rate.
+// Serve the operations with runtime.SampleRequestLogs to sample server logs like generated clients do.
+const (
+	// GetHealthLogSampleRate is the fraction of GetHealth requests that are logged.
+	GetHealthLogSampleRate = 0.5
+)
+
+// Parameters of operations marked with x-sensitive-data, masked in the logs and errors of generated clients.
+// Wrap the loggers of servers with runtime.MaskRequestLogs to mask them in server logs too.
+var (
+	// DeletePetSensitiveParameters are the sensitive parameters of DeletePet.
+	DeletePetSensitiveParameters = runtime.SensitiveParameters{
+		Path: "/pets/{petId}",
+		Parameters: []runtime.SensitiveParameter{
+			{
+				In:   "path",
+				Name: "petId",
+				Config: runtime.SensitiveDataConfig{
+					Type:       runtime.MaskTypeFull,
+					Pattern:    "",
+					Algorithm:  "",
+					KeepPrefix: 0,
+					KeepSuffix: 0,
+				},
+			},
+		},
+	}
+)
+
+type Pet struct {
+	Name string `json:"name" validate:"required"`
+}
+
+func (p Pet) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(p))
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
+}
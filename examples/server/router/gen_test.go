@@ -0,0 +1,156 @@
+package router
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type server struct{}
+
+func (server) ListPets(w http.ResponseWriter, r *http.Request, req *ListPetsRequest) {
+	_ = json.NewEncoder(w).Encode([]Pet{{Name: "Rex"}})
+}
+
+func (server) DeletePet(w http.ResponseWriter, r *http.Request, req *DeletePetRequest) {
+	w.Header().Set("X-Pet-Id", r.PathValue("petId"))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (server) GetHealth(w http.ResponseWriter, r *http.Request, req *GetHealthRequest) {
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// trace appends the name and the operationId of the request to the X-Trace header of the response.
+func trace(name string) runtime.Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Add("X-Trace", name+":"+OperationIDFromContext(r.Context()))
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func requireAdmin(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Role") != "admin" {
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func newHandler() http.Handler {
+	return HandlerWithOptions(server{}, ServerOptions{
+		Middlewares: []runtime.Middleware{trace("global")},
+		TagMiddlewares: map[string][]runtime.Middleware{
+			"pets":  {trace("pets")},
+			"admin": {requireAdmin},
+		},
+		OperationMiddlewares: map[string][]runtime.Middleware{
+			"deletePet": {trace("delete")},
+		},
+	})
+}
+
+func TestHandlerWithOptions(t *testing.T) {
+	t.Run("middlewares in order", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodDelete, "/pets/42", nil)
+		r.Header.Set("X-Role", "admin")
+		w := httptest.NewRecorder()
+
+		newHandler().ServeHTTP(w, r)
+
+		require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+		assert.Equal(t, "42", w.Header().Get("X-Pet-Id"))
+		assert.Equal(t, []string{"global:deletePet", "pets:deletePet", "delete:deletePet"}, w.Header().Values("X-Trace"))
+	})
+
+	t.Run("tag middleware rejects", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		newHandler().ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/pets/42", nil))
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Equal(t, []string{"global:deletePet", "pets:deletePet"}, w.Header().Values("X-Trace"))
+	})
+
+	t.Run("untagged operation", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		newHandler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/health", nil))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+		assert.Equal(t, []string{"global:getHealth"}, w.Header().Values("X-Trace"))
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		newHandler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/pets?limit=0", nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "Query.Limit")
+	})
+
+	t.Run("default handler", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		Handler(server{}).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/pets", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `[{"name":"Rex"}]`, w.Body.String())
+		assert.Empty(t, w.Header().Values("X-Trace"))
+	})
+}
+
+func TestServerOptions(t *testing.T) {
+	deletePet := func(opts ServerOptions) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		HandlerWithOptions(server{}, opts).ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/pets/42", nil))
+		return w
+	}
+	disabled := runtime.FlagCheckerFunc(func(_ context.Context, flag string) bool {
+		return flag != DeletePetFeatureFlag
+	})
+
+	t.Run("feature flag disabled", func(t *testing.T) {
+		assert.Equal(t, http.StatusNotFound, deletePet(ServerOptions{FlagChecker: disabled}).Code)
+	})
+
+	t.Run("feature flag disabled with status", func(t *testing.T) {
+		w := deletePet(ServerOptions{FlagChecker: disabled, FeatureDisabledStatus: http.StatusServiceUnavailable})
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	})
+
+	t.Run("feature flag enabled", func(t *testing.T) {
+		enabled := runtime.FlagCheckerFunc(func(context.Context, string) bool { return true })
+		assert.Equal(t, http.StatusNoContent, deletePet(ServerOptions{FlagChecker: enabled}).Code)
+	})
+
+	t.Run("request logger masks sensitive parameters", func(t *testing.T) {
+		var logs []runtime.RequestLog
+		logger := func(_ context.Context, entry runtime.RequestLog) { logs = append(logs, entry) }
+
+		w := deletePet(ServerOptions{RequestLogger: logger})
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+		require.Len(t, logs, 1)
+		assert.Equal(t, "/pets/{petId}", logs[0].Path)
+		assert.Equal(t, http.StatusNoContent, logs[0].StatusCode)
+		assert.NotContains(t, logs[0].URL, "42")
+	})
+}
+
+func TestOperationIDFromContext(t *testing.T) {
+	assert.Empty(t, OperationIDFromContext(httptest.NewRequest(http.MethodGet, "/", nil).Context()))
+}
+
+func TestStubs(t *testing.T) {
+	rec := httptest.NewRecorder()
+	Handler(&Stubs{}).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health", nil))
+	assert.Equal(t, http.StatusNotImplemented, rec.Code)
+}
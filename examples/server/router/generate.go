@@ -0,0 +1,4 @@
+package router
+
+//go:generate go run ../../../cmd/oapi-codegen -config cfg.yaml api.yaml
+//go:generate go run ../../../cmd/oapi-codegen -config cfg.yaml -update-handlers stubs.go -handler-type Stubs api.yaml
@@ -0,0 +1,23 @@
+package router
+
+import "net/http"
+
+// Stubs is kept up to date with the operations of the spec by -update-handlers.
+type Stubs struct{}
+
+var _ ServerInterface = (*Stubs)(nil)
+
+// ListPets handles GET /pets.
+func (s *Stubs) ListPets(w http.ResponseWriter, r *http.Request, req *ListPetsRequest) {
+	http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+}
+
+// DeletePet handles DELETE /pets/{petId}.
+func (s *Stubs) DeletePet(w http.ResponseWriter, r *http.Request, req *DeletePetRequest) {
+	http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+}
+
+// GetHealth handles GET /health.
+func (s *Stubs) GetHealth(w http.ResponseWriter, r *http.Request, req *GetHealthRequest) {
+	http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+}
@@ -0,0 +1,64 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package spec
+
+import (
+	"net/http"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/go-playground/validator/v10"
+)
+
+type ListPetsResponse []Pet
+
+// Metadata of the OpenAPI spec this code was generated from.
+const (
+	// SpecTitle is the title of the spec.
+	SpecTitle = "Pet store"
+
+	// SpecVersion is the version of the spec.
+	SpecVersion = "1.0.0"
+
+	// SpecChecksum is the SHA-256 checksum of the spec document.
+	SpecChecksum = "sha256:4ab1b54fcc1a621e664a2bcd55ce31512f24f3b2ec3afabbfa0969f99f83f1a4"
+)
+
+// embeddedSpec is the JSON of the spec after filtering and pruning, gzipped and base64 encoded.
+var embeddedSpec = runtime.NewEmbeddedSpec([]string{
+	"H4sIAAAAAAAC/0yQsU4zMRCEXyWa/y+t3AGd34DuCjpEYZxJ4ihnL94FKTrl3ZF9oMPNrqxv/Gm8oAhz",
+	"kASPp/24H+GQ8rHAL7BkV8Jjou3USiUcvlg1lQyPh07fHSTYWRs/CK0vJ1obRViDpZKfD/C4JrWpAQ4W",
+	"Tgr/is6/OVSqlKzs4cdxbONAjTWJrbKXM3eyhmPJxtwFQeSaYlcMF23gAo1nzqFtdhPCI9Qabq2Vce6C",
+	"/5VHePwbYpmlZGbTYU3pMNFw/z0OG7E93deJ9kdR3i+Mhlbk4zNVHlq5HGa2clLbP1ha2/XbLalWUz79",
+	"KL8HAGi1JJ6MAQAA",
+})
+
+// GetSwagger returns the JSON of the spec this code was generated from, after filtering and pruning.
+func GetSwagger() ([]byte, error) {
+	return embeddedSpec.JSON()
+}
+
+// ServeSpec serves the JSON of the spec, e.g. on GET /openapi.json.
+func ServeSpec(w http.ResponseWriter, r *http.Request) {
+	embeddedSpec.ServeHTTP(w, r)
+}
+
+// SpecUIHandler returns an http.Handler serving the swagger-ui page of the spec served by ServeSpec at specURL.
+func SpecUIHandler(specURL string, opts ...runtime.SpecUIOption) http.Handler {
+	return runtime.SpecUIHandler("swagger-ui", SpecTitle, specURL, opts...)
+}
+
+type Pet struct {
+	Name string `json:"name" validate:"required"`
+}
+
+func (p Pet) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(p))
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
+}
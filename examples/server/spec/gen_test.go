@@ -0,0 +1,47 @@
+package spec
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSwagger(t *testing.T) {
+	spec, err := GetSwagger()
+	require.NoError(t, err)
+
+	var doc struct {
+		Info struct {
+			Title string `json:"title"`
+		} `json:"info"`
+		Paths map[string]any `json:"paths"`
+	}
+	require.NoError(t, json.Unmarshal(spec, &doc))
+	assert.Equal(t, SpecTitle, doc.Info.Title)
+	assert.Contains(t, doc.Paths, "/pets")
+	assert.NotContains(t, doc.Paths, "/internal/reindex", "filtered out")
+}
+
+func TestServeSpec(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /openapi.json", ServeSpec)
+	mux.Handle("GET /docs", SpecUIHandler("/openapi.json"))
+
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	spec, err := GetSwagger()
+	require.NoError(t, err)
+	assert.JSONEq(t, string(spec), w.Body.String())
+
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/docs", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "SwaggerUIBundle")
+	assert.Contains(t, w.Body.String(), "/openapi.json")
+}
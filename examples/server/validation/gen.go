@@ -0,0 +1,359 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package validation
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/go-playground/validator/v10"
+)
+
+type GetPetPath struct {
+	PetID int64 `json:"petId" validate:"required,gte=1"`
+}
+
+func (g GetPetPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(g))
+}
+
+type CreatePetBody = NewPet
+
+type CreatePetResponse = Pet
+
+type CreatePetErrorResponse = Error
+
+type GetPetResponse = Pet
+
+// CreatePetRequest is a request to CreatePet, read from an *http.Request with BindCreatePetRequest.
+type CreatePetRequest struct {
+	Body *CreatePetBody
+}
+
+// Validate validates all the fields of the request.
+func (o *CreatePetRequest) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// BindCreatePetRequest reads the request to POST /pets and validates it.
+// Path parameters are read with r.PathValue, set by the router matching the request.
+// Parameters and bodies that can't be read are returned as a *runtime.BindError,
+// and invalid requests as runtime.ValidationErrors.
+func BindCreatePetRequest(r *http.Request) (*CreatePetRequest, error) {
+	req := &CreatePetRequest{}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, &runtime.BindError{In: "body", Err: err}
+	}
+	if !runtime.IsEmptyBody(body) {
+		req.Body = &CreatePetBody{}
+		if err = json.Unmarshal(body, req.Body); err != nil {
+			return nil, &runtime.BindError{In: "body", Err: err}
+		}
+	} else {
+		return nil, &runtime.BindError{In: "body", Err: runtime.ErrMissingValue}
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// CreatePetHandler returns the http.HandlerFunc of POST /pets, calling handle with the request
+// read by BindCreatePetRequest. Requests failing to bind are passed to onError instead,
+// runtime.DefaultBindErrorHandler if nil.
+func CreatePetHandler(handle func(w http.ResponseWriter, r *http.Request, req *CreatePetRequest), onError runtime.BindErrorHandler) http.HandlerFunc {
+	if onError == nil {
+		onError = runtime.DefaultBindErrorHandler
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		req, err := BindCreatePetRequest(r)
+		if err != nil {
+			onError(w, r, err)
+			return
+		}
+		handle(w, r, req)
+	}
+}
+
+// GetPetRequest is a request to GetPet, read from an *http.Request with BindGetPetRequest.
+type GetPetRequest struct {
+	PathParams *GetPetPath
+}
+
+// Validate validates all the fields of the request.
+func (o *GetPetRequest) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// BindGetPetRequest reads the request to GET /pets/{petId} and validates it.
+// Path parameters are read with r.PathValue, set by the router matching the request.
+// Parameters and bodies that can't be read are returned as a *runtime.BindError,
+// and invalid requests as runtime.ValidationErrors.
+func BindGetPetRequest(r *http.Request) (*GetPetRequest, error) {
+	req := &GetPetRequest{}
+
+	req.PathParams = &GetPetPath{}
+	if err := runtime.BindPathParams(r, req.PathParams, map[string]runtime.ParameterBinding{
+		"petId": {Required: true},
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// GetPetHandler returns the http.HandlerFunc of GET /pets/{petId}, calling handle with the request
+// read by BindGetPetRequest. Requests failing to bind are passed to onError instead,
+// runtime.DefaultBindErrorHandler if nil.
+func GetPetHandler(handle func(w http.ResponseWriter, r *http.Request, req *GetPetRequest), onError runtime.BindErrorHandler) http.HandlerFunc {
+	if onError == nil {
+		onError = runtime.DefaultBindErrorHandler
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		req, err := BindGetPetRequest(r)
+		if err != nil {
+			onError(w, r, err)
+			return
+		}
+		handle(w, r, req)
+	}
+}
+
+// OperationValidators validates the requests of the operations with their Bind functions, and the JSON bodies
+// of their responses, by http.ServeMux pattern. Use it with runtime.ValidationMiddleware.
+var OperationValidators = map[string]runtime.OperationValidator{
+	"POST /pets": {
+		Request: func(r *http.Request) error {
+			_, err := BindCreatePetRequest(r)
+			return err
+		},
+		Response: func(status int, body []byte) error {
+			var res any
+			switch {
+			case status == 201:
+				res = new(CreatePetResponse)
+			case status >= 400 && status < 500:
+				res = new(CreatePetErrorResponse)
+			default:
+				return nil
+			}
+			if err := json.Unmarshal(body, res); err != nil {
+				return err
+			}
+			if v, ok := res.(runtime.Validator); ok {
+				return v.Validate()
+			}
+			return nil
+		},
+	},
+	"GET /pets/{petId}": {
+		Request: func(r *http.Request) error {
+			_, err := BindGetPetRequest(r)
+			return err
+		},
+		Response: func(status int, body []byte) error {
+			var res any
+			switch {
+			case status == 200:
+				res = new(GetPetResponse)
+			default:
+				return nil
+			}
+			if err := json.Unmarshal(body, res); err != nil {
+				return err
+			}
+			if v, ok := res.(runtime.Validator); ok {
+				return v.Validate()
+			}
+			return nil
+		},
+	},
+}
+
+// ServerInterface handles the requests routed by HandlerWithOptions, one method per operation.
+type ServerInterface interface {
+	// CreatePet handles POST /pets.
+	CreatePet(w http.ResponseWriter, r *http.Request, req *CreatePetRequest)
+	// GetPet handles GET /pets/{petId}.
+	GetPet(w http.ResponseWriter, r *http.Request, req *GetPetRequest)
+}
+
+type serverContextKey string
+
+// OperationIDContextKey is the context key of the operationId of the routed request, set before the middlewares run.
+// Operations without an operationId in the spec use their generated name.
+const OperationIDContextKey serverContextKey = "operationId"
+
+// OperationIDFromContext returns the operationId of the request routed by HandlerWithOptions,
+// or an empty string outside of it.
+func OperationIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(OperationIDContextKey).(string)
+	return id
+}
+
+// ServerOptions configures the handler returned by HandlerWithOptions.
+type ServerOptions struct {
+	// BaseRouter is the mux the operations are registered on, a new one if nil.
+	BaseRouter *http.ServeMux
+
+	// Middlewares wrap every operation, the first one outermost.
+	Middlewares []runtime.Middleware
+
+	// TagMiddlewares wrap the operations with the tag, after the global middlewares.
+	TagMiddlewares map[string][]runtime.Middleware
+
+	// OperationMiddlewares wrap the operation with the operationId, after the tag middlewares.
+	OperationMiddlewares map[string][]runtime.Middleware
+
+	// ErrorHandler handles the requests failing to bind, runtime.DefaultBindErrorHandler if nil.
+	ErrorHandler runtime.BindErrorHandler
+
+	// FlagChecker gates the operations with x-feature-flag, which are all enabled if nil.
+	FlagChecker runtime.FlagChecker
+
+	// FeatureDisabledStatus answers the requests to operations whose feature flag is disabled,
+	// http.StatusNotFound if zero, e.g. http.StatusServiceUnavailable.
+	FeatureDisabledStatus int
+
+	// RequestLogger is passed the requests once served, sampled at the rate of the operations with x-log-sample-rate,
+	// with their sensitive parameters masked. Nothing is logged if nil.
+	RequestLogger runtime.RequestLogger
+}
+
+// serverRoute is an operation registered by HandlerWithOptions.
+type serverRoute struct {
+	operationID         string
+	tags                []string
+	featureFlag         string
+	logSampleRate       float64
+	sensitiveParameters runtime.SensitiveParameters
+}
+
+// Handler returns an http.Handler routing the operations to si.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ServerOptions{})
+}
+
+// HandlerWithOptions returns an http.Handler routing the operations to si, wrapped by the middlewares of options.
+func HandlerWithOptions(si ServerInterface, options ServerOptions) http.Handler {
+	mux := options.BaseRouter
+	if mux == nil {
+		mux = http.NewServeMux()
+	}
+
+	mux.Handle("POST /pets", options.wrap(serverRoute{
+		operationID: "createPet",
+	}, CreatePetHandler(si.CreatePet, options.ErrorHandler)))
+	mux.Handle("GET /pets/{petId}", options.wrap(serverRoute{
+		operationID: "getPet",
+	}, GetPetHandler(si.GetPet, options.ErrorHandler)))
+
+	return mux
+}
+
+// wrap chains the request logger, the feature flag gate and the global, tag and operation middlewares around h,
+// and sets the operationId in the request context before they run.
+func (o ServerOptions) wrap(route serverRoute, h http.Handler) http.Handler {
+	var middlewares []runtime.Middleware
+	if o.RequestLogger != nil {
+		rate := route.logSampleRate
+		if rate == 0 {
+			rate = 1
+		}
+		middlewares = append(middlewares, runtime.SampleRequestLogs(runtime.MaskRequestLogs(o.RequestLogger, route.sensitiveParameters), rate))
+	}
+	if route.featureFlag != "" && o.FlagChecker != nil {
+		status := o.FeatureDisabledStatus
+		if status == 0 {
+			status = http.StatusNotFound
+		}
+		middlewares = append(middlewares, runtime.RequireFeatureFlag(o.FlagChecker, route.featureFlag, status))
+	}
+	middlewares = append(middlewares, o.Middlewares...)
+	for _, tag := range route.tags {
+		middlewares = append(middlewares, o.TagMiddlewares[tag]...)
+	}
+	middlewares = append(middlewares, o.OperationMiddlewares[route.operationID]...)
+
+	h = runtime.ChainMiddlewares(h, middlewares...)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), OperationIDContextKey, route.operationID)
+		h.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+type NewPet struct {
+	Name string `json:"name" validate:"required,min=1"`
+}
+
+func (n NewPet) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(n))
+}
+
+type Pet struct {
+	ID   int64  `json:"id" validate:"required,gte=1"`
+	Name string `json:"name" validate:"required,min=1"`
+}
+
+func (p Pet) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(p))
+}
+
+type Error struct {
+	Message string `json:"message" validate:"required"`
+}
+
+func (e Error) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(e))
+}
+
+func (s Error) Error() string {
+	return "unmapped client error"
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
+}
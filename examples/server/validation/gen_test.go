@@ -0,0 +1,79 @@
+package validation
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// server returns the pet stored in it, invalid when its id is 0.
+type server struct {
+	pet Pet
+}
+
+func (s server) CreatePet(w http.ResponseWriter, r *http.Request, req *CreatePetRequest) {
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(Pet{ID: s.pet.ID, Name: req.Body.Name})
+}
+
+func (s server) GetPet(w http.ResponseWriter, r *http.Request, req *GetPetRequest) {
+	_ = json.NewEncoder(w).Encode(s.pet)
+}
+
+func newHandler(pet Pet, onResponseError runtime.BindErrorHandler) http.Handler {
+	return HandlerWithOptions(server{pet: pet}, ServerOptions{
+		Middlewares: []runtime.Middleware{
+			runtime.ValidationMiddleware(OperationValidators, runtime.ValidationOptions{
+				ValidateResponses:    true,
+				ResponseErrorHandler: onResponseError,
+			}),
+		},
+	})
+}
+
+func TestValidationMiddleware(t *testing.T) {
+	t.Run("valid request and response", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		newHandler(Pet{ID: 1}, nil).ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/pets", strings.NewReader(`{"name":"Rex"}`)))
+
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+		assert.JSONEq(t, `{"id":1,"name":"Rex"}`, w.Body.String())
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		newHandler(Pet{ID: 1}, nil).ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/pets", strings.NewReader(`{"name":""}`)))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "Body.Name")
+	})
+
+	t.Run("invalid path parameter", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		newHandler(Pet{ID: 1, Name: "Rex"}, nil).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/pets/0", nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("invalid response", func(t *testing.T) {
+		var got error
+		onResponseError := func(w http.ResponseWriter, r *http.Request, err error) {
+			got = err
+			runtime.DefaultResponseErrorHandler(w, r, err)
+		}
+		w := httptest.NewRecorder()
+		newHandler(Pet{Name: "Rex"}, onResponseError).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/pets/1", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		require.ErrorIs(t, got, runtime.ErrInvalidResponse)
+		var validationErrs runtime.ValidationErrors
+		assert.True(t, errors.As(got, &validationErrs), got)
+	})
+}
@@ -245,9 +245,26 @@ func (c *CreateUserBody_Pages_OneOf) Validate() error {
 	return nil
 }
 
+// UnmarshalJSON holds the variant whose required properties best match the data,
+// or a *runtime.AmbiguousUnionError if several match equally well.
+func (c *CreateUserBody_Pages_OneOf) UnmarshalJSON(data []byte) error {
+	n, err := runtime.MatchRequiredFields(data,
+		runtime.UnionVariant{Name: "CreateUserBody_Pages_OneOf_0", Required: []string{"first", "second"}, Properties: []string{"first", "second"}},
+		runtime.UnionVariant{Name: "CreateUserBody_Pages_OneOf_1", Required: []string{"last"}, Properties: []string{"last"}},
+	)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return c.Either.UnmarshalJSON(data)
+	}
+	return c.UnmarshalVariant(n, data)
+}
+
 var typesValidator *validator.Validate
 
 func init() {
 	typesValidator = validator.New(validator.WithRequiredStructEnabled())
 	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
 }
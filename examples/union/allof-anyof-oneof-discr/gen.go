@@ -5,8 +5,8 @@ package union
 import (
 	"bytes"
 	"encoding/json"
-	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
 	"github.com/go-playground/validator/v10"
@@ -30,6 +30,74 @@ func (c ClientAndMaybeIdentityType) Validate() error {
 	}
 }
 
+// clientAndMaybeIdentityTypeNames maps ClientAndMaybeIdentityType values to their names.
+var clientAndMaybeIdentityTypeNames = map[ClientAndMaybeIdentityType]string{
+	ClientAndMaybeIdentityTypeClient:   "Client",
+	ClientAndMaybeIdentityTypeIdentity: "Identity",
+	ClientWithID:                       "ClientWithID",
+}
+
+// clientAndMaybeIdentityTypeValues maps names to ClientAndMaybeIdentityType values.
+var clientAndMaybeIdentityTypeValues = map[string]ClientAndMaybeIdentityType{
+	"Client":       ClientAndMaybeIdentityTypeClient,
+	"Identity":     ClientAndMaybeIdentityTypeIdentity,
+	"ClientWithID": ClientWithID,
+}
+
+// String returns the wire value of the ClientAndMaybeIdentityType.
+func (c ClientAndMaybeIdentityType) String() string {
+	return string(c)
+}
+
+// IsValid reports whether the ClientAndMaybeIdentityType value is defined in the spec.
+func (c ClientAndMaybeIdentityType) IsValid() bool {
+	_, ok := clientAndMaybeIdentityTypeNames[c]
+	return ok
+}
+
+// Values returns all the ClientAndMaybeIdentityType values defined in the spec.
+func (ClientAndMaybeIdentityType) Values() []ClientAndMaybeIdentityType {
+	return []ClientAndMaybeIdentityType{
+		ClientAndMaybeIdentityTypeClient,
+		ClientAndMaybeIdentityTypeIdentity,
+		ClientWithID,
+	}
+}
+
+// ClientAndMaybeIdentityTypePtr returns a pointer to v, to set the optional and nullable ClientAndMaybeIdentityType properties.
+func ClientAndMaybeIdentityTypePtr(v ClientAndMaybeIdentityType) *ClientAndMaybeIdentityType {
+	return &v
+}
+
+// Name returns the name of the ClientAndMaybeIdentityType value, or an empty string for unknown values.
+func (c ClientAndMaybeIdentityType) Name() string {
+	return clientAndMaybeIdentityTypeNames[c]
+}
+
+// ParseClientAndMaybeIdentityType returns the ClientAndMaybeIdentityType matching s by wire value or by name.
+func ParseClientAndMaybeIdentityType(s string) (ClientAndMaybeIdentityType, error) {
+	if _, ok := clientAndMaybeIdentityTypeNames[ClientAndMaybeIdentityType(s)]; ok {
+		return ClientAndMaybeIdentityType(s), nil
+	}
+	if v, ok := clientAndMaybeIdentityTypeValues[s]; ok {
+		return v, nil
+	}
+	var zero ClientAndMaybeIdentityType
+	return zero, fmt.Errorf("%w for ClientAndMaybeIdentityType: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (c ClientAndMaybeIdentityType) MarshalText() ([]byte, error) {
+	return []byte(c), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseClientAndMaybeIdentityType.
+// Unknown values are kept as-is and reported by Validate.
+func (c *ClientAndMaybeIdentityType) UnmarshalText(text []byte) error {
+	*c = ClientAndMaybeIdentityType(text)
+	return nil
+}
+
 type DogType string
 
 const (
@@ -46,6 +114,68 @@ func (d DogType) Validate() error {
 	}
 }
 
+// dogTypeNames maps DogType values to their names.
+var dogTypeNames = map[DogType]string{
+	DogTypeDog: "Dog",
+}
+
+// dogTypeValues maps names to DogType values.
+var dogTypeValues = map[string]DogType{
+	"Dog": DogTypeDog,
+}
+
+// String returns the wire value of the DogType.
+func (d DogType) String() string {
+	return string(d)
+}
+
+// IsValid reports whether the DogType value is defined in the spec.
+func (d DogType) IsValid() bool {
+	_, ok := dogTypeNames[d]
+	return ok
+}
+
+// Values returns all the DogType values defined in the spec.
+func (DogType) Values() []DogType {
+	return []DogType{
+		DogTypeDog,
+	}
+}
+
+// DogTypePtr returns a pointer to v, to set the optional and nullable DogType properties.
+func DogTypePtr(v DogType) *DogType {
+	return &v
+}
+
+// Name returns the name of the DogType value, or an empty string for unknown values.
+func (d DogType) Name() string {
+	return dogTypeNames[d]
+}
+
+// ParseDogType returns the DogType matching s by wire value or by name.
+func ParseDogType(s string) (DogType, error) {
+	if _, ok := dogTypeNames[DogType(s)]; ok {
+		return DogType(s), nil
+	}
+	if v, ok := dogTypeValues[s]; ok {
+		return v, nil
+	}
+	var zero DogType
+	return zero, fmt.Errorf("%w for DogType: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (d DogType) MarshalText() ([]byte, error) {
+	return []byte(d), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseDogType.
+// Unknown values are kept as-is and reported by Validate.
+func (d *DogType) UnmarshalText(text []byte) error {
+	*d = DogType(text)
+	return nil
+}
+
 type CatType string
 
 const (
@@ -62,6 +192,150 @@ func (c CatType) Validate() error {
 	}
 }
 
+// catTypeNames maps CatType values to their names.
+var catTypeNames = map[CatType]string{
+	CatTypeCat: "Cat",
+}
+
+// catTypeValues maps names to CatType values.
+var catTypeValues = map[string]CatType{
+	"Cat": CatTypeCat,
+}
+
+// String returns the wire value of the CatType.
+func (c CatType) String() string {
+	return string(c)
+}
+
+// IsValid reports whether the CatType value is defined in the spec.
+func (c CatType) IsValid() bool {
+	_, ok := catTypeNames[c]
+	return ok
+}
+
+// Values returns all the CatType values defined in the spec.
+func (CatType) Values() []CatType {
+	return []CatType{
+		CatTypeCat,
+	}
+}
+
+// CatTypePtr returns a pointer to v, to set the optional and nullable CatType properties.
+func CatTypePtr(v CatType) *CatType {
+	return &v
+}
+
+// Name returns the name of the CatType value, or an empty string for unknown values.
+func (c CatType) Name() string {
+	return catTypeNames[c]
+}
+
+// ParseCatType returns the CatType matching s by wire value or by name.
+func ParseCatType(s string) (CatType, error) {
+	if _, ok := catTypeNames[CatType(s)]; ok {
+		return CatType(s), nil
+	}
+	if v, ok := catTypeValues[s]; ok {
+		return v, nil
+	}
+	var zero CatType
+	return zero, fmt.Errorf("%w for CatType: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (c CatType) MarshalText() ([]byte, error) {
+	return []byte(c), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseCatType.
+// Unknown values are kept as-is and reported by Validate.
+func (c *CatType) UnmarshalText(text []byte) error {
+	*c = CatType(text)
+	return nil
+}
+
+type BirdType string
+
+const (
+	BirdTypeBird BirdType = "bird"
+	Parrot       BirdType = "parrot"
+)
+
+// Validate checks if the BirdType value is valid
+func (b BirdType) Validate() error {
+	switch b {
+	case BirdTypeBird, Parrot:
+		return nil
+	default:
+		return runtime.NewValidationErrorsFromString("Enum", fmt.Sprintf("must be a valid BirdType value, got: %v", b))
+	}
+}
+
+// birdTypeNames maps BirdType values to their names.
+var birdTypeNames = map[BirdType]string{
+	BirdTypeBird: "Bird",
+	Parrot:       "Parrot",
+}
+
+// birdTypeValues maps names to BirdType values.
+var birdTypeValues = map[string]BirdType{
+	"Bird":   BirdTypeBird,
+	"Parrot": Parrot,
+}
+
+// String returns the wire value of the BirdType.
+func (b BirdType) String() string {
+	return string(b)
+}
+
+// IsValid reports whether the BirdType value is defined in the spec.
+func (b BirdType) IsValid() bool {
+	_, ok := birdTypeNames[b]
+	return ok
+}
+
+// Values returns all the BirdType values defined in the spec.
+func (BirdType) Values() []BirdType {
+	return []BirdType{
+		BirdTypeBird,
+		Parrot,
+	}
+}
+
+// BirdTypePtr returns a pointer to v, to set the optional and nullable BirdType properties.
+func BirdTypePtr(v BirdType) *BirdType {
+	return &v
+}
+
+// Name returns the name of the BirdType value, or an empty string for unknown values.
+func (b BirdType) Name() string {
+	return birdTypeNames[b]
+}
+
+// ParseBirdType returns the BirdType matching s by wire value or by name.
+func ParseBirdType(s string) (BirdType, error) {
+	if _, ok := birdTypeNames[BirdType(s)]; ok {
+		return BirdType(s), nil
+	}
+	if v, ok := birdTypeValues[s]; ok {
+		return v, nil
+	}
+	var zero BirdType
+	return zero, fmt.Errorf("%w for BirdType: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (b BirdType) MarshalText() ([]byte, error) {
+	return []byte(b), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseBirdType.
+// Unknown values are kept as-is and reported by Validate.
+func (b *BirdType) UnmarshalText(text []byte) error {
+	*b = BirdType(text)
+	return nil
+}
+
 type GetFooResponse = map[string]any
 
 type GetPetResponse = Pet
@@ -363,6 +637,81 @@ func (p *Pet) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+type Bird struct {
+	Name     string   `json:"name" validate:"required"`
+	Type     BirdType `json:"type" validate:"required"`
+	Wingspan *float32 `json:"wingspan,omitempty"`
+}
+
+func (b Bird) Validate() error {
+	var errors runtime.ValidationErrors
+	if err := typesValidator.Var(b.Name, "required"); err != nil {
+		errors = errors.Append("Name", err)
+	}
+	if v, ok := any(b.Type).(runtime.Validator); ok {
+		if err := v.Validate(); err != nil {
+			errors = errors.Append("Type", err)
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+type Animal struct {
+	Animal_OneOf *Animal_OneOf `json:"-"`
+}
+
+func (a Animal) Validate() error {
+	var errors runtime.ValidationErrors
+	if a.Animal_OneOf != nil {
+		if v, ok := any(a.Animal_OneOf).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Animal_OneOf", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+func (a Animal) MarshalJSON() ([]byte, error) {
+	var parts []json.RawMessage
+
+	{
+		b, err := runtime.MarshalJSON(a.Animal_OneOf)
+		if err != nil {
+			return nil, fmt.Errorf("Animal_OneOf marshal: %w", err)
+		}
+		parts = append(parts, b)
+	}
+
+	return runtime.CoalesceOrMerge(parts...)
+}
+
+func (a *Animal) UnmarshalJSON(data []byte) error {
+	trim := bytes.TrimSpace(data)
+	if bytes.Equal(trim, []byte("null")) {
+		return nil
+	}
+	if len(trim) == 0 {
+		return fmt.Errorf("empty JSON input")
+	}
+
+	if a.Animal_OneOf == nil {
+		a.Animal_OneOf = &Animal_OneOf{}
+	}
+
+	if err := runtime.UnmarshalJSON(data, a.Animal_OneOf); err != nil {
+		return fmt.Errorf("Animal_OneOf unmarshal: %w", err)
+	}
+
+	return nil
+}
+
 type ClientAndMaybeIdentity_Entity_AnyOf struct {
 	runtime.Either[Client, Identity]
 }
@@ -399,6 +748,22 @@ func (c *ClientOrID_OneOf) Validate() error {
 	return nil
 }
 
+// UnmarshalJSON holds the variant whose required properties best match the data,
+// or a *runtime.AmbiguousUnionError if several match equally well.
+func (c *ClientOrID_OneOf) UnmarshalJSON(data []byte) error {
+	n, err := runtime.MatchRequiredFields(data,
+		runtime.UnionVariant{Name: "Client", Required: []string{"name"}, Properties: []string{"name"}},
+		runtime.UnionVariant{Name: "string"},
+	)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return c.Either.UnmarshalJSON(data)
+	}
+	return c.UnmarshalVariant(n, data)
+}
+
 type ClientOrIdentityWithDiscriminator_OneOf struct {
 	runtime.Either[Client, Identity]
 }
@@ -427,6 +792,50 @@ func (c ClientOrIdentityWithDiscriminator_OneOf) discriminator(data []byte) (str
 	return discriminator.Value, nil
 }
 
+// Discriminator returns the value of the "type" discriminator property of the data held by the ClientOrIdentityWithDiscriminator_OneOf
+func (c *ClientOrIdentityWithDiscriminator_OneOf) Discriminator() (string, error) {
+	data := c.Value()
+	if data == nil {
+		return "", nil
+	}
+	obj, err := json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+	return c.heldDiscriminator(obj)
+}
+
+// heldDiscriminator returns the discriminator of obj, the marshaled data held by the ClientOrIdentityWithDiscriminator_OneOf,
+// defaulting to the value mapped to its type
+func (c *ClientOrIdentityWithDiscriminator_OneOf) heldDiscriminator(obj []byte) (string, error) {
+	discriminator, err := c.discriminator(obj)
+	if err != nil || discriminator != "" {
+		return discriminator, err
+	}
+	if c.IsA() {
+		return "client", nil
+	}
+	if c.IsB() {
+		return "identity", nil
+	}
+	return discriminator, nil
+}
+
+// ValueByDiscriminator returns the data held by the ClientOrIdentityWithDiscriminator_OneOf,
+// or a *runtime.UnknownDiscriminatorError if its discriminator is missing from the mapping
+func (c *ClientOrIdentityWithDiscriminator_OneOf) ValueByDiscriminator() (any, error) {
+	discriminator, err := c.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "client", "identity":
+		return c.Value(), nil
+	default:
+		return nil, &runtime.UnknownDiscriminatorError{Property: "type", Value: discriminator}
+	}
+}
+
 func (c *ClientOrIdentityWithDiscriminator_OneOf) MarshalJSON() ([]byte, error) {
 	data := c.Value()
 	if data == nil {
@@ -438,7 +847,7 @@ func (c *ClientOrIdentityWithDiscriminator_OneOf) MarshalJSON() ([]byte, error)
 		return nil, err
 	}
 
-	disc, err := c.discriminator(obj)
+	disc, err := c.heldDiscriminator(obj)
 	if err != nil {
 		return nil, err
 	}
@@ -469,7 +878,7 @@ func (c *ClientOrIdentityWithDiscriminator_OneOf) UnmarshalJSON(data []byte) err
 		c.B = res
 		c.N = 2
 	default:
-		return errors.New("unknown discriminator value: " + discriminator)
+		return &runtime.UnknownDiscriminatorError{Property: "type", Value: discriminator}
 	}
 	return nil
 }
@@ -502,6 +911,50 @@ func (p Pet_OneOf) discriminator(data []byte) (string, error) {
 	return discriminator.Value, nil
 }
 
+// Discriminator returns the value of the "type" discriminator property of the data held by the Pet_OneOf
+func (p *Pet_OneOf) Discriminator() (string, error) {
+	data := p.Value()
+	if data == nil {
+		return "", nil
+	}
+	obj, err := json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+	return p.heldDiscriminator(obj)
+}
+
+// heldDiscriminator returns the discriminator of obj, the marshaled data held by the Pet_OneOf,
+// defaulting to the value mapped to its type
+func (p *Pet_OneOf) heldDiscriminator(obj []byte) (string, error) {
+	discriminator, err := p.discriminator(obj)
+	if err != nil || discriminator != "" {
+		return discriminator, err
+	}
+	if p.IsA() {
+		return "dog", nil
+	}
+	if p.IsB() {
+		return "cat", nil
+	}
+	return discriminator, nil
+}
+
+// ValueByDiscriminator returns the data held by the Pet_OneOf,
+// or a *runtime.UnknownDiscriminatorError if its discriminator is missing from the mapping
+func (p *Pet_OneOf) ValueByDiscriminator() (any, error) {
+	discriminator, err := p.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "cat", "dog":
+		return p.Value(), nil
+	default:
+		return nil, &runtime.UnknownDiscriminatorError{Property: "type", Value: discriminator}
+	}
+}
+
 func (p *Pet_OneOf) MarshalJSON() ([]byte, error) {
 	data := p.Value()
 	if data == nil {
@@ -513,7 +966,7 @@ func (p *Pet_OneOf) MarshalJSON() ([]byte, error) {
 		return nil, err
 	}
 
-	disc, err := p.discriminator(obj)
+	disc, err := p.heldDiscriminator(obj)
 	if err != nil {
 		return nil, err
 	}
@@ -544,14 +997,282 @@ func (p *Pet_OneOf) UnmarshalJSON(data []byte) error {
 		p.A = res
 		p.N = 1
 	default:
-		return errors.New("unknown discriminator value: " + discriminator)
+		return &runtime.UnknownDiscriminatorError{Property: "type", Value: discriminator}
+	}
+	return nil
+}
+
+type Animal_OneOf struct {
+	union json.RawMessage
+}
+
+func (a *Animal_OneOf) Validate() error {
+	// NOTE: Validation is not supported for unions with more than 2 elements.
+	// Validating would require unmarshaling against each possible type, which is inefficient.
+	// Use AsValidated<Type>() methods to validate after retrieving the specific type.
+	return nil
+}
+
+// Raw returns the union data inside the Animal_OneOf as bytes
+func (a *Animal_OneOf) Raw() json.RawMessage {
+	return a.union
+}
+
+// AsDog returns the union data inside the Animal_OneOf as a Dog
+// The discriminator of the union data must map to Dog
+func (a *Animal_OneOf) AsDog() (Dog, error) {
+	if err := a.checkDiscriminator("Dog", "dog"); err != nil {
+		var zero Dog
+		return zero, err
+	}
+	return runtime.UnmarshalAs[Dog](a.union)
+}
+
+// AsValidatedDog returns the union data inside the Animal_OneOf as a validated Dog
+func (a *Animal_OneOf) AsValidatedDog() (Dog, error) {
+	val, err := a.AsDog()
+	if err != nil {
+		var zero Dog
+		return zero, err
+	}
+	if err := a.validateDog(val); err != nil {
+		var zero Dog
+		return zero, err
+	}
+	return val, nil
+}
+
+// FromDog overwrites any union data inside the Animal_OneOf as the provided Dog
+func (a *Animal_OneOf) FromDog(val Dog) error {
+	// Validate before storing
+	if err := a.validateDog(val); err != nil {
+		return err
+	}
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	bts, err = runtime.MarshalEitherWithDiscriminator(bts, "type", "dog")
+	a.union = bts
+	return err
+}
+
+// MergeDog merges the provided Dog into the union data inside the Animal_OneOf
+func (a *Animal_OneOf) MergeDog(val Dog) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	bts, err = runtime.MarshalEitherWithDiscriminator(bts, "type", "dog")
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(a.union, bts)
+	if err != nil {
+		return err
+	}
+	a.union = merged
+	return nil
+}
+
+// AsCat returns the union data inside the Animal_OneOf as a Cat
+// The discriminator of the union data must map to Cat
+func (a *Animal_OneOf) AsCat() (Cat, error) {
+	if err := a.checkDiscriminator("Cat", "cat"); err != nil {
+		var zero Cat
+		return zero, err
+	}
+	return runtime.UnmarshalAs[Cat](a.union)
+}
+
+// AsValidatedCat returns the union data inside the Animal_OneOf as a validated Cat
+func (a *Animal_OneOf) AsValidatedCat() (Cat, error) {
+	val, err := a.AsCat()
+	if err != nil {
+		var zero Cat
+		return zero, err
+	}
+	if err := a.validateCat(val); err != nil {
+		var zero Cat
+		return zero, err
+	}
+	return val, nil
+}
+
+// FromCat overwrites any union data inside the Animal_OneOf as the provided Cat
+func (a *Animal_OneOf) FromCat(val Cat) error {
+	// Validate before storing
+	if err := a.validateCat(val); err != nil {
+		return err
+	}
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	bts, err = runtime.MarshalEitherWithDiscriminator(bts, "type", "cat")
+	a.union = bts
+	return err
+}
+
+// MergeCat merges the provided Cat into the union data inside the Animal_OneOf
+func (a *Animal_OneOf) MergeCat(val Cat) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	bts, err = runtime.MarshalEitherWithDiscriminator(bts, "type", "cat")
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(a.union, bts)
+	if err != nil {
+		return err
+	}
+	a.union = merged
+	return nil
+}
+
+// AsBird returns the union data inside the Animal_OneOf as a Bird
+// The discriminator of the union data must map to Bird
+func (a *Animal_OneOf) AsBird() (Bird, error) {
+	if err := a.checkDiscriminator("Bird", "bird", "parrot"); err != nil {
+		var zero Bird
+		return zero, err
+	}
+	return runtime.UnmarshalAs[Bird](a.union)
+}
+
+// AsValidatedBird returns the union data inside the Animal_OneOf as a validated Bird
+func (a *Animal_OneOf) AsValidatedBird() (Bird, error) {
+	val, err := a.AsBird()
+	if err != nil {
+		var zero Bird
+		return zero, err
+	}
+	if err := a.validateBird(val); err != nil {
+		var zero Bird
+		return zero, err
+	}
+	return val, nil
+}
+
+// FromBird overwrites any union data inside the Animal_OneOf as the provided Bird
+func (a *Animal_OneOf) FromBird(val Bird) error {
+	// Validate before storing
+	if err := a.validateBird(val); err != nil {
+		return err
+	}
+	bts, err := json.Marshal(val)
+	a.union = bts
+	return err
+}
+
+// MergeBird merges the provided Bird into the union data inside the Animal_OneOf
+func (a *Animal_OneOf) MergeBird(val Bird) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(a.union, bts)
+	if err != nil {
+		return err
+	}
+	a.union = merged
+	return nil
+}
+
+// validateDog validates a Dog value
+func (a *Animal_OneOf) validateDog(val Dog) error {
+	if v, ok := any(val).(runtime.Validator); ok {
+		return v.Validate()
+	}
+	return nil
+}
+
+// validateCat validates a Cat value
+func (a *Animal_OneOf) validateCat(val Cat) error {
+	if v, ok := any(val).(runtime.Validator); ok {
+		return v.Validate()
+	}
+	return nil
+}
+
+// validateBird validates a Bird value
+func (a *Animal_OneOf) validateBird(val Bird) error {
+	if v, ok := any(val).(runtime.Validator); ok {
+		return v.Validate()
 	}
 	return nil
 }
 
+func (a Animal_OneOf) discriminator(data []byte) (string, error) {
+	var discriminator struct {
+		Value string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &discriminator); err != nil {
+		return "", err
+	}
+	return discriminator.Value, nil
+}
+
+// Discriminator returns the value of the "type" discriminator property of the union data inside the Animal_OneOf
+func (a Animal_OneOf) Discriminator() (string, error) {
+	return a.discriminator(a.union)
+}
+
+// ValueByDiscriminator returns the union data inside the Animal_OneOf as the type mapped to its discriminator,
+// or a *runtime.UnknownDiscriminatorError if the discriminator is missing from the mapping
+func (a Animal_OneOf) ValueByDiscriminator() (any, error) {
+	discriminator, err := a.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "dog":
+		return runtime.UnmarshalAs[Dog](a.union)
+	case "cat":
+		return runtime.UnmarshalAs[Cat](a.union)
+	case "bird", "parrot":
+		return runtime.UnmarshalAs[Bird](a.union)
+	default:
+		return nil, &runtime.UnknownDiscriminatorError{Property: "type", Value: discriminator}
+	}
+}
+
+// checkDiscriminator returns an error unless the discriminator of the union data is one of values,
+// a *runtime.UnknownDiscriminatorError if it is missing from the mapping
+func (a Animal_OneOf) checkDiscriminator(typeName string, values ...string) error {
+	discriminator, err := a.Discriminator()
+	if err != nil {
+		return err
+	}
+	if slices.Contains(values, discriminator) {
+		return nil
+	}
+	switch discriminator {
+	case "bird", "cat", "dog", "parrot":
+		return fmt.Errorf("discriminator value %q does not map to %s", discriminator, typeName)
+	default:
+		return &runtime.UnknownDiscriminatorError{Property: "type", Value: discriminator}
+	}
+}
+
+func (a Animal_OneOf) MarshalJSON() ([]byte, error) {
+	bts, err := a.union.MarshalJSON()
+
+	return bts, err
+}
+
+func (a *Animal_OneOf) UnmarshalJSON(bts []byte) error {
+	err := a.union.UnmarshalJSON(bts)
+
+	return err
+}
+
 var typesValidator *validator.Validate
 
 func init() {
 	typesValidator = validator.New(validator.WithRequiredStructEnabled())
 	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
 }
@@ -100,3 +100,93 @@ func TestPetUnion_MarshalCat(t *testing.T) {
 	assert.Equal(t, "Whiskers", result["name"])
 	assert.Equal(t, "cat", result["type"])
 }
+
+func TestPetUnion_Discriminator(t *testing.T) {
+	// Dog.type is optional, the discriminator defaults to the value mapped to Dog
+	pet := Pet_OneOf{Either: runtime.NewEitherFromA[Dog, Cat](Dog{Name: "Buddy"})}
+
+	discriminator, err := pet.Discriminator()
+	require.NoError(t, err)
+	assert.Equal(t, "dog", discriminator)
+
+	value, err := pet.ValueByDiscriminator()
+	require.NoError(t, err)
+	assert.Equal(t, Dog{Name: "Buddy"}, value)
+
+	data, err := json.Marshal(&pet)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"Buddy","type":"dog"}`, string(data))
+}
+
+func TestPetUnion_UnknownDiscriminator(t *testing.T) {
+	var pet Pet
+	err := json.Unmarshal([]byte(`{"name":"Nemo","type":"fish"}`), &pet)
+
+	var unknown *runtime.UnknownDiscriminatorError
+	require.ErrorAs(t, err, &unknown)
+	assert.Equal(t, "type", unknown.Property)
+	assert.Equal(t, "fish", unknown.Value)
+}
+
+func TestAnimalUnion_Accessors(t *testing.T) {
+	var animal Animal_OneOf
+	require.NoError(t, json.Unmarshal([]byte(`{"name":"Polly","type":"parrot"}`), &animal))
+
+	discriminator, err := animal.Discriminator()
+	require.NoError(t, err)
+	assert.Equal(t, "parrot", discriminator)
+
+	value, err := animal.ValueByDiscriminator()
+	require.NoError(t, err)
+	assert.Equal(t, Bird{Name: "Polly", Type: Parrot}, value)
+
+	bird, err := animal.AsBird()
+	require.NoError(t, err)
+	assert.Equal(t, "Polly", bird.Name)
+
+	// the discriminator maps to Bird
+	_, err = animal.AsDog()
+	assert.EqualError(t, err, `discriminator value "parrot" does not map to Dog`)
+
+	require.NoError(t, animal.MergeBird(Bird{Name: "Polly", Type: Parrot, Wingspan: ptr(float32(0.5))}))
+	bird, err = animal.AsBird()
+	require.NoError(t, err)
+	assert.Equal(t, float32(0.5), *bird.Wingspan)
+}
+
+func TestAnimalUnion_FromSetsDiscriminator(t *testing.T) {
+	var animal Animal_OneOf
+	require.NoError(t, animal.FromDog(Dog{Name: "Buddy"}))
+
+	data, err := json.Marshal(animal)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"Buddy","type":"dog"}`, string(data))
+
+	dog, err := animal.AsDog()
+	require.NoError(t, err)
+	assert.Equal(t, "Buddy", dog.Name)
+
+	require.NoError(t, animal.MergeCat(Cat{Name: "Tom"}))
+	_, err = animal.AsDog()
+	assert.Error(t, err)
+	cat, err := animal.AsCat()
+	require.NoError(t, err)
+	assert.Equal(t, CatTypeCat, cat.Type)
+}
+
+func TestAnimalUnion_UnknownDiscriminator(t *testing.T) {
+	var animal Animal_OneOf
+	require.NoError(t, json.Unmarshal([]byte(`{"name":"Nemo","type":"fish"}`), &animal))
+
+	var unknown *runtime.UnknownDiscriminatorError
+	_, err := animal.ValueByDiscriminator()
+	require.ErrorAs(t, err, &unknown)
+	assert.Equal(t, "fish", unknown.Value)
+
+	_, err = animal.AsCat()
+	assert.ErrorAs(t, err, &unknown)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
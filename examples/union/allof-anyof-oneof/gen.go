@@ -29,6 +29,74 @@ func (o OrderStatus) Validate() error {
 	}
 }
 
+// orderStatusNames maps OrderStatus values to their names.
+var orderStatusNames = map[OrderStatus]string{
+	Confirmed: "Confirmed",
+	Pending:   "Pending",
+	Shipped:   "Shipped",
+}
+
+// orderStatusValues maps names to OrderStatus values.
+var orderStatusValues = map[string]OrderStatus{
+	"Confirmed": Confirmed,
+	"Pending":   Pending,
+	"Shipped":   Shipped,
+}
+
+// String returns the wire value of the OrderStatus.
+func (o OrderStatus) String() string {
+	return string(o)
+}
+
+// IsValid reports whether the OrderStatus value is defined in the spec.
+func (o OrderStatus) IsValid() bool {
+	_, ok := orderStatusNames[o]
+	return ok
+}
+
+// Values returns all the OrderStatus values defined in the spec.
+func (OrderStatus) Values() []OrderStatus {
+	return []OrderStatus{
+		Confirmed,
+		Pending,
+		Shipped,
+	}
+}
+
+// OrderStatusPtr returns a pointer to v, to set the optional and nullable OrderStatus properties.
+func OrderStatusPtr(v OrderStatus) *OrderStatus {
+	return &v
+}
+
+// Name returns the name of the OrderStatus value, or an empty string for unknown values.
+func (o OrderStatus) Name() string {
+	return orderStatusNames[o]
+}
+
+// ParseOrderStatus returns the OrderStatus matching s by wire value or by name.
+func ParseOrderStatus(s string) (OrderStatus, error) {
+	if _, ok := orderStatusNames[OrderStatus(s)]; ok {
+		return OrderStatus(s), nil
+	}
+	if v, ok := orderStatusValues[s]; ok {
+		return v, nil
+	}
+	var zero OrderStatus
+	return zero, fmt.Errorf("%w for OrderStatus: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (o OrderStatus) MarshalText() ([]byte, error) {
+	return []byte(o), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseOrderStatus.
+// Unknown values are kept as-is and reported by Validate.
+func (o *OrderStatus) UnmarshalText(text []byte) error {
+	*o = OrderStatus(text)
+	return nil
+}
+
 type GetFooResponse = map[string]any
 
 type Order struct {
@@ -229,4 +297,5 @@ var typesValidator *validator.Validate
 func init() {
 	typesValidator = validator.New(validator.WithRequiredStructEnabled())
 	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
 }
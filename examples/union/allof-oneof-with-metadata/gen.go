@@ -27,6 +27,68 @@ func (f FileType) Validate() error {
 	}
 }
 
+// fileTypeNames maps FileType values to their names.
+var fileTypeNames = map[FileType]string{
+	FileTypeFile: "File",
+}
+
+// fileTypeValues maps names to FileType values.
+var fileTypeValues = map[string]FileType{
+	"File": FileTypeFile,
+}
+
+// String returns the wire value of the FileType.
+func (f FileType) String() string {
+	return string(f)
+}
+
+// IsValid reports whether the FileType value is defined in the spec.
+func (f FileType) IsValid() bool {
+	_, ok := fileTypeNames[f]
+	return ok
+}
+
+// Values returns all the FileType values defined in the spec.
+func (FileType) Values() []FileType {
+	return []FileType{
+		FileTypeFile,
+	}
+}
+
+// FileTypePtr returns a pointer to v, to set the optional and nullable FileType properties.
+func FileTypePtr(v FileType) *FileType {
+	return &v
+}
+
+// Name returns the name of the FileType value, or an empty string for unknown values.
+func (f FileType) Name() string {
+	return fileTypeNames[f]
+}
+
+// ParseFileType returns the FileType matching s by wire value or by name.
+func ParseFileType(s string) (FileType, error) {
+	if _, ok := fileTypeNames[FileType(s)]; ok {
+		return FileType(s), nil
+	}
+	if v, ok := fileTypeValues[s]; ok {
+		return v, nil
+	}
+	var zero FileType
+	return zero, fmt.Errorf("%w for FileType: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (f FileType) MarshalText() ([]byte, error) {
+	return []byte(f), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseFileType.
+// Unknown values are kept as-is and reported by Validate.
+func (f *FileType) UnmarshalText(text []byte) error {
+	*f = FileType(text)
+	return nil
+}
+
 type FolderType string
 
 const (
@@ -43,6 +105,68 @@ func (f FolderType) Validate() error {
 	}
 }
 
+// folderTypeNames maps FolderType values to their names.
+var folderTypeNames = map[FolderType]string{
+	FolderTypeFolder: "Folder",
+}
+
+// folderTypeValues maps names to FolderType values.
+var folderTypeValues = map[string]FolderType{
+	"Folder": FolderTypeFolder,
+}
+
+// String returns the wire value of the FolderType.
+func (f FolderType) String() string {
+	return string(f)
+}
+
+// IsValid reports whether the FolderType value is defined in the spec.
+func (f FolderType) IsValid() bool {
+	_, ok := folderTypeNames[f]
+	return ok
+}
+
+// Values returns all the FolderType values defined in the spec.
+func (FolderType) Values() []FolderType {
+	return []FolderType{
+		FolderTypeFolder,
+	}
+}
+
+// FolderTypePtr returns a pointer to v, to set the optional and nullable FolderType properties.
+func FolderTypePtr(v FolderType) *FolderType {
+	return &v
+}
+
+// Name returns the name of the FolderType value, or an empty string for unknown values.
+func (f FolderType) Name() string {
+	return folderTypeNames[f]
+}
+
+// ParseFolderType returns the FolderType matching s by wire value or by name.
+func ParseFolderType(s string) (FolderType, error) {
+	if _, ok := folderTypeNames[FolderType(s)]; ok {
+		return FolderType(s), nil
+	}
+	if v, ok := folderTypeValues[s]; ok {
+		return v, nil
+	}
+	var zero FolderType
+	return zero, fmt.Errorf("%w for FolderType: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (f FolderType) MarshalText() ([]byte, error) {
+	return []byte(f), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseFolderType.
+// Unknown values are kept as-is and reported by Validate.
+func (f *FolderType) UnmarshalText(text []byte) error {
+	*f = FolderType(text)
+	return nil
+}
+
 type WebLinkType string
 
 const (
@@ -59,6 +183,68 @@ func (w WebLinkType) Validate() error {
 	}
 }
 
+// webLinkTypeNames maps WebLinkType values to their names.
+var webLinkTypeNames = map[WebLinkType]string{
+	WebLinkTypeWebLink: "WebLink",
+}
+
+// webLinkTypeValues maps names to WebLinkType values.
+var webLinkTypeValues = map[string]WebLinkType{
+	"WebLink": WebLinkTypeWebLink,
+}
+
+// String returns the wire value of the WebLinkType.
+func (w WebLinkType) String() string {
+	return string(w)
+}
+
+// IsValid reports whether the WebLinkType value is defined in the spec.
+func (w WebLinkType) IsValid() bool {
+	_, ok := webLinkTypeNames[w]
+	return ok
+}
+
+// Values returns all the WebLinkType values defined in the spec.
+func (WebLinkType) Values() []WebLinkType {
+	return []WebLinkType{
+		WebLinkTypeWebLink,
+	}
+}
+
+// WebLinkTypePtr returns a pointer to v, to set the optional and nullable WebLinkType properties.
+func WebLinkTypePtr(v WebLinkType) *WebLinkType {
+	return &v
+}
+
+// Name returns the name of the WebLinkType value, or an empty string for unknown values.
+func (w WebLinkType) Name() string {
+	return webLinkTypeNames[w]
+}
+
+// ParseWebLinkType returns the WebLinkType matching s by wire value or by name.
+func ParseWebLinkType(s string) (WebLinkType, error) {
+	if _, ok := webLinkTypeNames[WebLinkType(s)]; ok {
+		return WebLinkType(s), nil
+	}
+	if v, ok := webLinkTypeValues[s]; ok {
+		return v, nil
+	}
+	var zero WebLinkType
+	return zero, fmt.Errorf("%w for WebLinkType: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (w WebLinkType) MarshalText() ([]byte, error) {
+	return []byte(w), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseWebLinkType.
+// Unknown values are kept as-is and reported by Validate.
+func (w *WebLinkType) UnmarshalText(text []byte) error {
+	*w = WebLinkType(text)
+	return nil
+}
+
 type CollaborationRole string
 
 const (
@@ -77,6 +263,74 @@ func (c CollaborationRole) Validate() error {
 	}
 }
 
+// collaborationRoleNames maps CollaborationRole values to their names.
+var collaborationRoleNames = map[CollaborationRole]string{
+	Editor: "Editor",
+	Owner:  "Owner",
+	Viewer: "Viewer",
+}
+
+// collaborationRoleValues maps names to CollaborationRole values.
+var collaborationRoleValues = map[string]CollaborationRole{
+	"Editor": Editor,
+	"Owner":  Owner,
+	"Viewer": Viewer,
+}
+
+// String returns the wire value of the CollaborationRole.
+func (c CollaborationRole) String() string {
+	return string(c)
+}
+
+// IsValid reports whether the CollaborationRole value is defined in the spec.
+func (c CollaborationRole) IsValid() bool {
+	_, ok := collaborationRoleNames[c]
+	return ok
+}
+
+// Values returns all the CollaborationRole values defined in the spec.
+func (CollaborationRole) Values() []CollaborationRole {
+	return []CollaborationRole{
+		Editor,
+		Owner,
+		Viewer,
+	}
+}
+
+// CollaborationRolePtr returns a pointer to v, to set the optional and nullable CollaborationRole properties.
+func CollaborationRolePtr(v CollaborationRole) *CollaborationRole {
+	return &v
+}
+
+// Name returns the name of the CollaborationRole value, or an empty string for unknown values.
+func (c CollaborationRole) Name() string {
+	return collaborationRoleNames[c]
+}
+
+// ParseCollaborationRole returns the CollaborationRole matching s by wire value or by name.
+func ParseCollaborationRole(s string) (CollaborationRole, error) {
+	if _, ok := collaborationRoleNames[CollaborationRole(s)]; ok {
+		return CollaborationRole(s), nil
+	}
+	if v, ok := collaborationRoleValues[s]; ok {
+		return v, nil
+	}
+	var zero CollaborationRole
+	return zero, fmt.Errorf("%w for CollaborationRole: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (c CollaborationRole) MarshalText() ([]byte, error) {
+	return []byte(c), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseCollaborationRole.
+// Unknown values are kept as-is and reported by Validate.
+func (c *CollaborationRole) UnmarshalText(text []byte) error {
+	*c = CollaborationRole(text)
+	return nil
+}
+
 type GetCollaborationResponse = Collaboration
 
 type File struct {
@@ -328,6 +582,20 @@ func (c *Collaboration_Item_AllOf0_OneOf) FromFile(val File) error {
 	return err
 }
 
+// MergeFile merges the provided File into the union data inside the Collaboration_Item_AllOf0_OneOf
+func (c *Collaboration_Item_AllOf0_OneOf) MergeFile(val File) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(c.union, bts)
+	if err != nil {
+		return err
+	}
+	c.union = merged
+	return nil
+}
+
 // AsFolder returns the union data inside the Collaboration_Item_AllOf0_OneOf as a Folder
 func (c *Collaboration_Item_AllOf0_OneOf) AsFolder() (Folder, error) {
 	return runtime.UnmarshalAs[Folder](c.union)
@@ -358,6 +626,20 @@ func (c *Collaboration_Item_AllOf0_OneOf) FromFolder(val Folder) error {
 	return err
 }
 
+// MergeFolder merges the provided Folder into the union data inside the Collaboration_Item_AllOf0_OneOf
+func (c *Collaboration_Item_AllOf0_OneOf) MergeFolder(val Folder) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(c.union, bts)
+	if err != nil {
+		return err
+	}
+	c.union = merged
+	return nil
+}
+
 // AsWebLink returns the union data inside the Collaboration_Item_AllOf0_OneOf as a WebLink
 func (c *Collaboration_Item_AllOf0_OneOf) AsWebLink() (WebLink, error) {
 	return runtime.UnmarshalAs[WebLink](c.union)
@@ -388,6 +670,20 @@ func (c *Collaboration_Item_AllOf0_OneOf) FromWebLink(val WebLink) error {
 	return err
 }
 
+// MergeWebLink merges the provided WebLink into the union data inside the Collaboration_Item_AllOf0_OneOf
+func (c *Collaboration_Item_AllOf0_OneOf) MergeWebLink(val WebLink) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(c.union, bts)
+	if err != nil {
+		return err
+	}
+	c.union = merged
+	return nil
+}
+
 // validateFile validates a File value
 func (c *Collaboration_Item_AllOf0_OneOf) validateFile(val File) error {
 	if v, ok := any(val).(runtime.Validator); ok {
@@ -429,4 +725,5 @@ var typesValidator *validator.Validate
 func init() {
 	typesValidator = validator.New(validator.WithRequiredStructEnabled())
 	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
 }
@@ -151,6 +151,20 @@ func (n *Notification_AnyOf) FromEmailNotification(val EmailNotification) error
 	return err
 }
 
+// MergeEmailNotification merges the provided EmailNotification into the union data inside the Notification_AnyOf
+func (n *Notification_AnyOf) MergeEmailNotification(val EmailNotification) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(n.union, bts)
+	if err != nil {
+		return err
+	}
+	n.union = merged
+	return nil
+}
+
 // AsSMSNotification returns the union data inside the Notification_AnyOf as a SMSNotification
 func (n *Notification_AnyOf) AsSMSNotification() (SMSNotification, error) {
 	return runtime.UnmarshalAs[SMSNotification](n.union)
@@ -181,6 +195,20 @@ func (n *Notification_AnyOf) FromSMSNotification(val SMSNotification) error {
 	return err
 }
 
+// MergeSMSNotification merges the provided SMSNotification into the union data inside the Notification_AnyOf
+func (n *Notification_AnyOf) MergeSMSNotification(val SMSNotification) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(n.union, bts)
+	if err != nil {
+		return err
+	}
+	n.union = merged
+	return nil
+}
+
 // AsPushNotification returns the union data inside the Notification_AnyOf as a PushNotification
 func (n *Notification_AnyOf) AsPushNotification() (PushNotification, error) {
 	return runtime.UnmarshalAs[PushNotification](n.union)
@@ -211,6 +239,20 @@ func (n *Notification_AnyOf) FromPushNotification(val PushNotification) error {
 	return err
 }
 
+// MergePushNotification merges the provided PushNotification into the union data inside the Notification_AnyOf
+func (n *Notification_AnyOf) MergePushNotification(val PushNotification) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(n.union, bts)
+	if err != nil {
+		return err
+	}
+	n.union = merged
+	return nil
+}
+
 // validateEmailNotification validates a EmailNotification value
 func (n *Notification_AnyOf) validateEmailNotification(val EmailNotification) error {
 	if v, ok := any(val).(runtime.Validator); ok {
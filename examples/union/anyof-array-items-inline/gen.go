@@ -27,6 +27,68 @@ func (s SpecificErrorIssuesAnyOf0Issue) Validate() error {
 	}
 }
 
+// specificErrorIssuesAnyOf0IssueNames maps SpecificErrorIssuesAnyOf0Issue values to their names.
+var specificErrorIssuesAnyOf0IssueNames = map[SpecificErrorIssuesAnyOf0Issue]string{
+	ERRORA: "ERRORA",
+}
+
+// specificErrorIssuesAnyOf0IssueValues maps names to SpecificErrorIssuesAnyOf0Issue values.
+var specificErrorIssuesAnyOf0IssueValues = map[string]SpecificErrorIssuesAnyOf0Issue{
+	"ERRORA": ERRORA,
+}
+
+// String returns the wire value of the SpecificErrorIssuesAnyOf0Issue.
+func (s SpecificErrorIssuesAnyOf0Issue) String() string {
+	return string(s)
+}
+
+// IsValid reports whether the SpecificErrorIssuesAnyOf0Issue value is defined in the spec.
+func (s SpecificErrorIssuesAnyOf0Issue) IsValid() bool {
+	_, ok := specificErrorIssuesAnyOf0IssueNames[s]
+	return ok
+}
+
+// Values returns all the SpecificErrorIssuesAnyOf0Issue values defined in the spec.
+func (SpecificErrorIssuesAnyOf0Issue) Values() []SpecificErrorIssuesAnyOf0Issue {
+	return []SpecificErrorIssuesAnyOf0Issue{
+		ERRORA,
+	}
+}
+
+// SpecificErrorIssuesAnyOf0IssuePtr returns a pointer to v, to set the optional and nullable SpecificErrorIssuesAnyOf0Issue properties.
+func SpecificErrorIssuesAnyOf0IssuePtr(v SpecificErrorIssuesAnyOf0Issue) *SpecificErrorIssuesAnyOf0Issue {
+	return &v
+}
+
+// Name returns the name of the SpecificErrorIssuesAnyOf0Issue value, or an empty string for unknown values.
+func (s SpecificErrorIssuesAnyOf0Issue) Name() string {
+	return specificErrorIssuesAnyOf0IssueNames[s]
+}
+
+// ParseSpecificErrorIssuesAnyOf0Issue returns the SpecificErrorIssuesAnyOf0Issue matching s by wire value or by name.
+func ParseSpecificErrorIssuesAnyOf0Issue(s string) (SpecificErrorIssuesAnyOf0Issue, error) {
+	if _, ok := specificErrorIssuesAnyOf0IssueNames[SpecificErrorIssuesAnyOf0Issue(s)]; ok {
+		return SpecificErrorIssuesAnyOf0Issue(s), nil
+	}
+	if v, ok := specificErrorIssuesAnyOf0IssueValues[s]; ok {
+		return v, nil
+	}
+	var zero SpecificErrorIssuesAnyOf0Issue
+	return zero, fmt.Errorf("%w for SpecificErrorIssuesAnyOf0Issue: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (s SpecificErrorIssuesAnyOf0Issue) MarshalText() ([]byte, error) {
+	return []byte(s), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseSpecificErrorIssuesAnyOf0Issue.
+// Unknown values are kept as-is and reported by Validate.
+func (s *SpecificErrorIssuesAnyOf0Issue) UnmarshalText(text []byte) error {
+	*s = SpecificErrorIssuesAnyOf0Issue(text)
+	return nil
+}
+
 type SpecificErrorIssuesAnyOf0Description string
 
 const (
@@ -43,6 +105,68 @@ func (s SpecificErrorIssuesAnyOf0Description) Validate() error {
 	}
 }
 
+// specificErrorIssuesAnyOf0DescriptionNames maps SpecificErrorIssuesAnyOf0Description values to their names.
+var specificErrorIssuesAnyOf0DescriptionNames = map[SpecificErrorIssuesAnyOf0Description]string{
+	ThisIsErrorTypeA: "ThisIsErrorTypeA",
+}
+
+// specificErrorIssuesAnyOf0DescriptionValues maps names to SpecificErrorIssuesAnyOf0Description values.
+var specificErrorIssuesAnyOf0DescriptionValues = map[string]SpecificErrorIssuesAnyOf0Description{
+	"ThisIsErrorTypeA": ThisIsErrorTypeA,
+}
+
+// String returns the wire value of the SpecificErrorIssuesAnyOf0Description.
+func (s SpecificErrorIssuesAnyOf0Description) String() string {
+	return string(s)
+}
+
+// IsValid reports whether the SpecificErrorIssuesAnyOf0Description value is defined in the spec.
+func (s SpecificErrorIssuesAnyOf0Description) IsValid() bool {
+	_, ok := specificErrorIssuesAnyOf0DescriptionNames[s]
+	return ok
+}
+
+// Values returns all the SpecificErrorIssuesAnyOf0Description values defined in the spec.
+func (SpecificErrorIssuesAnyOf0Description) Values() []SpecificErrorIssuesAnyOf0Description {
+	return []SpecificErrorIssuesAnyOf0Description{
+		ThisIsErrorTypeA,
+	}
+}
+
+// SpecificErrorIssuesAnyOf0DescriptionPtr returns a pointer to v, to set the optional and nullable SpecificErrorIssuesAnyOf0Description properties.
+func SpecificErrorIssuesAnyOf0DescriptionPtr(v SpecificErrorIssuesAnyOf0Description) *SpecificErrorIssuesAnyOf0Description {
+	return &v
+}
+
+// Name returns the name of the SpecificErrorIssuesAnyOf0Description value, or an empty string for unknown values.
+func (s SpecificErrorIssuesAnyOf0Description) Name() string {
+	return specificErrorIssuesAnyOf0DescriptionNames[s]
+}
+
+// ParseSpecificErrorIssuesAnyOf0Description returns the SpecificErrorIssuesAnyOf0Description matching s by wire value or by name.
+func ParseSpecificErrorIssuesAnyOf0Description(s string) (SpecificErrorIssuesAnyOf0Description, error) {
+	if _, ok := specificErrorIssuesAnyOf0DescriptionNames[SpecificErrorIssuesAnyOf0Description(s)]; ok {
+		return SpecificErrorIssuesAnyOf0Description(s), nil
+	}
+	if v, ok := specificErrorIssuesAnyOf0DescriptionValues[s]; ok {
+		return v, nil
+	}
+	var zero SpecificErrorIssuesAnyOf0Description
+	return zero, fmt.Errorf("%w for SpecificErrorIssuesAnyOf0Description: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (s SpecificErrorIssuesAnyOf0Description) MarshalText() ([]byte, error) {
+	return []byte(s), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseSpecificErrorIssuesAnyOf0Description.
+// Unknown values are kept as-is and reported by Validate.
+func (s *SpecificErrorIssuesAnyOf0Description) UnmarshalText(text []byte) error {
+	*s = SpecificErrorIssuesAnyOf0Description(text)
+	return nil
+}
+
 type SpecificErrorIssuesAnyOf1Issue string
 
 const (
@@ -59,6 +183,68 @@ func (s SpecificErrorIssuesAnyOf1Issue) Validate() error {
 	}
 }
 
+// specificErrorIssuesAnyOf1IssueNames maps SpecificErrorIssuesAnyOf1Issue values to their names.
+var specificErrorIssuesAnyOf1IssueNames = map[SpecificErrorIssuesAnyOf1Issue]string{
+	ERRORB: "ERRORB",
+}
+
+// specificErrorIssuesAnyOf1IssueValues maps names to SpecificErrorIssuesAnyOf1Issue values.
+var specificErrorIssuesAnyOf1IssueValues = map[string]SpecificErrorIssuesAnyOf1Issue{
+	"ERRORB": ERRORB,
+}
+
+// String returns the wire value of the SpecificErrorIssuesAnyOf1Issue.
+func (s SpecificErrorIssuesAnyOf1Issue) String() string {
+	return string(s)
+}
+
+// IsValid reports whether the SpecificErrorIssuesAnyOf1Issue value is defined in the spec.
+func (s SpecificErrorIssuesAnyOf1Issue) IsValid() bool {
+	_, ok := specificErrorIssuesAnyOf1IssueNames[s]
+	return ok
+}
+
+// Values returns all the SpecificErrorIssuesAnyOf1Issue values defined in the spec.
+func (SpecificErrorIssuesAnyOf1Issue) Values() []SpecificErrorIssuesAnyOf1Issue {
+	return []SpecificErrorIssuesAnyOf1Issue{
+		ERRORB,
+	}
+}
+
+// SpecificErrorIssuesAnyOf1IssuePtr returns a pointer to v, to set the optional and nullable SpecificErrorIssuesAnyOf1Issue properties.
+func SpecificErrorIssuesAnyOf1IssuePtr(v SpecificErrorIssuesAnyOf1Issue) *SpecificErrorIssuesAnyOf1Issue {
+	return &v
+}
+
+// Name returns the name of the SpecificErrorIssuesAnyOf1Issue value, or an empty string for unknown values.
+func (s SpecificErrorIssuesAnyOf1Issue) Name() string {
+	return specificErrorIssuesAnyOf1IssueNames[s]
+}
+
+// ParseSpecificErrorIssuesAnyOf1Issue returns the SpecificErrorIssuesAnyOf1Issue matching s by wire value or by name.
+func ParseSpecificErrorIssuesAnyOf1Issue(s string) (SpecificErrorIssuesAnyOf1Issue, error) {
+	if _, ok := specificErrorIssuesAnyOf1IssueNames[SpecificErrorIssuesAnyOf1Issue(s)]; ok {
+		return SpecificErrorIssuesAnyOf1Issue(s), nil
+	}
+	if v, ok := specificErrorIssuesAnyOf1IssueValues[s]; ok {
+		return v, nil
+	}
+	var zero SpecificErrorIssuesAnyOf1Issue
+	return zero, fmt.Errorf("%w for SpecificErrorIssuesAnyOf1Issue: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (s SpecificErrorIssuesAnyOf1Issue) MarshalText() ([]byte, error) {
+	return []byte(s), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseSpecificErrorIssuesAnyOf1Issue.
+// Unknown values are kept as-is and reported by Validate.
+func (s *SpecificErrorIssuesAnyOf1Issue) UnmarshalText(text []byte) error {
+	*s = SpecificErrorIssuesAnyOf1Issue(text)
+	return nil
+}
+
 type SpecificErrorIssuesAnyOf1Description string
 
 const (
@@ -75,6 +261,68 @@ func (s SpecificErrorIssuesAnyOf1Description) Validate() error {
 	}
 }
 
+// specificErrorIssuesAnyOf1DescriptionNames maps SpecificErrorIssuesAnyOf1Description values to their names.
+var specificErrorIssuesAnyOf1DescriptionNames = map[SpecificErrorIssuesAnyOf1Description]string{
+	ThisIsErrorTypeB: "ThisIsErrorTypeB",
+}
+
+// specificErrorIssuesAnyOf1DescriptionValues maps names to SpecificErrorIssuesAnyOf1Description values.
+var specificErrorIssuesAnyOf1DescriptionValues = map[string]SpecificErrorIssuesAnyOf1Description{
+	"ThisIsErrorTypeB": ThisIsErrorTypeB,
+}
+
+// String returns the wire value of the SpecificErrorIssuesAnyOf1Description.
+func (s SpecificErrorIssuesAnyOf1Description) String() string {
+	return string(s)
+}
+
+// IsValid reports whether the SpecificErrorIssuesAnyOf1Description value is defined in the spec.
+func (s SpecificErrorIssuesAnyOf1Description) IsValid() bool {
+	_, ok := specificErrorIssuesAnyOf1DescriptionNames[s]
+	return ok
+}
+
+// Values returns all the SpecificErrorIssuesAnyOf1Description values defined in the spec.
+func (SpecificErrorIssuesAnyOf1Description) Values() []SpecificErrorIssuesAnyOf1Description {
+	return []SpecificErrorIssuesAnyOf1Description{
+		ThisIsErrorTypeB,
+	}
+}
+
+// SpecificErrorIssuesAnyOf1DescriptionPtr returns a pointer to v, to set the optional and nullable SpecificErrorIssuesAnyOf1Description properties.
+func SpecificErrorIssuesAnyOf1DescriptionPtr(v SpecificErrorIssuesAnyOf1Description) *SpecificErrorIssuesAnyOf1Description {
+	return &v
+}
+
+// Name returns the name of the SpecificErrorIssuesAnyOf1Description value, or an empty string for unknown values.
+func (s SpecificErrorIssuesAnyOf1Description) Name() string {
+	return specificErrorIssuesAnyOf1DescriptionNames[s]
+}
+
+// ParseSpecificErrorIssuesAnyOf1Description returns the SpecificErrorIssuesAnyOf1Description matching s by wire value or by name.
+func ParseSpecificErrorIssuesAnyOf1Description(s string) (SpecificErrorIssuesAnyOf1Description, error) {
+	if _, ok := specificErrorIssuesAnyOf1DescriptionNames[SpecificErrorIssuesAnyOf1Description(s)]; ok {
+		return SpecificErrorIssuesAnyOf1Description(s), nil
+	}
+	if v, ok := specificErrorIssuesAnyOf1DescriptionValues[s]; ok {
+		return v, nil
+	}
+	var zero SpecificErrorIssuesAnyOf1Description
+	return zero, fmt.Errorf("%w for SpecificErrorIssuesAnyOf1Description: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (s SpecificErrorIssuesAnyOf1Description) MarshalText() ([]byte, error) {
+	return []byte(s), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseSpecificErrorIssuesAnyOf1Description.
+// Unknown values are kept as-is and reported by Validate.
+func (s *SpecificErrorIssuesAnyOf1Description) UnmarshalText(text []byte) error {
+	*s = SpecificErrorIssuesAnyOf1Description(text)
+	return nil
+}
+
 type SpecificErrorIssuesAnyOf2Issue string
 
 const (
@@ -91,6 +339,68 @@ func (s SpecificErrorIssuesAnyOf2Issue) Validate() error {
 	}
 }
 
+// specificErrorIssuesAnyOf2IssueNames maps SpecificErrorIssuesAnyOf2Issue values to their names.
+var specificErrorIssuesAnyOf2IssueNames = map[SpecificErrorIssuesAnyOf2Issue]string{
+	ERRORC: "ERRORC",
+}
+
+// specificErrorIssuesAnyOf2IssueValues maps names to SpecificErrorIssuesAnyOf2Issue values.
+var specificErrorIssuesAnyOf2IssueValues = map[string]SpecificErrorIssuesAnyOf2Issue{
+	"ERRORC": ERRORC,
+}
+
+// String returns the wire value of the SpecificErrorIssuesAnyOf2Issue.
+func (s SpecificErrorIssuesAnyOf2Issue) String() string {
+	return string(s)
+}
+
+// IsValid reports whether the SpecificErrorIssuesAnyOf2Issue value is defined in the spec.
+func (s SpecificErrorIssuesAnyOf2Issue) IsValid() bool {
+	_, ok := specificErrorIssuesAnyOf2IssueNames[s]
+	return ok
+}
+
+// Values returns all the SpecificErrorIssuesAnyOf2Issue values defined in the spec.
+func (SpecificErrorIssuesAnyOf2Issue) Values() []SpecificErrorIssuesAnyOf2Issue {
+	return []SpecificErrorIssuesAnyOf2Issue{
+		ERRORC,
+	}
+}
+
+// SpecificErrorIssuesAnyOf2IssuePtr returns a pointer to v, to set the optional and nullable SpecificErrorIssuesAnyOf2Issue properties.
+func SpecificErrorIssuesAnyOf2IssuePtr(v SpecificErrorIssuesAnyOf2Issue) *SpecificErrorIssuesAnyOf2Issue {
+	return &v
+}
+
+// Name returns the name of the SpecificErrorIssuesAnyOf2Issue value, or an empty string for unknown values.
+func (s SpecificErrorIssuesAnyOf2Issue) Name() string {
+	return specificErrorIssuesAnyOf2IssueNames[s]
+}
+
+// ParseSpecificErrorIssuesAnyOf2Issue returns the SpecificErrorIssuesAnyOf2Issue matching s by wire value or by name.
+func ParseSpecificErrorIssuesAnyOf2Issue(s string) (SpecificErrorIssuesAnyOf2Issue, error) {
+	if _, ok := specificErrorIssuesAnyOf2IssueNames[SpecificErrorIssuesAnyOf2Issue(s)]; ok {
+		return SpecificErrorIssuesAnyOf2Issue(s), nil
+	}
+	if v, ok := specificErrorIssuesAnyOf2IssueValues[s]; ok {
+		return v, nil
+	}
+	var zero SpecificErrorIssuesAnyOf2Issue
+	return zero, fmt.Errorf("%w for SpecificErrorIssuesAnyOf2Issue: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (s SpecificErrorIssuesAnyOf2Issue) MarshalText() ([]byte, error) {
+	return []byte(s), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseSpecificErrorIssuesAnyOf2Issue.
+// Unknown values are kept as-is and reported by Validate.
+func (s *SpecificErrorIssuesAnyOf2Issue) UnmarshalText(text []byte) error {
+	*s = SpecificErrorIssuesAnyOf2Issue(text)
+	return nil
+}
+
 type SpecificErrorIssuesAnyOf2Description string
 
 const (
@@ -107,6 +417,68 @@ func (s SpecificErrorIssuesAnyOf2Description) Validate() error {
 	}
 }
 
+// specificErrorIssuesAnyOf2DescriptionNames maps SpecificErrorIssuesAnyOf2Description values to their names.
+var specificErrorIssuesAnyOf2DescriptionNames = map[SpecificErrorIssuesAnyOf2Description]string{
+	ThisIsErrorTypeC: "ThisIsErrorTypeC",
+}
+
+// specificErrorIssuesAnyOf2DescriptionValues maps names to SpecificErrorIssuesAnyOf2Description values.
+var specificErrorIssuesAnyOf2DescriptionValues = map[string]SpecificErrorIssuesAnyOf2Description{
+	"ThisIsErrorTypeC": ThisIsErrorTypeC,
+}
+
+// String returns the wire value of the SpecificErrorIssuesAnyOf2Description.
+func (s SpecificErrorIssuesAnyOf2Description) String() string {
+	return string(s)
+}
+
+// IsValid reports whether the SpecificErrorIssuesAnyOf2Description value is defined in the spec.
+func (s SpecificErrorIssuesAnyOf2Description) IsValid() bool {
+	_, ok := specificErrorIssuesAnyOf2DescriptionNames[s]
+	return ok
+}
+
+// Values returns all the SpecificErrorIssuesAnyOf2Description values defined in the spec.
+func (SpecificErrorIssuesAnyOf2Description) Values() []SpecificErrorIssuesAnyOf2Description {
+	return []SpecificErrorIssuesAnyOf2Description{
+		ThisIsErrorTypeC,
+	}
+}
+
+// SpecificErrorIssuesAnyOf2DescriptionPtr returns a pointer to v, to set the optional and nullable SpecificErrorIssuesAnyOf2Description properties.
+func SpecificErrorIssuesAnyOf2DescriptionPtr(v SpecificErrorIssuesAnyOf2Description) *SpecificErrorIssuesAnyOf2Description {
+	return &v
+}
+
+// Name returns the name of the SpecificErrorIssuesAnyOf2Description value, or an empty string for unknown values.
+func (s SpecificErrorIssuesAnyOf2Description) Name() string {
+	return specificErrorIssuesAnyOf2DescriptionNames[s]
+}
+
+// ParseSpecificErrorIssuesAnyOf2Description returns the SpecificErrorIssuesAnyOf2Description matching s by wire value or by name.
+func ParseSpecificErrorIssuesAnyOf2Description(s string) (SpecificErrorIssuesAnyOf2Description, error) {
+	if _, ok := specificErrorIssuesAnyOf2DescriptionNames[SpecificErrorIssuesAnyOf2Description(s)]; ok {
+		return SpecificErrorIssuesAnyOf2Description(s), nil
+	}
+	if v, ok := specificErrorIssuesAnyOf2DescriptionValues[s]; ok {
+		return v, nil
+	}
+	var zero SpecificErrorIssuesAnyOf2Description
+	return zero, fmt.Errorf("%w for SpecificErrorIssuesAnyOf2Description: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (s SpecificErrorIssuesAnyOf2Description) MarshalText() ([]byte, error) {
+	return []byte(s), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseSpecificErrorIssuesAnyOf2Description.
+// Unknown values are kept as-is and reported by Validate.
+func (s *SpecificErrorIssuesAnyOf2Description) UnmarshalText(text []byte) error {
+	*s = SpecificErrorIssuesAnyOf2Description(text)
+	return nil
+}
+
 type CombinedErrorIssuesAnyOf0Issue string
 
 const (
@@ -123,6 +495,68 @@ func (c CombinedErrorIssuesAnyOf0Issue) Validate() error {
 	}
 }
 
+// combinedErrorIssuesAnyOf0IssueNames maps CombinedErrorIssuesAnyOf0Issue values to their names.
+var combinedErrorIssuesAnyOf0IssueNames = map[CombinedErrorIssuesAnyOf0Issue]string{
+	CombinedErrorIssuesAnyOf0IssueERRORA: "ERRORA",
+}
+
+// combinedErrorIssuesAnyOf0IssueValues maps names to CombinedErrorIssuesAnyOf0Issue values.
+var combinedErrorIssuesAnyOf0IssueValues = map[string]CombinedErrorIssuesAnyOf0Issue{
+	"ERRORA": CombinedErrorIssuesAnyOf0IssueERRORA,
+}
+
+// String returns the wire value of the CombinedErrorIssuesAnyOf0Issue.
+func (c CombinedErrorIssuesAnyOf0Issue) String() string {
+	return string(c)
+}
+
+// IsValid reports whether the CombinedErrorIssuesAnyOf0Issue value is defined in the spec.
+func (c CombinedErrorIssuesAnyOf0Issue) IsValid() bool {
+	_, ok := combinedErrorIssuesAnyOf0IssueNames[c]
+	return ok
+}
+
+// Values returns all the CombinedErrorIssuesAnyOf0Issue values defined in the spec.
+func (CombinedErrorIssuesAnyOf0Issue) Values() []CombinedErrorIssuesAnyOf0Issue {
+	return []CombinedErrorIssuesAnyOf0Issue{
+		CombinedErrorIssuesAnyOf0IssueERRORA,
+	}
+}
+
+// CombinedErrorIssuesAnyOf0IssuePtr returns a pointer to v, to set the optional and nullable CombinedErrorIssuesAnyOf0Issue properties.
+func CombinedErrorIssuesAnyOf0IssuePtr(v CombinedErrorIssuesAnyOf0Issue) *CombinedErrorIssuesAnyOf0Issue {
+	return &v
+}
+
+// Name returns the name of the CombinedErrorIssuesAnyOf0Issue value, or an empty string for unknown values.
+func (c CombinedErrorIssuesAnyOf0Issue) Name() string {
+	return combinedErrorIssuesAnyOf0IssueNames[c]
+}
+
+// ParseCombinedErrorIssuesAnyOf0Issue returns the CombinedErrorIssuesAnyOf0Issue matching s by wire value or by name.
+func ParseCombinedErrorIssuesAnyOf0Issue(s string) (CombinedErrorIssuesAnyOf0Issue, error) {
+	if _, ok := combinedErrorIssuesAnyOf0IssueNames[CombinedErrorIssuesAnyOf0Issue(s)]; ok {
+		return CombinedErrorIssuesAnyOf0Issue(s), nil
+	}
+	if v, ok := combinedErrorIssuesAnyOf0IssueValues[s]; ok {
+		return v, nil
+	}
+	var zero CombinedErrorIssuesAnyOf0Issue
+	return zero, fmt.Errorf("%w for CombinedErrorIssuesAnyOf0Issue: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (c CombinedErrorIssuesAnyOf0Issue) MarshalText() ([]byte, error) {
+	return []byte(c), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseCombinedErrorIssuesAnyOf0Issue.
+// Unknown values are kept as-is and reported by Validate.
+func (c *CombinedErrorIssuesAnyOf0Issue) UnmarshalText(text []byte) error {
+	*c = CombinedErrorIssuesAnyOf0Issue(text)
+	return nil
+}
+
 type CombinedErrorIssuesAnyOf0Description string
 
 const (
@@ -139,6 +573,68 @@ func (c CombinedErrorIssuesAnyOf0Description) Validate() error {
 	}
 }
 
+// combinedErrorIssuesAnyOf0DescriptionNames maps CombinedErrorIssuesAnyOf0Description values to their names.
+var combinedErrorIssuesAnyOf0DescriptionNames = map[CombinedErrorIssuesAnyOf0Description]string{
+	CombinedErrorIssuesAnyOf0DescriptionThisIsErrorTypeA: "ThisIsErrorTypeA",
+}
+
+// combinedErrorIssuesAnyOf0DescriptionValues maps names to CombinedErrorIssuesAnyOf0Description values.
+var combinedErrorIssuesAnyOf0DescriptionValues = map[string]CombinedErrorIssuesAnyOf0Description{
+	"ThisIsErrorTypeA": CombinedErrorIssuesAnyOf0DescriptionThisIsErrorTypeA,
+}
+
+// String returns the wire value of the CombinedErrorIssuesAnyOf0Description.
+func (c CombinedErrorIssuesAnyOf0Description) String() string {
+	return string(c)
+}
+
+// IsValid reports whether the CombinedErrorIssuesAnyOf0Description value is defined in the spec.
+func (c CombinedErrorIssuesAnyOf0Description) IsValid() bool {
+	_, ok := combinedErrorIssuesAnyOf0DescriptionNames[c]
+	return ok
+}
+
+// Values returns all the CombinedErrorIssuesAnyOf0Description values defined in the spec.
+func (CombinedErrorIssuesAnyOf0Description) Values() []CombinedErrorIssuesAnyOf0Description {
+	return []CombinedErrorIssuesAnyOf0Description{
+		CombinedErrorIssuesAnyOf0DescriptionThisIsErrorTypeA,
+	}
+}
+
+// CombinedErrorIssuesAnyOf0DescriptionPtr returns a pointer to v, to set the optional and nullable CombinedErrorIssuesAnyOf0Description properties.
+func CombinedErrorIssuesAnyOf0DescriptionPtr(v CombinedErrorIssuesAnyOf0Description) *CombinedErrorIssuesAnyOf0Description {
+	return &v
+}
+
+// Name returns the name of the CombinedErrorIssuesAnyOf0Description value, or an empty string for unknown values.
+func (c CombinedErrorIssuesAnyOf0Description) Name() string {
+	return combinedErrorIssuesAnyOf0DescriptionNames[c]
+}
+
+// ParseCombinedErrorIssuesAnyOf0Description returns the CombinedErrorIssuesAnyOf0Description matching s by wire value or by name.
+func ParseCombinedErrorIssuesAnyOf0Description(s string) (CombinedErrorIssuesAnyOf0Description, error) {
+	if _, ok := combinedErrorIssuesAnyOf0DescriptionNames[CombinedErrorIssuesAnyOf0Description(s)]; ok {
+		return CombinedErrorIssuesAnyOf0Description(s), nil
+	}
+	if v, ok := combinedErrorIssuesAnyOf0DescriptionValues[s]; ok {
+		return v, nil
+	}
+	var zero CombinedErrorIssuesAnyOf0Description
+	return zero, fmt.Errorf("%w for CombinedErrorIssuesAnyOf0Description: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (c CombinedErrorIssuesAnyOf0Description) MarshalText() ([]byte, error) {
+	return []byte(c), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseCombinedErrorIssuesAnyOf0Description.
+// Unknown values are kept as-is and reported by Validate.
+func (c *CombinedErrorIssuesAnyOf0Description) UnmarshalText(text []byte) error {
+	*c = CombinedErrorIssuesAnyOf0Description(text)
+	return nil
+}
+
 type CombinedErrorIssuesAnyOf1Issue string
 
 const (
@@ -155,6 +651,68 @@ func (c CombinedErrorIssuesAnyOf1Issue) Validate() error {
 	}
 }
 
+// combinedErrorIssuesAnyOf1IssueNames maps CombinedErrorIssuesAnyOf1Issue values to their names.
+var combinedErrorIssuesAnyOf1IssueNames = map[CombinedErrorIssuesAnyOf1Issue]string{
+	CombinedErrorIssuesAnyOf1IssueERRORB: "ERRORB",
+}
+
+// combinedErrorIssuesAnyOf1IssueValues maps names to CombinedErrorIssuesAnyOf1Issue values.
+var combinedErrorIssuesAnyOf1IssueValues = map[string]CombinedErrorIssuesAnyOf1Issue{
+	"ERRORB": CombinedErrorIssuesAnyOf1IssueERRORB,
+}
+
+// String returns the wire value of the CombinedErrorIssuesAnyOf1Issue.
+func (c CombinedErrorIssuesAnyOf1Issue) String() string {
+	return string(c)
+}
+
+// IsValid reports whether the CombinedErrorIssuesAnyOf1Issue value is defined in the spec.
+func (c CombinedErrorIssuesAnyOf1Issue) IsValid() bool {
+	_, ok := combinedErrorIssuesAnyOf1IssueNames[c]
+	return ok
+}
+
+// Values returns all the CombinedErrorIssuesAnyOf1Issue values defined in the spec.
+func (CombinedErrorIssuesAnyOf1Issue) Values() []CombinedErrorIssuesAnyOf1Issue {
+	return []CombinedErrorIssuesAnyOf1Issue{
+		CombinedErrorIssuesAnyOf1IssueERRORB,
+	}
+}
+
+// CombinedErrorIssuesAnyOf1IssuePtr returns a pointer to v, to set the optional and nullable CombinedErrorIssuesAnyOf1Issue properties.
+func CombinedErrorIssuesAnyOf1IssuePtr(v CombinedErrorIssuesAnyOf1Issue) *CombinedErrorIssuesAnyOf1Issue {
+	return &v
+}
+
+// Name returns the name of the CombinedErrorIssuesAnyOf1Issue value, or an empty string for unknown values.
+func (c CombinedErrorIssuesAnyOf1Issue) Name() string {
+	return combinedErrorIssuesAnyOf1IssueNames[c]
+}
+
+// ParseCombinedErrorIssuesAnyOf1Issue returns the CombinedErrorIssuesAnyOf1Issue matching s by wire value or by name.
+func ParseCombinedErrorIssuesAnyOf1Issue(s string) (CombinedErrorIssuesAnyOf1Issue, error) {
+	if _, ok := combinedErrorIssuesAnyOf1IssueNames[CombinedErrorIssuesAnyOf1Issue(s)]; ok {
+		return CombinedErrorIssuesAnyOf1Issue(s), nil
+	}
+	if v, ok := combinedErrorIssuesAnyOf1IssueValues[s]; ok {
+		return v, nil
+	}
+	var zero CombinedErrorIssuesAnyOf1Issue
+	return zero, fmt.Errorf("%w for CombinedErrorIssuesAnyOf1Issue: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (c CombinedErrorIssuesAnyOf1Issue) MarshalText() ([]byte, error) {
+	return []byte(c), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseCombinedErrorIssuesAnyOf1Issue.
+// Unknown values are kept as-is and reported by Validate.
+func (c *CombinedErrorIssuesAnyOf1Issue) UnmarshalText(text []byte) error {
+	*c = CombinedErrorIssuesAnyOf1Issue(text)
+	return nil
+}
+
 type CombinedErrorIssuesAnyOf1Description string
 
 const (
@@ -171,6 +729,68 @@ func (c CombinedErrorIssuesAnyOf1Description) Validate() error {
 	}
 }
 
+// combinedErrorIssuesAnyOf1DescriptionNames maps CombinedErrorIssuesAnyOf1Description values to their names.
+var combinedErrorIssuesAnyOf1DescriptionNames = map[CombinedErrorIssuesAnyOf1Description]string{
+	CombinedErrorIssuesAnyOf1DescriptionThisIsErrorTypeB: "ThisIsErrorTypeB",
+}
+
+// combinedErrorIssuesAnyOf1DescriptionValues maps names to CombinedErrorIssuesAnyOf1Description values.
+var combinedErrorIssuesAnyOf1DescriptionValues = map[string]CombinedErrorIssuesAnyOf1Description{
+	"ThisIsErrorTypeB": CombinedErrorIssuesAnyOf1DescriptionThisIsErrorTypeB,
+}
+
+// String returns the wire value of the CombinedErrorIssuesAnyOf1Description.
+func (c CombinedErrorIssuesAnyOf1Description) String() string {
+	return string(c)
+}
+
+// IsValid reports whether the CombinedErrorIssuesAnyOf1Description value is defined in the spec.
+func (c CombinedErrorIssuesAnyOf1Description) IsValid() bool {
+	_, ok := combinedErrorIssuesAnyOf1DescriptionNames[c]
+	return ok
+}
+
+// Values returns all the CombinedErrorIssuesAnyOf1Description values defined in the spec.
+func (CombinedErrorIssuesAnyOf1Description) Values() []CombinedErrorIssuesAnyOf1Description {
+	return []CombinedErrorIssuesAnyOf1Description{
+		CombinedErrorIssuesAnyOf1DescriptionThisIsErrorTypeB,
+	}
+}
+
+// CombinedErrorIssuesAnyOf1DescriptionPtr returns a pointer to v, to set the optional and nullable CombinedErrorIssuesAnyOf1Description properties.
+func CombinedErrorIssuesAnyOf1DescriptionPtr(v CombinedErrorIssuesAnyOf1Description) *CombinedErrorIssuesAnyOf1Description {
+	return &v
+}
+
+// Name returns the name of the CombinedErrorIssuesAnyOf1Description value, or an empty string for unknown values.
+func (c CombinedErrorIssuesAnyOf1Description) Name() string {
+	return combinedErrorIssuesAnyOf1DescriptionNames[c]
+}
+
+// ParseCombinedErrorIssuesAnyOf1Description returns the CombinedErrorIssuesAnyOf1Description matching s by wire value or by name.
+func ParseCombinedErrorIssuesAnyOf1Description(s string) (CombinedErrorIssuesAnyOf1Description, error) {
+	if _, ok := combinedErrorIssuesAnyOf1DescriptionNames[CombinedErrorIssuesAnyOf1Description(s)]; ok {
+		return CombinedErrorIssuesAnyOf1Description(s), nil
+	}
+	if v, ok := combinedErrorIssuesAnyOf1DescriptionValues[s]; ok {
+		return v, nil
+	}
+	var zero CombinedErrorIssuesAnyOf1Description
+	return zero, fmt.Errorf("%w for CombinedErrorIssuesAnyOf1Description: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (c CombinedErrorIssuesAnyOf1Description) MarshalText() ([]byte, error) {
+	return []byte(c), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseCombinedErrorIssuesAnyOf1Description.
+// Unknown values are kept as-is and reported by Validate.
+func (c *CombinedErrorIssuesAnyOf1Description) UnmarshalText(text []byte) error {
+	*c = CombinedErrorIssuesAnyOf1Description(text)
+	return nil
+}
+
 type CombinedErrorIssuesAnyOf2Issue string
 
 const (
@@ -187,6 +807,68 @@ func (c CombinedErrorIssuesAnyOf2Issue) Validate() error {
 	}
 }
 
+// combinedErrorIssuesAnyOf2IssueNames maps CombinedErrorIssuesAnyOf2Issue values to their names.
+var combinedErrorIssuesAnyOf2IssueNames = map[CombinedErrorIssuesAnyOf2Issue]string{
+	CombinedErrorIssuesAnyOf2IssueERRORC: "ERRORC",
+}
+
+// combinedErrorIssuesAnyOf2IssueValues maps names to CombinedErrorIssuesAnyOf2Issue values.
+var combinedErrorIssuesAnyOf2IssueValues = map[string]CombinedErrorIssuesAnyOf2Issue{
+	"ERRORC": CombinedErrorIssuesAnyOf2IssueERRORC,
+}
+
+// String returns the wire value of the CombinedErrorIssuesAnyOf2Issue.
+func (c CombinedErrorIssuesAnyOf2Issue) String() string {
+	return string(c)
+}
+
+// IsValid reports whether the CombinedErrorIssuesAnyOf2Issue value is defined in the spec.
+func (c CombinedErrorIssuesAnyOf2Issue) IsValid() bool {
+	_, ok := combinedErrorIssuesAnyOf2IssueNames[c]
+	return ok
+}
+
+// Values returns all the CombinedErrorIssuesAnyOf2Issue values defined in the spec.
+func (CombinedErrorIssuesAnyOf2Issue) Values() []CombinedErrorIssuesAnyOf2Issue {
+	return []CombinedErrorIssuesAnyOf2Issue{
+		CombinedErrorIssuesAnyOf2IssueERRORC,
+	}
+}
+
+// CombinedErrorIssuesAnyOf2IssuePtr returns a pointer to v, to set the optional and nullable CombinedErrorIssuesAnyOf2Issue properties.
+func CombinedErrorIssuesAnyOf2IssuePtr(v CombinedErrorIssuesAnyOf2Issue) *CombinedErrorIssuesAnyOf2Issue {
+	return &v
+}
+
+// Name returns the name of the CombinedErrorIssuesAnyOf2Issue value, or an empty string for unknown values.
+func (c CombinedErrorIssuesAnyOf2Issue) Name() string {
+	return combinedErrorIssuesAnyOf2IssueNames[c]
+}
+
+// ParseCombinedErrorIssuesAnyOf2Issue returns the CombinedErrorIssuesAnyOf2Issue matching s by wire value or by name.
+func ParseCombinedErrorIssuesAnyOf2Issue(s string) (CombinedErrorIssuesAnyOf2Issue, error) {
+	if _, ok := combinedErrorIssuesAnyOf2IssueNames[CombinedErrorIssuesAnyOf2Issue(s)]; ok {
+		return CombinedErrorIssuesAnyOf2Issue(s), nil
+	}
+	if v, ok := combinedErrorIssuesAnyOf2IssueValues[s]; ok {
+		return v, nil
+	}
+	var zero CombinedErrorIssuesAnyOf2Issue
+	return zero, fmt.Errorf("%w for CombinedErrorIssuesAnyOf2Issue: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (c CombinedErrorIssuesAnyOf2Issue) MarshalText() ([]byte, error) {
+	return []byte(c), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseCombinedErrorIssuesAnyOf2Issue.
+// Unknown values are kept as-is and reported by Validate.
+func (c *CombinedErrorIssuesAnyOf2Issue) UnmarshalText(text []byte) error {
+	*c = CombinedErrorIssuesAnyOf2Issue(text)
+	return nil
+}
+
 type CombinedErrorIssuesAnyOf2Description string
 
 const (
@@ -203,6 +885,68 @@ func (c CombinedErrorIssuesAnyOf2Description) Validate() error {
 	}
 }
 
+// combinedErrorIssuesAnyOf2DescriptionNames maps CombinedErrorIssuesAnyOf2Description values to their names.
+var combinedErrorIssuesAnyOf2DescriptionNames = map[CombinedErrorIssuesAnyOf2Description]string{
+	CombinedErrorIssuesAnyOf2DescriptionThisIsErrorTypeC: "ThisIsErrorTypeC",
+}
+
+// combinedErrorIssuesAnyOf2DescriptionValues maps names to CombinedErrorIssuesAnyOf2Description values.
+var combinedErrorIssuesAnyOf2DescriptionValues = map[string]CombinedErrorIssuesAnyOf2Description{
+	"ThisIsErrorTypeC": CombinedErrorIssuesAnyOf2DescriptionThisIsErrorTypeC,
+}
+
+// String returns the wire value of the CombinedErrorIssuesAnyOf2Description.
+func (c CombinedErrorIssuesAnyOf2Description) String() string {
+	return string(c)
+}
+
+// IsValid reports whether the CombinedErrorIssuesAnyOf2Description value is defined in the spec.
+func (c CombinedErrorIssuesAnyOf2Description) IsValid() bool {
+	_, ok := combinedErrorIssuesAnyOf2DescriptionNames[c]
+	return ok
+}
+
+// Values returns all the CombinedErrorIssuesAnyOf2Description values defined in the spec.
+func (CombinedErrorIssuesAnyOf2Description) Values() []CombinedErrorIssuesAnyOf2Description {
+	return []CombinedErrorIssuesAnyOf2Description{
+		CombinedErrorIssuesAnyOf2DescriptionThisIsErrorTypeC,
+	}
+}
+
+// CombinedErrorIssuesAnyOf2DescriptionPtr returns a pointer to v, to set the optional and nullable CombinedErrorIssuesAnyOf2Description properties.
+func CombinedErrorIssuesAnyOf2DescriptionPtr(v CombinedErrorIssuesAnyOf2Description) *CombinedErrorIssuesAnyOf2Description {
+	return &v
+}
+
+// Name returns the name of the CombinedErrorIssuesAnyOf2Description value, or an empty string for unknown values.
+func (c CombinedErrorIssuesAnyOf2Description) Name() string {
+	return combinedErrorIssuesAnyOf2DescriptionNames[c]
+}
+
+// ParseCombinedErrorIssuesAnyOf2Description returns the CombinedErrorIssuesAnyOf2Description matching s by wire value or by name.
+func ParseCombinedErrorIssuesAnyOf2Description(s string) (CombinedErrorIssuesAnyOf2Description, error) {
+	if _, ok := combinedErrorIssuesAnyOf2DescriptionNames[CombinedErrorIssuesAnyOf2Description(s)]; ok {
+		return CombinedErrorIssuesAnyOf2Description(s), nil
+	}
+	if v, ok := combinedErrorIssuesAnyOf2DescriptionValues[s]; ok {
+		return v, nil
+	}
+	var zero CombinedErrorIssuesAnyOf2Description
+	return zero, fmt.Errorf("%w for CombinedErrorIssuesAnyOf2Description: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (c CombinedErrorIssuesAnyOf2Description) MarshalText() ([]byte, error) {
+	return []byte(c), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseCombinedErrorIssuesAnyOf2Description.
+// Unknown values are kept as-is and reported by Validate.
+func (c *CombinedErrorIssuesAnyOf2Description) UnmarshalText(text []byte) error {
+	*c = CombinedErrorIssuesAnyOf2Description(text)
+	return nil
+}
+
 type TestEndpointBody = CombinedError
 
 type BaseError struct {
@@ -627,6 +1371,20 @@ func (s *SpecificError_Issues_AnyOf) FromSpecificError_Issues_AnyOf_0(val Specif
 	return err
 }
 
+// MergeSpecificError_Issues_AnyOf_0 merges the provided SpecificError_Issues_AnyOf_0 into the union data inside the SpecificError_Issues_AnyOf
+func (s *SpecificError_Issues_AnyOf) MergeSpecificError_Issues_AnyOf_0(val SpecificError_Issues_AnyOf_0) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(s.union, bts)
+	if err != nil {
+		return err
+	}
+	s.union = merged
+	return nil
+}
+
 // AsSpecificError_Issues_AnyOf_1 returns the union data inside the SpecificError_Issues_AnyOf as a SpecificError_Issues_AnyOf_1
 func (s *SpecificError_Issues_AnyOf) AsSpecificError_Issues_AnyOf_1() (SpecificError_Issues_AnyOf_1, error) {
 	return runtime.UnmarshalAs[SpecificError_Issues_AnyOf_1](s.union)
@@ -657,6 +1415,20 @@ func (s *SpecificError_Issues_AnyOf) FromSpecificError_Issues_AnyOf_1(val Specif
 	return err
 }
 
+// MergeSpecificError_Issues_AnyOf_1 merges the provided SpecificError_Issues_AnyOf_1 into the union data inside the SpecificError_Issues_AnyOf
+func (s *SpecificError_Issues_AnyOf) MergeSpecificError_Issues_AnyOf_1(val SpecificError_Issues_AnyOf_1) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(s.union, bts)
+	if err != nil {
+		return err
+	}
+	s.union = merged
+	return nil
+}
+
 // AsSpecificError_Issues_AnyOf_2 returns the union data inside the SpecificError_Issues_AnyOf as a SpecificError_Issues_AnyOf_2
 func (s *SpecificError_Issues_AnyOf) AsSpecificError_Issues_AnyOf_2() (SpecificError_Issues_AnyOf_2, error) {
 	return runtime.UnmarshalAs[SpecificError_Issues_AnyOf_2](s.union)
@@ -687,6 +1459,20 @@ func (s *SpecificError_Issues_AnyOf) FromSpecificError_Issues_AnyOf_2(val Specif
 	return err
 }
 
+// MergeSpecificError_Issues_AnyOf_2 merges the provided SpecificError_Issues_AnyOf_2 into the union data inside the SpecificError_Issues_AnyOf
+func (s *SpecificError_Issues_AnyOf) MergeSpecificError_Issues_AnyOf_2(val SpecificError_Issues_AnyOf_2) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(s.union, bts)
+	if err != nil {
+		return err
+	}
+	s.union = merged
+	return nil
+}
+
 // validateSpecificError_Issues_AnyOf_0 validates a SpecificError_Issues_AnyOf_0 value
 func (s *SpecificError_Issues_AnyOf) validateSpecificError_Issues_AnyOf_0(val SpecificError_Issues_AnyOf_0) error {
 	if v, ok := any(val).(runtime.Validator); ok {
@@ -769,6 +1555,20 @@ func (c *CombinedError_Issues_AnyOf) FromCombinedError_Issues_AnyOf_0(val Combin
 	return err
 }
 
+// MergeCombinedError_Issues_AnyOf_0 merges the provided CombinedError_Issues_AnyOf_0 into the union data inside the CombinedError_Issues_AnyOf
+func (c *CombinedError_Issues_AnyOf) MergeCombinedError_Issues_AnyOf_0(val CombinedError_Issues_AnyOf_0) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(c.union, bts)
+	if err != nil {
+		return err
+	}
+	c.union = merged
+	return nil
+}
+
 // AsCombinedError_Issues_AnyOf_1 returns the union data inside the CombinedError_Issues_AnyOf as a CombinedError_Issues_AnyOf_1
 func (c *CombinedError_Issues_AnyOf) AsCombinedError_Issues_AnyOf_1() (CombinedError_Issues_AnyOf_1, error) {
 	return runtime.UnmarshalAs[CombinedError_Issues_AnyOf_1](c.union)
@@ -799,6 +1599,20 @@ func (c *CombinedError_Issues_AnyOf) FromCombinedError_Issues_AnyOf_1(val Combin
 	return err
 }
 
+// MergeCombinedError_Issues_AnyOf_1 merges the provided CombinedError_Issues_AnyOf_1 into the union data inside the CombinedError_Issues_AnyOf
+func (c *CombinedError_Issues_AnyOf) MergeCombinedError_Issues_AnyOf_1(val CombinedError_Issues_AnyOf_1) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(c.union, bts)
+	if err != nil {
+		return err
+	}
+	c.union = merged
+	return nil
+}
+
 // AsCombinedError_Issues_AnyOf_2 returns the union data inside the CombinedError_Issues_AnyOf as a CombinedError_Issues_AnyOf_2
 func (c *CombinedError_Issues_AnyOf) AsCombinedError_Issues_AnyOf_2() (CombinedError_Issues_AnyOf_2, error) {
 	return runtime.UnmarshalAs[CombinedError_Issues_AnyOf_2](c.union)
@@ -829,6 +1643,20 @@ func (c *CombinedError_Issues_AnyOf) FromCombinedError_Issues_AnyOf_2(val Combin
 	return err
 }
 
+// MergeCombinedError_Issues_AnyOf_2 merges the provided CombinedError_Issues_AnyOf_2 into the union data inside the CombinedError_Issues_AnyOf
+func (c *CombinedError_Issues_AnyOf) MergeCombinedError_Issues_AnyOf_2(val CombinedError_Issues_AnyOf_2) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(c.union, bts)
+	if err != nil {
+		return err
+	}
+	c.union = merged
+	return nil
+}
+
 // validateCombinedError_Issues_AnyOf_0 validates a CombinedError_Issues_AnyOf_0 value
 func (c *CombinedError_Issues_AnyOf) validateCombinedError_Issues_AnyOf_0(val CombinedError_Issues_AnyOf_0) error {
 	if v, ok := any(val).(runtime.Validator); ok {
@@ -870,4 +1698,5 @@ var typesValidator *validator.Validate
 func init() {
 	typesValidator = validator.New(validator.WithRequiredStructEnabled())
 	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
 }
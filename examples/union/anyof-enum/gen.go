@@ -29,6 +29,74 @@ func (r RenderingOptionsAnyOf0AmountTaxDisplay) Validate() error {
 	}
 }
 
+// renderingOptionsAnyOf0AmountTaxDisplayNames maps RenderingOptionsAnyOf0AmountTaxDisplay values to their names.
+var renderingOptionsAnyOf0AmountTaxDisplayNames = map[RenderingOptionsAnyOf0AmountTaxDisplay]string{
+	Empty:               "Empty",
+	ExcludeTax:          "ExcludeTax",
+	IncludeInclusiveTax: "IncludeInclusiveTax",
+}
+
+// renderingOptionsAnyOf0AmountTaxDisplayValues maps names to RenderingOptionsAnyOf0AmountTaxDisplay values.
+var renderingOptionsAnyOf0AmountTaxDisplayValues = map[string]RenderingOptionsAnyOf0AmountTaxDisplay{
+	"Empty":               Empty,
+	"ExcludeTax":          ExcludeTax,
+	"IncludeInclusiveTax": IncludeInclusiveTax,
+}
+
+// String returns the wire value of the RenderingOptionsAnyOf0AmountTaxDisplay.
+func (r RenderingOptionsAnyOf0AmountTaxDisplay) String() string {
+	return string(r)
+}
+
+// IsValid reports whether the RenderingOptionsAnyOf0AmountTaxDisplay value is defined in the spec.
+func (r RenderingOptionsAnyOf0AmountTaxDisplay) IsValid() bool {
+	_, ok := renderingOptionsAnyOf0AmountTaxDisplayNames[r]
+	return ok
+}
+
+// Values returns all the RenderingOptionsAnyOf0AmountTaxDisplay values defined in the spec.
+func (RenderingOptionsAnyOf0AmountTaxDisplay) Values() []RenderingOptionsAnyOf0AmountTaxDisplay {
+	return []RenderingOptionsAnyOf0AmountTaxDisplay{
+		Empty,
+		ExcludeTax,
+		IncludeInclusiveTax,
+	}
+}
+
+// RenderingOptionsAnyOf0AmountTaxDisplayPtr returns a pointer to v, to set the optional and nullable RenderingOptionsAnyOf0AmountTaxDisplay properties.
+func RenderingOptionsAnyOf0AmountTaxDisplayPtr(v RenderingOptionsAnyOf0AmountTaxDisplay) *RenderingOptionsAnyOf0AmountTaxDisplay {
+	return &v
+}
+
+// Name returns the name of the RenderingOptionsAnyOf0AmountTaxDisplay value, or an empty string for unknown values.
+func (r RenderingOptionsAnyOf0AmountTaxDisplay) Name() string {
+	return renderingOptionsAnyOf0AmountTaxDisplayNames[r]
+}
+
+// ParseRenderingOptionsAnyOf0AmountTaxDisplay returns the RenderingOptionsAnyOf0AmountTaxDisplay matching s by wire value or by name.
+func ParseRenderingOptionsAnyOf0AmountTaxDisplay(s string) (RenderingOptionsAnyOf0AmountTaxDisplay, error) {
+	if _, ok := renderingOptionsAnyOf0AmountTaxDisplayNames[RenderingOptionsAnyOf0AmountTaxDisplay(s)]; ok {
+		return RenderingOptionsAnyOf0AmountTaxDisplay(s), nil
+	}
+	if v, ok := renderingOptionsAnyOf0AmountTaxDisplayValues[s]; ok {
+		return v, nil
+	}
+	var zero RenderingOptionsAnyOf0AmountTaxDisplay
+	return zero, fmt.Errorf("%w for RenderingOptionsAnyOf0AmountTaxDisplay: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (r RenderingOptionsAnyOf0AmountTaxDisplay) MarshalText() ([]byte, error) {
+	return []byte(r), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseRenderingOptionsAnyOf0AmountTaxDisplay.
+// Unknown values are kept as-is and reported by Validate.
+func (r *RenderingOptionsAnyOf0AmountTaxDisplay) UnmarshalText(text []byte) error {
+	*r = RenderingOptionsAnyOf0AmountTaxDisplay(text)
+	return nil
+}
+
 type GetFooResponse = map[string]any
 
 type Rendering struct {
@@ -151,4 +219,5 @@ var typesValidator *validator.Validate
 func init() {
 	typesValidator = validator.New(validator.WithRequiredStructEnabled())
 	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
 }
@@ -292,6 +292,20 @@ func (g *GetConfig_Response_Config_AnyOf) FromGetConfig_Response_Config_AnyOf_0(
 	return err
 }
 
+// MergeGetConfig_Response_Config_AnyOf_0 merges the provided GetConfig_Response_Config_AnyOf_0 into the union data inside the GetConfig_Response_Config_AnyOf
+func (g *GetConfig_Response_Config_AnyOf) MergeGetConfig_Response_Config_AnyOf_0(val GetConfig_Response_Config_AnyOf_0) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(g.union, bts)
+	if err != nil {
+		return err
+	}
+	g.union = merged
+	return nil
+}
+
 // AsGetConfig_Response_Config_AnyOf_1 returns the union data inside the GetConfig_Response_Config_AnyOf as a GetConfig_Response_Config_AnyOf_1
 func (g *GetConfig_Response_Config_AnyOf) AsGetConfig_Response_Config_AnyOf_1() (GetConfig_Response_Config_AnyOf_1, error) {
 	return runtime.UnmarshalAs[GetConfig_Response_Config_AnyOf_1](g.union)
@@ -322,6 +336,20 @@ func (g *GetConfig_Response_Config_AnyOf) FromGetConfig_Response_Config_AnyOf_1(
 	return err
 }
 
+// MergeGetConfig_Response_Config_AnyOf_1 merges the provided GetConfig_Response_Config_AnyOf_1 into the union data inside the GetConfig_Response_Config_AnyOf
+func (g *GetConfig_Response_Config_AnyOf) MergeGetConfig_Response_Config_AnyOf_1(val GetConfig_Response_Config_AnyOf_1) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(g.union, bts)
+	if err != nil {
+		return err
+	}
+	g.union = merged
+	return nil
+}
+
 // AsGetConfig_Response_Config_AnyOf_2 returns the union data inside the GetConfig_Response_Config_AnyOf as a GetConfig_Response_Config_AnyOf_2
 func (g *GetConfig_Response_Config_AnyOf) AsGetConfig_Response_Config_AnyOf_2() (GetConfig_Response_Config_AnyOf_2, error) {
 	return runtime.UnmarshalAs[GetConfig_Response_Config_AnyOf_2](g.union)
@@ -352,6 +380,20 @@ func (g *GetConfig_Response_Config_AnyOf) FromGetConfig_Response_Config_AnyOf_2(
 	return err
 }
 
+// MergeGetConfig_Response_Config_AnyOf_2 merges the provided GetConfig_Response_Config_AnyOf_2 into the union data inside the GetConfig_Response_Config_AnyOf
+func (g *GetConfig_Response_Config_AnyOf) MergeGetConfig_Response_Config_AnyOf_2(val GetConfig_Response_Config_AnyOf_2) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(g.union, bts)
+	if err != nil {
+		return err
+	}
+	g.union = merged
+	return nil
+}
+
 // validateGetConfig_Response_Config_AnyOf_0 validates a GetConfig_Response_Config_AnyOf_0 value
 func (g *GetConfig_Response_Config_AnyOf) validateGetConfig_Response_Config_AnyOf_0(val GetConfig_Response_Config_AnyOf_0) error {
 	if v, ok := any(val).(runtime.Validator); ok {
@@ -434,6 +476,20 @@ func (u *UpdateConfigBody_Config_AnyOf) FromUpdateConfigBody_Config_AnyOf_0(val
 	return err
 }
 
+// MergeUpdateConfigBody_Config_AnyOf_0 merges the provided UpdateConfigBody_Config_AnyOf_0 into the union data inside the UpdateConfigBody_Config_AnyOf
+func (u *UpdateConfigBody_Config_AnyOf) MergeUpdateConfigBody_Config_AnyOf_0(val UpdateConfigBody_Config_AnyOf_0) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(u.union, bts)
+	if err != nil {
+		return err
+	}
+	u.union = merged
+	return nil
+}
+
 // AsUpdateConfigBody_Config_AnyOf_1 returns the union data inside the UpdateConfigBody_Config_AnyOf as a UpdateConfigBody_Config_AnyOf_1
 func (u *UpdateConfigBody_Config_AnyOf) AsUpdateConfigBody_Config_AnyOf_1() (UpdateConfigBody_Config_AnyOf_1, error) {
 	return runtime.UnmarshalAs[UpdateConfigBody_Config_AnyOf_1](u.union)
@@ -464,6 +520,20 @@ func (u *UpdateConfigBody_Config_AnyOf) FromUpdateConfigBody_Config_AnyOf_1(val
 	return err
 }
 
+// MergeUpdateConfigBody_Config_AnyOf_1 merges the provided UpdateConfigBody_Config_AnyOf_1 into the union data inside the UpdateConfigBody_Config_AnyOf
+func (u *UpdateConfigBody_Config_AnyOf) MergeUpdateConfigBody_Config_AnyOf_1(val UpdateConfigBody_Config_AnyOf_1) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(u.union, bts)
+	if err != nil {
+		return err
+	}
+	u.union = merged
+	return nil
+}
+
 // AsUpdateConfigBody_Config_AnyOf_2 returns the union data inside the UpdateConfigBody_Config_AnyOf as a UpdateConfigBody_Config_AnyOf_2
 func (u *UpdateConfigBody_Config_AnyOf) AsUpdateConfigBody_Config_AnyOf_2() (UpdateConfigBody_Config_AnyOf_2, error) {
 	return runtime.UnmarshalAs[UpdateConfigBody_Config_AnyOf_2](u.union)
@@ -494,6 +564,20 @@ func (u *UpdateConfigBody_Config_AnyOf) FromUpdateConfigBody_Config_AnyOf_2(val
 	return err
 }
 
+// MergeUpdateConfigBody_Config_AnyOf_2 merges the provided UpdateConfigBody_Config_AnyOf_2 into the union data inside the UpdateConfigBody_Config_AnyOf
+func (u *UpdateConfigBody_Config_AnyOf) MergeUpdateConfigBody_Config_AnyOf_2(val UpdateConfigBody_Config_AnyOf_2) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(u.union, bts)
+	if err != nil {
+		return err
+	}
+	u.union = merged
+	return nil
+}
+
 // validateUpdateConfigBody_Config_AnyOf_0 validates a UpdateConfigBody_Config_AnyOf_0 value
 func (u *UpdateConfigBody_Config_AnyOf) validateUpdateConfigBody_Config_AnyOf_0(val UpdateConfigBody_Config_AnyOf_0) error {
 	if v, ok := any(val).(runtime.Validator); ok {
@@ -535,4 +619,5 @@ var typesValidator *validator.Validate
 func init() {
 	typesValidator = validator.New(validator.WithRequiredStructEnabled())
 	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
 }
@@ -26,6 +26,71 @@ func (s SpecificIssueCode) Validate() error {
 	}
 }
 
+// specificIssueCodeNames maps SpecificIssueCode values to their names.
+var specificIssueCodeNames = map[SpecificIssueCode]string{
+	BUSINESSERROR:  "BUSINESSERROR",
+	INVALIDREQUEST: "INVALIDREQUEST",
+}
+
+// specificIssueCodeValues maps names to SpecificIssueCode values.
+var specificIssueCodeValues = map[string]SpecificIssueCode{
+	"BUSINESSERROR":  BUSINESSERROR,
+	"INVALIDREQUEST": INVALIDREQUEST,
+}
+
+// String returns the wire value of the SpecificIssueCode.
+func (s SpecificIssueCode) String() string {
+	return string(s)
+}
+
+// IsValid reports whether the SpecificIssueCode value is defined in the spec.
+func (s SpecificIssueCode) IsValid() bool {
+	_, ok := specificIssueCodeNames[s]
+	return ok
+}
+
+// Values returns all the SpecificIssueCode values defined in the spec.
+func (SpecificIssueCode) Values() []SpecificIssueCode {
+	return []SpecificIssueCode{
+		BUSINESSERROR,
+		INVALIDREQUEST,
+	}
+}
+
+// SpecificIssueCodePtr returns a pointer to v, to set the optional and nullable SpecificIssueCode properties.
+func SpecificIssueCodePtr(v SpecificIssueCode) *SpecificIssueCode {
+	return &v
+}
+
+// Name returns the name of the SpecificIssueCode value, or an empty string for unknown values.
+func (s SpecificIssueCode) Name() string {
+	return specificIssueCodeNames[s]
+}
+
+// ParseSpecificIssueCode returns the SpecificIssueCode matching s by wire value or by name.
+func ParseSpecificIssueCode(s string) (SpecificIssueCode, error) {
+	if _, ok := specificIssueCodeNames[SpecificIssueCode(s)]; ok {
+		return SpecificIssueCode(s), nil
+	}
+	if v, ok := specificIssueCodeValues[s]; ok {
+		return v, nil
+	}
+	var zero SpecificIssueCode
+	return zero, fmt.Errorf("%w for SpecificIssueCode: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (s SpecificIssueCode) MarshalText() ([]byte, error) {
+	return []byte(s), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseSpecificIssueCode.
+// Unknown values are kept as-is and reported by Validate.
+func (s *SpecificIssueCode) UnmarshalText(text []byte) error {
+	*s = SpecificIssueCode(text)
+	return nil
+}
+
 type TestEndpointBody = CombinedError
 
 type BaseError struct {
@@ -120,4 +185,5 @@ var typesValidator *validator.Validate
 func init() {
 	typesValidator = validator.New(validator.WithRequiredStructEnabled())
 	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
 }
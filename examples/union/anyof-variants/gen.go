@@ -0,0 +1,254 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package anyofvariants
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/go-playground/validator/v10"
+)
+
+type Identity struct {
+	Issuer string `json:"issuer" validate:"required"`
+}
+
+func (i Identity) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(i))
+}
+
+type Verification struct {
+	Verifier string `json:"verifier" validate:"required"`
+	Level    *int   `json:"level,omitempty" validate:"omitempty,gte=1"`
+}
+
+func (v Verification) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(v))
+}
+
+type Client struct {
+	Client_AnyOf *Client_AnyOf `json:"-"`
+}
+
+func (c Client) Validate() error {
+	var errors runtime.ValidationErrors
+	if c.Client_AnyOf != nil {
+		if v, ok := any(c.Client_AnyOf).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Client_AnyOf", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+func (c Client) MarshalJSON() ([]byte, error) {
+	var parts []json.RawMessage
+
+	{
+		b, err := runtime.MarshalJSON(c.Client_AnyOf)
+		if err != nil {
+			return nil, fmt.Errorf("Client_AnyOf marshal: %w", err)
+		}
+		parts = append(parts, b)
+	}
+
+	return runtime.CoalesceOrMerge(parts...)
+}
+
+func (c *Client) UnmarshalJSON(data []byte) error {
+	trim := bytes.TrimSpace(data)
+	if bytes.Equal(trim, []byte("null")) {
+		return nil
+	}
+	if len(trim) == 0 {
+		return fmt.Errorf("empty JSON input")
+	}
+
+	if c.Client_AnyOf == nil {
+		c.Client_AnyOf = &Client_AnyOf{}
+	}
+
+	if err := runtime.UnmarshalJSON(data, c.Client_AnyOf); err != nil {
+		return fmt.Errorf("Client_AnyOf unmarshal: %w", err)
+	}
+
+	return nil
+}
+
+type Code struct {
+	Code_AnyOf *Code_AnyOf `json:"-"`
+}
+
+func (c Code) Validate() error {
+	var errors runtime.ValidationErrors
+	if c.Code_AnyOf != nil {
+		if v, ok := any(c.Code_AnyOf).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Code_AnyOf", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+func (c Code) MarshalJSON() ([]byte, error) {
+	var parts []json.RawMessage
+
+	{
+		b, err := runtime.MarshalJSON(c.Code_AnyOf)
+		if err != nil {
+			return nil, fmt.Errorf("Code_AnyOf marshal: %w", err)
+		}
+		parts = append(parts, b)
+	}
+
+	return runtime.CoalesceOrMerge(parts...)
+}
+
+func (c *Code) UnmarshalJSON(data []byte) error {
+	trim := bytes.TrimSpace(data)
+	if bytes.Equal(trim, []byte("null")) {
+		return nil
+	}
+	if len(trim) == 0 {
+		return fmt.Errorf("empty JSON input")
+	}
+
+	if c.Code_AnyOf == nil {
+		c.Code_AnyOf = &Code_AnyOf{}
+	}
+
+	if err := runtime.UnmarshalJSON(data, c.Code_AnyOf); err != nil {
+		return fmt.Errorf("Code_AnyOf unmarshal: %w", err)
+	}
+
+	return nil
+}
+
+type Order struct {
+	Client Client `json:"client"`
+	Code   *Code  `json:"code,omitempty"`
+}
+
+func (o Order) Validate() error {
+	var errors runtime.ValidationErrors
+	if v, ok := any(o.Client).(runtime.Validator); ok {
+		if err := v.Validate(); err != nil {
+			errors = errors.Append("Client", err)
+		}
+	}
+	if o.Code != nil {
+		if v, ok := any(o.Code).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Code", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+type Client_AnyOf struct {
+	Identity     *Identity     `json:"-"`
+	Verification *Verification `json:"-"`
+	union        json.RawMessage
+}
+
+// Validate validates the variants set in the Client_AnyOf
+func (c *Client_AnyOf) Validate() error {
+	if c.Identity != nil {
+		if v, ok := any(c.Identity).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+	if c.Verification != nil {
+		if v, ok := any(c.Verification).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Raw returns the data the Client_AnyOf was unmarshaled from
+func (c *Client_AnyOf) Raw() json.RawMessage {
+	return c.union
+}
+
+// MarshalJSON merges the variants set in the Client_AnyOf over the data it was unmarshaled from
+func (c Client_AnyOf) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalAnyOf(c.union, c.Identity, c.Verification)
+}
+
+// UnmarshalJSON sets every variant of the Client_AnyOf the data matches
+func (c *Client_AnyOf) UnmarshalJSON(data []byte) error {
+	if err := runtime.UnmarshalAnyOf(data, &c.Identity, &c.Verification); err != nil {
+		return err
+	}
+	c.union = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+type Code_AnyOf struct {
+	String *string `json:"-"`
+	Int    *int    `json:"-"`
+	union  json.RawMessage
+}
+
+// Validate validates the variants set in the Code_AnyOf
+func (c *Code_AnyOf) Validate() error {
+	if c.String != nil {
+		if err := typesValidator.Var(*c.String, "min=2"); err != nil {
+			return err
+		}
+	}
+	if c.Int != nil {
+		if v, ok := any(c.Int).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Raw returns the data the Code_AnyOf was unmarshaled from
+func (c *Code_AnyOf) Raw() json.RawMessage {
+	return c.union
+}
+
+// MarshalJSON merges the variants set in the Code_AnyOf over the data it was unmarshaled from
+func (c Code_AnyOf) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalAnyOf(c.union, c.String, c.Int)
+}
+
+// UnmarshalJSON sets every variant of the Code_AnyOf the data matches
+func (c *Code_AnyOf) UnmarshalJSON(data []byte) error {
+	if err := runtime.UnmarshalAnyOf(data, &c.String, &c.Int); err != nil {
+		return err
+	}
+	c.union = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
+}
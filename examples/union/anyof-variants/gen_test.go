@@ -0,0 +1,103 @@
+package anyofvariants
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		check func(t *testing.T, u *Client_AnyOf)
+	}{
+		{
+			name:  "identity",
+			input: `{"issuer":"acme"}`,
+			check: func(t *testing.T, u *Client_AnyOf) {
+				assert.Equal(t, &Identity{Issuer: "acme"}, u.Identity)
+				assert.Nil(t, u.Verification)
+			},
+		},
+		{
+			name:  "verification",
+			input: `{"verifier":"bob","level":2}`,
+			check: func(t *testing.T, u *Client_AnyOf) {
+				assert.Nil(t, u.Identity)
+				assert.Equal(t, &Verification{Verifier: "bob", Level: runtime.Ptr(2)}, u.Verification)
+			},
+		},
+		{
+			name:  "both",
+			input: `{"issuer":"acme","verifier":"bob"}`,
+			check: func(t *testing.T, u *Client_AnyOf) {
+				assert.Equal(t, &Identity{Issuer: "acme"}, u.Identity)
+				assert.Equal(t, &Verification{Verifier: "bob"}, u.Verification)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var res Client
+			require.NoError(t, json.Unmarshal([]byte(tt.input), &res))
+			require.NotNil(t, res.Client_AnyOf)
+			tt.check(t, res.Client_AnyOf)
+			require.NoError(t, res.Validate())
+
+			data, err := json.Marshal(res)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.input, string(data))
+		})
+	}
+}
+
+func TestClientRoundTrip(t *testing.T) {
+	input := `{"client":{"issuer":"acme","verifier":"bob","tenant":"eu"},"code":"AB"}`
+
+	var order Order
+	require.NoError(t, json.Unmarshal([]byte(input), &order))
+
+	order.Client.Client_AnyOf.Verification.Level = runtime.Ptr(3)
+
+	data, err := json.Marshal(order)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"client":{"issuer":"acme","verifier":"bob","level":3,"tenant":"eu"},"code":"AB"}`, string(data))
+}
+
+func TestClientNew(t *testing.T) {
+	client := Client{Client_AnyOf: &Client_AnyOf{
+		Identity:     &Identity{Issuer: "acme"},
+		Verification: &Verification{Verifier: "bob"},
+	}}
+
+	data, err := json.Marshal(client)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"issuer":"acme","verifier":"bob"}`, string(data))
+}
+
+func TestClientInvalid(t *testing.T) {
+	var res Client
+	require.NoError(t, json.Unmarshal([]byte(`{"verifier":"bob","level":0}`), &res))
+	assert.NotNil(t, res.Client_AnyOf.Identity)
+	assert.NotNil(t, res.Client_AnyOf.Verification)
+	assert.Error(t, res.Validate())
+}
+
+func TestCode(t *testing.T) {
+	var code Code
+	require.NoError(t, json.Unmarshal([]byte(`42`), &code))
+	assert.Nil(t, code.Code_AnyOf.String)
+	assert.Equal(t, runtime.Ptr(42), code.Code_AnyOf.Int)
+
+	require.NoError(t, json.Unmarshal([]byte(`"A"`), &code))
+	assert.Equal(t, runtime.Ptr("A"), code.Code_AnyOf.String)
+	assert.Nil(t, code.Code_AnyOf.Int)
+	assert.Error(t, code.Validate())
+
+	assert.Error(t, json.Unmarshal([]byte(`true`), &code))
+}
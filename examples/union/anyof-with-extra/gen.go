@@ -24,6 +24,13 @@ func (c ClientWithExtra) Validate() error {
 			}
 		}
 	}
+	for k, v := range c.AdditionalProperties {
+		if val, ok := any(v).(runtime.Validator); ok {
+			if err := val.Validate(); err != nil {
+				errors = errors.Append(k, err)
+			}
+		}
+	}
 	if len(errors) == 0 {
 		return nil
 	}
@@ -127,4 +134,5 @@ var typesValidator *validator.Validate
 func init() {
 	typesValidator = validator.New(validator.WithRequiredStructEnabled())
 	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
 }
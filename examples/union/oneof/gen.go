@@ -353,6 +353,20 @@ func (o *Order_Product_OneOf) FromVersionA(val VersionA) error {
 	return err
 }
 
+// MergeVersionA merges the provided VersionA into the union data inside the Order_Product_OneOf
+func (o *Order_Product_OneOf) MergeVersionA(val VersionA) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(o.union, bts)
+	if err != nil {
+		return err
+	}
+	o.union = merged
+	return nil
+}
+
 // AsVersionB returns the union data inside the Order_Product_OneOf as a VersionB
 func (o *Order_Product_OneOf) AsVersionB() (VersionB, error) {
 	return runtime.UnmarshalAs[VersionB](o.union)
@@ -383,6 +397,20 @@ func (o *Order_Product_OneOf) FromVersionB(val VersionB) error {
 	return err
 }
 
+// MergeVersionB merges the provided VersionB into the union data inside the Order_Product_OneOf
+func (o *Order_Product_OneOf) MergeVersionB(val VersionB) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(o.union, bts)
+	if err != nil {
+		return err
+	}
+	o.union = merged
+	return nil
+}
+
 // AsBool returns the union data inside the Order_Product_OneOf as a bool
 func (o *Order_Product_OneOf) AsBool() (bool, error) {
 	return runtime.UnmarshalAs[bool](o.union)
@@ -413,6 +441,20 @@ func (o *Order_Product_OneOf) FromBool(val bool) error {
 	return err
 }
 
+// MergeBool merges the provided bool into the union data inside the Order_Product_OneOf
+func (o *Order_Product_OneOf) MergeBool(val bool) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(o.union, bts)
+	if err != nil {
+		return err
+	}
+	o.union = merged
+	return nil
+}
+
 // AsOrder_Product_OneOf_3 returns the union data inside the Order_Product_OneOf as a Order_Product_OneOf_3
 func (o *Order_Product_OneOf) AsOrder_Product_OneOf_3() (Order_Product_OneOf_3, error) {
 	return runtime.UnmarshalAs[Order_Product_OneOf_3](o.union)
@@ -443,6 +485,20 @@ func (o *Order_Product_OneOf) FromOrder_Product_OneOf_3(val Order_Product_OneOf_
 	return err
 }
 
+// MergeOrder_Product_OneOf_3 merges the provided Order_Product_OneOf_3 into the union data inside the Order_Product_OneOf
+func (o *Order_Product_OneOf) MergeOrder_Product_OneOf_3(val Order_Product_OneOf_3) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(o.union, bts)
+	if err != nil {
+		return err
+	}
+	o.union = merged
+	return nil
+}
+
 // validateVersionA validates a VersionA value
 func (o *Order_Product_OneOf) validateVersionA(val VersionA) error {
 	if v, ok := any(val).(runtime.Validator); ok {
@@ -546,4 +602,5 @@ var typesValidator *validator.Validate
 func init() {
 	typesValidator = validator.New(validator.WithRequiredStructEnabled())
 	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
 }
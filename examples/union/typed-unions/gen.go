@@ -0,0 +1,707 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package typedunions
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/go-playground/validator/v10"
+)
+
+type Dog struct {
+	Kind string `json:"kind" validate:"required"`
+	Bark string `json:"bark" validate:"required"`
+}
+
+func (d Dog) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(d))
+}
+
+type Cat struct {
+	Kind  string `json:"kind" validate:"required"`
+	Lives int    `json:"lives" validate:"required,gte=1"`
+}
+
+func (c Cat) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(c))
+}
+
+type Bird struct {
+	Kind  string `json:"kind" validate:"required"`
+	Wings int    `json:"wings" validate:"required"`
+}
+
+func (b Bird) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(b))
+}
+
+type Pet struct {
+	Pet_OneOf *Pet_OneOf `json:"-"`
+}
+
+func (p Pet) Validate() error {
+	var errors runtime.ValidationErrors
+	if p.Pet_OneOf != nil {
+		if v, ok := any(p.Pet_OneOf).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Pet_OneOf", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+func (p Pet) MarshalJSON() ([]byte, error) {
+	var parts []json.RawMessage
+
+	{
+		b, err := runtime.MarshalJSON(p.Pet_OneOf)
+		if err != nil {
+			return nil, fmt.Errorf("Pet_OneOf marshal: %w", err)
+		}
+		parts = append(parts, b)
+	}
+
+	return runtime.CoalesceOrMerge(parts...)
+}
+
+func (p *Pet) UnmarshalJSON(data []byte) error {
+	trim := bytes.TrimSpace(data)
+	if bytes.Equal(trim, []byte("null")) {
+		return nil
+	}
+	if len(trim) == 0 {
+		return fmt.Errorf("empty JSON input")
+	}
+
+	if p.Pet_OneOf == nil {
+		p.Pet_OneOf = &Pet_OneOf{}
+	}
+
+	if err := runtime.UnmarshalJSON(data, p.Pet_OneOf); err != nil {
+		return fmt.Errorf("Pet_OneOf unmarshal: %w", err)
+	}
+
+	return nil
+}
+
+type Scalar struct {
+	Scalar_AnyOf *Scalar_AnyOf `json:"-"`
+}
+
+func (s Scalar) Validate() error {
+	var errors runtime.ValidationErrors
+	if s.Scalar_AnyOf != nil {
+		if v, ok := any(s.Scalar_AnyOf).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Scalar_AnyOf", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+func (s Scalar) MarshalJSON() ([]byte, error) {
+	var parts []json.RawMessage
+
+	{
+		b, err := runtime.MarshalJSON(s.Scalar_AnyOf)
+		if err != nil {
+			return nil, fmt.Errorf("Scalar_AnyOf marshal: %w", err)
+		}
+		parts = append(parts, b)
+	}
+
+	return runtime.CoalesceOrMerge(parts...)
+}
+
+func (s *Scalar) UnmarshalJSON(data []byte) error {
+	trim := bytes.TrimSpace(data)
+	if bytes.Equal(trim, []byte("null")) {
+		return nil
+	}
+	if len(trim) == 0 {
+		return fmt.Errorf("empty JSON input")
+	}
+
+	if s.Scalar_AnyOf == nil {
+		s.Scalar_AnyOf = &Scalar_AnyOf{}
+	}
+
+	if err := runtime.UnmarshalJSON(data, s.Scalar_AnyOf); err != nil {
+		return fmt.Errorf("Scalar_AnyOf unmarshal: %w", err)
+	}
+
+	return nil
+}
+
+type Owner struct {
+	Pet Pet     `json:"pet"`
+	Tag *Scalar `json:"tag,omitempty"`
+}
+
+func (o Owner) Validate() error {
+	var errors runtime.ValidationErrors
+	if v, ok := any(o.Pet).(runtime.Validator); ok {
+		if err := v.Validate(); err != nil {
+			errors = errors.Append("Pet", err)
+		}
+	}
+	if o.Tag != nil {
+		if v, ok := any(o.Tag).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Tag", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+type Many struct {
+	Many_OneOf *Many_OneOf `json:"-"`
+}
+
+func (m Many) Validate() error {
+	var errors runtime.ValidationErrors
+	if m.Many_OneOf != nil {
+		if v, ok := any(m.Many_OneOf).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Many_OneOf", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+func (m Many) MarshalJSON() ([]byte, error) {
+	var parts []json.RawMessage
+
+	{
+		b, err := runtime.MarshalJSON(m.Many_OneOf)
+		if err != nil {
+			return nil, fmt.Errorf("Many_OneOf marshal: %w", err)
+		}
+		parts = append(parts, b)
+	}
+
+	return runtime.CoalesceOrMerge(parts...)
+}
+
+func (m *Many) UnmarshalJSON(data []byte) error {
+	trim := bytes.TrimSpace(data)
+	if bytes.Equal(trim, []byte("null")) {
+		return nil
+	}
+	if len(trim) == 0 {
+		return fmt.Errorf("empty JSON input")
+	}
+
+	if m.Many_OneOf == nil {
+		m.Many_OneOf = &Many_OneOf{}
+	}
+
+	if err := runtime.UnmarshalJSON(data, m.Many_OneOf); err != nil {
+		return fmt.Errorf("Many_OneOf unmarshal: %w", err)
+	}
+
+	return nil
+}
+
+type Scalar_AnyOf_3 []string
+
+type Pet_OneOf struct {
+	runtime.OneOf3[Dog, Cat, Bird]
+}
+
+func (p *Pet_OneOf) Validate() error {
+	if p.IsA() {
+		if v, ok := any(p.A).(runtime.Validator); ok {
+			return v.Validate()
+		}
+	}
+	if p.IsB() {
+		if v, ok := any(p.B).(runtime.Validator); ok {
+			return v.Validate()
+		}
+	}
+	if p.IsC() {
+		if v, ok := any(p.C).(runtime.Validator); ok {
+			return v.Validate()
+		}
+	}
+	return nil
+}
+
+func (p Pet_OneOf) discriminator(data []byte) (string, error) {
+	var discriminator struct {
+		Value string `json:"kind"`
+	}
+	if err := json.Unmarshal(data, &discriminator); err != nil {
+		return "", err
+	}
+	return discriminator.Value, nil
+}
+
+// Discriminator returns the value of the "kind" discriminator property of the data held by the Pet_OneOf
+func (p *Pet_OneOf) Discriminator() (string, error) {
+	data := p.Value()
+	if data == nil {
+		return "", nil
+	}
+	obj, err := json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+	return p.heldDiscriminator(obj)
+}
+
+// heldDiscriminator returns the discriminator of obj, the marshaled data held by the Pet_OneOf,
+// defaulting to the value mapped to its type
+func (p *Pet_OneOf) heldDiscriminator(obj []byte) (string, error) {
+	discriminator, err := p.discriminator(obj)
+	if err != nil || discriminator != "" {
+		return discriminator, err
+	}
+	if p.IsA() {
+		return "dog", nil
+	}
+	if p.IsB() {
+		return "cat", nil
+	}
+	if p.IsC() {
+		return "bird", nil
+	}
+	return discriminator, nil
+}
+
+// ValueByDiscriminator returns the data held by the Pet_OneOf,
+// or a *runtime.UnknownDiscriminatorError if its discriminator is missing from the mapping
+func (p *Pet_OneOf) ValueByDiscriminator() (any, error) {
+	discriminator, err := p.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "bird", "cat", "dog":
+		return p.Value(), nil
+	default:
+		return nil, &runtime.UnknownDiscriminatorError{Property: "kind", Value: discriminator}
+	}
+}
+
+func (p *Pet_OneOf) MarshalJSON() ([]byte, error) {
+	data := p.Value()
+	if data == nil {
+		return []byte("null"), nil
+	}
+
+	obj, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	disc, err := p.heldDiscriminator(obj)
+	if err != nil {
+		return nil, err
+	}
+	return runtime.MarshalEitherWithDiscriminator(obj, "kind", disc)
+}
+
+func (p *Pet_OneOf) UnmarshalJSON(data []byte) error {
+	discriminator, err := p.discriminator(data)
+	if err != nil {
+		return err
+	}
+
+	switch discriminator {
+	case "bird":
+		var res Bird
+		if err = json.Unmarshal(data, &res); err != nil {
+			return err
+		}
+
+		p.C = res
+		p.N = 3
+	case "cat":
+		var res Cat
+		if err = json.Unmarshal(data, &res); err != nil {
+			return err
+		}
+
+		p.B = res
+		p.N = 2
+	case "dog":
+		var res Dog
+		if err = json.Unmarshal(data, &res); err != nil {
+			return err
+		}
+
+		p.A = res
+		p.N = 1
+	default:
+		return &runtime.UnknownDiscriminatorError{Property: "kind", Value: discriminator}
+	}
+	return nil
+}
+
+type Scalar_AnyOf struct {
+	runtime.OneOf4[string, int, bool, Scalar_AnyOf_3]
+}
+
+func (s *Scalar_AnyOf) Validate() error {
+	if s.IsA() {
+		if err := typesValidator.Var(s.A, "min=2"); err != nil {
+			return err
+		}
+	}
+	if s.IsB() {
+		if v, ok := any(s.B).(runtime.Validator); ok {
+			return v.Validate()
+		}
+	}
+	if s.IsC() {
+		if v, ok := any(s.C).(runtime.Validator); ok {
+			return v.Validate()
+		}
+	}
+	if s.IsD() {
+		if v, ok := any(s.D).(runtime.Validator); ok {
+			return v.Validate()
+		}
+	}
+	return nil
+}
+
+type Value struct {
+	runtime.OneOf3[string, int, bool]
+}
+
+func (v *Value) Validate() error {
+	if v.IsA() {
+		if v, ok := any(v.A).(runtime.Validator); ok {
+			return v.Validate()
+		}
+	}
+	if v.IsB() {
+		if v, ok := any(v.B).(runtime.Validator); ok {
+			return v.Validate()
+		}
+	}
+	if v.IsC() {
+		if v, ok := any(v.C).(runtime.Validator); ok {
+			return v.Validate()
+		}
+	}
+	return nil
+}
+
+type Many_OneOf struct {
+	union json.RawMessage
+}
+
+func (m *Many_OneOf) Validate() error {
+	// NOTE: Validation is not supported for unions with more than 2 elements.
+	// Validating would require unmarshaling against each possible type, which is inefficient.
+	// Use AsValidated<Type>() methods to validate after retrieving the specific type.
+	return nil
+}
+
+// Raw returns the union data inside the Many_OneOf as bytes
+func (m *Many_OneOf) Raw() json.RawMessage {
+	return m.union
+}
+
+// AsDog returns the union data inside the Many_OneOf as a Dog
+func (m *Many_OneOf) AsDog() (Dog, error) {
+	return runtime.UnmarshalAs[Dog](m.union)
+}
+
+// AsValidatedDog returns the union data inside the Many_OneOf as a validated Dog
+func (m *Many_OneOf) AsValidatedDog() (Dog, error) {
+	val, err := m.AsDog()
+	if err != nil {
+		var zero Dog
+		return zero, err
+	}
+	if err := m.validateDog(val); err != nil {
+		var zero Dog
+		return zero, err
+	}
+	return val, nil
+}
+
+// FromDog overwrites any union data inside the Many_OneOf as the provided Dog
+func (m *Many_OneOf) FromDog(val Dog) error {
+	// Validate before storing
+	if err := m.validateDog(val); err != nil {
+		return err
+	}
+	bts, err := json.Marshal(val)
+	m.union = bts
+	return err
+}
+
+// MergeDog merges the provided Dog into the union data inside the Many_OneOf
+func (m *Many_OneOf) MergeDog(val Dog) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(m.union, bts)
+	if err != nil {
+		return err
+	}
+	m.union = merged
+	return nil
+}
+
+// AsCat returns the union data inside the Many_OneOf as a Cat
+func (m *Many_OneOf) AsCat() (Cat, error) {
+	return runtime.UnmarshalAs[Cat](m.union)
+}
+
+// AsValidatedCat returns the union data inside the Many_OneOf as a validated Cat
+func (m *Many_OneOf) AsValidatedCat() (Cat, error) {
+	val, err := m.AsCat()
+	if err != nil {
+		var zero Cat
+		return zero, err
+	}
+	if err := m.validateCat(val); err != nil {
+		var zero Cat
+		return zero, err
+	}
+	return val, nil
+}
+
+// FromCat overwrites any union data inside the Many_OneOf as the provided Cat
+func (m *Many_OneOf) FromCat(val Cat) error {
+	// Validate before storing
+	if err := m.validateCat(val); err != nil {
+		return err
+	}
+	bts, err := json.Marshal(val)
+	m.union = bts
+	return err
+}
+
+// MergeCat merges the provided Cat into the union data inside the Many_OneOf
+func (m *Many_OneOf) MergeCat(val Cat) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(m.union, bts)
+	if err != nil {
+		return err
+	}
+	m.union = merged
+	return nil
+}
+
+// AsBird returns the union data inside the Many_OneOf as a Bird
+func (m *Many_OneOf) AsBird() (Bird, error) {
+	return runtime.UnmarshalAs[Bird](m.union)
+}
+
+// AsValidatedBird returns the union data inside the Many_OneOf as a validated Bird
+func (m *Many_OneOf) AsValidatedBird() (Bird, error) {
+	val, err := m.AsBird()
+	if err != nil {
+		var zero Bird
+		return zero, err
+	}
+	if err := m.validateBird(val); err != nil {
+		var zero Bird
+		return zero, err
+	}
+	return val, nil
+}
+
+// FromBird overwrites any union data inside the Many_OneOf as the provided Bird
+func (m *Many_OneOf) FromBird(val Bird) error {
+	// Validate before storing
+	if err := m.validateBird(val); err != nil {
+		return err
+	}
+	bts, err := json.Marshal(val)
+	m.union = bts
+	return err
+}
+
+// MergeBird merges the provided Bird into the union data inside the Many_OneOf
+func (m *Many_OneOf) MergeBird(val Bird) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(m.union, bts)
+	if err != nil {
+		return err
+	}
+	m.union = merged
+	return nil
+}
+
+// AsString returns the union data inside the Many_OneOf as a string
+func (m *Many_OneOf) AsString() (string, error) {
+	return runtime.UnmarshalAs[string](m.union)
+}
+
+// AsValidatedString returns the union data inside the Many_OneOf as a validated string
+func (m *Many_OneOf) AsValidatedString() (string, error) {
+	val, err := m.AsString()
+	if err != nil {
+		var zero string
+		return zero, err
+	}
+	if err := m.validateString(val); err != nil {
+		var zero string
+		return zero, err
+	}
+	return val, nil
+}
+
+// FromString overwrites any union data inside the Many_OneOf as the provided string
+func (m *Many_OneOf) FromString(val string) error {
+	// Validate before storing
+	if err := m.validateString(val); err != nil {
+		return err
+	}
+	bts, err := json.Marshal(val)
+	m.union = bts
+	return err
+}
+
+// MergeString merges the provided string into the union data inside the Many_OneOf
+func (m *Many_OneOf) MergeString(val string) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(m.union, bts)
+	if err != nil {
+		return err
+	}
+	m.union = merged
+	return nil
+}
+
+// AsInt returns the union data inside the Many_OneOf as a int
+func (m *Many_OneOf) AsInt() (int, error) {
+	return runtime.UnmarshalAs[int](m.union)
+}
+
+// AsValidatedInt returns the union data inside the Many_OneOf as a validated int
+func (m *Many_OneOf) AsValidatedInt() (int, error) {
+	val, err := m.AsInt()
+	if err != nil {
+		var zero int
+		return zero, err
+	}
+	if err := m.validateInt(val); err != nil {
+		var zero int
+		return zero, err
+	}
+	return val, nil
+}
+
+// FromInt overwrites any union data inside the Many_OneOf as the provided int
+func (m *Many_OneOf) FromInt(val int) error {
+	// Validate before storing
+	if err := m.validateInt(val); err != nil {
+		return err
+	}
+	bts, err := json.Marshal(val)
+	m.union = bts
+	return err
+}
+
+// MergeInt merges the provided int into the union data inside the Many_OneOf
+func (m *Many_OneOf) MergeInt(val int) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(m.union, bts)
+	if err != nil {
+		return err
+	}
+	m.union = merged
+	return nil
+}
+
+// validateDog validates a Dog value
+func (m *Many_OneOf) validateDog(val Dog) error {
+	if v, ok := any(val).(runtime.Validator); ok {
+		return v.Validate()
+	}
+	return nil
+}
+
+// validateCat validates a Cat value
+func (m *Many_OneOf) validateCat(val Cat) error {
+	if v, ok := any(val).(runtime.Validator); ok {
+		return v.Validate()
+	}
+	return nil
+}
+
+// validateBird validates a Bird value
+func (m *Many_OneOf) validateBird(val Bird) error {
+	if v, ok := any(val).(runtime.Validator); ok {
+		return v.Validate()
+	}
+	return nil
+}
+
+// validateString validates a string value
+func (m *Many_OneOf) validateString(val string) error {
+	if v, ok := any(val).(runtime.Validator); ok {
+		return v.Validate()
+	}
+	return nil
+}
+
+// validateInt validates a int value
+func (m *Many_OneOf) validateInt(val int) error {
+	if v, ok := any(val).(runtime.Validator); ok {
+		return v.Validate()
+	}
+	return nil
+}
+
+func (m Many_OneOf) MarshalJSON() ([]byte, error) {
+	bts, err := m.union.MarshalJSON()
+
+	return bts, err
+}
+
+func (m *Many_OneOf) UnmarshalJSON(bts []byte) error {
+	err := m.union.UnmarshalJSON(bts)
+
+	return err
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
+}
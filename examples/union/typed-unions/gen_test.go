@@ -0,0 +1,136 @@
+package typedunions
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPet(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		check func(t *testing.T, u *Pet_OneOf)
+	}{
+		{
+			name:  "dog",
+			input: `{"kind":"dog","bark":"woof"}`,
+			check: func(t *testing.T, u *Pet_OneOf) {
+				require.True(t, u.IsA())
+				assert.Equal(t, Dog{Kind: "dog", Bark: "woof"}, u.A)
+			},
+		},
+		{
+			name:  "cat",
+			input: `{"kind":"cat","lives":9}`,
+			check: func(t *testing.T, u *Pet_OneOf) {
+				require.True(t, u.IsB())
+				assert.Equal(t, 9, u.B.Lives)
+			},
+		},
+		{
+			name:  "bird",
+			input: `{"kind":"bird","wings":2}`,
+			check: func(t *testing.T, u *Pet_OneOf) {
+				require.True(t, u.IsC())
+				assert.Equal(t, 2, u.C.Wings)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var pet Pet
+			require.NoError(t, json.Unmarshal([]byte(tt.input), &pet))
+			tt.check(t, pet.Pet_OneOf)
+
+			disc, err := pet.Pet_OneOf.Discriminator()
+			require.NoError(t, err)
+			assert.Equal(t, tt.name, disc)
+
+			data, err := json.Marshal(pet)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.input, string(data))
+		})
+	}
+
+	t.Run("unknown discriminator", func(t *testing.T) {
+		var pet Pet
+		err := json.Unmarshal([]byte(`{"kind":"fish"}`), &pet)
+		var discErr *runtime.UnknownDiscriminatorError
+		require.ErrorAs(t, err, &discErr)
+		assert.Equal(t, "fish", discErr.Value)
+	})
+
+	t.Run("marshal sets the discriminator", func(t *testing.T) {
+		pet := Pet{Pet_OneOf: &Pet_OneOf{OneOf3: runtime.NewOneOf3FromC[Dog, Cat, Bird](Bird{Wings: 2})}}
+		data, err := json.Marshal(pet)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"kind":"bird","wings":2}`, string(data))
+	})
+
+	t.Run("validates the held variant", func(t *testing.T) {
+		u := &Pet_OneOf{OneOf3: runtime.NewOneOf3FromB[Dog, Cat, Bird](Cat{Kind: "cat", Lives: 0})}
+		assert.Error(t, u.Validate())
+		u.B.Lives = 1
+		assert.NoError(t, u.Validate())
+	})
+}
+
+func TestScalar(t *testing.T) {
+	tests := []struct {
+		input string
+		check func(t *testing.T, u *Scalar_AnyOf)
+	}{
+		{input: `"abc"`, check: func(t *testing.T, u *Scalar_AnyOf) { assert.True(t, u.IsA()); assert.Equal(t, "abc", u.A) }},
+		{input: `42`, check: func(t *testing.T, u *Scalar_AnyOf) { assert.True(t, u.IsB()); assert.Equal(t, 42, u.B) }},
+		{input: `true`, check: func(t *testing.T, u *Scalar_AnyOf) { assert.True(t, u.IsC()); assert.True(t, u.C) }},
+		{input: `["a","b"]`, check: func(t *testing.T, u *Scalar_AnyOf) {
+			assert.True(t, u.IsD())
+			assert.Equal(t, Scalar_AnyOf_3{"a", "b"}, u.D)
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			var owner Owner
+			require.NoError(t, json.Unmarshal([]byte(`{"pet":{"kind":"dog","bark":"woof"},"tag":`+tt.input+`}`), &owner))
+			require.NotNil(t, owner.Tag)
+			tt.check(t, owner.Tag.Scalar_AnyOf)
+
+			data, err := json.Marshal(owner.Tag)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.input, string(data))
+		})
+	}
+
+	t.Run("validates constraints of the held variant", func(t *testing.T) {
+		u := &Scalar_AnyOf{OneOf4: runtime.NewOneOf4FromA[string, int, bool, Scalar_AnyOf_3]("a")}
+		assert.Error(t, u.Validate())
+	})
+}
+
+func TestValue(t *testing.T) {
+	var v Value
+	require.NoError(t, json.Unmarshal([]byte(`7`), &v))
+	assert.True(t, v.IsB())
+	assert.Equal(t, 7, v.B)
+
+	require.NoError(t, json.Unmarshal([]byte(`"seven"`), &v))
+	assert.True(t, v.IsA())
+	assert.Equal(t, 0, v.B)
+
+	err := json.Unmarshal([]byte(`{}`), &v)
+	assert.ErrorIs(t, err, runtime.ErrFailedToUnmarshalUnion)
+}
+
+func TestMany_fallsBackToRawMessage(t *testing.T) {
+	var m Many
+	require.NoError(t, json.Unmarshal([]byte(`"text"`), &m))
+	s, err := m.Many_OneOf.AsString()
+	require.NoError(t, err)
+	assert.Equal(t, "text", s)
+}
@@ -130,6 +130,20 @@ func (m *Measurement_Count) FromString(val string) error {
 	return err
 }
 
+// MergeString merges the provided string into the union data inside the Measurement_Count
+func (m *Measurement_Count) MergeString(val string) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(m.union, bts)
+	if err != nil {
+		return err
+	}
+	m.union = merged
+	return nil
+}
+
 // AsInt returns the union data inside the Measurement_Count as a int
 func (m *Measurement_Count) AsInt() (int, error) {
 	return runtime.UnmarshalAs[int](m.union)
@@ -160,6 +174,20 @@ func (m *Measurement_Count) FromInt(val int) error {
 	return err
 }
 
+// MergeInt merges the provided int into the union data inside the Measurement_Count
+func (m *Measurement_Count) MergeInt(val int) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(m.union, bts)
+	if err != nil {
+		return err
+	}
+	m.union = merged
+	return nil
+}
+
 // AsBool returns the union data inside the Measurement_Count as a bool
 func (m *Measurement_Count) AsBool() (bool, error) {
 	return runtime.UnmarshalAs[bool](m.union)
@@ -190,6 +218,20 @@ func (m *Measurement_Count) FromBool(val bool) error {
 	return err
 }
 
+// MergeBool merges the provided bool into the union data inside the Measurement_Count
+func (m *Measurement_Count) MergeBool(val bool) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(m.union, bts)
+	if err != nil {
+		return err
+	}
+	m.union = merged
+	return nil
+}
+
 // validateString validates a string value
 func (m *Measurement_Count) validateString(val string) error {
 	if v, ok := any(val).(runtime.Validator); ok {
@@ -272,6 +314,20 @@ func (m *Measurement_Count_AdditionalProperties) FromString(val string) error {
 	return err
 }
 
+// MergeString merges the provided string into the union data inside the Measurement_Count_AdditionalProperties
+func (m *Measurement_Count_AdditionalProperties) MergeString(val string) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(m.union, bts)
+	if err != nil {
+		return err
+	}
+	m.union = merged
+	return nil
+}
+
 // AsInt returns the union data inside the Measurement_Count_AdditionalProperties as a int
 func (m *Measurement_Count_AdditionalProperties) AsInt() (int, error) {
 	return runtime.UnmarshalAs[int](m.union)
@@ -302,6 +358,20 @@ func (m *Measurement_Count_AdditionalProperties) FromInt(val int) error {
 	return err
 }
 
+// MergeInt merges the provided int into the union data inside the Measurement_Count_AdditionalProperties
+func (m *Measurement_Count_AdditionalProperties) MergeInt(val int) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(m.union, bts)
+	if err != nil {
+		return err
+	}
+	m.union = merged
+	return nil
+}
+
 // AsBool returns the union data inside the Measurement_Count_AdditionalProperties as a bool
 func (m *Measurement_Count_AdditionalProperties) AsBool() (bool, error) {
 	return runtime.UnmarshalAs[bool](m.union)
@@ -332,6 +402,20 @@ func (m *Measurement_Count_AdditionalProperties) FromBool(val bool) error {
 	return err
 }
 
+// MergeBool merges the provided bool into the union data inside the Measurement_Count_AdditionalProperties
+func (m *Measurement_Count_AdditionalProperties) MergeBool(val bool) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(m.union, bts)
+	if err != nil {
+		return err
+	}
+	m.union = merged
+	return nil
+}
+
 // validateString validates a string value
 func (m *Measurement_Count_AdditionalProperties) validateString(val string) error {
 	if v, ok := any(val).(runtime.Validator); ok {
@@ -428,4 +512,5 @@ var typesValidator *validator.Validate
 func init() {
 	typesValidator = validator.New(validator.WithRequiredStructEnabled())
 	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
 }
@@ -318,6 +318,20 @@ func (n *Nested_Entity_OneOf_1_Name_OneOf) FromInt(val int) error {
 	return err
 }
 
+// MergeInt merges the provided int into the union data inside the Nested_Entity_OneOf_1_Name_OneOf
+func (n *Nested_Entity_OneOf_1_Name_OneOf) MergeInt(val int) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(n.union, bts)
+	if err != nil {
+		return err
+	}
+	n.union = merged
+	return nil
+}
+
 // AsString returns the union data inside the Nested_Entity_OneOf_1_Name_OneOf as a string
 func (n *Nested_Entity_OneOf_1_Name_OneOf) AsString() (string, error) {
 	return runtime.UnmarshalAs[string](n.union)
@@ -348,6 +362,20 @@ func (n *Nested_Entity_OneOf_1_Name_OneOf) FromString(val string) error {
 	return err
 }
 
+// MergeString merges the provided string into the union data inside the Nested_Entity_OneOf_1_Name_OneOf
+func (n *Nested_Entity_OneOf_1_Name_OneOf) MergeString(val string) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(n.union, bts)
+	if err != nil {
+		return err
+	}
+	n.union = merged
+	return nil
+}
+
 // AsUser returns the union data inside the Nested_Entity_OneOf_1_Name_OneOf as a User
 func (n *Nested_Entity_OneOf_1_Name_OneOf) AsUser() (User, error) {
 	return runtime.UnmarshalAs[User](n.union)
@@ -378,6 +406,20 @@ func (n *Nested_Entity_OneOf_1_Name_OneOf) FromUser(val User) error {
 	return err
 }
 
+// MergeUser merges the provided User into the union data inside the Nested_Entity_OneOf_1_Name_OneOf
+func (n *Nested_Entity_OneOf_1_Name_OneOf) MergeUser(val User) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(n.union, bts)
+	if err != nil {
+		return err
+	}
+	n.union = merged
+	return nil
+}
+
 // validateInt validates a int value
 func (n *Nested_Entity_OneOf_1_Name_OneOf) validateInt(val int) error {
 	return typesValidator.Var(val, "gte=1")
@@ -413,4 +455,5 @@ var typesValidator *validator.Validate
 func init() {
 	typesValidator = validator.New(validator.WithRequiredStructEnabled())
 	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
 }
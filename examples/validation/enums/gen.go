@@ -27,6 +27,74 @@ func (s Status) Validate() error {
 	}
 }
 
+// statusNames maps Status values to their names.
+var statusNames = map[Status]string{
+	ACTIVE:   "ACTIVE",
+	INACTIVE: "INACTIVE",
+	PENDING:  "PENDING",
+}
+
+// statusValues maps names to Status values.
+var statusValues = map[string]Status{
+	"ACTIVE":   ACTIVE,
+	"INACTIVE": INACTIVE,
+	"PENDING":  PENDING,
+}
+
+// String returns the wire value of the Status.
+func (s Status) String() string {
+	return string(s)
+}
+
+// IsValid reports whether the Status value is defined in the spec.
+func (s Status) IsValid() bool {
+	_, ok := statusNames[s]
+	return ok
+}
+
+// Values returns all the Status values defined in the spec.
+func (Status) Values() []Status {
+	return []Status{
+		ACTIVE,
+		INACTIVE,
+		PENDING,
+	}
+}
+
+// StatusPtr returns a pointer to v, to set the optional and nullable Status properties.
+func StatusPtr(v Status) *Status {
+	return &v
+}
+
+// Name returns the name of the Status value, or an empty string for unknown values.
+func (s Status) Name() string {
+	return statusNames[s]
+}
+
+// ParseStatus returns the Status matching s by wire value or by name.
+func ParseStatus(s string) (Status, error) {
+	if _, ok := statusNames[Status(s)]; ok {
+		return Status(s), nil
+	}
+	if v, ok := statusValues[s]; ok {
+		return v, nil
+	}
+	var zero Status
+	return zero, fmt.Errorf("%w for Status: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (s Status) MarshalText() ([]byte, error) {
+	return []byte(s), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseStatus.
+// Unknown values are kept as-is and reported by Validate.
+func (s *Status) UnmarshalText(text []byte) error {
+	*s = Status(text)
+	return nil
+}
+
 // IndicatorUnit A numeric unit for an indicator - includes empty string
 type IndicatorUnit string
 
@@ -49,6 +117,83 @@ func (i IndicatorUnit) Validate() error {
 	}
 }
 
+// indicatorUnitNames maps IndicatorUnit values to their names.
+var indicatorUnitNames = map[IndicatorUnit]string{
+	Empty:     "Empty",
+	EuroSign:  "EuroSign",
+	Percent:   "Percent",
+	PoundSign: "PoundSign",
+	Pp:        "Pp",
+	Value:     "Value",
+}
+
+// indicatorUnitValues maps names to IndicatorUnit values.
+var indicatorUnitValues = map[string]IndicatorUnit{
+	"Empty":     Empty,
+	"EuroSign":  EuroSign,
+	"Percent":   Percent,
+	"PoundSign": PoundSign,
+	"Pp":        Pp,
+	"Value":     Value,
+}
+
+// String returns the wire value of the IndicatorUnit.
+func (i IndicatorUnit) String() string {
+	return string(i)
+}
+
+// IsValid reports whether the IndicatorUnit value is defined in the spec.
+func (i IndicatorUnit) IsValid() bool {
+	_, ok := indicatorUnitNames[i]
+	return ok
+}
+
+// Values returns all the IndicatorUnit values defined in the spec.
+func (IndicatorUnit) Values() []IndicatorUnit {
+	return []IndicatorUnit{
+		Empty,
+		EuroSign,
+		Percent,
+		PoundSign,
+		Pp,
+		Value,
+	}
+}
+
+// IndicatorUnitPtr returns a pointer to v, to set the optional and nullable IndicatorUnit properties.
+func IndicatorUnitPtr(v IndicatorUnit) *IndicatorUnit {
+	return &v
+}
+
+// Name returns the name of the IndicatorUnit value, or an empty string for unknown values.
+func (i IndicatorUnit) Name() string {
+	return indicatorUnitNames[i]
+}
+
+// ParseIndicatorUnit returns the IndicatorUnit matching s by wire value or by name.
+func ParseIndicatorUnit(s string) (IndicatorUnit, error) {
+	if _, ok := indicatorUnitNames[IndicatorUnit(s)]; ok {
+		return IndicatorUnit(s), nil
+	}
+	if v, ok := indicatorUnitValues[s]; ok {
+		return v, nil
+	}
+	var zero IndicatorUnit
+	return zero, fmt.Errorf("%w for IndicatorUnit: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (i IndicatorUnit) MarshalText() ([]byte, error) {
+	return []byte(i), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseIndicatorUnit.
+// Unknown values are kept as-is and reported by Validate.
+func (i *IndicatorUnit) UnmarshalText(text []byte) error {
+	*i = IndicatorUnit(text)
+	return nil
+}
+
 type NullableStatus string
 
 const (
@@ -66,6 +211,71 @@ func (n NullableStatus) Validate() error {
 	}
 }
 
+// nullableStatusNames maps NullableStatus values to their names.
+var nullableStatusNames = map[NullableStatus]string{
+	NullableStatusACTIVE:   "ACTIVE",
+	NullableStatusINACTIVE: "INACTIVE",
+}
+
+// nullableStatusValues maps names to NullableStatus values.
+var nullableStatusValues = map[string]NullableStatus{
+	"ACTIVE":   NullableStatusACTIVE,
+	"INACTIVE": NullableStatusINACTIVE,
+}
+
+// String returns the wire value of the NullableStatus.
+func (n NullableStatus) String() string {
+	return string(n)
+}
+
+// IsValid reports whether the NullableStatus value is defined in the spec.
+func (n NullableStatus) IsValid() bool {
+	_, ok := nullableStatusNames[n]
+	return ok
+}
+
+// Values returns all the NullableStatus values defined in the spec.
+func (NullableStatus) Values() []NullableStatus {
+	return []NullableStatus{
+		NullableStatusACTIVE,
+		NullableStatusINACTIVE,
+	}
+}
+
+// NullableStatusPtr returns a pointer to v, to set the optional and nullable NullableStatus properties.
+func NullableStatusPtr(v NullableStatus) *NullableStatus {
+	return &v
+}
+
+// Name returns the name of the NullableStatus value, or an empty string for unknown values.
+func (n NullableStatus) Name() string {
+	return nullableStatusNames[n]
+}
+
+// ParseNullableStatus returns the NullableStatus matching s by wire value or by name.
+func ParseNullableStatus(s string) (NullableStatus, error) {
+	if _, ok := nullableStatusNames[NullableStatus(s)]; ok {
+		return NullableStatus(s), nil
+	}
+	if v, ok := nullableStatusValues[s]; ok {
+		return v, nil
+	}
+	var zero NullableStatus
+	return zero, fmt.Errorf("%w for NullableStatus: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (n NullableStatus) MarshalText() ([]byte, error) {
+	return []byte(n), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseNullableStatus.
+// Unknown values are kept as-is and reported by Validate.
+func (n *NullableStatus) UnmarshalText(text []byte) error {
+	*n = NullableStatus(text)
+	return nil
+}
+
 type Response struct {
 	Status Status `json:"status" validate:"required"`
 
@@ -104,4 +314,5 @@ var typesValidator *validator.Validate
 func init() {
 	typesValidator = validator.New(validator.WithRequiredStructEnabled())
 	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
 }
@@ -216,6 +216,22 @@ func (p *PointRequestOneOf_OneOf) Validate() error {
 	return nil
 }
 
+// UnmarshalJSON holds the variant whose required properties best match the data,
+// or a *runtime.AmbiguousUnionError if several match equally well.
+func (p *PointRequestOneOf_OneOf) UnmarshalJSON(data []byte) error {
+	n, err := runtime.MatchRequiredFields(data,
+		runtime.UnionVariant{Name: "TimeBasedLocation", Required: []string{"time"}, Properties: []string{"time"}},
+		runtime.UnionVariant{Name: "DistanceBasedLocation", Required: []string{"distance"}, Properties: []string{"distance"}},
+	)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return p.Either.UnmarshalJSON(data)
+	}
+	return p.UnmarshalVariant(n, data)
+}
+
 type TimeIntervalType_OneOf struct {
 	runtime.Either[AbsoluteTimeRange, RelativeTimeDuration]
 }
@@ -234,9 +250,26 @@ func (t *TimeIntervalType_OneOf) Validate() error {
 	return nil
 }
 
+// UnmarshalJSON holds the variant whose required properties best match the data,
+// or a *runtime.AmbiguousUnionError if several match equally well.
+func (t *TimeIntervalType_OneOf) UnmarshalJSON(data []byte) error {
+	n, err := runtime.MatchRequiredFields(data,
+		runtime.UnionVariant{Name: "AbsoluteTimeRange", Required: []string{"start", "end"}, Properties: []string{"start", "end"}},
+		runtime.UnionVariant{Name: "RelativeTimeDuration", Required: []string{"duration"}, Properties: []string{"duration"}},
+	)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return t.Either.UnmarshalJSON(data)
+	}
+	return t.UnmarshalVariant(n, data)
+}
+
 var typesValidator *validator.Validate
 
 func init() {
 	typesValidator = validator.New(validator.WithRequiredStructEnabled())
 	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
 }
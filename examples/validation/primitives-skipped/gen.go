@@ -2,6 +2,12 @@
 
 package gen
 
+import (
+	"fmt"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+)
+
 type ResponsePredefined string
 
 const (
@@ -10,6 +16,74 @@ const (
 	C ResponsePredefined = "C"
 )
 
+// responsePredefinedNames maps ResponsePredefined values to their names.
+var responsePredefinedNames = map[ResponsePredefined]string{
+	A: "A",
+	B: "B",
+	C: "C",
+}
+
+// responsePredefinedValues maps names to ResponsePredefined values.
+var responsePredefinedValues = map[string]ResponsePredefined{
+	"A": A,
+	"B": B,
+	"C": C,
+}
+
+// String returns the wire value of the ResponsePredefined.
+func (r ResponsePredefined) String() string {
+	return string(r)
+}
+
+// IsValid reports whether the ResponsePredefined value is defined in the spec.
+func (r ResponsePredefined) IsValid() bool {
+	_, ok := responsePredefinedNames[r]
+	return ok
+}
+
+// Values returns all the ResponsePredefined values defined in the spec.
+func (ResponsePredefined) Values() []ResponsePredefined {
+	return []ResponsePredefined{
+		A,
+		B,
+		C,
+	}
+}
+
+// ResponsePredefinedPtr returns a pointer to v, to set the optional and nullable ResponsePredefined properties.
+func ResponsePredefinedPtr(v ResponsePredefined) *ResponsePredefined {
+	return &v
+}
+
+// Name returns the name of the ResponsePredefined value, or an empty string for unknown values.
+func (r ResponsePredefined) Name() string {
+	return responsePredefinedNames[r]
+}
+
+// ParseResponsePredefined returns the ResponsePredefined matching s by wire value or by name.
+func ParseResponsePredefined(s string) (ResponsePredefined, error) {
+	if _, ok := responsePredefinedNames[ResponsePredefined(s)]; ok {
+		return ResponsePredefined(s), nil
+	}
+	if v, ok := responsePredefinedValues[s]; ok {
+		return v, nil
+	}
+	var zero ResponsePredefined
+	return zero, fmt.Errorf("%w for ResponsePredefined: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (r ResponsePredefined) MarshalText() ([]byte, error) {
+	return []byte(r), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParseResponsePredefined.
+// Unknown values are kept as-is, see IsValid.
+func (r *ResponsePredefined) UnmarshalText(text []byte) error {
+	*r = ResponsePredefined(text)
+	return nil
+}
+
 type Predefined string
 
 const (
@@ -18,6 +92,74 @@ const (
 	C2 Predefined = "C2"
 )
 
+// predefinedNames maps Predefined values to their names.
+var predefinedNames = map[Predefined]string{
+	A2: "A2",
+	B2: "B2",
+	C2: "C2",
+}
+
+// predefinedValues maps names to Predefined values.
+var predefinedValues = map[string]Predefined{
+	"A2": A2,
+	"B2": B2,
+	"C2": C2,
+}
+
+// String returns the wire value of the Predefined.
+func (p Predefined) String() string {
+	return string(p)
+}
+
+// IsValid reports whether the Predefined value is defined in the spec.
+func (p Predefined) IsValid() bool {
+	_, ok := predefinedNames[p]
+	return ok
+}
+
+// Values returns all the Predefined values defined in the spec.
+func (Predefined) Values() []Predefined {
+	return []Predefined{
+		A2,
+		B2,
+		C2,
+	}
+}
+
+// PredefinedPtr returns a pointer to v, to set the optional and nullable Predefined properties.
+func PredefinedPtr(v Predefined) *Predefined {
+	return &v
+}
+
+// Name returns the name of the Predefined value, or an empty string for unknown values.
+func (p Predefined) Name() string {
+	return predefinedNames[p]
+}
+
+// ParsePredefined returns the Predefined matching s by wire value or by name.
+func ParsePredefined(s string) (Predefined, error) {
+	if _, ok := predefinedNames[Predefined(s)]; ok {
+		return Predefined(s), nil
+	}
+	if v, ok := predefinedValues[s]; ok {
+		return v, nil
+	}
+	var zero Predefined
+	return zero, fmt.Errorf("%w for Predefined: %q", runtime.ErrUnknownEnumValue, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the wire value.
+func (p Predefined) MarshalText() ([]byte, error) {
+	return []byte(p), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the wire value, names are only accepted by ParsePredefined.
+// Unknown values are kept as-is, see IsValid.
+func (p *Predefined) UnmarshalText(text []byte) error {
+	*p = Predefined(text)
+	return nil
+}
+
 type Response struct {
 	Msn1                     *MsnWithConstraints    `json:"msn1,omitempty"`
 	Msn2                     *MsnWithoutConstraints `json:"msn2,omitempty"`
@@ -287,6 +287,20 @@ func (r *Response_Friend_AnyOf) FromUser(val User) error {
 	return err
 }
 
+// MergeUser merges the provided User into the union data inside the Response_Friend_AnyOf
+func (r *Response_Friend_AnyOf) MergeUser(val User) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(r.union, bts)
+	if err != nil {
+		return err
+	}
+	r.union = merged
+	return nil
+}
+
 // AsString returns the union data inside the Response_Friend_AnyOf as a string
 func (r *Response_Friend_AnyOf) AsString() (string, error) {
 	return runtime.UnmarshalAs[string](r.union)
@@ -317,6 +331,20 @@ func (r *Response_Friend_AnyOf) FromString(val string) error {
 	return err
 }
 
+// MergeString merges the provided string into the union data inside the Response_Friend_AnyOf
+func (r *Response_Friend_AnyOf) MergeString(val string) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(r.union, bts)
+	if err != nil {
+		return err
+	}
+	r.union = merged
+	return nil
+}
+
 // AsInt returns the union data inside the Response_Friend_AnyOf as a int
 func (r *Response_Friend_AnyOf) AsInt() (int, error) {
 	return runtime.UnmarshalAs[int](r.union)
@@ -347,6 +375,20 @@ func (r *Response_Friend_AnyOf) FromInt(val int) error {
 	return err
 }
 
+// MergeInt merges the provided int into the union data inside the Response_Friend_AnyOf
+func (r *Response_Friend_AnyOf) MergeInt(val int) error {
+	bts, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	merged, err := runtime.JSONMerge(r.union, bts)
+	if err != nil {
+		return err
+	}
+	r.union = merged
+	return nil
+}
+
 // validateUser validates a User value
 func (r *Response_Friend_AnyOf) validateUser(val User) error {
 	if v, ok := any(val).(runtime.Validator); ok {
@@ -400,4 +442,5 @@ var typesValidator *validator.Validate
 func init() {
 	typesValidator = validator.New(validator.WithRequiredStructEnabled())
 	runtime.RegisterCustomTypeFunc(typesValidator)
+	runtime.RegisterValidations(typesValidator)
 }
@@ -0,0 +1,204 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"slices"
+	"strings"
+)
+
+// ChangeKind tells how a declaration changed between two generation runs.
+type ChangeKind string
+
+const (
+	ChangeAdded   ChangeKind = "added"
+	ChangeRemoved ChangeKind = "removed"
+	ChangeChanged ChangeKind = "changed"
+)
+
+// DeclChange is a change of an exported top-level declaration of the generated code.
+// Name is the declared name, with the receiver type for methods, e.g. "Client.GetPet".
+// Old and New hold the declaration, without function bodies, and are empty for added and removed declarations respectively.
+type DeclChange struct {
+	Kind ChangeKind
+	Name string
+	Old  string
+	New  string
+}
+
+// Changelog is the list of changes between two generation runs, sorted by name.
+type Changelog []DeclChange
+
+// DiffGeneratedCode compares the exported types, functions and methods of the previously generated
+// files with the newly generated code. Both are maps of file names to Go source,
+// how the code is split into files doesn't matter.
+func DiffGeneratedCode(previous, current map[string]string) (Changelog, error) {
+	oldDecls, err := collectDecls(previous)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing previous code: %w", err)
+	}
+	newDecls, err := collectDecls(current)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing generated code: %w", err)
+	}
+
+	var res Changelog
+	for name, newDecl := range newDecls {
+		oldDecl, ok := oldDecls[name]
+		switch {
+		case !ok:
+			res = append(res, DeclChange{Kind: ChangeAdded, Name: name, New: newDecl})
+		case oldDecl != newDecl:
+			res = append(res, DeclChange{Kind: ChangeChanged, Name: name, Old: oldDecl, New: newDecl})
+		}
+	}
+	for name, oldDecl := range oldDecls {
+		if _, ok := newDecls[name]; !ok {
+			res = append(res, DeclChange{Kind: ChangeRemoved, Name: name, Old: oldDecl})
+		}
+	}
+
+	slices.SortFunc(res, func(a, b DeclChange) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return res, nil
+}
+
+// Markdown renders the changelog with one section per kind of change.
+// Each line starts with the declared name in backticks, so the file is easy to grep and parse.
+func (c Changelog) Markdown() string {
+	var b strings.Builder
+	b.WriteString("# Generated code changes\n")
+	if len(c) == 0 {
+		b.WriteString("\nNo changes to exported declarations.\n")
+		return b.String()
+	}
+
+	sections := []struct {
+		kind  ChangeKind
+		title string
+	}{
+		{ChangeAdded, "Added"},
+		{ChangeRemoved, "Removed"},
+		{ChangeChanged, "Changed"},
+	}
+	for _, section := range sections {
+		var lines []string
+		for _, change := range c {
+			if change.Kind != section.kind {
+				continue
+			}
+			switch change.Kind {
+			case ChangeAdded:
+				lines = append(lines, fmt.Sprintf("- `%s`: `%s`", change.Name, firstLine(change.New)))
+			case ChangeRemoved:
+				lines = append(lines, fmt.Sprintf("- `%s`: `%s`", change.Name, firstLine(change.Old)))
+			default:
+				lines = append(lines, fmt.Sprintf("- `%s`\n  ```go\n  // before\n%s\n  // after\n%s\n  ```",
+					change.Name, indentLines(change.Old, "  "), indentLines(change.New, "  ")))
+			}
+		}
+		if len(lines) == 0 {
+			continue
+		}
+		b.WriteString("\n## " + section.title + "\n\n")
+		b.WriteString(strings.Join(lines, "\n") + "\n")
+	}
+	return b.String()
+}
+
+// collectDecls returns the exported top-level types, functions and methods of the files by name.
+func collectDecls(files map[string]string) (map[string]string, error) {
+	fset := token.NewFileSet()
+	decls := make(map[string]string)
+	for name, src := range files {
+		file, err := parser.ParseFile(fset, name, src, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, decl := range file.Decls {
+			switch d := decl.(type) {
+			case *ast.FuncDecl:
+				if !d.Name.IsExported() {
+					continue
+				}
+				declName := d.Name.Name
+				if d.Recv != nil && len(d.Recv.List) > 0 {
+					recv := receiverName(d.Recv.List[0].Type)
+					if !ast.IsExported(recv) {
+						continue
+					}
+					declName = recv + "." + declName
+				}
+				sig := *d
+				sig.Body = nil
+				decls[declName] = printNode(fset, &sig)
+			case *ast.GenDecl:
+				if d.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range d.Specs {
+					ts := spec.(*ast.TypeSpec)
+					if !ts.Name.IsExported() {
+						continue
+					}
+					decls[ts.Name.Name] = "type " + printNode(fset, ts)
+				}
+			}
+		}
+	}
+	return decls, nil
+}
+
+// receiverName returns the type name of a method receiver, e.g. "Client" for "*Client".
+func receiverName(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.StarExpr:
+		return receiverName(e.X)
+	case *ast.IndexExpr:
+		return receiverName(e.X)
+	case *ast.IndexListExpr:
+		return receiverName(e.X)
+	case *ast.Ident:
+		return e.Name
+	}
+	return ""
+}
+
+// printNode prints node, comments are only printed for whole files so they are left out.
+func printNode(fset *token.FileSet, node ast.Node) string {
+	var buf bytes.Buffer
+	cfg := printer.Config{Mode: printer.UseSpaces | printer.TabIndent, Tabwidth: 8}
+	_ = cfg.Fprint(&buf, fset, node)
+	return buf.String()
+}
+
+func firstLine(s string) string {
+	line, _, _ := strings.Cut(s, "\n")
+	return strings.TrimSuffix(strings.TrimSpace(line), " {")
+}
+
+func indentLines(s, prefix string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = prefix + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,194 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffGeneratedCode(t *testing.T) {
+	previous := map[string]string{
+		"types.go": `package api
+
+type Pet struct {
+	// Name of the pet.
+	Name string
+	Tag  *string
+}
+
+type Owner struct {
+	Name string
+}
+
+type internal struct{}
+
+func (i internal) Exported() {}
+`,
+		"client.go": `package api
+
+type Client[T any] struct{}
+
+func (c *Client[T]) GetPet(id string) (*Pet, error) {
+	return nil, nil
+}
+
+func (c *Client[T]) ListPets() ([]Pet, error) {
+	return nil, nil
+}
+
+func NewClient() *Client[int] {
+	return nil
+}
+`,
+	}
+
+	t.Run("no changes", func(t *testing.T) {
+		changes, err := DiffGeneratedCode(previous, previous)
+		require.NoError(t, err)
+		assert.Empty(t, changes)
+		assert.Equal(t, "# Generated code changes\n\nNo changes to exported declarations.\n", changes.Markdown())
+	})
+
+	t.Run("changes across files", func(t *testing.T) {
+		current := map[string]string{
+			"all": `package api
+
+// Pet has new docs, only the field type changed.
+type Pet struct {
+	// The name of the pet.
+	Name string
+	Tag  string
+}
+
+type Owner struct {
+	Name string
+}
+
+type Store struct {
+	ID int
+}
+
+type Client[T any] struct{}
+
+func (c *Client[T]) GetPet(id string, opts ...string) (*Pet, error) {
+	return &Pet{}, nil
+}
+
+func (c *Client[T]) ListPets() ([]Pet, error) {
+	var pets []Pet
+	return pets, nil
+}
+
+func NewClient() *Client[int] {
+	return nil
+}
+`,
+		}
+
+		changes, err := DiffGeneratedCode(previous, current)
+		require.NoError(t, err)
+
+		assert.Equal(t, Changelog{
+			{
+				Kind: ChangeChanged,
+				Name: "Client.GetPet",
+				Old:  "func (c *Client[T]) GetPet(id string) (*Pet, error)",
+				New:  "func (c *Client[T]) GetPet(id string, opts ...string) (*Pet, error)",
+			},
+			{
+				Kind: ChangeChanged,
+				Name: "Pet",
+				Old:  "type Pet struct {\n\tName string\n\tTag  *string\n}",
+				New:  "type Pet struct {\n\tName string\n\tTag  string\n}",
+			},
+			{
+				Kind: ChangeAdded,
+				Name: "Store",
+				New:  "type Store struct {\n\tID int\n}",
+			},
+		}, changes)
+
+		assert.Equal(t, "# Generated code changes\n"+
+			"\n## Added\n\n"+
+			"- `Store`: `type Store struct`\n"+
+			"\n## Changed\n\n"+
+			"- `Client.GetPet`\n"+
+			"  ```go\n"+
+			"  // before\n"+
+			"  func (c *Client[T]) GetPet(id string) (*Pet, error)\n"+
+			"  // after\n"+
+			"  func (c *Client[T]) GetPet(id string, opts ...string) (*Pet, error)\n"+
+			"  ```\n"+
+			"- `Pet`\n"+
+			"  ```go\n"+
+			"  // before\n"+
+			"  type Pet struct {\n"+
+			"  \tName string\n"+
+			"  \tTag  *string\n"+
+			"  }\n"+
+			"  // after\n"+
+			"  type Pet struct {\n"+
+			"  \tName string\n"+
+			"  \tTag  string\n"+
+			"  }\n"+
+			"  ```\n", changes.Markdown())
+	})
+
+	t.Run("removed", func(t *testing.T) {
+		changes, err := DiffGeneratedCode(previous, map[string]string{"types.go": previous["types.go"]})
+		require.NoError(t, err)
+
+		require.Len(t, changes, 4)
+		for _, change := range changes {
+			assert.Equal(t, ChangeRemoved, change.Kind)
+		}
+		assert.Equal(t, "Client", changes[0].Name)
+		assert.Equal(t, "# Generated code changes\n"+
+			"\n## Removed\n\n"+
+			"- `Client`: `type Client[T any] struct{}`\n"+
+			"- `Client.GetPet`: `func (c *Client[T]) GetPet(id string) (*Pet, error)`\n"+
+			"- `Client.ListPets`: `func (c *Client[T]) ListPets() ([]Pet, error)`\n"+
+			"- `NewClient`: `func NewClient() *Client[int]`\n", changes.Markdown())
+	})
+
+	t.Run("invalid previous code", func(t *testing.T) {
+		_, err := DiffGeneratedCode(map[string]string{"gen.go": "package"}, previous)
+		assert.ErrorContains(t, err, "error parsing previous code")
+	})
+
+	t.Run("invalid generated code", func(t *testing.T) {
+		_, err := DiffGeneratedCode(previous, map[string]string{"gen.go": "package"})
+		assert.ErrorContains(t, err, "error parsing generated code")
+	})
+}
+
+func TestReceiverName(t *testing.T) {
+	previous := map[string]string{"gen.go": `package api
+
+type Pair[K, V any] struct{}
+
+func (p Pair[K, V]) First() {}
+
+func (Pair[K, V]) Second() {}
+`}
+
+	changes, err := DiffGeneratedCode(nil, previous)
+	require.NoError(t, err)
+
+	var names []string
+	for _, change := range changes {
+		names = append(names, change.Name)
+	}
+	assert.Equal(t, []string{"Pair", "Pair.First", "Pair.Second"}, names)
+}
@@ -11,7 +11,9 @@
 package codegen
 
 import (
+	"errors"
 	"fmt"
+	"log/slog"
 	"strings"
 
 	"github.com/pb33f/libopenapi"
@@ -27,6 +29,27 @@ type ParseContext struct {
 	Imports         []string
 	ResponseErrors  []string
 	TypeTracker     *TypeTracker
+	Info            SpecInfo
+
+	// FieldAlignments are the structs whose fields were reordered with generate.align-fields.
+	FieldAlignments []FieldAlignment
+
+	// model is the filtered and pruned document the code is generated from.
+	model *v3high.Document
+}
+
+// getSpecInfo returns the title, version, checksum and size of the spec.
+func getSpecInfo(model *v3high.Document, doc libopenapi.Document) SpecInfo {
+	var info SpecInfo
+	if model.Info != nil {
+		info.Title = model.Info.Title
+		info.Version = model.Info.Version
+	}
+	if specInfo := doc.GetSpecInfo(); specInfo != nil && specInfo.SpecBytes != nil {
+		info.Checksum = specChecksum(*specInfo.SpecBytes)
+		info.Size = len(*specInfo.SpecBytes)
+	}
+	return info
 }
 
 type operationsCollection struct {
@@ -39,7 +62,14 @@ type operationsCollection struct {
 // Generate creates Go code from an OpenAPI document and a configuration in single file output.
 func Generate(docContents []byte, cfg Configuration) (GeneratedCode, error) {
 	cfg = cfg.WithDefaults()
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
 	parseCtx, errs := CreateParseContext(docContents, cfg)
+	return generateFromParseContext(cfg, parseCtx, errs)
+}
+
+func generateFromParseContext(cfg Configuration, parseCtx *ParseContext, errs []error) (GeneratedCode, error) {
 	if errs != nil {
 		return nil, fmt.Errorf("error creating parse context: %w", errs[0])
 	}
@@ -90,6 +120,25 @@ func CreateParseContextFromDocument(doc libopenapi.Document, cfg Configuration)
 		DefaultIntType:         cfg.Generate.DefaultIntType,
 		AlwaysPrefixEnumValues: cfg.Generate.AlwaysPrefixEnumValues,
 		SkipValidation:         cfg.Generate.Validation.Skip,
+		ResponseUnions:         cfg.Generate.ResponseUnions,
+		IdempotencyKey:         cfg.Generate.IdempotencyKey,
+		ConditionalRequests:    cfg.Generate.ConditionalRequests,
+		ServerBinding:          cfg.Generate.ServerBinding,
+		OperationIDCasing:      cfg.Generate.OperationIDCasing,
+		PreferNullable:         cfg.Output != nil && cfg.Output.PreferNullable,
+		PreferOmitZero:         cfg.Output != nil && cfg.Output.PreferOmitZero,
+		PreferSensitive:        cfg.Output != nil && cfg.Output.PreferSensitive,
+		PatchBodies:            cfg.Generate.PatchBodies,
+		TypedUnions:            cfg.Generate.TypedUnions,
+		AnyOfVariants:          cfg.Generate.AnyOfVariants,
+		MaxDescriptionLength:   cfg.Generate.MaxDescriptionLength,
+		EnforceRemoveAfter:     cfg.Generate.EnforceRemoveAfter,
+		DecimalType:            cfg.Generate.DecimalType,
+		CaptureUnknownFields:   cfg.Generate.CaptureUnknownFields,
+		BasePath:               serverBasePath(cfg.Server),
+		StripBasePath:          cfg.Client != nil && cfg.Client.StripBasePath,
+		ResponseLinks:          cfg.Generate.Client && (cfg.Output == nil || cfg.Output.ClientPackage == ""),
+		FormatTags:             formatValidationTags(cfg.Generate.Validation.Formats),
 		ErrorMapping:           cfg.ErrorMapping,
 		typeTracker:            newTypeTracker(),
 		visited:                map[string]bool{},
@@ -102,16 +151,22 @@ func CreateParseContextFromDocument(doc libopenapi.Document, cfg Configuration)
 		responseErrors []string
 	)
 
+	reserveGeneratedNames(parseOptions.typeTracker, cfg)
+
 	// Process Components
-	typeDefs, err := collectComponentDefinitions(model, parseOptions)
-	if err != nil {
-		return nil, fmt.Errorf("error collecting component definitions: %s", err)
+	typeDefs, componentsErr := collectComponentDefinitions(model, parseOptions)
+	if componentsErr != nil {
+		componentsErr = fmt.Errorf("error collecting component definitions:\n%w", componentsErr)
 	}
 
-	// collect operations
-	opColl, err := collectOperationDefinitions(model, parseOptions)
-	if err != nil {
-		return nil, fmt.Errorf("error collecting operation definitions: %w", err)
+	// collect operations, even when components failed, to report all errors in one run
+	opColl, operationsErr := collectOperationDefinitions(model, parseOptions)
+	if operationsErr != nil {
+		operationsErr = fmt.Errorf("error collecting operation definitions:\n%w", operationsErr)
+	}
+
+	if err = errors.Join(componentsErr, operationsErr); err != nil {
+		return nil, err
 	}
 
 	if opColl != nil {
@@ -135,9 +190,30 @@ func CreateParseContextFromDocument(doc libopenapi.Document, cfg Configuration)
 		}
 		mergeImports(imprts, importRes)
 	}
+	if err = importMap(imprts).checkNames(); err != nil {
+		return nil, fmt.Errorf("error getting schema imports: %w", err)
+	}
 
 	enums, typeDefs := filterOutEnums(typeDefs, parseOptions)
 
+	setKnownFields(typeDefs)
+
+	if cfg.Generate.Client {
+		if operations, err = resolvePagination(operations, typeDefs, cfg.Generate.Pagination); err != nil {
+			return nil, err
+		}
+		operations = resolveLinks(operations)
+	}
+
+	var fieldAlignments []FieldAlignment
+	if cfg.Generate.AlignFields {
+		fieldAlignments = alignStructFields(typeDefs, enums, parseOptions)
+	}
+
+	if cfg.Generate.EnforceReadWriteOnly {
+		setReadWriteOnlyViews(typeDefs)
+	}
+
 	groupedTypeDefs := make(map[SpecLocation][]TypeDefinition)
 	var unionTypes []TypeDefinition
 
@@ -178,6 +254,9 @@ func CreateParseContextFromDocument(doc libopenapi.Document, cfg Configuration)
 		Imports:         importMap(imprts).GoImports(),
 		ResponseErrors:  respErrs,
 		TypeTracker:     parseOptions.typeTracker,
+		Info:            getSpecInfo(model, doc),
+		FieldAlignments: fieldAlignments,
+		model:           model,
 	}, nil
 }
 
@@ -191,38 +270,65 @@ func collectOperationDefinitions(model *v3high.Document, options ParseOptions) (
 		importSchemas  []GoSchema
 		typeDefs       []TypeDefinition
 		responseErrors []string
+		errs           []error
 	)
 
+	// Failing operations are skipped and reported together
 	for path, pathItem := range model.Paths.PathItems.FromOldest() {
 		// These are parameters defined for all methods on a given path. They
 		// are shared by all methods.
 		globalParams, err := describeOperationParameters(pathItem.Parameters, options.WithPath(nil))
 		if err != nil {
-			return nil, fmt.Errorf("error describing global parameters for %s: %s", path, err)
+			errs = append(errs, newSpecError(fmt.Errorf("error describing global parameters for %s: %s", path, err), "paths", path, "parameters"))
+			continue
 		}
 
 		for method, operation := range pathItem.GetOperations().FromOldest() {
 			var (
 				headerDef     *TypeDefinition
 				pathParamsDef *TypeDefinition
+				cookiesDef    *TypeDefinition
 			)
 
-			operationID, err := createOperationID(method, path, operation.OperationId)
+			extensions := extractExtensions(operation.Extensions)
+			httpMethod, err := operationHTTPMethod(method, extensions)
+			if err != nil {
+				errs = append(errs, newSpecError(fmt.Errorf("error in operation %s %s: %w", strings.ToUpper(method), path, err), "paths", path, method))
+				continue
+			}
+			specID := operation.OperationId
+			if specID == "" {
+				specID = synthesizeOperationID(httpMethod, path, options.OperationIDCasing)
+				slog.Warn(fmt.Sprintf("operation %s %s has no operationId, using %s", httpMethod, path, specID))
+			}
+			// the Go name follows the synthesized operationId too
+			operationID, err := operationGoName(httpMethod, path, specID, extensions)
 			if err != nil {
-				return nil, fmt.Errorf("error creating operation ID: %w", err)
+				errs = append(errs, newSpecError(fmt.Errorf("error creating operation ID: %w", err), "paths", path, method))
+				continue
 			}
 
+			omitValidation, err := operationOmitValidation(extensions)
+			if err != nil {
+				errs = append(errs, newSpecError(fmt.Errorf("error in operation %s: %w", operationID, err), "paths", path, method))
+				continue
+			}
+			opOptions := options
+			opOptions.omitValidation = omitValidation
+
 			// These are parameters defined for the specific path method that we're iterating over.
-			localParams, err := describeOperationParameters(operation.Parameters, options.WithPath([]string{operationID}))
+			localParams, err := describeOperationParameters(operation.Parameters, opOptions.WithPath([]string{operationID}))
 			if err != nil {
-				return nil, fmt.Errorf("error describing local parameters for %s/%s: %s", method, path, err)
+				errs = append(errs, newSpecError(fmt.Errorf("error describing local parameters for %s/%s: %s", method, path, err), "paths", path, method))
+				continue
 			}
 
 			// All the parameters required by a handler are the union of the
 			// global parameters and the local parameters.
 			allParams, err := combineOperationParameters(globalParams, localParams)
 			if err != nil {
-				return nil, err
+				errs = append(errs, newSpecError(err, "paths", path, method))
+				continue
 			}
 			for _, param := range allParams {
 				importSchemas = append(importSchemas, param.Schema)
@@ -232,7 +338,7 @@ func collectOperationDefinitions(model *v3high.Document, options ParseOptions) (
 			// the path, not in the openapi spec, and validate that the parameter
 			// names match, as downstream code depends on that.
 			pathParameters := filterParameterDefinitionByType(allParams, "path")
-			reqParamsDef, pathDefs, pathSchemas := generateParamsTypes(pathParameters, operationID+"Path", options)
+			reqParamsDef, pathDefs, pathSchemas := generateParamsTypes(pathParameters, operationID+"Path", opOptions)
 			if reqParamsDef != nil {
 				pathParamsDef = &reqParamsDef.TypeDef
 				typeDefs = append(typeDefs, pathDefs...)
@@ -242,7 +348,7 @@ func collectOperationDefinitions(model *v3high.Document, options ParseOptions) (
 			}
 
 			queryParams := filterParameterDefinitionByType(allParams, "query")
-			queryParamsDef, queryDefs, querySchemas := generateParamsTypes(queryParams, operationID+"Query", options)
+			queryParamsDef, queryDefs, querySchemas := generateParamsTypes(queryParams, operationID+"Query", opOptions)
 			if queryParamsDef != nil {
 				typeDefs = append(typeDefs, queryDefs...)
 				if len(querySchemas) > 0 {
@@ -251,7 +357,7 @@ func collectOperationDefinitions(model *v3high.Document, options ParseOptions) (
 			}
 
 			headerParams := filterParameterDefinitionByType(allParams, "header")
-			headerParamsDef, headerDefs, headerSchemas := generateParamsTypes(headerParams, operationID+"Headers", options)
+			headerParamsDef, headerDefs, headerSchemas := generateParamsTypes(headerParams, operationID+"Headers", opOptions)
 			if headerParamsDef != nil {
 				headerDef = &headerParamsDef.TypeDef
 				typeDefs = append(typeDefs, headerDefs...)
@@ -260,10 +366,24 @@ func collectOperationDefinitions(model *v3high.Document, options ParseOptions) (
 				}
 			}
 
+			// Cookies are only read by the server binding
+			if options.ServerBinding {
+				cookieParams := filterParameterDefinitionByType(allParams, "cookie")
+				cookieParamsDef, cookieDefs, cookieSchemas := generateParamsTypes(cookieParams, operationID+"Cookies", opOptions)
+				if cookieParamsDef != nil {
+					cookiesDef = &cookieParamsDef.TypeDef
+					typeDefs = append(typeDefs, cookieDefs...)
+					if len(cookieSchemas) > 0 {
+						importSchemas = append(importSchemas, cookieSchemas...)
+					}
+				}
+			}
+
 			// Process Request Body
-			bodyDefinition, bodyTypeDef, err := createBodyDefinition(operationID, operation.RequestBody, options)
+			bodyDefinition, bodyTypeDef, err := createBodyDefinition(operationID, operation.RequestBody, opOptions)
 			if err != nil {
-				return nil, fmt.Errorf("error generating body definitions: %w", err)
+				errs = append(errs, newSpecError(fmt.Errorf("error generating body definitions: %w", err), "paths", path, method))
+				continue
 			}
 			if bodyTypeDef != nil {
 				typeDefs = append(typeDefs, *bodyTypeDef)
@@ -275,9 +395,10 @@ func collectOperationDefinitions(model *v3high.Document, options ParseOptions) (
 
 			// Process Responses
 			response := ResponseDefinition{}
-			responseDef, responseTypes, err := getOperationResponses(operationID, operation.Responses, options)
+			responseDef, responseTypes, err := getOperationResponses(operationID, operation.Responses, opOptions)
 			if err != nil {
-				return nil, fmt.Errorf("error getting operation responses: %w", err)
+				errs = append(errs, newSpecError(fmt.Errorf("error getting operation responses: %w", err), "paths", path, method))
+				continue
 			}
 			if responseTypes != nil {
 				typeDefs = append(typeDefs, responseTypes...)
@@ -292,25 +413,95 @@ func collectOperationDefinitions(model *v3high.Document, options ParseOptions) (
 				}
 			}
 
+			idempotencyKeyHeader, err := operationIdempotencyKeyHeader(httpMethod, extensions, options.IdempotencyKey)
+			if err != nil {
+				errs = append(errs, newSpecError(fmt.Errorf("error in operation %s: %w", operationID, err), "paths", path, method))
+				continue
+			}
+			timeout, err := operationTimeout(extensions)
+			if err != nil {
+				errs = append(errs, newSpecError(fmt.Errorf("error in operation %s: %w", operationID, err), "paths", path, method))
+				continue
+			}
+			dedupe, err := operationDedupe(extensions)
+			if err != nil {
+				errs = append(errs, newSpecError(fmt.Errorf("error in operation %s: %w", operationID, err), "paths", path, method))
+				continue
+			}
+			pagination, err := operationPagination(extensions)
+			if err != nil {
+				errs = append(errs, newSpecError(fmt.Errorf("error in operation %s: %w", operationID, err), "paths", path, method))
+				continue
+			}
+			featureFlag, err := operationFeatureFlag(extensions)
+			if err != nil {
+				errs = append(errs, newSpecError(fmt.Errorf("error in operation %s: %w", operationID, err), "paths", path, method))
+				continue
+			}
+			logSampleRate, err := operationLogSampleRate(extensions)
+			if err != nil {
+				errs = append(errs, newSpecError(fmt.Errorf("error in operation %s: %w", operationID, err), "paths", path, method))
+				continue
+			}
+			sensitiveParams, err := operationSensitiveParameters(allParams)
+			if err != nil {
+				errs = append(errs, newSpecError(fmt.Errorf("error in operation %s: %w", operationID, err), "paths", path, method))
+				continue
+			}
+			jsonRPCMethod, err := operationJSONRPCMethod(extensions, httpMethod, bodyDefinition, response)
+			if err != nil {
+				errs = append(errs, newSpecError(fmt.Errorf("error in operation %s: %w", operationID, err), "paths", path, method))
+				continue
+			}
+
 			operations = append(operations, OperationDefinition{
-				ID:          operationID,
-				Summary:     operation.Summary,
-				Description: operation.Description,
-				// https://datatracker.ietf.org/doc/html/rfc7231
-				Method:     strings.ToUpper(method),
-				Path:       path,
-				PathParams: pathParamsDef,
-				Header:     headerDef,
-				Query:      queryParamsDef,
-				Response:   response,
-				Body:       bodyDefinition,
+				ID:            operationID,
+				SpecID:        specID,
+				SynthesizedID: operation.OperationId == "",
+				Summary:       operation.Summary,
+				Description:   operation.Description,
+				// https://datatracker.ietf.org/doc/html/rfc9110#name-methods
+				Method:      httpMethod,
+				Path:        joinBasePath(options.BasePath, path),
+				RequestPath: joinBasePath(clientBasePath(options), path),
+				PathParams:  pathParamsDef,
+				Header:      headerDef,
+				Query:       queryParamsDef,
+				Response:    response,
+				Body:        bodyDefinition,
+
+				IdempotencyKeyHeader: idempotencyKeyHeader,
+				Conditional:          operationConditional(httpMethod, allParams, options.ConditionalRequests),
+				Security:             operationSecurity(operation.Security, model.Security),
+				Timeout:              timeout,
+				Dedupe:               dedupe,
+				FeatureFlag:          featureFlag,
+				LogSampleRate:        logSampleRate,
+				SensitiveParameters:  sensitiveParams,
+				OmitValidation:       omitValidation,
+				Tags:                 operation.Tags,
+				Cookies:              cookiesDef,
+				Parameters:           allParams,
+				JSONRPCMethod:        jsonRPCMethod,
+				pagination:           pagination,
 			})
 		}
 	}
 
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
 	// Deduplicate operation IDs and resolve RequestOptions name collisions
 	operations = deduplicateOperationIDs(operations)
 	operations = resolveRequestOptionsCollisions(operations, options.typeTracker)
+	if options.ResponseUnions {
+		operations = resolveResponseUnionNames(operations, options.typeTracker)
+	}
+	if options.ServerBinding {
+		operations = resolveServerBindingNames(operations, options.typeTracker)
+	}
+	operations = resolveOperationConstNames(operations, options.typeTracker)
 
 	allTypeDefs := extractAllTypeDefinitions(typeDefs)
 
@@ -342,6 +533,85 @@ func resolveRequestOptionsCollisions(operations []OperationDefinition, tracker *
 	return result
 }
 
+// resolveResponseUnionNames assigns a unique result interface name to every operation with multiple responses.
+// The names of the interface, its visitor and per status code implementations are reserved in the tracker.
+// Operations with a streamed success response are skipped, their body is returned unread, and so are JSON-RPC ones.
+func resolveResponseUnionNames(operations []OperationDefinition, tracker *TypeTracker) []OperationDefinition {
+	for i, op := range operations {
+		cases := op.Response.Cases()
+		if len(cases) < 2 || op.Response.Success.IsStream || op.JSONRPCMethod != "" {
+			continue
+		}
+
+		name := tracker.generateUniqueName(UppercaseFirstCharacter(op.ID) + "Result")
+		tracker.registerName(name)
+		tracker.registerName(name + "Visitor")
+		for _, c := range cases {
+			tracker.registerName(name + c.CaseName())
+		}
+		operations[i].Response.UnionName = name
+	}
+
+	return operations
+}
+
+// resolveServerBindingNames assigns unique names to the bound request struct and handler of every operation.
+// The names of the Bind functions of the requests are reserved in the tracker too.
+func resolveServerBindingNames(operations []OperationDefinition, tracker *TypeTracker) []OperationDefinition {
+	for i, op := range operations {
+		request := tracker.generateUniqueName(UppercaseFirstCharacter(op.ID) + "Request")
+		tracker.registerName(request)
+		tracker.registerName("Bind" + request)
+		handler := tracker.generateUniqueName(UppercaseFirstCharacter(op.ID) + "Handler")
+		tracker.registerName(handler)
+		operations[i].Binding = &ServerBindingDefinition{RequestName: request, HandlerName: handler}
+	}
+
+	return operations
+}
+
+// resolveOperationConstNames assigns unique names to the feature flag, log sample rate and sensitive parameters
+// declared for operations with x-feature-flag, x-log-sample-rate and x-sensitive-data parameters.
+func resolveOperationConstNames(operations []OperationDefinition, tracker *TypeTracker) []OperationDefinition {
+	reserve := func(name string) string {
+		name = tracker.generateUniqueName(name)
+		tracker.registerName(name)
+		return name
+	}
+
+	for i, op := range operations {
+		if op.FeatureFlag != "" {
+			operations[i].FeatureFlagName = reserve(op.ID + "FeatureFlag")
+		}
+		if op.LogSampleRate > 0 {
+			operations[i].LogSampleRateName = reserve(op.ID + "LogSampleRate")
+		}
+		if len(op.SensitiveParameters) > 0 {
+			operations[i].SensitiveParametersName = reserve(op.ID + "SensitiveParameters")
+		}
+	}
+
+	return operations
+}
+
+// reserveGeneratedNames registers the names of the package-level declarations generated next to the types,
+// so that schemas with the same names are renamed instead of redeclaring them.
+func reserveGeneratedNames(tracker *TypeTracker, cfg Configuration) {
+	var names []string
+	if cfg.Generate.SpecMetadata {
+		names = append(names, "SpecTitle", "SpecVersion", "SpecChecksum")
+		if cfg.Generate.Client {
+			names = append(names, "DefaultUserAgent")
+		}
+	}
+	if cfg.Generate.EmbedSpec {
+		names = append(names, "GetSwagger", "ServeSpec", "SpecUIHandler")
+	}
+	for _, name := range names {
+		tracker.registerName(name)
+	}
+}
+
 // deduplicateOperationIDs ensures all operation IDs are unique by appending a suffix to duplicates
 func deduplicateOperationIDs(operations []OperationDefinition) []OperationDefinition {
 	seen := make(map[string]int) // map of operation ID to count
@@ -372,7 +642,10 @@ func collectComponentDefinitions(model *v3high.Document, options ParseOptions) (
 		return nil, nil
 	}
 
-	var typeDefs []TypeDefinition
+	var (
+		typeDefs []TypeDefinition
+		errs     []error
+	)
 
 	// Pre-register schema names and refs FIRST, before processing any other components.
 	// This ensures that when parameters/requestBodies/responses reference schemas,
@@ -390,7 +663,7 @@ func collectComponentDefinitions(model *v3high.Document, options ParseOptions) (
 	if model.Components.Parameters != nil {
 		res, err := getComponentParameters(model.Components.Parameters, options)
 		if err != nil {
-			return nil, err
+			errs = append(errs, err)
 		}
 		typeDefs = append(typeDefs, res...)
 	}
@@ -399,7 +672,7 @@ func collectComponentDefinitions(model *v3high.Document, options ParseOptions) (
 	if model.Components.Schemas != nil {
 		schemas, err := generateSchemaDefinitions(model.Components.Schemas, schemaNames, options)
 		if err != nil {
-			return nil, fmt.Errorf("error getting components schemas: %w", err)
+			errs = append(errs, err)
 		}
 		typeDefs = append(typeDefs, schemas...)
 	}
@@ -408,7 +681,7 @@ func collectComponentDefinitions(model *v3high.Document, options ParseOptions) (
 	if model.Components.RequestBodies != nil {
 		bodyTypes, err := getComponentsRequestBodies(model.Components.RequestBodies, options)
 		if err != nil {
-			return nil, fmt.Errorf("error getting components request bodies: %w", err)
+			errs = append(errs, fmt.Errorf("error getting components request bodies: %w", err))
 		}
 		typeDefs = append(typeDefs, bodyTypes...)
 	}
@@ -417,11 +690,15 @@ func collectComponentDefinitions(model *v3high.Document, options ParseOptions) (
 	if model.Components.Responses != nil {
 		componentResponses, err := getComponentResponses(model.Components.Responses, options)
 		if err != nil {
-			return nil, fmt.Errorf("error getting content responses: %w", err)
+			errs = append(errs, fmt.Errorf("error getting content responses: %w", err))
 		}
 		typeDefs = append(typeDefs, componentResponses...)
 	}
 
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
 	all := extractAllTypeDefinitions(typeDefs)
 	return all, nil
 }
@@ -14,6 +14,8 @@ import (
 	"embed"
 	"go/format"
 	"os"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -64,6 +66,14 @@ func TestExampleOpenAPICodeGeneration(t *testing.T) {
 	assert.Contains(t, code, "N2 EnumTestNumerics = 2")
 	assert.Contains(t, code, "type EnumTestEnumNames int")
 	assert.Contains(t, code, "Two  EnumTestEnumNames = 2")
+
+	// Enums map between wire values and names
+	assert.Contains(t, code, "func (e EnumTestEnumNames) String() string")
+	assert.Contains(t, code, "func (e EnumTestEnumNames) Name() string")
+	assert.Contains(t, code, "func ParseEnumTestEnumNames(s string) (EnumTestEnumNames, error)")
+	assert.Contains(t, code, "Two:  \"Two\",")
+	assert.Contains(t, code, "\"Two\":  Two,")
+	assert.Contains(t, code, "case \"2\":\n\t\treturn Two, nil")
 }
 
 func TestExtPropGoTypeSkipOptionalPointer(t *testing.T) {
@@ -268,6 +278,43 @@ func TestBackslashEscapingJSON(t *testing.T) {
 	require.NoError(t, err, "Generated code should compile without syntax errors")
 }
 
+func TestDescriptionSanitization(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "testdescriptions",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client: true,
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "descriptions.yml")), cfg)
+	require.NoError(t, err)
+
+	codeStr := codes.GetCombined()
+	assert.Contains(t, codeStr, "// Widget A widget, see `/*` and `* /`.[0m Größe: 日本語")
+	assert.Contains(t, codeStr, "// ListWidgets Lists widgets * / with a NUL\n// and a\n// line separator")
+	assert.Contains(t, codeStr, "// Name The widget name. It is shown to customers in the catalog, in receipts and in every email we send about the widget.")
+	assert.NotContains(t, codeStr, "\x00")
+	assert.NotContains(t, codeStr, "\uFEFF")
+
+	_, err = format.Source([]byte(codeStr))
+	require.NoError(t, err, "Generated code should compile without syntax errors")
+
+	t.Run("max description length", func(t *testing.T) {
+		cfg.Generate.MaxDescriptionLength = 30
+
+		codes, err := Generate([]byte(readTestdata(t, "descriptions.yml")), cfg)
+		require.NoError(t, err)
+
+		codeStr := codes.GetCombined()
+		assert.Contains(t, codeStr, "// ListWidgets Lists widgets * / with a NUL... (truncated, see the spec for the full description)")
+		assert.Contains(t, codeStr, "// Name The widget name. It is shown... (truncated, see the spec for the full description)")
+		assert.Contains(t, codeStr, "// Widget A widget, see `/*` and... (truncated, see the spec for the full description)")
+	})
+}
+
 func TestArrayItemPropertyNamedItem(t *testing.T) {
 	// Test that when an array item has a property named "item", the array item type
 	// gets a unique name (with numeric suffix) to avoid collision with the property's type.
@@ -364,3 +411,1030 @@ func TestOperationResponseAliasReusesSameType(t *testing.T) {
 	_, err = format.Source([]byte(code))
 	require.NoError(t, err, "Generated code should compile without syntax errors")
 }
+
+// TestQueryArrayParameterConstraints tests that minItems, maxItems and uniqueItems
+// on array query parameters end up in the generated validation.
+func TestQueryArrayParameterConstraints(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "api",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "query-array-constraints.yml")), cfg)
+	require.NoError(t, err)
+
+	code := codes.GetCombined()
+
+	assert.Contains(t, code, "Ids  []string `json:\"ids\" validate:\"required,max=5,min=1,unique\"`")
+	assert.Contains(t, code, "Tags []string `json:\"tags,omitempty\" validate:\"omitempty,max=3\"`")
+
+	// Items of tags need custom validation, the slice itself must still be checked
+	assert.Contains(t, code, `typesValidator.Var(l.Tags, "omitempty,max=3")`)
+	assert.Contains(t, code, `typesValidator.Var(item, "omitempty,min=2")`)
+
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+}
+
+// TestRequestBodyValidation tests that client methods validate the request body before it is sent
+// unless it is turned off.
+func TestRequestBodyValidation(t *testing.T) {
+	spec := []byte(readTestdata(t, "user.yml"))
+	validateCall := "return nil, fmt.Errorf(\"error validating request body: %w\", err)"
+
+	tests := []struct {
+		name       string
+		validation ValidationOptions
+		expected   bool
+	}{
+		{name: "default", expected: true},
+		{name: "skip request", validation: ValidationOptions{SkipRequest: true}},
+		{name: "skip validation", validation: ValidationOptions{Skip: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Configuration{
+				PackageName: "api",
+				Output: &Output{
+					UseSingleFile: true,
+				},
+				Generate: &GenerateOptions{
+					Client:     true,
+					Validation: tt.validation,
+				},
+			}
+
+			codes, err := Generate(spec, cfg)
+			require.NoError(t, err)
+
+			code := codes.GetCombined()
+			if tt.expected {
+				assert.Contains(t, code, validateCall)
+			} else {
+				assert.NotContains(t, code, validateCall)
+			}
+
+			_, err = format.Source([]byte(code))
+			require.NoError(t, err)
+		})
+	}
+}
+
+// TestStreamResponses tests that binary, event-stream and JSON Lines responses are returned unread.
+func TestStreamResponses(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "api",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client:         true,
+			ResponseUnions: true,
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "stream-responses.yml")), cfg)
+	require.NoError(t, err)
+
+	code := codes.GetCombined()
+
+	assert.Contains(t, code, "DownloadFile(ctx context.Context, options *DownloadFileRequestOptions, reqEditors ...runtime.RequestEditorFn) (io.ReadCloser, error)")
+	assert.Contains(t, code, "StreamEvents(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (io.ReadCloser, error)")
+	assert.Contains(t, code, "GetBlob(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (io.ReadCloser, error)")
+	assert.Contains(t, code, "runtime.ExecuteStreamRequest(ctx, c.apiClient, req, \"/files/{id}\")")
+
+	// streamed operations are not wrapped in a result union
+	assert.NotContains(t, code, "DownloadFileResult")
+
+	// only event streams get a typed iterator
+	assert.Contains(t, code, "StreamEventsEvents(ctx context.Context, reqEditors ...runtime.RequestEditorFn) iter.Seq2[StreamEventsResponse, error]")
+	assert.Contains(t, code, "runtime.SSEEvents[StreamEventsResponse]")
+	assert.Contains(t, code, "}, runtime.ClientJSONCodec(c.apiClient, nil))")
+	assert.NotContains(t, code, "DownloadFileEvents")
+	assert.NotContains(t, code, "GetBlobEvents")
+
+	// JSON Lines get a typed iterator over the lines
+	assert.Contains(t, code, "ExportOrders(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (io.ReadCloser, error)")
+	assert.Contains(t, code, "ExportOrdersLines(ctx context.Context, reqEditors ...runtime.RequestEditorFn) iter.Seq2[ExportOrdersResponse, error]")
+	assert.Contains(t, code, "runtime.JSONLines[ExportOrdersResponse]")
+	assert.Contains(t, code, "}, runtime.ClientJSONCodec(c.apiClient, nil))")
+	assert.NotContains(t, code, "StreamEventsLines")
+	assert.NotContains(t, code, "ExportOrdersResume")
+
+	// downloads accept partial content and can be resumed, event streams can't
+	assert.Contains(t, code, "DownloadFileResume(ctx context.Context, options *DownloadFileRequestOptions, dst io.WriterAt, offset int64, reqEditors ...runtime.RequestEditorFn) (int64, error)")
+	assert.Contains(t, code, "GetBlobResume(ctx context.Context, dst io.WriterAt, offset int64, reqEditors ...runtime.RequestEditorFn) (int64, error)")
+	assert.Contains(t, code, "resp.StatusCode != 200 && resp.StatusCode != 206")
+	assert.NotContains(t, code, "StreamEventsResume")
+
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+
+	t.Run("server binding", func(t *testing.T) {
+		cfg := cfg
+		cfg.Generate = &GenerateOptions{ServerBinding: true}
+
+		codes, err := Generate([]byte(readTestdata(t, "stream-responses.yml")), cfg)
+		require.NoError(t, err)
+
+		// only event streams get a typed event writer
+		code := codes.GetCombined()
+		assert.Contains(t, code, "func NewStreamEventsEventWriter(w http.ResponseWriter, opts ...runtime.EventWriterOption) *runtime.EventWriter[StreamEventsResponse] {")
+		assert.NotContains(t, code, "NewDownloadFileEventWriter")
+		assert.NotContains(t, code, "NewExportOrdersEventWriter")
+	})
+}
+
+func TestIdempotencyKey(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "api",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client:         true,
+			IdempotencyKey: true,
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "idempotency-key.yml")), cfg)
+	require.NoError(t, err)
+
+	code := codes.GetCombined()
+
+	// POST operations get the default header, x-idempotency-key overrides the header or opts out
+	assert.Contains(t, code, `RequestURL:           c.apiClient.GetBaseURL() + "/payments",
+		Method:               "POST",
+		IdempotencyKeyHeader: "Idempotency-Key",`)
+	assert.Contains(t, code, `IdempotencyKeyHeader: "X-Capture-Key",`)
+	assert.Equal(t, 2, strings.Count(code, "IdempotencyKeyHeader:"))
+
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+}
+
+// TestSpecMetadata tests that the title, version and checksum of the spec are generated as constants.
+func TestSpecMetadata(t *testing.T) {
+	spec := []byte(readTestdata(t, "idempotency-key.yml"))
+
+	t.Run("opt-in", func(t *testing.T) {
+		cfg := Configuration{
+			PackageName: "api",
+			Generate:    &GenerateOptions{Client: true, SpecMetadata: true},
+			Output: &Output{
+				UseSingleFile: true,
+			},
+		}
+
+		codes, err := Generate(spec, cfg)
+		require.NoError(t, err)
+
+		code := codes.GetCombined()
+		assert.Contains(t, code, `SpecTitle = "Payments"`)
+		assert.Contains(t, code, `SpecVersion = "1.0.0"`)
+		assert.Contains(t, code, `SpecChecksum = "`+specChecksum(spec)+`"`)
+		assert.Contains(t, specChecksum(spec), "sha256:")
+		assert.Contains(t, code, "const DefaultUserAgent = ")
+		assert.Contains(t, code, "runtime.WithUserAgent(DefaultUserAgent)")
+
+		_, err = format.Source([]byte(code))
+		require.NoError(t, err)
+	})
+
+	t.Run("not generated by default", func(t *testing.T) {
+		cfg := Configuration{
+			PackageName: "api",
+			Generate:    &GenerateOptions{Client: true},
+			Output: &Output{
+				UseSingleFile: true,
+			},
+		}
+
+		codes, err := Generate(spec, cfg)
+		require.NoError(t, err)
+
+		code := codes.GetCombined()
+		assert.NotContains(t, code, "SpecTitle")
+		assert.NotContains(t, code, "DefaultUserAgent")
+		assert.Contains(t, code, `runtime.WithUserAgent("`+defaultUserAgent(SpecInfo{Title: "Payments", Version: "1.0.0"}, generatorVersion())+`")`)
+
+		_, err = format.Source([]byte(code))
+		require.NoError(t, err)
+	})
+}
+
+// TestReservedNames tests that schemas named like the generated spec metadata, user agent and
+// operation constants are renamed instead of redeclaring them.
+func TestReservedNames(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "api",
+		Generate:    &GenerateOptions{Client: true, SpecMetadata: true},
+		Output: &Output{
+			UseSingleFile: true,
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "reserved-names.yml")), cfg)
+	require.NoError(t, err)
+
+	code := codes.GetCombined()
+	assert.Contains(t, code, `SpecVersion = "1.0.0"`)
+	assert.Contains(t, code, "type SpecVersion0 struct")
+	assert.Contains(t, code, "type GetHealthResponse = SpecVersion0")
+	assert.Contains(t, code, "const DefaultUserAgent = ")
+	assert.Contains(t, code, "type DefaultUserAgent0 = string")
+	assert.Contains(t, code, `GetHealthFeatureFlag0 = "health-check"`)
+	assert.Contains(t, code, "type GetHealthFeatureFlag = bool")
+	assert.Contains(t, code, "GetHealthLogSampleRate0 = 0.5")
+	assert.Contains(t, code, "LogSampleRate: GetHealthLogSampleRate0,")
+
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+
+	t.Run("spec metadata names are free by default", func(t *testing.T) {
+		cfg.Generate.SpecMetadata = false
+		codes, err := Generate([]byte(readTestdata(t, "reserved-names.yml")), cfg)
+		require.NoError(t, err)
+
+		code := codes.GetCombined()
+		assert.Contains(t, code, "type SpecVersion struct")
+		assert.Contains(t, code, "type DefaultUserAgent = string")
+	})
+}
+
+// TestResponseUnions tests that operations with multiple responses get a sealed result interface
+// whose name doesn't collide with existing types.
+func TestResponseUnions(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "api",
+		SkipPrune:   true,
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client:         true,
+			ResponseUnions: true,
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "response-unions.yml")), cfg)
+	require.NoError(t, err)
+
+	code := codes.GetCombined()
+
+	// GetPetResult is taken by the component schema
+	assert.Contains(t, code, "type GetPetResult struct")
+	assert.Contains(t, code, "type GetPetResult0 interface")
+	assert.Contains(t, code, "Visit200(res *GetPetResult0200) error")
+	assert.Contains(t, code, "Visit404(res *GetPetResult0404) error")
+	assert.Contains(t, code, "GetPetResult(ctx context.Context, options *GetPetRequestOptions, reqEditors ...runtime.RequestEditorFn) (GetPetResult0, error)")
+
+	// ranges are matched after single status codes
+	assert.Regexp(t, `Visit200\(res \*ListPetsResult200\) error\s+Visit404\(res \*ListPetsResult404\) error\s+Visit4XX\(res \*ListPetsResult4XX\) error`, code)
+	assert.Contains(t, code, "case resp.StatusCode >= 400 && resp.StatusCode < 500:")
+	assert.Contains(t, code, "res := &ListPetsResult4XX{Headers: resp.Headers, Status: resp.StatusCode}")
+
+	// the default response matches all other status codes
+	assert.Contains(t, code, "VisitDefault(res *CreatePetResultDefault) error")
+	assert.Regexp(t, `default:\s+res := &CreatePetResultDefault\{Headers: resp.Headers, Status: resp.StatusCode\}`, code)
+
+	// single response operations are not wrapped
+	assert.NotContains(t, code, "DeletePetsResult")
+
+	// redirects without content are returned with their Location
+	assert.Contains(t, code, "Visit302(res *AuthorizeResult302) error")
+	assert.Regexp(t, `type AuthorizeResult302 struct \{\s+Headers http.Header\s+// Location .*\s+Location \*url.URL\s+\}`, code)
+	assert.Contains(t, code, "if res.Location, err = resp.Location(); err != nil {")
+
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+}
+
+// TestServerBinding tests that operations get a request struct bound from an *http.Request,
+// with the cookie parameters only generated for it.
+func TestServerBinding(t *testing.T) {
+	spec := []byte(readTestdata(t, "server-binding.yml"))
+	cfg := Configuration{
+		PackageName: "api",
+		SkipPrune:   true,
+		Output:      &Output{UseSingleFile: true},
+		Generate:    &GenerateOptions{ServerBinding: true},
+	}
+
+	codes, err := Generate(spec, cfg)
+	require.NoError(t, err)
+
+	code := codes.GetCombined()
+
+	// UpdatePetRequest is taken by the component schema
+	assert.Contains(t, code, "type UpdatePetRequest struct")
+	assert.Regexp(t, `type UpdatePetRequest0 struct \{
+\s+PathParams \*UpdatePetPath
+\s+Query +\*UpdatePetQuery
+\s+Body +\*UpdatePetBody
+\s+Header +\*UpdatePetHeaders
+\s+Cookies +\*UpdatePetCookies
+\}`, code)
+	assert.Contains(t, code, "func (u UpdatePetCookies) Validate() error")
+	assert.Contains(t, code, "func (o *UpdatePetRequest0) Validate() error")
+	assert.Contains(t, code, "func BindUpdatePetRequest0(r *http.Request) (*UpdatePetRequest0, error)")
+	assert.Contains(t, code, "func UpdatePetHandler(handle func(w http.ResponseWriter, r *http.Request, req *UpdatePetRequest0), onError runtime.BindErrorHandler) http.HandlerFunc")
+
+	// the encoding of the parameters is passed to the binders
+	assert.Contains(t, code, `"petId": {Required: true},`)
+	assert.Contains(t, code, `"fields": {Style: "form", Explode: runtime.Ptr(false)},`)
+	assert.Contains(t, code, `"since":  {TimeFormat: "unix"},`)
+	assert.Contains(t, code, `"filter": {Style: "deepObject"},`)
+	assert.Contains(t, code, "runtime.BindCookies(r, req.Cookies, nil)")
+	assert.Contains(t, code, `return nil, &runtime.BindError{In: "body", Err: runtime.ErrMissingValue}`)
+
+	// only JSON bodies are bound, and requests without inputs aren't validated
+	assert.Regexp(t, `type UploadPetsRequest struct \{\s*\}`, code)
+	assert.NotContains(t, code, "func (o *ListPetsRequest) Validate() error")
+
+	// the validators of the routes bind the requests and decode the JSON responses
+	assert.Contains(t, code, "var OperationValidators = map[string]runtime.OperationValidator{")
+	assert.Regexp(t, `"PUT /pets/\{petId\}": \{
+\s+Request: func\(r \*http.Request\) error \{
+\s+_, err := BindUpdatePetRequest0\(r\)`, code)
+	assert.Regexp(t, `case status == 200:
+\s+res = new\(UpdatePetResponse\)
+\s+default:
+\s+res = new\(UpdatePetErrorResponse\)
+\s+\}`, code)
+	assert.Regexp(t, `"GET /pets": \{
+\s+Request: func\(r \*http.Request\) error \{
+\s+_, err := BindListPetsRequest\(r\)
+\s+return err
+\s+\},
+\s+\},`, code)
+
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+
+	t.Run("no cookies without server binding", func(t *testing.T) {
+		codes, err := Generate(spec, Configuration{PackageName: "api", Generate: &GenerateOptions{Client: true}})
+		require.NoError(t, err)
+
+		code := codes.GetCombined()
+		assert.NotContains(t, code, "UpdatePetCookies")
+		assert.NotContains(t, code, "BindUpdatePetRequest")
+	})
+
+	t.Run("split by concern", func(t *testing.T) {
+		cfg := cfg
+		cfg.Output = &Output{SplitByConcern: true}
+
+		codes, err := Generate(spec, cfg)
+		require.NoError(t, err)
+
+		server := codes[ServerFile]
+		assert.Contains(t, server, "func BindListPetsRequest(r *http.Request) (*ListPetsRequest, error)")
+		assert.Contains(t, server, "func (o *UpdatePetRequest0) Validate() error")
+		assert.NotContains(t, codes[TypesFile], "BindListPetsRequest")
+	})
+}
+
+func TestServerRouter(t *testing.T) {
+	spec := readTestdata(t, "server-binding.yml")
+	cfg := Configuration{
+		PackageName: "api",
+		SkipPrune:   true,
+		Output:      &Output{UseSingleFile: true},
+		Generate:    &GenerateOptions{ServerBinding: true, ServerRouter: true},
+	}
+
+	codes, err := Generate([]byte(spec), cfg)
+	require.NoError(t, err)
+
+	code := codes.GetCombined()
+	assert.Contains(t, code, "UpdatePet(w http.ResponseWriter, r *http.Request, req *UpdatePetRequest0)")
+	assert.Contains(t, code, `const OperationIDContextKey serverContextKey = "operationId"`)
+	assert.Contains(t, code, "func HandlerWithOptions(si ServerInterface, options ServerOptions) http.Handler")
+	assert.Contains(t, code, "mux.Handle(\"PUT /pets/{petId}\", options.wrap(serverRoute{\n"+
+		"\t\toperationID: \"updatePet\",\n"+
+		"\t\ttags:        []string{\"pets\", \"admin\"},\n"+
+		"\t}, UpdatePetHandler(si.UpdatePet, options.ErrorHandler)))")
+	assert.Contains(t, code, "mux.Handle(\"POST /pets\", options.wrap(serverRoute{\n"+
+		"\t\toperationID: \"uploadPets\",\n"+
+		"\t}, UploadPetsHandler(si.UploadPets, options.ErrorHandler)))")
+
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+
+	t.Run("invalid pattern", func(t *testing.T) {
+		invalid := strings.ReplaceAll(spec, "petId", "pet-id")
+
+		_, err := Generate([]byte(invalid), cfg)
+		assert.ErrorIs(t, err, ErrInvalidRoute)
+	})
+}
+
+func TestJSONRPC(t *testing.T) {
+	spec := readTestdata(t, "jsonrpc.yml")
+	cfg := Configuration{
+		PackageName: "api",
+		Output:      &Output{UseSingleFile: true},
+		Generate:    &GenerateOptions{Client: true, ResponseUnions: true},
+	}
+
+	codes, err := Generate([]byte(spec), cfg)
+	require.NoError(t, err)
+
+	code := codes.GetCombined()
+	assert.Contains(t, code, "GetPet(ctx context.Context, params *GetPetBody, reqEditors ...runtime.RequestEditorFn) (*GetPetResponse, error)")
+	assert.Contains(t, code, "CountPets(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (*CountPetsResponse, error)")
+	assert.Contains(t, code, `call := runtime.NewJSONRPCRequest("pets.get", nil)`)
+	assert.Contains(t, code, `RequestURL:  c.apiClient.GetBaseURL() + "/rpc",`)
+	assert.Contains(t, code, "details := new(GetPetErrorResponse)")
+
+	// JSON-RPC methods return their result, not a union of the responses
+	assert.NotContains(t, code, "GetPetResult")
+	assert.Contains(t, code, "ListPets(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (*ListPetsResponse, error)")
+
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+
+	t.Run("not a post", func(t *testing.T) {
+		invalid := strings.Replace(spec, "post:", "put:", 1)
+
+		_, err := Generate([]byte(invalid), cfg)
+		assert.ErrorContains(t, err, `invalid x-jsonrpc: method "pets.get" must be called with POST, not PUT`)
+	})
+}
+
+func TestOptionalRequestBody(t *testing.T) {
+	spec := readTestdata(t, "optional-request-body.yml")
+	cfg := Configuration{
+		PackageName: "api",
+		Output:      &Output{UseSingleFile: true},
+		Generate:    &GenerateOptions{Client: true, ServerBinding: true},
+	}
+
+	codes, err := Generate([]byte(spec), cfg)
+	require.NoError(t, err)
+
+	code := codes.GetCombined()
+	// only the required body is checked before sending and binding
+	assert.Equal(t, 1, strings.Count(code, "if options == nil || options.Body == nil {"))
+	assert.Equal(t, 1, strings.Count(code, `return nil, &runtime.BindError{In: "body", Err: runtime.ErrMissingValue}`))
+	assert.Contains(t, code, "if !runtime.IsEmptyBody(body) {")
+	// unset bodies are not sent as null
+	assert.Contains(t, code, "if o.Body == nil {\n\t\treturn nil\n\t}\n\treturn o.Body")
+
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+
+	t.Run("request validation skipped", func(t *testing.T) {
+		cfg := cfg
+		cfg.Generate = &GenerateOptions{Client: true, Validation: ValidationOptions{SkipRequest: true}}
+
+		codes, err := Generate([]byte(spec), cfg)
+		require.NoError(t, err)
+
+		// the required body is still checked
+		code := codes.GetCombined()
+		assert.Equal(t, 1, strings.Count(code, "if options == nil || options.Body == nil {"))
+		assert.NotContains(t, code, "error validating request body")
+	})
+}
+
+func TestCustomMethods(t *testing.T) {
+	spec := readTestdata(t, "custom-methods.yml")
+	cfg := Configuration{
+		PackageName: "api",
+		Output:      &Output{UseSingleFile: true},
+		Generate:    &GenerateOptions{Client: true, ServerBinding: true, ServerRouter: true},
+	}
+
+	codes, err := Generate([]byte(spec), cfg)
+	require.NoError(t, err)
+
+	code := codes.GetCombined()
+	assert.Contains(t, code, "SearchPets(ctx context.Context, options *SearchPetsRequestOptions")
+	assert.Contains(t, code, `Method:      "QUERY",`)
+	assert.Contains(t, code, "PurgePets(ctx context.Context, reqEditors ...runtime.RequestEditorFn)")
+	assert.Contains(t, code, `Method:     "PURGE",`)
+	assert.Contains(t, code, `mux.Handle("QUERY /pets", `)
+	assert.Contains(t, code, `mux.Handle("PURGE /pets", `)
+	assert.Contains(t, code, `mux.Handle("QUERY /pets/search", `)
+
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+
+	t.Run("invalid method", func(t *testing.T) {
+		invalid := strings.Replace(spec, "x-custom-method: QUERY", "x-custom-method: SEARCH PETS", 1)
+
+		_, err := Generate([]byte(invalid), cfg)
+		assert.ErrorContains(t, err, `invalid x-custom-method: "SEARCH PETS" is not an HTTP method`)
+	})
+}
+
+func TestConditionalRequests(t *testing.T) {
+	spec := []byte(readTestdata(t, "conditional-requests.yml"))
+	cfg := Configuration{
+		PackageName: "api",
+		Output:      &Output{UseSingleFile: true},
+		Generate:    &GenerateOptions{Client: true, ResponseUnions: true, ConditionalRequests: true},
+	}
+
+	codes, err := Generate(spec, cfg)
+	require.NoError(t, err)
+
+	code := codes.GetCombined()
+	assert.Contains(t, code, "CreatePet(ctx context.Context, options *CreatePetRequestOptions")
+	assert.Contains(t, code, "type UpdatePetRequestOptions struct {")
+	assert.Contains(t, code, `header["If-Match"] = o.IfMatch`)
+	assert.Contains(t, code, "func (r *GetPetResult200) ETag() string {")
+	assert.Contains(t, code, "func (r *UpdatePetResult200) ETag() string {")
+	assert.NotContains(t, code, "func (r *GetPetResult404) ETag() string {")
+
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+
+	options := func(name string) string {
+		start := strings.Index(code, "type "+name+"RequestOptions struct {")
+		require.GreaterOrEqual(t, start, 0)
+		return code[start : start+strings.Index(code[start:], "}")]
+	}
+	assert.Contains(t, options("UpdatePet"), "IfNoneMatch string")
+	assert.NotContains(t, options("GetPet"), "IfMatch")
+	assert.NotContains(t, options("DeletePet"), "IfMatch string")
+
+	t.Run("disabled", func(t *testing.T) {
+		cfg.Generate.ConditionalRequests = false
+		codes, err := Generate(spec, cfg)
+		require.NoError(t, err)
+
+		code := codes.GetCombined()
+		assert.Contains(t, code, "CreatePet(ctx context.Context, reqEditors ...runtime.RequestEditorFn)")
+		assert.NotContains(t, code, "IfNoneMatch")
+	})
+}
+
+func TestNoContentResponses(t *testing.T) {
+	spec := []byte(readTestdata(t, "no-content.yml"))
+	cfg := Configuration{
+		PackageName: "api",
+		Output:      &Output{UseSingleFile: true},
+		Generate:    &GenerateOptions{Client: true, ResponseUnions: true},
+	}
+
+	codes, err := Generate(spec, cfg)
+	require.NoError(t, err)
+
+	code := codes.GetCombined()
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+
+	parser := func(op string) string {
+		start := strings.Index(code, "func (c *Client) "+op+"(")
+		require.GreaterOrEqual(t, start, 0)
+		end := strings.Index(code[start:], "resp, err := c.apiClient.ExecuteRequest")
+		return code[start : start+end]
+	}
+	for _, op := range []string{"ResetForm", "StartJob", "CancelJob"} {
+		assert.Contains(t, parser(op), "return nil, nil", op)
+		assert.NotContains(t, parser(op), "target := new(struct{})", op)
+	}
+	assert.Contains(t, code, "type ResetFormResult205 struct {\n\tHeaders http.Header\n}")
+}
+
+func TestResponseHeaderAccessors(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "api",
+		Output:      &Output{UseSingleFile: true},
+		Generate:    &GenerateOptions{Client: true, ResponseUnions: true},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "response-headers.yml")), cfg)
+	require.NoError(t, err)
+
+	code := codes.GetCombined()
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+
+	assert.Contains(t, code, "func (r *ListPetsResult200) XRateLimitRemaining() (int, error) {")
+	assert.Contains(t, code, `runtime.ParseResponseHeader[int](r.Headers, "X-Rate-Limit-Remaining", "gte=0")`)
+	assert.Contains(t, code, "func (r *ListPetsResult200) XRateLimitReset() (time.Time, error) {")
+	assert.Contains(t, code, "func (r *ListPetsResult200) XExpiresOn() (runtime.Date, error) {")
+	assert.Contains(t, code, `runtime.ParseResponseHeader[string](r.Headers, "X-Request-ID", "")`)
+	assert.Contains(t, code, "func (r *ListPetsResult429) RetryAfter() (int64, error) {")
+
+	// arrays are only read from Headers, and ETag keeps its accessor
+	assert.NotContains(t, code, "XTags()")
+	assert.Contains(t, code, "func (r *ListPetsResult200) ETag() string {")
+}
+
+func TestDefaultResponses(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "api",
+		Output:      &Output{UseSingleFile: true},
+		Generate:    &GenerateOptions{Client: true, ResponseUnions: true},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "default-responses.yml")), cfg)
+	require.NoError(t, err)
+
+	code := codes.GetCombined()
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+
+	// the default response of an operation without success response is the success response of all 2xx codes
+	assert.Contains(t, code, "ListPets(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (*ListPetsResponse, error)")
+	assert.Contains(t, code, "if resp.StatusCode < 200 || resp.StatusCode >= 300 {")
+	assert.NotContains(t, code, "ListPetsResult")
+
+	// next to error responses, it is the catch-all case of the result
+	assert.Contains(t, code, "VisitDefault(res *CreatePetResultDefault) error")
+	assert.Regexp(t, `type CreatePetResultDefault struct \{\s+Body\s+\*CreatePetDefaultResponse\s+Headers http.Header\s+Status\s+int\s+\}`, code)
+	assert.Regexp(t, `default:\s+res := &CreatePetResultDefault\{Headers: resp.Headers, Status: resp.StatusCode\}`, code)
+	assert.Regexp(t, `type DeletePetResultDefault struct \{\s+Headers http.Header\s+Status\s+int\s+\}`, code)
+
+	// every success code is a case of the result
+	assert.Contains(t, code, "Visit207(res *UpdatePetsResult207) error")
+}
+
+func TestSynthesizedOperationIDs(t *testing.T) {
+	spec := []byte(readTestdata(t, "synthesized-operation-ids.yml"))
+	cfg := Configuration{
+		PackageName: "api",
+		Output:      &Output{UseSingleFile: true, RouteManifest: "routes.json"},
+		Generate:    &GenerateOptions{Client: true, OperationIDCasing: "snake"},
+	}
+
+	codes, err := Generate(spec, cfg)
+	require.NoError(t, err)
+
+	code := codes.GetCombined()
+	assert.Contains(t, code, "func (c *Client) GetPetsByPetID(")
+	assert.Contains(t, code, "func (c *Client) DeletePet(")
+	assert.Contains(t, code, "func (c *Client) ListToys(")
+
+	// x-go-operation-name only renames the Go symbols
+	assert.Contains(t, codes["routes.json"], `"operationId": "get_pets_by_pet_id"`)
+	assert.Contains(t, codes["routes.json"], `"operationId": "removePet"`)
+	assert.Contains(t, codes["routes.json"], `"operationId": "get_pets_by_pet_id_toys"`)
+
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+
+	t.Run("invalid name", func(t *testing.T) {
+		invalid := strings.Replace(string(spec), "x-go-operation-name: ListToys", "x-go-operation-name: list-toys", 1)
+
+		_, err := Generate([]byte(invalid), cfg)
+		assert.ErrorContains(t, err, `invalid x-go-operation-name: "list-toys" is not an exported Go identifier`)
+	})
+}
+
+func TestGoTimeFormat(t *testing.T) {
+	spec := readTestdata(t, "go-time-format.yml")
+	cfg := Configuration{PackageName: "api", Generate: &GenerateOptions{Client: true}}
+
+	codes, err := Generate([]byte(spec), cfg)
+	require.NoError(t, err)
+
+	code := codes.GetCombined()
+	assert.Contains(t, code, `"since": {Style: "form", TimeFormat: "unixmilli"},`)
+	assert.NotContains(t, code, `"until":`)
+
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+
+	t.Run("only on query parameters", func(t *testing.T) {
+		invalid := strings.Replace(spec, "schema:\n            type: string\n        - name: since", "x-go-time-format: unix\n          schema:\n            type: string\n        - name: since", 1)
+		require.NotEqual(t, spec, invalid)
+
+		_, err := Generate([]byte(invalid), cfg)
+		assert.ErrorContains(t, err, "x-go-time-format is only supported on query parameters")
+	})
+}
+
+func TestGoOmitValidation(t *testing.T) {
+	spec := readTestdata(t, "go-omit-validation.yml")
+	cfg := Configuration{PackageName: "api", Generate: &GenerateOptions{Client: true}}
+
+	codes, err := Generate([]byte(spec), cfg)
+	require.NoError(t, err)
+
+	code := codes.GetCombined()
+
+	// schemas
+	assert.Contains(t, code, "func (o Order) Validate() error")
+	assert.Contains(t, code, "ID     string        `json:\"id\" validate:\"required,min=1\"`")
+	assert.Contains(t, code, "Vendor VendorPayload `json:\"vendor\"`")
+	assert.NotContains(t, code, "func (v VendorPayload) Validate() error")
+	assert.Contains(t, code, "Name    string                 `json:\"name\"`")
+	assert.NotContains(t, code, "VendorPayload_Address) Validate() error")
+
+	// operations
+	assert.Contains(t, code, "func (o *CreateOrderRequestOptions) Validate() error")
+	assert.NotContains(t, code, "func (o *ProxyEventRequestOptions) Validate() error")
+	assert.NotContains(t, code, "ProxyEventBody) Validate() error")
+	assert.NotContains(t, code, "ProxyEventQuery) Validate() error")
+	assert.Equal(t, 1, strings.Count(code, "error validating request body"))
+
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+
+	t.Run("invalid value", func(t *testing.T) {
+		invalid := strings.Replace(spec, "x-go-omit-validation: true\n      parameters", "x-go-omit-validation: sometimes\n      parameters", 1)
+		require.NotEqual(t, spec, invalid)
+
+		_, err := Generate([]byte(invalid), cfg)
+		assert.ErrorContains(t, err, "invalid x-go-omit-validation")
+	})
+}
+
+func TestValidateSkipOnInput(t *testing.T) {
+	spec := readTestdata(t, "validate-skip-on-input.yml")
+	cfg := Configuration{PackageName: "api", Generate: &GenerateOptions{Client: true}}
+
+	codes, err := Generate([]byte(spec), cfg)
+	require.NoError(t, err)
+
+	code := codes.GetCombined()
+	assert.Contains(t, code, "ID   *string `json:\"id,omitempty\" validate:\"omitempty,min=10\"`")
+	assert.Contains(t, code, "Name string  `json:\"name\" validate:\"required,min=1\"`")
+
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+
+	t.Run("invalid value", func(t *testing.T) {
+		invalid := strings.Replace(spec, "x-validate-skip-on-input: true", "x-validate-skip-on-input: sometimes", 1)
+
+		_, err := Generate([]byte(invalid), cfg)
+		assert.ErrorContains(t, err, `invalid value for "x-validate-skip-on-input"`)
+	})
+}
+
+func TestGenerateDeterministic(t *testing.T) {
+	for _, name := range []string{"train-travel-api.yml", "test_spec.yml", "x-go-type-import-pet.yml"} {
+		t.Run(name, func(t *testing.T) {
+			spec := []byte(readTestdata(t, name))
+			cfg := Configuration{
+				PackageName: "api",
+				Generate:    &GenerateOptions{Client: true},
+				Output:      &Output{UseSingleFile: false},
+			}
+
+			want, err := Generate(spec, cfg)
+			require.NoError(t, err)
+
+			for range 5 {
+				got, err := Generate(spec, cfg)
+				require.NoError(t, err)
+				assert.Equal(t, want, got)
+			}
+		})
+	}
+}
+
+func TestGenerationErrors(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "testgenerationerrors",
+		Generate: &GenerateOptions{
+			Client: true,
+		},
+	}
+
+	_, err := Generate([]byte(readTestdata(t, "generation-errors.yml")), cfg)
+	require.Error(t, err)
+
+	assert.ErrorIs(t, err, ErrAmbiguousDiscriminatorMapping)
+	assert.ErrorContains(t, err, "#/components/schemas/Pet: ")
+	assert.ErrorContains(t, err, "#/components/schemas/Animal: ")
+	assert.ErrorContains(t, err, "#/paths/~1pets~1{id}/get: ")
+	assert.ErrorContains(t, err, "#/paths/~1pets/get: error in operation ListPets: invalid x-dedupe")
+	assert.ErrorContains(t, err, "#/paths/~1owners/get: ")
+	assert.NotContains(t, err.Error(), "Valid")
+
+	var specErr *SpecError
+	require.ErrorAs(t, err, &specErr)
+	assert.Equal(t, "#/components/schemas/Pet", specErr.Path)
+}
+
+func TestEnumVarNames(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "api",
+		SkipPrune:   true,
+		Output: &Output{
+			UseSingleFile: true,
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "enum-varnames.yml")), cfg)
+	require.NoError(t, err)
+
+	code := codes.GetCombined()
+	assert.Contains(t, code, "\t// StatusPending Waiting for approval\n\tStatusPending Status = 1\n")
+	assert.Contains(t, code, "\tStatusActive Status = 2\n")
+	assert.NotContains(t, code, "// StatusActive")
+
+	// x-enum-names takes precedence
+	assert.Contains(t, code, `Red   Color = "r"`)
+	assert.NotContains(t, code, "ColorRed")
+
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+
+	t.Run("omit description", func(t *testing.T) {
+		cfg.Generate = &GenerateOptions{OmitDescription: true}
+		codes, err := Generate([]byte(readTestdata(t, "enum-varnames.yml")), cfg)
+		require.NoError(t, err)
+		assert.NotContains(t, codes.GetCombined(), "Waiting for approval")
+	})
+}
+
+func TestRemoveAfter(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "api",
+		SkipPrune:   true,
+		Output: &Output{
+			UseSingleFile: true,
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "remove-after.yml")), cfg)
+	require.NoError(t, err)
+
+	code := codes.GetCombined()
+	assert.Contains(t, code, "\t// Deprecated: To be removed after 2000-01-31.\n\tFullName *string")
+	assert.Contains(t, code, "\t// Deprecated: Use name instead\n\t// To be removed after 2999-12-01.\n\tNickname *string")
+
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+
+	t.Run("enforced", func(t *testing.T) {
+		cfg.Generate = &GenerateOptions{EnforceRemoveAfter: true}
+		_, err := Generate([]byte(readTestdata(t, "remove-after.yml")), cfg)
+		require.ErrorIs(t, err, ErrRemoveAfterPassed)
+		assert.ErrorContains(t, err, "#/components/schemas/Client: ")
+		assert.ErrorContains(t, err, "property 'fullName' (x-remove-after 2000-01-31)")
+		assert.NotContains(t, err.Error(), "nickname")
+	})
+
+	t.Run("invalid date", func(t *testing.T) {
+		spec := strings.Replace(readTestdata(t, "remove-after.yml"), "2000-01-31", "soon", 1)
+		_, err := Generate([]byte(spec), Configuration{PackageName: "api", SkipPrune: true})
+		assert.ErrorContains(t, err, "invalid x-remove-after of property 'fullName'")
+	})
+}
+
+func TestDecimal(t *testing.T) {
+	spec := readTestdata(t, "decimal.yml")
+
+	t.Run("default", func(t *testing.T) {
+		codes, err := Generate([]byte(spec), Configuration{PackageName: "api", SkipPrune: true})
+		require.NoError(t, err)
+
+		code := codes.GetCombined()
+		assert.Regexp(t, `Amount\s+string\s`, code)
+		assert.Regexp(t, `Fee\s+\*float64\s`, code)
+		assert.Regexp(t, `Total\s+\*decimal\.Decimal\s`, code)
+		assert.Contains(t, code, `"github.com/shopspring/decimal"`)
+
+		_, err = format.Source([]byte(code))
+		require.NoError(t, err)
+	})
+
+	t.Run("decimal type", func(t *testing.T) {
+		cfg := Configuration{
+			PackageName: "api",
+			SkipPrune:   true,
+			Generate:    &GenerateOptions{DecimalType: "decimal.Decimal"},
+		}
+		codes, err := Generate([]byte(spec), cfg)
+		require.NoError(t, err)
+
+		code := codes.GetCombined()
+		assert.Regexp(t, `Amount\s+decimal\.Decimal\s`, code)
+		assert.Regexp(t, `Fee\s+\*runtime\.DecimalNumber\[decimal\.Decimal\]\s`, code)
+		assert.Regexp(t, `Rate\s+\*runtime\.DecimalNumber\[decimal\.Decimal\]\s`, code)
+		assert.Regexp(t, `Total\s+\*decimal\.Decimal\s`, code)
+		assert.NotContains(t, code, "min=1")
+		assert.NotContains(t, code, "gte=0")
+
+		_, err = format.Source([]byte(code))
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid encoding", func(t *testing.T) {
+		invalid := strings.Replace(spec, "x-decimal-encoding: number", "x-decimal-encoding: float", 1)
+		cfg := Configuration{PackageName: "api", SkipPrune: true, Generate: &GenerateOptions{DecimalType: "decimal.Decimal"}}
+		_, err := Generate([]byte(invalid), cfg)
+		assert.ErrorContains(t, err, `invalid value for "x-decimal-encoding": "float"`)
+	})
+}
+
+func TestJSONLibrary(t *testing.T) {
+	spec := []byte(readTestdata(t, "json-library.yml"))
+
+	t.Run("event streams", func(t *testing.T) {
+		cfg := Configuration{PackageName: "api", JSONLibrary: "jsoniter", Generate: &GenerateOptions{Client: true}}
+		codes, err := Generate([]byte(readTestdata(t, "stream-responses.yml")), cfg)
+		require.NoError(t, err)
+		assert.Contains(t, codes.GetCombined(), "}, runtime.ClientJSONCodec(c.apiClient, jsonCodec{}))")
+	})
+
+	t.Run("event writers", func(t *testing.T) {
+		cfg := Configuration{PackageName: "api", JSONLibrary: "jsoniter", Generate: &GenerateOptions{ServerBinding: true}}
+		codes, err := Generate([]byte(readTestdata(t, "stream-responses.yml")), cfg)
+		require.NoError(t, err)
+
+		code := codes.GetCombined()
+		assert.Contains(t, code, "type jsonCodec struct{}")
+		assert.Contains(t, code, "opts = append([]runtime.EventWriterOption{runtime.WithEventJSONCodec(jsonCodec{})}, opts...)")
+
+		_, err = format.Source([]byte(code))
+		require.NoError(t, err)
+	})
+
+	t.Run("encoding/json", func(t *testing.T) {
+		codes, err := Generate(spec, Configuration{PackageName: "api", Generate: &GenerateOptions{Client: true}})
+		require.NoError(t, err)
+
+		code := codes.GetCombined()
+		assert.Contains(t, code, "json.Unmarshal(")
+		assert.Contains(t, code, "var object runtime.JSONObjectWriter")
+		assert.NotContains(t, code, "jsonUnmarshal")
+		assert.NotContains(t, code, "WithJSONCodec")
+	})
+
+	tests := []struct {
+		library    string
+		importSpec string
+		marshal    string
+	}{
+		{library: "jsoniter", importSpec: `jsoniter "github.com/json-iterator/go"`, marshal: "jsoniter.ConfigCompatibleWithStandardLibrary.Marshal(v)"},
+		{library: "sonic", importSpec: `"github.com/bytedance/sonic"`, marshal: "sonic.ConfigStd.Marshal(v)"},
+		{library: "encoding/json/v2", importSpec: `jsonv2 "encoding/json/v2"`, marshal: "jsonv2.Marshal(v)"},
+		{library: "github.com/goccy/go-json", importSpec: `jsonlib "github.com/goccy/go-json"`, marshal: "jsonlib.Marshal(v)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.library, func(t *testing.T) {
+			cfg := Configuration{
+				PackageName: "api",
+				JSONLibrary: tt.library,
+				Generate:    &GenerateOptions{Client: true},
+			}
+			codes, err := Generate(spec, cfg)
+			require.NoError(t, err)
+
+			code := codes.GetCombined()
+			assert.Contains(t, code, tt.importSpec)
+			assert.Contains(t, code, "return "+tt.marshal)
+			assert.Contains(t, code, "runtime.WithJSONCodec(jsonCodec{})")
+			assert.Contains(t, code, "object := runtime.NewJSONObjectWriter(jsonCodec{})")
+			assert.NotRegexp(t, `\bjson\.(Marshal|Unmarshal)\(`, code)
+
+			_, err = format.Source([]byte(code))
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCaptureUnknownFields(t *testing.T) {
+	spec := readTestdata(t, "capture-unknown.yml")
+	captured := func(code, typeName string) bool {
+		return regexp.MustCompile(`(?s)type ` + typeName + ` struct \{[^}]*AdditionalProperties\s+map\[string\]json\.RawMessage`).MatchString(code)
+	}
+
+	t.Run("x-capture-unknown", func(t *testing.T) {
+		codes, err := Generate([]byte(spec), Configuration{PackageName: "api", SkipPrune: true})
+		require.NoError(t, err)
+
+		code := codes.GetCombined()
+		assert.True(t, captured(code, "Captured"))
+		assert.Contains(t, code, "func (c *Captured) UnmarshalJSON(data []byte) error {")
+		assert.Contains(t, code, "func (c Captured) MarshalJSON() ([]byte, error) {")
+		assert.False(t, captured(code, "Plain"))
+		assert.False(t, captured(code, "Strict"))
+		assert.False(t, captured(code, "Opted"))
+		assert.Regexp(t, `AdditionalProperties map\[string\]string`, code)
+
+		_, err = format.Source([]byte(code))
+		require.NoError(t, err)
+	})
+
+	t.Run("capture-unknown-fields", func(t *testing.T) {
+		cfg := Configuration{PackageName: "api", SkipPrune: true, Generate: &GenerateOptions{CaptureUnknownFields: true}}
+		codes, err := Generate([]byte(spec), cfg)
+		require.NoError(t, err)
+
+		code := codes.GetCombined()
+		assert.True(t, captured(code, "Captured"))
+		assert.True(t, captured(code, "Plain"))
+		assert.False(t, captured(code, "Strict"))
+		assert.False(t, captured(code, "Opted"))
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		invalid := strings.Replace(spec, "x-capture-unknown: true", "x-capture-unknown: always", 1)
+		_, err := Generate([]byte(invalid), Configuration{PackageName: "api", SkipPrune: true})
+		assert.ErrorContains(t, err, `invalid value for "x-capture-unknown"`)
+	})
+}
@@ -0,0 +1,203 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"embed"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// configSources declare the configuration types, whose doc comments describe the options.
+//
+//go:embed configuration.go plugin.go
+var configSources embed.FS
+
+// ConfigOption is an option of the YAML configuration.
+type ConfigOption struct {
+	// Path is the dotted path of the option, e.g. output.use-single-file.
+	// The options of list elements are reached through [], e.g. plugins[].name.
+	Path string
+
+	// Type is the YAML type of the option: string, boolean, integer, duration, list, map, object or any.
+	Type string
+
+	// Default is the value of the option in NewDefaultConfiguration, empty if it is the zero value.
+	Default string
+
+	// Description is the doc comment of the field of the option, e.g. with its examples.
+	Description string
+}
+
+// ConfigOptions returns all the options of the YAML configuration, in the order of their fields,
+// described by the doc comments of the fields, e.g. for CLI help.
+func ConfigOptions() []ConfigOption {
+	var res []ConfigOption
+	collectConfigOptions(&res, "", reflect.TypeOf(Configuration{}), reflect.ValueOf(NewDefaultConfiguration()))
+	return res
+}
+
+// collectConfigOptions adds the options of the fields of the struct type t, with default values from v, if valid.
+func collectConfigOptions(res *[]ConfigOption, prefix string, t reflect.Type, v reflect.Value) {
+	docs := configFieldDocs()[t.Name()]
+	for i := range t.NumField() {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+
+		var value reflect.Value
+		if v.IsValid() {
+			value = v.Field(i)
+		}
+		opt := ConfigOption{
+			Path:        prefix + name,
+			Type:        configOptionType(field.Type),
+			Default:     configOptionDefault(value),
+			Description: docs[field.Name],
+		}
+		*res = append(*res, opt)
+
+		ft := field.Type
+		if ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+			if value.IsValid() {
+				value = value.Elem()
+			}
+		}
+		switch {
+		case ft.Kind() == reflect.Struct && ft != reflect.TypeOf(time.Time{}):
+			collectConfigOptions(res, opt.Path+".", ft, value)
+		case ft.Kind() == reflect.Slice && ft.Elem().Kind() == reflect.Struct:
+			collectConfigOptions(res, opt.Path+"[].", ft.Elem(), reflect.Value{})
+		}
+	}
+}
+
+// configOptionType returns the YAML type of an option of Go type t.
+func configOptionType(t reflect.Type) string {
+	if t == reflect.TypeOf(time.Duration(0)) {
+		return "duration"
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return "boolean"
+	case reflect.String:
+		return "string"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Slice:
+		return "list"
+	case reflect.Map:
+		return "map"
+	case reflect.Pointer, reflect.Struct:
+		return "object"
+	default:
+		return "any"
+	}
+}
+
+// configOptionDefault returns the default value of an option, empty for zero values and objects.
+func configOptionDefault(v reflect.Value) string {
+	if !v.IsValid() || v.IsZero() {
+		return ""
+	}
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Struct, reflect.Slice, reflect.Map:
+		return ""
+	}
+	return fmt.Sprint(v.Interface())
+}
+
+// configFieldDocs maps the names of the configuration types to the doc comments of their fields.
+// Fields without a doc comment are described by the paragraph of the type doc comment starting with their name.
+var configFieldDocs = sync.OnceValue(func() map[string]map[string]string {
+	res := make(map[string]map[string]string)
+	fset := token.NewFileSet()
+	entries, err := configSources.ReadDir(".")
+	if err != nil {
+		panic(err)
+	}
+	for _, entry := range entries {
+		src, err := configSources.ReadFile(entry.Name())
+		if err != nil {
+			panic(err)
+		}
+		f, err := parser.ParseFile(fset, entry.Name(), src, parser.ParseComments)
+		if err != nil {
+			panic(err)
+		}
+
+		for _, decl := range f.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				ts := spec.(*ast.TypeSpec)
+				st, ok := ts.Type.(*ast.StructType)
+				if !ok {
+					continue
+				}
+
+				var names []string
+				for _, field := range st.Fields.List {
+					for _, name := range field.Names {
+						names = append(names, name.Name)
+					}
+				}
+
+				docs := make(map[string]string)
+				for _, field := range st.Fields.List {
+					for _, name := range field.Names {
+						doc := strings.TrimSpace(field.Doc.Text())
+						if doc == "" {
+							doc = typeDocParagraph(gen.Doc.Text(), name.Name, names)
+						}
+						docs[name.Name] = doc
+					}
+				}
+				res[ts.Name.Name] = docs
+			}
+		}
+	}
+	return res
+})
+
+// typeDocParagraph returns the lines of the type doc comment describing a field,
+// from the line starting with its name to the line starting with the name of another field.
+func typeDocParagraph(doc, name string, names []string) string {
+	var lines []string
+	found := false
+	for line := range strings.SplitSeq(doc, "\n") {
+		startsWith := func(field string) bool {
+			return strings.HasPrefix(line, field+" ")
+		}
+		if !found {
+			found = startsWith(name)
+		} else if slices.ContainsFunc(names, startsWith) {
+			break
+		}
+		if found && strings.TrimSpace(line) != "" {
+			lines = append(lines, strings.TrimSpace(line))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
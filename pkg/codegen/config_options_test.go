@@ -0,0 +1,57 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigOptions(t *testing.T) {
+	options := make(map[string]ConfigOption)
+	for _, opt := range ConfigOptions() {
+		options[opt.Path] = opt
+	}
+
+	t.Run("every option is described", func(t *testing.T) {
+		for path, opt := range options {
+			assert.NotEmpty(t, opt.Description, path)
+		}
+	})
+
+	t.Run("types and defaults", func(t *testing.T) {
+		assert.Equal(t, ConfigOption{
+			Path:        "package",
+			Type:        "string",
+			Default:     "gen",
+			Description: "PackageName to generate the code under.",
+		}, options["package"])
+		assert.Equal(t, "boolean", options["output.use-single-file"].Type)
+		assert.Equal(t, "true", options["output.use-single-file"].Default)
+		assert.Equal(t, "duration", options["client.timeout"].Type)
+		assert.Equal(t, "3s", options["client.timeout"].Default)
+		assert.Equal(t, "list", options["filter.include.paths"].Type)
+		assert.Equal(t, "object", options["generate.validation"].Type)
+		assert.Empty(t, options["generate.client"].Default)
+	})
+
+	t.Run("list elements", func(t *testing.T) {
+		assert.Equal(t, "Name is the name of a plugin registered with RegisterPlugin.", options["plugins[].name"].Description)
+		assert.Contains(t, options, "additional-imports[].package")
+	})
+
+	t.Run("type doc paragraphs", func(t *testing.T) {
+		assert.Equal(t, "ErrorMapping is the configuration for mapping the OpenAPI error responses to Go types.\n"+
+			"The key is the spec error type name\nand the value is the dotted json path to the string result.",
+			options["error-mapping"].Description)
+	})
+}
@@ -0,0 +1,160 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"go.yaml.in/yaml/v4"
+)
+
+// ConfigEnvPrefix starts the names of the environment variables overriding config options, see EnvConfigOverrides.
+const ConfigEnvPrefix = "OAPI_CODEGEN_"
+
+// ConfigOverride sets a config option over the value of the config file.
+type ConfigOverride struct {
+	// Path is the dotted path of the option, as listed by ConfigOptions, e.g. output.directory.
+	Path string
+
+	// Value is the YAML value of the option, e.g. true, 10s or [pets, users] for lists.
+	Value string
+}
+
+// ParseConfigOverride parses a key=value override, e.g. output.directory=gen.
+func ParseConfigOverride(s string) (ConfigOverride, error) {
+	key, value, ok := strings.Cut(s, "=")
+	if !ok || key == "" {
+		return ConfigOverride{}, fmt.Errorf("invalid override %q, expected key=value", s)
+	}
+	return ConfigOverride{Path: strings.TrimSpace(key), Value: value}, nil
+}
+
+// EnvConfigOverrides returns the overrides of the OAPI_CODEGEN_* variables of environ, formatted as by os.Environ.
+// The variable of an option is its path in upper case, with dots and dashes replaced by underscores,
+// e.g. OAPI_CODEGEN_OUTPUT_USE_SINGLE_FILE for output.use-single-file. Variables of unknown options are skipped
+// with a warning: they may be set for other tools or by older versions of the CI.
+func EnvConfigOverrides(environ []string) []ConfigOverride {
+	paths := make(map[string]string)
+	for _, opt := range ConfigOptions() {
+		if !strings.Contains(opt.Path, "[]") {
+			paths[configEnvName(opt.Path)] = opt.Path
+		}
+	}
+
+	var res []ConfigOverride
+	for _, kv := range environ {
+		name, value, _ := strings.Cut(kv, "=")
+		if !strings.HasPrefix(name, ConfigEnvPrefix) {
+			continue
+		}
+		path, ok := paths[name]
+		if !ok {
+			slog.Warn(fmt.Sprintf("skipping environment variable %s: it doesn't override a config option", name))
+			continue
+		}
+		res = append(res, ConfigOverride{Path: path, Value: value})
+	}
+	slices.SortFunc(res, func(a, b ConfigOverride) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+	return res
+}
+
+// configEnvName returns the environment variable overriding the option at path.
+func configEnvName(path string) string {
+	return ConfigEnvPrefix + strings.ToUpper(strings.NewReplacer(".", "_", "-", "_").Replace(path))
+}
+
+// ApplyConfigOverrides returns the YAML config with the options of the overrides set, in order,
+// so the later overrides of an option win. The contents may be empty, for the default config.
+// Options of list elements can't be overridden, the whole list can.
+func ApplyConfigOverrides(contents []byte, overrides []ConfigOverride) ([]byte, error) {
+	if len(overrides) == 0 {
+		return contents, nil
+	}
+
+	options := make(map[string]ConfigOption)
+	for _, opt := range ConfigOptions() {
+		options[opt.Path] = opt
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(contents, &doc); err != nil {
+		return nil, err
+	}
+	if doc.Kind == 0 {
+		doc = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode}}}
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("the config is not a mapping")
+	}
+
+	for _, override := range overrides {
+		opt, ok := options[override.Path]
+		if !ok || strings.Contains(override.Path, "[]") {
+			return nil, fmt.Errorf("unknown config option %q", override.Path)
+		}
+
+		var value yaml.Node
+		if err := yaml.Unmarshal([]byte(override.Value), &value); err != nil {
+			return nil, fmt.Errorf("invalid value of %s: %w", override.Path, err)
+		}
+		valueNode := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: ""}
+		if value.Kind == yaml.DocumentNode {
+			valueNode = value.Content[0]
+		}
+		if opt.Type == "string" && valueNode.Kind == yaml.ScalarNode {
+			// e.g. a package named 1 or an output directory named true
+			valueNode = &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: override.Value}
+		}
+
+		if err := setConfigNode(root, strings.Split(override.Path, "."), valueNode); err != nil {
+			return nil, fmt.Errorf("can't override %s: %w", override.Path, err)
+		}
+	}
+
+	return yaml.Marshal(&doc)
+}
+
+// setConfigNode sets the value at the keys of the mapping node, adding the missing mappings.
+func setConfigNode(node *yaml.Node, keys []string, value *yaml.Node) error {
+	for i := 0; i < len(node.Content); i += 2 {
+		if node.Content[i].Value != keys[0] {
+			continue
+		}
+		if len(keys) == 1 {
+			node.Content[i+1] = value
+			return nil
+		}
+		child := node.Content[i+1]
+		if child.Kind == yaml.ScalarNode && child.Tag == "!!null" {
+			*child = yaml.Node{Kind: yaml.MappingNode}
+		}
+		if child.Kind != yaml.MappingNode {
+			return fmt.Errorf("%s is not a mapping", keys[0])
+		}
+		return setConfigNode(child, keys[1:], value)
+	}
+
+	child := value
+	if len(keys) > 1 {
+		child = &yaml.Node{Kind: yaml.MappingNode}
+	}
+	node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: keys[0]}, child)
+	if len(keys) > 1 {
+		return setConfigNode(child, keys[1:], value)
+	}
+	return nil
+}
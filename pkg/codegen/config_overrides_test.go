@@ -0,0 +1,115 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.yaml.in/yaml/v4"
+)
+
+func TestParseConfigOverride(t *testing.T) {
+	o, err := ParseConfigOverride("output.directory=gen=1")
+	require.NoError(t, err)
+	assert.Equal(t, ConfigOverride{Path: "output.directory", Value: "gen=1"}, o)
+
+	_, err = ParseConfigOverride("output.directory")
+	assert.EqualError(t, err, `invalid override "output.directory", expected key=value`)
+}
+
+func TestEnvConfigOverrides(t *testing.T) {
+	overrides := EnvConfigOverrides([]string{
+		"HOME=/root",
+		"OAPI_CODEGEN_PACKAGE=api",
+		"OAPI_CODEGEN_OUTPUT_USE_SINGLE_FILE=false",
+		// unknown options are skipped
+		"OAPI_CODEGEN_OUTPUT_DIR=gen",
+		"OAPI_CODEGEN_VERSION=v3",
+	})
+	assert.Equal(t, []ConfigOverride{
+		{Path: "output.use-single-file", Value: "false"},
+		{Path: "package", Value: "api"},
+	}, overrides)
+
+	t.Run("names are unique", func(t *testing.T) {
+		names := make(map[string]string)
+		for _, opt := range ConfigOptions() {
+			name := configEnvName(opt.Path)
+			assert.Empty(t, names[name], "%s and %s have the same environment variable", names[name], opt.Path)
+			names[name] = opt.Path
+		}
+	})
+}
+
+func TestApplyConfigOverrides(t *testing.T) {
+	parse := func(t *testing.T, contents string, overrides ...ConfigOverride) Configuration {
+		t.Helper()
+		res, err := ApplyConfigOverrides([]byte(contents), overrides)
+		require.NoError(t, err)
+
+		var cfg Configuration
+		require.NoError(t, yaml.Unmarshal(res, &cfg))
+		return cfg
+	}
+
+	t.Run("sets options of the config file", func(t *testing.T) {
+		cfg := parse(t, "package: api\noutput:\n  directory: out\n  use-single-file: true\n",
+			ConfigOverride{Path: "output.directory", Value: "gen"},
+			ConfigOverride{Path: "output.use-single-file", Value: "false"},
+			ConfigOverride{Path: "client.timeout", Value: "10s"},
+			ConfigOverride{Path: "filter.include.tags", Value: "[pets, users]"},
+		)
+		assert.Equal(t, "api", cfg.PackageName)
+		assert.Equal(t, "gen", cfg.Output.Directory)
+		assert.False(t, cfg.Output.UseSingleFile)
+		assert.Equal(t, 10*time.Second, cfg.Client.Timeout)
+		assert.Equal(t, []string{"pets", "users"}, cfg.Filter.Include.Tags)
+	})
+
+	t.Run("without config file", func(t *testing.T) {
+		cfg := parse(t, "", ConfigOverride{Path: "package", Value: "api"})
+		assert.Equal(t, "api", cfg.PackageName)
+	})
+
+	t.Run("later overrides win", func(t *testing.T) {
+		cfg := parse(t, "",
+			ConfigOverride{Path: "package", Value: "env"},
+			ConfigOverride{Path: "package", Value: "flag"},
+		)
+		assert.Equal(t, "flag", cfg.PackageName)
+	})
+
+	t.Run("strings stay strings", func(t *testing.T) {
+		cfg := parse(t, "", ConfigOverride{Path: "output.directory", Value: "true"})
+		assert.Equal(t, "true", cfg.Output.Directory)
+	})
+
+	t.Run("empty mappings", func(t *testing.T) {
+		cfg := parse(t, "output:\n", ConfigOverride{Path: "output.directory", Value: "gen"})
+		assert.Equal(t, "gen", cfg.Output.Directory)
+	})
+
+	t.Run("unknown options", func(t *testing.T) {
+		_, err := ApplyConfigOverrides(nil, []ConfigOverride{{Path: "output.dir", Value: "gen"}})
+		assert.EqualError(t, err, `unknown config option "output.dir"`)
+
+		_, err = ApplyConfigOverrides(nil, []ConfigOverride{{Path: "plugins[].name", Value: "x"}})
+		assert.EqualError(t, err, `unknown config option "plugins[].name"`)
+	})
+
+	t.Run("options under scalars", func(t *testing.T) {
+		_, err := ApplyConfigOverrides([]byte("output: gen\n"), []ConfigOverride{{Path: "output.directory", Value: "gen"}})
+		assert.EqualError(t, err, "can't override output.directory: output is not a mapping")
+	})
+}
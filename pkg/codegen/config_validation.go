@@ -0,0 +1,207 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"errors"
+	"fmt"
+	"go/build/constraint"
+	"go/token"
+	"net/http"
+	"strings"
+)
+
+// ErrInvalidConfiguration is returned by Validate for configurations with invalid options or combinations of options.
+var ErrInvalidConfiguration = errors.New("invalid configuration")
+
+// Validate reports the invalid options and the incompatible combinations of options of the configuration,
+// which would otherwise be ignored or fail generation halfway. Every problem is reported, each naming the options
+// to change. It is called by Generate and the CLI, after the defaults are filled.
+func (o Configuration) Validate() error {
+	var errs []error
+	add := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+
+	if o.PackageName != "" && !token.IsIdentifier(o.PackageName) {
+		add("package %q is not a valid Go package name", o.PackageName)
+	}
+
+	if out := o.Output; out != nil {
+		if out.UseSingleFile {
+			if out.SplitByConcern {
+				add("output.split-by-concern requires output.use-single-file: false")
+			}
+			if out.SplitByTag {
+				add("split-by-tag requires use-single-file: false")
+			}
+		}
+		if out.ClientPackage != "" {
+			if !out.SplitByConcern {
+				add("output.client-package requires output.split-by-concern: true")
+			}
+			if !token.IsIdentifier(out.ClientPackage) {
+				add("output.client-package %q is not a valid Go package name", out.ClientPackage)
+			}
+			if out.ImportPath == "" {
+				add("client-package %q requires the import-path of the generated package", out.ClientPackage)
+			}
+		}
+		if out.SplitByTag {
+			if out.ClientPackage != "" {
+				add("output.split-by-tag and output.client-package can't be combined, the clients are generated in the packages of the tags")
+			}
+			if out.ImportPath == "" {
+				add("split-by-tag requires the import-path of the generated package")
+			}
+		}
+		if out.BuildTags != "" {
+			if _, err := constraint.Parse("//go:build " + out.BuildTags); err != nil {
+				add("invalid output.build-tags %q: %w", out.BuildTags, err)
+			}
+		}
+		if out.GoGenerate && out.GoGenerateCommand == "" {
+			add("output.go-generate requires output.go-generate-command when not generating with the CLI")
+		}
+	}
+
+	if gen := o.Generate; gen != nil {
+		if !gen.Client {
+			// the options of the client are ignored without it
+			clientOptions := map[string]bool{
+				"generate.response-unions":         gen.ResponseUnions,
+				"generate.idempotency-key":         gen.IdempotencyKey,
+				"generate.conditional-requests":    gen.ConditionalRequests,
+				"generate.validation.skip-request": gen.Validation.SkipRequest,
+				"client.strip-base-path":           o.Client != nil && o.Client.StripBasePath,
+				"output.client-package":            o.Output != nil && o.Output.ClientPackage != "",
+				"output.split-by-tag":              o.Output != nil && o.Output.SplitByTag,
+				"output.implementations":           o.Output != nil && len(o.Output.Implementations) > 0,
+			}
+			for _, option := range sortedMapKeys(clientOptions) {
+				if clientOptions[option] {
+					add("%s requires generate.client: true", option)
+				}
+			}
+		}
+		if gen.ServerRouter && !gen.ServerBinding {
+			add("generate.server-router requires generate.server-binding: true")
+		}
+		switch gen.SpecUI {
+		case "":
+		case "swagger-ui", "redoc":
+			if !gen.EmbedSpec {
+				add("generate.spec-ui requires generate.embed-spec: true")
+			}
+		default:
+			add("generate.spec-ui %q is not one of swagger-ui or redoc", gen.SpecUI)
+		}
+		if gen.Validation.Skip {
+			if gen.Validation.Response {
+				add("generate.validation.response requires Validate methods, which generate.validation.skip turns off")
+			}
+			if gen.Validation.Simple {
+				add("generate.validation.simple requires Validate methods, which generate.validation.skip turns off")
+			}
+		}
+		if gen.RouteConflicts != "" {
+			if _, ok := routers[gen.RouteConflicts]; !ok {
+				add("unknown router %q for route conflicts, expected one of net/http, chi, echo, gin or httprouter", gen.RouteConflicts)
+			}
+		}
+		switch gen.OperationIDCasing {
+		case "", "camel", "snake", "kebab":
+		default:
+			add("generate.operation-id-casing %q is not one of camel, snake or kebab", gen.OperationIDCasing)
+		}
+		for _, id := range sortedMapKeys(gen.Pagination.Operations) {
+			if err := gen.Pagination.Operations[id].validateStyle(); err != nil {
+				add("generate.pagination.operations.%s: %s", id, err)
+			}
+		}
+		if gen.MaxDescriptionLength < 0 {
+			add("generate.max-description-length must not be negative, got %d", gen.MaxDescriptionLength)
+		}
+		switch gen.DefaultIntType {
+		case "", "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64":
+		default:
+			add("generate.default-int-type %q is not a Go integer type", gen.DefaultIntType)
+		}
+	}
+
+	if o.Client != nil && o.Client.Timeout < 0 {
+		add("client.timeout must not be negative, got %s", o.Client.Timeout)
+	}
+
+	for _, severity := range []keyValue[string, Severity]{
+		{"spec-validation.invalid-examples", o.SpecValidation.InvalidExamples},
+		{"spec-validation.unknown-keywords", o.SpecValidation.UnknownKeywords},
+	} {
+		switch severity.value {
+		case "", SeverityIgnore, SeverityWarn, SeverityError:
+		default:
+			add("%s %q is not one of ignore, warn or error", severity.key, severity.value)
+		}
+	}
+
+	errs = append(errs, validateFilterConfig(o.Filter)...)
+
+	for i, p := range o.Plugins {
+		if (p.Name == "") == (p.Path == "") {
+			add("plugins[%d] needs either a name or a path", i)
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w:\n%w", ErrInvalidConfiguration, errors.Join(errs...))
+}
+
+// validateFilterConfig reports the invalid filters: path regular expressions that don't compile,
+// unknown methods, and extensions not starting with x-.
+func validateFilterConfig(cfg FilterConfig) []error {
+	var errs []error
+	if err := validateFilter(cfg); err != nil {
+		errs = append(errs, err)
+	}
+
+	for _, params := range []keyValue[string, FilterParamsConfig]{{"include", cfg.Include}, {"exclude", cfg.Exclude}} {
+		for _, method := range params.value.Methods {
+			if !isHTTPMethod(method) {
+				errs = append(errs, fmt.Errorf("filter.%s.methods: %q is not an HTTP method", params.key, method))
+			}
+		}
+		for _, extensions := range []keyValue[string, []string]{
+			{"extensions", params.value.Extensions},
+			{"operation-extensions", params.value.OperationExtensions},
+		} {
+			for _, ext := range extensions.value {
+				if !strings.HasPrefix(ext, "x-") {
+					errs = append(errs, fmt.Errorf("filter.%s.%s: %q is not an extension, extensions start with x-",
+						params.key, extensions.key, ext))
+				}
+			}
+		}
+	}
+	return errs
+}
+
+// isHTTPMethod reports whether method is an HTTP method operations are declared with: a method of the fixed
+// fields of path items in any case, or a custom method, e.g. PURGE, in upper case.
+func isHTTPMethod(method string) bool {
+	switch strings.ToUpper(method) {
+	case http.MethodGet, http.MethodPut, http.MethodPost, http.MethodDelete, http.MethodOptions,
+		http.MethodHead, http.MethodPatch, http.MethodTrace, "QUERY":
+		return true
+	}
+	return isHTTPToken(method) && method == strings.ToUpper(method)
+}
@@ -0,0 +1,166 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfiguration_Validate(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  Configuration
+		errs []string
+	}{
+		{
+			name: "defaults",
+			cfg:  Configuration{},
+		},
+		{
+			name: "split client package",
+			cfg: Configuration{
+				Generate: &GenerateOptions{Client: true},
+				Output:   &Output{SplitByConcern: true, ClientPackage: "apiclient", ImportPath: "github.com/acme/api"},
+			},
+		},
+		{
+			name: "single file split",
+			cfg: Configuration{
+				Generate: &GenerateOptions{Client: true},
+				Output:   &Output{UseSingleFile: true, SplitByConcern: true, SplitByTag: true, ImportPath: "github.com/acme/api"},
+			},
+			errs: []string{
+				"output.split-by-concern requires output.use-single-file: false",
+				"split-by-tag requires use-single-file: false",
+			},
+		},
+		{
+			name: "client package",
+			cfg: Configuration{
+				Generate: &GenerateOptions{Client: true},
+				Output:   &Output{ClientPackage: "api-client"},
+			},
+			errs: []string{
+				"output.client-package requires output.split-by-concern: true",
+				`output.client-package "api-client" is not a valid Go package name`,
+				`client-package "api-client" requires the import-path of the generated package`,
+			},
+		},
+		{
+			name: "models only with client options",
+			cfg: Configuration{
+				Generate: &GenerateOptions{ResponseUnions: true, IdempotencyKey: true, ConditionalRequests: true, Validation: ValidationOptions{SkipRequest: true}},
+				Client:   &Client{StripBasePath: true},
+			},
+			errs: []string{
+				"client.strip-base-path requires generate.client: true",
+				"generate.conditional-requests requires generate.client: true",
+				"generate.idempotency-key requires generate.client: true",
+				"generate.response-unions requires generate.client: true",
+				"generate.validation.skip-request requires generate.client: true",
+			},
+		},
+		{
+			name: "validation skipped",
+			cfg: Configuration{
+				Generate: &GenerateOptions{Validation: ValidationOptions{Skip: true, Response: true}},
+			},
+			errs: []string{"generate.validation.response requires Validate methods, which generate.validation.skip turns off"},
+		},
+		{
+			name: "spec ui without embedded spec",
+			cfg: Configuration{
+				Generate: &GenerateOptions{SpecUI: "redoc"},
+			},
+			errs: []string{"generate.spec-ui requires generate.embed-spec: true"},
+		},
+		{
+			name: "spec validation severities",
+			cfg: Configuration{
+				SpecValidation: SpecValidationOptions{InvalidExamples: SeverityWarn, UnknownKeywords: "fatal"},
+			},
+			errs: []string{`spec-validation.unknown-keywords "fatal" is not one of ignore, warn or error`},
+		},
+		{
+			name: "generate options",
+			cfg: Configuration{
+				PackageName: "my-api",
+				Generate: &GenerateOptions{
+					RouteConflicts: "gorilla", DefaultIntType: "integer", MaxDescriptionLength: -1, ServerRouter: true, OperationIDCasing: "pascal",
+					SpecUI:     "rapidoc",
+					Pagination: PaginationOptions{Operations: map[string]Pagination{"listPets": {Style: "keyset"}}},
+				},
+			},
+			errs: []string{
+				`package "my-api" is not a valid Go package name`,
+				"generate.server-router requires generate.server-binding: true",
+				`generate.spec-ui "rapidoc" is not one of swagger-ui or redoc`,
+				`unknown router "gorilla" for route conflicts, expected one of net/http, chi, echo, gin or httprouter`,
+				`generate.operation-id-casing "pascal" is not one of camel, snake or kebab`,
+				`generate.pagination.operations.listPets: unknown pagination style "keyset", expected one of cursor, offset, page, link or none`,
+				"generate.max-description-length must not be negative, got -1",
+				`generate.default-int-type "integer" is not a Go integer type`,
+			},
+		},
+		{
+			name: "filters",
+			cfg: Configuration{
+				Filter: FilterConfig{
+					Include: FilterParamsConfig{Paths: []string{"^/v[12"}, Methods: []string{"get", "query", "PURGE", "fetch"}},
+					Exclude: FilterParamsConfig{Extensions: []string{"x-internal", "internal"}, OperationExtensions: []string{"beta"}},
+				},
+			},
+			errs: []string{
+				`invalid path filter "^/v[12"`,
+				`filter.include.methods: "fetch" is not an HTTP method`,
+				`filter.exclude.extensions: "internal" is not an extension, extensions start with x-`,
+				`filter.exclude.operation-extensions: "beta" is not an extension, extensions start with x-`,
+			},
+		},
+		{
+			name: "output directives and plugins",
+			cfg: Configuration{
+				Output:  &Output{UseSingleFile: true, BuildTags: "linux &&", GoGenerate: true},
+				Plugins: []PluginConfig{{Name: "audit"}, {}},
+			},
+			errs: []string{
+				`invalid output.build-tags "linux &&"`,
+				"output.go-generate requires output.go-generate-command when not generating with the CLI",
+				"plugins[1] needs either a name or a path",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.WithDefaults().Validate()
+			if len(tt.errs) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, ErrInvalidConfiguration)
+			for _, msg := range tt.errs {
+				assert.ErrorContains(t, err, msg)
+			}
+		})
+	}
+}
+
+func TestGenerate_invalidConfiguration(t *testing.T) {
+	cfg := Configuration{PackageName: "api", Generate: &GenerateOptions{ResponseUnions: true}}
+
+	_, err := Generate([]byte(readTestdata(t, "response-unions.yml")), cfg)
+	assert.ErrorIs(t, err, ErrInvalidConfiguration)
+	assert.ErrorContains(t, err, "generate.response-unions requires generate.client: true")
+}
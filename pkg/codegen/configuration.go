@@ -18,6 +18,7 @@ import "time"
 // SkipPrune indicates whether to skip pruning unused components on the generated code.
 // Output specifies the output options for the generated code.
 //
+// Generate specifies what code is generated and how.
 // Filter is the configuration for filtering the paths and operations to be parsed.
 //
 // AdditionalImports defines any additional Go imports to add to the generated code.
@@ -26,8 +27,14 @@ import "time"
 //	The key is the spec error type name
 //	and the value is the dotted json path to the string result.
 //
+// Client defines options for the generated client.
+// Server defines how the API is served.
+//
+// SpecValidation sets how the problems of the spec found when loading it are reported.
+//
 // UserTemplates is the map of user-provided templates overriding the default ones.
 // UserContext is the map of user-provided context values to be used in templates user overrides.
+// Plugins are the plugins run during generation, in order. See Plugin.
 type Configuration struct {
 	PackageName     string  `yaml:"package"`
 	CopyrightHeader string  `yaml:"copyright-header"`
@@ -40,9 +47,19 @@ type Configuration struct {
 	AdditionalImports []AdditionalImport `yaml:"additional-imports,omitempty"`
 	ErrorMapping      map[string]string  `yaml:"error-mapping,omitempty"`
 	Client            *Client            `yaml:"client,omitempty"`
+	Server            *Server            `yaml:"server,omitempty"`
+
+	SpecValidation SpecValidationOptions `yaml:"spec-validation,omitempty"`
 
 	UserTemplates map[string]string `yaml:"user-templates,omitempty"`
 	UserContext   map[string]any    `yaml:"user-context,omitempty"`
+
+	Plugins []PluginConfig `yaml:"plugins,omitempty"`
+
+	// JSONLibrary is the JSON package of the generated marshal and unmarshal code and of the default client's
+	// request bodies: encoding/json (default), jsoniter, sonic, encoding/json/v2, or the import path of a package
+	// with Marshal and Unmarshal functions like encoding/json, e.g. github.com/goccy/go-json.
+	JSONLibrary string `yaml:"json-library,omitempty"`
 }
 
 // Merge combines two configurations, with the receiver (o) taking priority.
@@ -129,6 +146,57 @@ func (o Configuration) OverwriteWith(other Configuration) Configuration {
 			if other.Output.UseSingleFile {
 				o.Output.UseSingleFile = other.Output.UseSingleFile
 			}
+			if other.Output.Changelog != "" {
+				o.Output.Changelog = other.Output.Changelog
+			}
+			if other.Output.RouteManifest != "" {
+				o.Output.RouteManifest = other.Output.RouteManifest
+			}
+			if other.Output.SymbolIndex != "" {
+				o.Output.SymbolIndex = other.Output.SymbolIndex
+			}
+			if other.Output.RemoveStale {
+				o.Output.RemoveStale = other.Output.RemoveStale
+			}
+			if other.Output.EmitSpec != "" {
+				o.Output.EmitSpec = other.Output.EmitSpec
+			}
+			if len(other.Output.Implementations) > 0 {
+				o.Output.Implementations = other.Output.Implementations
+			}
+			if other.Output.PreferNullable {
+				o.Output.PreferNullable = other.Output.PreferNullable
+			}
+			if other.Output.PreferOmitZero {
+				o.Output.PreferOmitZero = other.Output.PreferOmitZero
+			}
+			if other.Output.PreferSensitive {
+				o.Output.PreferSensitive = other.Output.PreferSensitive
+			}
+			if other.Output.SplitByConcern {
+				o.Output.SplitByConcern = other.Output.SplitByConcern
+			}
+			if other.Output.ClientPackage != "" {
+				o.Output.ClientPackage = other.Output.ClientPackage
+			}
+			if other.Output.SplitByTag {
+				o.Output.SplitByTag = other.Output.SplitByTag
+			}
+			if other.Output.ImportPath != "" {
+				o.Output.ImportPath = other.Output.ImportPath
+			}
+			if other.Output.BuildTags != "" {
+				o.Output.BuildTags = other.Output.BuildTags
+			}
+			if other.Output.SpecHeader {
+				o.Output.SpecHeader = other.Output.SpecHeader
+			}
+			if other.Output.GoGenerate {
+				o.Output.GoGenerate = other.Output.GoGenerate
+			}
+			if other.Output.GoGenerateCommand != "" {
+				o.Output.GoGenerateCommand = other.Output.GoGenerateCommand
+			}
 		}
 	}
 
@@ -149,6 +217,69 @@ func (o Configuration) OverwriteWith(other Configuration) Configuration {
 			if other.Generate.AlwaysPrefixEnumValues {
 				o.Generate.AlwaysPrefixEnumValues = other.Generate.AlwaysPrefixEnumValues
 			}
+			if other.Generate.ResponseUnions {
+				o.Generate.ResponseUnions = other.Generate.ResponseUnions
+			}
+			if other.Generate.IdempotencyKey {
+				o.Generate.IdempotencyKey = other.Generate.IdempotencyKey
+			}
+			if other.Generate.ConditionalRequests {
+				o.Generate.ConditionalRequests = other.Generate.ConditionalRequests
+			}
+			if other.Generate.AlignFields {
+				o.Generate.AlignFields = other.Generate.AlignFields
+			}
+			if other.Generate.InternStrings {
+				o.Generate.InternStrings = other.Generate.InternStrings
+			}
+			if other.Generate.EnforceReadWriteOnly {
+				o.Generate.EnforceReadWriteOnly = other.Generate.EnforceReadWriteOnly
+			}
+			if other.Generate.PatchBodies {
+				o.Generate.PatchBodies = other.Generate.PatchBodies
+			}
+			if other.Generate.TypedUnions {
+				o.Generate.TypedUnions = other.Generate.TypedUnions
+			}
+			if other.Generate.AnyOfVariants {
+				o.Generate.AnyOfVariants = other.Generate.AnyOfVariants
+			}
+			if other.Generate.MaxDescriptionLength != 0 {
+				o.Generate.MaxDescriptionLength = other.Generate.MaxDescriptionLength
+			}
+			if other.Generate.EnforceRemoveAfter {
+				o.Generate.EnforceRemoveAfter = other.Generate.EnforceRemoveAfter
+			}
+			if other.Generate.DecimalType != "" {
+				o.Generate.DecimalType = other.Generate.DecimalType
+			}
+			if other.Generate.CaptureUnknownFields {
+				o.Generate.CaptureUnknownFields = other.Generate.CaptureUnknownFields
+			}
+			if other.Generate.RouteConflicts != "" {
+				o.Generate.RouteConflicts = other.Generate.RouteConflicts
+			}
+			if other.Generate.OperationIDCasing != "" {
+				o.Generate.OperationIDCasing = other.Generate.OperationIDCasing
+			}
+			if other.Generate.SensitiveDataTests {
+				o.Generate.SensitiveDataTests = other.Generate.SensitiveDataTests
+			}
+			if other.Generate.ServerBinding {
+				o.Generate.ServerBinding = other.Generate.ServerBinding
+			}
+			if other.Generate.ServerRouter {
+				o.Generate.ServerRouter = other.Generate.ServerRouter
+			}
+			if other.Generate.SpecMetadata {
+				o.Generate.SpecMetadata = other.Generate.SpecMetadata
+			}
+			if other.Generate.EmbedSpec {
+				o.Generate.EmbedSpec = other.Generate.EmbedSpec
+			}
+			if other.Generate.SpecUI != "" {
+				o.Generate.SpecUI = other.Generate.SpecUI
+			}
 			// Overwrite Validation options
 			if other.Generate.Validation.Skip {
 				o.Generate.Validation.Skip = other.Generate.Validation.Skip
@@ -159,6 +290,22 @@ func (o Configuration) OverwriteWith(other Configuration) Configuration {
 			if other.Generate.Validation.Response {
 				o.Generate.Validation.Response = other.Generate.Validation.Response
 			}
+			if other.Generate.Validation.SkipRequest {
+				o.Generate.Validation.SkipRequest = other.Generate.Validation.SkipRequest
+			}
+			if other.Generate.Validation.StrictEnums {
+				o.Generate.Validation.StrictEnums = other.Generate.Validation.StrictEnums
+			}
+			if other.Generate.Validation.Formats != nil {
+				o.Generate.Validation.Formats = other.Generate.Validation.Formats
+			}
+			// Overwrite Pagination options
+			if other.Generate.Pagination.Detect {
+				o.Generate.Pagination.Detect = other.Generate.Pagination.Detect
+			}
+			if other.Generate.Pagination.Operations != nil {
+				o.Generate.Pagination.Operations = other.Generate.Pagination.Operations
+			}
 		}
 	}
 
@@ -173,9 +320,29 @@ func (o Configuration) OverwriteWith(other Configuration) Configuration {
 			if other.Client.Timeout != 0 {
 				o.Client.Timeout = other.Client.Timeout
 			}
+			if other.Client.StripBasePath {
+				o.Client.StripBasePath = other.Client.StripBasePath
+			}
 		}
 	}
 
+	// Overwrite Server options
+	if other.Server != nil {
+		if o.Server == nil {
+			o.Server = other.Server
+		} else if other.Server.BasePath != "" {
+			o.Server.BasePath = other.Server.BasePath
+		}
+	}
+
+	// Overwrite SpecValidation options
+	if other.SpecValidation.InvalidExamples != "" {
+		o.SpecValidation.InvalidExamples = other.SpecValidation.InvalidExamples
+	}
+	if other.SpecValidation.UnknownKeywords != "" {
+		o.SpecValidation.UnknownKeywords = other.SpecValidation.UnknownKeywords
+	}
+
 	// Overwrite Filter
 	if !other.Filter.IsEmpty() {
 		o.Filter = other.Filter
@@ -201,17 +368,53 @@ func (o Configuration) OverwriteWith(other Configuration) Configuration {
 		o.UserContext = other.UserContext
 	}
 
+	// Overwrite Plugins
+	if len(other.Plugins) > 0 {
+		o.Plugins = other.Plugins
+	}
+
+	if other.JSONLibrary != "" {
+		o.JSONLibrary = other.JSONLibrary
+	}
+
 	return o
 }
 
+// SpecValidationOptions set the severity of each class of problems of the spec checked when loading it,
+// to enforce the quality of owned specs or still generate from slightly invalid vendor specs.
+type SpecValidationOptions struct {
+	// InvalidExamples is the severity of the examples not matching their schema. Defaults to ignore.
+	InvalidExamples Severity `yaml:"invalid-examples,omitempty"`
+
+	// UnknownKeywords is the severity of the schema keywords that are neither JSON Schema nor OpenAPI keywords,
+	// nor x- extensions, usually typos ignored by generation. Defaults to ignore.
+	UnknownKeywords Severity `yaml:"unknown-keywords,omitempty"`
+}
+
+// Severity is how a class of spec problems is reported: ignore, warn to log them, or error to fail generation.
+type Severity string
+
+const (
+	SeverityIgnore Severity = "ignore"
+	SeverityWarn   Severity = "warn"
+	SeverityError  Severity = "error"
+)
+
+// AdditionalImport is a Go package imported by the generated code.
 type AdditionalImport struct {
-	Alias   string `yaml:"alias,omitempty"`
+	// Alias is the name the package is imported as, e.g. _ for side effects. Defaults to the package name.
+	Alias string `yaml:"alias,omitempty"`
+
+	// Package is the import path of the package.
 	Package string `yaml:"package"`
 }
 
 // FilterConfig is the configuration for filtering the paths and operations to be parsed.
 type FilterConfig struct {
+	// Include keeps only the paths and operations matching these filters.
 	Include FilterParamsConfig `yaml:"include"`
+
+	// Exclude removes the paths and operations matching these filters.
 	Exclude FilterParamsConfig `yaml:"exclude"`
 }
 
@@ -221,21 +424,41 @@ func (o FilterConfig) IsEmpty() bool {
 }
 
 // FilterParamsConfig is the configuration for filtering the paths to be parsed.
+// Paths are matched exactly, as glob patterns with * matching a path segment and ** any number of segments,
+// e.g. /admin/**, or as regular expressions starting with ^.
 type FilterParamsConfig struct {
-	Paths            []string            `yaml:"paths"`
-	Tags             []string            `yaml:"tags"`
-	OperationIDs     []string            `yaml:"operation-ids"`
+	// Paths filters the paths, e.g. /admin/** or ^/v[12]/.
+	Paths []string `yaml:"paths"`
+
+	// Methods filters the operations by HTTP method, e.g. DELETE, custom methods, e.g. PURGE, in upper case.
+	Methods []string `yaml:"methods"`
+
+	// Tags filters the operations by tag.
+	Tags []string `yaml:"tags"`
+
+	// OperationIDs filters the operations by operationId.
+	OperationIDs []string `yaml:"operation-ids"`
+
+	// SchemaProperties filters the properties of schemas, mapping schema names to property names.
 	SchemaProperties map[string][]string `yaml:"schema-properties"`
-	Extensions       []string            `yaml:"extensions"`
+
+	// Extensions filters the x- extensions kept in the spec.
+	Extensions []string `yaml:"extensions"`
+
+	// OperationExtensions filters the operations by the extensions they set to a value other than false,
+	// e.g. x-internal: true.
+	OperationExtensions []string `yaml:"operation-extensions"`
 }
 
 // IsEmpty returns true if the filter is empty.
 func (o FilterParamsConfig) IsEmpty() bool {
 	return len(o.Paths) == 0 &&
+		len(o.Methods) == 0 &&
 		len(o.Tags) == 0 &&
 		len(o.OperationIDs) == 0 &&
 		len(o.SchemaProperties) == 0 &&
-		len(o.Extensions) == 0
+		len(o.Extensions) == 0 &&
+		len(o.OperationExtensions) == 0
 }
 
 type GenerateOptions struct {
@@ -251,8 +474,145 @@ type GenerateOptions struct {
 	// AlwaysPrefixEnumValues specifies whether to always prefix enum values with the schema name. Defaults to true.
 	AlwaysPrefixEnumValues bool `yaml:"always-prefix-enum-values"`
 
+	// ResponseUnions specifies whether to generate a sealed result interface for operations with multiple responses,
+	// together with a visitor that has to handle every status code. Requires Client. Defaults to false.
+	ResponseUnions bool `yaml:"response-unions"`
+
+	// IdempotencyKey specifies whether client POST and PATCH operations send a generated Idempotency-Key header.
+	// Operations can opt in or out with the x-idempotency-key extension. Defaults to false.
+	IdempotencyKey bool `yaml:"idempotency-key"`
+
+	// ConditionalRequests specifies whether the request options of client POST, PUT, PATCH and DELETE operations
+	// have IfMatch and IfNoneMatch fields, sent as the If-Match and If-None-Match headers,
+	// unless the operations declare these headers. Requires Client. Defaults to false.
+	ConditionalRequests bool `yaml:"conditional-requests"`
+
+	// AlignFields specifies whether to reorder struct fields by alignment when it makes the structs smaller,
+	// logging the bytes saved. JSON tags are kept, but encoding/json marshals fields in the new order. Defaults to false.
+	AlignFields bool `yaml:"align-fields"`
+
+	// InternStrings specifies whether string literals repeated across the generated code, such as content types,
+	// header names, error messages and validation tags, are replaced with shared package-level constants.
+	// Defaults to false.
+	InternStrings bool `yaml:"intern-strings"`
+
+	// EnforceReadWriteOnly specifies whether types with readOnly or writeOnly properties, directly or in nested types,
+	// get MarshalJSONForRequest and MarshalJSONForResponse methods omitting them.
+	// The client sends request bodies with MarshalJSONForRequest. Defaults to false.
+	EnforceReadWriteOnly bool `yaml:"enforce-read-write-only"`
+
+	// PatchBodies specifies whether application/merge-patch+json request bodies are generated with runtime.Nullable
+	// properties, with a New<Body> function computing them from two values of the patched type,
+	// and application/json-patch+json request bodies as runtime.JSONPatch. Defaults to false.
+	PatchBodies bool `yaml:"patch-bodies"`
+
+	// TypedUnions specifies whether oneOf/anyOf unions of 3 or 4 elements are stored in runtime.OneOf3
+	// and runtime.OneOf4 with typed fields, like runtime.Either for 2 elements, instead of json.RawMessage.
+	// Defaults to false.
+	TypedUnions bool `yaml:"typed-unions"`
+
+	// AnyOfVariants specifies whether anyOf unions are generated as structs with an optional field for every variant,
+	// set for all the variants the data matches, instead of holding a single one like oneOf unions.
+	// The data is kept, so properties unknown to the variants survive a round trip. Defaults to false.
+	AnyOfVariants bool `yaml:"anyof-variants"`
+
+	// MaxDescriptionLength specifies the number of characters after which descriptions in generated comments
+	// are truncated, with a pointer back to the spec for the full text. Defaults to 0, no truncation.
+	MaxDescriptionLength int `yaml:"max-description-length"`
+
+	// EnforceRemoveAfter specifies whether generation fails for properties whose x-remove-after date has passed,
+	// so fields scheduled for removal are removed from the spec in time. Defaults to false.
+	EnforceRemoveAfter bool `yaml:"enforce-remove-after"`
+
+	// DecimalType specifies the Go type of strings and numbers with format decimal, instead of string and float64,
+	// e.g. decimal.Decimal for github.com/shopspring/decimal. Other packages are imported with additional-imports.
+	// Properties with x-go-type: decimal use it too, defaulting to decimal.Decimal.
+	DecimalType string `yaml:"decimal-type"`
+
+	// CaptureUnknownFields specifies whether the fields of objects missing from the spec are kept
+	// in their AdditionalProperties, as json.RawMessage, to be marshaled back unchanged.
+	// It applies to the objects with properties that don't set additionalProperties, like x-capture-unknown does
+	// per object. Defaults to false.
+	CaptureUnknownFields bool `yaml:"capture-unknown-fields"`
+
+	// RouteConflicts specifies the router whose matching rules the operation paths are checked against,
+	// failing generation for paths it can't route unambiguously, e.g. /pets/{id}/toys and /pets/mine/{kind}
+	// on net/http. One of net/http, chi, echo, gin or httprouter. Defaults to no check.
+	RouteConflicts string `yaml:"route-conflicts"`
+
+	// OperationIDCasing specifies the casing of the operationIds synthesized from the method and path
+	// of the operations without one, e.g. getPetsByPetId for GET /pets/{petId}: camel, snake or kebab.
+	// They are reported as warnings. Defaults to camel.
+	OperationIDCasing string `yaml:"operation-id-casing"`
+
+	// SensitiveDataTests specifies whether a sensitive_data_test.go file is generated next to the code,
+	// checking that the masked JSON of every type with x-sensitive-data properties decodes back into the type
+	// and keeps the lengths the schema allows. Defaults to false.
+	SensitiveDataTests bool `yaml:"sensitive-data-tests"`
+
+	// ServerBinding specifies whether to generate, for every operation, an <Op>Request struct with its path, query,
+	// header and cookie parameters and JSON body, a Bind<Op>Request function reading and validating it
+	// from an *http.Request, and an <Op>Handler adapting a handler of the typed request to an http.HandlerFunc.
+	// Defaults to false.
+	ServerBinding bool `yaml:"server-binding"`
+
+	// ServerRouter specifies whether to generate a ServerInterface with a method per operation receiving the bound
+	// request, and HandlerWithOptions routing the operations to it on an http.ServeMux, wrapped by the middlewares
+	// registered globally, per tag and per operationId. Requires ServerBinding. Defaults to false.
+	ServerRouter bool `yaml:"server-router"`
+
+	// SpecMetadata specifies whether to generate the SpecTitle, SpecVersion and SpecChecksum constants,
+	// and the DefaultUserAgent constant of clients, for services to report the contract they were generated from.
+	// Schemas with the same names are renamed when enabled. Defaults to false.
+	SpecMetadata bool `yaml:"spec-metadata"`
+
+	// EmbedSpec specifies whether to embed a gzipped copy of the spec, after filtering and pruning,
+	// with GetSwagger returning its JSON and ServeSpec serving it, for services to publish their contract.
+	// Defaults to false.
+	EmbedSpec bool `yaml:"embed-spec"`
+
+	// SpecUI specifies the documentation page returned by the generated SpecUIHandler, loading the spec served
+	// by ServeSpec: swagger-ui or redoc. Requires EmbedSpec. Defaults to no page.
+	SpecUI string `yaml:"spec-ui"`
+
 	// Validation specifies options for Validate() method generation.
 	Validation ValidationOptions `yaml:"validation"`
+
+	// Pagination specifies options for the <Op>Pages and <Op>All client methods iterating over the pages
+	// and results of paginated operations.
+	Pagination PaginationOptions `yaml:"pagination"`
+}
+
+// PaginationOptions configures the pagers generated for the operations paginated with x-pagination,
+// detected from their query parameters and response properties, or set by operationId.
+type PaginationOptions struct {
+	// Detect specifies whether to generate pagers for the operations matching common pagination patterns:
+	// a cursor, pageToken or after query parameter with a next cursor in the response, or an offset or page
+	// query parameter with an array of results, or a Link response header. Otherwise, the detected operations
+	// are reported as warnings. Defaults to false.
+	Detect bool `yaml:"detect"`
+
+	// Operations sets the pagination of operations by operationId, overriding x-pagination and detection.
+	// An empty style confirms the detected pagination, and "none" turns it off.
+	Operations map[string]Pagination `yaml:"operations,omitempty"`
+}
+
+// Pagination describes how an operation is paginated, in x-pagination and generate.pagination.operations.
+// Unset fields are detected.
+type Pagination struct {
+	// Style is cursor, offset, page, link to follow the Link response header, or none to generate no pager.
+	Style string `yaml:"style"`
+
+	// Param is the query parameter of the cursor, offset or page number to request, unused by the link style.
+	Param string `yaml:"param,omitempty"`
+
+	// Next is the string response property with the cursor of the next page, for the cursor style.
+	// The pager stops when it is empty.
+	Next string `yaml:"next,omitempty"`
+
+	// Items is the array response property with the results, generating the <Op>All iterator.
+	// The pager of the offset and page styles stops when it is empty.
+	Items string `yaml:"items,omitempty"`
 }
 
 type ValidationOptions struct {
@@ -266,17 +626,133 @@ type ValidationOptions struct {
 	// Response specifies whether to generate Validate() methods for response types.
 	// Useful for contract testing to ensure responses match the OpenAPI spec. Defaults to false.
 	Response bool `yaml:"response"`
+
+	// SkipRequest specifies whether to skip validating request bodies, and the items of array of enum query parameters,
+	// in client methods before they are sent. By default, a body that fails Validate() is returned as an error
+	// without calling the server. Defaults to false.
+	SkipRequest bool `yaml:"skip-request"`
+
+	// StrictEnums specifies whether enum values missing from the spec are rejected with runtime.ErrUnknownEnumValue
+	// when binding server requests, and when validating client requests unless SkipRequest is set.
+	// Decoding responses always keeps unknown values, for IsValid and Validate to report them,
+	// so clients stay compatible with servers adding enum values. Defaults to false.
+	StrictEnums bool `yaml:"strict-enums"`
+
+	// Formats maps string formats to the validator tags checking them, overriding the defaults
+	// for uuid, email, uri, ipv4, ipv6, hostname, date and date-time.
+	// "-" disables validation of a format, and an empty value validates it with the function
+	// registered with runtime.RegisterFormat.
+	Formats map[string]string `yaml:"formats,omitempty"`
 }
 
 type Output struct {
-	UseSingleFile bool   `yaml:"use-single-file"`
-	Directory     string `yaml:"directory"`
-	Filename      string `yaml:"filename"`
+	// UseSingleFile specifies whether all the code is generated in Filename
+	// instead of a file per concern in a directory named after the package. Defaults to true.
+	UseSingleFile bool `yaml:"use-single-file"`
+
+	// Directory is the directory the code is generated in. Defaults to the current directory.
+	Directory string `yaml:"directory"`
+
+	// Filename is the name of the file of the single file output. Defaults to gen.go.
+	Filename string `yaml:"filename"`
+
+	// Changelog is the name of a Markdown file, written next to the generated code, summarizing the
+	// added, removed and changed exported declarations when regenerating over existing output.
+	Changelog string `yaml:"changelog"`
+
+	// RouteManifest is the name of a JSON file, written next to the generated code, listing the method, path,
+	// operationId, security requirements and timeout of every operation, e.g. for API gateway configs.
+	RouteManifest string `yaml:"route-manifest"`
+
+	// SymbolIndex is the name of a JSON file, written next to the generated code, mapping the component refs and
+	// operationIds of the spec to the Go symbols generated for them and the files declaring them, e.g. index.gen.json,
+	// for IDE plugins and tools navigating from the spec to the generated code.
+	SymbolIndex string `yaml:"symbol-index"`
+
+	// RemoveStale specifies whether the CLI removes the generated files of earlier runs that are no longer generated,
+	// e.g. after the filters changed, from the output directory, and its sub-directories with the multi-file output.
+	// Generated files are the Go files starting with the header of the generated code. The files of the other
+	// configs of the same run are kept, but the directory must not hold the output of other runs. Defaults to false.
+	RemoveStale bool `yaml:"remove-stale"`
+
+	// EmitSpec is the name of a file, written next to the generated code, with the spec after filtering and pruning,
+	// e.g. to publish a trimmed public spec matching the generated code. It is JSON for .json names, YAML otherwise.
+	EmitSpec string `yaml:"emit-spec"`
+
+	// Implementations maps the names of hand-written types implementing the client interface, e.g. fakes,
+	// to the files next to the generated code where stubs of their missing methods are added.
+	// The generated code asserts these types implement the interface, so they are kept in sync with the spec.
+	Implementations map[string]string `yaml:"implementations,omitempty"`
+
+	// PreferNullable specifies whether optional properties that are nullable in the spec are declared as
+	// runtime.Nullable instead of pointers, to tell an absent property from an explicit null,
+	// e.g. in PATCH requests. Defaults to false.
+	PreferNullable bool `yaml:"prefer-nullable"`
+
+	// PreferOmitZero specifies whether optional properties of struct types, e.g. time.Time, runtime.Date or objects,
+	// are declared as values with the omitzero JSON tag instead of pointers with omitempty. Their zero values
+	// are left out of the JSON. x-omitzero overrides it for a property. Defaults to false.
+	PreferOmitZero bool `yaml:"prefer-omitzero"`
+
+	// PreferSensitive specifies whether properties of primitive types with x-sensitive-data are declared as
+	// runtime.Sensitive, masked by fmt, text marshaling and slog too, so printing a struct can't leak them.
+	// Their values are set with runtime.NewSensitive and read with Value. Defaults to false.
+	PreferSensitive bool `yaml:"prefer-sensitive"`
+
+	// SplitByConcern specifies whether the files of the multi-file output are types.gen.go, client.gen.go
+	// and validation.gen.go, with the Validate methods, each importing only the packages it uses,
+	// instead of a file per spec location. Defaults to false.
+	SplitByConcern bool `yaml:"split-by-concern"`
+
+	// ClientPackage is the name of the sub-package, and of its directory, client.gen.go is generated in
+	// with SplitByConcern, referring to the types of the generated package through ImportPath.
+	// Defaults to the generated package.
+	ClientPackage string `yaml:"client-package"`
+
+	// SplitByTag specifies whether the client of the multi-file output is generated in a sub-package per tag,
+	// named after the first tag of the operations, e.g. payments/client.gen.go,
+	// referring to the types of the generated package through ImportPath.
+	// Untagged operations stay in the generated package. Defaults to false.
+	SplitByTag bool `yaml:"split-by-tag"`
+
+	// ImportPath is the Go import path of the generated package, imported by the ClientPackage
+	// and the packages of SplitByTag.
+	ImportPath string `yaml:"import-path"`
+
+	// BuildTags is the build constraint expression of the generated files, e.g. !codeanalysis,
+	// added as a //go:build line.
+	BuildTags string `yaml:"build-tags"`
+
+	// SpecHeader specifies whether the header of the generated files names the generator version
+	// and the title, version and checksum of the spec, telling which spec the code was generated from.
+	// Defaults to false.
+	SpecHeader bool `yaml:"spec-header"`
+
+	// GoGenerate specifies whether a //go:generate directive running GoGenerateCommand is added to
+	// the generated package, so go generate regenerates it. Defaults to false.
+	GoGenerate bool `yaml:"go-generate"`
+
+	// GoGenerateCommand is the command of the //go:generate directive, run in the directory of the generated package.
+	// The CLI defaults it to its own invocation, with the config and spec paths relative to that directory.
+	GoGenerateCommand string `yaml:"go-generate-command"`
 }
 
 type Client struct {
-	Name    string        `yaml:"name"`
+	// Name is the name of the generated client type. Defaults to Client.
+	Name string `yaml:"name"`
+
+	// Timeout is the default timeout of the client requests, e.g. 10s. Defaults to 3s.
 	Timeout time.Duration `yaml:"timeout"`
+
+	// StripBasePath specifies whether the client requests paths without the server base path,
+	// for base URLs already ending with it. Defaults to false.
+	StripBasePath bool `yaml:"strip-base-path"`
+}
+
+type Server struct {
+	// BasePath is the path prefix the handlers are mounted under, e.g. /api/v2, prepended to the paths
+	// of the operations, their routes and client requests.
+	BasePath string `yaml:"base-path"`
 }
 
 // NewDefaultConfiguration creates a new default Configuration.
@@ -212,6 +212,27 @@ func TestConfiguration_OverwriteWith(t *testing.T) {
 		assert.Equal(t, []string{"/override/path"}, result.Filter.Include.Paths)
 	})
 
+	t.Run("other StrictEnums overwrites user StrictEnums", func(t *testing.T) {
+		overrides := Configuration{
+			Generate: &GenerateOptions{Validation: ValidationOptions{StrictEnums: true}},
+		}
+
+		result := Configuration{Generate: &GenerateOptions{}}.OverwriteWith(overrides)
+		assert.True(t, result.Generate.Validation.StrictEnums)
+	})
+
+	t.Run("other SpecValidation severities overwrite user SpecValidation severities", func(t *testing.T) {
+		userConfig := Configuration{
+			SpecValidation: SpecValidationOptions{InvalidExamples: SeverityWarn, UnknownKeywords: SeverityWarn},
+		}
+		overrides := Configuration{
+			SpecValidation: SpecValidationOptions{UnknownKeywords: SeverityError},
+		}
+
+		result := userConfig.OverwriteWith(overrides)
+		assert.Equal(t, SpecValidationOptions{InvalidExamples: SeverityWarn, UnknownKeywords: SeverityError}, result.SpecValidation)
+	})
+
 	t.Run("other Output fields overwrite user Output fields", func(t *testing.T) {
 		userConfig := Configuration{
 			Output: &Output{
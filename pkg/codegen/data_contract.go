@@ -0,0 +1,593 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	"go.yaml.in/yaml/v4"
+)
+
+// DataContractFormat is a schema format generated for x-data-contract models.
+type DataContractFormat string
+
+const (
+	// DataContractJSONSchema generates a <Type>.schema.json JSON Schema (draft 2020-12).
+	DataContractJSONSchema DataContractFormat = "json-schema"
+	// DataContractAvro generates a <Type>.avsc Avro schema.
+	DataContractAvro DataContractFormat = "avro"
+)
+
+const jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// openAPIOnlyKeywords are OpenAPI schema keywords without a JSON Schema equivalent.
+var openAPIOnlyKeywords = []string{"discriminator", "xml", "externalDocs", "nullable", "example"}
+
+// newDataContracts returns the data contract schemas of the component schemas marked with x-data-contract,
+// by file name. Avro schemas use namespace as their namespace.
+func newDataContracts(ctx *ParseContext, namespace string) (map[string]string, error) {
+	res := make(map[string]string)
+	for _, td := range ctx.TypeDefinitions[SpecLocationSchema] {
+		schema := td.Schema.OpenAPISchema
+		if schema == nil {
+			continue
+		}
+		v, ok := extractExtensions(schema.Extensions)[extDataContract]
+		if !ok {
+			continue
+		}
+		formats, err := extParseDataContract(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s of %s: %w", extDataContract, td.Name, err)
+		}
+
+		for _, format := range formats {
+			var (
+				name     string
+				contents string
+			)
+			switch format {
+			case DataContractJSONSchema:
+				name = td.Name + ".schema.json"
+				contents, err = jsonSchemaContract(td.Name, "#/components/schemas/"+td.JsonName, schema)
+			case DataContractAvro:
+				name = td.Name + ".avsc"
+				contents, err = avroContract(td.Name, "#/components/schemas/"+td.JsonName, namespace, schema)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("error generating %s data contract of %s: %w", format, td.Name, err)
+			}
+			res[name] = contents
+		}
+	}
+	return res, nil
+}
+
+// jsonSchemaContract renders the schema referenced by ref as a standalone JSON Schema,
+// with the schemas it references under $defs.
+func jsonSchemaContract(name, ref string, schema *base.Schema) (string, error) {
+	pointers := map[string]string{ref: "#"}
+	defs := make(map[string]any)
+
+	doc, err := renderJSONSchema(schema, pointers, defs)
+	if err != nil {
+		return "", err
+	}
+	rewriteRefs(doc, pointers)
+	rewriteRefs(defs, pointers)
+
+	if len(defs) > 0 {
+		doc["$defs"] = defs
+	}
+	doc["$schema"] = jsonSchemaDialect
+	if _, ok := doc["title"]; !ok {
+		doc["title"] = name
+	}
+	return marshalContract(doc)
+}
+
+// renderJSONSchema renders schema as JSON Schema, adding the schemas it references to defs
+// and their JSON pointers to pointers.
+func renderJSONSchema(schema *base.Schema, pointers map[string]string, defs map[string]any) (map[string]any, error) {
+	rendered, err := schema.Render()
+	if err != nil {
+		return nil, err
+	}
+	var doc map[string]any
+	if err = yaml.Unmarshal(rendered, &doc); err != nil {
+		return nil, err
+	}
+	if doc == nil {
+		doc = make(map[string]any)
+	}
+
+	refs := make(map[string]*base.SchemaProxy)
+	collectContractRefs(schema, refs, make(map[*base.Schema]bool))
+	for _, ref := range slices.Sorted(maps.Keys(refs)) {
+		if _, ok := pointers[ref]; ok {
+			continue
+		}
+		name := refPathToObjName(ref)
+		pointers[ref] = "#/$defs/" + name
+
+		sub, err := refs[ref].BuildSchema()
+		if err != nil {
+			return nil, err
+		}
+		if sub == nil {
+			continue
+		}
+		if defs[name], err = renderJSONSchema(sub, pointers, defs); err != nil {
+			return nil, err
+		}
+	}
+	return toJSONSchema(doc), nil
+}
+
+// collectContractRefs collects the references in schema, without following them.
+func collectContractRefs(schema *base.Schema, refs map[string]*base.SchemaProxy, visited map[*base.Schema]bool) {
+	if schema == nil || visited[schema] {
+		return
+	}
+	visited[schema] = true
+
+	var proxies []*base.SchemaProxy
+	proxies = append(proxies, schema.AllOf...)
+	proxies = append(proxies, schema.OneOf...)
+	proxies = append(proxies, schema.AnyOf...)
+	proxies = append(proxies, schema.PrefixItems...)
+	proxies = append(proxies, schema.Not, schema.Contains, schema.PropertyNames)
+	if schema.Items != nil && schema.Items.IsA() {
+		proxies = append(proxies, schema.Items.A)
+	}
+	if schema.AdditionalProperties != nil && schema.AdditionalProperties.IsA() {
+		proxies = append(proxies, schema.AdditionalProperties.A)
+	}
+	if schema.Properties != nil {
+		for _, proxy := range schema.Properties.FromOldest() {
+			proxies = append(proxies, proxy)
+		}
+	}
+	if schema.PatternProperties != nil {
+		for _, proxy := range schema.PatternProperties.FromOldest() {
+			proxies = append(proxies, proxy)
+		}
+	}
+
+	for _, proxy := range proxies {
+		if proxy == nil {
+			continue
+		}
+		if proxy.IsReference() {
+			refs[proxy.GetReference()] = proxy
+			continue
+		}
+		collectContractRefs(proxy.Schema(), refs, visited)
+	}
+}
+
+// rewriteRefs replaces the $ref values found in v with their JSON pointers.
+func rewriteRefs(v any, pointers map[string]string) {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if ref, ok := value.(string); ok && key == "$ref" {
+				if pointer, ok := pointers[ref]; ok {
+					v[key] = pointer
+				}
+				continue
+			}
+			rewriteRefs(value, pointers)
+		}
+	case []any:
+		for _, value := range v {
+			rewriteRefs(value, pointers)
+		}
+	}
+}
+
+// toJSONSchema converts the OpenAPI 3.0 keywords of an inlined schema to JSON Schema, recursively.
+func toJSONSchema(schema map[string]any) map[string]any {
+	if nullable, _ := schema["nullable"].(bool); nullable {
+		switch t := schema["type"].(type) {
+		case string:
+			schema["type"] = []any{t, "null"}
+		case []any:
+			if !slices.Contains(t, any("null")) {
+				schema["type"] = append(t, "null")
+			}
+		}
+		if enum, ok := schema["enum"].([]any); ok && !slices.Contains(enum, nil) {
+			schema["enum"] = append(enum, nil)
+		}
+	}
+	for _, bound := range []string{"Minimum", "Maximum"} {
+		exclusive, limit := "exclusive"+bound, strings.ToLower(bound)
+		if isExclusive, ok := schema[exclusive].(bool); ok {
+			delete(schema, exclusive)
+			if isExclusive {
+				schema[exclusive] = schema[limit]
+				delete(schema, limit)
+			}
+		}
+	}
+	if example, ok := schema["example"]; ok {
+		if _, exists := schema["examples"]; !exists {
+			schema["examples"] = []any{example}
+		}
+	}
+	for key := range schema {
+		if strings.HasPrefix(key, "x-") || slices.Contains(openAPIOnlyKeywords, key) {
+			delete(schema, key)
+		}
+	}
+
+	for _, key := range []string{"items", "additionalProperties", "not", "contains", "propertyNames"} {
+		if sub, ok := schema[key].(map[string]any); ok {
+			schema[key] = toJSONSchema(sub)
+		}
+	}
+	for _, key := range []string{"properties", "patternProperties", "$defs"} {
+		if subs, ok := schema[key].(map[string]any); ok {
+			for name, sub := range subs {
+				if m, ok := sub.(map[string]any); ok {
+					subs[name] = toJSONSchema(m)
+				}
+			}
+		}
+	}
+	for _, key := range []string{"allOf", "anyOf", "oneOf", "prefixItems"} {
+		if subs, ok := schema[key].([]any); ok {
+			for i, sub := range subs {
+				if m, ok := sub.(map[string]any); ok {
+					subs[i] = toJSONSchema(m)
+				}
+			}
+		}
+	}
+	return schema
+}
+
+// avroContract converts the schema referenced by ref to an Avro schema, usually a record.
+// Types Avro can't express, e.g. free-form objects, are JSON-encoded strings.
+func avroContract(name, ref, namespace string, schema *base.Schema) (string, error) {
+	b := &avroBuilder{
+		defined:  map[string]bool{name: true},
+		reserved: map[string]bool{name: true},
+		refs:     map[string]string{ref: name},
+	}
+	t, err := b.schemaType(name, schema)
+	if err != nil {
+		return "", err
+	}
+	if named, ok := t.(map[string]any); ok && named["name"] != nil && namespace != "" {
+		named["namespace"] = namespace
+	}
+	return marshalContract(t)
+}
+
+// avroBuilder builds Avro types, keeping track of the named types as Avro names must be unique.
+// refs maps the references to the name of their record or enum, reserved holds the names
+// reserved for references whose type is being built.
+type avroBuilder struct {
+	defined  map[string]bool
+	reserved map[string]bool
+	refs     map[string]string
+}
+
+// proxyType returns the Avro type of a schema proxy, referencing records and enums that are already defined by name.
+func (b *avroBuilder) proxyType(name string, proxy *base.SchemaProxy) (any, error) {
+	if proxy == nil {
+		return "string", nil
+	}
+	schema, err := proxy.BuildSchema()
+	if err != nil {
+		return nil, err
+	}
+	if schema == nil {
+		return "string", nil
+	}
+	if !proxy.IsReference() {
+		return b.schemaType(name, schema)
+	}
+
+	ref := proxy.GetReference()
+	if defined, ok := b.refs[ref]; ok {
+		return defined, nil
+	}
+	name = b.uniqueName(schemaNameToTypeName(refPathToObjName(ref)))
+	b.refs[ref] = name
+	b.reserved[name] = true
+
+	t, err := b.schemaType(name, schema)
+	if b.reserved[name] {
+		// not a named type, so it is repeated wherever it is referenced
+		delete(b.reserved, name)
+		delete(b.refs, ref)
+	}
+	return t, err
+}
+
+// schemaType returns the Avro type of schema, naming records and enums after name.
+func (b *avroBuilder) schemaType(name string, schema *base.Schema) (any, error) {
+	t, err := b.nonNullType(name, schema)
+	if err != nil {
+		return nil, err
+	}
+	if isNullable(schema) {
+		return nullableAvroType(t), nil
+	}
+	return t, nil
+}
+
+func (b *avroBuilder) nonNullType(name string, schema *base.Schema) (any, error) {
+	switch {
+	case len(schema.AllOf) == 1:
+		return b.proxyType(name, schema.AllOf[0])
+	case len(schema.AllOf) > 1:
+		return b.record(name, schema)
+	case len(schema.OneOf) > 0 || len(schema.AnyOf) > 0:
+		return b.union(name, append(slices.Clone(schema.OneOf), schema.AnyOf...))
+	}
+
+	switch schemaTypeName(schema) {
+	case "object":
+		if schema.Properties != nil && schema.Properties.Len() > 0 {
+			return b.record(name, schema)
+		}
+		if schema.AdditionalProperties != nil && schema.AdditionalProperties.IsA() {
+			values, err := b.proxyType(name+"Value", schema.AdditionalProperties.A)
+			if err != nil {
+				return nil, err
+			}
+			return map[string]any{"type": "map", "values": values}, nil
+		}
+		return "string", nil
+	case "array":
+		var items any = "string"
+		if schema.Items != nil && schema.Items.IsA() {
+			var err error
+			if items, err = b.proxyType(name+"Item", schema.Items.A); err != nil {
+				return nil, err
+			}
+		}
+		return map[string]any{"type": "array", "items": items}, nil
+	case "string":
+		if t := b.enum(name, schema); t != nil {
+			return t, nil
+		}
+		switch schema.Format {
+		case "uuid":
+			return map[string]any{"type": "string", "logicalType": "uuid"}, nil
+		case "byte", "binary":
+			return "bytes", nil
+		}
+		return "string", nil
+	case "integer":
+		if schema.Format == "int32" {
+			return "int", nil
+		}
+		return "long", nil
+	case "number":
+		if schema.Format == "float" {
+			return "float", nil
+		}
+		return "double", nil
+	case "boolean":
+		return "boolean", nil
+	}
+	return "string", nil
+}
+
+// record returns an Avro record with the properties of schema and its allOf schemas.
+func (b *avroBuilder) record(name string, schema *base.Schema) (map[string]any, error) {
+	name = b.define(name)
+	res := map[string]any{"type": "record", "name": name}
+	if schema.Description != "" {
+		res["doc"] = schema.Description
+	}
+
+	props, required, err := collectAvroProperties(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]any, 0, len(props))
+	for _, prop := range props {
+		t, err := b.proxyType(name+UppercaseFirstCharacter(avroName(prop.name)), prop.proxy)
+		if err != nil {
+			return nil, fmt.Errorf("property %s: %w", prop.name, err)
+		}
+		field := map[string]any{"name": avroName(prop.name)}
+		if field["name"] != prop.name {
+			field["jsonName"] = prop.name
+		}
+		if !slices.Contains(required, prop.name) {
+			t = nullableAvroType(t)
+			field["default"] = nil
+		}
+		field["type"] = t
+		if prop.proxy.IsReference() {
+			fields = append(fields, field)
+			continue
+		}
+		if s := prop.proxy.Schema(); s != nil && s.Description != "" {
+			field["doc"] = s.Description
+		}
+		fields = append(fields, field)
+	}
+	res["fields"] = fields
+	return res, nil
+}
+
+// enum returns an Avro enum for string enums whose values are valid Avro names, nil otherwise.
+func (b *avroBuilder) enum(name string, schema *base.Schema) map[string]any {
+	if len(schema.Enum) == 0 {
+		return nil
+	}
+	symbols := make([]any, 0, len(schema.Enum))
+	for _, node := range schema.Enum {
+		if node == nil || node.Value == "" || avroName(node.Value) != node.Value {
+			return nil
+		}
+		symbols = append(symbols, node.Value)
+	}
+	return map[string]any{"type": "enum", "name": b.define(name), "symbols": symbols}
+}
+
+// union returns an Avro union of the alternative schemas, with null first if any is nullable.
+func (b *avroBuilder) union(name string, proxies []*base.SchemaProxy) (any, error) {
+	var res []any
+	for i, proxy := range proxies {
+		t, err := b.proxyType(name+strconv.Itoa(i+1), proxy)
+		if err != nil {
+			return nil, err
+		}
+		if types, ok := t.([]any); ok {
+			for _, t := range types {
+				res = appendAvroUnionType(res, t)
+			}
+			continue
+		}
+		res = appendAvroUnionType(res, t)
+	}
+	if len(res) == 1 {
+		return res[0], nil
+	}
+	return res, nil
+}
+
+// define returns the name of a new record or enum, which is name if it was reserved for it.
+func (b *avroBuilder) define(name string) string {
+	if b.reserved[name] {
+		delete(b.reserved, name)
+		return name
+	}
+	return b.uniqueName(name)
+}
+
+// uniqueName returns name, or name with a number suffix if it is already used.
+func (b *avroBuilder) uniqueName(name string) string {
+	name = avroName(name)
+	res := name
+	for i := 2; b.defined[res]; i++ {
+		res = name + strconv.Itoa(i)
+	}
+	b.defined[res] = true
+	return res
+}
+
+type avroProperty struct {
+	name  string
+	proxy *base.SchemaProxy
+}
+
+// collectAvroProperties returns the properties of schema and of its allOf schemas, in order, and the required ones.
+func collectAvroProperties(schema *base.Schema) ([]avroProperty, []string, error) {
+	var (
+		props    []avroProperty
+		required = slices.Clone(schema.Required)
+	)
+	for _, proxy := range schema.AllOf {
+		sub, err := proxy.BuildSchema()
+		if err != nil {
+			return nil, nil, err
+		}
+		if sub == nil {
+			continue
+		}
+		subProps, subRequired, err := collectAvroProperties(sub)
+		if err != nil {
+			return nil, nil, err
+		}
+		props = append(props, subProps...)
+		required = append(required, subRequired...)
+	}
+	if schema.Properties != nil {
+		for name, proxy := range schema.Properties.FromOldest() {
+			props = slices.DeleteFunc(props, func(p avroProperty) bool { return p.name == name })
+			props = append(props, avroProperty{name: name, proxy: proxy})
+		}
+	}
+	return props, required, nil
+}
+
+// schemaTypeName returns the non-null type of schema, inferring objects from their properties.
+func schemaTypeName(schema *base.Schema) string {
+	for _, t := range schema.Type {
+		if t != "null" {
+			return t
+		}
+	}
+	if schema.Properties != nil || schema.AdditionalProperties != nil {
+		return "object"
+	}
+	return ""
+}
+
+func isNullable(schema *base.Schema) bool {
+	return (schema.Nullable != nil && *schema.Nullable) || slices.Contains(schema.Type, "null")
+}
+
+// nullableAvroType returns a union of null and t, with null first so it can default to null.
+func nullableAvroType(t any) any {
+	types, ok := t.([]any)
+	if !ok {
+		return []any{"null", t}
+	}
+	if slices.Contains(types, any("null")) {
+		return append([]any{"null"}, slices.DeleteFunc(slices.Clone(types), func(t any) bool { return t == "null" })...)
+	}
+	return append([]any{"null"}, types...)
+}
+
+func appendAvroUnionType(types []any, t any) []any {
+	if s, ok := t.(string); ok && slices.Contains(types, any(s)) {
+		return types
+	}
+	return append(types, t)
+}
+
+// avroName replaces the characters that are not valid in Avro names with underscores.
+func avroName(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		switch {
+		case r == '_', r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+			b.WriteRune(r)
+		case r >= '0' && r <= '9':
+			if i == 0 {
+				b.WriteRune('_')
+			}
+			b.WriteRune(r)
+		default:
+			b.WriteRune('_')
+		}
+	}
+	if b.Len() == 0 {
+		return "_"
+	}
+	return b.String()
+}
+
+func marshalContract(v any) (string, error) {
+	out, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(out) + "\n", nil
+}
@@ -0,0 +1,139 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"encoding/json"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataContracts(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "api",
+		Output:      &Output{UseSingleFile: true},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "data-contract.yml")), cfg)
+	require.NoError(t, err)
+
+	files := codes.GetExtraFiles()
+	assert.ElementsMatch(t, []string{"Order.schema.json", "Order.avsc", "Event.avsc"}, slices.Collect(maps.Keys(files)))
+
+	t.Run("json schema", func(t *testing.T) {
+		var doc map[string]any
+		require.NoError(t, json.Unmarshal([]byte(files["Order.schema.json"]), &doc))
+
+		assert.Equal(t, "https://json-schema.org/draft/2020-12/schema", doc["$schema"])
+		assert.Equal(t, "Order", doc["title"])
+
+		props := doc["properties"].(map[string]any)
+		assert.Equal(t, map[string]any{"$ref": "#"}, props["parent"])
+		assert.Equal(t, map[string]any{"$ref": "#/$defs/Status"}, props["status"])
+		assert.Equal(t, map[string]any{
+			"type":        []any{"string", "null"},
+			"description": "Free text.",
+		}, props["note"])
+		assert.Equal(t, map[string]any{
+			"type":             "number",
+			"format":           "float",
+			"exclusiveMinimum": float64(0),
+			"examples":         []any{12.5},
+		}, props["total"])
+
+		assert.Equal(t, map[string]any{
+			"Status": map[string]any{"type": "string", "enum": []any{"pending", "shipped"}},
+		}, doc["$defs"])
+	})
+
+	t.Run("avro", func(t *testing.T) {
+		assert.JSONEq(t, `{
+			"type": "record",
+			"name": "Event",
+			"namespace": "api",
+			"fields": [
+				{"name": "id", "type": "string"},
+				{"name": "payload", "type": [
+					{"type": "enum", "name": "Status", "symbols": ["pending", "shipped"]},
+					"long",
+					"null",
+					"string"
+				]}
+			]
+		}`, files["Event.avsc"])
+
+		var doc map[string]any
+		require.NoError(t, json.Unmarshal([]byte(files["Order.avsc"]), &doc))
+
+		fields := make(map[string]any)
+		for _, f := range doc["fields"].([]any) {
+			field := f.(map[string]any)
+			fields[field["name"].(string)] = field
+		}
+		assert.Equal(t, map[string]any{
+			"name": "id",
+			"type": map[string]any{"type": "string", "logicalType": "uuid"},
+		}, fields["id"])
+		assert.Equal(t, map[string]any{
+			"name":     "previous_status",
+			"jsonName": "previous-status",
+			"type":     []any{"null", "Status"},
+			"default":  nil,
+		}, fields["previous_status"])
+		assert.Equal(t, map[string]any{
+			"name":    "parent",
+			"type":    []any{"null", "Order"},
+			"default": nil,
+		}, fields["parent"])
+		assert.Equal(t, map[string]any{
+			"name":    "labels",
+			"type":    []any{"null", map[string]any{"type": "map", "values": "string"}},
+			"default": nil,
+		}, fields["labels"])
+	})
+}
+
+func Test_extParseDataContract(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   any
+		want    []DataContractFormat
+		wantErr string
+	}{
+		{name: "true", value: "true", want: []DataContractFormat{DataContractJSONSchema, DataContractAvro}},
+		{name: "false", value: "false", want: nil},
+		{name: "single format", value: "avro", want: []DataContractFormat{DataContractAvro}},
+		{name: "list", value: []any{"json-schema"}, want: []DataContractFormat{DataContractJSONSchema}},
+		{name: "unknown format", value: "protobuf", wantErr: `unknown format "protobuf"`},
+		{name: "invalid type", value: 1, wantErr: "failed to convert type: int"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := extParseDataContract(tt.value)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_avroName(t *testing.T) {
+	assert.Equal(t, "previous_status", avroName("previous-status"))
+	assert.Equal(t, "_1st", avroName("1st"))
+	assert.Equal(t, "Order", avroName("Order"))
+}
@@ -0,0 +1,63 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+)
+
+// defaultDecimalType is the Go type of x-go-type: decimal when no decimal type is configured.
+const defaultDecimalType = "decimal.Decimal"
+
+// isDecimalSchema returns whether schema is generated as a decimal type:
+// x-go-type: decimal, or format decimal when a decimal type is configured.
+func isDecimalSchema(schema *base.Schema, decimalType string) bool {
+	if schema == nil || len(schema.Enum) > 0 {
+		return false
+	}
+	if goType, ok := extractExtensions(schema.Extensions)[extPropGoType]; ok {
+		return goType == "decimal"
+	}
+	return decimalType != "" && schema.Format == "decimal" &&
+		(slices.Contains(schema.Type, "string") || slices.Contains(schema.Type, "number"))
+}
+
+// decimalGoType returns the Go type of a decimal schema.
+// Decimals are encoded as JSON strings, or as numbers when the schema is a number or x-decimal-encoding is "number".
+func decimalGoType(schema *base.Schema, decimalType string) (string, error) {
+	if decimalType == "" {
+		decimalType = defaultDecimalType
+	}
+
+	encoding := "string"
+	if slices.Contains(schema.Type, "number") {
+		encoding = "number"
+	}
+	if extension, ok := extractExtensions(schema.Extensions)[extDecimalEncoding]; ok {
+		value, err := parseString(extension)
+		if err != nil {
+			return "", fmt.Errorf("invalid value for %q: %w", extDecimalEncoding, err)
+		}
+		encoding = value
+	}
+
+	switch encoding {
+	case "string":
+		return decimalType, nil
+	case "number":
+		return "runtime.DecimalNumber[" + decimalType + "]", nil
+	default:
+		return "", fmt.Errorf("invalid value for %q: %q, expected \"string\" or \"number\"", extDecimalEncoding, encoding)
+	}
+}
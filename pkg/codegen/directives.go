@@ -0,0 +1,99 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"fmt"
+	"go/build/constraint"
+	"path"
+	"strings"
+)
+
+// addFileDirectives adds the build constraint, the spec header and the //go:generate directive
+// of the output options to the generated Go files.
+// The //go:generate directive is added to a single file of the generated package,
+// so go generate runs the generator once.
+func addFileDirectives(files map[string]string, output *Output, info SpecInfo) error {
+	if output == nil || (output.BuildTags == "" && !output.SpecHeader && !output.GoGenerate) {
+		return nil
+	}
+
+	var buildLine string
+	if output.BuildTags != "" {
+		expr, err := constraint.Parse("//go:build " + output.BuildTags)
+		if err != nil {
+			return fmt.Errorf("invalid build-tags %q: %w", output.BuildTags, err)
+		}
+		buildLine = "//go:build " + expr.String() + "\n\n"
+	}
+
+	var generateFile string
+	if output.GoGenerate {
+		if output.GoGenerateCommand == "" {
+			return fmt.Errorf("go-generate requires the go-generate-command regenerating the code")
+		}
+		generateFile = goGenerateFile(files)
+	}
+
+	for _, name := range sortedMapKeys(files) {
+		if ext := path.Ext(name); ext != "" && ext != ".go" {
+			continue
+		}
+		src := files[name]
+
+		// the lines are added at the end of the header comment, before the package clause
+		var lines []string
+		if output.SpecHeader {
+			lines = append(lines, "// "+specHeader(info, generatorVersion()))
+		}
+		if name == generateFile {
+			lines = append(lines, "//go:generate "+output.GoGenerateCommand)
+		}
+		if len(lines) > 0 {
+			end := strings.Index(src, "\n\n")
+			if end < 0 || !strings.HasPrefix(src, "//") {
+				return fmt.Errorf("error adding directives to %s: no header comment", name)
+			}
+			src = src[:end+1] + strings.Join(lines, "\n") + src[end:]
+		}
+
+		files[name] = buildLine + src
+	}
+	return nil
+}
+
+// goGenerateFile returns the generated file of the package the //go:generate directive is added to.
+func goGenerateFile(files map[string]string) string {
+	for _, name := range []string{"all", TypesFile, "spec"} {
+		if _, ok := files[name]; ok {
+			return name
+		}
+	}
+	for _, name := range sortedMapKeys(files) {
+		if ext := path.Ext(name); ext == "" || ext == ".go" {
+			return name
+		}
+	}
+	return ""
+}
+
+// specHeader describes the generator and the spec the code is generated from,
+// e.g. "Generated by oapi-codegen-dd v3.1.0 from Pet Store 1.0.0 (sha256:9f86d0...).".
+func specHeader(info SpecInfo, generatorVersion string) string {
+	res := "Generated by " + generatorName + " " + generatorVersion
+	if spec := strings.Join(strings.Fields(info.Title+" "+info.Version), " "); spec != "" {
+		res += " from " + spec
+	}
+	if info.Checksum != "" {
+		res += " (" + info.Checksum + ")"
+	}
+	return res + "."
+}
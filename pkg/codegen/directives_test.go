@@ -0,0 +1,94 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileDirectives(t *testing.T) {
+	spec := []byte(readTestdata(t, "train-travel-api.yml"))
+	cfg := Configuration{
+		PackageName: "api",
+		Generate:    &GenerateOptions{Client: true},
+		Output: &Output{
+			UseSingleFile:     true,
+			BuildTags:         "!codeanalysis",
+			SpecHeader:        true,
+			GoGenerate:        true,
+			GoGenerateCommand: "go run github.com/doordash-oss/oapi-codegen-dd/v3/cmd/oapi-codegen -config cfg.yaml ../api.yml",
+		},
+	}
+
+	t.Run("single file", func(t *testing.T) {
+		codes, err := Generate(spec, cfg)
+		require.NoError(t, err)
+
+		code := codes.GetCombined()
+		lines := strings.SplitN(code, "\n", 7)
+		assert.Equal(t, []string{
+			"//go:build !codeanalysis",
+			"",
+			"// Code generated by oapi-codegen. DO NOT EDIT.",
+			"// Generated by oapi-codegen-dd devel from Train Travel API 1.2.1 (" + specChecksum(spec) + ").",
+			"//go:generate " + cfg.Output.GoGenerateCommand,
+			"",
+		}, lines[:6])
+
+		f, err := parser.ParseFile(token.NewFileSet(), "gen.go", code, parser.ParseComments)
+		require.NoError(t, err)
+		assert.Equal(t, "api", f.Name.Name)
+	})
+
+	t.Run("multiple files", func(t *testing.T) {
+		cfg := cfg
+		cfg.Output = &Output{BuildTags: "linux && !codeanalysis", GoGenerate: true, GoGenerateCommand: "oapi-codegen ../api.yml"}
+
+		codes, err := Generate(spec, cfg)
+		require.NoError(t, err)
+
+		generates := 0
+		for name, code := range codes {
+			assert.True(t, strings.HasPrefix(code, "//go:build linux && !codeanalysis\n\n"), name)
+			generates += strings.Count(code, "//go:generate ")
+		}
+		assert.Equal(t, 1, generates)
+		assert.Contains(t, codes["client"], "// Code generated by oapi-codegen. DO NOT EDIT.\n//go:generate oapi-codegen ../api.yml\n\npackage api")
+	})
+
+	t.Run("invalid build tags", func(t *testing.T) {
+		cfg := cfg
+		cfg.Output = &Output{UseSingleFile: true, BuildTags: "linux &&"}
+
+		_, err := Generate(spec, cfg)
+		assert.ErrorContains(t, err, `invalid output.build-tags "linux &&"`)
+	})
+
+	t.Run("go generate without command", func(t *testing.T) {
+		cfg := cfg
+		cfg.Output = &Output{UseSingleFile: true, GoGenerate: true}
+
+		_, err := Generate(spec, cfg)
+		assert.ErrorContains(t, err, "output.go-generate requires output.go-generate-command")
+	})
+}
+
+func TestSpecHeader(t *testing.T) {
+	assert.Equal(t, "Generated by oapi-codegen-dd v3.1.0 from Pet Store 1.0.0 (sha256:abc).",
+		specHeader(SpecInfo{Title: "Pet Store", Version: "1.0.0", Checksum: "sha256:abc"}, "v3.1.0"))
+	assert.Equal(t, "Generated by oapi-codegen-dd devel.", specHeader(SpecInfo{}, "devel"))
+}
@@ -10,7 +10,10 @@
 
 package codegen
 
-import "errors"
+import (
+	"errors"
+	"strings"
+)
 
 var (
 	ErrOperationNameEmpty                        = errors.New("operation name cannot be an empty string")
@@ -31,4 +34,41 @@ var (
 	ErrDiscriminatorNotAllMapped                 = errors.New("discriminator: not all schemas were mapped")
 	ErrEmptySchema                               = errors.New("empty schema")
 	ErrEmptyReferencePath                        = errors.New("empty reference path")
+	ErrRemoveAfterPassed                         = errors.New("x-remove-after date has passed, remove the property from the spec")
+	ErrRouteConflict                             = errors.New("conflicting routes")
+	ErrInvalidRoute                              = errors.New("invalid route")
 )
+
+// SpecError is a failure to generate code for a part of the spec, located by a JSON pointer such as
+// #/components/schemas/Pet or #/paths/~1pets/get.
+// Generation carries on past failed schemas and operations, so all of them are reported in one run,
+// joined with errors.Join.
+type SpecError struct {
+	Path string
+	Err  error
+}
+
+func (e *SpecError) Error() string {
+	return e.Path + ": " + e.Err.Error()
+}
+
+func (e *SpecError) Unwrap() error {
+	return e.Err
+}
+
+// newSpecError creates a SpecError for the JSON pointer made of the given reference tokens.
+func newSpecError(err error, tokens ...string) *SpecError {
+	escaped := make([]string, len(tokens))
+	for i, token := range tokens {
+		escaped[i] = escapeJSONPointer(token)
+	}
+	return &SpecError{Path: "#/" + strings.Join(escaped, "/"), Err: err}
+}
+
+// jsonPointerEscaper escapes the reference tokens of JSON pointers, see RFC 6901.
+var jsonPointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// escapeJSONPointer escapes a reference token of a JSON pointer.
+func escapeJSONPointer(token string) string {
+	return jsonPointerEscaper.Replace(token)
+}
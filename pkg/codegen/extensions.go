@@ -13,6 +13,7 @@ package codegen
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
 	"github.com/pb33f/libopenapi/orderedmap"
@@ -40,18 +41,90 @@ const (
 	extPropOmitEmpty    = "x-omitempty"
 	extPropExtraTags    = "x-oapi-codegen-extra-tags"
 
+	// extPropOmitZero declares an optional property as a value with the omitzero JSON tag instead of a pointer,
+	// or keeps the pointer with false when output.prefer-omitzero is set.
+	extPropOmitZero = "x-omitzero"
+
+	// extPropValidateSkipOnInput makes a required property, e.g. a server-generated ID,
+	// optional when validating, like readOnly does, so request bodies reusing the schema can omit it.
+	extPropValidateSkipOnInput = "x-validate-skip-on-input"
+
 	// Override generated variable names for enum constants.
 	extEnumNames         = "x-enum-names"
+	extEnumVarNames      = "x-enum-varnames"
 	extDeprecationReason = "x-deprecated-reason"
 
+	// extEnumDescriptions documents enum constants, one description per value.
+	extEnumDescriptions = "x-enum-descriptions"
+
+	// extCaptureUnknown keeps the unknown fields of an object in its AdditionalProperties,
+	// even when additionalProperties is not set.
+	extCaptureUnknown = "x-capture-unknown"
+
+	// extDecimalEncoding encodes a decimal as a JSON "string" or "number",
+	// defaulting to the type of the schema.
+	extDecimalEncoding = "x-decimal-encoding"
+
+	// extGoTimeFormat formats the date-time values of a query parameter: unix, unixmilli, rfc3339
+	// or a time.Format layout.
+	extGoTimeFormat = "x-go-time-format"
+
+	// extRemoveAfter deprecates a property, to be removed after the given date, e.g. 2025-12-01.
+	extRemoveAfter = "x-remove-after"
+
 	// extOapiCodegenOnlyHonourGoName explicitly enforces the generation of a
 	// field as the `x-go-name` extension describes it.
 	extOapiCodegenOnlyHonourGoName = "x-oapi-codegen-only-honour-go-name"
 
 	// extSensitiveData marks a field as containing sensitive data that should be masked
 	extSensitiveData = "x-sensitive-data"
+
+	// extStream marks a response as streamed, so the client returns the unread body.
+	extStream = "x-stream"
+
+	// extIdempotencyKey makes the client send a generated idempotency key with an operation.
+	// The value is a boolean or the name of the header to use instead of Idempotency-Key.
+	extIdempotencyKey = "x-idempotency-key"
+
+	// extTimeout sets the timeout of an operation in the route manifest, e.g. "5s".
+	extTimeout = "x-timeout"
+
+	// extDedupe generates a canonical request hash for an operation, to suppress duplicate submissions.
+	extDedupe = "x-dedupe"
+
+	// extFeatureFlag names the feature flag gating an operation.
+	extFeatureFlag = "x-feature-flag"
+
+	// extLogSampleRate sets the fraction of the requests of an operation that are logged, e.g. 0.01.
+	extLogSampleRate = "x-log-sample-rate"
+
+	// extGoOperationName overrides the Go name of an operation, derived from its operationId,
+	// or from its method and path without one.
+	extGoOperationName = "x-go-operation-name"
+
+	// extPagination describes how an operation is paginated, generating its <Op>Pages client method:
+	// an object with the style, param, next and items of a Pagination, true to use the detected one,
+	// or false to generate no pager.
+	extPagination = "x-pagination"
+
+	// extJSONRPC names the JSON-RPC 2.0 method called by a POST operation, its request body being the params
+	// and its success response the result.
+	extJSONRPC = "x-jsonrpc"
+
+	// extCustomMethod sets the HTTP method of an operation, e.g. QUERY, for specs whose version can't declare it.
+	extCustomMethod = "x-custom-method"
+
+	// extGoOmitValidation leaves a schema, or the types of an operation, out of Validate() generation.
+	extGoOmitValidation = "x-go-omit-validation"
+
+	// extDataContract generates data contract schemas for a component schema.
+	// The value is true for all formats, or a format or list of formats: json-schema, avro.
+	extDataContract = "x-data-contract"
 )
 
+// defaultIdempotencyKeyHeader is the header sent for x-idempotency-key: true.
+const defaultIdempotencyKeyHeader = "Idempotency-Key"
+
 func extExtraTags(extPropValue any) (map[string]string, error) {
 	tagsI, ok := extPropValue.(map[string]any)
 	if !ok {
@@ -179,6 +252,100 @@ func parseBooleanValue(value any) (bool, error) {
 	return false, fmt.Errorf("failed to convert type: %T", value)
 }
 
+// extParseIdempotencyKey parses the x-idempotency-key extension value into the header name to send,
+// which is empty if the operation opts out.
+func extParseIdempotencyKey(extPropValue any) (string, error) {
+	if enabled, err := parseBooleanValue(extPropValue); err == nil {
+		if enabled {
+			return defaultIdempotencyKeyHeader, nil
+		}
+		return "", nil
+	}
+
+	header, err := parseString(extPropValue)
+	if err != nil {
+		return "", err
+	}
+	if header == "" {
+		return "", fmt.Errorf("header name must not be empty")
+	}
+	return header, nil
+}
+
+// extParseTimeout parses the x-timeout extension value as a positive Go duration.
+func extParseTimeout(extPropValue any) (time.Duration, error) {
+	str, err := parseString(extPropValue)
+	if err != nil {
+		return 0, err
+	}
+	timeout, err := time.ParseDuration(str)
+	if err != nil {
+		return 0, err
+	}
+	if timeout <= 0 {
+		return 0, fmt.Errorf("timeout must be positive")
+	}
+	return timeout, nil
+}
+
+// extParseLogSampleRate parses the x-log-sample-rate extension value as a rate greater than 0 and at most 1.
+func extParseLogSampleRate(extPropValue any) (float64, error) {
+	str, err := parseString(extPropValue)
+	if err != nil {
+		return 0, err
+	}
+	rate, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		return 0, err
+	}
+	if rate <= 0 || rate > 1 {
+		return 0, fmt.Errorf("sample rate must be greater than 0 and at most 1")
+	}
+	return rate, nil
+}
+
+// extParseRemoveAfter parses the x-remove-after extension value as a date, e.g. 2025-12-01.
+func extParseRemoveAfter(extPropValue any) (time.Time, error) {
+	if t, ok := extPropValue.(time.Time); ok {
+		return t, nil
+	}
+	str, err := parseString(extPropValue)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Parse(time.DateOnly, str)
+}
+
+// extParseDataContract parses the x-data-contract extension value into the formats to generate.
+func extParseDataContract(extPropValue any) ([]DataContractFormat, error) {
+	if enabled, err := parseBooleanValue(extPropValue); err == nil {
+		if enabled {
+			return []DataContractFormat{DataContractJSONSchema, DataContractAvro}, nil
+		}
+		return nil, nil
+	}
+
+	var values []any
+	switch v := extPropValue.(type) {
+	case string:
+		values = []any{v}
+	case []any:
+		values = v
+	default:
+		return nil, fmt.Errorf("failed to convert type: %T", extPropValue)
+	}
+
+	formats := make([]DataContractFormat, 0, len(values))
+	for _, value := range values {
+		format := DataContractFormat(fmt.Sprint(value))
+		if format != DataContractJSONSchema && format != DataContractAvro {
+			return nil, fmt.Errorf("unknown format %q, expected %s or %s", format, DataContractJSONSchema, DataContractAvro)
+		}
+		formats = append(formats, format)
+	}
+	return formats, nil
+}
+
 // extParseSensitiveData parses the x-sensitive-data extension value into runtime.SensitiveDataConfig
 func extParseSensitiveData(extPropValue any) (*runtime.SensitiveDataConfig, error) {
 	config := runtime.NewDefaultSensitiveDataConfig()
@@ -0,0 +1,276 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"cmp"
+	"go/token"
+	"go/types"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// typeLayout is the size and alignment of a Go type.
+type typeLayout struct {
+	size  int64
+	align int64
+}
+
+// layoutArch is the architecture the layouts of the generated types are computed for.
+const layoutArch = "amd64"
+
+var (
+	anyType  = types.Universe.Lookup("any").Type()
+	wordType = types.Typ[types.UnsafePointer]
+	timeType = structOf(types.Typ[types.Uint64], types.Typ[types.Int64], wordType)
+
+	// knownTypes are the builtin and library types used by the generated code, by their declaration,
+	// with the same layout as their definitions.
+	knownTypes = map[string]types.Type{
+		"byte":             types.Universe.Lookup("byte").Type(),
+		"rune":             types.Universe.Lookup("rune").Type(),
+		"interface{}":      anyType,
+		"time.Time":        timeType,
+		"time.Duration":    types.Typ[types.Int64],
+		"uuid.UUID":        types.NewArray(types.Typ[types.Byte], 16),
+		"json.RawMessage":  types.NewSlice(types.Typ[types.Byte]),
+		"json.Number":      types.Typ[types.String],
+		"runtime.Email":    types.Typ[types.String],
+		"runtime.Date":     structOf(timeType),
+		"runtime.Duration": structOf(types.Typ[types.Int64]),
+		"runtime.File":     structOf(wordType, types.NewSlice(types.Typ[types.Byte]), types.Typ[types.String]),
+		"decimal.Decimal":  structOf(wordType, types.Typ[types.Int32]),
+	}
+)
+
+// FieldAlignment reports the size of a struct before and after its fields were reordered.
+type FieldAlignment struct {
+	TypeName string
+	Before   int64
+	After    int64
+}
+
+// Saved returns the number of bytes saved per value.
+func (f FieldAlignment) Saved() int64 {
+	return f.Before - f.After
+}
+
+// alignStructFields reorders the fields of the struct types by decreasing alignment, keeping the spec order otherwise,
+// when it makes the struct smaller. JSON names and tags are unchanged, and so is the order of the properties
+// used by the generated methods. Only the Go field order, and so the key order of encoding/json, changes.
+func alignStructFields(typeDefs []TypeDefinition, enums []EnumDefinition, options ParseOptions) []FieldAlignment {
+	l := newLayoutResolver(len(typeDefs) + len(enums))
+	for i := range enums {
+		l.schemas[enums[i].Name] = &enums[i].Schema
+	}
+	for i := range typeDefs {
+		l.schemas[typeDefs[i].Name] = &typeDefs[i].Schema
+	}
+
+	var res []FieldAlignment
+	for i := range typeDefs {
+		schema := &typeDefs[i].Schema
+		props := deduplicateProperties(schema.Properties)
+		fieldOptions := options.WithSpecLocation(typeDefs[i].SpecLocation)
+		if len(props) < 2 || schema.GoType != schema.createGoStruct(genFieldsFromProperties(props, fieldOptions)) {
+			// not a struct generated from its properties only, e.g. merged allOf/anyOf schemas
+			continue
+		}
+
+		aligned := slices.Clone(props)
+		slices.SortStableFunc(aligned, func(a, b Property) int {
+			return cmp.Compare(l.sizes.Alignof(l.propertyType(b)), l.sizes.Alignof(l.propertyType(a)))
+		})
+
+		before := l.sizes.Sizeof(l.structType(schema, props))
+		after := l.sizes.Sizeof(l.structType(schema, aligned))
+		if after >= before {
+			continue
+		}
+
+		schema.GoType = schema.createGoStruct(genFieldsFromProperties(aligned, fieldOptions))
+		res = append(res, FieldAlignment{TypeName: typeDefs[i].Name, Before: before, After: after})
+	}
+	return res
+}
+
+// layoutResolver builds the go/types types of generated types, to compute their layouts with the sizes of the gc compiler.
+// Types it doesn't know are assumed to be a word.
+type layoutResolver struct {
+	sizes    types.Sizes
+	schemas  map[string]*GoSchema
+	resolved map[string]types.Type
+	visiting map[string]bool
+}
+
+func newLayoutResolver(n int) *layoutResolver {
+	return &layoutResolver{
+		sizes:    types.SizesFor("gc", layoutArch),
+		schemas:  make(map[string]*GoSchema, n),
+		resolved: make(map[string]types.Type),
+		visiting: make(map[string]bool),
+	}
+}
+
+// layout returns the layout of the type declared as decl.
+func (l *layoutResolver) layout(decl string) typeLayout {
+	t := l.typeOf(decl)
+	return typeLayout{size: l.sizes.Sizeof(t), align: l.sizes.Alignof(t)}
+}
+
+func (l *layoutResolver) propertyType(p Property) types.Type {
+	if p.IsPointerType() {
+		return wordType
+	}
+	return l.typeOf(p.Schema.TypeDecl())
+}
+
+// structType returns the struct of schema with the given properties.
+func (l *layoutResolver) structType(schema *GoSchema, props []Property) types.Type {
+	fields := make([]types.Type, 0, len(props)+2)
+	for _, p := range props {
+		fields = append(fields, l.propertyType(p))
+	}
+	if schema.HasAdditionalProperties {
+		fields = append(fields, wordType)
+	}
+	if variants := schema.UnionVariants(); variants != nil {
+		names := make([]string, len(variants))
+		for i, v := range variants {
+			names[i] = v.TypeName
+		}
+		fields = append(fields, l.unionType(names...))
+	} else if len(schema.UnionElements) > 0 {
+		if schema.AnyOfVariants {
+			for range schema.UnionElements {
+				fields = append(fields, wordType)
+			}
+		}
+		fields = append(fields, knownTypes["json.RawMessage"])
+	}
+	return structOf(fields...)
+}
+
+func (l *layoutResolver) typeOf(decl string) types.Type {
+	decl = strings.TrimSpace(decl)
+	if t, ok := knownTypes[decl]; ok {
+		return t
+	}
+	if obj, ok := types.Universe.Lookup(decl).(*types.TypeName); ok {
+		return obj.Type()
+	}
+
+	switch {
+	case strings.HasPrefix(decl, "*"), strings.HasPrefix(decl, "map["),
+		strings.HasPrefix(decl, "func"), strings.HasPrefix(decl, "chan "):
+		return wordType
+	case strings.HasPrefix(decl, "[]"):
+		return types.NewSlice(wordType)
+	case strings.HasPrefix(decl, "["):
+		n, elem, ok := strings.Cut(decl[1:], "]")
+		count, err := strconv.ParseInt(n, 10, 64)
+		if !ok || err != nil {
+			return wordType
+		}
+		return types.NewArray(l.typeOf(elem), count)
+	}
+	if name, args, ok := genericType(decl); ok {
+		return l.instanceType(name, args)
+	}
+
+	if t, ok := l.resolved[decl]; ok {
+		return t
+	}
+	schema, ok := l.schemas[decl]
+	if !ok || l.visiting[decl] {
+		return wordType
+	}
+
+	l.visiting[decl] = true
+	var t types.Type
+	if strings.HasPrefix(schema.GoType, "struct {") {
+		t = l.structType(schema, deduplicateProperties(schema.Properties))
+	} else {
+		t = l.typeOf(schema.GoType)
+	}
+	delete(l.visiting, decl)
+
+	l.resolved[decl] = t
+	return t
+}
+
+// instanceType returns the struct of the instance of a generic runtime type, a word for unknown ones.
+func (l *layoutResolver) instanceType(name string, args []string) types.Type {
+	switch {
+	case name == "runtime.Nullable" && len(args) == 1:
+		return structOf(l.typeOf(args[0]), types.Typ[types.Bool], types.Typ[types.Bool])
+	case name == "runtime.Sensitive" && len(args) == 1:
+		return structOf(l.typeOf(args[0]), anyType, types.Typ[types.Bool])
+	case name == "runtime.DecimalNumber" && len(args) == 1:
+		return structOf(l.typeOf(args[0]))
+	case name == "runtime.Either" && len(args) == 2,
+		name == "runtime.OneOf3" && len(args) == 3,
+		name == "runtime.OneOf4" && len(args) == 4:
+		return l.unionType(args...)
+	}
+	return wordType
+}
+
+// unionType returns the struct of runtime.Either, runtime.OneOf3 or runtime.OneOf4 of the types.
+func (l *layoutResolver) unionType(decls ...string) types.Type {
+	fields := make([]types.Type, 0, len(decls)+1)
+	for _, decl := range decls {
+		fields = append(fields, l.typeOf(decl))
+	}
+	return structOf(append(fields, types.Typ[types.Int])...)
+}
+
+// structOf returns a struct with fields of the given types, in order.
+func structOf(fieldTypes ...types.Type) *types.Struct {
+	fields := make([]*types.Var, len(fieldTypes))
+	for i, t := range fieldTypes {
+		fields[i] = types.NewField(token.NoPos, nil, "f"+strconv.Itoa(i), t, false)
+	}
+	return types.NewStruct(fields, nil)
+}
+
+// genericType splits the declaration of an instantiated generic type into its name and type arguments.
+func genericType(decl string) (string, []string, bool) {
+	name, rest, ok := strings.Cut(decl, "[")
+	if !ok || name == "" || !strings.HasSuffix(rest, "]") {
+		return "", nil, false
+	}
+	return name, splitTypeArgs(strings.TrimSuffix(rest, "]")), true
+}
+
+// splitTypeArgs splits a list of type arguments at its top-level commas.
+func splitTypeArgs(s string) []string {
+	var (
+		res   []string
+		depth int
+		start int
+	)
+	for i, r := range s {
+		switch r {
+		case '[', '(', '{':
+			depth++
+		case ']', ')', '}':
+			depth--
+		case ',':
+			if depth == 0 {
+				res = append(res, strings.TrimSpace(s[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	return append(res, strings.TrimSpace(s[start:]))
+}
@@ -0,0 +1,133 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlignFields(t *testing.T) {
+	spec := []byte(readTestdata(t, "field-alignment.yml"))
+
+	t.Run("disabled", func(t *testing.T) {
+		codes, err := Generate(spec, Configuration{PackageName: "api"})
+		require.NoError(t, err)
+		assert.Contains(t, codes.GetCombined(), "type Order struct {\n\tPaid     bool    `json:\"paid\"`\n\tID       string")
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		cfg := Configuration{
+			PackageName: "api",
+			Generate:    &GenerateOptions{AlignFields: true},
+		}
+		ctx, errs := CreateParseContext(spec, cfg)
+		require.Empty(t, errs)
+		assert.Equal(t, []FieldAlignment{{TypeName: "Order", Before: 64, After: 56}}, ctx.FieldAlignments)
+
+		codes, err := Generate(spec, cfg)
+		require.NoError(t, err)
+
+		code := codes.GetCombined()
+		assert.Contains(t, code, `type Order struct {
+	ID       string  `+"`json:\"id\" validate:\"required\"`"+`
+	Status   Status  `+"`json:\"status\" validate:\"required\"`"+`
+	Gift     *bool   `+"`json:\"gift,omitempty\"`"+`
+	Total    float32 `+"`json:\"total\" validate:\"required\"`"+`
+	Quantity int32   `+"`json:\"quantity\" validate:\"required\"`"+`
+	Paid     bool    `+"`json:\"paid\"`"+`
+	Express  bool    `+"`json:\"express\"`"+`
+}`)
+		assert.Contains(t, code, "type Point struct {\n\tX *float32 `json:\"x,omitempty\"`\n\tY *float32 `json:\"y,omitempty\"`\n}")
+	})
+}
+
+func TestAlignStructFields(t *testing.T) {
+	typeDefs := []TypeDefinition{
+		{
+			Name: "Small",
+			Schema: GoSchema{
+				Properties: []Property{
+					{GoName: "A", JsonFieldName: "a", Schema: GoSchema{GoType: "bool"}},
+					{GoName: "B", JsonFieldName: "b", Schema: GoSchema{GoType: "int64"}},
+					{GoName: "C", JsonFieldName: "c", Schema: GoSchema{GoType: "bool"}},
+				},
+			},
+		},
+		{
+			Name: "Outer",
+			Schema: GoSchema{
+				Properties: []Property{
+					{GoName: "Flag", JsonFieldName: "flag", Schema: GoSchema{GoType: "bool"}},
+					{GoName: "Inner", JsonFieldName: "inner", Schema: GoSchema{RefType: "Small"}},
+					{GoName: "Code", JsonFieldName: "code", Schema: GoSchema{GoType: "Code"}},
+				},
+			},
+		},
+	}
+	for i := range typeDefs {
+		s := &typeDefs[i].Schema
+		s.GoType = s.createGoStruct(genFieldsFromProperties(s.Properties, ParseOptions{}))
+	}
+	enums := []EnumDefinition{{Name: "Code", Schema: GoSchema{GoType: "int16"}}}
+
+	res := alignStructFields(typeDefs, enums, ParseOptions{})
+	assert.Equal(t, []FieldAlignment{
+		{TypeName: "Small", Before: 24, After: 16},
+		{TypeName: "Outer", Before: 40, After: 32},
+	}, res)
+	assert.Equal(t, int64(8), res[0].Saved())
+	assert.Equal(t, "struct {\n    B int64`json:\"b\"`\n    A bool`json:\"a\"`\n    C bool`json:\"c\"`\n}", typeDefs[0].Schema.GoType)
+
+	// the properties are kept in spec order
+	assert.Equal(t, "A", typeDefs[0].Schema.Properties[0].GoName)
+}
+
+func TestTypeLayout(t *testing.T) {
+	l := newLayoutResolver(1)
+	l.schemas["Self"] = &GoSchema{GoType: "struct {}", Properties: []Property{{Schema: GoSchema{GoType: "Self"}}}}
+
+	tests := []struct {
+		decl string
+		want typeLayout
+	}{
+		{"bool", typeLayout{1, 1}},
+		{"uuid.UUID", typeLayout{16, 1}},
+		{"*Foo", typeLayout{8, 8}},
+		{"map[string]any", typeLayout{8, 8}},
+		{"[]int32", typeLayout{24, 8}},
+		{"[3]int16", typeLayout{6, 2}},
+		{"[n]int16", typeLayout{8, 8}},
+		{"runtime.Either[bool, string]", typeLayout{32, 8}},
+		{"runtime.Either[map[string]int]", typeLayout{8, 8}},
+		{"runtime.OneOf3[bool, string, int32]", typeLayout{40, 8}},
+		{"runtime.OneOf4[bool, bool, bool, bool]", typeLayout{16, 8}},
+		{"runtime.OneOf4[bool, bool]", typeLayout{8, 8}},
+		{"runtime.Nullable[bool]", typeLayout{3, 1}},
+		{"runtime.Nullable[int64]", typeLayout{16, 8}},
+		{"runtime.Sensitive[string]", typeLayout{40, 8}},
+		{"runtime.DecimalNumber[decimal.Decimal]", typeLayout{16, 8}},
+		{"time.Time", typeLayout{24, 8}},
+		{"Unknown", typeLayout{8, 8}},
+		{"Self", typeLayout{8, 8}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.decl, func(t *testing.T) {
+			assert.Equal(t, tt.want, l.layout(tt.decl))
+		})
+	}
+}
+
+func TestSplitTypeArgs(t *testing.T) {
+	assert.Equal(t, []string{"map[string]int", "runtime.Either[A, B]"}, splitTypeArgs("map[string]int, runtime.Either[A, B]"))
+}
@@ -12,6 +12,8 @@ package codegen
 
 import (
 	"fmt"
+	"path"
+	"regexp"
 	"slices"
 	"strings"
 
@@ -22,6 +24,10 @@ import (
 )
 
 func filterOutDocument(doc libopenapi.Document, cfg FilterConfig) (*v3high.Document, bool, error) {
+	if err := validateFilter(cfg); err != nil {
+		return nil, false, err
+	}
+
 	model, err := doc.BuildV3Model()
 	if err != nil {
 		return nil, false, fmt.Errorf("error building model: %w", err)
@@ -35,6 +41,18 @@ func filterOutDocument(doc libopenapi.Document, cfg FilterConfig) (*v3high.Docum
 	return &model.Model, filtered, nil
 }
 
+// validateFilter checks the regular expressions of the path filters compile.
+func validateFilter(cfg FilterConfig) error {
+	for _, pattern := range slices.Concat(cfg.Include.Paths, cfg.Exclude.Paths) {
+		if strings.HasPrefix(pattern, "^") {
+			if _, err := regexp.Compile(pattern); err != nil {
+				return fmt.Errorf("invalid path filter %q: %w", pattern, err)
+			}
+		}
+	}
+	return nil
+}
+
 func filterOperations(model *v3high.Document, cfg FilterConfig) bool {
 	if cfg.IsEmpty() {
 		return false
@@ -51,21 +69,34 @@ func filterOperations(model *v3high.Document, cfg FilterConfig) bool {
 	}
 
 	for path, pathItem := range paths {
-		if len(cfg.Include.Paths) > 0 && !slices.Contains(cfg.Include.Paths, path) {
+		if len(cfg.Include.Paths) > 0 && !matchesAnyPath(cfg.Include.Paths, path) {
 			model.Paths.PathItems.Delete(path)
 			removed = true
 			continue
 		}
 
-		if len(cfg.Exclude.Paths) > 0 && slices.Contains(cfg.Exclude.Paths, path) {
+		if len(cfg.Exclude.Paths) > 0 && matchesAnyPath(cfg.Exclude.Paths, path) {
 			model.Paths.PathItems.Delete(path)
 			removed = true
 			continue
 		}
 
+		removedOps := false
 		for method, op := range pathItem.GetOperations().FromOldest() {
 			remove := false
 
+			// Methods, matching the method sent for x-custom-method
+			httpMethod, err := operationHTTPMethod(method, extractExtensions(op.Extensions))
+			if err != nil {
+				httpMethod = method
+			}
+			if len(cfg.Exclude.Methods) > 0 && containsFold(cfg.Exclude.Methods, httpMethod) {
+				remove = true
+			}
+			if len(cfg.Include.Methods) > 0 && !containsFold(cfg.Include.Methods, httpMethod) {
+				remove = true
+			}
+
 			// Tags
 			for _, tag := range op.Tags {
 				if slices.Contains(cfg.Exclude.Tags, tag) {
@@ -96,8 +127,17 @@ func filterOperations(model *v3high.Document, cfg FilterConfig) bool {
 				remove = true
 			}
 
+			// Operation extensions
+			if hasAnyExtension(op.Extensions, cfg.Exclude.OperationExtensions) {
+				remove = true
+			}
+			if len(cfg.Include.OperationExtensions) > 0 && !hasAnyExtension(op.Extensions, cfg.Include.OperationExtensions) {
+				remove = true
+			}
+
 			if remove {
 				removed = true
+				removedOps = true
 				switch strings.ToLower(method) {
 				case "get":
 					pathItem.Get = nil
@@ -115,9 +155,18 @@ func filterOperations(model *v3high.Document, cfg FilterConfig) bool {
 					pathItem.Options = nil
 				case "trace":
 					pathItem.Trace = nil
+				case "query":
+					pathItem.Query = nil
+				default:
+					pathItem.AdditionalOperations.Delete(method)
 				}
 			}
 		}
+
+		// drop the paths left without operations
+		if removedOps && pathItem.GetOperations().Len() == 0 {
+			model.Paths.PathItems.Delete(path)
+		}
 	}
 
 	return removed
@@ -204,3 +253,63 @@ func sliceToBoolMap(slice []string) map[string]bool {
 	}
 	return m
 }
+
+// hasAnyExtension returns whether any of the extensions is set to a value other than false.
+func hasAnyExtension(extensions *orderedmap.Map[string, *yaml.Node], names []string) bool {
+	if extensions == nil {
+		return false
+	}
+	for _, name := range names {
+		if node, ok := extensions.Get(name); ok && !(node.Kind == yaml.ScalarNode && node.Value == "false") {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesAnyPath returns whether the path matches any of the path filters.
+func matchesAnyPath(patterns []string, path string) bool {
+	return slices.ContainsFunc(patterns, func(pattern string) bool {
+		return matchPath(pattern, path)
+	})
+}
+
+// matchPath matches a path to an exact path, a regular expression starting with ^,
+// or a glob pattern where * matches a path segment and ** any number of segments.
+func matchPath(pattern, urlPath string) bool {
+	if strings.HasPrefix(pattern, "^") {
+		matched, _ := regexp.MatchString(pattern, urlPath)
+		return matched
+	}
+	if !strings.Contains(pattern, "*") {
+		return pattern == urlPath
+	}
+	return matchSegments(strings.Split(strings.Trim(pattern, "/"), "/"), strings.Split(strings.Trim(urlPath, "/"), "/"))
+}
+
+func matchSegments(patterns, segments []string) bool {
+	if len(patterns) == 0 {
+		return len(segments) == 0
+	}
+	if patterns[0] == "**" {
+		for i := range len(segments) + 1 {
+			if matchSegments(patterns[1:], segments[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(segments) == 0 {
+		return false
+	}
+	if matched, err := path.Match(patterns[0], segments[0]); err != nil || !matched {
+		return false
+	}
+	return matchSegments(patterns[1:], segments[1:])
+}
+
+func containsFold(values []string, value string) bool {
+	return slices.ContainsFunc(values, func(v string) bool {
+		return strings.EqualFold(v, value)
+	})
+}
@@ -344,3 +344,185 @@ func TestFilterOperationsByPath(t *testing.T) {
 		assert.Contains(t, combined, `"/enum"`)
 	})
 }
+
+func TestFilterOperationsByExtension(t *testing.T) {
+	spec := []byte(readTestdata(t, "filter-operation-extensions.yml"))
+
+	tests := []struct {
+		name     string
+		filter   FilterConfig
+		included []string
+		excluded []string
+	}{
+		{
+			name:     "exclude",
+			filter:   FilterConfig{Exclude: FilterParamsConfig{OperationExtensions: []string{"x-internal"}}},
+			included: []string{"ListPets", "CreatePet"},
+			excluded: []string{"PurgePets", `"/admin/pets"`},
+		},
+		{
+			name:     "include",
+			filter:   FilterConfig{Include: FilterParamsConfig{OperationExtensions: []string{"x-beta", "x-internal"}}},
+			included: []string{"CreatePet", "PurgePets"},
+			excluded: []string{"ListPets"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Configuration{
+				PackageName: "api",
+				Filter:      tt.filter,
+				Generate:    &GenerateOptions{Client: true},
+			}
+			code, err := Generate(spec, cfg)
+			require.NoError(t, err)
+
+			combined := code.GetCombined()
+			for _, s := range tt.included {
+				assert.Contains(t, combined, s)
+			}
+			for _, s := range tt.excluded {
+				assert.NotContains(t, combined, s)
+			}
+		})
+	}
+}
+
+func TestFilterOperationsByMethodAndPathPattern(t *testing.T) {
+	spec := []byte(readTestdata(t, "filter-operation-extensions.yml"))
+
+	tests := []struct {
+		name     string
+		filter   FilterConfig
+		included []string
+		excluded []string
+	}{
+		{
+			name:     "include methods",
+			filter:   FilterConfig{Include: FilterParamsConfig{Methods: []string{"get"}}},
+			included: []string{"ListPets"},
+			excluded: []string{"CreatePet", "PurgePets"},
+		},
+		{
+			name:     "exclude methods",
+			filter:   FilterConfig{Exclude: FilterParamsConfig{Methods: []string{"POST", "delete"}}},
+			included: []string{"ListPets"},
+			excluded: []string{"CreatePet", "PurgePets"},
+		},
+		{
+			name:     "exclude glob",
+			filter:   FilterConfig{Exclude: FilterParamsConfig{Paths: []string{"/admin/**"}}},
+			included: []string{"ListPets", "CreatePet"},
+			excluded: []string{"PurgePets"},
+		},
+		{
+			name:     "include regular expression",
+			filter:   FilterConfig{Include: FilterParamsConfig{Paths: []string{"^/admin/"}}},
+			included: []string{"PurgePets"},
+			excluded: []string{"ListPets", "CreatePet"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Configuration{
+				PackageName: "api",
+				Filter:      tt.filter,
+				Generate:    &GenerateOptions{Client: true},
+			}
+			code, err := Generate(spec, cfg)
+			require.NoError(t, err)
+
+			combined := code.GetCombined()
+			for _, s := range tt.included {
+				assert.Contains(t, combined, s)
+			}
+			for _, s := range tt.excluded {
+				assert.NotContains(t, combined, s)
+			}
+		})
+	}
+
+	t.Run("invalid regular expression", func(t *testing.T) {
+		cfg := Configuration{
+			PackageName: "api",
+			Filter:      FilterConfig{Exclude: FilterParamsConfig{Paths: []string{"^/admin/("}}},
+		}
+		_, err := Generate(spec, cfg)
+		assert.ErrorContains(t, err, `invalid path filter "^/admin/("`)
+	})
+}
+
+func TestFilterOperationsByCustomMethod(t *testing.T) {
+	spec := []byte(readTestdata(t, "custom-methods.yml"))
+
+	tests := []struct {
+		name     string
+		filter   FilterConfig
+		included []string
+		excluded []string
+	}{
+		{
+			name:     "include get",
+			filter:   FilterConfig{Include: FilterParamsConfig{Methods: []string{"get"}}},
+			included: []string{"ListPets"},
+			excluded: []string{"SearchPets", "PurgePets", "SearchPetsLegacy"},
+		},
+		{
+			name:     "exclude query",
+			filter:   FilterConfig{Exclude: FilterParamsConfig{Methods: []string{"query"}}},
+			included: []string{"ListPets", "PurgePets"},
+			excluded: []string{"SearchPets", "SearchPetsLegacy"},
+		},
+		{
+			name:     "include custom method",
+			filter:   FilterConfig{Include: FilterParamsConfig{Methods: []string{"PURGE"}}},
+			included: []string{"PurgePets"},
+			excluded: []string{"ListPets", "SearchPets", "SearchPetsLegacy"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Configuration{
+				PackageName: "api",
+				Filter:      tt.filter,
+				Generate:    &GenerateOptions{Client: true},
+			}
+			code, err := Generate(spec, cfg)
+			require.NoError(t, err)
+
+			combined := code.GetCombined()
+			for _, s := range tt.included {
+				assert.Contains(t, combined, s)
+			}
+			for _, s := range tt.excluded {
+				assert.NotContains(t, combined, s+"(")
+			}
+		})
+	}
+}
+
+func TestMatchPath(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		matches bool
+	}{
+		{"/pets", "/pets", true},
+		{"/pets", "/pets/{id}", false},
+		{"/pets/*", "/pets/{id}", true},
+		{"/pets/*", "/pets/{id}/toys", false},
+		{"/pets/*/toys", "/pets/{id}/toys", true},
+		{"/admin/**", "/admin", true},
+		{"/admin/**", "/admin/users/{id}/roles", true},
+		{"/admin/**", "/administrators", false},
+		{"/**/toys", "/pets/{id}/toys", true},
+		{"/v*/pets", "/v2/pets", true},
+		{"^/v[0-9]+/", "/v2/pets", true},
+		{"^/v[0-9]+/", "/pets/v2/", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.matches, matchPath(tt.pattern, tt.path))
+		})
+	}
+}
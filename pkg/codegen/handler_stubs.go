@@ -0,0 +1,85 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"fmt"
+	"go/ast"
+	"strconv"
+	"strings"
+)
+
+// HandlerStubs returns src, the Go source of the file declaring the hand-written handler type typeName,
+// with stubs appended for the operations the type has no method for. Stubs are methods named after the operations,
+// responding with 501 Not Implemented. They are http.HandlerFunc methods, or the methods of the ServerInterface
+// with generate.server-router, the file importing the generated package when it is another one.
+// The existing code is kept as is, and src is returned unchanged when no operation is missing.
+func HandlerStubs(src, typeName string, cfg Configuration, operations []OperationDefinition) (string, error) {
+	f := stubFile{
+		desc:        "the handler file",
+		src:         src,
+		typeName:    typeName,
+		importPaths: map[string]string{"http": "net/http"},
+	}
+	return addStubs(f, func(file *ast.File, recv stubReceiver) ([]stubMethod, error) {
+		// the bound requests of the server router are declared in the generated package
+		router := cfg.Generate != nil && cfg.Generate.ServerRouter
+		qualifier := ""
+		if router && file.Name.Name != cfg.PackageName {
+			qualifier = importName(file, cfg.PackageName)
+			if qualifier == "" {
+				return nil, fmt.Errorf("the handler file must import the generated package %s", cfg.PackageName)
+			}
+			qualifier += "."
+		}
+
+		// keep the parameters apart from the receiver
+		w, r, req := "w", "r", "req"
+		if recv.name == w || recv.name == r {
+			w, r = "rw", "req"
+		}
+		if recv.name == req || r == req {
+			req = "bound"
+		}
+
+		stubs := make([]stubMethod, 0, len(operations))
+		for _, op := range operations {
+			params := fmt.Sprintf("%s http.ResponseWriter, %s *http.Request", w, r)
+			if router && op.Binding != nil {
+				params += fmt.Sprintf(", %s *%s%s", req, qualifier, op.Binding.RequestName)
+			}
+			stubs = append(stubs, stubMethod{
+				name:     op.ID,
+				doc:      fmt.Sprintf("%s handles %s %s.", op.ID, op.Method, op.Path),
+				params:   "(" + params + ")",
+				body:     fmt.Sprintf("http.Error(%s, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)", w),
+				packages: []string{"http"},
+			})
+		}
+		return stubs, nil
+	})
+}
+
+// importName returns the name the file imports the package pkgName with, empty if it doesn't import it.
+// The package is matched by the last element of its import path.
+func importName(file *ast.File, pkgName string) string {
+	for _, imp := range file.Imports {
+		path, _ := strconv.Unquote(imp.Path.Value)
+		if path[strings.LastIndex(path, "/")+1:] != pkgName {
+			continue
+		}
+		if imp.Name != nil {
+			return imp.Name.Name
+		}
+		return pkgName
+	}
+	return ""
+}
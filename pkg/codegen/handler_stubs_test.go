@@ -0,0 +1,85 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"go/format"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandlerStubs(t *testing.T) {
+	ctx, errs := CreateParseContext([]byte(readTestdata(t, "prune-cat-dog.yml")), Configuration{PackageName: "api"})
+	require.Empty(t, errs)
+
+	t.Run("missing operations only", func(t *testing.T) {
+		src := `package handlers
+
+import "encoding/json"
+
+// Server serves the API.
+type Server struct{}
+
+func (srv *Server) GetCatStatus(w http.ResponseWriter, r *http.Request) {
+	_ = json.NewEncoder(w).Encode("meow")
+}
+`
+		res, err := HandlerStubs(src, "Server", Configuration{}, ctx.Operations)
+		require.NoError(t, err)
+
+		assert.Contains(t, res, "import (\n\t\"encoding/json\"\n\t\"net/http\"\n)\n")
+		assert.Contains(t, res, `_ = json.NewEncoder(w).Encode("meow")`)
+		assert.NotContains(t, res, "// GetCatStatus handles")
+		assert.Contains(t, res, "// GetDogStatus handles GET /dog.\n"+
+			"func (srv *Server) GetDogStatus(w http.ResponseWriter, r *http.Request) {\n"+
+			"\thttp.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)\n}\n")
+
+		formatted, err := format.Source([]byte(res))
+		require.NoError(t, err)
+		assert.Equal(t, string(formatted), res)
+
+		again, err := HandlerStubs(res, "Server", Configuration{}, ctx.Operations)
+		require.NoError(t, err)
+		assert.Equal(t, res, again)
+	})
+
+	t.Run("receiver named like a parameter", func(t *testing.T) {
+		res, err := HandlerStubs("package handlers\n\ntype Router struct{}\n\nfunc (r Router) Ping() {}\n", "Router", Configuration{}, ctx.Operations)
+		require.NoError(t, err)
+		assert.Contains(t, res, "func (r Router) GetCatStatus(rw http.ResponseWriter, req *http.Request) {\n"+
+			"\thttp.Error(rw, ")
+	})
+
+	t.Run("server router", func(t *testing.T) {
+		cfg := Configuration{PackageName: "api", Generate: &GenerateOptions{ServerBinding: true, ServerRouter: true}}
+		ctx, errs := CreateParseContext([]byte(readTestdata(t, "prune-cat-dog.yml")), cfg)
+		require.Empty(t, errs)
+
+		res, err := HandlerStubs("package api\n\ntype Server struct{}\n", "Server", cfg, ctx.Operations)
+		require.NoError(t, err)
+		assert.Contains(t, res, "func (s *Server) GetDogStatus(w http.ResponseWriter, r *http.Request, req *GetDogStatusRequest) {\n")
+
+		src := "package handlers\n\nimport gen \"example.com/pets/api\"\n\nvar _ gen.ServerInterface = (*Router)(nil)\n\ntype Router struct{}\n\nfunc (r Router) Ping() {}\n"
+		res, err = HandlerStubs(src, "Router", cfg, ctx.Operations)
+		require.NoError(t, err)
+		assert.Contains(t, res, "func (r Router) GetDogStatus(rw http.ResponseWriter, req *http.Request, bound *gen.GetDogStatusRequest) {\n")
+
+		_, err = HandlerStubs("package handlers\n\ntype Server struct{}\n", "Server", cfg, ctx.Operations)
+		assert.EqualError(t, err, "the handler file must import the generated package api")
+	})
+
+	t.Run("type not declared", func(t *testing.T) {
+		_, err := HandlerStubs("package handlers\n", "Server", Configuration{}, ctx.Operations)
+		assert.EqualError(t, err, "type Server not found in the handler file")
+	})
+}
@@ -0,0 +1,111 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"strings"
+)
+
+// ImplementationStubs returns the contents of the file holding the hand-written implementation typeName
+// of the client interface, with stubs added for the interface methods it is missing.
+// generated maps file names to the Go source of the generated code, existing to the other files
+// of the package, including the file for the stubs if it exists already.
+// The stubs share the receiver of the methods in the file, and the type is declared as an empty struct
+// if it isn't yet. When nothing is missing, the file is returned as is, empty if it doesn't exist.
+func ImplementationStubs(cfg Configuration, typeName string, generated, existing map[string]string) (string, error) {
+	cfg = cfg.WithDefaults()
+	if cfg.Output == nil || cfg.Output.Implementations[typeName] == "" {
+		return "", fmt.Errorf("no file configured for implementation %s", typeName)
+	}
+	filename := cfg.Output.Implementations[typeName]
+	interfaceName := cfg.Client.Name + "Interface"
+
+	fset := token.NewFileSet()
+	var (
+		pkgName     string
+		methods     []*ast.Field
+		importPaths = make(map[string]string) // package name -> import path
+	)
+	for name, src := range generated {
+		file, err := parser.ParseFile(fset, name, src, parser.SkipObjectResolution)
+		if err != nil {
+			return "", fmt.Errorf("error parsing generated code: %w", err)
+		}
+		pkgName = file.Name.Name
+		for _, imp := range file.Imports {
+			path, _ := strconv.Unquote(imp.Path.Value)
+			importName := path[strings.LastIndex(path, "/")+1:]
+			if imp.Name != nil {
+				importName = imp.Name.Name
+			}
+			importPaths[importName] = path
+		}
+		ast.Inspect(file, func(n ast.Node) bool {
+			if ts, ok := n.(*ast.TypeSpec); ok && ts.Name.Name == interfaceName {
+				if it, ok := ts.Type.(*ast.InterfaceType); ok {
+					methods = it.Methods.List
+				}
+			}
+			return true
+		})
+	}
+	if methods == nil {
+		return "", fmt.Errorf("interface %s not found, implementations need the generated client", interfaceName)
+	}
+
+	others := make(map[string]string)
+	for name, src := range existing {
+		if name != filename {
+			others[name] = src
+		}
+	}
+	f := stubFile{
+		name:        filename,
+		desc:        filename,
+		src:         existing[filename],
+		pkgName:     pkgName,
+		typeName:    typeName,
+		typeDoc:     fmt.Sprintf("%s implements %s.", typeName, interfaceName),
+		others:      others,
+		importPaths: importPaths,
+	}
+	return addStubs(f, func(*ast.File, stubReceiver) ([]stubMethod, error) {
+		var stubs []stubMethod
+		for _, method := range methods {
+			if len(method.Names) == 0 {
+				continue
+			}
+			var packages []string
+			ast.Inspect(method.Type, func(n ast.Node) bool {
+				if sel, ok := n.(*ast.SelectorExpr); ok {
+					if ident, ok := sel.X.(*ast.Ident); ok {
+						packages = append(packages, ident.Name)
+					}
+				}
+				return true
+			})
+			name := method.Names[0].Name
+			stubs = append(stubs, stubMethod{
+				name:     name,
+				doc:      fmt.Sprintf("%s implements %s.", name, interfaceName),
+				params:   strings.TrimPrefix(printNode(fset, method.Type), "func"),
+				body:     `panic("not implemented")`,
+				packages: packages,
+			})
+		}
+		return stubs, nil
+	})
+}
@@ -0,0 +1,106 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"go/format"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImplementationStubs(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "api",
+		Output: &Output{
+			UseSingleFile:   true,
+			Implementations: map[string]string{"FakeClient": "fake_client.go"},
+		},
+		Generate: &GenerateOptions{
+			Client: true,
+		},
+	}
+
+	code, err := Generate([]byte(readTestdata(t, "prune-cat-dog.yml")), cfg)
+	require.NoError(t, err)
+	generated := map[string]string{"gen.go": code.GetCombined()}
+	assert.Contains(t, code.GetCombined(), "var _ ClientInterface = (*FakeClient)(nil)")
+
+	t.Run("new file", func(t *testing.T) {
+		res, err := ImplementationStubs(cfg, "FakeClient", generated, nil)
+		require.NoError(t, err)
+
+		assert.Contains(t, res, "package api\n")
+		assert.Contains(t, res, `"context"`)
+		assert.Contains(t, res, `"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"`)
+		assert.Contains(t, res, "// FakeClient implements ClientInterface.\ntype FakeClient struct{}\n")
+		assert.Contains(t, res, "// GetCatStatus implements ClientInterface.\n"+
+			"func (f *FakeClient) GetCatStatus(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (*GetCatStatusResponse, error) {\n"+
+			"\tpanic(\"not implemented\")\n}\n")
+		assert.Contains(t, res, "func (f *FakeClient) GetDogStatus(")
+
+		formatted, err := format.Source([]byte(res))
+		require.NoError(t, err)
+		assert.Equal(t, string(formatted), res)
+	})
+
+	t.Run("missing methods only", func(t *testing.T) {
+		existing := map[string]string{
+			"fake.go": `package api
+
+type FakeClient struct {
+	cats int
+}
+`,
+			"fake_client.go": `package api
+
+import "context"
+
+// GetCatStatus returns a cat.
+func (f *FakeClient) GetCatStatus(ctx context.Context, _ ...runtime.RequestEditorFn) (*GetCatStatusResponse, error) {
+	return &GetCatStatusResponse{}, nil
+}
+`,
+		}
+		res, err := ImplementationStubs(cfg, "FakeClient", generated, existing)
+		require.NoError(t, err)
+
+		assert.Contains(t, res, "// GetCatStatus returns a cat.\n")
+		assert.NotContains(t, res, "type FakeClient")
+		assert.Equal(t, 1, strings.Count(res, "GetCatStatus("))
+		assert.Contains(t, res, "func (f *FakeClient) GetDogStatus(")
+		assert.Contains(t, res, `"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"`)
+
+		existing["fake_client.go"] = res
+		again, err := ImplementationStubs(cfg, "FakeClient", generated, existing)
+		require.NoError(t, err)
+		assert.Equal(t, res, again)
+	})
+
+	t.Run("implemented elsewhere", func(t *testing.T) {
+		res, err := ImplementationStubs(cfg, "Client", generated, generated)
+		assert.Error(t, err)
+		assert.Empty(t, res)
+
+		cfg := cfg
+		cfg.Output = &Output{Implementations: map[string]string{"Client": "client_stubs.go"}}
+		res, err = ImplementationStubs(cfg, "Client", generated, generated)
+		require.NoError(t, err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("no client", func(t *testing.T) {
+		_, err := ImplementationStubs(cfg, "FakeClient", map[string]string{"gen.go": "package api\n"}, nil)
+		assert.ErrorContains(t, err, "interface ClientInterface not found")
+	})
+}
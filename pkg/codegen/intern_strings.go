@@ -0,0 +1,183 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// minInternedStringUses is how many times a string literal must appear in the generated code to be interned.
+	minInternedStringUses = 3
+
+	// minInternedStringLength is the shortest string literal that is interned, shorter ones read better inline.
+	minInternedStringLength = 4
+
+	// maxInternedNameWords caps the number of words of the string used to name its constant.
+	maxInternedNameWords = 6
+)
+
+var formatVerbRe = regexp.MustCompile(`%[-+# 0]*[0-9.*]*[a-zA-Z%]`)
+
+// StringConstant is a package-level constant holding a string literal shared across the generated code.
+type StringConstant struct {
+	Name  string
+	Value string
+}
+
+// TplStringsContext is the context passed to templates to generate the interned string constants.
+type TplStringsContext struct {
+	Constants  []StringConstant
+	Imports    []string
+	Config     Configuration
+	WithHeader bool
+}
+
+// stringLiteral is an occurrence of a string literal in a generated file.
+type stringLiteral struct {
+	start, end int
+	value      string
+}
+
+// internStrings replaces the string literals repeated at least minInternedStringUses times across the
+// generated Go files with package-level constants. It rewrites the files in place and returns the constants
+// to declare, sorted by name. Imports, struct tags and existing const declarations are left untouched.
+func internStrings(files map[string]string) ([]StringConstant, error) {
+	fset := token.NewFileSet()
+	literals := make(map[string][]stringLiteral, len(files))
+	idents := make(map[string]bool)
+	uses := make(map[string]int)
+
+	for _, name := range sortedMapKeys(files) {
+		file, err := parser.ParseFile(fset, name, files[name], parser.SkipObjectResolution)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing generated code %s: %w", name, err)
+		}
+
+		tags := make(map[*ast.BasicLit]bool)
+		ast.Inspect(file, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.ImportSpec:
+				return false
+			case *ast.Field:
+				if n.Tag != nil {
+					tags[n.Tag] = true
+				}
+			case *ast.GenDecl:
+				if n.Tok == token.CONST {
+					collectConstNames(n, idents)
+					return false
+				}
+			case *ast.Ident:
+				idents[n.Name] = true
+			case *ast.BasicLit:
+				if n.Kind != token.STRING || tags[n] {
+					return true
+				}
+				value, err := strconv.Unquote(n.Value)
+				if err != nil || len(value) < minInternedStringLength {
+					return true
+				}
+				uses[value]++
+				literals[name] = append(literals[name], stringLiteral{
+					start: fset.Position(n.Pos()).Offset,
+					end:   fset.Position(n.End()).Offset,
+					value: value,
+				})
+			}
+			return true
+		})
+	}
+
+	values := make([]string, 0, len(uses))
+	for value, count := range uses {
+		if count >= minInternedStringUses {
+			values = append(values, value)
+		}
+	}
+	if len(values) == 0 {
+		return nil, nil
+	}
+	sort.Strings(values)
+
+	names := make(map[string]string, len(values))
+	constants := make([]StringConstant, 0, len(values))
+	for _, value := range values {
+		name := internedStringName(value, idents)
+		idents[name] = true
+		names[value] = name
+		constants = append(constants, StringConstant{Name: name, Value: strconv.Quote(value)})
+	}
+	sort.Slice(constants, func(i, j int) bool {
+		return constants[i].Name < constants[j].Name
+	})
+
+	for name, lits := range literals {
+		src := files[name]
+		var b strings.Builder
+		last := 0
+		for _, lit := range lits {
+			constName, ok := names[lit.value]
+			if !ok {
+				continue
+			}
+			b.WriteString(src[last:lit.start])
+			b.WriteString(constName)
+			last = lit.end
+		}
+		b.WriteString(src[last:])
+		files[name] = b.String()
+	}
+
+	return constants, nil
+}
+
+// collectConstNames records the names declared by a const declaration, whose literals are not interned.
+func collectConstNames(decl *ast.GenDecl, idents map[string]bool) {
+	for _, spec := range decl.Specs {
+		if vs, ok := spec.(*ast.ValueSpec); ok {
+			for _, id := range vs.Names {
+				idents[id.Name] = true
+			}
+		}
+	}
+}
+
+// internedStringName returns the constant name for an interned string, built from its first words
+// with format verbs stripped, e.g. "error decoding response: %w" -> strErrorDecodingResponse.
+// The name is suffixed with a number when it is already taken.
+func internedStringName(value string, taken map[string]bool) string {
+	value = formatVerbRe.ReplaceAllString(value, " ")
+	value = strings.NewReplacer("/", "_", "*", "Wildcard_", "+", "Plus_").Replace(value)
+
+	parts := camelCaseMatchParts.FindAllString(toCamelCaseWithInitialism(value), -1)
+	if len(parts) > maxInternedNameWords {
+		parts = parts[:maxInternedNameWords]
+	}
+
+	base := "str" + strings.Join(parts, "")
+	if base == "str" {
+		base = "strLiteral"
+	}
+
+	name := base
+	for i := 2; taken[name]; i++ {
+		name = base + strconv.Itoa(i)
+	}
+	return name
+}
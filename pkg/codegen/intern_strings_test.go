@@ -0,0 +1,137 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInternStrings(t *testing.T) {
+	spec := []byte(readTestdata(t, "train-travel-api.yml"))
+
+	t.Run("disabled", func(t *testing.T) {
+		codes, err := Generate(spec, Configuration{PackageName: "api", Generate: &GenerateOptions{Client: true}})
+		require.NoError(t, err)
+		assert.NotContains(t, codes.GetCombined(), "strErrorExecutingRequest")
+	})
+
+	t.Run("single file", func(t *testing.T) {
+		codes, err := Generate(spec, Configuration{
+			PackageName: "api",
+			Generate:    &GenerateOptions{Client: true, InternStrings: true},
+		})
+		require.NoError(t, err)
+
+		code := codes.GetCombined()
+		assert.Contains(t, code, "// String literals shared across the generated code.\nconst (\n")
+		assert.Regexp(t, `strBookingsBookingID\s+= "/bookings/\{bookingId\}"`, code)
+		assert.Regexp(t, `strErrorExecutingRequest\s+= "error executing request: %w"`, code)
+		assert.Contains(t, code, "c.apiClient.GetBaseURL() + strBookingsBookingID,")
+		assert.Contains(t, code, `fmt.Errorf(strErrorExecutingRequest, err)`)
+		assert.NotContains(t, code, `"error executing request: %w", err`)
+	})
+
+	t.Run("multiple files", func(t *testing.T) {
+		codes, err := Generate(spec, Configuration{
+			PackageName: "api",
+			Generate:    &GenerateOptions{Client: true, InternStrings: true},
+			Output:      &Output{UseSingleFile: false},
+		})
+		require.NoError(t, err)
+
+		assert.Contains(t, codes["strings"], "// Code generated by oapi-codegen. DO NOT EDIT.\n\npackage api\n")
+		assert.Regexp(t, `strErrorExecutingRequest\s+= "error executing request: %w"`, codes["strings"])
+		assert.Contains(t, codes["client"], `fmt.Errorf(strErrorExecutingRequest, err)`)
+	})
+}
+
+func Test_internStrings(t *testing.T) {
+	files := map[string]string{
+		"a": `package api
+
+import "net/http"
+
+const strContentType = "Content-Type"
+
+type Pet struct {
+	Name string ` + "`json:\"name\"`" + `
+}
+
+func a(r *http.Request) {
+	r.Header.Set("Content-Type", "application/json")
+	r.Header.Set("Content-Type", "application/json")
+	r.Header.Set("Accept", "application/json")
+}
+`,
+		"b": `package api
+
+import "net/http"
+
+func b(r *http.Request) {
+	r.Header.Set("Content-Type", "name")
+	r.Header.Set("json", "name")
+	r.Header.Set("json", "other")
+}
+`,
+	}
+
+	constants, err := internStrings(files)
+	require.NoError(t, err)
+	assert.Equal(t, []StringConstant{
+		{Name: "strApplicationJSON", Value: `"application/json"`},
+		{Name: "strContentType2", Value: `"Content-Type"`},
+	}, constants)
+
+	assert.Contains(t, files["a"], `import "net/http"`)
+	assert.Contains(t, files["a"], `const strContentType = "Content-Type"`)
+	assert.Contains(t, files["a"], "`json:\"name\"`")
+	assert.Contains(t, files["a"], `r.Header.Set(strContentType2, strApplicationJSON)`)
+	assert.Contains(t, files["a"], `r.Header.Set("Accept", strApplicationJSON)`)
+	assert.Contains(t, files["b"], `r.Header.Set(strContentType2, "name")`)
+	assert.Contains(t, files["b"], `r.Header.Set("json", "name")`)
+
+	t.Run("nothing to intern", func(t *testing.T) {
+		constants, err := internStrings(map[string]string{"a": "package api\n\nvar a = \"value\"\n"})
+		require.NoError(t, err)
+		assert.Empty(t, constants)
+	})
+
+	t.Run("invalid code", func(t *testing.T) {
+		_, err := internStrings(map[string]string{"a": "package api\n\nfunc {"})
+		assert.ErrorContains(t, err, "error parsing generated code a")
+	})
+}
+
+func Test_internedStringName(t *testing.T) {
+	tests := []struct {
+		value string
+		taken map[string]bool
+		want  string
+	}{
+		{value: "application/json", want: "strApplicationJSON"},
+		{value: "application/vnd.api+json", want: "strApplicationVndAPIPlusJSON"},
+		{value: "error decoding response: %w", want: "strErrorDecodingResponse"},
+		{value: "API error (status %d): %v", want: "strAPIErrorStatus"},
+		{value: "/pets/{petId}", want: "strPetsPetID"},
+		{value: "required,min=1,max=10", want: "strRequiredMin1Max10"},
+		{value: "the quick brown fox jumps over the lazy dog", want: "strTheQuickBrownFoxJumpsOver"},
+		{value: "%s: %w", want: "strLiteral"},
+		{value: "Accept", taken: map[string]bool{"strAccept": true, "strAccept2": true}, want: "strAccept3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			assert.Equal(t, tt.want, internedStringName(tt.value, tt.taken))
+		})
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import "fmt"
+
+// jsonLibrary is a JSON package the generated code marshals and unmarshals with instead of encoding/json.
+type jsonLibrary struct {
+	// Name is the json-library of the configuration.
+	Name string
+	// Import is the import spec of the package, e.g. jsoniter "github.com/json-iterator/go".
+	Import string
+	// Marshal and Unmarshal are the functions with the signatures of json.Marshal and json.Unmarshal.
+	Marshal   string
+	Unmarshal string
+}
+
+// knownJSONLibraries configures the JSON libraries by their short names,
+// compatible with encoding/json where they have options for it.
+var knownJSONLibraries = map[string]jsonLibrary{
+	"jsoniter": {
+		Import:    `jsoniter "github.com/json-iterator/go"`,
+		Marshal:   "jsoniter.ConfigCompatibleWithStandardLibrary.Marshal",
+		Unmarshal: "jsoniter.ConfigCompatibleWithStandardLibrary.Unmarshal",
+	},
+	"sonic": {
+		Import:    `"github.com/bytedance/sonic"`,
+		Marshal:   "sonic.ConfigStd.Marshal",
+		Unmarshal: "sonic.ConfigStd.Unmarshal",
+	},
+	"encoding/json/v2": {
+		Import:    `jsonv2 "encoding/json/v2"`,
+		Marshal:   "jsonv2.Marshal",
+		Unmarshal: "jsonv2.Unmarshal",
+	},
+}
+
+// newJSONLibrary returns the JSON library of name: a short name of knownJSONLibraries,
+// or the import path of a package with Marshal and Unmarshal functions like encoding/json.
+// It returns nil for encoding/json.
+func newJSONLibrary(name string) *jsonLibrary {
+	if name == "" || name == "encoding/json" {
+		return nil
+	}
+	if lib, ok := knownJSONLibraries[name]; ok {
+		lib.Name = name
+		return &lib
+	}
+	return &jsonLibrary{
+		Name:      name,
+		Import:    fmt.Sprintf("jsonlib %q", name),
+		Marshal:   "jsonlib.Marshal",
+		Unmarshal: "jsonlib.Unmarshal",
+	}
+}
+
+// marshalFunc returns the function the generated code marshals with.
+func (l *jsonLibrary) marshalFunc() string {
+	if l == nil {
+		return "json.Marshal"
+	}
+	return "jsonMarshal"
+}
+
+// unmarshalFunc returns the function the generated code unmarshals with.
+func (l *jsonLibrary) unmarshalFunc() string {
+	if l == nil {
+		return "json.Unmarshal"
+	}
+	return "jsonUnmarshal"
+}
@@ -0,0 +1,196 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"go.yaml.in/yaml/v4"
+)
+
+// LinkDefinition is a link of the success response of an operation, generating the Link<Name> method
+// of the response returning the request options of the linked operation, filled in from the response.
+type LinkDefinition struct {
+	// Name is the Go name of the link.
+	Name string
+
+	// Description is the description of the link.
+	Description string
+
+	// OperationID is the ID of the linked operation.
+	OperationID string
+
+	// PathParams, Query and Header are the JSON objects of the values of the parameters set by the link
+	// by name, empty if it sets none in that location. Strings starting with $ are runtime expressions.
+	PathParams string
+	Query      string
+	Header     string
+
+	// Body is the JSON value of the request body set by the link, empty if it sets none.
+	Body string
+}
+
+// resolveLinks resolves the links of the success responses of the operations against the operations they link to.
+// Links that can't be followed from the response body are skipped with a warning, like they were before
+// links were generated.
+func resolveLinks(operations []OperationDefinition) []OperationDefinition {
+	targets := make(map[string]OperationDefinition, len(operations))
+	for _, op := range operations {
+		if !op.SynthesizedID {
+			targets[op.SpecID] = op
+		}
+	}
+
+	for i, op := range operations {
+		if op.Response.Success == nil || op.Response.Success.links == nil {
+			continue
+		}
+		names := make(map[string]bool)
+		for name, link := range op.Response.Success.links.FromOldest() {
+			def, err := newLinkDefinition(name, link, targets)
+			if err == nil && names[def.Name] {
+				err = fmt.Errorf("its method Link%s is already generated for another link", def.Name)
+			}
+			if err != nil {
+				slog.Warn(fmt.Sprintf("skipping link %s of operation %s: %s", name, op.SpecID, err))
+				continue
+			}
+			names[def.Name] = true
+			operations[i].Links = append(operations[i].Links, def)
+		}
+	}
+	return operations
+}
+
+// newLinkDefinition checks a link against the operation it links to, by operationId.
+func newLinkDefinition(name string, link *v3high.Link, targets map[string]OperationDefinition) (LinkDefinition, error) {
+	if link.OperationId == "" {
+		return LinkDefinition{}, fmt.Errorf("only links with an operationId are supported")
+	}
+	target, ok := targets[link.OperationId]
+	if !ok {
+		return LinkDefinition{}, fmt.Errorf("operation %s not found", link.OperationId)
+	}
+
+	def := LinkDefinition{
+		Name:        schemaNameToTypeName(name),
+		Description: link.Description,
+		OperationID: target.ID,
+	}
+
+	paramNodes, bodyNode := linkValueNodes(link)
+	values := map[string]map[string]json.RawMessage{}
+	if link.Parameters != nil {
+		for param, expr := range link.Parameters.FromOldest() {
+			in, paramName, err := linkParameter(param, target)
+			if err != nil {
+				return LinkDefinition{}, err
+			}
+			value, err := linkValue(expr, paramNodes[param])
+			if err != nil {
+				return LinkDefinition{}, fmt.Errorf("parameter %s: %w", param, err)
+			}
+			if values[in] == nil {
+				values[in] = map[string]json.RawMessage{}
+			}
+			values[in][paramName] = value
+		}
+	}
+	for in, dst := range map[string]*string{"path": &def.PathParams, "query": &def.Query, "header": &def.Header} {
+		if values[in] == nil {
+			continue
+		}
+		data, err := json.Marshal(values[in])
+		if err != nil {
+			return LinkDefinition{}, err
+		}
+		*dst = string(data)
+	}
+
+	if link.RequestBody != "" || bodyNode != nil {
+		if target.Body == nil {
+			return LinkDefinition{}, fmt.Errorf("operation %s has no request body", link.OperationId)
+		}
+		value, err := linkValue(link.RequestBody, bodyNode)
+		if err != nil {
+			return LinkDefinition{}, fmt.Errorf("request body: %w", err)
+		}
+		def.Body = string(value)
+	}
+
+	if !target.HasRequestOptions() {
+		return LinkDefinition{}, fmt.Errorf("operation %s has no parameters or request body", link.OperationId)
+	}
+	return def, nil
+}
+
+// linkParameter returns the location and name of the parameter of the target operation set by a link,
+// named like id or qualified with its location like path.id.
+func linkParameter(name string, target OperationDefinition) (string, string, error) {
+	in, paramName := "", name
+	if prefix, rest, ok := strings.Cut(name, "."); ok {
+		switch prefix {
+		case "path", "query", "header", "cookie":
+			in, paramName = prefix, rest
+		}
+	}
+
+	for _, param := range target.Parameters {
+		if param.ParamName != paramName || in != "" && param.In != in {
+			continue
+		}
+		if param.In == "cookie" {
+			return "", "", fmt.Errorf("cookie parameter %s is not sent by the client", paramName)
+		}
+		return param.In, paramName, nil
+	}
+	return "", "", fmt.Errorf("%s is not a parameter of operation %s", name, target.SpecID)
+}
+
+// linkValueNodes returns the YAML nodes of the parameters and request body of a link, to keep the types
+// of constant values.
+func linkValueNodes(link *v3high.Link) (map[string]*yaml.Node, *yaml.Node) {
+	low := link.GoLow()
+	if low == nil {
+		return nil, nil
+	}
+	params := map[string]*yaml.Node{}
+	if low.Parameters.Value != nil {
+		for k, v := range low.Parameters.Value.FromOldest() {
+			params[k.Value] = v.ValueNode
+		}
+	}
+	return params, low.RequestBody.ValueNode
+}
+
+// linkValue returns the JSON of the value of a link parameter or request body, which must be a constant
+// or a runtime expression evaluated against the response body: $response.body, or a JSON pointer in it
+// like $response.body#/id.
+func linkValue(value string, node *yaml.Node) (json.RawMessage, error) {
+	if node == nil || node.Kind == yaml.ScalarNode && node.Tag == "!!str" {
+		switch {
+		case value == "$response.body" || strings.HasPrefix(value, "$response.body#/"):
+		case strings.HasPrefix(value, "$"), strings.Contains(value, "{$"):
+			return nil, fmt.Errorf("runtime expression %s can't be evaluated against the response body", value)
+		}
+		return json.Marshal(value)
+	}
+
+	var v any
+	if err := node.Decode(&v); err != nil {
+		return nil, err
+	}
+	return json.Marshal(v)
+}
@@ -0,0 +1,82 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"go/format"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinks(t *testing.T) {
+	spec := []byte(readTestdata(t, "links.yml"))
+	cfg := Configuration{
+		PackageName: "api",
+		Output:      &Output{UseSingleFile: true},
+		Generate:    &GenerateOptions{Client: true},
+	}
+
+	codes, err := Generate(spec, cfg)
+	require.NoError(t, err)
+
+	code := codes.GetCombined()
+	assert.Contains(t, code, "type CreateUserResponse struct {")
+	assert.Contains(t, code, "func (r *CreateUserResponse) LinkGetCreatedUser() (*GetUserRequestOptions, error) {")
+	assert.Contains(t, code, `runtime.ResolveLinkParameters(r, "{\"userId\":\"$response.body#/id\"}", &options.PathParams)`)
+	assert.Contains(t, code, "func (r *CreateUserResponse) LinkCopyUser() (*CreateUserRequestOptions, error) {")
+	assert.Contains(t, code, `runtime.ResolveLinkBody(r, "\"$response.body\"", &options.Body)`)
+	assert.NotContains(t, code, "LinkGetRequestedUser")
+
+	assert.Contains(t, code, "func (r *GetUserResponse) LinkManager() (*GetUserRequestOptions, error) {")
+	assert.Contains(t, code, "// The manager of the user.")
+	assert.Contains(t, code, `runtime.ResolveLinkParameters(r, "{\"limit\":10,\"owner\":\"$response.body#/id\"}", &options.Query)`)
+	assert.NotContains(t, code, "LinkMissing")
+
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+
+	t.Run("models only", func(t *testing.T) {
+		codes, err := Generate(spec, Configuration{PackageName: "api", Output: &Output{UseSingleFile: true}})
+		require.NoError(t, err)
+
+		code := codes.GetCombined()
+		assert.Contains(t, code, "type CreateUserResponse = User")
+		assert.NotContains(t, code, "LinkGetCreatedUser")
+	})
+}
+
+func TestLinkValue(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+		err   string
+	}{
+		{value: "$response.body", want: `"$response.body"`},
+		{value: "$response.body#/items/0/id", want: `"$response.body#/items/0/id"`},
+		{value: "active", want: `"active"`},
+		{value: "$response.header.Location", err: "runtime expression $response.header.Location can't be evaluated against the response body"},
+		{value: "id-{$response.body#/id}", err: "can't be evaluated against the response body"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := linkValue(tt.value, nil)
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.want, string(got))
+		})
+	}
+}
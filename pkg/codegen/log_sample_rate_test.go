@@ -0,0 +1,66 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"go/format"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperationLogSampleRate(t *testing.T) {
+	tests := []struct {
+		name       string
+		extensions map[string]any
+		expected   float64
+		err        string
+	}{
+		{name: "not set", extensions: map[string]any{}},
+		{name: "rate", extensions: map[string]any{extLogSampleRate: "0.25"}, expected: 0.25},
+		{name: "all", extensions: map[string]any{extLogSampleRate: "1"}, expected: 1},
+		{name: "zero", extensions: map[string]any{extLogSampleRate: "0"}, err: "invalid x-log-sample-rate: sample rate must be greater than 0 and at most 1"},
+		{name: "above 1", extensions: map[string]any{extLogSampleRate: "10"}, err: "invalid x-log-sample-rate: sample rate must be greater than 0 and at most 1"},
+		{name: "not a number", extensions: map[string]any{extLogSampleRate: "often"}, err: `invalid x-log-sample-rate: strconv.ParseFloat: parsing "often": invalid syntax`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, err := operationLogSampleRate(tt.extensions)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, rate)
+		})
+	}
+}
+
+func TestLogSampleRate(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "api",
+		Generate:    &GenerateOptions{Client: true},
+		Output:      &Output{UseSingleFile: true},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "log-sample-rate.yml")), cfg)
+	require.NoError(t, err)
+	code := codes.GetCombined()
+
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+
+	assert.Contains(t, code, "GetHealthLogSampleRate = 0.01")
+	assert.NotContains(t, code, "CreateOrderLogSampleRate")
+	assert.Contains(t, code, "LogSampleRate: GetHealthLogSampleRate,")
+}
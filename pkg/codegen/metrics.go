@@ -0,0 +1,60 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"time"
+)
+
+// GenerationMetrics is the machine-readable summary of a generation,
+// e.g. for platform teams to aggregate adoption and problems across repositories.
+type GenerationMetrics struct {
+	Generator    string   `json:"generator"`
+	PackageName  string   `json:"packageName"`
+	SpecTitle    string   `json:"specTitle"`
+	SpecVersion  string   `json:"specVersion"`
+	SpecChecksum string   `json:"specChecksum"`
+	SpecSize     int      `json:"specSize"`
+	Operations   int      `json:"operations"`
+	Types        int      `json:"types"`
+	Enums        int      `json:"enums"`
+	Files        int      `json:"files"`
+	Warnings     []string `json:"warnings"`
+	DurationMs   int64    `json:"durationMs"`
+}
+
+// NewGenerationMetrics summarizes the code generated from ctx with cfg, which took duration.
+// Warnings are left to the caller, e.g. from the warnings logged during generation.
+func NewGenerationMetrics(cfg Configuration, ctx *ParseContext, code GeneratedCode, duration time.Duration) GenerationMetrics {
+	res := GenerationMetrics{
+		Generator:   generatorName + "/" + generatorVersion(),
+		PackageName: cfg.PackageName,
+		Files:       len(code),
+		Warnings:    []string{},
+		DurationMs:  duration.Milliseconds(),
+	}
+	if ctx == nil {
+		return res
+	}
+
+	res.SpecTitle = ctx.Info.Title
+	res.SpecVersion = ctx.Info.Version
+	res.SpecChecksum = ctx.Info.Checksum
+	res.SpecSize = ctx.Info.Size
+	res.Operations = len(ctx.Operations)
+	res.Enums = len(ctx.Enums)
+	res.Types = len(ctx.UnionTypes)
+	for _, typeDefs := range ctx.TypeDefinitions {
+		res.Types += len(typeDefs)
+	}
+
+	return res
+}
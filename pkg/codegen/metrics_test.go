@@ -0,0 +1,53 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGenerationMetrics(t *testing.T) {
+	contents := []byte(readTestdata(t, "prune-cat-dog.yml"))
+	cfg := Configuration{PackageName: "api"}.WithDefaults()
+
+	ctx, errs := CreateParseContext(contents, cfg)
+	require.Nil(t, errs)
+	parser, err := NewParser(cfg, ctx)
+	require.NoError(t, err)
+	code, err := parser.Parse()
+	require.NoError(t, err)
+
+	res := NewGenerationMetrics(cfg, ctx, code, 1500*time.Millisecond)
+	assert.Equal(t, "oapi-codegen-dd/devel", res.Generator)
+	assert.Equal(t, "api", res.PackageName)
+	assert.Equal(t, "OpenAPI-CodeGen Test", res.SpecTitle)
+	assert.Equal(t, "1.0.0", res.SpecVersion)
+	assert.Equal(t, specChecksum(contents), res.SpecChecksum)
+	assert.Equal(t, len(contents), res.SpecSize)
+	assert.Equal(t, len(ctx.Operations), res.Operations)
+	assert.Positive(t, res.Operations)
+	assert.Positive(t, res.Types)
+	assert.Equal(t, len(ctx.Enums), res.Enums)
+	assert.Equal(t, len(code), res.Files)
+	assert.Empty(t, res.Warnings)
+	assert.NotNil(t, res.Warnings)
+	assert.Equal(t, int64(1500), res.DurationMs)
+
+	t.Run("without parse context", func(t *testing.T) {
+		res := NewGenerationMetrics(cfg, nil, nil, time.Second)
+		assert.Equal(t, "api", res.PackageName)
+		assert.Zero(t, res.Operations)
+	})
+}
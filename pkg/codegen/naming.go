@@ -584,9 +584,7 @@ func stringToGoCommentWithPrefix(in, prefix string) string {
 		return ""
 	}
 
-	// Normalize newlines from Windows/Mac to Linux
-	in = strings.ReplaceAll(in, "\r\n", "\n")
-	in = strings.ReplaceAll(in, "\r", "\n")
+	in = sanitizeComment(in)
 
 	// Add comment to each line
 	var lines []string
@@ -605,6 +603,48 @@ func stringToGoCommentWithPrefix(in, prefix string) string {
 	return in
 }
 
+// sanitizeComment makes a description safe to embed in Go comments.
+// Newlines are normalized to \n, invalid UTF-8 is replaced with U+FFFD,
+// control characters (except tabs and newlines) and byte order marks, which the Go
+// scanner rejects, are dropped and "*/" is broken up so it cannot close a block comment.
+func sanitizeComment(in string) string {
+	in = strings.ToValidUTF8(in, string(utf8.RuneError))
+
+	// Normalize newlines from Windows/Mac to Linux
+	in = strings.ReplaceAll(in, "\r\n", "\n")
+	in = strings.ReplaceAll(in, "\r", "\n")
+
+	in = strings.Map(func(r rune) rune {
+		switch {
+		case r == '\n' || r == '\t':
+			return r
+		case r == '\u0085' || r == '\u2028' || r == '\u2029':
+			return '\n'
+		case r == '\uFEFF' || unicode.IsControl(r):
+			return -1
+		}
+		return r
+	}, in)
+
+	return strings.ReplaceAll(in, "*/", "* /")
+}
+
+// truncateDescription shortens descriptions longer than maxLen runes, cutting at a word boundary
+// and pointing the reader back to the spec. A maxLen of 0 or less disables truncation.
+func truncateDescription(in string, maxLen int) string {
+	if maxLen <= 0 || utf8.RuneCountInString(in) <= maxLen {
+		return in
+	}
+
+	runes := []rune(in)
+	cut := string(runes[:maxLen])
+	if i := strings.LastIndexFunc(cut, unicode.IsSpace); i > 0 {
+		cut = cut[:i]
+	}
+
+	return strings.TrimRightFunc(cut, unicode.IsSpace) + "... (truncated, see the spec for the full description)"
+}
+
 // escapePathElements breaks apart a path, and looks at each element. If it's
 // not a path parameter, eg, {param}, it will URL-escape the element.
 func escapePathElements(path string) string {
@@ -312,6 +312,81 @@ Line
 	}
 }
 
+func TestSanitizeComment(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected string
+		message  string
+	}{
+		{
+			input:    "Line one\r\nLine two\rLine three",
+			expected: "Line one\nLine two\nLine three",
+			message:  "windows and mac newlines are normalized",
+		},
+		{
+			input:    "Ends */ a block comment",
+			expected: "Ends * / a block comment",
+			message:  "block comment terminators are broken up",
+		},
+		{
+			input:    "Nul\x00 bell\a bom\uFEFF escape\x1b[0m",
+			expected: "Nul bell bom escape[0m",
+			message:  "control characters and byte order marks are dropped",
+		},
+		{
+			input:    "Keeps\ttabs and `backticks`",
+			expected: "Keeps\ttabs and `backticks`",
+			message:  "tabs and backticks are kept",
+		},
+		{
+			input:    "Line\u2028separator\u2029paragraph",
+			expected: "Line\nseparator\nparagraph",
+			message:  "unicode line separators become newlines",
+		},
+		{
+			input:    "Invalid \xff byte, Größe 日本語",
+			expected: "Invalid \uFFFD byte, Größe 日本語",
+			message:  "invalid UTF-8 is replaced and other text is kept",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.message, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, sanitizeComment(testCase.input))
+		})
+	}
+
+	t.Run("generated comment compiles", func(t *testing.T) {
+		comment := stringWithTypeNameToGoComment("Weird\x00 */ description\r\n\uFEFFsecond line", "Foo")
+		_, err := FormatCode("package foo\n\n" + comment + "\ntype Foo struct{}\n")
+		require.NoError(t, err)
+		assert.Equal(t, "// Foo Weird * / description\n// second line", comment)
+	})
+}
+
+func TestTruncateDescription(t *testing.T) {
+	const suffix = "... (truncated, see the spec for the full description)"
+
+	t.Run("disabled", func(t *testing.T) {
+		assert.Equal(t, "A long description", truncateDescription("A long description", 0))
+	})
+
+	t.Run("short enough", func(t *testing.T) {
+		assert.Equal(t, "Short", truncateDescription("Short", 5))
+	})
+
+	t.Run("cuts at word boundary", func(t *testing.T) {
+		assert.Equal(t, "A long"+suffix, truncateDescription("A long description", 10))
+	})
+
+	t.Run("cuts long words", func(t *testing.T) {
+		assert.Equal(t, "Supercali"+suffix, truncateDescription("Supercalifragilistic", 9))
+	})
+
+	t.Run("counts characters", func(t *testing.T) {
+		assert.Equal(t, "日本"+suffix, truncateDescription("日本語の説明", 2))
+	})
+}
+
 func TestEscapePathElements(t *testing.T) {
 	p := "/foo/bar/baz"
 	assert.Equal(t, p, escapePathElements(p))
@@ -11,12 +11,19 @@
 package codegen
 
 import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"path/filepath"
 	"strings"
 	"unicode"
 
 	"github.com/pb33f/libopenapi"
 	"github.com/pb33f/libopenapi/datamodel"
+	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
 	"go.yaml.in/yaml/v4"
 )
 
@@ -26,9 +33,11 @@ func CreateDocument(docContents []byte, cfg Configuration) (libopenapi.Document,
 		return nil, err
 	}
 
-	if _, err = doc.BuildV3Model(); err != nil {
+	built, err := doc.BuildV3Model()
+	if err != nil {
 		return nil, fmt.Errorf("error building model: %w", err)
 	}
+	restoreAdditionalOperations(&built.Model)
 
 	var filtered bool
 	model, filtered, err := filterOutDocument(doc, cfg.Filter)
@@ -42,12 +51,110 @@ func CreateDocument(docContents []byte, cfg Configuration) (libopenapi.Document,
 		if err = pruneSchema(model); err != nil {
 			return nil, fmt.Errorf("error pruning schema: %w", err)
 		}
-		return doc, nil
+	}
+
+	// Only the parts of the spec code is generated from are validated
+	if err = validateSpec(model, cfg.SpecValidation); err != nil {
+		return nil, err
 	}
 
 	return doc, nil
 }
 
+// restoreAdditionalOperations adds the operations of the path items declared with other methods than
+// their fixed fields, in additionalOperations, to the model: libopenapi parses them, but leaves them out
+// of the high-level path items and GetOperations.
+func restoreAdditionalOperations(model *v3high.Document) {
+	if model.Paths == nil || model.Paths.PathItems == nil {
+		return
+	}
+	for _, pathItem := range model.Paths.PathItems.FromOldest() {
+		low := pathItem.GoLow()
+		if low == nil || low.AdditionalOperations.Value == nil || pathItem.AdditionalOperations != nil {
+			continue
+		}
+
+		ops := orderedmap.New[string, *v3high.Operation]()
+		for key, op := range low.AdditionalOperations.Value.FromOldest() {
+			ops.Set(key.Value, v3high.NewOperation(op.Value))
+		}
+		pathItem.AdditionalOperations = ops
+		// GetOperations skips the additional operations of a low-level path item without nodes
+		low.AdditionalOperations.ValueNode = low.RootNode
+	}
+}
+
+// RenderProcessedSpec renders the OpenAPI document code is generated from, after filtering and pruning,
+// for docs portals, mock servers and other tools to use the same contract.
+// It is rendered as JSON for JSON contents, as YAML otherwise.
+func RenderProcessedSpec(docContents []byte, cfg Configuration) ([]byte, error) {
+	cfg = cfg.WithDefaults()
+
+	doc, err := CreateDocument(docContents, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return renderDocument(doc, docContents)
+}
+
+// renderSpec renders the model as JSON for .json file names, as YAML otherwise.
+func renderSpec(model *v3high.Document, filename string) ([]byte, error) {
+	if strings.EqualFold(filepath.Ext(filename), ".json") {
+		return model.RenderJSON("  ")
+	}
+	return model.Render()
+}
+
+// embeddedSpecLineLength is the length of the base64 strings the embedded spec is split into.
+const embeddedSpecLineLength = 80
+
+// encodeEmbeddedSpec renders the model as compact JSON, gzipped and base64 encoded in strings short enough
+// for the generated code to stay readable, decoded by runtime.NewEmbeddedSpec.
+func encodeEmbeddedSpec(model *v3high.Document) ([]string, error) {
+	rendered, err := model.RenderJSON("")
+	if err != nil {
+		return nil, err
+	}
+	var compact bytes.Buffer
+	if err = json.Compact(&compact, rendered); err != nil {
+		return nil, err
+	}
+
+	var compressed bytes.Buffer
+	zw, err := gzip.NewWriterLevel(&compressed, gzip.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = zw.Write(compact.Bytes()); err != nil {
+		return nil, err
+	}
+	if err = zw.Close(); err != nil {
+		return nil, err
+	}
+
+	encoded := base64.StdEncoding.EncodeToString(compressed.Bytes())
+	lines := make([]string, 0, len(encoded)/embeddedSpecLineLength+1)
+	for len(encoded) > embeddedSpecLineLength {
+		lines = append(lines, encoded[:embeddedSpecLineLength])
+		encoded = encoded[embeddedSpecLineLength:]
+	}
+	return append(lines, encoded), nil
+}
+
+// renderDocument renders the document as JSON for JSON contents, as YAML otherwise.
+func renderDocument(doc libopenapi.Document, docContents []byte) ([]byte, error) {
+	model, err := doc.BuildV3Model()
+	if err != nil {
+		return nil, fmt.Errorf("error building model: %w", err)
+	}
+
+	if json.Valid(docContents) {
+		return model.Model.RenderJSON("  ")
+	}
+	return model.Model.Render()
+}
+
 func LoadDocumentFromContents(contents []byte) (libopenapi.Document, error) {
 	docConfig := &datamodel.DocumentConfiguration{
 		SkipCircularReferenceCheck: true,
@@ -0,0 +1,157 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.yaml.in/yaml/v4"
+)
+
+func TestRenderProcessedSpec(t *testing.T) {
+	t.Run("filtered and pruned yaml", func(t *testing.T) {
+		cfg := Configuration{
+			Filter: FilterConfig{
+				Exclude: FilterParamsConfig{Tags: []string{"dog"}},
+			},
+		}
+
+		out, err := RenderProcessedSpec([]byte(readTestdata(t, "prune-cat-dog.yml")), cfg)
+		require.NoError(t, err)
+
+		var doc struct {
+			Paths      map[string]any `yaml:"paths"`
+			Components struct {
+				Schemas map[string]any `yaml:"schemas"`
+			} `yaml:"components"`
+		}
+		require.NoError(t, yaml.Unmarshal(out, &doc))
+		assert.Contains(t, doc.Paths, "/cat")
+		assert.NotContains(t, doc.Paths, "/dog")
+		assert.Contains(t, doc.Components.Schemas, "CatAlive")
+		assert.NotContains(t, doc.Components.Schemas, "DogAlive")
+
+		// the processed spec generates the same code
+		expected, err := Generate([]byte(readTestdata(t, "prune-cat-dog.yml")), cfg)
+		require.NoError(t, err)
+		actual, err := Generate(out, Configuration{})
+		require.NoError(t, err)
+		withoutChecksum := func(code string) string {
+			return regexp.MustCompile(`SpecChecksum = ".*"`).ReplaceAllString(code, "")
+		}
+		assert.Equal(t, withoutChecksum(expected.GetCombined()), withoutChecksum(actual.GetCombined()))
+	})
+
+	t.Run("json", func(t *testing.T) {
+		out, err := RenderProcessedSpec([]byte(readTestdata(t, "backslash-escaping.json")), Configuration{})
+		require.NoError(t, err)
+		assert.True(t, json.Valid(out))
+	})
+
+	t.Run("invalid spec", func(t *testing.T) {
+		_, err := RenderProcessedSpec([]byte("not: [a spec"), Configuration{})
+		assert.Error(t, err)
+	})
+}
+
+func TestEmitSpec(t *testing.T) {
+	contents := []byte(readTestdata(t, "prune-cat-dog.yml"))
+	cfg := Configuration{
+		PackageName: "api",
+		Filter: FilterConfig{
+			Exclude: FilterParamsConfig{Tags: []string{"dog"}},
+		},
+		Output: &Output{UseSingleFile: true, EmitSpec: "public-api.yaml"},
+	}
+
+	t.Run("yaml", func(t *testing.T) {
+		codes, err := Generate(contents, cfg)
+		require.NoError(t, err)
+
+		expected, err := RenderProcessedSpec(contents, cfg)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"public-api.yaml": string(expected)}, codes.GetExtraFiles())
+		assert.NotContains(t, codes["public-api.yaml"], "/dog")
+	})
+
+	t.Run("json", func(t *testing.T) {
+		cfg := cfg
+		cfg.Output = &Output{UseSingleFile: true, EmitSpec: "public-api.json"}
+
+		codes, err := Generate(contents, cfg)
+		require.NoError(t, err)
+
+		var doc struct {
+			Paths map[string]any `json:"paths"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(codes["public-api.json"]), &doc))
+		assert.Contains(t, doc.Paths, "/cat")
+		assert.NotContains(t, doc.Paths, "/dog")
+	})
+
+	t.Run("file name without extension", func(t *testing.T) {
+		cfg := cfg
+		cfg.Output = &Output{UseSingleFile: true, EmitSpec: "public-api"}
+
+		_, err := Generate(contents, cfg)
+		assert.EqualError(t, err, `spec file name "public-api" must have an extension`)
+	})
+}
+
+func TestEmbedSpec(t *testing.T) {
+	contents := []byte(readTestdata(t, "prune-cat-dog.yml"))
+	cfg := Configuration{
+		PackageName: "api",
+		Filter: FilterConfig{
+			Exclude: FilterParamsConfig{Tags: []string{"dog"}},
+		},
+		Output:   &Output{UseSingleFile: true},
+		Generate: &GenerateOptions{EmbedSpec: true, SpecUI: "redoc"},
+	}
+
+	codes, err := Generate(contents, cfg)
+	require.NoError(t, err)
+
+	code := codes.GetCombined()
+	assert.Contains(t, code, "var embeddedSpec = runtime.NewEmbeddedSpec([]string{")
+	assert.Contains(t, code, "func GetSwagger() ([]byte, error) {")
+	assert.Contains(t, code, "func ServeSpec(w http.ResponseWriter, r *http.Request) {")
+	assert.Contains(t, code, `return runtime.SpecUIHandler("redoc", "OpenAPI-CodeGen Test", specURL, opts...)`)
+
+	// the embedded spec is the filtered and pruned one
+	model, err := CreateDocument(contents, cfg)
+	require.NoError(t, err)
+	v3, err := model.BuildV3Model()
+	require.NoError(t, err)
+	expected, err := encodeEmbeddedSpec(&v3.Model)
+	require.NoError(t, err)
+	assert.Contains(t, code, `"`+expected[0]+`",`)
+
+	spec, err := runtime.NewEmbeddedSpec(expected).JSON()
+	require.NoError(t, err)
+	var doc struct {
+		Paths map[string]any `json:"paths"`
+	}
+	require.NoError(t, json.Unmarshal(spec, &doc))
+	assert.Contains(t, doc.Paths, "/cat")
+	assert.NotContains(t, doc.Paths, "/dog")
+
+	t.Run("not embedded by default", func(t *testing.T) {
+		codes, err := Generate(contents, Configuration{PackageName: "api"})
+		require.NoError(t, err)
+		assert.NotContains(t, codes.GetCombined(), "GetSwagger")
+	})
+}
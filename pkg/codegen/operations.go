@@ -11,30 +11,44 @@
 package codegen
 
 import (
+	"fmt"
+	"go/token"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
+	"unicode"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/pb33f/libopenapi/datamodel/high/base"
 )
 
 // OperationDefinition describes an Operation.
 // ID The operation_id description from Swagger, used to generate function names.
+// SpecID The operationId as written in the spec, or synthesized from the method and path if the spec doesn't set it.
+// SynthesizedID Whether SpecID was synthesized.
 // Summary string from OpenAPI spec, used to generate a comment.
 // Description string from OpenAPI spec.
 // Method The HTTP method for this operation.
-// Path The path for this operation.
+// Path The path for this operation, prefixed with the server base path.
+// RequestPath The path requested by the client, relative to its base URL.
 // PathParams Parameters in the path
 // Header HTTP headers.
 // Query Query
 // TypeDefinitions These are all the types we need to define for this operation.
 // BodyRequired Whether the body is required for this operation.
 type OperationDefinition struct {
-	ID          string
-	Summary     string
-	Description string
-	Method      string
-	Path        string
-	PathParams  *TypeDefinition
-	Header      *TypeDefinition
-	Query       *RequestParametersDefinition
+	ID            string
+	SpecID        string
+	SynthesizedID bool
+	Summary       string
+	Description   string
+	Method        string
+	Path          string
+	RequestPath   string
+	PathParams    *TypeDefinition
+	Header        *TypeDefinition
+	Query         *RequestParametersDefinition
 
 	TypeDefinitions []TypeDefinition
 	// TODO: check if can be removed
@@ -42,8 +56,92 @@ type OperationDefinition struct {
 
 	Body     *RequestBodyDefinition
 	Response ResponseDefinition
+
+	// IdempotencyKeyHeader is the header the client sets to a generated idempotency key, if any.
+	IdempotencyKeyHeader string
+
+	// Conditional adds the IfMatch and IfNoneMatch request options, sent as the If-Match and If-None-Match headers.
+	Conditional bool
+
+	// Security lists the alternative security requirements of the operation, inherited from the spec if not set.
+	Security []SecurityRequirement
+
+	// Timeout is the timeout set with x-timeout, zero if not set.
+	Timeout time.Duration
+
+	// Dedupe generates a request hash method for the operation, set with x-dedupe.
+	Dedupe bool
+
+	// FeatureFlag is the feature flag gating the operation, set with x-feature-flag.
+	FeatureFlag string
+
+	// FeatureFlagName is the name of the constant holding FeatureFlag, <Op>FeatureFlag unless taken.
+	FeatureFlagName string
+
+	// LogSampleRate is the fraction of the requests of the operation that are logged, set with x-log-sample-rate.
+	// Zero if not set, when all of them are.
+	LogSampleRate float64
+
+	// LogSampleRateName is the name of the constant holding LogSampleRate, <Op>LogSampleRate unless taken.
+	LogSampleRateName string
+
+	// SensitiveParameters are the path, query and header parameters marked with x-sensitive-data,
+	// masked in client logs and errors.
+	SensitiveParameters []SensitiveParameterDefinition
+
+	// SensitiveParametersName is the name of the variable holding SensitiveParameters, <Op>SensitiveParameters unless taken.
+	SensitiveParametersName string
+
+	// OmitValidation leaves the request options and types of the operation out of Validate() generation,
+	// set with x-go-omit-validation.
+	OmitValidation bool
+
+	// Tags are the tags of the operation in the spec.
+	Tags []string
+
+	// Cookies are the cookie parameters of the operation, only generated with ServerBinding.
+	Cookies *TypeDefinition
+
+	// Parameters are the path, query, header and cookie parameters of the operation.
+	Parameters []ParameterDefinition
+
+	// Binding names the request struct and handler generated for servers with generate.server-binding, nil otherwise.
+	Binding *ServerBindingDefinition
+
+	// JSONRPCMethod is the JSON-RPC method called by the operation, set with x-jsonrpc.
+	// Its client method sends the body as params in a JSON-RPC request and returns the result.
+	JSONRPCMethod string
+
+	// Pagination generates the <Op>Pages client method of paginated operations, nil otherwise.
+	Pagination *PaginationDefinition
+
+	// Links generate the Link<Name> client methods of the success response following its links.
+	Links []LinkDefinition
+
+	// pagination is the x-pagination of the operation, nil if not set.
+	pagination *Pagination
 }
 
+// ServerBindingDefinition holds the names of the declarations binding the requests of an operation on the server.
+type ServerBindingDefinition struct {
+	// RequestName is the struct of the bound request, read by Bind<RequestName>.
+	RequestName string
+
+	// HandlerName is the function adapting a handler of the bound request to an http.HandlerFunc.
+	HandlerName string
+}
+
+// SensitiveParameterDefinition is a parameter marked with x-sensitive-data, on the parameter or its schema.
+type SensitiveParameterDefinition struct {
+	In     string
+	Name   string
+	Config *runtime.SensitiveDataConfig
+}
+
+// SecurityRequirement maps the names of the security schemes that must all be satisfied to their required scopes.
+// An empty requirement makes security optional.
+type SecurityRequirement map[string][]string
+
 // RequiresParamObject indicates If we have parameters other than path parameters, they're bundled into an
 // object. Returns true if we have any of those.
 // This is used from the template engine.
@@ -56,7 +154,7 @@ func (o OperationDefinition) SummaryAsComment() string {
 	if o.Summary == "" {
 		return ""
 	}
-	trimmed := strings.TrimSuffix(o.Summary, "\n")
+	trimmed := strings.TrimSuffix(sanitizeComment(o.Summary), "\n")
 	parts := strings.Split(trimmed, "\n")
 	for i, p := range parts {
 		parts[i] = "// " + p
@@ -65,14 +163,286 @@ func (o OperationDefinition) SummaryAsComment() string {
 }
 
 func (o OperationDefinition) GetSuccessResponse() string {
-	if o.Response.SuccessStatusCode == http.StatusNoContent {
+	if o.Response.Success == nil || o.Response.Success.IsNoContent() {
 		return ""
 	}
 	return o.Response.Success.ResponseName
 }
 
 func (o OperationDefinition) HasRequestOptions() bool {
-	return o.PathParams != nil || o.Header != nil || o.Query != nil || o.Body != nil
+	return o.PathParams != nil || o.Header != nil || o.Query != nil || o.Body != nil || o.Conditional
+}
+
+// ServeMuxPattern returns the http.ServeMux pattern routing the operation, e.g. GET /pets/{petId}.
+// Paths ending with a slash only match themselves, not the paths under them.
+func (o OperationDefinition) ServeMuxPattern() string {
+	path := o.Path
+	if strings.HasSuffix(path, "/") {
+		path += "{$}"
+	}
+	return o.Method + " " + path
+}
+
+// BindsBody returns true if the server binding of the operation decodes the request body, which must be JSON.
+// Other bodies are left to be read from the request.
+func (o OperationDefinition) BindsBody() bool {
+	return o.Body != nil && isMediaTypeJson(o.Body.ContentType)
+}
+
+// ParameterBindings returns the Go literal of the map of the runtime.ParameterBinding of the parameters in a location,
+// nil if they are all read with the defaults.
+func (o OperationDefinition) ParameterBindings(in string) string {
+	var entries []string
+	for _, param := range o.Parameters {
+		if param.In != in {
+			continue
+		}
+		if binding := param.binding(); binding != "" {
+			entries = append(entries, fmt.Sprintf("%q: {%s},", param.ParamName, binding))
+		}
+	}
+	if len(entries) == 0 {
+		return "nil"
+	}
+	return "map[string]runtime.ParameterBinding{\n" + strings.Join(entries, "\n") + "\n}"
+}
+
+// operationIdempotencyKeyHeader returns the header used to send an idempotency key for the operation.
+// x-idempotency-key takes precedence, otherwise enabledByDefault adds it to POST and PATCH operations,
+// the methods that are not idempotent by definition.
+func operationIdempotencyKeyHeader(method string, extensions map[string]any, enabledByDefault bool) (string, error) {
+	if v, ok := extensions[extIdempotencyKey]; ok {
+		header, err := extParseIdempotencyKey(v)
+		if err != nil {
+			return "", fmt.Errorf("invalid %s: %w", extIdempotencyKey, err)
+		}
+		return header, nil
+	}
+
+	switch strings.ToUpper(method) {
+	case http.MethodPost, http.MethodPatch:
+		if enabledByDefault {
+			return defaultIdempotencyKeyHeader, nil
+		}
+	}
+	return "", nil
+}
+
+// operationConditional reports whether the request options of an operation have IfMatch and IfNoneMatch fields:
+// for POST, PUT, PATCH and DELETE operations with generate.conditional-requests, unless they declare
+// the If-Match or If-None-Match header parameters, set with the other headers.
+func operationConditional(method string, params []ParameterDefinition, enabled bool) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+	default:
+		return false
+	}
+	if !enabled {
+		return false
+	}
+	for _, param := range params {
+		if param.In == "header" && (strings.EqualFold(param.ParamName, "If-Match") || strings.EqualFold(param.ParamName, "If-None-Match")) {
+			return false
+		}
+	}
+	return true
+}
+
+// operationSecurity returns the security requirements of an operation, falling back to the global ones.
+// An operation with an empty security list has no security requirements.
+func operationSecurity(operation, global []*base.SecurityRequirement) []SecurityRequirement {
+	reqs := operation
+	if reqs == nil {
+		reqs = global
+	}
+
+	res := make([]SecurityRequirement, 0, len(reqs))
+	for _, req := range reqs {
+		if req == nil {
+			continue
+		}
+		requirement := SecurityRequirement{}
+		if req.Requirements != nil {
+			for scheme, scopes := range req.Requirements.FromOldest() {
+				if scopes == nil {
+					scopes = []string{}
+				}
+				requirement[scheme] = scopes
+			}
+		}
+		res = append(res, requirement)
+	}
+	return res
+}
+
+// operationTimeout returns the timeout set with x-timeout, zero if not set.
+func operationTimeout(extensions map[string]any) (time.Duration, error) {
+	v, ok := extensions[extTimeout]
+	if !ok {
+		return 0, nil
+	}
+	timeout, err := extParseTimeout(v)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", extTimeout, err)
+	}
+	return timeout, nil
+}
+
+// operationDedupe reports whether x-dedupe is set for the operation.
+func operationDedupe(extensions map[string]any) (bool, error) {
+	v, ok := extensions[extDedupe]
+	if !ok {
+		return false, nil
+	}
+	dedupe, err := parseBooleanValue(v)
+	if err != nil {
+		return false, fmt.Errorf("invalid %s: %w", extDedupe, err)
+	}
+	return dedupe, nil
+}
+
+// operationSensitiveParameters returns the path, query and header parameters marked with x-sensitive-data.
+func operationSensitiveParameters(params []ParameterDefinition) ([]SensitiveParameterDefinition, error) {
+	var res []SensitiveParameterDefinition
+	for _, param := range params {
+		value, ok := extractExtensions(param.Spec.Extensions)[extSensitiveData]
+		if !ok && param.Spec.Schema != nil {
+			if schema := param.Spec.Schema.Schema(); schema != nil {
+				value, ok = extractExtensions(schema.Extensions)[extSensitiveData]
+			}
+		}
+		if !ok {
+			continue
+		}
+		if param.In == "cookie" {
+			return nil, fmt.Errorf("%s is not supported on cookie parameter %s", extSensitiveData, param.ParamName)
+		}
+
+		config, err := extParseSensitiveData(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s of parameter %s: %w", extSensitiveData, param.ParamName, err)
+		}
+		res = append(res, SensitiveParameterDefinition{In: param.In, Name: param.ParamName, Config: config})
+	}
+	return res, nil
+}
+
+// operationLogSampleRate returns the log sample rate set with x-log-sample-rate, zero if not set.
+func operationLogSampleRate(extensions map[string]any) (float64, error) {
+	v, ok := extensions[extLogSampleRate]
+	if !ok {
+		return 0, nil
+	}
+	rate, err := extParseLogSampleRate(v)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", extLogSampleRate, err)
+	}
+	return rate, nil
+}
+
+// LogSampleRateLiteral returns the Go literal of the log sample rate of the operation.
+func (o OperationDefinition) LogSampleRateLiteral() string {
+	return strconv.FormatFloat(o.LogSampleRate, 'g', -1, 64)
+}
+
+// operationFeatureFlag returns the feature flag set with x-feature-flag, empty if not set.
+func operationFeatureFlag(extensions map[string]any) (string, error) {
+	v, ok := extensions[extFeatureFlag]
+	if !ok {
+		return "", nil
+	}
+	flag, err := parseString(v)
+	if err == nil && flag == "" {
+		err = fmt.Errorf("flag name must not be empty")
+	}
+	if err != nil {
+		return "", fmt.Errorf("invalid %s: %w", extFeatureFlag, err)
+	}
+	return flag, nil
+}
+
+// operationHTTPMethod returns the HTTP method of an operation declared with the method key of its path item:
+// the x-custom-method override if set, the upper-cased method for the fixed fields of path items,
+// and the key itself for additionalOperations, sent as written.
+func operationHTTPMethod(method string, extensions map[string]any) (string, error) {
+	if v, ok := extensions[extCustomMethod]; ok {
+		custom, err := parseString(v)
+		if err == nil && !isHTTPToken(custom) {
+			err = fmt.Errorf("%q is not an HTTP method", custom)
+		}
+		if err != nil {
+			return "", fmt.Errorf("invalid %s: %w", extCustomMethod, err)
+		}
+		return custom, nil
+	}
+
+	switch lower := strings.ToLower(method); lower {
+	case "get", "put", "post", "delete", "options", "head", "patch", "trace", "query":
+		return strings.ToUpper(lower), nil
+	}
+	if !isHTTPToken(method) {
+		return "", fmt.Errorf("%q is not an HTTP method", method)
+	}
+	return method, nil
+}
+
+// isHTTPToken reports whether s is a token, the syntax of HTTP methods (RFC 9110, section 5.6.2).
+func isHTTPToken(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r > unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("!#$%&'*+-.^_`|~", r)) {
+			return false
+		}
+	}
+	return true
+}
+
+// operationJSONRPCMethod returns the JSON-RPC method set with x-jsonrpc, empty if not set.
+// The operation must be a POST with a JSON body, if any, and a JSON success response.
+func operationJSONRPCMethod(extensions map[string]any, method string, body *RequestBodyDefinition, response ResponseDefinition) (string, error) {
+	v, ok := extensions[extJSONRPC]
+	if !ok {
+		return "", nil
+	}
+	rpcMethod, err := parseString(v)
+	switch {
+	case err != nil:
+	case rpcMethod == "":
+		err = fmt.Errorf("method name must not be empty")
+	case method != http.MethodPost:
+		err = fmt.Errorf("method %q must be called with POST, not %s", rpcMethod, method)
+	case body != nil && !isMediaTypeJson(body.ContentType):
+		err = fmt.Errorf("method %q must have JSON params, not %s", rpcMethod, body.ContentType)
+	case response.Success == nil || !response.Success.HasBody() || response.Success.IsStream ||
+		!isMediaTypeJson(response.Success.ContentType):
+		err = fmt.Errorf("method %q must have a JSON success response for its result", rpcMethod)
+	}
+	if err != nil {
+		return "", fmt.Errorf("invalid %s: %w", extJSONRPC, err)
+	}
+	return rpcMethod, nil
+}
+
+// JSONRPCPath returns the path the client sends the JSON-RPC requests of the operation to: its request path
+// without the fragment, e.g. /rpc for /rpc#pets.get, distinguishing the methods of the endpoint in the spec.
+func (o OperationDefinition) JSONRPCPath() string {
+	path, _, _ := strings.Cut(o.RequestPath, "#")
+	return path
+}
+
+// operationOmitValidation returns the value of x-go-omit-validation of an operation.
+func operationOmitValidation(extensions map[string]any) (bool, error) {
+	v, ok := extensions[extGoOmitValidation]
+	if !ok {
+		return false, nil
+	}
+	omit, err := parseBooleanValue(v)
+	if err != nil {
+		return false, fmt.Errorf("invalid %s: %w", extGoOmitValidation, err)
+	}
+	return omit, nil
 }
 
 // filterParameterDefinitionByType returns the subset of the specified parameters which are of the
@@ -113,3 +483,95 @@ func createOperationID(method, path, initial string) (string, error) {
 
 	return nameNormalizer(res), nil
 }
+
+// operationGoName returns the x-go-operation-name of an operation, which must be an exported Go identifier,
+// or the name created from its operationId, or from its method and path without one.
+func operationGoName(method, path, operationID string, extensions map[string]any) (string, error) {
+	v, ok := extensions[extGoOperationName]
+	if !ok {
+		return createOperationID(method, path, operationID)
+	}
+	name, err := parseString(v)
+	if err == nil && (!token.IsIdentifier(name) || !token.IsExported(name)) {
+		err = fmt.Errorf("%q is not an exported Go identifier", name)
+	}
+	if err != nil {
+		return "", fmt.Errorf("invalid %s: %w", extGoOperationName, err)
+	}
+	return name, nil
+}
+
+// synthesizeOperationID returns an operationId for an operation the spec doesn't set it on, from its method
+// and path, in camel, snake or kebab casing, e.g. getPetsByPetId, get_pets_by_pet_id or get-pets-by-pet-id
+// for GET /pets/{petId}. The path parameters are prefixed with "by" so /pets/{id} and /pets/id don't collide.
+func synthesizeOperationID(method, path, casing string) string {
+	words := []string{strings.ToLower(method)}
+	for _, segment := range pathSegments(path) {
+		if isPathParam(segment) {
+			words = append(words, "by")
+		}
+		words = append(words, splitWords(segment)...)
+	}
+
+	switch casing {
+	case "snake":
+		return strings.Join(words, "_")
+	case "kebab":
+		return strings.Join(words, "-")
+	}
+	for i := 1; i < len(words); i++ {
+		words[i] = UppercaseFirstCharacter(words[i])
+	}
+	return strings.Join(words, "")
+}
+
+// splitWords splits s into lowercase words on the characters other than letters and digits,
+// and before the uppercase letters following lowercase ones, e.g. pet and id for {petId}.
+func splitWords(s string) []string {
+	var (
+		words []string
+		word  []rune
+		prev  rune
+	)
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) || unicode.IsUpper(r) && unicode.IsLower(prev) {
+			if len(word) > 0 {
+				words = append(words, string(word))
+			}
+			word = word[:0]
+		}
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			word = append(word, unicode.ToLower(r))
+		}
+		prev = r
+	}
+	if len(word) > 0 {
+		words = append(words, string(word))
+	}
+	return words
+}
+
+// serverBasePath returns the base path the handlers are mounted under, empty if not set.
+func serverBasePath(server *Server) string {
+	if server == nil {
+		return ""
+	}
+	return server.BasePath
+}
+
+// clientBasePath returns the base path of the paths requested by the client.
+func clientBasePath(options ParseOptions) string {
+	if options.StripBasePath {
+		return ""
+	}
+	return options.BasePath
+}
+
+// joinBasePath prepends a base path like /api/v2 to the path of an operation.
+func joinBasePath(basePath, path string) string {
+	basePath = strings.Trim(basePath, "/")
+	if basePath == "" {
+		return path
+	}
+	return "/" + basePath + "/" + strings.TrimPrefix(path, "/")
+}
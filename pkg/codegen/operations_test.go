@@ -90,3 +90,242 @@ func TestCreateOperationID(t *testing.T) {
 		}
 	}
 }
+
+func TestOperationIdempotencyKeyHeader(t *testing.T) {
+	tests := []struct {
+		name             string
+		method           string
+		extensions       map[string]any
+		enabledByDefault bool
+		want             string
+		wantErr          bool
+	}{
+		{name: "disabled", method: http.MethodPost},
+		{name: "enabled for POST", method: http.MethodPost, enabledByDefault: true, want: "Idempotency-Key"},
+		{name: "enabled for PATCH", method: "patch", enabledByDefault: true, want: "Idempotency-Key"},
+		{name: "not for PUT", method: http.MethodPut, enabledByDefault: true},
+		{name: "extension opts in", method: http.MethodPut, extensions: map[string]any{"x-idempotency-key": "true"}, want: "Idempotency-Key"},
+		{name: "extension opts out", method: http.MethodPost, extensions: map[string]any{"x-idempotency-key": "false"}, enabledByDefault: true},
+		{name: "extension sets header", method: http.MethodPost, extensions: map[string]any{"x-idempotency-key": "X-Request-Key"}, want: "X-Request-Key"},
+		{name: "empty header", method: http.MethodPost, extensions: map[string]any{"x-idempotency-key": ""}, wantErr: true},
+		{name: "invalid value", method: http.MethodPost, extensions: map[string]any{"x-idempotency-key": map[string]any{}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := operationIdempotencyKeyHeader(tt.method, tt.extensions, tt.enabledByDefault)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %q", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("want %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestOperationDedupe(t *testing.T) {
+	tests := []struct {
+		name       string
+		extensions map[string]any
+		want       bool
+		wantErr    bool
+	}{
+		{name: "not set"},
+		{name: "enabled", extensions: map[string]any{"x-dedupe": true}, want: true},
+		{name: "enabled as string", extensions: map[string]any{"x-dedupe": "true"}, want: true},
+		{name: "disabled", extensions: map[string]any{"x-dedupe": false}},
+		{name: "invalid value", extensions: map[string]any{"x-dedupe": "always"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := operationDedupe(tt.extensions)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("want %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestSynthesizeOperationID(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string
+		casing string
+		want   string
+	}{
+		{method: http.MethodGet, path: "/pets/{petId}", want: "getPetsByPetId"},
+		{method: http.MethodGet, path: "/pets/{petId}", casing: "camel", want: "getPetsByPetId"},
+		{method: http.MethodGet, path: "/pets/{petId}", casing: "snake", want: "get_pets_by_pet_id"},
+		{method: http.MethodGet, path: "/pets/{petId}", casing: "kebab", want: "get-pets-by-pet-id"},
+		{method: http.MethodGet, path: "/pets/id/toys", want: "getPetsIdToys"},
+		{method: http.MethodDelete, path: "/v1/pets:batch-delete/", want: "deleteV1PetsBatchDelete"},
+		{method: http.MethodGet, path: "/reports/{report_id}.json", casing: "snake", want: "get_reports_by_report_id_json"},
+		{method: http.MethodGet, path: "/", want: "get"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path+" "+tt.casing, func(t *testing.T) {
+			if got := synthesizeOperationID(tt.method, tt.path, tt.casing); got != tt.want {
+				t.Fatalf("want %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestOperationGoName(t *testing.T) {
+	tests := []struct {
+		name        string
+		operationID string
+		extensions  map[string]any
+		want        string
+		wantErr     bool
+	}{
+		{name: "from the path", want: "GetPetsPetID"},
+		{name: "from the synthesized operationId", operationID: "get_pets_by_pet_id", want: "GetPetsByPetID"},
+		{name: "from the synthesized camel operationId", operationID: "getPetsByPetId", want: "GetPetsByPetID"},
+		{name: "from the synthesized kebab operationId", operationID: "get-pets-by-pet-id", want: "GetPetsByPetID"},
+		{name: "from the operationId", operationID: "showPetById", want: "ShowPetByID"},
+		{name: "extension", operationID: "showPetById", extensions: map[string]any{"x-go-operation-name": "GetPet"}, want: "GetPet"},
+		{name: "extension without operationId", extensions: map[string]any{"x-go-operation-name": "GetPet"}, want: "GetPet"},
+		{name: "unexported", extensions: map[string]any{"x-go-operation-name": "getPet"}, wantErr: true},
+		{name: "not an identifier", extensions: map[string]any{"x-go-operation-name": "Get-Pet"}, wantErr: true},
+		{name: "invalid value", extensions: map[string]any{"x-go-operation-name": []any{"GetPet"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := operationGoName(http.MethodGet, "/pets/{petId}", tt.operationID, tt.extensions)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("want %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestOperationJSONRPCMethod(t *testing.T) {
+	jsonBody := &RequestBodyDefinition{ContentType: "application/json"}
+	jsonResponse := ResponseDefinition{Success: &ResponseContentDefinition{ResponseName: "GetPetResponse", ContentType: "application/json"}}
+	rpc := map[string]any{"x-jsonrpc": "pets.get"}
+
+	tests := []struct {
+		name       string
+		extensions map[string]any
+		method     string
+		body       *RequestBodyDefinition
+		response   ResponseDefinition
+		want       string
+		wantErr    string
+	}{
+		{name: "not set", method: "POST", response: jsonResponse},
+		{name: "with params", extensions: rpc, method: "POST", body: jsonBody, response: jsonResponse, want: "pets.get"},
+		{name: "without params", extensions: rpc, method: "POST", response: jsonResponse, want: "pets.get"},
+		{
+			name: "empty", extensions: map[string]any{"x-jsonrpc": ""}, method: "POST", response: jsonResponse,
+			wantErr: "invalid x-jsonrpc: method name must not be empty",
+		},
+		{
+			name: "not a post", extensions: rpc, method: "GET", response: jsonResponse,
+			wantErr: `invalid x-jsonrpc: method "pets.get" must be called with POST, not GET`,
+		},
+		{
+			name: "form params", extensions: rpc, method: "POST", body: &RequestBodyDefinition{ContentType: "application/x-www-form-urlencoded"}, response: jsonResponse,
+			wantErr: `invalid x-jsonrpc: method "pets.get" must have JSON params, not application/x-www-form-urlencoded`,
+		},
+		{
+			name: "no result", extensions: rpc, method: "POST",
+			wantErr: `invalid x-jsonrpc: method "pets.get" must have a JSON success response for its result`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := operationJSONRPCMethod(tt.extensions, tt.method, tt.body, tt.response)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("want error %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("want %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestOperationHTTPMethod(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		extensions map[string]any
+		want       string
+		wantErr    string
+	}{
+		{name: "fixed field", method: "get", want: "GET"},
+		{name: "query", method: "query", want: "QUERY"},
+		{name: "additional operation", method: "PURGE", want: "PURGE"},
+		{name: "additional operation as written", method: "Purge", want: "Purge"},
+		{name: "extension", method: "post", extensions: map[string]any{"x-custom-method": "QUERY"}, want: "QUERY"},
+		{name: "not a token", method: "PURGE ALL", wantErr: `"PURGE ALL" is not an HTTP method`},
+		{
+			name: "invalid extension", method: "post", extensions: map[string]any{"x-custom-method": ""},
+			wantErr: `invalid x-custom-method: "" is not an HTTP method`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := operationHTTPMethod(tt.method, tt.extensions)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("want error %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("want %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestOperationDefinition_JSONRPCPath(t *testing.T) {
+	if got := (OperationDefinition{RequestPath: "/api/rpc#pets.get"}).JSONRPCPath(); got != "/api/rpc" {
+		t.Fatalf("want /api/rpc, got %v", got)
+	}
+	if got := (OperationDefinition{RequestPath: "/rpc"}).JSONRPCPath(); got != "/rpc" {
+		t.Fatalf("want /rpc, got %v", got)
+	}
+}
@@ -0,0 +1,402 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+)
+
+const (
+	paginationCursor = "cursor"
+	paginationOffset = "offset"
+	paginationPage   = "page"
+	paginationLink   = "link"
+	paginationNone   = "none"
+)
+
+// Names of the query parameters and response properties matching common pagination patterns,
+// lowercased without separators, in order of preference.
+var (
+	cursorParamNames   = []string{"cursor", "pagetoken", "nexttoken", "continuationtoken", "pagecursor", "after", "startingafter"}
+	nextCursorNames    = []string{"nextcursor", "nextpagetoken", "nexttoken", "continuationtoken", "next", "cursor"}
+	offsetParamNames   = []string{"offset", "skip"}
+	pageParamNames     = []string{"page", "pagenumber", "pagenum"}
+	itemsPropertyNames = []string{"items", "data", "results", "records", "entries", "values"}
+)
+
+// PaginationDefinition is the resolved pagination of an operation, generating its <Op>Pages client method,
+// and its <Op>All client method when the results are known.
+type PaginationDefinition struct {
+	// Style is cursor, offset, page or link.
+	Style string
+
+	// Param is the query parameter property of the cursor, offset or page number.
+	Param Property
+
+	// Next is the response property with the cursor of the next page, for the cursor style.
+	Next Property
+
+	// Items is the array response property with the results, unset when the response is the array of results.
+	Items Property
+
+	// ItemType is the type of the results, empty if the response has no array of them.
+	ItemType string
+}
+
+// ItemsOf returns the expression of the results of the page variable.
+func (p PaginationDefinition) ItemsOf(page string) string {
+	if p.Items.GoName == "" {
+		return "*" + page
+	}
+	return page + "." + p.Items.GoName
+}
+
+// ParamPointer returns whether the query parameter is declared as a pointer.
+func (p PaginationDefinition) ParamPointer() bool {
+	return strings.HasPrefix(p.Param.GoTypeDef(), "*")
+}
+
+// ParamType returns the type of the query parameter, without the pointer.
+func (p PaginationDefinition) ParamType() string {
+	return strings.TrimPrefix(p.Param.GoTypeDef(), "*")
+}
+
+// NextPointer returns whether the next cursor response property is declared as a pointer.
+func (p PaginationDefinition) NextPointer() bool {
+	return strings.HasPrefix(p.Next.GoTypeDef(), "*")
+}
+
+func (p Pagination) validateStyle() error {
+	switch p.Style {
+	case "", paginationCursor, paginationOffset, paginationPage, paginationLink, paginationNone:
+		return nil
+	}
+	return fmt.Errorf("unknown pagination style %q, expected one of cursor, offset, page, link or none", p.Style)
+}
+
+// overrideWith returns p with the fields set in other.
+func (p Pagination) overrideWith(other Pagination) Pagination {
+	if other.Style != "" {
+		p.Style = other.Style
+	}
+	if other.Param != "" {
+		p.Param = other.Param
+	}
+	if other.Next != "" {
+		p.Next = other.Next
+	}
+	if other.Items != "" {
+		p.Items = other.Items
+	}
+	return p
+}
+
+// operationPagination returns the x-pagination of an operation, nil if not set.
+func operationPagination(extensions map[string]any) (*Pagination, error) {
+	v, ok := extensions[extPagination]
+	if !ok {
+		return nil, nil
+	}
+	if enabled, err := parseBooleanValue(v); err == nil {
+		if enabled {
+			return &Pagination{}, nil
+		}
+		return &Pagination{Style: paginationNone}, nil
+	}
+
+	fields, ok := v.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid %s: expected a boolean or an object, got %T", extPagination, v)
+	}
+	res := &Pagination{}
+	for key, value := range fields {
+		s, err := parseString(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %s: %w", extPagination, key, err)
+		}
+		switch key {
+		case "style":
+			res.Style = s
+		case "param":
+			res.Param = s
+		case "next":
+			res.Next = s
+		case "items":
+			res.Items = s
+		default:
+			return nil, fmt.Errorf("invalid %s: unknown field %q", extPagination, key)
+		}
+	}
+	if err := res.validateStyle(); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", extPagination, err)
+	}
+	return res, nil
+}
+
+// resolvePagination sets the pagination of the operations, from generate.pagination.operations, x-pagination,
+// or detected from their query parameters, response properties and Link response header. Detected paginations only generate pagers
+// with generate.pagination.detect, and are reported as warnings otherwise.
+func resolvePagination(operations []OperationDefinition, typeDefs []TypeDefinition, options PaginationOptions) ([]OperationDefinition, error) {
+	schemas := make(map[string]GoSchema, len(typeDefs))
+	for _, td := range typeDefs {
+		schemas[td.Name] = td.Schema
+	}
+
+	var errs []error
+	configured := make(map[string]bool, len(options.Operations))
+	for i, op := range operations {
+		params := paginationParams(op)
+		schema := paginationResponseSchema(op, schemas)
+		detected := detectPagination(params, schema.Properties)
+		if detected == nil && hasLinkHeader(op) {
+			detected = &Pagination{Style: paginationLink}
+		}
+
+		override, ok := options.Operations[op.SpecID]
+		configured[op.SpecID] = ok
+		if !ok && op.pagination == nil {
+			if detected == nil {
+				continue
+			}
+			if !options.Detect {
+				slog.Warn(fmt.Sprintf("operation %s looks %s-paginated by the %s query parameter, set generate.pagination.detect or x-pagination to generate %sPages",
+					op.SpecID, detected.Style, detected.Param, op.ID))
+				continue
+			}
+		}
+
+		var pagination Pagination
+		if detected != nil {
+			pagination = *detected
+		}
+		if op.pagination != nil {
+			pagination = pagination.overrideWith(*op.pagination)
+		}
+		pagination = pagination.overrideWith(override)
+		if pagination.Style == paginationNone {
+			continue
+		}
+
+		def, err := newPaginationDefinition(pagination, params, schema)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error in the pagination of operation %s: %w", op.SpecID, err))
+			continue
+		}
+		operations[i].Pagination = def
+	}
+
+	for _, id := range sortedMapKeys(options.Operations) {
+		if !configured[id] {
+			slog.Warn(fmt.Sprintf("generate.pagination.operations.%s matches no operation", id))
+		}
+	}
+
+	return operations, errors.Join(errs...)
+}
+
+// newPaginationDefinition checks the pagination against the query parameters and response schema
+// of the operation.
+func newPaginationDefinition(pagination Pagination, params []Property, schema GoSchema) (*PaginationDefinition, error) {
+	if pagination.Style == "" {
+		return nil, errors.New("no pagination detected, set its style")
+	}
+
+	res := &PaginationDefinition{Style: pagination.Style}
+	if pagination.Style != paginationLink {
+		if pagination.Param == "" {
+			return nil, errors.New("no query parameter detected, set its param")
+		}
+		param, ok := findProperty(params, pagination.Param)
+		if !ok {
+			return nil, fmt.Errorf("no query parameter %q", pagination.Param)
+		}
+		res.Param = param
+	}
+
+	props := schema.Properties
+	switch pagination.Style {
+	case paginationCursor:
+		if !isStringProperty(res.Param) {
+			return nil, fmt.Errorf("query parameter %q is not a string", pagination.Param)
+		}
+		if pagination.Next == "" {
+			return nil, errors.New("no next cursor detected in the response, set its next property")
+		}
+		next, ok := findProperty(props, pagination.Next)
+		if !ok || !isStringProperty(next) {
+			return nil, fmt.Errorf("no string response property %q", pagination.Next)
+		}
+		res.Next = next
+	case paginationOffset, paginationPage:
+		if !isIntegerProperty(res.Param) {
+			return nil, fmt.Errorf("query parameter %q is not an integer", pagination.Param)
+		}
+	}
+
+	// the offset and page styles stop at an empty page, the others only use the results for <Op>All
+	switch {
+	case pagination.Items != "":
+		items, ok := findProperty(props, pagination.Items)
+		if !ok || !isArrayProperty(items) {
+			return nil, fmt.Errorf("no array response property %q", pagination.Items)
+		}
+		res.Items = items
+		res.ItemType = strings.TrimPrefix(items.GoTypeDef(), "[]")
+	case schema.ArrayType != nil:
+		res.ItemType = schema.ArrayType.TypeDecl()
+	case pagination.Style == paginationCursor || pagination.Style == paginationLink:
+		if items, ok := detectItems(props); ok {
+			res.Items = items
+			res.ItemType = strings.TrimPrefix(items.GoTypeDef(), "[]")
+		}
+	default:
+		return nil, errors.New("no array of results detected in the response, set its items property")
+	}
+	return res, nil
+}
+
+// detectPagination returns the pagination matching common patterns of the query parameters and response properties
+// of an operation, nil if none does.
+func detectPagination(params, props []Property) *Pagination {
+	if len(params) == 0 || len(props) == 0 {
+		return nil
+	}
+
+	if param, ok := findPropertyNamed(params, cursorParamNames, isStringProperty); ok {
+		if next, ok := findPropertyNamed(props, nextCursorNames, isStringProperty); ok {
+			return &Pagination{Style: paginationCursor, Param: param.JsonFieldName, Next: next.JsonFieldName}
+		}
+	}
+
+	items, ok := detectItems(props)
+	if !ok {
+		return nil
+	}
+	if param, ok := findPropertyNamed(params, offsetParamNames, isIntegerProperty); ok {
+		return &Pagination{Style: paginationOffset, Param: param.JsonFieldName, Items: items.JsonFieldName}
+	}
+	if param, ok := findPropertyNamed(params, pageParamNames, isIntegerProperty); ok {
+		return &Pagination{Style: paginationPage, Param: param.JsonFieldName, Items: items.JsonFieldName}
+	}
+	return nil
+}
+
+// detectItems returns the array response property with the results: the first with a common name,
+// or the only one.
+func detectItems(props []Property) (Property, bool) {
+	if items, ok := findPropertyNamed(props, itemsPropertyNames, isArrayProperty); ok {
+		return items, true
+	}
+	var arrays []Property
+	for _, p := range props {
+		if isArrayProperty(p) {
+			arrays = append(arrays, p)
+		}
+	}
+	if len(arrays) != 1 {
+		return Property{}, false
+	}
+	return arrays[0], true
+}
+
+// hasLinkHeader returns whether the success response of an operation declares a Link header.
+func hasLinkHeader(op OperationDefinition) bool {
+	if op.Response.Success == nil {
+		return false
+	}
+	for name := range op.Response.Success.Headers {
+		if strings.EqualFold(name, "Link") {
+			return true
+		}
+	}
+	return false
+}
+
+// paginationParams returns the query parameter properties of an operation.
+func paginationParams(op OperationDefinition) []Property {
+	if op.Query == nil {
+		return nil
+	}
+	return op.Query.TypeDef.Schema.Properties
+}
+
+// paginationResponseSchema returns the schema of the JSON object or array returned by an operation,
+// an empty schema for other responses.
+func paginationResponseSchema(op OperationDefinition, schemas map[string]GoSchema) GoSchema {
+	success := op.Response.Success
+	if success == nil || success.IsStream || success.IsNoContent() || !isMediaTypeJson(success.ContentType) {
+		return GoSchema{}
+	}
+
+	// responses referencing a component are declared with its type
+	schema := success.Schema
+	for seen := map[string]bool{}; len(schema.Properties) == 0 && schema.ArrayType == nil; {
+		name := schema.TypeDecl()
+		next, ok := schemas[name]
+		if !ok || seen[name] {
+			break
+		}
+		seen[name] = true
+		schema = next
+	}
+	return schema
+}
+
+// findProperty returns the property with the JSON name.
+func findProperty(props []Property, name string) (Property, bool) {
+	i := slices.IndexFunc(props, func(p Property) bool {
+		return p.JsonFieldName == name
+	})
+	if i < 0 {
+		return Property{}, false
+	}
+	return props[i], true
+}
+
+// findPropertyNamed returns the first of the names, compared lowercased without separators,
+// with a property matching ok.
+func findPropertyNamed(props []Property, names []string, ok func(Property) bool) (Property, bool) {
+	for _, name := range names {
+		for _, p := range props {
+			if normalizePaginationName(p.JsonFieldName) == name && ok(p) {
+				return p, true
+			}
+		}
+	}
+	return Property{}, false
+}
+
+func normalizePaginationName(name string) string {
+	return strings.ToLower(strings.NewReplacer("_", "", "-", "", ".", "").Replace(name))
+}
+
+// isStringProperty returns whether the property is declared as a string or a pointer to one.
+func isStringProperty(p Property) bool {
+	return strings.TrimPrefix(p.GoTypeDef(), "*") == "string"
+}
+
+// isIntegerProperty returns whether the property is declared as an integer or a pointer to one.
+func isIntegerProperty(p Property) bool {
+	switch strings.TrimPrefix(p.GoTypeDef(), "*") {
+	case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64":
+		return true
+	}
+	return false
+}
+
+// isArrayProperty returns whether the property is declared as a slice.
+func isArrayProperty(p Property) bool {
+	return strings.HasPrefix(p.GoTypeDef(), "[]")
+}
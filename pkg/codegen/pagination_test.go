@@ -0,0 +1,180 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"go/format"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectPagination(t *testing.T) {
+	prop := func(name, goType string) Property {
+		return Property{JsonFieldName: name, Schema: GoSchema{GoType: goType}}
+	}
+
+	tests := []struct {
+		name   string
+		params []Property
+		props  []Property
+		want   *Pagination
+	}{
+		{
+			name:   "cursor",
+			params: []Property{prop("limit", "*int"), prop("page_token", "*string")},
+			props:  []Property{prop("items", "[]Pet"), prop("next_page_token", "*string")},
+			want:   &Pagination{Style: "cursor", Param: "page_token", Next: "next_page_token"},
+		},
+		{
+			name:   "offset",
+			params: []Property{prop("offset", "*int64")},
+			props:  []Property{prop("total", "int"), prop("data", "[]Pet")},
+			want:   &Pagination{Style: "offset", Param: "offset", Items: "data"},
+		},
+		{
+			name:   "page with the only array",
+			params: []Property{prop("pageNumber", "int")},
+			props:  []Property{prop("pets", "[]Pet")},
+			want:   &Pagination{Style: "page", Param: "pageNumber", Items: "pets"},
+		},
+		{
+			name:   "cursor without next cursor",
+			params: []Property{prop("cursor", "*string")},
+			props:  []Property{prop("pets", "[]Pet")},
+		},
+		{
+			name:   "ambiguous arrays",
+			params: []Property{prop("page", "*int")},
+			props:  []Property{prop("pets", "[]Pet"), prop("toys", "[]Toy")},
+		},
+		{
+			name:   "not an integer",
+			params: []Property{prop("offset", "*string")},
+			props:  []Property{prop("items", "[]Pet")},
+		},
+		{
+			name:  "no query parameters",
+			props: []Property{prop("items", "[]Pet")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, detectPagination(tt.params, tt.props))
+		})
+	}
+}
+
+func TestOperationPagination(t *testing.T) {
+	tests := []struct {
+		name       string
+		extensions map[string]any
+		want       *Pagination
+		wantErr    string
+	}{
+		{name: "not set"},
+		{name: "detected", extensions: map[string]any{"x-pagination": "true"}, want: &Pagination{}},
+		{name: "disabled", extensions: map[string]any{"x-pagination": false}, want: &Pagination{Style: "none"}},
+		{
+			name:       "object",
+			extensions: map[string]any{"x-pagination": map[string]any{"style": "cursor", "param": "after", "next": "next"}},
+			want:       &Pagination{Style: "cursor", Param: "after", Next: "next"},
+		},
+		{
+			name:       "unknown style",
+			extensions: map[string]any{"x-pagination": map[string]any{"style": "keyset"}},
+			wantErr:    `invalid x-pagination: unknown pagination style "keyset"`,
+		},
+		{
+			name:       "unknown field",
+			extensions: map[string]any{"x-pagination": map[string]any{"size": "limit"}},
+			wantErr:    `invalid x-pagination: unknown field "size"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := operationPagination(tt.extensions)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPagination(t *testing.T) {
+	spec := readTestdata(t, "pagination.yml")
+	cfg := Configuration{
+		PackageName: "api",
+		Output:      &Output{UseSingleFile: true},
+		Generate:    &GenerateOptions{Client: true},
+	}
+
+	codes, err := Generate([]byte(spec), cfg)
+	require.NoError(t, err)
+
+	// only x-pagination generates pagers without detect
+	code := codes.GetCombined()
+	assert.Contains(t, code, "ListOrdersPages(ctx context.Context, options *ListOrdersRequestOptions, reqEditors ...runtime.RequestEditorFn) iter.Seq2[*ListOrdersResponse, error]")
+	assert.Contains(t, code, "query.Page++")
+	assert.Contains(t, code, "ListOrdersAll(ctx context.Context, options *ListOrdersRequestOptions, reqEditors ...runtime.RequestEditorFn) iter.Seq2[string, error]")
+	assert.NotContains(t, code, "ListPetsPages")
+
+	// the Link header is followed for operations without query parameters
+	assert.Contains(t, code, "ListReposPages(ctx context.Context, reqEditors ...runtime.RequestEditorFn) iter.Seq2[*ListReposResponse, error]")
+	assert.Contains(t, code, "ListReposAll(ctx context.Context, reqEditors ...runtime.RequestEditorFn) iter.Seq2[Repo, error]")
+	assert.Contains(t, code, "runtime.NextLink(req.URL, resp.Headers)")
+	assert.Contains(t, code, "for _, item := range *page {")
+
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+
+	t.Run("detect", func(t *testing.T) {
+		cfg := cfg
+		cfg.Generate = &GenerateOptions{Client: true, Pagination: PaginationOptions{Detect: true}}
+
+		codes, err := Generate([]byte(spec), cfg)
+		require.NoError(t, err)
+
+		code := codes.GetCombined()
+		assert.Contains(t, code, "func (c *Client) ListPetsPages(")
+		assert.Contains(t, code, "query.Cursor = &next")
+		assert.Contains(t, code, "for _, item := range page.Pets {")
+	})
+
+	t.Run("configured", func(t *testing.T) {
+		cfg := cfg
+		cfg.Generate = &GenerateOptions{Client: true, Pagination: PaginationOptions{
+			Operations: map[string]Pagination{"listPets": {}, "listOrders": {Style: "none"}},
+		}}
+
+		codes, err := Generate([]byte(spec), cfg)
+		require.NoError(t, err)
+
+		code := codes.GetCombined()
+		assert.Contains(t, code, "func (c *Client) ListPetsPages(")
+		assert.NotContains(t, code, "ListOrdersPages")
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		invalid := strings.Replace(spec, "items: orders", "items: total", 1)
+		require.NotEqual(t, spec, invalid)
+
+		_, err := Generate([]byte(invalid), cfg)
+		assert.ErrorContains(t, err, `error in the pagination of operation listOrders: no array response property "total"`)
+	})
+}
@@ -17,10 +17,15 @@ import (
 	"fmt"
 	"go/format"
 	"io/fs"
+	"maps"
 	"os"
+	"path"
+	"path/filepath"
+	goruntime "runtime"
 	"slices"
 	"sort"
 	"strings"
+	"sync"
 	"text/template"
 
 	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
@@ -40,11 +45,24 @@ func (g GeneratedCode) GetCombined() string {
 	return g["all"]
 }
 
+// GetExtraFiles returns the files added by plugins, whose names have an extension, e.g. "routes.yaml".
+// The other entries are Go files named without the .go extension.
+func (g GeneratedCode) GetExtraFiles() map[string]string {
+	res := make(map[string]string)
+	for name, contents := range g {
+		if filepath.Ext(name) != "" {
+			res[name] = contents
+		}
+	}
+	return res
+}
+
 // Parser uses the provided ParseContext to generate Go code for the API.
 type Parser struct {
-	tpl *template.Template
-	ctx *ParseContext
-	cfg Configuration
+	tpl     *template.Template
+	ctx     *ParseContext
+	cfg     Configuration
+	plugins []Plugin
 }
 
 type ParseOptions struct {
@@ -52,6 +70,60 @@ type ParseOptions struct {
 	DefaultIntType         string
 	AlwaysPrefixEnumValues bool
 	SkipValidation         bool
+	ResponseUnions         bool
+	IdempotencyKey         bool
+
+	// ConditionalRequests adds the IfMatch and IfNoneMatch request options to mutations.
+	ConditionalRequests bool
+
+	// OperationIDCasing is the casing of the operationIds synthesized for the operations without one.
+	OperationIDCasing string
+
+	// ServerBinding generates the cookie parameters and the request binders of the operations.
+	ServerBinding bool
+
+	// PreferNullable declares optional nullable properties as runtime.Nullable.
+	PreferNullable bool
+
+	// PreferOmitZero declares optional properties of struct types as values with the omitzero JSON tag.
+	PreferOmitZero bool
+
+	// PreferSensitive declares primitive properties with x-sensitive-data as runtime.Sensitive.
+	PreferSensitive bool
+
+	// PatchBodies generates typed merge patch and JSON Patch request bodies.
+	PatchBodies bool
+
+	// TypedUnions stores unions of 3 or 4 elements in runtime.OneOf3 and runtime.OneOf4.
+	TypedUnions bool
+
+	// AnyOfVariants generates anyOf unions with an optional field for every variant.
+	AnyOfVariants bool
+
+	// MaxDescriptionLength truncates longer property descriptions. 0 disables truncation.
+	MaxDescriptionLength int
+
+	// EnforceRemoveAfter fails generation for properties past their x-remove-after date.
+	EnforceRemoveAfter bool
+
+	// DecimalType is the Go type of format decimal, empty to keep string and float64.
+	DecimalType string
+
+	// CaptureUnknownFields keeps the unknown fields of objects without additionalProperties.
+	CaptureUnknownFields bool
+
+	// BasePath is prepended to the paths of the operations.
+	BasePath string
+
+	// StripBasePath leaves BasePath out of the paths requested by the client.
+	StripBasePath bool
+
+	// ResponseLinks generates the client methods following the links of success responses,
+	// whose types can't be aliases.
+	ResponseLinks bool
+
+	// FormatTags maps string formats to the validator tags checking them.
+	FormatTags map[string]string
 
 	// ErrorMapping maps response type names to the field that should be used
 	// for the Error() method. When a response type has error mapping configured,
@@ -64,6 +136,12 @@ type ParseOptions struct {
 	path         []string
 	specLocation SpecLocation
 
+	// mergePatch declares all properties of the object being generated as runtime.Nullable.
+	mergePatch bool
+
+	// omitValidation leaves the schemas being generated out of Validate() generation, set with x-go-omit-validation.
+	omitValidation bool
+
 	// Track visited schema paths to prevent infinite recursion
 	visited map[string]bool
 
@@ -87,6 +165,11 @@ func (o ParseOptions) WithSpecLocation(specLocation SpecLocation) ParseOptions {
 	return o
 }
 
+func (o ParseOptions) WithMergePatch(mergePatch bool) ParseOptions {
+	o.mergePatch = mergePatch
+	return o
+}
+
 type EnumContext struct {
 	Enums       []EnumDefinition
 	Imports     []string
@@ -108,18 +191,48 @@ type TplTypeContext struct {
 	ResponseErrors map[string]bool
 }
 
-// TplOperationsContext is the context passed to templates to generate client code.
+// TplOperationsContext is the context passed to templates to generate client and server binding code.
+// UserAgent is the default User-Agent of the generated client.
 type TplOperationsContext struct {
 	Operations []OperationDefinition
 	Imports    []string
 	Config     Configuration
 	WithHeader bool
+	UserAgent  string
+}
+
+// TplSpecContext is the context passed to templates to generate the spec metadata.
+type TplSpecContext struct {
+	Info       SpecInfo
+	Imports    []string
+	Config     Configuration
+	WithHeader bool
+
+	// Operations are the operations gated by a feature flag.
+	Operations []OperationDefinition
+
+	// SampledOperations are the operations with a log sample rate.
+	SampledOperations []OperationDefinition
+
+	// SensitiveOperations are the operations with sensitive parameters.
+	SensitiveOperations []OperationDefinition
+
+	// EmbeddedSpec is the spec after filtering and pruning, encoded by encodeEmbeddedSpec, with generate.embed-spec.
+	EmbeddedSpec []string
 }
 
 // NewParser creates a new Parser with the provided ParseConfig and ParseContext.
 func NewParser(cfg Configuration, ctx *ParseContext) (*Parser, error) {
 	cfg = cfg.WithDefaults()
-	tpl, err := loadTemplates()
+	funcs := maps.Clone(TemplateFunctions)
+	funcs["toGoComment"] = func(in, prefix string) string {
+		return stringToGoCommentWithPrefix(truncateDescription(in, cfg.Generate.MaxDescriptionLength), prefix)
+	}
+	jsonLib := newJSONLibrary(cfg.JSONLibrary)
+	funcs["jsonLibrary"] = func() *jsonLibrary { return jsonLib }
+	funcs["jsonMarshal"] = jsonLib.marshalFunc
+	funcs["jsonUnmarshal"] = jsonLib.unmarshalFunc
+	tpl, err := loadTemplates(funcs)
 	if err != nil {
 		return nil, fmt.Errorf("loading templates: %w", err)
 	}
@@ -139,104 +252,212 @@ func NewParser(cfg Configuration, ctx *ParseContext) (*Parser, error) {
 		}
 	}
 
+	plugins, err := loadPlugins(cfg.Plugins)
+	if err != nil {
+		return nil, fmt.Errorf("error loading plugins: %w", err)
+	}
+
 	return &Parser{
-		tpl: tpl,
-		ctx: ctx,
-		cfg: cfg,
+		tpl:     tpl,
+		ctx:     ctx,
+		cfg:     cfg,
+		plugins: plugins,
 	}, nil
 }
 
 // Parse generates Go code for the API using the provided ParseContext.
 // It returns a map of generated code for each type of definition.
 func (p *Parser) Parse() (GeneratedCode, error) {
-	typesOut := make(map[string]string)
+	if err := runParsePlugins(p.plugins, p.ctx); err != nil {
+		return nil, err
+	}
+
+	if router := p.cfg.Generate.RouteConflicts; router != "" {
+		if err := checkRouteConflicts(p.ctx.Operations, router); err != nil {
+			return nil, err
+		}
+	}
+
+	// http.ServeMux panics when registering invalid or conflicting routes
+	if p.cfg.Generate.ServerRouter {
+		if err := checkServeMuxPatterns(p.ctx.Operations); err != nil {
+			return nil, err
+		}
+		if p.cfg.Generate.RouteConflicts != "net/http" {
+			if err := checkRouteConflicts(p.ctx.Operations, "net/http"); err != nil {
+				return nil, err
+			}
+		}
+	}
 
 	useSingleFile := p.cfg.Output != nil && p.cfg.Output.UseSingleFile
 	withHeader := !useSingleFile
+
+	byTag := p.cfg.Output != nil && p.cfg.Output.SplitByTag
+	if byTag && useSingleFile {
+		return nil, fmt.Errorf("split-by-tag requires use-single-file: false")
+	}
+
+	// Every file is rendered from the finished parse context, so they are rendered concurrently.
+	// In single file mode, they are formatted together once combined.
+	var jobs []renderJob
 	if useSingleFile {
-		out, err := p.ParseTemplates([]string{"header-inc.tmpl"}, EnumContext{
-			Imports:    p.ctx.Imports,
-			Config:     p.cfg,
-			WithHeader: true,
+		jobs = append(jobs, renderJob{
+			name:        "header",
+			description: "header",
+			templates:   []string{"header-inc.tmpl"},
+			data: EnumContext{
+				Imports:    p.ctx.Imports,
+				Config:     p.cfg,
+				WithHeader: true,
+			},
 		})
-		if err != nil {
-			return nil, fmt.Errorf("error generating code for header: %w", err)
-		}
-		typesOut["header"] = out
 
 		// Generate validator declaration for single file mode
 		if !p.cfg.Generate.Validation.Skip {
-			out, err := p.ParseTemplates([]string{"common.tmpl"}, EnumContext{
-				Imports:    p.ctx.Imports,
-				Config:     p.cfg,
-				WithHeader: false,
+			jobs = append(jobs, renderJob{
+				name:        "validator",
+				description: "validator",
+				templates:   []string{"common.tmpl"},
+				data: EnumContext{
+					Imports:    p.ctx.Imports,
+					Config:     p.cfg,
+					WithHeader: false,
+				},
 			})
-			if err != nil {
-				return nil, fmt.Errorf("error generating code for validator: %w", err)
-			}
-			typesOut["validator"] = out
 		}
 	}
 
+	var flaggedOps, sampledOps, sensitiveOps []OperationDefinition
+	for _, op := range p.ctx.Operations {
+		if op.FeatureFlag != "" {
+			flaggedOps = append(flaggedOps, op)
+		}
+		if op.LogSampleRate > 0 {
+			sampledOps = append(sampledOps, op)
+		}
+		if len(op.SensitiveParameters) > 0 {
+			sensitiveOps = append(sensitiveOps, op)
+		}
+	}
+	var embeddedSpec []string
+	if p.cfg.Generate.EmbedSpec && p.ctx.model != nil {
+		var err error
+		if embeddedSpec, err = encodeEmbeddedSpec(p.ctx.model); err != nil {
+			return nil, fmt.Errorf("error embedding spec: %w", err)
+		}
+	}
+
+	// the spec file is left out when it has nothing to declare
+	hasSpecDecls := p.cfg.Generate.SpecMetadata || len(flaggedOps) > 0 || len(sampledOps) > 0 || len(sensitiveOps) > 0 || len(embeddedSpec) > 0
+	if hasSpecDecls {
+		jobs = append(jobs, renderJob{
+			name:        "spec",
+			description: "spec metadata",
+			templates:   []string{"spec.tmpl"},
+			data: &TplSpecContext{
+				Info:       p.ctx.Info,
+				Imports:    p.ctx.Imports,
+				Config:     p.cfg,
+				WithHeader: withHeader,
+				Operations: flaggedOps,
+
+				SampledOperations:   sampledOps,
+				SensitiveOperations: sensitiveOps,
+				EmbeddedSpec:        embeddedSpec,
+			},
+			format: !useSingleFile,
+		})
+	}
+
+	if newJSONLibrary(p.cfg.JSONLibrary) != nil {
+		jobs = append(jobs, renderJob{
+			name:        "json",
+			description: "json library",
+			templates:   []string{"json.tmpl"},
+			data: EnumContext{
+				Imports:    p.ctx.Imports,
+				Config:     p.cfg,
+				WithHeader: withHeader,
+			},
+			format: !useSingleFile,
+		})
+	}
+
 	if len(p.ctx.Operations) > 0 && p.cfg.Generate.Client {
-		opsCtx := &TplOperationsContext{
-			Operations: p.ctx.Operations,
-			Imports:    p.ctx.Imports,
-			Config:     p.cfg,
-			WithHeader: withHeader,
-		}
-		for _, tmpl := range []string{"client", "client-options"} {
-			out, err := p.ParseTemplates([]string{tmpl + ".tmpl"}, opsCtx)
-			if err != nil {
-				return nil, fmt.Errorf("error generating code for client: %w", err)
+		// with split-by-tag, the clients of the tags are rendered in files named after their package
+		operations := map[string][]OperationDefinition{"": p.ctx.Operations}
+		if byTag {
+			var err error
+			if operations, err = operationsByTag(p.ctx.Operations, p.cfg.PackageName); err != nil {
+				return nil, err
+			}
+		}
+		userAgent := defaultUserAgent(p.ctx.Info, generatorVersion())
+		for _, pkg := range sortedMapKeys(operations) {
+			opsCtx := &TplOperationsContext{
+				Operations: operations[pkg],
+				Imports:    p.ctx.Imports,
+				Config:     p.cfg,
+				WithHeader: withHeader,
+				UserAgent:  userAgent,
 			}
-			formatted := out
-			if !useSingleFile {
-				formatted, err = FormatCode(out)
-				if err != nil {
-					return nil, err
-				}
+			for _, tmpl := range []string{"client", "client-options"} {
+				jobs = append(jobs, renderJob{
+					name:        path.Join(pkg, strcase.ToSnake(tmpl)),
+					description: "client",
+					templates:   []string{tmpl + ".tmpl"},
+					data:        opsCtx,
+					format:      !useSingleFile,
+				})
 			}
-			typesOut[strcase.ToSnake(tmpl)] = formatted
 		}
 	}
 
+	if len(p.ctx.Operations) > 0 && p.cfg.Generate.ServerBinding {
+		jobs = append(jobs, renderJob{
+			name:        "server",
+			description: "server binding",
+			templates:   []string{"server.tmpl"},
+			data: &TplOperationsContext{
+				Operations: p.ctx.Operations,
+				Imports:    p.ctx.Imports,
+				Config:     p.cfg,
+				WithHeader: withHeader,
+			},
+			format: !useSingleFile,
+		})
+	}
+
 	// Generate validator file if validation is not skipped and not using single file
 	if !useSingleFile && !p.cfg.Generate.Validation.Skip {
-		out, err := p.ParseTemplates([]string{"common.tmpl"}, EnumContext{
-			Imports:    p.ctx.Imports,
-			Config:     p.cfg,
-			WithHeader: withHeader,
+		jobs = append(jobs, renderJob{
+			name:        "common",
+			description: "validator",
+			templates:   []string{"common.tmpl"},
+			data: EnumContext{
+				Imports:    p.ctx.Imports,
+				Config:     p.cfg,
+				WithHeader: withHeader,
+			},
+			format: true,
 		})
-		if err != nil {
-			return nil, fmt.Errorf("error generating code for validator: %w", err)
-		}
-		formatted, err := FormatCode(out)
-		if err != nil {
-			return nil, err
-		}
-		typesOut["common"] = formatted
 	}
 
 	if len(p.ctx.Enums) > 0 {
-		out, err := p.ParseTemplates([]string{"enums.tmpl"}, EnumContext{
-			Enums:       p.ctx.Enums,
-			Imports:     p.ctx.Imports,
-			Config:      p.cfg,
-			WithHeader:  withHeader,
-			TypeTracker: p.ctx.TypeTracker,
+		jobs = append(jobs, renderJob{
+			name:        "enums",
+			description: "type enums",
+			templates:   []string{"enums.tmpl"},
+			data: EnumContext{
+				Enums:       p.ctx.Enums,
+				Imports:     p.ctx.Imports,
+				Config:      p.cfg,
+				WithHeader:  withHeader,
+				TypeTracker: p.ctx.TypeTracker,
+			},
+			format: !useSingleFile,
 		})
-		if err != nil {
-			return nil, fmt.Errorf("error generating code for type enums: %w", err)
-		}
-		formatted := out
-		if !useSingleFile {
-			formatted, err = FormatCode(out)
-			if err != nil {
-				return nil, err
-			}
-		}
-		typesOut["enums"] = formatted
 	}
 
 	responseErrs := make(map[string]bool)
@@ -255,54 +476,50 @@ func (p *Parser) Parse() (GeneratedCode, error) {
 		typeSchemaMap[td.Name] = td.Schema
 	}
 
-	for sl, tds := range p.ctx.TypeDefinitions {
+	// sorted, for errors to be reported in the same order on every run
+	for _, sl := range slices.Sorted(maps.Keys(p.ctx.TypeDefinitions)) {
+		tds := p.ctx.TypeDefinitions[sl]
 		if len(tds) == 0 {
 			continue
 		}
-		typesCtx := &TplTypeContext{
-			Types:          tds,
-			TypeSchemaMap:  typeSchemaMap,
-			SpecLocation:   string(sl),
-			Imports:        p.ctx.Imports,
-			Config:         p.cfg,
-			WithHeader:     withHeader,
-			ResponseErrors: responseErrs,
-		}
-		out, err := p.ParseTemplates([]string{"types.tmpl"}, typesCtx)
-		if err != nil {
-			return nil, fmt.Errorf("error generating code for %s type definitions: %w", sl, err)
-		}
-		formatted := out
-		if !useSingleFile {
-			formatted, err = FormatCode(out)
-			if err != nil {
-				return nil, err
-			}
-		}
-		typesOut[getSpecLocationOutName(sl)] = formatted
+		jobs = append(jobs, renderJob{
+			name:        getSpecLocationOutName(sl),
+			description: string(sl) + " type definitions",
+			templates:   []string{"types.tmpl"},
+			data: &TplTypeContext{
+				Types:          tds,
+				TypeSchemaMap:  typeSchemaMap,
+				SpecLocation:   string(sl),
+				Imports:        p.ctx.Imports,
+				Config:         p.cfg,
+				WithHeader:     withHeader,
+				ResponseErrors: responseErrs,
+			},
+			format: !useSingleFile,
+		})
 	}
 
 	if len(p.ctx.UnionTypes) > 0 {
-		out, err := p.ParseTemplates([]string{"types.tmpl", "union.tmpl"}, &TplTypeContext{
-			Types:          p.ctx.UnionTypes,
-			TypeSchemaMap:  typeSchemaMap,
-			SpecLocation:   "union",
-			Imports:        p.ctx.Imports,
-			Config:         p.cfg,
-			WithHeader:     withHeader,
-			ResponseErrors: responseErrs,
+		jobs = append(jobs, renderJob{
+			name:        "unions",
+			description: "union types",
+			templates:   []string{"types.tmpl", "union.tmpl"},
+			data: &TplTypeContext{
+				Types:          p.ctx.UnionTypes,
+				TypeSchemaMap:  typeSchemaMap,
+				SpecLocation:   "union",
+				Imports:        p.ctx.Imports,
+				Config:         p.cfg,
+				WithHeader:     withHeader,
+				ResponseErrors: responseErrs,
+			},
+			format: !useSingleFile,
 		})
-		if err != nil {
-			return nil, fmt.Errorf("error generating code for union types: %w", err)
-		}
-		formatted := out
-		if !useSingleFile {
-			formatted, err = FormatCode(out)
-			if err != nil {
-				return nil, err
-			}
-		}
-		typesOut["unions"] = formatted
+	}
+
+	typesOut, err := p.renderJobs(jobs)
+	if err != nil {
+		return nil, err
 	}
 
 	if useSingleFile {
@@ -337,9 +554,192 @@ func (p *Parser) Parse() (GeneratedCode, error) {
 		typesOut = map[string]string{"all": formatted}
 	}
 
+	if p.cfg.Generate.InternStrings {
+		if err := p.internStrings(typesOut, useSingleFile); err != nil {
+			return nil, err
+		}
+	}
+
+	if byTag {
+		if err := splitByTag(typesOut, p.cfg); err != nil {
+			return nil, fmt.Errorf("error splitting the output by tag: %w", err)
+		}
+	}
+
+	if !useSingleFile && p.cfg.Output != nil && p.cfg.Output.SplitByConcern {
+		if err := splitByConcern(typesOut, p.cfg); err != nil {
+			return nil, fmt.Errorf("error splitting the output by concern: %w", err)
+		}
+	}
+
+	if p.cfg.Generate.SensitiveDataTests {
+		if tests := newSensitiveDataTests(p.ctx); len(tests) > 0 {
+			out, err := p.render(renderJob{
+				description: "sensitive data tests",
+				templates:   []string{"sensitive-data-tests.tmpl"},
+				data: &TplSensitiveDataTestsContext{
+					Tests:      tests,
+					Imports:    p.ctx.Imports,
+					Config:     p.cfg,
+					WithHeader: true,
+				},
+				format: true,
+			})
+			if err != nil {
+				return nil, err
+			}
+			typesOut[SensitiveDataTestsFile] = out
+		}
+	}
+
+	if err := addFileDirectives(typesOut, p.cfg.Output, p.ctx.Info); err != nil {
+		return nil, err
+	}
+
+	if p.cfg.Output != nil && p.cfg.Output.RouteManifest != "" {
+		if filepath.Ext(p.cfg.Output.RouteManifest) == "" {
+			return nil, fmt.Errorf("route manifest file name %q must have an extension", p.cfg.Output.RouteManifest)
+		}
+		manifest, err := NewRouteManifest(p.ctx).JSON()
+		if err != nil {
+			return nil, fmt.Errorf("error generating route manifest: %w", err)
+		}
+		typesOut[p.cfg.Output.RouteManifest] = manifest
+	}
+
+	if p.cfg.Output != nil && p.cfg.Output.SymbolIndex != "" {
+		if filepath.Ext(p.cfg.Output.SymbolIndex) == "" {
+			return nil, fmt.Errorf("symbol index file name %q must have an extension", p.cfg.Output.SymbolIndex)
+		}
+		singleFile := ""
+		if useSingleFile {
+			singleFile = p.cfg.Output.Filename
+		}
+		index, err := NewSymbolIndex(p.ctx, typesOut, singleFile)
+		if err != nil {
+			return nil, fmt.Errorf("error generating symbol index: %w", err)
+		}
+		out, err := index.JSON()
+		if err != nil {
+			return nil, fmt.Errorf("error generating symbol index: %w", err)
+		}
+		typesOut[p.cfg.Output.SymbolIndex] = out
+	}
+
+	if p.cfg.Output != nil && p.cfg.Output.EmitSpec != "" && p.ctx.model != nil {
+		if filepath.Ext(p.cfg.Output.EmitSpec) == "" {
+			return nil, fmt.Errorf("spec file name %q must have an extension", p.cfg.Output.EmitSpec)
+		}
+		spec, err := renderSpec(p.ctx.model, p.cfg.Output.EmitSpec)
+		if err != nil {
+			return nil, fmt.Errorf("error rendering spec: %w", err)
+		}
+		typesOut[p.cfg.Output.EmitSpec] = string(spec)
+	}
+
+	contracts, err := newDataContracts(p.ctx, p.cfg.PackageName)
+	if err != nil {
+		return nil, err
+	}
+	maps.Copy(typesOut, contracts)
+
+	if err := runRenderPlugins(p.plugins, typesOut); err != nil {
+		return nil, err
+	}
+
 	return typesOut, nil
 }
 
+// internStrings replaces the string literals repeated across the generated Go code with package-level constants,
+// declared at the end of the single file or in their own "strings" file.
+func (p *Parser) internStrings(typesOut map[string]string, useSingleFile bool) error {
+	constants, err := internStrings(typesOut)
+	if err != nil || len(constants) == 0 {
+		return err
+	}
+
+	out, err := p.ParseTemplates([]string{"strings.tmpl"}, &TplStringsContext{
+		Constants:  constants,
+		Imports:    p.ctx.Imports,
+		Config:     p.cfg,
+		WithHeader: !useSingleFile,
+	})
+	if err != nil {
+		return fmt.Errorf("error generating code for interned strings: %w", err)
+	}
+
+	if useSingleFile {
+		typesOut["all"] += "\n" + out
+	} else {
+		typesOut["strings"] = out
+	}
+
+	// constant names differ in length from the literals they replace, so the code is formatted again
+	for _, name := range sortedMapKeys(typesOut) {
+		formatted, err := FormatCode(typesOut[name])
+		if err != nil {
+			return err
+		}
+		typesOut[name] = formatted
+	}
+	return nil
+}
+
+// renderJob is a generated file rendered by Parse.
+type renderJob struct {
+	// name is the name of the file in the GeneratedCode.
+	name string
+
+	// description is the part of the code in errors, e.g. "union types".
+	description string
+
+	templates []string
+	data      any
+
+	// format formats the code, outside single file mode where files are formatted once combined.
+	format bool
+}
+
+// renderJobs renders the jobs concurrently, at most GOMAXPROCS at a time.
+// Only the templates are executed and formatted concurrently: the schemas and operations are collected
+// sequentially beforehand, since the names of conflicting types depend on the order they are seen.
+// When jobs fail, the error of the first one in order is returned.
+func (p *Parser) renderJobs(jobs []renderJob) (map[string]string, error) {
+	results := make([]string, len(jobs))
+	errs := make([]error, len(jobs))
+
+	var wg sync.WaitGroup
+	limit := make(chan struct{}, goruntime.GOMAXPROCS(0))
+	for i, job := range jobs {
+		wg.Go(func() {
+			limit <- struct{}{}
+			defer func() { <-limit }()
+			results[i], errs[i] = p.render(job)
+		})
+	}
+	wg.Wait()
+
+	res := make(map[string]string, len(jobs))
+	for i, job := range jobs {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		res[job.name] = results[i]
+	}
+	return res, nil
+}
+
+func (p *Parser) render(job renderJob) (string, error) {
+	out, err := p.ParseTemplates(job.templates, job.data)
+	if err != nil {
+		return "", fmt.Errorf("error generating code for %s: %w", job.description, err)
+	}
+	if !job.format {
+		return out, nil
+	}
+	return FormatCode(out)
+}
+
 // ParseTemplates parses provided templates with the given data and returns the generated code.
 func (p *Parser) ParseTemplates(templates []string, data any) (string, error) {
 	var generatedTemplates []string
@@ -359,8 +759,8 @@ func (p *Parser) ParseTemplates(templates []string, data any) (string, error) {
 	return strings.Join(generatedTemplates, "\n"), nil
 }
 
-func loadTemplates() (*template.Template, error) {
-	tpl := template.New("templates").Funcs(TemplateFunctions)
+func loadTemplates(funcs template.FuncMap) (*template.Template, error) {
+	tpl := template.New("templates").Funcs(funcs)
 
 	err := fs.WalkDir(templates, "templates", func(path string, d fs.DirEntry, err error) error {
 		if err != nil {
@@ -432,6 +832,8 @@ func getSpecLocationOutName(specLocation SpecLocation) string {
 		return "queries"
 	case SpecLocationHeader:
 		return "headers"
+	case SpecLocationCookie:
+		return "cookies"
 	case SpecLocationBody:
 		return "payloads"
 	case SpecLocationResponse:
@@ -45,6 +45,9 @@ var TemplateFunctions = template.FuncMap{
 	},
 	"filterOmitEmpty": filterOmitEmpty,
 	"deref":           derefBool,
+	"jsonLibrary":     func() *jsonLibrary { return nil },
+	"jsonMarshal":     (*jsonLibrary)(nil).marshalFunc,
+	"jsonUnmarshal":   (*jsonLibrary)(nil).unmarshalFunc,
 }
 
 // uppercaseFirstCharacter Uppercases the first character in a string.
@@ -219,3 +219,35 @@ type AddressOneOf struct {
 		}
 	})
 }
+
+func TestParser_renderJobs(t *testing.T) {
+	parser, err := NewParser(Configuration{
+		UserTemplates: map[string]string{
+			"a.tmpl":       "package {{ .Name }}\nconst  A = 1",
+			"b.tmpl":       "package {{ .Name }}\nconst  B = 2",
+			"invalid.tmpl": "package {{ .Name }}\nconst",
+		},
+	}, &ParseContext{})
+	require.NoError(t, err)
+
+	t.Run("renders every job", func(t *testing.T) {
+		res, err := parser.renderJobs([]renderJob{
+			{name: "a", templates: []string{"a.tmpl"}, data: map[string]string{"Name": "api"}, format: true},
+			{name: "b", templates: []string{"b.tmpl"}, data: map[string]string{"Name": "api"}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			"a": "package api\n\nconst A = 1\n",
+			"b": "package api\nconst  B = 2",
+		}, res)
+	})
+
+	t.Run("reports the error of the first failing job", func(t *testing.T) {
+		_, err := parser.renderJobs([]renderJob{
+			{name: "a", templates: []string{"a.tmpl"}, data: map[string]string{"Name": "api"}},
+			{name: "missing", description: "missing", templates: []string{"missing.tmpl"}},
+			{name: "invalid", description: "invalid", templates: []string{"invalid.tmpl"}, data: map[string]string{"Name": "api"}, format: true},
+		})
+		assert.ErrorContains(t, err, "error generating code for missing")
+	})
+}
@@ -0,0 +1,224 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"fmt"
+
+	"github.com/pb33f/libopenapi"
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+)
+
+// Pipeline applies the transformations the generator applies to documents before generating code,
+// for other tools, e.g. spec governance or docs, to transform documents the same way:
+//
+//	model, err := codegen.NewPipeline().Filter(cfg.Filter).Prune().RemoveExamples().Run(doc)
+//
+// Steps run in the order they are added, on the model built by doc.BuildV3Model,
+// which is changed in place.
+type Pipeline struct {
+	steps []func(model *v3high.Document) error
+}
+
+// NewPipeline creates a Pipeline without steps.
+func NewPipeline() *Pipeline {
+	return &Pipeline{}
+}
+
+// Filter removes the operations and component schema properties excluded by the filter,
+// like the filter configuration of the generator.
+func (p *Pipeline) Filter(cfg FilterConfig) *Pipeline {
+	p.steps = append(p.steps, func(model *v3high.Document) error {
+		if err := validateFilter(cfg); err != nil {
+			return err
+		}
+		filterOperations(model, cfg)
+		filterComponentSchemaProperties(model, cfg)
+		return nil
+	})
+	return p
+}
+
+// Prune removes the components not referenced by operations, webhooks, security schemes, callbacks,
+// component examples and links, like the generator does unless skip-prune is set.
+func (p *Pipeline) Prune() *Pipeline {
+	p.steps = append(p.steps, pruneSchema)
+	return p
+}
+
+// RemoveExamples removes the examples of schemas, parameters, headers and media types, and component examples.
+func (p *Pipeline) RemoveExamples() *Pipeline {
+	p.steps = append(p.steps, func(model *v3high.Document) error {
+		removeExamples(model)
+		return nil
+	})
+	return p
+}
+
+// Run builds the model of the document and applies the steps to it.
+func (p *Pipeline) Run(doc libopenapi.Document) (*v3high.Document, error) {
+	built, err := doc.BuildV3Model()
+	if err != nil {
+		return nil, fmt.Errorf("error building model: %w", err)
+	}
+	model := &built.Model
+	restoreAdditionalOperations(model)
+
+	for _, step := range p.steps {
+		if err = step(model); err != nil {
+			return nil, err
+		}
+	}
+	return model, nil
+}
+
+// removeExamples removes the examples of the operations and components of the model.
+func removeExamples(model *v3high.Document) {
+	visited := map[*base.Schema]bool{}
+
+	if model.Paths != nil && model.Paths.PathItems != nil {
+		for _, pathItem := range model.Paths.PathItems.FromOldest() {
+			for _, param := range pathItem.Parameters {
+				removeParameterExamples(param, visited)
+			}
+			for _, op := range pathItem.GetOperations().FromOldest() {
+				for _, param := range op.Parameters {
+					removeParameterExamples(param, visited)
+				}
+				if op.RequestBody != nil {
+					removeContentExamples(op.RequestBody.Content, visited)
+				}
+				if op.Responses != nil {
+					if op.Responses.Default != nil {
+						removeResponseExamples(op.Responses.Default, visited)
+					}
+					for _, resp := range op.Responses.Codes.FromOldest() {
+						removeResponseExamples(resp, visited)
+					}
+				}
+			}
+		}
+	}
+
+	if model.Components == nil {
+		return
+	}
+	model.Components.Examples = nil
+	if model.Components.Schemas != nil {
+		for _, proxy := range model.Components.Schemas.FromOldest() {
+			removeSchemaExamples(proxy, visited)
+		}
+	}
+	if model.Components.Parameters != nil {
+		for _, param := range model.Components.Parameters.FromOldest() {
+			removeParameterExamples(param, visited)
+		}
+	}
+	if model.Components.RequestBodies != nil {
+		for _, reqBody := range model.Components.RequestBodies.FromOldest() {
+			removeContentExamples(reqBody.Content, visited)
+		}
+	}
+	if model.Components.Responses != nil {
+		for _, resp := range model.Components.Responses.FromOldest() {
+			removeResponseExamples(resp, visited)
+		}
+	}
+	if model.Components.Headers != nil {
+		for _, header := range model.Components.Headers.FromOldest() {
+			removeHeaderExamples(header, visited)
+		}
+	}
+}
+
+func removeParameterExamples(param *v3high.Parameter, visited map[*base.Schema]bool) {
+	if param == nil {
+		return
+	}
+	param.Example = nil
+	param.Examples = nil
+	removeSchemaExamples(param.Schema, visited)
+	removeContentExamples(param.Content, visited)
+}
+
+func removeHeaderExamples(header *v3high.Header, visited map[*base.Schema]bool) {
+	if header == nil {
+		return
+	}
+	header.Example = nil
+	header.Examples = nil
+	removeSchemaExamples(header.Schema, visited)
+	removeContentExamples(header.Content, visited)
+}
+
+func removeResponseExamples(resp *v3high.Response, visited map[*base.Schema]bool) {
+	if resp == nil {
+		return
+	}
+	if resp.Headers != nil {
+		for _, header := range resp.Headers.FromOldest() {
+			removeHeaderExamples(header, visited)
+		}
+	}
+	removeContentExamples(resp.Content, visited)
+}
+
+func removeContentExamples(content *orderedmap.Map[string, *v3high.MediaType], visited map[*base.Schema]bool) {
+	if content == nil {
+		return
+	}
+	for _, mediaType := range content.FromOldest() {
+		if mediaType == nil {
+			continue
+		}
+		mediaType.Example = nil
+		mediaType.Examples = nil
+		removeSchemaExamples(mediaType.Schema, visited)
+	}
+}
+
+// removeSchemaExamples removes the examples of an inline schema and its subschemas.
+// Referenced schemas are left to their components.
+func removeSchemaExamples(proxy *base.SchemaProxy, visited map[*base.Schema]bool) {
+	if proxy == nil || proxy.IsReference() {
+		return
+	}
+	schema := proxy.Schema()
+	if schema == nil || visited[schema] {
+		return
+	}
+	visited[schema] = true
+
+	schema.Example = nil
+	schema.Examples = nil
+
+	for _, proxies := range [][]*base.SchemaProxy{schema.AllOf, schema.OneOf, schema.AnyOf, schema.PrefixItems} {
+		for _, p := range proxies {
+			removeSchemaExamples(p, visited)
+		}
+	}
+	for _, p := range []*base.SchemaProxy{schema.Not, schema.Contains, schema.If, schema.Then, schema.Else, schema.PropertyNames} {
+		removeSchemaExamples(p, visited)
+	}
+	if schema.Properties != nil {
+		for _, p := range schema.Properties.FromOldest() {
+			removeSchemaExamples(p, visited)
+		}
+	}
+	if schema.Items != nil && schema.Items.IsA() {
+		removeSchemaExamples(schema.Items.A, visited)
+	}
+	if schema.AdditionalProperties != nil && schema.AdditionalProperties.IsA() {
+		removeSchemaExamples(schema.AdditionalProperties.A, visited)
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipeline(t *testing.T) {
+	t.Run("filter and prune like the generator", func(t *testing.T) {
+		contents := []byte(readTestdata(t, "prune-cat-dog.yml"))
+		filter := FilterConfig{
+			Exclude: FilterParamsConfig{Tags: []string{"dog"}},
+		}
+
+		doc, err := LoadDocumentFromContents(contents)
+		require.NoError(t, err)
+		model, err := NewPipeline().Filter(filter).Prune().Run(doc)
+		require.NoError(t, err)
+		actual, err := model.Render()
+		require.NoError(t, err)
+
+		expected, err := RenderProcessedSpec(contents, Configuration{Filter: filter})
+		require.NoError(t, err)
+		assert.Equal(t, string(expected), string(actual))
+		assert.NotContains(t, string(actual), "/dog")
+	})
+
+	t.Run("remove examples", func(t *testing.T) {
+		doc, err := LoadDocumentFromContents([]byte(readTestdata(t, "with-examples.yml")))
+		require.NoError(t, err)
+
+		model, err := NewPipeline().RemoveExamples().Run(doc)
+		require.NoError(t, err)
+		out, err := model.Render()
+		require.NoError(t, err)
+
+		assert.NotContains(t, string(out), "example:")
+		assert.NotContains(t, string(out), "examples:")
+		assert.Contains(t, string(out), "/sessions")
+	})
+
+	t.Run("steps run in order", func(t *testing.T) {
+		doc, err := LoadDocumentFromContents([]byte(readTestdata(t, "with-examples.yml")))
+		require.NoError(t, err)
+
+		model, err := NewPipeline().Prune().RemoveExamples().Run(doc)
+		require.NoError(t, err)
+		assert.Nil(t, model.Components.Examples)
+	})
+
+	t.Run("invalid filter", func(t *testing.T) {
+		doc, err := LoadDocumentFromContents([]byte(readTestdata(t, "prune-cat-dog.yml")))
+		require.NoError(t, err)
+
+		_, err = NewPipeline().Filter(FilterConfig{Include: FilterParamsConfig{Paths: []string{"^/cat(["}}}).Run(doc)
+		assert.ErrorContains(t, err, `invalid path filter "^/cat(["`)
+	})
+}
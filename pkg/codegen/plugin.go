@@ -0,0 +1,178 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+)
+
+// Plugin extends code generation with custom hooks, e.g. to produce audit manifests or routing configs
+// without forking the templates. A plugin implements any of AfterParsePlugin, TypePlugin,
+// OperationPlugin and AfterRenderPlugin, and ConfigurablePlugin to receive its options.
+//
+// Plugins are registered at compile time with RegisterPlugin, or built with `go build -buildmode=plugin`
+// exporting a `Plugin` variable, and enabled with the `plugins` configuration. Go plugins are opened
+// and registered by the oapi-codegen CLI, so this package doesn't link the plugin package.
+type Plugin interface {
+	Name() string
+}
+
+// ConfigurablePlugin receives the options of its `plugins` configuration entry before any hook runs.
+type ConfigurablePlugin interface {
+	Plugin
+	Configure(options map[string]any) error
+}
+
+// AfterParsePlugin is called with the parse context before any code is rendered.
+type AfterParsePlugin interface {
+	Plugin
+	AfterParse(ctx *ParseContext) error
+}
+
+// TypePlugin is called for every type definition before it is rendered, and may modify it.
+type TypePlugin interface {
+	Plugin
+	Type(td *TypeDefinition) error
+}
+
+// OperationPlugin is called for every operation before it is rendered, and may modify it.
+type OperationPlugin interface {
+	Plugin
+	Operation(op *OperationDefinition) error
+}
+
+// AfterRenderPlugin is called with the generated code. It may modify it or add files:
+// keys with an extension, e.g. "routes.yaml", are written as-is next to the generated code.
+type AfterRenderPlugin interface {
+	Plugin
+	AfterRender(code GeneratedCode) error
+}
+
+// PluginConfig enables a plugin, either registered by Name or loaded from the Go plugin at Path.
+type PluginConfig struct {
+	// Name is the name of a plugin registered with RegisterPlugin.
+	Name string `yaml:"name,omitempty"`
+
+	// Path is the path of a Go plugin exporting the plugin as Plugin, only loaded by the oapi-codegen CLI.
+	Path string `yaml:"path,omitempty"`
+
+	// Options are passed to the Configure method of a ConfigurablePlugin.
+	Options map[string]any `yaml:"options,omitempty"`
+}
+
+var (
+	pluginsMu sync.RWMutex
+	plugins   = make(map[string]Plugin)
+)
+
+// RegisterPlugin makes a plugin available by its name, usually from an init function.
+// It panics if a plugin with the same name is already registered.
+func RegisterPlugin(p Plugin) {
+	pluginsMu.Lock()
+	defer pluginsMu.Unlock()
+
+	if _, ok := plugins[p.Name()]; ok {
+		panic(fmt.Sprintf("codegen: plugin %q registered twice", p.Name()))
+	}
+	plugins[p.Name()] = p
+}
+
+// loadPlugins returns the configured plugins, configured with their options, in order.
+func loadPlugins(cfgs []PluginConfig) ([]Plugin, error) {
+	res := make([]Plugin, 0, len(cfgs))
+	for _, cfg := range cfgs {
+		var (
+			p   Plugin
+			err error
+		)
+		switch {
+		case cfg.Path != "":
+			err = fmt.Errorf("plugin %s: Go plugins are only loaded by the oapi-codegen CLI, register it with RegisterPlugin", cfg.Path)
+		case cfg.Name != "":
+			p, err = registeredPlugin(cfg.Name)
+		default:
+			err = fmt.Errorf("plugin needs a name or a path")
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if c, ok := p.(ConfigurablePlugin); ok {
+			if err = c.Configure(cfg.Options); err != nil {
+				return nil, fmt.Errorf("error configuring plugin %q: %w", p.Name(), err)
+			}
+		}
+		res = append(res, p)
+	}
+	return res, nil
+}
+
+func registeredPlugin(name string) (Plugin, error) {
+	pluginsMu.RLock()
+	defer pluginsMu.RUnlock()
+
+	p, ok := plugins[name]
+	if !ok {
+		return nil, fmt.Errorf("plugin %q is not registered", name)
+	}
+	return p, nil
+}
+
+// runParsePlugins runs the hooks called before the code is rendered.
+func runParsePlugins(plugins []Plugin, ctx *ParseContext) error {
+	for _, p := range plugins {
+		if hook, ok := p.(AfterParsePlugin); ok {
+			if err := hook.AfterParse(ctx); err != nil {
+				return fmt.Errorf("plugin %q: %w", p.Name(), err)
+			}
+		}
+
+		if hook, ok := p.(TypePlugin); ok {
+			for _, sl := range slices.Sorted(maps.Keys(ctx.TypeDefinitions)) {
+				tds := ctx.TypeDefinitions[sl]
+				for i := range tds {
+					if err := hook.Type(&tds[i]); err != nil {
+						return fmt.Errorf("plugin %q: type %s: %w", p.Name(), tds[i].Name, err)
+					}
+				}
+			}
+			for i := range ctx.UnionTypes {
+				if err := hook.Type(&ctx.UnionTypes[i]); err != nil {
+					return fmt.Errorf("plugin %q: type %s: %w", p.Name(), ctx.UnionTypes[i].Name, err)
+				}
+			}
+		}
+
+		if hook, ok := p.(OperationPlugin); ok {
+			for i := range ctx.Operations {
+				if err := hook.Operation(&ctx.Operations[i]); err != nil {
+					return fmt.Errorf("plugin %q: operation %s: %w", p.Name(), ctx.Operations[i].ID, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// runRenderPlugins runs the hooks called with the generated code.
+func runRenderPlugins(plugins []Plugin, code GeneratedCode) error {
+	for _, p := range plugins {
+		if hook, ok := p.(AfterRenderPlugin); ok {
+			if err := hook.AfterRender(code); err != nil {
+				return fmt.Errorf("plugin %q: %w", p.Name(), err)
+			}
+		}
+	}
+	return nil
+}
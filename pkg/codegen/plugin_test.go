@@ -0,0 +1,217 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"errors"
+	"go/format"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// routesPlugin implements every hook, writing a routes file and tagging operation summaries.
+type routesPlugin struct {
+	name   string
+	prefix string
+	parsed bool
+	types  []string
+	err    error
+}
+
+func (p *routesPlugin) Name() string { return p.name }
+
+func (p *routesPlugin) Configure(options map[string]any) error {
+	prefix, ok := options["prefix"].(string)
+	if !ok {
+		return errors.New("prefix option is required")
+	}
+	p.prefix = prefix
+	return nil
+}
+
+func (p *routesPlugin) AfterParse(ctx *ParseContext) error {
+	p.parsed = len(ctx.Operations) > 0
+	return nil
+}
+
+func (p *routesPlugin) Type(td *TypeDefinition) error {
+	p.types = append(p.types, td.Name)
+	return p.err
+}
+
+func (p *routesPlugin) Operation(op *OperationDefinition) error {
+	op.Summary = "served by " + p.prefix
+	return nil
+}
+
+func (p *routesPlugin) AfterRender(code GeneratedCode) error {
+	var routes []string
+	for name := range code {
+		routes = append(routes, name)
+	}
+	slices.Sort(routes)
+	code["routes.txt"] = p.prefix + ": " + strings.Join(routes, ",")
+	return nil
+}
+
+// hookPlugin implements a single hook returning err.
+type hookPlugin struct {
+	name string
+	err  error
+}
+
+func (p *hookPlugin) Name() string { return p.name }
+
+type afterParseErrPlugin struct{ hookPlugin }
+
+func (p *afterParseErrPlugin) AfterParse(*ParseContext) error { return p.err }
+
+type operationErrPlugin struct{ hookPlugin }
+
+func (p *operationErrPlugin) Operation(*OperationDefinition) error { return p.err }
+
+type afterRenderErrPlugin struct{ hookPlugin }
+
+func (p *afterRenderErrPlugin) AfterRender(GeneratedCode) error { return p.err }
+
+func TestPlugins(t *testing.T) {
+	spec := []byte(readTestdata(t, "plugins.yml"))
+	routes := &routesPlugin{name: "test-routes"}
+	RegisterPlugin(routes)
+
+	cfg := Configuration{
+		PackageName: "api",
+		Output:      &Output{UseSingleFile: true},
+		Generate:    &GenerateOptions{Client: true},
+		Plugins: []PluginConfig{
+			{Name: "test-routes", Options: map[string]any{"prefix": "pets-service"}},
+		},
+	}
+
+	codes, err := Generate(spec, cfg)
+	require.NoError(t, err)
+
+	assert.True(t, routes.parsed)
+	assert.Contains(t, routes.types, "Pet")
+	assert.Contains(t, codes.GetCombined(), "// ListPets served by pets-service")
+	assert.Equal(t, map[string]string{"routes.txt": "pets-service: all"}, codes.GetExtraFiles())
+
+	_, err = format.Source([]byte(codes.GetCombined()))
+	require.NoError(t, err)
+
+	t.Run("multiple files", func(t *testing.T) {
+		cfg := cfg
+		cfg.Output = &Output{UseSingleFile: false}
+
+		codes, err := Generate(spec, cfg)
+		require.NoError(t, err)
+
+		routesFile := codes.GetExtraFiles()["routes.txt"]
+		assert.Contains(t, routesFile, "client,")
+		assert.Len(t, codes.GetExtraFiles(), 1)
+	})
+
+	t.Run("type hook error", func(t *testing.T) {
+		RegisterPlugin(&routesPlugin{name: "test-routes-type-err", err: errors.New("boom")})
+		cfg := cfg
+		cfg.Plugins = []PluginConfig{{Name: "test-routes-type-err", Options: map[string]any{"prefix": "x"}}}
+
+		_, err := Generate(spec, cfg)
+		assert.ErrorContains(t, err, `plugin "test-routes-type-err": type`)
+		assert.ErrorContains(t, err, "boom")
+	})
+
+	t.Run("hook errors", func(t *testing.T) {
+		boom := errors.New("boom")
+		tests := []struct {
+			plugin   Plugin
+			expected string
+		}{
+			{&afterParseErrPlugin{hookPlugin{name: "test-after-parse-err", err: boom}}, `plugin "test-after-parse-err": boom`},
+			{&operationErrPlugin{hookPlugin{name: "test-operation-err", err: boom}}, `plugin "test-operation-err": operation ListPets: boom`},
+			{&afterRenderErrPlugin{hookPlugin{name: "test-after-render-err", err: boom}}, `plugin "test-after-render-err": boom`},
+		}
+		for _, tt := range tests {
+			t.Run(tt.plugin.Name(), func(t *testing.T) {
+				RegisterPlugin(tt.plugin)
+				cfg := cfg
+				cfg.Plugins = []PluginConfig{{Name: tt.plugin.Name()}}
+
+				_, err := Generate(spec, cfg)
+				assert.ErrorContains(t, err, tt.expected)
+			})
+		}
+	})
+}
+
+func TestLoadPlugins(t *testing.T) {
+	RegisterPlugin(&hookPlugin{name: "test-load"})
+	RegisterPlugin(&routesPlugin{name: "test-load-configurable"})
+
+	tests := []struct {
+		name     string
+		cfgs     []PluginConfig
+		expected string
+	}{
+		{
+			name: "registered",
+			cfgs: []PluginConfig{{Name: "test-load"}},
+		},
+		{
+			name:     "not registered",
+			cfgs:     []PluginConfig{{Name: "test-missing"}},
+			expected: `plugin "test-missing" is not registered`,
+		},
+		{
+			name:     "no name or path",
+			cfgs:     []PluginConfig{{}},
+			expected: "plugin needs a name or a path",
+		},
+		{
+			name:     "path",
+			cfgs:     []PluginConfig{{Path: "testdata/missing.so"}},
+			expected: "plugin testdata/missing.so: Go plugins are only loaded by the oapi-codegen CLI",
+		},
+		{
+			name:     "configure error",
+			cfgs:     []PluginConfig{{Name: "test-load-configurable"}},
+			expected: `error configuring plugin "test-load-configurable": prefix option is required`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plugins, err := loadPlugins(tt.cfgs)
+			if tt.expected != "" {
+				assert.ErrorContains(t, err, tt.expected)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, plugins, len(tt.cfgs))
+		})
+	}
+
+	t.Run("parser", func(t *testing.T) {
+		_, err := NewParser(Configuration{Plugins: []PluginConfig{{Name: "test-missing"}}}, &ParseContext{})
+		assert.EqualError(t, err, `error loading plugins: plugin "test-missing" is not registered`)
+	})
+}
+
+func TestRegisterPlugin_duplicate(t *testing.T) {
+	RegisterPlugin(&hookPlugin{name: "test-duplicate"})
+	assert.PanicsWithValue(t, `codegen: plugin "test-duplicate" registered twice`, func() {
+		RegisterPlugin(&hookPlugin{name: "test-duplicate"})
+	})
+}
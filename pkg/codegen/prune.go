@@ -32,28 +32,14 @@ func pruneSchema(model *v3high.Document) error {
 		model.Components.Links = nil
 	}
 
-	// Iteratively prune unreferenced components
-	iteration := 0
-	maxIterations := 1000
+	// Components reachable from operations are found in a single walk, so one removal pass is enough
+	refs := findOperationRefs(model)
+	slog.Debug("Found operation refs", "count", len(refs))
 
-	// Iteratively prune unreferenced components until nothing left to remove
-	for {
-		iteration++
-		if iteration > maxIterations {
-			return fmt.Errorf("pruning exceeded maximum iterations (%d), possible infinite loop", maxIterations)
-		}
-
-		refs := findOperationRefs(model)
-		slog.Debug("Found operation refs", "count", len(refs), "iteration", iteration)
+	countRemoved := removeOrphanedComponents(model, refs)
+	slog.Debug("Removed orphaned components", "count", countRemoved)
 
-		countRemoved := removeOrphanedComponents(model, refs)
-		slog.Debug("Removed orphaned components", "count", countRemoved, "iteration", iteration)
-
-		if countRemoved < 1 {
-			// Done pruning
-			return nil
-		}
-	}
+	return nil
 }
 
 func removeOrphanedComponents(model *v3high.Document, refs map[string]bool) int {
@@ -156,58 +142,22 @@ func findOperationRefs(model *v3high.Document) map[string]bool {
 		}
 	}
 
-	// Walk component parameters/requestBodies/responses/headers to collect schema refs
-	// These components are only kept if referenced by operations (collected above)
-	// But we need to walk them to find schemas they reference
-	if model.Components != nil {
-		if model.Components.Parameters != nil {
-			for _, param := range model.Components.Parameters.FromOldest() {
-				collectRefFromProxy(param, refSet, model)
-			}
-		}
-		if model.Components.RequestBodies != nil {
-			for _, reqBody := range model.Components.RequestBodies.FromOldest() {
-				collectRefFromProxy(reqBody, refSet, model)
-			}
-		}
-		if model.Components.Responses != nil {
-			for _, resp := range model.Components.Responses.FromOldest() {
-				collectRefFromProxy(resp, refSet, model)
+	// Walk the components referenced so far, e.g. component parameters and responses,
+	// adding the components they reference, until every referenced component is walked
+	walked := make(map[string]bool)
+	for {
+		var pending []string
+		for ref := range refSet {
+			if !walked[ref] {
+				pending = append(pending, ref)
 			}
 		}
-		if model.Components.Headers != nil {
-			for _, header := range model.Components.Headers.FromOldest() {
-				collectRefFromProxy(header, refSet, model)
-			}
+		if len(pending) == 0 {
+			break
 		}
-
-		// Walk component schemas that are already in refSet to collect refs to other schemas (for composition)
-		// This is done iteratively to handle transitive references
-		if model.Components.Schemas != nil {
-			// Keep expanding until no new refs are added
-			for {
-				prevSize := len(refSet)
-				for schemaName, schemaProxy := range model.Components.Schemas.FromOldest() {
-					if schemaProxy == nil {
-						continue
-					}
-					schemaRef := fmt.Sprintf("#/components/schemas/%s", schemaName)
-					// Only process schemas that are already in the refSet
-					if !refSet[schemaRef] {
-						continue
-					}
-					// Check if the schema proxy itself is a $ref to another schema
-					if targetRef := schemaProxy.GoLow().GetReference(); targetRef != "" {
-						refSet[targetRef] = true
-					}
-					// Collect refs from the schema's content (allOf, oneOf, anyOf, properties, etc.)
-					collectSchemaRefs(schemaProxy.Schema(), refSet, model)
-				}
-				// If no new refs were added, we're done
-				if len(refSet) == prevSize {
-					break
-				}
-			}
+		for _, ref := range pending {
+			walked[ref] = true
+			collectComponentRefs(ref, refSet, model)
 		}
 	}
 
@@ -215,6 +165,59 @@ func findOperationRefs(model *v3high.Document) map[string]bool {
 	return refSet
 }
 
+// collectComponentRefs collects the refs of the component the ref points to in the model.
+func collectComponentRefs(ref string, refSet map[string]bool, model *v3high.Document) {
+	if model.Components == nil {
+		return
+	}
+	kind, name, ok := strings.Cut(strings.TrimPrefix(ref, "#/components/"), "/")
+	if !ok || !strings.HasPrefix(ref, "#/components/") {
+		return
+	}
+
+	switch kind {
+	case "schemas":
+		if model.Components.Schemas == nil {
+			return
+		}
+		if proxy, found := model.Components.Schemas.Get(name); found && proxy != nil {
+			// the component schema may itself be a $ref to another schema
+			if targetRef := proxy.GoLow().GetReference(); targetRef != "" {
+				refSet[targetRef] = true
+			}
+			collectSchemaRefs(proxy.Schema(), refSet, model)
+		}
+	case "parameters":
+		if model.Components.Parameters == nil {
+			return
+		}
+		if param, found := model.Components.Parameters.Get(name); found {
+			collectRefFromProxy(param, refSet, model)
+		}
+	case "requestBodies":
+		if model.Components.RequestBodies == nil {
+			return
+		}
+		if reqBody, found := model.Components.RequestBodies.Get(name); found {
+			collectRefFromProxy(reqBody, refSet, model)
+		}
+	case "responses":
+		if model.Components.Responses == nil {
+			return
+		}
+		if resp, found := model.Components.Responses.Get(name); found {
+			collectRefFromProxy(resp, refSet, model)
+		}
+	case "headers":
+		if model.Components.Headers == nil {
+			return
+		}
+		if header, found := model.Components.Headers.Get(name); found {
+			collectRefFromProxy(header, refSet, model)
+		}
+	}
+}
+
 // addParentSchemaRef adds the parent schema reference if the given ref is a property reference
 // e.g., if ref is "#/components/schemas/Foo/properties/bar", also add "#/components/schemas/Foo"
 func addParentSchemaRef(ref string, refSet map[string]bool) {
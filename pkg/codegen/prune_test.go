@@ -12,9 +12,11 @@ package codegen
 
 import (
 	"os"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestFindReferences(t *testing.T) {
@@ -113,7 +115,7 @@ func TestFilterOnlyCat(t *testing.T) {
 
 	assert.NotEmpty(t, m2.Model.Paths.PathItems.GetOrZero("/cat"), "/cat path should still be in spec")
 	assert.NotEmpty(t, m2.Model.Paths.PathItems.GetOrZero("/cat").Get, "GET /cat operation should still be in spec")
-	assert.Empty(t, m2.Model.Paths.PathItems.GetOrZero("/dog").Get, "GET /dog should have been removed from spec")
+	assert.Nil(t, m2.Model.Paths.PathItems.GetOrZero("/dog"), "/dog path should have been removed from spec")
 
 	err = pruneSchema(&m2.Model)
 	assert.Nil(t, err)
@@ -156,7 +158,7 @@ func TestFilterOnlyDog(t *testing.T) {
 
 	assert.NotEmpty(t, m2.Model.Paths.PathItems.GetOrZero("/dog"))
 	assert.NotEmpty(t, m2.Model.Paths.PathItems.GetOrZero("/dog").Get)
-	assert.Empty(t, m2.Model.Paths.PathItems.GetOrZero("/cat").Get)
+	assert.Nil(t, m2.Model.Paths.PathItems.GetOrZero("/cat"), "/cat path should have been removed from spec")
 
 	err = pruneSchema(&m2.Model)
 	assert.Nil(t, err)
@@ -429,6 +431,26 @@ func TestPruneDefaultResponseHeaders(t *testing.T) {
 	})
 }
 
+func TestPruneUnreferencedChains(t *testing.T) {
+	contents, err := os.ReadFile("testdata/prune-unreferenced-chains.yml")
+	require.NoError(t, err)
+
+	doc, err := LoadDocumentFromContents(contents)
+	require.NoError(t, err)
+	model, err := doc.BuildV3Model()
+	require.NoError(t, err)
+
+	require.NoError(t, pruneSchema(&model.Model))
+
+	components := model.Model.Components
+	assert.Equal(t, []string{"Limit"}, slices.Collect(components.Parameters.KeysFromOldest()))
+	assert.Equal(t, []string{"Pets"}, slices.Collect(components.Responses.KeysFromOldest()))
+	assert.Equal(t, []string{"X-Total"}, slices.Collect(components.Headers.KeysFromOldest()))
+	// unreferenced components and everything only they reference are removed in one pass,
+	// aliases of referenced schemas keep their targets
+	assert.Equal(t, []string{"Limit", "Total", "PetList", "PetAlias", "Pet"}, slices.Collect(components.Schemas.KeysFromOldest()))
+}
+
 func TestPruneExamples(t *testing.T) {
 	t.Run("examples removed during pruning", func(t *testing.T) {
 		contents, err := os.ReadFile("testdata/with-examples.yml")
@@ -0,0 +1,152 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ReadWriteOnlyView lists the JSON properties of a type omitted when it is marshaled as a request or response body,
+// and the properties holding nested types with properties to omit.
+type ReadWriteOnlyView struct {
+	Omit   []string
+	Nested []Property
+}
+
+// IsEmpty returns true if marshaling the type doesn't omit anything.
+func (v ReadWriteOnlyView) IsEmpty() bool {
+	return len(v.Omit) == 0 && len(v.Nested) == 0
+}
+
+// Args returns the omitted and nested properties arguments of runtime.MarshalJSONOmitting for the value named alias.
+func (v ReadWriteOnlyView) Args(alias string) string {
+	omit := "nil"
+	if len(v.Omit) > 0 {
+		names := make([]string, len(v.Omit))
+		for i, name := range v.Omit {
+			names[i] = fmt.Sprintf("%q", name)
+		}
+		omit = "[]string{" + strings.Join(names, ", ") + "}"
+	}
+
+	nested := "nil"
+	if len(v.Nested) > 0 {
+		values := make([]string, len(v.Nested))
+		for i, p := range v.Nested {
+			values[i] = fmt.Sprintf("%q: %s.%s", p.JsonFieldName, alias, p.GoName)
+		}
+		nested = "map[string]any{" + strings.Join(values, ", ") + "}"
+	}
+
+	return omit + ", " + nested
+}
+
+// ReadWriteOnlyViews are the request view, omitting readOnly properties, and the response view,
+// omitting writeOnly properties, of a type.
+type ReadWriteOnlyViews struct {
+	Request  ReadWriteOnlyView
+	Response ReadWriteOnlyView
+}
+
+// setReadWriteOnlyViews sets the views of the struct types with readOnly or writeOnly properties,
+// directly or in the named types of their properties.
+func setReadWriteOnlyViews(typeDefs []TypeDefinition) {
+	readOnly := readWriteOnlyTypes(typeDefs, readOnlyConstraint)
+	writeOnly := readWriteOnlyTypes(typeDefs, writeOnlyConstraint)
+
+	for i, td := range typeDefs {
+		if !isPlainStruct(td) {
+			continue
+		}
+		views := ReadWriteOnlyViews{
+			Request:  readWriteOnlyView(td, readOnly, readOnlyConstraint),
+			Response: readWriteOnlyView(td, writeOnly, writeOnlyConstraint),
+		}
+		if !views.Request.IsEmpty() || !views.Response.IsEmpty() {
+			typeDefs[i].ReadWriteOnly = &views
+		}
+	}
+}
+
+// readWriteOnlyTypes returns the names of the types with properties flagged by flag,
+// directly or in the named types of their properties, elements or aliased types.
+func readWriteOnlyTypes(typeDefs []TypeDefinition, flag func(Constraints) *bool) map[string]bool {
+	res := make(map[string]bool)
+	for changed := true; changed; {
+		changed = false
+		for _, td := range typeDefs {
+			if res[td.Name] || !hasReadWriteOnly(td, res, flag) {
+				continue
+			}
+			res[td.Name] = true
+			changed = true
+		}
+	}
+	return res
+}
+
+func hasReadWriteOnly(td TypeDefinition, types map[string]bool, flag func(Constraints) *bool) bool {
+	if !isPlainStruct(td) {
+		return types[baseTypeName(td.Schema.TypeDecl())]
+	}
+	for _, p := range td.Schema.Properties {
+		if isFlagged(p, flag) || (p.JsonFieldName != "" && types[baseTypeName(p.Schema.TypeDecl())]) {
+			return true
+		}
+	}
+	return false
+}
+
+func readWriteOnlyView(td TypeDefinition, types map[string]bool, flag func(Constraints) *bool) ReadWriteOnlyView {
+	var view ReadWriteOnlyView
+	for _, p := range td.Schema.Properties {
+		switch {
+		case p.JsonFieldName == "":
+			continue
+		case isFlagged(p, flag):
+			view.Omit = append(view.Omit, p.JsonFieldName)
+		case types[baseTypeName(p.Schema.TypeDecl())]:
+			view.Nested = append(view.Nested, p)
+		}
+	}
+	return view
+}
+
+func readOnlyConstraint(c Constraints) *bool  { return c.ReadOnly }
+func writeOnlyConstraint(c Constraints) *bool { return c.WriteOnly }
+
+func isFlagged(p Property, flag func(Constraints) *bool) bool {
+	v := flag(p.Constraints)
+	return v != nil && *v
+}
+
+// isPlainStruct returns true if the type is a struct whose generated methods can omit properties.
+func isPlainStruct(td TypeDefinition) bool {
+	return !td.IsAlias() && len(td.Schema.Properties) > 0 && len(td.Schema.UnionElements) == 0 &&
+		td.Schema.ArrayType == nil
+}
+
+// baseTypeName strips pointers, slices and maps from a type declaration, e.g. map[string][]*Pet -> Pet.
+func baseTypeName(typeDecl string) string {
+	for {
+		switch {
+		case strings.HasPrefix(typeDecl, "*"):
+			typeDecl = typeDecl[1:]
+		case strings.HasPrefix(typeDecl, "[]"):
+			typeDecl = typeDecl[2:]
+		case strings.HasPrefix(typeDecl, "map[string]"):
+			typeDecl = strings.TrimPrefix(typeDecl, "map[string]")
+		default:
+			return typeDecl
+		}
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnforceReadWriteOnly(t *testing.T) {
+	spec := []byte(readTestdata(t, "read-write-only.yml"))
+
+	t.Run("disabled", func(t *testing.T) {
+		codes, err := Generate(spec, Configuration{PackageName: "api"})
+		require.NoError(t, err)
+		assert.NotContains(t, codes.GetCombined(), "MarshalJSONForRequest")
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		codes, err := Generate(spec, Configuration{
+			PackageName: "api",
+			Generate:    &GenerateOptions{EnforceReadWriteOnly: true},
+		})
+		require.NoError(t, err)
+
+		code := codes.GetCombined()
+		assert.Contains(t, code, `// MarshalJSONForRequest marshals Team as a request body, omitting readOnly properties.
+func (t Team) MarshalJSONForRequest() ([]byte, error) {
+	return runtime.MarshalJSONOmitting(t, []string{"id"}, map[string]any{"lead": t.Lead, "members": t.Members}, runtime.MarshalJSONForRequest)
+}`)
+		assert.Contains(t, code, `func (t Team) MarshalJSONForResponse() ([]byte, error) {
+	return runtime.MarshalJSONOmitting(t, nil, map[string]any{"lead": t.Lead, "members": t.Members}, runtime.MarshalJSONForResponse)
+}`)
+		assert.Contains(t, code, `func (m Member) MarshalJSONForResponse() ([]byte, error) {
+	return runtime.MarshalJSONOmitting(m, []string{"password"}, nil, runtime.MarshalJSONForResponse)
+}`)
+		assert.Contains(t, code, "func (c CreateTeamBody) MarshalJSONForRequest() ([]byte, error) {")
+	})
+}
+
+func TestSetReadWriteOnlyViews(t *testing.T) {
+	readOnly, writeOnly := true, true
+	typeDefs := []TypeDefinition{
+		{
+			Name: "Pet",
+			Schema: GoSchema{Properties: []Property{
+				{GoName: "ID", JsonFieldName: "id", Constraints: Constraints{ReadOnly: &readOnly}},
+				{GoName: "Name", JsonFieldName: "name"},
+			}},
+		},
+		{Name: "Pets", Schema: GoSchema{GoType: "[]Pet", ArrayType: &GoSchema{RefType: "Pet"}}},
+		{Name: "PetAlias", Schema: GoSchema{RefType: "Pet", DefineViaAlias: true}},
+		{
+			Name: "Owner",
+			Schema: GoSchema{Properties: []Property{
+				{GoName: "Pets", JsonFieldName: "pets", Schema: GoSchema{RefType: "Pets"}},
+				{GoName: "Favorite", JsonFieldName: "favorite", Schema: GoSchema{GoType: "*PetAlias"}},
+				{GoName: "Secret", JsonFieldName: "secret", Constraints: Constraints{WriteOnly: &writeOnly}},
+				{GoName: "Base", Schema: GoSchema{RefType: "Pet"}},
+			}},
+		},
+		{
+			Name: "Plain",
+			Schema: GoSchema{Properties: []Property{
+				{GoName: "Name", JsonFieldName: "name", Schema: GoSchema{GoType: "string"}},
+			}},
+		},
+	}
+
+	setReadWriteOnlyViews(typeDefs)
+
+	assert.Equal(t, &ReadWriteOnlyViews{Request: ReadWriteOnlyView{Omit: []string{"id"}}}, typeDefs[0].ReadWriteOnly)
+	assert.Nil(t, typeDefs[1].ReadWriteOnly)
+	assert.Nil(t, typeDefs[2].ReadWriteOnly)
+	assert.Nil(t, typeDefs[4].ReadWriteOnly)
+
+	owner := typeDefs[3].ReadWriteOnly
+	require.NotNil(t, owner)
+	assert.Equal(t, []Property{typeDefs[3].Schema.Properties[0], typeDefs[3].Schema.Properties[1]}, owner.Request.Nested)
+	assert.Equal(t, []string{"secret"}, owner.Response.Omit)
+	assert.Equal(t, `nil, map[string]any{"pets": o.Pets, "favorite": o.Favorite}`, owner.Request.Args("o"))
+	assert.Equal(t, `[]string{"secret"}, nil`, owner.Response.Args("o"))
+}
+
+func Test_baseTypeName(t *testing.T) {
+	assert.Equal(t, "Pet", baseTypeName("Pet"))
+	assert.Equal(t, "Pet", baseTypeName("*Pet"))
+	assert.Equal(t, "Pet", baseTypeName("map[string][]*Pet"))
+	assert.Equal(t, "struct{}", baseTypeName("[]struct{}"))
+}
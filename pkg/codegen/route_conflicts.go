@@ -0,0 +1,149 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// routeMatchRules are the rules of a router deciding whether two routes of the same method conflict,
+// given their path segments of the same length.
+type routeMatchRules func(a, b []string) bool
+
+// routers maps the routers checked for route conflicts to their matching rules.
+var routers = map[string]routeMatchRules{
+	"net/http":   serveMuxConflict,
+	"chi":        staticPriorityConflict,
+	"echo":       staticPriorityConflict,
+	"gin":        staticPriorityConflict,
+	"httprouter": httpRouterConflict,
+}
+
+// checkRouteConflicts returns an error for each pair of operations whose paths conflict on the router:
+// requests the router can't route to one operation unambiguously, or routes it refuses to register.
+func checkRouteConflicts(operations []OperationDefinition, router string) error {
+	conflict, ok := routers[router]
+	if !ok {
+		return fmt.Errorf("unknown router %q for route conflicts, expected one of net/http, chi, echo, gin or httprouter", router)
+	}
+
+	var errs []error
+	for i, a := range operations {
+		for _, b := range operations[i+1:] {
+			if !strings.EqualFold(a.Method, b.Method) {
+				continue
+			}
+			segmentsA, segmentsB := pathSegments(a.Path), pathSegments(b.Path)
+			if len(segmentsA) != len(segmentsB) && router != "httprouter" {
+				continue
+			}
+			if conflict(segmentsA, segmentsB) {
+				errs = append(errs, fmt.Errorf("%w on %s: %s %s (%s) and %s (%s)",
+					ErrRouteConflict, router, strings.ToUpper(a.Method), a.Path, a.SpecID, b.Path, b.SpecID))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// checkServeMuxPatterns returns an error for each operation whose path http.ServeMux refuses to register:
+// path parameters must be whole segments, named with letters, digits and underscores, e.g. {petId}.
+func checkServeMuxPatterns(operations []OperationDefinition) error {
+	var errs []error
+	for _, op := range operations {
+		for _, segment := range pathSegments(op.Path) {
+			if !isPathParam(segment) {
+				continue
+			}
+			name, whole := strings.CutPrefix(segment, "{")
+			name, closed := strings.CutSuffix(name, "}")
+			if !whole || !closed || !isServeMuxWildcardName(name) {
+				errs = append(errs, fmt.Errorf("%w on net/http: %s %s (%s): path parameter %s must be a whole segment named with letters, digits and underscores",
+					ErrInvalidRoute, strings.ToUpper(op.Method), op.Path, op.SpecID, segment))
+				break
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// isServeMuxWildcardName follows the names http.ServeMux accepts for wildcards: Go identifiers, keywords included.
+func isServeMuxWildcardName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i, r := range name {
+		if !unicode.IsLetter(r) && r != '_' && (i == 0 || !unicode.IsDigit(r)) {
+			return false
+		}
+	}
+	return true
+}
+
+func pathSegments(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
+
+// isPathParam returns whether a path segment matches any value, e.g. {id} or {name}.json.
+func isPathParam(segment string) bool {
+	return strings.Contains(segment, "{")
+}
+
+// serveMuxConflict follows the precedence of http.ServeMux patterns: of two patterns matching the same requests,
+// the more specific one wins, and the patterns conflict if neither is, e.g. /pets/{id}/toys and /pets/mine/{kind}.
+func serveMuxConflict(a, b []string) bool {
+	moreSpecificA, moreSpecificB := false, false
+	for i := range a {
+		paramA, paramB := isPathParam(a[i]), isPathParam(b[i])
+		switch {
+		case !paramA && !paramB && a[i] != b[i]:
+			// no request matches both
+			return false
+		case !paramA && paramB:
+			moreSpecificA = true
+		case paramA && !paramB:
+			moreSpecificB = true
+		}
+	}
+	return moreSpecificA == moreSpecificB
+}
+
+// staticPriorityConflict follows routers trying static segments before parameters,
+// where only routes differing by the names of their parameters conflict, e.g. /pets/{id} and /pets/{name}.
+func staticPriorityConflict(a, b []string) bool {
+	for i := range a {
+		paramA, paramB := isPathParam(a[i]), isPathParam(b[i])
+		if paramA != paramB || (!paramA && a[i] != b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// httpRouterConflict follows httprouter, where a parameter can't share its position with a static segment
+// or a parameter of another name after the same prefix, e.g. /pets/{id} and /pets/mine.
+func httpRouterConflict(a, b []string) bool {
+	for i := range min(len(a), len(b)) {
+		paramA, paramB := isPathParam(a[i]), isPathParam(b[i])
+		switch {
+		case paramA || paramB:
+			if a[i] != b[i] {
+				return true
+			}
+		case a[i] != b[i]:
+			return false
+		}
+	}
+	return len(a) == len(b)
+}
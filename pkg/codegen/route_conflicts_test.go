@@ -0,0 +1,109 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouteConflicts(t *testing.T) {
+	spec := []byte(readTestdata(t, "route-conflicts.yml"))
+	generate := func(router string) error {
+		_, err := Generate(spec, Configuration{
+			PackageName: "api",
+			Generate:    &GenerateOptions{RouteConflicts: router},
+		})
+		return err
+	}
+
+	t.Run("net/http", func(t *testing.T) {
+		err := generate("net/http")
+		require.ErrorIs(t, err, ErrRouteConflict)
+		assert.Equal(t, "conflicting routes on net/http: GET /pets/{id}/toys (getPetToys) and /pets/mine/{kind} (getMyPetsOfKind)", err.Error())
+	})
+
+	t.Run("static priority", func(t *testing.T) {
+		require.NoError(t, generate("chi"))
+	})
+
+	t.Run("httprouter", func(t *testing.T) {
+		err := generate("httprouter")
+		require.ErrorIs(t, err, ErrRouteConflict)
+		assert.Contains(t, err.Error(), "GET /pets/{id} (getPet) and /pets/mine (getMyPets)")
+		assert.Len(t, err.(interface{ Unwrap() []error }).Unwrap(), 4)
+	})
+
+	t.Run("unknown router", func(t *testing.T) {
+		err := generate("gorilla")
+		require.Error(t, err)
+		assert.False(t, errors.Is(err, ErrRouteConflict))
+	})
+}
+
+func TestCheckRouteConflicts(t *testing.T) {
+	op := func(id, method, path string) OperationDefinition {
+		return OperationDefinition{SpecID: id, Method: method, Path: path}
+	}
+
+	tests := []struct {
+		name     string
+		router   string
+		a, b     OperationDefinition
+		conflict bool
+	}{
+		{"different methods", "chi", op("a", "GET", "/pets/{id}"), op("b", "POST", "/pets/{name}"), false},
+		{"parameter names", "chi", op("a", "GET", "/pets/{id}"), op("b", "GET", "/pets/{name}"), true},
+		{"static before parameter", "echo", op("a", "GET", "/pets/{id}"), op("b", "GET", "/pets/mine"), false},
+		{"more specific pattern", "net/http", op("a", "GET", "/pets/{id}"), op("b", "GET", "/pets/mine"), false},
+		{"same pattern", "net/http", op("a", "GET", "/pets/{id}"), op("b", "GET", "/pets/{name}"), true},
+		{"disjoint paths", "net/http", op("a", "GET", "/pets/{id}"), op("b", "GET", "/toys/{id}"), false},
+		{"nested same parameter", "httprouter", op("a", "GET", "/pets/{id}"), op("b", "GET", "/pets/{id}/toys"), false},
+		{"nested other parameter", "httprouter", op("a", "GET", "/pets/{id}"), op("b", "GET", "/pets/{name}/toys"), true},
+		{"parameter beside static", "httprouter", op("a", "GET", "/pets/{id}/toys"), op("b", "GET", "/pets/mine"), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkRouteConflicts([]OperationDefinition{tt.a, tt.b}, tt.router)
+			if tt.conflict {
+				assert.ErrorIs(t, err, ErrRouteConflict)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCheckServeMuxPatterns(t *testing.T) {
+	tests := []struct {
+		path  string
+		valid bool
+	}{
+		{"/pets/{petId}/toys", true},
+		{"/pets/{pet_id2}", true},
+		{"/pets/{petId}.json", false},
+		{"/pets/{pet-id}", false},
+		{"/pets/{2nd}", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			err := checkServeMuxPatterns([]OperationDefinition{{SpecID: "getPet", Method: "GET", Path: tt.path}})
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrInvalidRoute)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// RouteManifest is the machine-readable list of the generated operations, for API gateways and
+// config generators to allow exactly these routes with their security requirements and timeouts.
+type RouteManifest struct {
+	Title   string  `json:"title,omitempty"`
+	Version string  `json:"version,omitempty"`
+	Routes  []Route `json:"routes"`
+}
+
+// Route is an operation in the RouteManifest.
+// Timeout is in seconds with an "s" suffix, e.g. "1.5s", as used by Envoy and the protobuf JSON mapping.
+type Route struct {
+	Method      string                `json:"method"`
+	Path        string                `json:"path"`
+	OperationID string                `json:"operationId,omitempty"`
+	Security    []SecurityRequirement `json:"security"`
+	Timeout     string                `json:"timeout,omitempty"`
+	FeatureFlag string                `json:"featureFlag,omitempty"`
+}
+
+// NewRouteManifest builds the route manifest of the operations of ctx.
+func NewRouteManifest(ctx *ParseContext) RouteManifest {
+	res := RouteManifest{
+		Title:   ctx.Info.Title,
+		Version: ctx.Info.Version,
+		Routes:  make([]Route, 0, len(ctx.Operations)),
+	}
+	for _, op := range ctx.Operations {
+		route := Route{
+			Method:      op.Method,
+			Path:        op.Path,
+			OperationID: op.SpecID,
+			Security:    op.Security,
+			FeatureFlag: op.FeatureFlag,
+		}
+		if route.Security == nil {
+			route.Security = []SecurityRequirement{}
+		}
+		if op.Timeout > 0 {
+			route.Timeout = strconv.FormatFloat(op.Timeout.Seconds(), 'f', -1, 64) + "s"
+		}
+		res.Routes = append(res.Routes, route)
+	}
+	return res
+}
+
+// JSON returns the indented JSON encoding of the manifest.
+func (m RouteManifest) JSON() (string, error) {
+	out, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(out) + "\n", nil
+}
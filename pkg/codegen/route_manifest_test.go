@@ -0,0 +1,159 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouteManifest(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "api",
+		Output: &Output{
+			UseSingleFile: true,
+			RouteManifest: "routes.json",
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "route-manifest.yml")), cfg)
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{
+		"title": "Orders",
+		"version": "2.1.0",
+		"routes": [
+			{
+				"method": "GET",
+				"path": "/orders",
+				"operationId": "listOrders",
+				"security": [{"oauth": ["orders:read"]}],
+				"timeout": "1.5s"
+			},
+			{
+				"method": "POST",
+				"path": "/orders",
+				"operationId": "createOrder",
+				"security": [{"oauth": ["orders:write"], "apiKey": []}, {}],
+				"timeout": "10s",
+				"featureFlag": "new-checkout"
+			},
+			{
+				"method": "GET",
+				"path": "/health",
+				"operationId": "getHealth",
+				"security": []
+			}
+		]
+	}`, codes["routes.json"])
+	assert.Equal(t, map[string]string{"routes.json": codes["routes.json"]}, codes.GetExtraFiles())
+
+	t.Run("not configured", func(t *testing.T) {
+		cfg := cfg
+		cfg.Output = &Output{UseSingleFile: true}
+
+		codes, err := Generate([]byte(readTestdata(t, "route-manifest.yml")), cfg)
+		require.NoError(t, err)
+		assert.Empty(t, codes.GetExtraFiles())
+	})
+
+	t.Run("file name without extension", func(t *testing.T) {
+		cfg := cfg
+		cfg.Output = &Output{UseSingleFile: true, RouteManifest: "routes"}
+
+		_, err := Generate([]byte(readTestdata(t, "route-manifest.yml")), cfg)
+		assert.EqualError(t, err, `route manifest file name "routes" must have an extension`)
+	})
+}
+
+func TestOperationTimeout(t *testing.T) {
+	tests := []struct {
+		name       string
+		extensions map[string]any
+		expected   string
+		err        string
+	}{
+		{name: "not set", extensions: map[string]any{}, expected: "0s"},
+		{name: "duration", extensions: map[string]any{extTimeout: "2m"}, expected: "2m0s"},
+		{name: "invalid", extensions: map[string]any{extTimeout: "soon"}, err: `invalid x-timeout: time: invalid duration "soon"`},
+		{name: "not positive", extensions: map[string]any{extTimeout: "0s"}, err: "invalid x-timeout: timeout must be positive"},
+		{name: "not a string", extensions: map[string]any{extTimeout: []any{"1s"}}, err: "invalid x-timeout: failed to convert type: []interface {}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timeout, err := operationTimeout(tt.extensions)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, timeout.String())
+		})
+	}
+}
+
+func TestOperationFeatureFlag(t *testing.T) {
+	tests := []struct {
+		name       string
+		extensions map[string]any
+		expected   string
+		err        string
+	}{
+		{name: "not set", extensions: map[string]any{}},
+		{name: "flag", extensions: map[string]any{extFeatureFlag: "new-checkout"}, expected: "new-checkout"},
+		{name: "empty", extensions: map[string]any{extFeatureFlag: ""}, err: "invalid x-feature-flag: flag name must not be empty"},
+		{name: "not a string", extensions: map[string]any{extFeatureFlag: true}, err: "invalid x-feature-flag: failed to convert type: bool"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flag, err := operationFeatureFlag(tt.extensions)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, flag)
+		})
+	}
+}
+
+func TestServerBasePath(t *testing.T) {
+	spec := []byte(readTestdata(t, "route-manifest.yml"))
+
+	tests := []struct {
+		name          string
+		stripBasePath bool
+		requestURL    string
+	}{
+		{name: "prefixed", requestURL: `c.apiClient.GetBaseURL() + "/api/v2/orders"`},
+		{name: "stripped on the client", stripBasePath: true, requestURL: `c.apiClient.GetBaseURL() + "/orders"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Configuration{
+				PackageName: "api",
+				Generate:    &GenerateOptions{Client: true},
+				Output:      &Output{UseSingleFile: true, RouteManifest: "routes.json"},
+				Client:      &Client{StripBasePath: tt.stripBasePath},
+				Server:      &Server{BasePath: "/api/v2/"},
+			}
+			codes, err := Generate(spec, cfg)
+			require.NoError(t, err)
+
+			assert.Contains(t, codes["routes.json"], `"path": "/api/v2/orders"`)
+			assert.Contains(t, codes.GetCombined(), tt.requestURL)
+		})
+	}
+}
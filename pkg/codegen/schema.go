@@ -13,6 +13,7 @@ package codegen
 import (
 	"fmt"
 	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/pb33f/libopenapi/datamodel/high/base"
@@ -24,6 +25,7 @@ import (
 // RefType is the type name of the schema, if it has one.
 // ArrayType is the schema of the array element, if it's an array.
 // EnumValues is a map of enum values.
+// EnumDescriptions maps enum values to their x-enum-descriptions.
 // Properties is a list of fields for an object.
 // HasAdditionalProperties is true if the object has additional properties.
 // AdditionalPropertiesType is the type of additional properties.
@@ -39,6 +41,7 @@ type GoSchema struct {
 	RefType                  string
 	ArrayType                *GoSchema
 	EnumValues               map[string]string
+	EnumDescriptions         map[string]string
 	Properties               []Property
 	HasAdditionalProperties  bool
 	AdditionalPropertiesType *GoSchema
@@ -51,10 +54,19 @@ type GoSchema struct {
 	Discriminator *Discriminator
 	// True if this schema is a struct wrapper around a union (embedded Either or union field)
 	IsUnionWrapper bool
+	// True if unions of 3 or 4 elements are stored in runtime.OneOf3 or runtime.OneOf4
+	TypedUnion bool
+	// True if the anyOf union has an optional field for every element, set for all the elements the data matches
+	AnyOfVariants bool
+	// True if the union is a oneOf, whose data matches exactly one element
+	OneOf bool
 
 	DefineViaAlias   bool
 	IsPrimitiveAlias bool
 	OpenAPISchema    *base.Schema
+
+	// True if the type has no Validate() method and is not validated by its parents, set with x-go-omit-validation
+	OmitValidation bool
 }
 
 func (s GoSchema) IsRef() bool {
@@ -112,6 +124,10 @@ func (s GoSchema) GetAdditionalTypeDefs() []TypeDefinition {
 // - Union types (has union elements)
 // - Types with validation constraints
 func (s GoSchema) NeedsValidation() bool {
+	if s.OmitValidation {
+		return false
+	}
+
 	// External refs don't need validation (they're from other packages)
 	if s.IsExternalRef() {
 		return false
@@ -149,8 +165,8 @@ func (s GoSchema) NeedsValidation() bool {
 				return true
 			}
 		}
-		// No properties need validation
-		return false
+		// Additional properties next to the declared ones need validation
+		return s.additionalPropertiesNeedValidation()
 	}
 
 	// Check if it's a map with additionalProperties that need validation
@@ -169,8 +185,8 @@ func (s GoSchema) NeedsValidation() bool {
 
 	// Check if it's an array with items that need validation
 	if s.ArrayType != nil {
-		// Check if the array has minItems/maxItems constraints
-		if s.Constraints.MinItems != nil || s.Constraints.MaxItems != nil {
+		// Check if the array has minItems/maxItems/uniqueItems constraints
+		if s.Constraints.MinItems != nil || s.Constraints.MaxItems != nil || deref(s.Constraints.UniqueItems) {
 			return true
 		}
 		// Check if the array item type needs validation
@@ -251,9 +267,14 @@ func (s GoSchema) createGoStruct(fields []string) string {
 		)
 	}
 
-	if len(s.UnionElements) == 2 {
-		objectParts = append(objectParts, fmt.Sprintf("runtime.Either[%s, %s]", s.UnionElements[0], s.UnionElements[1]))
+	if unionType := s.UnionType(); unionType != "" {
+		objectParts = append(objectParts, unionType)
 	} else if len(s.UnionElements) > 0 {
+		if s.AnyOfVariants {
+			for _, elem := range s.UnionElements {
+				objectParts = append(objectParts, fmt.Sprintf("%s *%s `json:\"-\"`", elem.Method(), elem.TypeName))
+			}
+		}
 		objectParts = append(objectParts, "union json.RawMessage")
 	}
 
@@ -277,7 +298,83 @@ func (d *Discriminator) PropertyName() string {
 	return schemaNameToTypeName(d.Property)
 }
 
+// ValuesOf returns the sorted discriminator values mapped to the Go type.
+func (d *Discriminator) ValuesOf(typeName string) []string {
+	var res []string
+	for value, mapped := range d.Mapping {
+		if mapped == typeName {
+			res = append(res, value)
+		}
+	}
+	slices.Sort(res)
+	return res
+}
+
+// ValueOf returns the discriminator value of the Go type if it's the only one mapped to it.
+func (d *Discriminator) ValueOf(typeName string) string {
+	if values := d.ValuesOf(typeName); len(values) == 1 {
+		return values[0]
+	}
+	return ""
+}
+
+// CasesOf returns the discriminator values mapped to the Go type as Go string literals for a switch case.
+func (d *Discriminator) CasesOf(typeName string) string {
+	return quoteCases(d.ValuesOf(typeName))
+}
+
+// Cases returns all discriminator values of the mapping as Go string literals for a switch case.
+func (d *Discriminator) Cases() string {
+	values := make([]string, 0, len(d.Mapping))
+	for value := range d.Mapping {
+		values = append(values, value)
+	}
+	slices.Sort(values)
+	return quoteCases(values)
+}
+
+func quoteCases(values []string) string {
+	cases := make([]string, len(values))
+	for i, value := range values {
+		cases[i] = strconv.Quote(value)
+	}
+	return strings.Join(cases, ", ")
+}
+
 func GenerateGoSchema(schemaProxy *base.SchemaProxy, options ParseOptions) (GoSchema, error) {
+	if !options.omitValidation && schemaProxy != nil {
+		omit, err := omitValidation(resolveSchema(schemaProxy, options.model))
+		if err != nil {
+			return GoSchema{}, err
+		}
+		options.omitValidation = omit
+	}
+
+	res, err := generateGoSchema(schemaProxy, options)
+	if err != nil {
+		return GoSchema{}, err
+	}
+	res.OmitValidation = res.OmitValidation || options.omitValidation
+	return res, nil
+}
+
+// omitValidation returns true for schemas with x-go-omit-validation.
+func omitValidation(schema *base.Schema) (bool, error) {
+	if schema == nil {
+		return false, nil
+	}
+	extension, ok := extractExtensions(schema.Extensions)[extGoOmitValidation]
+	if !ok {
+		return false, nil
+	}
+	omit, err := parseBooleanValue(extension)
+	if err != nil {
+		return false, fmt.Errorf("invalid value for %q: %w", extGoOmitValidation, err)
+	}
+	return omit, nil
+}
+
+func generateGoSchema(schemaProxy *base.SchemaProxy, options ParseOptions) (GoSchema, error) {
 	// Add a fallback value in case the schemaProxy is nil.
 	// i.e. the parent schema defines a type:array, but the array has
 	// no items defined. Therefore, we have at least valid Go-Code.
@@ -417,6 +514,8 @@ func GenerateGoSchema(schemaProxy *base.SchemaProxy, options ParseOptions) (GoSc
 				constraints := newConstraints(schema, ConstraintsContext{
 					hasNilType:   slices.Contains(schema.Type, "null"),
 					specLocation: options.specLocation,
+					formatTags:   options.FormatTags,
+					decimalType:  options.DecimalType,
 				})
 				return GoSchema{
 					GoType:           refType,
@@ -519,6 +618,11 @@ func GenerateGoSchema(schemaProxy *base.SchemaProxy, options ParseOptions) (GoSc
 		if err != nil {
 			return outSchema, fmt.Errorf("invalid value for %q: %w", extPropGoType, err)
 		}
+		if typeName == "decimal" {
+			if typeName, err = decimalGoType(schema, options.DecimalType); err != nil {
+				return outSchema, err
+			}
+		}
 		outSchema.GoType = typeName
 		outSchema.DefineViaAlias = true
 
@@ -549,6 +653,8 @@ func GenerateGoSchema(schemaProxy *base.SchemaProxy, options ParseOptions) (GoSc
 			constraints := newConstraints(schema, ConstraintsContext{
 				hasNilType:   slices.Contains(schema.Type, "null"),
 				specLocation: options.specLocation,
+				formatTags:   options.FormatTags,
+				decimalType:  options.DecimalType,
 			})
 			return GoSchema{
 				GoType:         actualName,
@@ -571,6 +677,8 @@ func GenerateGoSchema(schemaProxy *base.SchemaProxy, options ParseOptions) (GoSc
 		// in discriminator contexts
 		constraints := newConstraints(schema, ConstraintsContext{
 			specLocation: options.specLocation,
+			formatTags:   options.FormatTags,
+			decimalType:  options.DecimalType,
 		})
 		return GoSchema{
 			GoType:         "string",
@@ -816,6 +924,9 @@ func enhanceSchema(src, other GoSchema, options ParseOptions) GoSchema {
 	src.Properties = append(src.Properties, other.Properties...)
 	src.Discriminator = other.Discriminator
 	src.UnionElements = other.UnionElements
+	src.TypedUnion = other.TypedUnion
+	src.AnyOfVariants = other.AnyOfVariants
+	src.OneOf = other.OneOf
 	src.AdditionalTypes = append(src.AdditionalTypes, other.AdditionalTypes...)
 
 	srcFields := genFieldsFromProperties(src.Properties, options)
@@ -12,8 +12,11 @@ package codegen
 
 import (
 	"fmt"
+	"maps"
+	"math"
 	"slices"
 	"sort"
+	"strconv"
 
 	"github.com/pb33f/libopenapi/datamodel/high/base"
 )
@@ -22,6 +25,64 @@ type ConstraintsContext struct {
 	hasNilType   bool
 	required     bool
 	specLocation SpecLocation
+	formatTags   map[string]string
+	decimalType  string
+}
+
+// defaultFormatTags maps the string formats validated by default to their validator tags.
+var defaultFormatTags = map[string]string{
+	"uuid":      "uuid",
+	"email":     "email",
+	"uri":       "uri",
+	"ipv4":      "ipv4",
+	"ipv6":      "ipv6",
+	"hostname":  "hostname_rfc1123",
+	"date":      "datetime=2006-01-02",
+	"date-time": "datetime=2006-01-02T15:04:05Z07:00",
+}
+
+// formatValidationTags returns the validator tags of string formats, overriding the defaults with formats.
+// Formats mapped to "-" are not validated and formats mapped to "" use the runtime "format" validation.
+func formatValidationTags(formats map[string]string) map[string]string {
+	res := maps.Clone(defaultFormatTags)
+	for format, tag := range formats {
+		switch tag {
+		case "-":
+			delete(res, format)
+		case "":
+			res[format] = "format=" + format
+		default:
+			res[format] = tag
+		}
+	}
+	return res
+}
+
+// formatValidationTag returns the validator tag of the string format of schema,
+// if the format is validated and the schema is generated as a Go string.
+func formatValidationTag(schema *base.Schema, formatTags map[string]string) string {
+	tag, ok := formatTags[schema.Format]
+	if !ok {
+		return ""
+	}
+	if _, ok := extractExtensions(schema.Extensions)[extPropGoType]; ok {
+		return ""
+	}
+
+	// These formats are generated as other Go types, validated when they are unmarshaled.
+	switch schema.Format {
+	case "byte", "date", "json", "binary":
+		return ""
+	case "date-time", "duration":
+		if len(schema.Enum) == 0 {
+			return ""
+		}
+	case "uuid":
+		if isStandardUUIDLength(schema) {
+			return ""
+		}
+	}
+	return tag
 }
 
 type Constraints struct {
@@ -34,8 +95,10 @@ type Constraints struct {
 	Pattern        *string
 	Min            *float64
 	Max            *float64
+	MultipleOf     *float64
 	MinItems       *int64
 	MaxItems       *int64
+	UniqueItems    *bool
 	MinProperties  *int64
 	MaxProperties  *int64
 	ValidationTags []string
@@ -51,8 +114,10 @@ func (c Constraints) IsEqual(other Constraints) bool {
 		ptrEqual(c.Pattern, other.Pattern) &&
 		ptrEqual(c.Min, other.Min) &&
 		ptrEqual(c.Max, other.Max) &&
+		ptrEqual(c.MultipleOf, other.MultipleOf) &&
 		ptrEqual(c.MinItems, other.MinItems) &&
 		ptrEqual(c.MaxItems, other.MaxItems) &&
+		ptrEqual(c.UniqueItems, other.UniqueItems) &&
 		ptrEqual(c.MinProperties, other.MinProperties) &&
 		ptrEqual(c.MaxProperties, other.MaxProperties) &&
 		slices.Equal(c.ValidationTags, other.ValidationTags)
@@ -81,6 +146,9 @@ func (c Constraints) Count() int {
 	if c.Max != nil {
 		count++
 	}
+	if c.MultipleOf != nil {
+		count++
+	}
 
 	// Array constraints
 	if c.MinItems != nil {
@@ -89,6 +157,9 @@ func (c Constraints) Count() int {
 	if c.MaxItems != nil {
 		count++
 	}
+	if c.UniqueItems != nil {
+		count++
+	}
 
 	// Object constraints
 	if c.MinProperties != nil {
@@ -110,15 +181,18 @@ func newConstraints(schema *base.Schema, opts ConstraintsContext) Constraints {
 		return Constraints{}
 	}
 
-	isInt := slices.Contains(schema.Type, "integer")
-	isFloat := slices.Contains(schema.Type, "number")
+	// Decimals are generated as Go structs, which have neither numeric nor length validation tags.
+	isDecimal := isDecimalSchema(schema, opts.decimalType)
+	isInt := !isDecimal && slices.Contains(schema.Type, "integer")
+	isFloat := !isDecimal && slices.Contains(schema.Type, "number")
 	isBoolean := slices.Contains(schema.Type, "boolean")
 	isString := slices.Contains(schema.Type, "string")
 
 	// Check if the string format converts to a non-string Go type.
 	// These formats do not support minLength/maxLength validation tags because
 	// the Go type is not a string (e.g., time.Time, uuid.UUID).
-	hasNonStringFormat := isString && (schema.Format == "date-time" || schema.Format == "date" || schema.Format == "uuid")
+	hasNonStringFormat := isString && (isDecimal || schema.Format == "date-time" || schema.Format == "date" || schema.Format == "uuid" ||
+		(schema.Format == "duration" && len(schema.Enum) == 0))
 	isArray := slices.Contains(schema.Type, "array")
 	isObject := schema.Type == nil || slices.Contains(schema.Type, "object")
 	var validationTags []string
@@ -178,7 +252,8 @@ func newConstraints(schema *base.Schema, opts ConstraintsContext) Constraints {
 	if required && nullable {
 		nullable = true
 	}
-	if required {
+	// nil pointers are valid values of nullable enums, even required ones
+	if required && !isNullableEnumSchema(schema) {
 		validationTags = append(validationTags, "required")
 	} else if nullable {
 		validationTags = append(validationTags, "omitempty")
@@ -194,46 +269,25 @@ func newConstraints(schema *base.Schema, opts ConstraintsContext) Constraints {
 		writeOnly = schema.WriteOnly
 	}
 
-	var minValue *float64
-	// Only store minimum for numeric types (integer/number)
-	// For strings, minimum is invalid per OpenAPI spec - ignore it completely
-	if schema.Minimum != nil && (isInt || isFloat) {
-		minTag := "gte"
-		val := *schema.Minimum
-		if schema.ExclusiveMinimum != nil && ((schema.ExclusiveMinimum.IsA() && schema.ExclusiveMinimum.A) || schema.ExclusiveMinimum.IsB()) {
-			minTag = "gt"
-			if schema.ExclusiveMinimum.IsB() {
-				val = schema.ExclusiveMinimum.B
-			}
-		}
-
-		minValue = &val
-		tag := fmt.Sprintf("%s=%g", minTag, val)
-		if isInt {
-			tag = fmt.Sprintf("%s=%d", minTag, int64(val))
+	// Only store bounds for numeric types (integer/number)
+	// For strings, minimum/maximum are invalid per OpenAPI spec - ignore them completely
+	var minValue, maxValue *float64
+	if isInt || isFloat {
+		var exclusive bool
+		minValue, exclusive = numericBound(schema.Minimum, schema.ExclusiveMinimum, func(a, b float64) bool { return a >= b })
+		if minValue != nil {
+			validationTags = append(validationTags, boundValidationTag(schema, *minValue, exclusive, true))
 		}
-		validationTags = append(validationTags, tag)
-	}
-
-	var maxValue *float64
-	// Only store maximum for numeric types (integer/number)
-	// For strings, maximum is invalid per OpenAPI spec - ignore it completely
-	if schema.Maximum != nil && (isInt || isFloat) {
-		maxTag := "lte"
-		val := *schema.Maximum
-		if schema.ExclusiveMaximum != nil && ((schema.ExclusiveMaximum.IsA() && schema.ExclusiveMaximum.A) || schema.ExclusiveMaximum.IsB()) {
-			maxTag = "lt"
-			if schema.ExclusiveMaximum.IsB() {
-				val = schema.ExclusiveMaximum.B
-			}
+		maxValue, exclusive = numericBound(schema.Maximum, schema.ExclusiveMaximum, func(a, b float64) bool { return a <= b })
+		if maxValue != nil {
+			validationTags = append(validationTags, boundValidationTag(schema, *maxValue, exclusive, false))
 		}
+	}
 
-		maxValue = &val
-		tag := fmt.Sprintf("%s=%g", maxTag, val)
-		if isInt {
-			tag = fmt.Sprintf("%s=%d", maxTag, int64(val))
-		}
-		validationTags = append(validationTags, tag)
+	var multipleOf *float64
+	if (isInt || isFloat) && schema.MultipleOf != nil && *schema.MultipleOf > 0 {
+		multipleOf = schema.MultipleOf
+		validationTags = append(validationTags, "multiple_of="+formatNumericParam(schema, *multipleOf))
 	}
 
 	var minLength *int64
@@ -252,6 +306,12 @@ func newConstraints(schema *base.Schema, opts ConstraintsContext) Constraints {
 		validationTags = append(validationTags, fmt.Sprintf("max=%d", *maxLength))
 	}
 
+	if isString {
+		if tag := formatValidationTag(schema, opts.formatTags); tag != "" {
+			validationTags = append(validationTags, tag)
+		}
+	}
+
 	var pattern *string
 	if schema.Pattern != "" {
 		pattern = &schema.Pattern
@@ -267,6 +327,11 @@ func newConstraints(schema *base.Schema, opts ConstraintsContext) Constraints {
 		maxItems = schema.MaxItems
 	}
 
+	var uniqueItems *bool
+	if isArray && deref(schema.UniqueItems) {
+		uniqueItems = ptr(true)
+	}
+
 	var minProperties *int64
 	if schema.MinProperties != nil {
 		minProperties = schema.MinProperties
@@ -281,28 +346,7 @@ func newConstraints(schema *base.Schema, opts ConstraintsContext) Constraints {
 		validationTags = nil
 	}
 
-	// place required, omitempty first in the list, then sort the rest
-	sort.Slice(validationTags, func(i, j int) bool {
-		a, b := validationTags[i], validationTags[j]
-
-		// Define priority order
-		priority := func(tag string) int {
-			switch tag {
-			case "required":
-				return 0
-			case "omitempty":
-				return 1
-			default:
-				return 2
-			}
-		}
-
-		pa, pb := priority(a), priority(b)
-		if pa != pb {
-			return pa < pb
-		}
-		return a < b
-	})
+	sortValidationTags(validationTags)
 
 	var requiredPtr *bool
 	if required {
@@ -321,13 +365,101 @@ func newConstraints(schema *base.Schema, opts ConstraintsContext) Constraints {
 		WriteOnly:      writeOnly,
 		Min:            minValue,
 		Max:            maxValue,
+		MultipleOf:     multipleOf,
 		MinLength:      minLength,
 		MaxLength:      maxLength,
 		Pattern:        pattern,
 		MinItems:       minItems,
 		MaxItems:       maxItems,
+		UniqueItems:    uniqueItems,
 		MinProperties:  minProperties,
 		MaxProperties:  maxProperties,
 		ValidationTags: validationTags,
 	}
 }
+
+// numericBound returns the stricter of an inclusive bound and an exclusive bound, and whether it is exclusive.
+// The exclusive bound is either the boolean of OpenAPI 3.0, making the inclusive bound exclusive,
+// or the number of OpenAPI 3.1. stricter reports whether the first bound is at least as strict as the second.
+func numericBound(bound *float64, exclusive *base.DynamicValue[bool, float64], stricter func(a, b float64) bool) (*float64, bool) {
+	if exclusive == nil {
+		return bound, false
+	}
+	if exclusive.IsB() {
+		if bound == nil || stricter(exclusive.B, *bound) {
+			val := exclusive.B
+			return &val, true
+		}
+		return bound, false
+	}
+	return bound, bound != nil && exclusive.A
+}
+
+// boundValidationTag returns the validator tag of a minimum (gt, gte) or maximum (lt, lte) of schema.
+// Fractional bounds of integers are rounded to the inclusive integer bound, e.g. exclusiveMinimum: 0.5 is gte=1.
+func boundValidationTag(schema *base.Schema, val float64, exclusive, isMin bool) string {
+	tag := "lte"
+	if isMin {
+		tag = "gte"
+	}
+	if exclusive {
+		tag = tag[:2]
+	}
+
+	if isIntegerGoType(schema) {
+		if val != math.Trunc(val) {
+			if isMin {
+				return fmt.Sprintf("gte=%d", int64(math.Ceil(val)))
+			}
+			return fmt.Sprintf("lte=%d", int64(math.Floor(val)))
+		}
+		return fmt.Sprintf("%s=%d", tag, int64(val))
+	}
+	return tag + "=" + formatNumericParam(schema, val)
+}
+
+// formatNumericParam formats val as a validator tag parameter for values of schema, at the precision of their Go type.
+// The validator parses the parameters of float32 fields as float32, so e.g. gte=0.01 lets float32(0.01) pass.
+func formatNumericParam(schema *base.Schema, val float64) string {
+	if isIntegerGoType(schema) && val == math.Trunc(val) {
+		return strconv.FormatInt(int64(val), 10)
+	}
+	if slices.Contains(schema.Type, "number") && !slices.Contains([]string{"double", "decimal"}, schema.Format) {
+		return strconv.FormatFloat(val, 'g', -1, 32)
+	}
+	return strconv.FormatFloat(val, 'g', -1, 64)
+}
+
+// isIntegerGoType reports whether schema is generated as a Go integer type,
+// including numbers with an integer format.
+func isIntegerGoType(schema *base.Schema) bool {
+	if slices.Contains(schema.Type, "integer") {
+		return true
+	}
+	return slices.Contains(schema.Type, "number") && slices.Contains([]string{"integer", "int", "int32", "int64"}, schema.Format)
+}
+
+// sortValidationTags places required and omitempty first in the list, then sorts the rest.
+func sortValidationTags(validationTags []string) {
+	sort.Slice(validationTags, func(i, j int) bool {
+		a, b := validationTags[i], validationTags[j]
+
+		// Define priority order
+		priority := func(tag string) int {
+			switch tag {
+			case "required":
+				return 0
+			case "omitempty":
+				return 1
+			default:
+				return 2
+			}
+		}
+
+		pa, pb := priority(a), priority(b)
+		if pa != pb {
+			return pa < pb
+		}
+		return a < b
+	})
+}
@@ -16,6 +16,8 @@ import (
 
 	"github.com/pb33f/libopenapi/datamodel/high/base"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.yaml.in/yaml/v4"
 )
 
 func TestNewConstraints(t *testing.T) {
@@ -418,6 +420,244 @@ func TestNewConstraints_NonStringFormats(t *testing.T) {
 	})
 }
 
+func TestNewConstraints_FormatTags(t *testing.T) {
+	formatTags := formatValidationTags(map[string]string{
+		"hostname": "-",
+		"phone":    "e164",
+		"sku":      "",
+		"duration": "",
+	})
+
+	tests := []struct {
+		name     string
+		schema   *base.Schema
+		required bool
+		want     []string
+	}{
+		{
+			name:   "ipv4",
+			schema: &base.Schema{Type: []string{"string"}, Format: "ipv4"},
+			want:   []string{"omitempty", "ipv4"},
+		},
+		{
+			name:     "required uri",
+			schema:   &base.Schema{Type: []string{"string"}, Format: "uri"},
+			required: true,
+			want:     []string{"required", "uri"},
+		},
+		{
+			name:   "email",
+			schema: &base.Schema{Type: []string{"string"}, Format: "email"},
+			want:   []string{"omitempty", "email"},
+		},
+		{
+			name:   "uuid with non-standard length is a string",
+			schema: &base.Schema{Type: []string{"string"}, Format: "uuid", MaxLength: ptr(int64(64))},
+			want:   []string{"omitempty", "uuid"},
+		},
+		{
+			name:   "uuid.UUID is not tagged",
+			schema: &base.Schema{Type: []string{"string"}, Format: "uuid"},
+		},
+		{
+			name:   "runtime.Date is not tagged",
+			schema: &base.Schema{Type: []string{"string"}, Format: "date"},
+		},
+		{
+			name:   "runtime.Duration is not tagged",
+			schema: &base.Schema{Type: []string{"string"}, Format: "duration", MinLength: ptr(int64(3))},
+		},
+		{
+			name: "duration enum is a string",
+			schema: &base.Schema{Type: []string{"string"}, Format: "duration", Enum: []*yaml.Node{
+				{Kind: yaml.ScalarNode, Value: "PT1H"},
+			}},
+			want: []string{"omitempty", "format=duration"},
+		},
+		{
+			name: "date-time enum is a string",
+			schema: &base.Schema{Type: []string{"string"}, Format: "date-time", Enum: []*yaml.Node{
+				{Kind: yaml.ScalarNode, Value: "2025-01-01T00:00:00Z"},
+			}},
+			want: []string{"omitempty", "datetime=2006-01-02T15:04:05Z07:00"},
+		},
+		{
+			name:   "disabled format",
+			schema: &base.Schema{Type: []string{"string"}, Format: "hostname"},
+		},
+		{
+			name:   "validator tag",
+			schema: &base.Schema{Type: []string{"string"}, Format: "phone"},
+			want:   []string{"omitempty", "e164"},
+		},
+		{
+			name:   "custom format",
+			schema: &base.Schema{Type: []string{"string"}, Format: "sku"},
+			want:   []string{"omitempty", "format=sku"},
+		},
+		{
+			name:   "unknown format",
+			schema: &base.Schema{Type: []string{"string"}, Format: "color"},
+		},
+		{
+			name:   "integer",
+			schema: &base.Schema{Type: []string{"integer"}, Format: "uuid"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := newConstraints(tt.schema, ConstraintsContext{
+				required:   tt.required,
+				formatTags: formatTags,
+			})
+			assert.Equal(t, tt.want, res.ValidationTags)
+		})
+	}
+}
+
+func TestFormatValidationCodegen(t *testing.T) {
+	spec := `openapi: 3.0.0
+info:
+  title: Test
+  version: 1.0.0
+paths:
+  /items:
+    post:
+      operationId: createItem
+      requestBody:
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/Item'
+      responses:
+        '204':
+          description: ok
+components:
+  schemas:
+    Item:
+      type: object
+      required: [sku]
+      properties:
+        sku:
+          type: string
+          format: sku
+        host:
+          type: string
+          format: hostname
+`
+	cfg := Configuration{
+		PackageName: "api",
+		Generate: &GenerateOptions{
+			Validation: ValidationOptions{Formats: map[string]string{"sku": ""}},
+		},
+	}
+	codes, err := Generate([]byte(spec), cfg)
+	require.NoError(t, err)
+
+	code := codes.GetCombined()
+	assert.Contains(t, code, "`json:\"sku\" validate:\"required,format=sku\"`")
+	assert.Contains(t, code, "`json:\"host,omitempty\" validate:\"omitempty,hostname_rfc1123\"`")
+}
+
+func TestNewConstraints_NumericBounds(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema *base.Schema
+		want   []string
+	}{
+		{
+			name: "3.1 exclusive minimum without minimum",
+			schema: &base.Schema{
+				Type:             []string{"number"},
+				Format:           "double",
+				ExclusiveMinimum: &base.DynamicValue[bool, float64]{N: 1, B: 0},
+			},
+			want: []string{"omitempty", "gt=0"},
+		},
+		{
+			name: "3.1 stricter inclusive maximum",
+			schema: &base.Schema{
+				Type:             []string{"number"},
+				Format:           "double",
+				Maximum:          ptr(float64(10)),
+				ExclusiveMaximum: &base.DynamicValue[bool, float64]{N: 1, B: 20},
+			},
+			want: []string{"omitempty", "lte=10"},
+		},
+		{
+			name: "3.0 exclusive maximum",
+			schema: &base.Schema{
+				Type:             []string{"integer"},
+				Maximum:          ptr(float64(10)),
+				ExclusiveMaximum: &base.DynamicValue[bool, float64]{A: true},
+			},
+			want: []string{"omitempty", "lt=10"},
+		},
+		{
+			name: "3.0 exclusive without bound",
+			schema: &base.Schema{
+				Type:             []string{"integer"},
+				ExclusiveMinimum: &base.DynamicValue[bool, float64]{A: true},
+			},
+		},
+		{
+			name: "fractional integer bounds",
+			schema: &base.Schema{
+				Type:             []string{"integer"},
+				ExclusiveMinimum: &base.DynamicValue[bool, float64]{N: 1, B: -0.5},
+				Maximum:          ptr(9.5),
+			},
+			want: []string{"omitempty", "gte=0", "lte=9"},
+		},
+		{
+			name: "float32 bounds at float32 precision",
+			schema: &base.Schema{
+				Type:             []string{"number"},
+				ExclusiveMinimum: &base.DynamicValue[bool, float64]{N: 1, B: 0.1},
+			},
+			want: []string{"omitempty", "gt=0.1"},
+		},
+		{
+			name: "integer multipleOf",
+			schema: &base.Schema{
+				Type:       []string{"integer"},
+				MultipleOf: ptr(float64(5)),
+			},
+			want: []string{"omitempty", "multiple_of=5"},
+		},
+		{
+			name: "number multipleOf",
+			schema: &base.Schema{
+				Type:       []string{"number"},
+				Format:     "double",
+				MultipleOf: ptr(0.01),
+			},
+			want: []string{"omitempty", "multiple_of=0.01"},
+		},
+		{
+			name: "string multipleOf is ignored",
+			schema: &base.Schema{
+				Type:       []string{"string"},
+				MultipleOf: ptr(float64(2)),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := newConstraints(tt.schema, ConstraintsContext{})
+			assert.Equal(t, tt.want, res.ValidationTags)
+		})
+	}
+}
+
+func TestNewConstraints_UniqueItems(t *testing.T) {
+	res := newConstraints(&base.Schema{Type: []string{"array"}, UniqueItems: ptr(true)}, ConstraintsContext{})
+	assert.Equal(t, ptr(true), res.UniqueItems)
+
+	res = newConstraints(&base.Schema{Type: []string{"array"}, UniqueItems: ptr(false)}, ConstraintsContext{})
+	assert.Nil(t, res.UniqueItems)
+}
+
 func TestIsStandardUUIDLength(t *testing.T) {
 	assert := assert.New(t)
 
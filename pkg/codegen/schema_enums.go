@@ -38,9 +38,18 @@ type EnumDefinition struct {
 }
 
 // EnumValue represents a single enum constant.
+// Name is the Go constant name, Label is the name given by x-enum-names or derived from the value.
+// Description comes from x-enum-descriptions.
 type EnumValue struct {
-	Name  string
-	Value string
+	Name        string
+	Label       string
+	Value       string
+	Description string
+}
+
+// isNullableEnumSchema returns true if the schema is an enum allowing null, with nullable: true or the null type.
+func isNullableEnumSchema(schema *base.Schema) bool {
+	return schema != nil && len(schema.Enum) > 0 && (deref(schema.Nullable) || slices.Contains(schema.Type, "null"))
 }
 
 func createEnumsSchema(schema *base.Schema, options ParseOptions) (GoSchema, error) {
@@ -63,7 +72,8 @@ func createEnumsSchema(schema *base.Schema, options ParseOptions) (GoSchema, err
 
 	enumNames := enumValues
 	exts := extractExtensions(schema.Extensions)
-	for _, key := range []string{extEnumNames} {
+	// x-enum-names takes precedence over x-enum-varnames
+	for _, key := range []string{extEnumVarNames, extEnumNames} {
 		if extension, ok := exts[key]; ok {
 			names, err := extParseEnumVarNames(extension)
 			if err != nil {
@@ -73,6 +83,20 @@ func createEnumsSchema(schema *base.Schema, options ParseOptions) (GoSchema, err
 		}
 	}
 
+	var enumDescriptions map[string]string
+	if extension, ok := exts[extEnumDescriptions]; ok {
+		descriptions, err := extParseEnumVarNames(extension)
+		if err != nil {
+			return outSchema, fmt.Errorf("invalid value for %q: %w", extEnumDescriptions, err)
+		}
+		enumDescriptions = make(map[string]string, len(descriptions))
+		for i, description := range descriptions {
+			if i < len(enumValues) && description != "" {
+				enumDescriptions[enumValues[i]] = description
+			}
+		}
+	}
+
 	sanitizedValues := sanitizeEnumNames(enumNames, enumValues)
 
 	// If all enum values were filtered out (e.g., all were null),
@@ -83,6 +107,7 @@ func createEnumsSchema(schema *base.Schema, options ParseOptions) (GoSchema, err
 	}
 
 	outSchema.EnumValues = make(map[string]string, len(sanitizedValues))
+	outSchema.EnumDescriptions = enumDescriptions
 
 	for k, v := range sanitizedValues {
 		outSchema.EnumValues[schemaNameToTypeName(k)] = v
@@ -347,7 +372,7 @@ func filterOutEnums(types []TypeDefinition, options ParseOptions) ([]EnumDefinit
 			}
 
 			options.typeTracker.registerName(name)
-			values = append(values, EnumValue{Name: name, Value: v})
+			values = append(values, EnumValue{Name: name, Label: k, Value: v, Description: e.Schema.EnumDescriptions[v]})
 		}
 		slices.SortFunc(values, func(a, b EnumValue) int {
 			return strings.Compare(a.Name, b.Name)
@@ -12,6 +12,11 @@ package codegen
 
 import (
 	"testing"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.yaml.in/yaml/v4"
 )
 
 func TestNeedsQuotesForEnumValue(t *testing.T) {
@@ -92,3 +97,137 @@ func TestNeedsQuotesForEnumValue(t *testing.T) {
 		})
 	}
 }
+
+func TestNullableEnums(t *testing.T) {
+	codes, err := Generate([]byte(readTestdata(t, "nullable-enums.yml")), Configuration{
+		PackageName: "api",
+		Output:      &Output{UseSingleFile: true},
+	})
+	require.NoError(t, err)
+	code := codes.GetCombined()
+
+	assert.Contains(t, code, `func StatusPtr(v Status) *Status {
+	return &v
+}`)
+
+	// required nullable properties are sent as null, without the required validation
+	assert.Contains(t, code, "Status       *Status            `json:\"status\"`")
+	assert.Contains(t, code, "RequiredKind *PetRequiredKind   `json:\"requiredKind\"`")
+	assert.Contains(t, code, "Kind         *PetKind           `json:\"kind,omitempty\"`")
+
+	// parameters can't be null
+	assert.Contains(t, code, "Status *Status `json:\"status,omitempty\"`")
+
+	// custom marshalers send nil as null too
+	assert.Contains(t, code, `	if err := object.WriteField("status", t.Status); err != nil {`)
+
+	// nil items are valid
+	assert.Contains(t, code, "if v, ok := any(item).(runtime.Validator); ok && item != nil {")
+	assert.Contains(t, code, "if validator, ok := any(v).(runtime.Validator); ok && v != nil {")
+}
+
+func TestIsNullableEnumSchema(t *testing.T) {
+	tests := []struct {
+		name     string
+		schema   *base.Schema
+		expected bool
+	}{
+		{"nullable", &base.Schema{Type: []string{"string"}, Nullable: ptr(true), Enum: []*yaml.Node{{Kind: yaml.ScalarNode, Value: "a"}}}, true},
+		{"null type", &base.Schema{Type: []string{"string", "null"}, Enum: []*yaml.Node{{Kind: yaml.ScalarNode, Value: "a"}}}, true},
+		{"not nullable", &base.Schema{Type: []string{"string"}, Enum: []*yaml.Node{{Kind: yaml.ScalarNode, Value: "a"}}}, false},
+		{"not an enum", &base.Schema{Type: []string{"string"}, Nullable: ptr(true)}, false},
+		{"nil", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, isNullableEnumSchema(tt.schema))
+		})
+	}
+}
+
+func TestEnumArrayQueryParameters(t *testing.T) {
+	codes, err := Generate([]byte(readTestdata(t, "enum-array-query.yml")), Configuration{
+		PackageName: "api",
+		SkipPrune:   true,
+		Generate:    &GenerateOptions{Client: true},
+		Output:      &Output{UseSingleFile: true},
+	})
+	require.NoError(t, err)
+	code := codes.GetCombined()
+
+	// the items are typed, reusing the component enums
+	assert.Contains(t, code, "Colors Colors              `json:\"colors,omitempty\"`")
+	assert.Contains(t, code, "Status []Status            `json:\"status,omitempty\"`")
+	assert.Contains(t, code, "Kind   []ListPetsQueryKind `json:\"kind,omitempty\"`")
+
+	// the items of top-level arrays get their own enum
+	assert.Contains(t, code, "type Colors []ColorsItem")
+	assert.Contains(t, code, `Red   ColorsItem = "red"`)
+	assert.Contains(t, code, "type Palette []PaletteItem")
+
+	// and are validated before the query is encoded
+	assert.Contains(t, code, `if err = runtime.ValidateEach(options.Query.Colors); err != nil {
+			return nil, fmt.Errorf("error validating query parameter 'colors': %w", err)
+		}`)
+	assert.Contains(t, code, "runtime.ValidateEach(options.Query.Status)")
+	assert.Contains(t, code, "runtime.ValidateEach(options.Query.Kind)")
+	assert.NotContains(t, code, "runtime.ValidateEach(options.Query.Limit)")
+
+	t.Run("skipped with request validation", func(t *testing.T) {
+		codes, err := Generate([]byte(readTestdata(t, "enum-array-query.yml")), Configuration{
+			PackageName: "api",
+			Generate:    &GenerateOptions{Client: true, Validation: ValidationOptions{SkipRequest: true}},
+			Output:      &Output{UseSingleFile: true},
+		})
+		require.NoError(t, err)
+		assert.NotContains(t, codes.GetCombined(), "runtime.ValidateEach")
+	})
+}
+
+func TestUnknownEnumValues(t *testing.T) {
+	generate := func(t *testing.T, generateOpts *GenerateOptions) string {
+		codes, err := Generate([]byte(readTestdata(t, "unknown-enum-values.yml")), Configuration{
+			PackageName: "api",
+			SkipPrune:   true,
+			Generate:    generateOpts,
+			Output:      &Output{UseSingleFile: true},
+		})
+		require.NoError(t, err)
+		return codes.GetCombined()
+	}
+
+	t.Run("kept by default", func(t *testing.T) {
+		code := generate(t, &GenerateOptions{Client: true, ServerBinding: true})
+		assert.Contains(t, code, "// Unknown values are kept as-is and reported by Validate.")
+		assert.Contains(t, code, "func (p *Priority) UnmarshalText(text []byte) error {\n\treturn runtime.UnmarshalEnumText(text, p)\n}")
+		assert.Contains(t, code, "func (p *Priority) UnmarshalJSON(data []byte) error {\n\treturn json.Unmarshal(data, (*int)(p))\n}")
+		assert.Contains(t, code, "func (p Priority) MarshalJSON() ([]byte, error) {\n\treturn json.Marshal(int(p))\n}")
+		assert.NotContains(t, code, "func (s *Status) UnmarshalJSON(")
+		assert.NotContains(t, code, "runtime.CheckEnums")
+	})
+
+	t.Run("kept with skipped validation", func(t *testing.T) {
+		code := generate(t, &GenerateOptions{Validation: ValidationOptions{Skip: true}})
+		assert.Contains(t, code, "// Unknown values are kept as-is, see IsValid.")
+	})
+
+	t.Run("rejected by server bindings and client requests when strict", func(t *testing.T) {
+		code := generate(t, &GenerateOptions{Client: true, ServerBinding: true, Validation: ValidationOptions{StrictEnums: true}})
+		assert.Contains(t, code, "// Unknown values are kept as-is and reported by Validate.")
+		assert.Contains(t, code, "func (p *Priority) UnmarshalJSON(data []byte) error {\n\treturn json.Unmarshal(data, (*int)(p))\n}")
+		assert.Contains(t, code, `if err = runtime.CheckEnums(options); err != nil {
+		return nil, fmt.Errorf("error validating request: %w", err)
+	}`)
+		assert.Contains(t, code, `if err := runtime.CheckEnums(req.Query); err != nil {
+		return nil, &runtime.BindError{In: "query", Err: err}
+	}`)
+		assert.Contains(t, code, `if err = runtime.CheckEnums(req.Body); err != nil {
+			return nil, &runtime.BindError{In: "body", Err: err}
+		}`)
+	})
+
+	t.Run("not rejected by client requests skipping validation", func(t *testing.T) {
+		code := generate(t, &GenerateOptions{Client: true, Validation: ValidationOptions{StrictEnums: true, SkipRequest: true}})
+		assert.NotContains(t, code, "runtime.CheckEnums")
+	})
+}
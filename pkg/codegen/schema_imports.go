@@ -11,7 +11,10 @@
 package codegen
 
 import (
+	"cmp"
+	"errors"
 	"fmt"
+	"path"
 	"slices"
 	"strings"
 
@@ -40,16 +43,92 @@ type importMap map[string]goImport
 // We use `-` to indicate that this is a bit of a special case
 const importMappingCurrentPackage = "-"
 
-// GoImports returns a slice of go import statements
+// generatedCodeImports maps the packages imported by the templates, see header.tmpl,
+// to the names the generated code refers to them with.
+var generatedCodeImports = map[string]string{
+	"bytes":           "bytes",
+	"compress/gzip":   "gzip",
+	"context":         "context",
+	"encoding/base64": "base64",
+	"encoding/json":   "json",
+	"encoding/xml":    "xml",
+	"errors":          "errors",
+	"fmt":             "fmt",
+	"io":              "io",
+	"iter":            "iter",
+	"maps":            "maps",
+	"os":              "os",
+	"mime":            "mime",
+	"mime/multipart":  "multipart",
+	"net/http":        "http",
+	"net/url":         "url",
+	"path":            "path",
+	"slices":          "slices",
+	"strings":         "strings",
+	"time":            "time",
+	"log/slog":        "slog",
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime": "runtime",
+	"github.com/google/go-querystring/query":                 "query",
+	"github.com/google/uuid":                                 "uuid",
+	"github.com/go-playground/validator/v10":                 "validator",
+	"github.com/shopspring/decimal":                          "decimal",
+}
+
+// GoImports returns a sorted slice of go import statements,
+// leaving out the packages the templates already import under the same name.
 func (im importMap) GoImports() []string {
 	goImports := make([]string, 0, len(im))
 	for _, v := range im {
 		if v.Path == importMappingCurrentPackage {
 			continue
 		}
+		if name, ok := generatedCodeImports[v.Path]; ok && (v.Name == "" || v.Name == name) {
+			continue
+		}
 		goImports = append(goImports, v.String())
 	}
-	return goImports
+	slices.Sort(goImports)
+	return slices.Compact(goImports)
+}
+
+// checkNames returns an error for the imports of x-go-type-import whose names are taken by other packages,
+// either imported by the generated code or by other x-go-type-imports, as they would not compile.
+// A package can be imported under several names.
+func (im importMap) checkNames() error {
+	paths := make(map[string]string, len(generatedCodeImports))
+	for importPath, name := range generatedCodeImports {
+		paths[name] = importPath
+	}
+
+	var errs []error
+	for _, key := range sortedMapKeys(im) {
+		gi := im[key]
+		if gi.Path == importMappingCurrentPackage || gi.Name == "_" || gi.Name == "." {
+			continue
+		}
+		name := cmp.Or(gi.Name, importPackageName(gi.Path))
+		if other, ok := paths[name]; ok && other != gi.Path {
+			errs = append(errs, fmt.Errorf("%s of %s as %s conflicts with %s, set another name", extPropGoImport, gi.Path, name, other))
+			continue
+		}
+		paths[name] = gi.Path
+	}
+	return errors.Join(errs...)
+}
+
+// importPackageName returns the name a package is imported under without a name: the last element of its path,
+// without a major version suffix like /v2.
+func importPackageName(importPath string) string {
+	name := path.Base(importPath)
+	if dir := path.Dir(importPath); dir != "." && len(name) > 1 && name[0] == 'v' && isDigits(name[1:]) {
+		name = path.Base(dir)
+	}
+	return name
+}
+
+// isDigits reports whether s is made of ASCII digits.
+func isDigits(s string) bool {
+	return strings.Trim(s, "0123456789") == ""
 }
 
 func collectSchemaImports(s GoSchema) (map[string]goImport, error) {
@@ -120,11 +199,17 @@ func parseGoImportExtension(v *base.Schema) (*goImport, error) {
 		return nil, nil
 	}
 
-	importI := map[string]any{}
 	goTypeImportExt := v.Extensions.Value(extPropGoImport)
-	// TODO: check if this is correct
+
+	// the import path alone imports the package under its own name
+	var importPath string
+	if goTypeImportExt.Decode(&importPath) == nil {
+		return &goImport{Path: importPath}, nil
+	}
+
+	importI := map[string]any{}
 	if err := goTypeImportExt.Decode(&importI); err != nil {
-		return nil, err
+		return nil, fmt.Errorf("invalid value for %q: %w", extPropGoImport, err)
 	}
 
 	gi := goImport{}
@@ -0,0 +1,112 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"go/parser"
+	"go/token"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fileImports returns the import specs of a Go file, e.g. `dec "github.com/shopspring/decimal"`.
+func fileImports(t *testing.T, src string) []string {
+	t.Helper()
+	f, err := parser.ParseFile(token.NewFileSet(), "gen.go", src, parser.ImportsOnly)
+	require.NoError(t, err)
+
+	var res []string
+	for _, spec := range f.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		require.NoError(t, err)
+		if spec.Name != nil {
+			path = spec.Name.Name + " " + strconv.Quote(path)
+		} else {
+			path = strconv.Quote(path)
+		}
+		res = append(res, path)
+	}
+	return res
+}
+
+func TestGoTypeImportAliases(t *testing.T) {
+	spec := []byte(readTestdata(t, "go-type-import.yml"))
+	cfg := Configuration{
+		PackageName: "api",
+		Generate:    &GenerateOptions{Client: true},
+	}
+
+	t.Run("single file", func(t *testing.T) {
+		cfg := cfg
+		cfg.Output = &Output{UseSingleFile: true}
+
+		codes, err := Generate(spec, cfg)
+		require.NoError(t, err)
+
+		imports := fileImports(t, codes.GetCombined())
+		assert.Subset(t, imports, []string{
+			`"cloud.google.com/go/civil"`,
+			`gouuid "github.com/gofrs/uuid"`,
+			`dec "github.com/shopspring/decimal"`,
+			`"github.com/google/uuid"`,
+			`"net/netip"`,
+			`"math/big"`,
+			`url2 "net/url"`,
+		})
+		assert.NotContains(t, imports, `uuid "github.com/google/uuid"`)
+	})
+
+	t.Run("imported by the files using them", func(t *testing.T) {
+		cfg := cfg
+		cfg.Output = &Output{}
+
+		codes, err := Generate(spec, cfg)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{`"cloud.google.com/go/civil"`}, filterImports(fileImports(t, codes["queries"]), "civil"))
+		assert.Equal(t, []string{`gouuid "github.com/gofrs/uuid"`}, filterImports(fileImports(t, codes["responses"]), "uuid"))
+		assert.Contains(t, fileImports(t, codes["unions"]), `"math/big"`)
+		assert.Contains(t, fileImports(t, codes["types"]), `url2 "net/url"`)
+		assert.Empty(t, filterImports(fileImports(t, codes["client"]), "civil", "uuid", "decimal", "big"))
+	})
+
+	t.Run("names taken by other packages", func(t *testing.T) {
+		spec := strings.Replace(string(spec), "name: gouuid", "name: uuid", 1)
+
+		_, err := Generate([]byte(spec), cfg)
+		assert.ErrorContains(t, err, "x-go-type-import of github.com/gofrs/uuid as uuid conflicts with github.com/google/uuid, set another name")
+	})
+}
+
+// filterImports returns the imports containing one of the strings.
+func filterImports(imports []string, contains ...string) []string {
+	var res []string
+	for _, imp := range imports {
+		for _, s := range contains {
+			if strings.Contains(imp, s) {
+				res = append(res, imp)
+				break
+			}
+		}
+	}
+	return res
+}
+
+func TestImportPackageName(t *testing.T) {
+	assert.Equal(t, "uuid", importPackageName("github.com/google/uuid"))
+	assert.Equal(t, "carbon", importPackageName("github.com/golang-module/carbon/v2"))
+	assert.Equal(t, "netip", importPackageName("net/netip"))
+	assert.Equal(t, "v2", importPackageName("v2"))
+}
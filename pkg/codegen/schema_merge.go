@@ -97,6 +97,7 @@ func createFromCombinator(schema *base.Schema, options ParseOptions) (GoSchema,
 			return anyOfSchema, nil
 		}
 
+		anyOfSchema.AnyOfVariants = options.AnyOfVariants
 		anyOfFields := genFieldsFromProperties(anyOfSchema.Properties, options)
 		anyOfSchema.GoType = anyOfSchema.createGoStruct(anyOfFields)
 		anyOfSchema.IsUnionWrapper = len(anyOfSchema.UnionElements) > 0
@@ -137,6 +138,7 @@ func createFromCombinator(schema *base.Schema, options ParseOptions) (GoSchema,
 		oneOfFields := genFieldsFromProperties(oneOfSchema.Properties, options)
 		oneOfSchema.GoType = oneOfSchema.createGoStruct(oneOfFields)
 		oneOfSchema.IsUnionWrapper = len(oneOfSchema.UnionElements) > 0
+		oneOfSchema.OneOf = true
 
 		oneOfName := pathToTypeName(oneOfPath)
 		td := TypeDefinition{
@@ -587,7 +589,7 @@ func mergeOpenapiSchemas(s1, s2 *base.Schema) (*base.Schema, error) {
 
 	if isAdditionalPropertiesExplicitFalse(s1) || isAdditionalPropertiesExplicitFalse(s2) {
 		result.AdditionalProperties = &base.DynamicValue[*base.SchemaProxy, bool]{
-			A: nil,
+			N: 1,
 			B: false,
 		}
 	} else if s1.AdditionalProperties != nil && s1.AdditionalProperties.IsA() && s1.AdditionalProperties.A != nil {
@@ -625,7 +627,7 @@ func isAdditionalPropertiesExplicitFalse(s *base.Schema) bool {
 		return false
 	}
 
-	return !s.AdditionalProperties.IsB()
+	return s.AdditionalProperties.IsB() && !s.AdditionalProperties.B
 }
 
 func getSchemaType(schema *base.Schema) []string {
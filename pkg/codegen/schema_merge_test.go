@@ -15,6 +15,7 @@ import (
 	"testing"
 
 	"github.com/pb33f/libopenapi"
+	"github.com/pb33f/libopenapi/datamodel/high/base"
 	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -118,6 +119,33 @@ func TestMergeOpenapiSchemas(t *testing.T) {
 		require.NoError(t, err)
 		assert.NotEmpty(t, code)
 	})
+
+	t.Run("merge additionalProperties false", func(t *testing.T) {
+		closed := &base.Schema{
+			Type:                 []string{"object"},
+			AdditionalProperties: &base.DynamicValue[*base.SchemaProxy, bool]{N: 1, B: false},
+		}
+		open := &base.Schema{Type: []string{"object"}}
+		values := &base.Schema{
+			Type: []string{"object"},
+			AdditionalProperties: &base.DynamicValue[*base.SchemaProxy, bool]{
+				A: base.CreateSchemaProxy(&base.Schema{Type: []string{"string"}}),
+			},
+		}
+
+		assert.True(t, isAdditionalPropertiesExplicitFalse(closed))
+		assert.False(t, isAdditionalPropertiesExplicitFalse(open))
+		assert.False(t, isAdditionalPropertiesExplicitFalse(values))
+
+		merged, err := mergeOpenapiSchemas(open, closed)
+		require.NoError(t, err)
+		assert.True(t, isAdditionalPropertiesExplicitFalse(merged))
+
+		merged, err = mergeOpenapiSchemas(open, values)
+		require.NoError(t, err)
+		assert.False(t, isAdditionalPropertiesExplicitFalse(merged))
+		assert.True(t, schemaHasAdditionalProperties(merged))
+	})
 }
 
 func TestSingleElementUnionOptimization(t *testing.T) {
@@ -57,6 +57,8 @@ func oapiSchemaToGoType(schema *base.Schema, options ParseOptions) (GoSchema, er
 	constraints := newConstraints(schema, ConstraintsContext{
 		hasNilType:   slices.Contains(t, "null"),
 		specLocation: options.specLocation,
+		formatTags:   options.FormatTags,
+		decimalType:  options.DecimalType,
 	})
 
 	// Handle multi-type schemas (union types like ["string", "number"]).
@@ -88,6 +90,11 @@ func oapiSchemaToGoType(schema *base.Schema, options ParseOptions) (GoSchema, er
 			// For inline items (no reference), we don't append to the path here.
 			// The path will be used for naming if needed (e.g., for unions or complex types).
 			// We rely on the tracking logic to only track schemas with references or single-element paths.
+			// Inline enums are typed only below the top level, so the items of top-level arrays,
+			// e.g. the schemas of components and parameters, are named after the array.
+			if itemRef == "" && len(path) == 1 && len(items.Schema().Enum) > 0 {
+				opts = opts.WithPath(append(slices.Clone(path), "Item"))
+			}
 		}
 
 		// Pre-register the type name with the reference if available.
@@ -233,8 +240,14 @@ func oapiSchemaToGoType(schema *base.Schema, options ParseOptions) (GoSchema, er
 			goType = "float32"
 		case "decimal":
 			// Non-standard format used by some specs to indicate arbitrary precision decimal
-			// Treat as float64 for compatibility
+			// Treat as float64 for compatibility, unless a decimal type is configured
 			goType = "float64"
+			if isDecimalSchema(schema, options.DecimalType) {
+				var err error
+				if goType, err = decimalGoType(schema, options.DecimalType); err != nil {
+					return GoSchema{}, err
+				}
+			}
 		case "integer", "int":
 			// Treat type: number, format: integer or format: int as integer type
 			// format: int is non-standard but used by some specs
@@ -286,6 +299,18 @@ func oapiSchemaToGoType(schema *base.Schema, options ParseOptions) (GoSchema, er
 			goType = "runtime.Email"
 		case "date":
 			goType = "runtime.Date"
+		case "decimal":
+			if isDecimalSchema(schema, options.DecimalType) {
+				var err error
+				if goType, err = decimalGoType(schema, options.DecimalType); err != nil {
+					return GoSchema{}, err
+				}
+			}
+		case "duration":
+			// Enum values are string literals, which can't be runtime.Duration constants
+			if len(schema.Enum) == 0 {
+				goType = "runtime.Duration"
+			}
 		case "date-time":
 			// If the schema has enum values, treat it as a string instead of time.Time
 			// because enum values are string literals and time.Time cannot be used as constants
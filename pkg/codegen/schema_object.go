@@ -14,6 +14,7 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
 	"github.com/pb33f/libopenapi/datamodel/high/base"
@@ -30,12 +31,18 @@ func createObjectSchema(schema *base.Schema, options ParseOptions) (GoSchema, er
 		hasNilType = slices.Contains(schema.Type, "null")
 	}
 
+	// Nested objects of a merge patch keep their declared types.
+	mergePatch := options.mergePatch
+	options.mergePatch = false
+
 	outSchema := GoSchema{
 		Description:   description,
 		OpenAPISchema: schema,
 		Constraints: newConstraints(schema, ConstraintsContext{
 			hasNilType:   hasNilType,
 			specLocation: options.specLocation,
+			formatTags:   options.FormatTags,
+			decimalType:  options.DecimalType,
 		}),
 	}
 
@@ -101,8 +108,9 @@ func createObjectSchema(schema *base.Schema, options ParseOptions) (GoSchema, er
 		}
 
 		// We've got an object with some properties.
+		// Every property of a merge patch is optional.
 		var required []string
-		if schema != nil {
+		if schema != nil && !mergePatch {
 			required = schema.Required
 		}
 
@@ -110,6 +118,11 @@ func createObjectSchema(schema *base.Schema, options ParseOptions) (GoSchema, er
 		goFieldNames := make(map[string]int)
 		if schema != nil && schema.Properties != nil {
 			for pName, p := range schema.Properties.FromOldest() {
+				// readOnly properties can't be patched
+				if mergePatch && p.Schema() != nil && deref(p.Schema().ReadOnly) {
+					continue
+				}
+
 				propertyPath := append(path, pName)
 				pRef := p.GoLow().GetReference()
 				opts := options.WithReference(pRef).WithPath(propertyPath)
@@ -128,10 +141,16 @@ func createObjectSchema(schema *base.Schema, options ParseOptions) (GoSchema, er
 				if p.Schema() != nil {
 					hasNilTyp = slices.Contains(p.Schema().Type, "null")
 				}
+				skipOnInput, err := validateSkipOnInput(p.Schema())
+				if err != nil {
+					return GoSchema{}, fmt.Errorf("error generating Go schema for property '%s': %w", pName, err)
+				}
 				constraints := newConstraints(p.Schema(), ConstraintsContext{
 					hasNilType:   hasNilTyp,
-					required:     slices.Contains(required, pName),
+					required:     slices.Contains(required, pName) && !skipOnInput,
 					specLocation: options.specLocation,
+					formatTags:   options.FormatTags,
+					decimalType:  options.DecimalType,
 				})
 				pSchema.Constraints = constraints
 
@@ -165,7 +184,10 @@ func createObjectSchema(schema *base.Schema, options ParseOptions) (GoSchema, er
 				description := ""
 				extensions := make(map[string]any)
 				deprecated := false
-				var sensitiveData *runtime.SensitiveDataConfig
+				var (
+					sensitiveData *runtime.SensitiveDataConfig
+					removeAfter   time.Time
+				)
 
 				if p.Schema() != nil {
 					s := p.Schema()
@@ -177,10 +199,24 @@ func createObjectSchema(schema *base.Schema, options ParseOptions) (GoSchema, er
 
 					// Parse x-sensitive-data extension
 					if extension, ok := extensions[extSensitiveData]; ok {
-						if config, err := extParseSensitiveData(extension); err == nil {
-							sensitiveData = config
+						sensitiveData, err = extParseSensitiveData(extension)
+						if err != nil {
+							return GoSchema{}, fmt.Errorf("invalid %s of property '%s': %w", extSensitiveData, pName, err)
 						}
 					}
+
+					if extension, ok := extensions[extRemoveAfter]; ok {
+						removeAfter, err = extParseRemoveAfter(extension)
+						if err != nil {
+							return GoSchema{}, fmt.Errorf("invalid %s of property '%s': %w", extRemoveAfter, pName, err)
+						}
+						// the property can be used until the end of the day
+						if options.EnforceRemoveAfter && !time.Now().Before(removeAfter.AddDate(0, 0, 1)) {
+							return GoSchema{}, fmt.Errorf("property '%s' (%s %s): %w",
+								pName, extRemoveAfter, removeAfter.Format(time.DateOnly), ErrRemoveAfterPassed)
+						}
+						deprecated = true
+					}
 				}
 
 				pSchema, _ = replaceInlineTypes(pSchema, opts)
@@ -211,10 +247,22 @@ func createObjectSchema(schema *base.Schema, options ParseOptions) (GoSchema, er
 					Description:   description,
 					Extensions:    extensions,
 					Deprecated:    deprecated,
+					RemoveAfter:   removeAfter,
 					Constraints:   constraints,
 					SensitiveData: sensitiveData,
 					ParentType:    parentType,
 				}
+				if mergePatch || options.PreferNullable && !slices.Contains(required, pName) &&
+					(hasNilTyp || (p.Schema() != nil && deref(p.Schema().Nullable))) {
+					prop.NullableWrapper = prop.canBeNullableWrapper()
+				}
+				if !prop.NullableWrapper && !slices.Contains(required, pName) && prop.canOmitZero(options.PreferOmitZero) {
+					prop.OmitZero = true
+					prop.Schema.SkipOptionalPointer = true
+				}
+				if options.PreferSensitive {
+					prop.SensitiveWrapper = prop.canBeSensitiveWrapper()
+				}
 				outSchema.Properties = append(outSchema.Properties, prop)
 				if len(pSchema.AdditionalTypes) > 0 {
 					outSchema.AdditionalTypes = append(outSchema.AdditionalTypes, pSchema.AdditionalTypes...)
@@ -259,6 +307,13 @@ func enhanceSchemaWithAdditionalProperties(out GoSchema, schema *base.Schema, op
 	}
 
 	if !schemaHasAdditionalProperties(schema) {
+		capture, err := captureUnknownFields(schema, options.CaptureUnknownFields)
+		if err != nil || !capture {
+			return out, err
+		}
+		// Unknown fields are kept as they are, to be marshaled back unchanged.
+		out.HasAdditionalProperties = true
+		out.AdditionalPropertiesType = &GoSchema{GoType: "json.RawMessage"}
 		return out, nil
 	}
 
@@ -347,3 +402,36 @@ func enhanceSchemaWithAdditionalProperties(out GoSchema, schema *base.Schema, op
 
 	return out, nil
 }
+
+// captureUnknownFields returns whether the unknown fields of an object schema without additional properties
+// are kept in its AdditionalProperties: with x-capture-unknown, or with capture-unknown-fields
+// unless additionalProperties is false.
+func captureUnknownFields(schema *base.Schema, captureAll bool) (bool, error) {
+	if schema.Properties == nil || schema.Properties.Len() == 0 {
+		return false, nil
+	}
+	if extension, ok := extractExtensions(schema.Extensions)[extCaptureUnknown]; ok {
+		capture, err := parseBooleanValue(extension)
+		if err != nil {
+			return false, fmt.Errorf("invalid value for %q: %w", extCaptureUnknown, err)
+		}
+		return capture, nil
+	}
+	return captureAll && schema.AdditionalProperties == nil, nil
+}
+
+// validateSkipOnInput returns whether the property schema is marked with x-validate-skip-on-input.
+func validateSkipOnInput(schema *base.Schema) (bool, error) {
+	if schema == nil {
+		return false, nil
+	}
+	extension, ok := extractExtensions(schema.Extensions)[extPropValidateSkipOnInput]
+	if !ok {
+		return false, nil
+	}
+	skip, err := parseBooleanValue(extension)
+	if err != nil {
+		return false, fmt.Errorf("invalid value for %q: %w", extPropValidateSkipOnInput, err)
+	}
+	return skip, nil
+}
@@ -14,6 +14,7 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
 )
@@ -25,9 +26,22 @@ type Property struct {
 	Schema        GoSchema
 	Extensions    map[string]any
 	Deprecated    bool
+	RemoveAfter   time.Time // Date of x-remove-after, zero if unset
 	Constraints   Constraints
 	SensitiveData *runtime.SensitiveDataConfig
 	ParentType    string // Name of the parent type (for detecting recursive references)
+
+	// NullableWrapper is true if the property is declared as runtime.Nullable
+	// to tell an absent property from an explicit null.
+	NullableWrapper bool
+
+	// OmitZero is true if the optional property is declared as a value with the omitzero JSON tag
+	// instead of a pointer with omitempty.
+	OmitZero bool
+
+	// SensitiveWrapper is true if the sensitive property is declared as runtime.Sensitive,
+	// masked wherever it is printed.
+	SensitiveWrapper bool
 }
 
 func (p Property) IsEqual(other Property) bool {
@@ -39,6 +53,12 @@ func (p Property) IsEqual(other Property) bool {
 func (p Property) GoTypeDef() string {
 	typeDef := p.Schema.TypeDecl()
 
+	if p.NullableWrapper {
+		return "runtime.Nullable[" + strings.TrimPrefix(typeDef, "*") + "]"
+	}
+	if p.SensitiveWrapper {
+		typeDef = "runtime.Sensitive[" + strings.TrimPrefix(typeDef, "*") + "]"
+	}
 	if p.IsPointerType() {
 		typeDef = "*" + strings.TrimPrefix(typeDef, "*")
 	}
@@ -47,6 +67,9 @@ func (p Property) GoTypeDef() string {
 
 // IsPointerType returns true if this property's Go type is a pointer.
 func (p Property) IsPointerType() bool {
+	if p.NullableWrapper {
+		return false
+	}
 	typeDef := p.Schema.TypeDecl()
 
 	// Check for recursive references FIRST: if this property's type is the same as its parent type,
@@ -76,6 +99,82 @@ func (p Property) IsPointerType() bool {
 	return !p.Schema.SkipOptionalPointer && p.Constraints.Nullable != nil && *p.Constraints.Nullable
 }
 
+// isNullableEnum returns true if the property is of a nullable enum type, declared as a pointer to it.
+func (p Property) isNullableEnum() bool {
+	return p.IsPointerType() && isNullableEnumSchema(p.Schema.OpenAPISchema)
+}
+
+// SendsNull returns true if the property is sent as null when nil, instead of being left out:
+// required properties of nullable enums, unless x-omitempty says otherwise. Masked values can't be nil.
+func (p Property) SendsNull() bool {
+	if !p.isNullableEnum() || !deref(p.Constraints.Required) || p.SensitiveData != nil {
+		return false
+	}
+	if extension, ok := p.Extensions[extPropOmitEmpty]; ok {
+		if omitEmpty, err := parseBooleanValue(extension); err == nil {
+			return !omitEmpty
+		}
+	}
+	return true
+}
+
+// isParameterLocation returns true for the structs of the path, query, header and cookie parameters.
+func isParameterLocation(specLocation SpecLocation) bool {
+	return specLocation == SpecLocationPath || specLocation == SpecLocationQuery || specLocation == SpecLocationHeader ||
+		specLocation == SpecLocationCookie
+}
+
+// canBeNullableWrapper returns true if the property can be declared as runtime.Nullable:
+// recursive references must stay pointers, and masking and x-go-type-skip-optional-pointer expect the plain type.
+func (p Property) canBeNullableWrapper() bool {
+	if p.ParentType != "" && (p.Schema.RefType == p.ParentType || p.Schema.GoType == p.ParentType) {
+		return false
+	}
+	if _, ok := p.Extensions[extPropGoTypeSkipOptionalPointer]; ok {
+		return false
+	}
+	return p.SensitiveData == nil && !p.Schema.SkipOptionalPointer
+}
+
+// canBeSensitiveWrapper returns true if the sensitive property can be declared as runtime.Sensitive:
+// its value must be of a primitive type, masked the same way by fmt and the configured mask.
+func (p Property) canBeSensitiveWrapper() bool {
+	return p.SensitiveData != nil && !p.NullableWrapper && isPrimitiveType(strings.TrimPrefix(p.Schema.TypeDecl(), "*"))
+}
+
+// canOmitZero returns true if the optional property can be declared as a value with the omitzero JSON tag.
+// x-omitzero decides, otherwise it can with preferOmitZero for struct types, e.g. time.Time or objects,
+// whose zero values aren't valid values to send.
+func (p Property) canOmitZero(preferOmitZero bool) bool {
+	if p.ParentType != "" && (p.Schema.RefType == p.ParentType || p.Schema.GoType == p.ParentType) {
+		return false
+	}
+	if extension, ok := p.Extensions[extPropOmitZero]; ok {
+		omitZero, err := parseBooleanValue(extension)
+		return err == nil && omitZero
+	}
+	return preferOmitZero && p.SensitiveData == nil && isStructSchema(p.Schema)
+}
+
+// isStructSchema returns true if the Go type of the schema is a struct: time.Time, runtime.Date,
+// or the type of an object with properties, or of a union. Objects with only additional properties are maps.
+func isStructSchema(s GoSchema) bool {
+	switch s.TypeDecl() {
+	case "time.Time", "runtime.Date":
+		return true
+	case "":
+		return false
+	}
+	if s.IsExternalRef() || s.OpenAPISchema == nil || len(s.OpenAPISchema.Enum) > 0 {
+		return false
+	}
+	o := s.OpenAPISchema
+	if len(o.AllOf) > 0 || len(o.OneOf) > 0 || len(o.AnyOf) > 0 {
+		return true
+	}
+	return slices.Contains(o.Type, "object") && o.Properties != nil && o.Properties.Len() > 0
+}
+
 // needsCustomValidation returns true if this property needs custom validation logic
 // (i.e., calling Validate() method) instead of just using validator tags.
 //
@@ -86,6 +185,10 @@ func (p Property) IsPointerType() bool {
 // 4. If primitive type without validation tags → skip validation (return false)
 // 5. Special case: arrays/maps with item types that need validation → return true
 func (p Property) needsCustomValidation() bool {
+	if p.Schema.OmitValidation {
+		return false
+	}
+
 	// Get the type definition
 	typeDef := p.Schema.TypeDecl()
 
@@ -94,6 +197,18 @@ func (p Property) needsCustomValidation() bool {
 		return false
 	}
 
+	// Sensitive values are unwrapped to validate them with their tags
+	if p.SensitiveWrapper {
+		return len(p.Constraints.ValidationTags) > 0
+	}
+
+	// Nullable values are unwrapped to validate them
+	if p.NullableWrapper {
+		unwrapped := p
+		unwrapped.NullableWrapper = false
+		return len(p.Constraints.ValidationTags) > 0 || unwrapped.needsCustomValidation()
+	}
+
 	// Check if it's an array with items that need validation
 	// This must be checked before the general "primitive" check because arrays
 	// of custom types (e.g., []DisputeInfo) need custom validation to iterate
@@ -208,7 +323,7 @@ func genFieldsFromProperties(props []Property, options ParseOptions) []string {
 			if i != 0 {
 				field += "\n"
 			}
-			field += fmt.Sprintf("%s\n", stringWithTypeNameToGoComment(p.Description, p.GoName))
+			field += fmt.Sprintf("%s\n", stringWithTypeNameToGoComment(truncateDescription(p.Description, options.MaxDescriptionLength), p.GoName))
 		}
 
 		if p.Deprecated {
@@ -220,6 +335,14 @@ func genFieldsFromProperties(props []Property, options ParseOptions) []string {
 				}
 			}
 
+			if !p.RemoveAfter.IsZero() {
+				removal := "To be removed after " + p.RemoveAfter.Format(time.DateOnly) + "."
+				if deprecationReason != "" {
+					removal = deprecationReason + "\n" + removal
+				}
+				deprecationReason = removal
+			}
+
 			field += fmt.Sprintf("%s\n", deprecationComment(deprecationReason))
 		}
 
@@ -235,6 +358,10 @@ func genFieldsFromProperties(props []Property, options ParseOptions) []string {
 
 		c := p.Constraints
 		omitEmpty := c.Nullable != nil && *c.Nullable
+		if p.SendsNull() && !isParameterLocation(options.specLocation) {
+			// parameters can't be null, they are left out instead
+			omitEmpty = false
+		}
 		if p.Schema.SkipOptionalPointer {
 			omitEmpty = false
 		}
@@ -248,7 +375,8 @@ func genFieldsFromProperties(props []Property, options ParseOptions) []string {
 
 		fieldTags := make(map[string]string)
 
-		if !options.SkipValidation && len(p.Constraints.ValidationTags) > 0 {
+		// Nullable and sensitive values are validated by the Validate() method, the validator can't look into them
+		if !options.SkipValidation && !options.omitValidation && len(p.Constraints.ValidationTags) > 0 && !p.NullableWrapper && !p.SensitiveWrapper {
 			fieldTags["validate"] = strings.Join(c.ValidationTags, ",")
 		}
 
@@ -257,7 +385,14 @@ func genFieldsFromProperties(props []Property, options ParseOptions) []string {
 			jsonFieldName = "-"
 		}
 		fieldTags["json"] = jsonFieldName
-		if omitEmpty && jsonFieldName != "-" {
+		switch {
+		case jsonFieldName == "-":
+		case p.NullableWrapper:
+			// absent values are left out, explicit nulls are sent
+			fieldTags["json"] += ",omitzero"
+		case p.OmitZero:
+			fieldTags["json"] += ",omitzero"
+		case omitEmpty:
 			fieldTags["json"] += ",omitempty"
 		}
 
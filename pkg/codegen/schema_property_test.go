@@ -11,9 +11,14 @@
 package codegen
 
 import (
+	"go/format"
 	"testing"
 
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	"github.com/pb33f/libopenapi/orderedmap"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestProperty_GoTypeDef(t *testing.T) {
@@ -213,3 +218,172 @@ func TestProperty_GoTypeDef_nullable(t *testing.T) {
 		})
 	}
 }
+
+func TestProperty_GoTypeDef_nullableWrapper(t *testing.T) {
+	p := Property{
+		Schema:          GoSchema{GoType: "*int"},
+		Constraints:     Constraints{Nullable: ptr(true)},
+		NullableWrapper: true,
+	}
+	assert.Equal(t, "runtime.Nullable[int]", p.GoTypeDef())
+	assert.False(t, p.IsPointerType())
+
+	self := Property{ParentType: "Node", Schema: GoSchema{RefType: "Node"}}
+	assert.False(t, self.canBeNullableWrapper())
+
+	skip := Property{Extensions: map[string]any{extPropGoTypeSkipOptionalPointer: true}}
+	assert.False(t, skip.canBeNullableWrapper())
+
+	sensitive := Property{SensitiveData: &runtime.SensitiveDataConfig{}}
+	assert.False(t, sensitive.canBeNullableWrapper())
+}
+
+func TestPreferNullable(t *testing.T) {
+	spec := []byte(readTestdata(t, "prefer-nullable.yml"))
+
+	t.Run("disabled", func(t *testing.T) {
+		codes, err := Generate(spec, Configuration{PackageName: "api"})
+		require.NoError(t, err)
+		assert.NotContains(t, codes.GetCombined(), "runtime.Nullable")
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		codes, err := Generate(spec, Configuration{PackageName: "api", Output: &Output{UseSingleFile: true, PreferNullable: true}})
+		require.NoError(t, err)
+
+		code := codes.GetCombined()
+		assert.Contains(t, code, "Nickname runtime.Nullable[string]   `json:\"nickname,omitzero\"`")
+		assert.Contains(t, code, "Address  runtime.Nullable[Address]  `json:\"address,omitzero\"`")
+		assert.Contains(t, code, "Tags     runtime.Nullable[[]string] `json:\"tags,omitzero\"`")
+		// not nullable in the spec
+		assert.Contains(t, code, "Bio      *string                    `json:\"bio,omitempty\"`")
+		assert.Contains(t, code, `	if value, ok := u.Nickname.Get(); ok {
+		if err := typesValidator.Var(value, "omitempty,max=10"); err != nil {
+			errors = errors.Append("Nickname", err)
+		}
+	}`)
+		assert.Contains(t, code, "if s.Theme.IsSpecified() {")
+	})
+}
+
+func TestProperty_canOmitZero(t *testing.T) {
+	object := &base.Schema{Type: []string{"object"}, Properties: orderedmap.New[string, *base.SchemaProxy]()}
+	object.Properties.Set("city", base.CreateSchemaProxy(&base.Schema{Type: []string{"string"}}))
+
+	tests := []struct {
+		name     string
+		property Property
+		prefer   bool
+		expected bool
+	}{
+		{"time", Property{Schema: GoSchema{GoType: "time.Time"}}, true, true},
+		{"date", Property{Schema: GoSchema{GoType: "runtime.Date"}}, true, true},
+		{"object", Property{Schema: GoSchema{RefType: "Location", OpenAPISchema: object}}, true, true},
+		{"not preferred", Property{Schema: GoSchema{GoType: "time.Time"}}, false, false},
+		{"string", Property{Schema: GoSchema{GoType: "string"}}, true, false},
+		{"map", Property{Schema: GoSchema{GoType: "map[string]any", OpenAPISchema: &base.Schema{Type: []string{"object"}}}}, true, false},
+		{"recursive", Property{ParentType: "Node", Schema: GoSchema{RefType: "Node", OpenAPISchema: object}}, true, false},
+		{"sensitive", Property{SensitiveData: &runtime.SensitiveDataConfig{}, Schema: GoSchema{GoType: "time.Time"}}, true, false},
+		{"extension", Property{Extensions: map[string]any{extPropOmitZero: true}, Schema: GoSchema{GoType: "int"}}, false, true},
+		{"extension opt out", Property{Extensions: map[string]any{extPropOmitZero: false}, Schema: GoSchema{GoType: "time.Time"}}, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.property.canOmitZero(tt.prefer))
+		})
+	}
+}
+
+func TestPreferOmitZero(t *testing.T) {
+	spec := []byte(readTestdata(t, "omitzero.yml"))
+
+	t.Run("extension only", func(t *testing.T) {
+		codes, err := Generate(spec, Configuration{PackageName: "api", Output: &Output{UseSingleFile: true}})
+		require.NoError(t, err)
+
+		code := codes.GetCombined()
+		assert.Contains(t, code, "StartsAt  *time.Time    `json:\"startsAt,omitempty\"`")
+		assert.Contains(t, code, "Attendees int           `json:\"attendees,omitzero\"`")
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		codes, err := Generate(spec, Configuration{PackageName: "api", Output: &Output{UseSingleFile: true, PreferOmitZero: true}})
+		require.NoError(t, err)
+
+		code := codes.GetCombined()
+		assert.Contains(t, code, "StartsAt  time.Time    `json:\"startsAt,omitzero\"`")
+		assert.Contains(t, code, "Day       runtime.Date `json:\"day,omitzero\"`")
+		assert.Contains(t, code, "Location  Location     `json:\"location,omitzero\"`")
+		assert.Contains(t, code, "Labels    Labels       `json:\"labels,omitzero\"`")
+		// opted out with x-omitzero: false
+		assert.Contains(t, code, "EndsAt    *time.Time   `json:\"endsAt,omitempty\"`")
+		// not a struct
+		assert.Contains(t, code, "Note      *string      `json:\"note,omitempty\"`")
+		// recursive
+		assert.Contains(t, code, "Parent    *Event       `json:\"parent,omitempty\"`")
+
+		// absent values are not validated
+		assert.Contains(t, code, `	if !runtime.IsZero(e.Location) {
+		if v, ok := any(e.Location).(runtime.Validator); ok {`)
+		// nor marshaled with the additional properties
+		assert.Contains(t, code, "if !runtime.IsZero(l.UpdatedAt) {")
+	})
+}
+
+func TestProperty_canBeSensitiveWrapper(t *testing.T) {
+	sensitive := &runtime.SensitiveDataConfig{Type: runtime.MaskTypeFull}
+	tests := []struct {
+		name     string
+		property Property
+		expected bool
+	}{
+		{"string", Property{SensitiveData: sensitive, Schema: GoSchema{GoType: "string"}}, true},
+		{"int", Property{SensitiveData: sensitive, Schema: GoSchema{GoType: "int"}}, true},
+		{"not sensitive", Property{Schema: GoSchema{GoType: "string"}}, false},
+		{"slice", Property{SensitiveData: sensitive, Schema: GoSchema{GoType: "[]string"}}, false},
+		{"reference", Property{SensitiveData: sensitive, Schema: GoSchema{RefType: "Secret"}}, false},
+		{"nullable", Property{SensitiveData: sensitive, NullableWrapper: true, Schema: GoSchema{GoType: "string"}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.property.canBeSensitiveWrapper())
+		})
+	}
+}
+
+func TestPreferSensitive(t *testing.T) {
+	spec := []byte(readTestdata(t, "prefer-sensitive.yml"))
+
+	t.Run("disabled", func(t *testing.T) {
+		codes, err := Generate(spec, Configuration{PackageName: "api", Output: &Output{UseSingleFile: true}})
+		require.NoError(t, err)
+		assert.NotContains(t, codes.GetCombined(), "runtime.Sensitive[")
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		codes, err := Generate(spec, Configuration{PackageName: "api", Output: &Output{UseSingleFile: true, PreferSensitive: true}})
+		require.NoError(t, err)
+
+		code := codes.GetCombined()
+		_, err = format.Source([]byte(code))
+		require.NoError(t, err)
+
+		assert.Contains(t, code, "Name   string                     `json:\"name\" validate:\"required\"`")
+		assert.Contains(t, code, "Ssn    runtime.Sensitive[string]  `json:\"ssn\" sensitive:\"\"`")
+		assert.Contains(t, code, "Pin    *runtime.Sensitive[int]    `json:\"pin,omitempty\" sensitive:\"\"`")
+
+		// the values are validated with the tags of the properties
+		assert.Contains(t, code, `if err := typesValidator.Var(u.Ssn.Value(), "required"); err != nil {`)
+		assert.Contains(t, code, `	if u.Pin != nil {
+		if err := typesValidator.Var(u.Pin.Value(), "omitempty,gte=1000"); err != nil {`)
+
+		// and masked with the configured mask in MarshalJSON
+		assert.Contains(t, code, "val := masked.Ssn.Masked(runtime.SensitiveDataConfig{")
+		assert.Contains(t, code, "masked.Pin = &val")
+
+		// and unmasked in MarshalJSONUnmasked
+		assert.Contains(t, code, "func (u User) MarshalJSONUnmasked() ([]byte, error) {")
+		assert.Contains(t, code, "val := unmasked.Ssn.Unmasked()")
+		assert.Contains(t, code, "unmasked.Pin = &val")
+	})
+}
@@ -32,6 +32,132 @@ func (u UnionElement) String() string {
 	return u.TypeName
 }
 
+// UnionVariant is a union element stored in a field of runtime.Either, runtime.OneOf3 or runtime.OneOf4.
+type UnionVariant struct {
+	UnionElement
+
+	// Field is the field holding the element: A, B, C or D.
+	Field string
+
+	// N is the value of the N field when the element is held, its 1-based position.
+	N int
+}
+
+// unionVariantFields are the fields of the typed union types, in order.
+var unionVariantFields = []string{"A", "B", "C", "D"}
+
+// UnionType returns the typed union embedded in the struct of the schema: runtime.Either for 2 elements,
+// runtime.OneOf3 or runtime.OneOf4 for 3 or 4 elements if TypedUnion is set, empty otherwise.
+// anyOf unions with AnyOfVariants are never typed, they have a field for every element instead.
+func (s GoSchema) UnionType() string {
+	name := s.UnionField()
+	if name == "" {
+		return ""
+	}
+
+	args := make([]string, len(s.UnionElements))
+	for i, elem := range s.UnionElements {
+		args[i] = elem.TypeName
+	}
+	return fmt.Sprintf("runtime.%s[%s]", name, strings.Join(args, ", "))
+}
+
+// UnionField returns the name of the typed union embedded in the struct of the schema:
+// Either, OneOf3 or OneOf4, empty if the union isn't typed.
+func (s GoSchema) UnionField() string {
+	if s.AnyOfVariants {
+		return ""
+	}
+
+	switch n := len(s.UnionElements); {
+	case n == 2:
+		return "Either"
+	case s.TypedUnion && (n == 3 || n == 4):
+		return fmt.Sprintf("OneOf%d", n)
+	default:
+		return ""
+	}
+}
+
+// MatchRequiredFieldsArgs returns the runtime.UnionVariant arguments of runtime.MatchRequiredFields
+// describing the elements of a typed oneOf union without discriminator,
+// or an empty string if none of its elements requires properties to pick it by.
+func (s GoSchema) MatchRequiredFieldsArgs() string {
+	if !s.OneOf || s.Discriminator != nil || s.UnionField() == "" {
+		return ""
+	}
+
+	var args []string
+	hasRequired := false
+	for _, elem := range s.UnionElements {
+		required, properties := elem.jsonFields()
+		hasRequired = hasRequired || len(required) > 0
+		fields := []string{fmt.Sprintf("Name: %q", elem.TypeName)}
+		if len(required) > 0 {
+			fields = append(fields, "Required: "+stringSliceLiteral(required))
+		}
+		if len(properties) > 0 {
+			fields = append(fields, "Properties: "+stringSliceLiteral(properties))
+		}
+		args = append(args, "runtime.UnionVariant{"+strings.Join(fields, ", ")+"}")
+	}
+	if !hasRequired {
+		return ""
+	}
+	return "\n" + strings.Join(args, ",\n") + ",\n"
+}
+
+// jsonFields returns the required and declared properties of the element, including the ones of its allOf elements.
+func (u UnionElement) jsonFields() (required, properties []string) {
+	schema := u.Schema.OpenAPISchema
+	if schema == nil {
+		return nil, nil
+	}
+	schemas := []*base.Schema{schema}
+	if len(schema.AllOf) > 0 {
+		if merged, err := mergeAllOf(schema.AllOf); err == nil && merged != nil {
+			schemas = append(schemas, merged)
+		}
+	}
+
+	for _, s := range schemas {
+		for _, name := range s.Required {
+			if !slices.Contains(required, name) {
+				required = append(required, name)
+			}
+		}
+		if s.Properties == nil {
+			continue
+		}
+		for name := range s.Properties.KeysFromOldest() {
+			if !slices.Contains(properties, name) {
+				properties = append(properties, name)
+			}
+		}
+	}
+	return required, properties
+}
+
+func stringSliceLiteral(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = fmt.Sprintf("%q", v)
+	}
+	return "[]string{" + strings.Join(quoted, ", ") + "}"
+}
+
+// UnionVariants returns the union elements with the fields holding them if the union is typed, nil otherwise.
+func (s GoSchema) UnionVariants() []UnionVariant {
+	if s.UnionType() == "" {
+		return nil
+	}
+	res := make([]UnionVariant, len(s.UnionElements))
+	for i, elem := range s.UnionElements {
+		res[i] = UnionVariant{UnionElement: elem, Field: unionVariantFields[i], N: i + 1}
+	}
+	return res
+}
+
 // Method generates union method name for template functions `As/From`.
 func (u UnionElement) Method() string {
 	var method string
@@ -232,6 +358,7 @@ func generateUnion(elements []*base.SchemaProxy, discriminator *base.Discriminat
 
 	// Deduplicate union elements to avoid generating duplicate methods
 	outSchema.UnionElements = deduplicateUnionElements(outSchema.UnionElements)
+	outSchema.TypedUnion = options.TypedUnions
 
 	// Verify that all union elements have at least one discriminator mapping.
 	// Note: Multiple discriminator values can map to the same schema (e.g., different event types
@@ -402,6 +529,7 @@ func generateUnionFromTypes(types []string, schema *base.Schema, constraints Con
 
 	// Deduplicate union elements
 	outSchema.UnionElements = deduplicateUnionElements(outSchema.UnionElements)
+	outSchema.TypedUnion = options.TypedUnions
 
 	// Set GoType using createGoStruct to generate proper union struct
 	outSchema.GoType = outSchema.createGoStruct(nil)
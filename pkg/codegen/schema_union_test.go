@@ -103,6 +103,79 @@ func TestGenerateGoSchema_generateUnion(t *testing.T) {
 	})
 }
 
+func TestDiscriminator_Cases(t *testing.T) {
+	d := &Discriminator{
+		Property: "type",
+		Mapping:  map[string]string{"dog": "Dog", "parrot": "Bird", "bird": "Bird", `"quoted"`: "Quoted"},
+	}
+
+	assert.Equal(t, []string{"bird", "parrot"}, d.ValuesOf("Bird"))
+	assert.Nil(t, d.ValuesOf("Cat"))
+
+	assert.Equal(t, "dog", d.ValueOf("Dog"))
+	assert.Equal(t, "", d.ValueOf("Bird"), "several values map to Bird")
+
+	assert.Equal(t, `"bird", "parrot"`, d.CasesOf("Bird"))
+	assert.Equal(t, `"\"quoted\"", "bird", "dog", "parrot"`, d.Cases())
+}
+
+func TestGoSchema_UnionType(t *testing.T) {
+	elements := func(types ...string) []UnionElement {
+		res := make([]UnionElement, len(types))
+		for i, typ := range types {
+			res[i] = UnionElement{TypeName: typ}
+		}
+		return res
+	}
+
+	tests := []struct {
+		name     string
+		schema   GoSchema
+		expected string
+	}{
+		{name: "no union", schema: GoSchema{}},
+		{name: "either", schema: GoSchema{UnionElements: elements("A", "B")}, expected: "runtime.Either[A, B]"},
+		{name: "3 elements", schema: GoSchema{UnionElements: elements("A", "B", "C")}},
+		{name: "typed 3 elements", schema: GoSchema{UnionElements: elements("A", "B", "C"), TypedUnion: true}, expected: "runtime.OneOf3[A, B, C]"},
+		{name: "typed 4 elements", schema: GoSchema{UnionElements: elements("A", "B", "C", "[]D"), TypedUnion: true}, expected: "runtime.OneOf4[A, B, C, []D]"},
+		{name: "typed 5 elements", schema: GoSchema{UnionElements: elements("A", "B", "C", "D", "E"), TypedUnion: true}},
+		{name: "anyOf variants", schema: GoSchema{UnionElements: elements("A", "B"), TypedUnion: true, AnyOfVariants: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.schema.UnionType())
+
+			variants := tt.schema.UnionVariants()
+			if tt.expected == "" {
+				assert.Nil(t, variants)
+				return
+			}
+			require.Len(t, variants, len(tt.schema.UnionElements))
+			for i, v := range variants {
+				assert.Equal(t, tt.schema.UnionElements[i].TypeName, v.TypeName)
+				assert.Equal(t, i+1, v.N)
+				assert.Equal(t, string(rune('A'+i)), v.Field)
+			}
+		})
+	}
+}
+
+func TestGoSchema_AnyOfVariantsStruct(t *testing.T) {
+	schema := GoSchema{
+		UnionElements: []UnionElement{{TypeName: "Identity"}, {TypeName: "string"}, {TypeName: "external.Type"}},
+		AnyOfVariants: true,
+	}
+
+	expected := "struct {\n" +
+		"Identity *Identity `json:\"-\"`\n" +
+		"String *string `json:\"-\"`\n" +
+		"ExternalType *external.Type `json:\"-\"`\n" +
+		"union json.RawMessage\n" +
+		"}"
+	assert.Equal(t, expected, schema.createGoStruct(nil))
+}
+
 func TestExtractDiscriminatorValue(t *testing.T) {
 	t.Run("extracts discriminator value from inline schema with enum", func(t *testing.T) {
 		// Create a simple inline schema with a discriminator property that has an enum value
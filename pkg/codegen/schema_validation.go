@@ -12,6 +12,7 @@ package codegen
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 )
 
@@ -30,6 +31,7 @@ const (
 	errMsgArrayMinItems    = "must have at least %d items, got %%d"
 	errMsgArrayMaxItems    = "must have at most %d items, got %%d"
 	errMsgArrayMinItemsNil = "must have at least %d items, got 0"
+	errMsgArrayUniqueItems = "must have unique items, item %d is a duplicate"
 
 	// Map validation error messages
 	errMsgMapMinProps    = "must have at least %d properties, got %%d"
@@ -159,8 +161,13 @@ func (s GoSchema) generateArrayValidation(alias, validatorVar string) string {
 	}
 
 	// Collect all constraint violations
-	needsErrorCollection := (s.Constraints.MinItems != nil && s.Constraints.MaxItems != nil) ||
-		(s.ArrayType != nil && s.ArrayType.NeedsValidation())
+	arrayChecks := 0
+	for _, c := range []bool{s.Constraints.MinItems != nil, s.Constraints.MaxItems != nil, deref(s.Constraints.UniqueItems)} {
+		if c {
+			arrayChecks++
+		}
+	}
+	needsErrorCollection := arrayChecks > 1 || (s.ArrayType != nil && s.ArrayType.NeedsValidation())
 
 	if needsErrorCollection {
 		lines = append(lines, declareErrorsVar())
@@ -188,6 +195,16 @@ func (s GoSchema) generateArrayValidation(alias, validatorVar string) string {
 		}
 		lines = append(lines, "}")
 	}
+	// Check UniqueItems constraint
+	if deref(s.Constraints.UniqueItems) {
+		lines = append(lines, fmt.Sprintf("if i, ok := runtime.DuplicateItem(%s); ok {", alias))
+		if needsErrorCollection {
+			lines = append(lines, fmt.Sprintf("    errors = errors.Add(\"Array\", fmt.Sprintf(\"%s\", i))", errMsgArrayUniqueItems))
+		} else {
+			lines = append(lines, fmt.Sprintf("    return runtime.NewValidationError(\"Array\", fmt.Sprintf(\"%s\", i))", errMsgArrayUniqueItems))
+		}
+		lines = append(lines, "}")
+	}
 	// Validate array items if they need validation
 	if s.ArrayType != nil && s.ArrayType.NeedsValidation() {
 		lines = append(lines, "for i, item := range "+alias+" {")
@@ -200,7 +217,7 @@ func (s GoSchema) generateArrayValidation(alias, validatorVar string) string {
 			lines = append(lines, "    }")
 		} else {
 			// Otherwise, try to call Validate() method (for RefTypes, structs, unions)
-			lines = append(lines, "    if v, ok := any(item).(runtime.Validator); ok {")
+			lines = append(lines, "    if v, ok := any(item).(runtime.Validator); ok"+nonNilCheck("item", schemaValueIsPointer(s.ArrayType))+" {")
 			lines = append(lines, "        if err := v.Validate(); err != nil {")
 			lines = append(lines, "            errors = errors.Append(fmt.Sprintf(\"[%d]\", i), err)")
 			lines = append(lines, "        }")
@@ -283,10 +300,16 @@ func (s GoSchema) generateMapValidation(alias, validatorVar string) string {
 			lines = append(lines, "    }")
 			lines = append(lines, "}")
 			lines = append(lines, returnNilIfEmptyErrors())
+		} else if s.AdditionalPropertiesType.isContainerType() && s.AdditionalPropertiesType.NeedsValidation() {
+			// Slices and maps don't validate themselves, iterate their elements
+			lines = append(lines, "for k, v := range "+alias+" {")
+			lines = append(lines, generateValueValidation("v", "%s", []string{"k"}, s.AdditionalPropertiesType, schemaValueIsPointer(s.AdditionalPropertiesType), validatorVar, 0)...)
+			lines = append(lines, "}")
+			lines = append(lines, returnNilIfEmptyErrors())
 		} else if s.AdditionalPropertiesType.NeedsValidation() {
 			// For complex types (structs, unions, etc.), call Validate() method
 			lines = append(lines, "for k, v := range "+alias+" {")
-			lines = append(lines, "    if validator, ok := any(v).(runtime.Validator); ok {")
+			lines = append(lines, "    if validator, ok := any(v).(runtime.Validator); ok"+nonNilCheck("v", schemaValueIsPointer(s.AdditionalPropertiesType))+" {")
 			lines = append(lines, "        if err := validator.Validate(); err != nil {")
 			lines = append(lines, "            errors = errors.Append(k, err)")
 			lines = append(lines, "        }")
@@ -339,7 +362,15 @@ func (s GoSchema) generateCustomPropertyValidation(alias, validatorVar string) s
 	// Collect all errors instead of returning early
 	lines = append(lines, declareErrorsVar())
 	for _, prop := range s.Properties {
-		if prop.needsCustomValidation() {
+		if prop.NullableWrapper {
+			if prop.needsCustomValidation() {
+				lines = append(lines, generateNullablePropertyValidation(alias, prop, validatorVar)...)
+			}
+		} else if prop.SensitiveWrapper {
+			if prop.needsCustomValidation() {
+				lines = append(lines, generateSensitivePropertyValidation(alias, prop, validatorVar)...)
+			}
+		} else if prop.needsCustomValidation() {
 			// Check if this is an array property with items that need validation
 			if prop.Schema.ArrayType != nil && prop.Schema.ArrayType.NeedsValidation() {
 				lines = append(lines, generateArrayPropertyValidation(alias, prop, validatorVar)...)
@@ -356,6 +387,15 @@ func (s GoSchema) generateCustomPropertyValidation(alias, validatorVar string) s
 					lines = append(lines, "        }")
 					lines = append(lines, "    }")
 					lines = append(lines, "}")
+				} else if prop.OmitZero {
+					// absent values are zero, and not validated like nil pointers
+					lines = append(lines, fmt.Sprintf("if !runtime.IsZero(%s.%s) {", alias, prop.GoName))
+					lines = append(lines, fmt.Sprintf("    if v, ok := any(%s.%s).(runtime.Validator); ok {", alias, prop.GoName))
+					lines = append(lines, "        if err := v.Validate(); err != nil {")
+					lines = append(lines, fmt.Sprintf("            errors = errors.Append(\"%s\", err)", prop.GoName))
+					lines = append(lines, "        }")
+					lines = append(lines, "    }")
+					lines = append(lines, "}")
 				} else {
 					// For non-pointer types, we still need to handle the case where the field
 					// might be nil (e.g., slices, maps, interfaces).
@@ -401,15 +441,109 @@ func (s GoSchema) generateCustomPropertyValidation(alias, validatorVar string) s
 		}
 	}
 
+	if s.additionalPropertiesNeedValidation() {
+		lines = append(lines, fmt.Sprintf("for k, v := range %s.AdditionalProperties {", alias))
+		lines = append(lines, generateValueValidation("v", "%s", []string{"k"}, s.AdditionalPropertiesType, schemaValueIsPointer(s.AdditionalPropertiesType), validatorVar, 0)...)
+		lines = append(lines, "}")
+	}
+
 	lines = append(lines, returnNilIfEmptyErrors())
 	return strings.Join(lines, "\n")
 }
 
+// generateValueValidation generates validation for a single value of the given schema,
+// iterating slices and maps so their elements are validated at any depth.
+// The field name used in errors is built from fieldFormat and the Go expressions in fieldArgs.
+// pointer tells whether the value is a pointer, e.g. a nullable item, whose nil values are valid.
+func generateValueValidation(value, fieldFormat string, fieldArgs []string, schema *GoSchema, pointer bool, validatorVar string, depth int) []string {
+	var lines []string
+
+	field := fieldArgs[0]
+	if fieldFormat != "%s" {
+		field = fmt.Sprintf("fmt.Sprintf(\"%s\", %s)", fieldFormat, strings.Join(fieldArgs, ", "))
+	}
+
+	if len(schema.Constraints.ValidationTags) > 0 {
+		tags := strings.Join(schema.Constraints.ValidationTags, ",")
+		lines = append(lines, fmt.Sprintf("if err := %s.Var(%s, \"%s\"); err != nil {", validatorVar, value, tags))
+		lines = append(lines, fmt.Sprintf("    errors = errors.Append(%s, err)", field))
+		lines = append(lines, "}")
+	}
+
+	switch {
+	case schema.isContainerType() && schema.ArrayType != nil:
+		if !schema.ArrayType.NeedsValidation() {
+			break
+		}
+		index, item := fmt.Sprintf("i%d", depth), fmt.Sprintf("item%d", depth)
+		lines = append(lines, fmt.Sprintf("for %s, %s := range %s {", index, item, value))
+		args := append(append([]string{}, fieldArgs...), index)
+		lines = append(lines, generateValueValidation(item, fieldFormat+"[%d]", args, schema.ArrayType, schemaValueIsPointer(schema.ArrayType), validatorVar, depth+1)...)
+		lines = append(lines, "}")
+	case schema.isContainerType():
+		if len(schema.AdditionalPropertiesType.Constraints.ValidationTags) == 0 && !schema.AdditionalPropertiesType.NeedsValidation() {
+			break
+		}
+		key, val := fmt.Sprintf("k%d", depth), fmt.Sprintf("v%d", depth)
+		lines = append(lines, fmt.Sprintf("for %s, %s := range %s {", key, val, value))
+		args := append(append([]string{}, fieldArgs...), key)
+		lines = append(lines, generateValueValidation(val, fieldFormat+"[%s]", args, schema.AdditionalPropertiesType, schemaValueIsPointer(schema.AdditionalPropertiesType), validatorVar, depth+1)...)
+		lines = append(lines, "}")
+	case len(schema.Constraints.ValidationTags) == 0:
+		// Structs, unions and references validate themselves
+		lines = append(lines, fmt.Sprintf("if val, ok := any(%s).(runtime.Validator); ok%s {", value, nonNilCheck(value, pointer)))
+		lines = append(lines, "    if err := val.Validate(); err != nil {")
+		lines = append(lines, fmt.Sprintf("        errors = errors.Append(%s, err)", field))
+		lines = append(lines, "    }")
+		lines = append(lines, "}")
+	}
+
+	return lines
+}
+
+// generateNullablePropertyValidation generates validation code for a runtime.Nullable property,
+// validating its value when it is set.
+func generateNullablePropertyValidation(alias string, prop Property, validatorVar string) []string {
+	schema := prop.Schema
+	schema.Constraints.ValidationTags = prop.Constraints.ValidationTags
+
+	lines := []string{fmt.Sprintf("if value, ok := %s.%s.Get(); ok {", alias, prop.GoName)}
+	lines = append(lines, generateValueValidation("value", "%s", []string{strconv.Quote(prop.GoName)}, &schema, false, validatorVar, 0)...)
+	return append(lines, "}")
+}
+
+// generateSensitivePropertyValidation generates validation code for a runtime.Sensitive property,
+// validating its value with the tags of the property.
+func generateSensitivePropertyValidation(alias string, prop Property, validatorVar string) []string {
+	tags := strings.Join(prop.Constraints.ValidationTags, ",")
+	check := []string{
+		fmt.Sprintf("if err := %s.Var(%s.%s.Value(), \"%s\"); err != nil {", validatorVar, alias, prop.GoName, tags),
+		fmt.Sprintf("    errors = errors.Append(\"%s\", err)", prop.GoName),
+		"}",
+	}
+	if !prop.IsPointerType() {
+		return check
+	}
+	lines := []string{fmt.Sprintf("if %s.%s != nil {", alias, prop.GoName)}
+	for _, line := range check {
+		lines = append(lines, "    "+line)
+	}
+	return append(lines, "}")
+}
+
 // generateArrayPropertyValidation generates validation code for an array property
 func generateArrayPropertyValidation(alias string, prop Property, validatorVar string) []string {
 	var lines []string
 	fieldAccess := fmt.Sprintf("%s.%s", alias, prop.GoName)
 
+	// Check slice-level constraints (e.g. min/max/unique from array parameters) before the items
+	if hasSliceValidationTags(prop.Constraints.ValidationTags) {
+		tags := strings.Join(prop.Constraints.ValidationTags, ",")
+		lines = append(lines, fmt.Sprintf("if err := %s.Var(%s, \"%s\"); err != nil {", validatorVar, fieldAccess, tags))
+		lines = append(lines, fmt.Sprintf("    errors = errors.Append(\"%s\", err)", prop.GoName))
+		lines = append(lines, "}")
+	}
+
 	// Check for nil before iterating
 	lines = append(lines, fmt.Sprintf("for i, item := range %s {", fieldAccess))
 
@@ -421,7 +555,7 @@ func generateArrayPropertyValidation(alias string, prop Property, validatorVar s
 		lines = append(lines, "    }")
 	} else {
 		// Otherwise, try to call Validate() method (for RefTypes, structs, unions)
-		lines = append(lines, "    if v, ok := any(item).(runtime.Validator); ok {")
+		lines = append(lines, "    if v, ok := any(item).(runtime.Validator); ok"+nonNilCheck("item", schemaValueIsPointer(prop.Schema.ArrayType))+" {")
 		lines = append(lines, "        if err := v.Validate(); err != nil {")
 		lines = append(lines, fmt.Sprintf("            errors = errors.Append(fmt.Sprintf(\"%s[%%d]\", i), err)", prop.GoName))
 		lines = append(lines, "        }")
@@ -446,9 +580,12 @@ func generateMapPropertyValidation(alias string, prop Property, validatorVar str
 		lines = append(lines, fmt.Sprintf("    if err := %s.Var(v, \"%s\"); err != nil {", validatorVar, tags))
 		lines = append(lines, fmt.Sprintf("        errors = errors.Append(fmt.Sprintf(\"%s[%%s]\", k), err)", prop.GoName))
 		lines = append(lines, "    }")
+	} else if prop.Schema.AdditionalPropertiesType.isContainerType() {
+		// Slices and maps don't validate themselves, iterate their elements
+		lines = append(lines, generateValueValidation("v", prop.GoName+"[%s]", []string{"k"}, prop.Schema.AdditionalPropertiesType, false, validatorVar, 0)...)
 	} else {
 		// Otherwise, try to call Validate() method (for RefTypes, structs, unions)
-		lines = append(lines, "    if validator, ok := any(v).(runtime.Validator); ok {")
+		lines = append(lines, "    if validator, ok := any(v).(runtime.Validator); ok"+nonNilCheck("v", schemaValueIsPointer(prop.Schema.AdditionalPropertiesType))+" {")
 		lines = append(lines, "        if err := validator.Validate(); err != nil {")
 		lines = append(lines, fmt.Sprintf("            errors = errors.Append(fmt.Sprintf(\"%s[%%s]\", k), err)", prop.GoName))
 		lines = append(lines, "        }")
@@ -459,8 +596,27 @@ func generateMapPropertyValidation(alias string, prop Property, validatorVar str
 	return lines
 }
 
+// nonNilCheck returns the condition skipping value when it is a nil pointer: nil is a valid nullable value,
+// and calling the Validate method of its type, with a value receiver, would panic.
+func nonNilCheck(value string, pointer bool) string {
+	if !pointer {
+		return ""
+	}
+	return " && " + value + " != nil"
+}
+
 // Helper predicates
 
+// hasSliceValidationTags checks if the tags constrain the slice itself rather than just its presence
+func hasSliceValidationTags(tags []string) bool {
+	for _, tag := range tags {
+		if tag != "required" && tag != "omitempty" {
+			return true
+		}
+	}
+	return false
+}
+
 // isStructType checks if this schema represents a struct type
 func (s GoSchema) isStructType() bool {
 	typeDecl := s.TypeDecl()
@@ -473,6 +629,10 @@ func (s GoSchema) canUseSimpleStructValidation() bool {
 	if !strings.HasPrefix(typeDecl, "struct") || len(s.Properties) == 0 || s.ContainsUnions() {
 		return false
 	}
+	// validate.Struct() doesn't look into the AdditionalProperties map
+	if s.additionalPropertiesNeedValidation() {
+		return false
+	}
 	// Check if any property needs custom validation
 	for _, prop := range s.Properties {
 		if prop.needsCustomValidation() {
@@ -514,5 +674,20 @@ func (s GoSchema) hasCustomValidation() bool {
 			return true
 		}
 	}
-	return false
+	return len(s.Properties) > 0 && s.additionalPropertiesNeedValidation()
+}
+
+// additionalPropertiesNeedValidation checks if the values of the AdditionalProperties field of a struct need validation
+func (s GoSchema) additionalPropertiesNeedValidation() bool {
+	if !s.HasAdditionalProperties || s.AdditionalPropertiesType == nil {
+		return false
+	}
+	return len(s.AdditionalPropertiesType.Constraints.ValidationTags) > 0 || s.AdditionalPropertiesType.NeedsValidation()
+}
+
+// isContainerType checks if values of this schema are slices or maps that have to be iterated to validate their elements
+func (s GoSchema) isContainerType() bool {
+	typeDecl := strings.TrimPrefix(s.TypeDecl(), "*")
+	return (s.ArrayType != nil && strings.HasPrefix(typeDecl, "[]")) ||
+		(s.AdditionalPropertiesType != nil && strings.HasPrefix(typeDecl, "map["))
 }
@@ -150,6 +150,57 @@ func TestGoSchema_ValidateDecl_ArrayWithMinAndMaxItems(t *testing.T) {
 	assertCodeEqual(t, expected, result)
 }
 
+func TestGoSchema_ValidateDecl_ArrayWithUniqueItems(t *testing.T) {
+	schema := GoSchema{
+		GoType: "[]string",
+		ArrayType: &GoSchema{
+			GoType: "string",
+		},
+		Constraints: Constraints{
+			UniqueItems: ptr(true),
+		},
+	}
+
+	result := schema.ValidateDecl("p", "validate")
+	expected := `
+		if i, ok := runtime.DuplicateItem(p); ok {
+			return runtime.NewValidationError("Array", fmt.Sprintf("must have unique items, item %d is a duplicate", i))
+		}
+		return nil
+	`
+	assertCodeEqual(t, expected, result)
+}
+
+func TestGoSchema_ValidateDecl_ArrayWithMaxAndUniqueItems(t *testing.T) {
+	maxItems := int64(10)
+	schema := GoSchema{
+		GoType: "[]string",
+		ArrayType: &GoSchema{
+			GoType: "string",
+		},
+		Constraints: Constraints{
+			MaxItems:    &maxItems,
+			UniqueItems: ptr(true),
+		},
+	}
+
+	result := schema.ValidateDecl("p", "validate")
+	expected := `
+		var errors runtime.ValidationErrors
+		if len(p) > 10 {
+			errors = errors.Add("Array", fmt.Sprintf("must have at most 10 items, got %d", len(p)))
+		}
+		if i, ok := runtime.DuplicateItem(p); ok {
+			errors = errors.Add("Array", fmt.Sprintf("must have unique items, item %d is a duplicate", i))
+		}
+		if len(errors) == 0 {
+			return nil
+		}
+		return errors
+	`
+	assertCodeEqual(t, expected, result)
+}
+
 func TestGoSchema_ValidateDecl_NullableArrayWithConstraints(t *testing.T) {
 	minItems := int64(1)
 	nullable := true
@@ -413,6 +464,37 @@ func TestGoSchema_ValidateDecl_MapWithRefTypeValues(t *testing.T) {
 	assertCodeEqual(t, expected, result)
 }
 
+func TestGoSchema_ValidateDecl_MapWithNestedArrayValues(t *testing.T) {
+	schema := GoSchema{
+		GoType: "map[string][]Payment",
+		AdditionalPropertiesType: &GoSchema{
+			GoType: "[]Payment",
+			ArrayType: &GoSchema{
+				RefType: "Payment",
+			},
+		},
+	}
+
+	result := schema.ValidateDecl("m", "validate")
+	expected := `
+		var errors runtime.ValidationErrors
+		for k, v := range m {
+			for i0, item0 := range v {
+				if val, ok := any(item0).(runtime.Validator); ok {
+					if err := val.Validate(); err != nil {
+						errors = errors.Append(fmt.Sprintf("%s[%d]", k, i0), err)
+					}
+				}
+			}
+		}
+		if len(errors) == 0 {
+			return nil
+		}
+		return errors
+	`
+	assertCodeEqual(t, expected, result)
+}
+
 func TestGoSchema_ValidateDecl_MapWithExternalRefTypeValues(t *testing.T) {
 	schema := GoSchema{
 		GoType: "map[string]external.Payment",
@@ -499,6 +581,82 @@ func TestGoSchema_ValidateDecl_StructWithRefTypeProperty(t *testing.T) {
 	assertCodeEqual(t, expected, result)
 }
 
+func TestGoSchema_ValidateDecl_StructWithAdditionalProperties(t *testing.T) {
+	schema := GoSchema{
+		GoType: "struct { Name *string }",
+		Properties: []Property{
+			{
+				GoName:        "Name",
+				JsonFieldName: "name",
+				Schema:        GoSchema{GoType: "string"},
+			},
+		},
+		HasAdditionalProperties: true,
+		AdditionalPropertiesType: &GoSchema{
+			GoType: "map[string]User",
+			AdditionalPropertiesType: &GoSchema{
+				RefType: "User",
+			},
+		},
+	}
+
+	if !schema.NeedsValidation() {
+		t.Fatal("expected struct with validated additional properties to need validation")
+	}
+
+	result := schema.ValidateDecl("s", "validate")
+	expected := `
+		var errors runtime.ValidationErrors
+		for k, v := range s.AdditionalProperties {
+			for k0, v0 := range v {
+				if val, ok := any(v0).(runtime.Validator); ok {
+					if err := val.Validate(); err != nil {
+						errors = errors.Append(fmt.Sprintf("%s[%s]", k, k0), err)
+					}
+				}
+			}
+		}
+		if len(errors) == 0 {
+			return nil
+		}
+		return errors
+	`
+	assertCodeEqual(t, expected, result)
+}
+
+func TestGoSchema_ValidateDecl_StructWithConstrainedAdditionalProperties(t *testing.T) {
+	schema := GoSchema{
+		GoType: "struct { Name *string }",
+		Properties: []Property{
+			{
+				GoName:        "Name",
+				JsonFieldName: "name",
+				Schema:        GoSchema{GoType: "string"},
+			},
+		},
+		HasAdditionalProperties: true,
+		AdditionalPropertiesType: &GoSchema{
+			GoType:      "string",
+			Constraints: Constraints{ValidationTags: []string{"max=3"}},
+		},
+	}
+
+	result := schema.ValidateDecl("s", "validate")
+	expected := `
+		var errors runtime.ValidationErrors
+		for k, v := range s.AdditionalProperties {
+			if err := validate.Var(v, "max=3"); err != nil {
+				errors = errors.Append(k, err)
+			}
+		}
+		if len(errors) == 0 {
+			return nil
+		}
+		return errors
+	`
+	assertCodeEqual(t, expected, result)
+}
+
 func TestGoSchema_ValidateDecl_StructWithPointerRefTypeProperty(t *testing.T) {
 	schema := GoSchema{
 		GoType: "struct { User *User }",
@@ -1239,3 +1397,44 @@ func TestGoSchema_ValidateDecl_StructWithMapOfCustomTypes(t *testing.T) {
 	`
 	assertCodeEqual(t, expected, result)
 }
+
+func TestGoSchema_ValidateDecl_StructWithConstrainedArrayOfRefTypes(t *testing.T) {
+	schema := GoSchema{
+		GoType: "struct { Ids []ID }",
+		Properties: []Property{
+			{
+				GoName: "Ids",
+				Schema: GoSchema{
+					GoType: "[]ID",
+					ArrayType: &GoSchema{
+						RefType: "ID",
+					},
+				},
+				Constraints: Constraints{
+					Required:       ptr(true),
+					ValidationTags: []string{"required", "max=5", "min=1"},
+				},
+			},
+		},
+	}
+
+	result := schema.ValidateDecl("p", "validate")
+	expected := `
+		var errors runtime.ValidationErrors
+		if err := validate.Var(p.Ids, "required,max=5,min=1"); err != nil {
+			errors = errors.Append("Ids", err)
+		}
+		for i, item := range p.Ids {
+			if v, ok := any(item).(runtime.Validator); ok {
+				if err := v.Validate(); err != nil {
+					errors = errors.Append(fmt.Sprintf("Ids[%d]", i), err)
+				}
+			}
+		}
+		if len(errors) == 0 {
+			return nil
+		}
+		return errors
+	`
+	assertCodeEqual(t, expected, result)
+}
@@ -0,0 +1,105 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	// SensitiveDataTestsFile is the name of the file with the generated tests of the masked types.
+	SensitiveDataTestsFile = "sensitive_data_test.go"
+
+	// sensitiveSampleLength is the length of the values the tests set the masked string fields to,
+	// unless the schema requires another one.
+	sensitiveSampleLength = 16
+)
+
+// SensitiveDataTest is the wire format test of a type with x-sensitive-data properties.
+type SensitiveDataTest struct {
+	TypeName string
+	Fields   []SensitiveDataTestField
+}
+
+// SensitiveDataTestField is a masked property of a SensitiveDataTest.
+// String fields are set to Sample, a Go string literal, and have their masked lengths checked,
+// other fields keep their zero value. Wrapped fields are declared as runtime.Sensitive.
+type SensitiveDataTestField struct {
+	GoName    string
+	Pointer   bool
+	Wrapped   bool
+	Sample    string
+	MinLength *int64
+	MaxLength *int64
+}
+
+// TplSensitiveDataTestsContext is the context passed to templates to generate the tests of the masked types.
+type TplSensitiveDataTestsContext struct {
+	Tests      []SensitiveDataTest
+	Imports    []string
+	Config     Configuration
+	WithHeader bool
+}
+
+// newSensitiveDataTests returns the tests of the types masking properties in their MarshalJSON, sorted by type name.
+func newSensitiveDataTests(ctx *ParseContext) []SensitiveDataTest {
+	tests := make(map[string]SensitiveDataTest)
+	add := func(td TypeDefinition) {
+		s := td.Schema
+		if !td.HasSensitiveData || !td.NeedsMarshaler || td.IsAlias() || s.HasAdditionalProperties || s.ArrayType != nil {
+			return
+		}
+		test := SensitiveDataTest{TypeName: td.Name}
+		for _, p := range s.Properties {
+			if p.SensitiveData == nil {
+				continue
+			}
+			field := SensitiveDataTestField{GoName: p.GoName, Pointer: p.IsPointerType(), Wrapped: p.SensitiveWrapper}
+			if p.Schema.TypeDecl() == "string" {
+				field.Sample = strconv.Quote(sensitiveSample(p.Constraints))
+				field.MinLength = p.Constraints.MinLength
+				field.MaxLength = p.Constraints.MaxLength
+			}
+			test.Fields = append(test.Fields, field)
+		}
+		tests[td.Name] = test
+	}
+
+	for _, tds := range ctx.TypeDefinitions {
+		for _, td := range tds {
+			add(td)
+		}
+	}
+	for _, td := range ctx.UnionTypes {
+		add(td)
+	}
+
+	res := make([]SensitiveDataTest, 0, len(tests))
+	for _, name := range slices.Sorted(maps.Keys(tests)) {
+		res = append(res, tests[name])
+	}
+	return res
+}
+
+// sensitiveSample returns a string of digits with a length within the bounds of the constraints.
+func sensitiveSample(c Constraints) string {
+	n := int64(sensitiveSampleLength)
+	if c.MaxLength != nil && *c.MaxLength < n {
+		n = *c.MaxLength
+	}
+	if c.MinLength != nil && *c.MinLength > n {
+		n = *c.MinLength
+	}
+	return strings.Repeat("1234567890", int(n)/10+1)[:n]
+}
@@ -0,0 +1,68 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"go/format"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSensitiveDataTests(t *testing.T) {
+	spec := []byte(readTestdata(t, "sensitive-data-tests.yml"))
+
+	t.Run("disabled", func(t *testing.T) {
+		codes, err := Generate(spec, Configuration{PackageName: "api", SkipPrune: true})
+		require.NoError(t, err)
+		assert.NotContains(t, codes, SensitiveDataTestsFile)
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		codes, err := Generate(spec, Configuration{
+			PackageName: "api",
+			SkipPrune:   true,
+			Generate:    &GenerateOptions{SensitiveDataTests: true},
+		})
+		require.NoError(t, err)
+		assert.Contains(t, codes.GetExtraFiles(), SensitiveDataTestsFile)
+
+		code := codes[SensitiveDataTestsFile]
+		assert.Contains(t, code, "package api")
+
+		// string fields are set within their lengths and checked once masked
+		assert.Contains(t, code, "func TestCard_SensitiveDataWireFormat(t *testing.T) {")
+		assert.Contains(t, code, `v.Number = "1234567890123456"`)
+		assert.Contains(t, code, `v.Pin = "1234"`)
+		assert.Contains(t, code, "if n < 12 {")
+		assert.Contains(t, code, "if n > 19 {")
+		assert.Contains(t, code, `t.Errorf("masked Card.Pin has %d characters, more than the maxLength of 4", n)`)
+		assert.NotContains(t, code, "Holder")
+
+		// other fields are marshaled with their zero value
+		assert.Contains(t, code, "func TestAccount_SensitiveDataWireFormat(t *testing.T) {")
+		assert.NotContains(t, code, "v.Balance")
+
+		assert.NotContains(t, code, "TestPlain")
+
+		_, err = format.Source([]byte(code))
+		require.NoError(t, err)
+	})
+}
+
+func TestSensitiveSample(t *testing.T) {
+	ptr := func(v int64) *int64 { return &v }
+
+	assert.Equal(t, "1234567890123456", sensitiveSample(Constraints{}))
+	assert.Equal(t, "1234", sensitiveSample(Constraints{MaxLength: ptr(4)}))
+	assert.Equal(t, "1234567890123456789012", sensitiveSample(Constraints{MinLength: ptr(22)}))
+}
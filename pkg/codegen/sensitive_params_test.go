@@ -0,0 +1,118 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"go/format"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSensitiveParameters(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "api",
+		Generate:    &GenerateOptions{Client: true},
+		Output:      &Output{UseSingleFile: true},
+	}
+	codes, err := Generate([]byte(readTestdata(t, "sensitive-parameters.yml")), cfg)
+	require.NoError(t, err)
+	code := codes.GetCombined()
+
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+
+	assert.Contains(t, code, "GetUserSensitiveParameters = runtime.SensitiveParameters{")
+	assert.Contains(t, code, `Path: "/users/{ssn}",`)
+	assert.Contains(t, code, `In:   "path",`)
+	assert.Contains(t, code, `Name: "ssn",`)
+	assert.Contains(t, code, "Type:       runtime.MaskTypePartial,")
+	assert.Contains(t, code, `Name: "api_key",`)
+	assert.Contains(t, code, "runtime.MaskTypeHash,")
+	assert.Contains(t, code, `Name: "X-Auth-Token",`)
+	assert.Contains(t, code, "Type:       runtime.MaskTypeCustom,")
+	assert.Contains(t, code, `Name:       "last4",`)
+	assert.NotContains(t, code, `Name: "page",`)
+	assert.NotContains(t, code, "ListOrdersSensitiveParameters")
+	assert.Contains(t, code, "SensitiveParameters: GetUserSensitiveParameters,")
+}
+
+func TestSensitiveParameters_Cookie(t *testing.T) {
+	spec := `openapi: 3.0.0
+info:
+  title: Sensitive cookie
+  version: 1.0.0
+paths:
+  /session:
+    get:
+      operationId: getSession
+      parameters:
+        - name: session
+          in: cookie
+          schema:
+            type: string
+          x-sensitive-data: {}
+      responses:
+        '204':
+          description: OK
+`
+	cfg := Configuration{
+		PackageName: "api",
+		Generate:    &GenerateOptions{Client: true},
+		Output:      &Output{UseSingleFile: true},
+	}
+	_, err := Generate([]byte(spec), cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "x-sensitive-data is not supported on cookie parameter session")
+}
+
+func TestSensitiveData_CustomWithoutName(t *testing.T) {
+	spec := `openapi: 3.0.0
+info:
+  title: Custom mask
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Account:
+      type: object
+      properties:
+        number:
+          type: string
+          x-sensitive-data:
+            mask: custom
+`
+	_, err := Generate([]byte(spec), Configuration{PackageName: "api", SkipPrune: true})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid x-sensitive-data of property 'number': x-sensitive-data with mask custom requires the name")
+}
+
+func TestSensitiveData_MarshalJSONUnmasked(t *testing.T) {
+	spec := []byte(readTestdata(t, "sensitive-unmasked.yml"))
+	codes, err := Generate(spec, Configuration{PackageName: "api", SkipPrune: true, Output: &Output{UseSingleFile: true}})
+	require.NoError(t, err)
+
+	code := codes.GetCombined()
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+
+	assert.Contains(t, code, "func (c Card) MarshalJSONUnmasked() ([]byte, error) {")
+	assert.Contains(t, code, "unmasked := _Alias_Card(c)")
+
+	// types with additional properties write the values of their sensitive properties
+	assert.Contains(t, code, "func (m Metadata) MarshalJSONUnmasked() ([]byte, error) {")
+	assert.Contains(t, code, `object.WriteField("token", m.Token)`)
+	assert.Contains(t, code, `object.WriteField("token", runtime.MaskSensitiveValue(*m.Token,`)
+
+	// types without sensitive properties marshal as usual
+	assert.NotContains(t, code, "func (a Address) MarshalJSONUnmasked()")
+}
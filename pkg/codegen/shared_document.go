@@ -0,0 +1,93 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pb33f/libopenapi"
+)
+
+// SharedDocument generates several targets from the same OpenAPI document, e.g. models, client and server packages,
+// loading, filtering and pruning it once instead of once per target.
+// Targets with the same filter, prune and spec validation settings reuse the same built model.
+// A SharedDocument is not safe for concurrent use.
+type SharedDocument struct {
+	contents []byte
+	docs     map[string]libopenapi.Document
+}
+
+// NewSharedDocument creates a SharedDocument from OpenAPI contents.
+func NewSharedDocument(docContents []byte) *SharedDocument {
+	return &SharedDocument{
+		contents: docContents,
+		docs:     map[string]libopenapi.Document{},
+	}
+}
+
+// Document returns the filtered and pruned document for the configuration,
+// creating and validating it on the first call for its filter, prune and spec validation settings.
+func (s *SharedDocument) Document(cfg Configuration) (libopenapi.Document, error) {
+	key, err := json.Marshal(struct {
+		Filter         FilterConfig
+		SkipPrune      bool
+		SpecValidation SpecValidationOptions
+	}{cfg.Filter, cfg.SkipPrune, cfg.SpecValidation})
+	if err != nil {
+		return nil, fmt.Errorf("error creating document key: %w", err)
+	}
+
+	if doc, ok := s.docs[string(key)]; ok {
+		return doc, nil
+	}
+
+	doc, err := CreateDocument(s.contents, cfg)
+	if err != nil {
+		return nil, err
+	}
+	s.docs[string(key)] = doc
+
+	return doc, nil
+}
+
+// CreateParseContext creates a ParseContext for the configuration from the shared document.
+func (s *SharedDocument) CreateParseContext(cfg Configuration) (*ParseContext, []error) {
+	cfg = cfg.WithDefaults()
+
+	doc, err := s.Document(cfg)
+	if err != nil {
+		return nil, []error{fmt.Errorf("error filtering document: %w", err)}
+	}
+
+	res, err := CreateParseContextFromDocument(doc, cfg)
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	return res, nil
+}
+
+// Generate creates Go code for the configuration from the shared document.
+func (s *SharedDocument) Generate(cfg Configuration) (GeneratedCode, error) {
+	cfg = cfg.WithDefaults()
+	parseCtx, errs := s.CreateParseContext(cfg)
+	return generateFromParseContext(cfg, parseCtx, errs)
+}
+
+// RenderProcessedSpec renders the shared document after filtering and pruning for the configuration.
+func (s *SharedDocument) RenderProcessedSpec(cfg Configuration) ([]byte, error) {
+	doc, err := s.Document(cfg.WithDefaults())
+	if err != nil {
+		return nil, err
+	}
+	return renderDocument(doc, s.contents)
+}
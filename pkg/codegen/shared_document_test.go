@@ -0,0 +1,78 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSharedDocument(t *testing.T) {
+	contents := []byte(readTestdata(t, "prune-cat-dog.yml"))
+	catsOnly := FilterConfig{
+		Exclude: FilterParamsConfig{Tags: []string{"dog"}},
+	}
+
+	t.Run("same filter reuses the document", func(t *testing.T) {
+		shared := NewSharedDocument(contents)
+
+		models, err := shared.Document(Configuration{PackageName: "models"})
+		require.NoError(t, err)
+		client, err := shared.Document(Configuration{PackageName: "client", Generate: &GenerateOptions{Client: true}})
+		require.NoError(t, err)
+		assert.Same(t, models, client)
+
+		cats, err := shared.Document(Configuration{Filter: catsOnly})
+		require.NoError(t, err)
+		assert.NotSame(t, models, cats)
+	})
+
+	t.Run("validates the document for each spec validation setting", func(t *testing.T) {
+		shared := NewSharedDocument([]byte(readTestdata(t, "spec-validation.yml")))
+
+		_, err := shared.Document(Configuration{PackageName: "lenient"})
+		require.NoError(t, err)
+		_, err = shared.Document(Configuration{PackageName: "strict", SpecValidation: SpecValidationOptions{UnknownKeywords: SeverityError}})
+		require.ErrorIs(t, err, ErrInvalidSpec)
+		_, err = shared.Generate(Configuration{PackageName: "strict", SpecValidation: SpecValidationOptions{InvalidExamples: SeverityError}})
+		require.ErrorIs(t, err, ErrInvalidSpec)
+	})
+
+	t.Run("generates the same code as separate runs", func(t *testing.T) {
+		shared := NewSharedDocument(contents)
+		cfgs := []Configuration{
+			{PackageName: "models"},
+			{PackageName: "client", Generate: &GenerateOptions{Client: true}},
+			{PackageName: "cats", Filter: catsOnly},
+			{PackageName: "all", SkipPrune: true},
+		}
+
+		for _, cfg := range cfgs {
+			expected, err := Generate(contents, cfg)
+			require.NoError(t, err)
+
+			actual, err := shared.Generate(cfg)
+			require.NoError(t, err)
+			assert.Equal(t, expected.GetCombined(), actual.GetCombined(), cfg.PackageName)
+		}
+	})
+
+	t.Run("renders the processed spec", func(t *testing.T) {
+		expected, err := RenderProcessedSpec(contents, Configuration{Filter: catsOnly})
+		require.NoError(t, err)
+
+		actual, err := NewSharedDocument(contents).RenderProcessedSpec(Configuration{Filter: catsOnly})
+		require.NoError(t, err)
+		assert.Equal(t, string(expected), string(actual))
+	})
+}
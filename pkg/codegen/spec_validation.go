@@ -0,0 +1,478 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"go.yaml.in/yaml/v4"
+)
+
+// ErrInvalidSpec is returned when loading a spec with problems of a class configured with the error severity.
+var ErrInvalidSpec = errors.New("invalid spec")
+
+// schemaKeywords are the keywords of the JSON Schema 2020-12 vocabularies and the OpenAPI schema object.
+var schemaKeywords = map[string]bool{
+	"$schema": true, "$id": true, "$ref": true, "$anchor": true, "$dynamicAnchor": true, "$dynamicRef": true,
+	"$defs": true, "$comment": true, "$vocabulary": true,
+	"title": true, "description": true, "default": true, "deprecated": true, "readOnly": true, "writeOnly": true,
+	"examples": true, "example": true,
+	"type": true, "enum": true, "const": true, "format": true,
+	"multipleOf": true, "maximum": true, "exclusiveMaximum": true, "minimum": true, "exclusiveMinimum": true,
+	"maxLength": true, "minLength": true, "pattern": true,
+	"maxItems": true, "minItems": true, "uniqueItems": true, "maxContains": true, "minContains": true,
+	"maxProperties": true, "minProperties": true, "required": true, "dependentRequired": true,
+	"allOf": true, "anyOf": true, "oneOf": true, "not": true, "if": true, "then": true, "else": true,
+	"dependentSchemas": true, "prefixItems": true, "items": true, "contains": true,
+	"properties": true, "patternProperties": true, "additionalProperties": true, "propertyNames": true,
+	"unevaluatedItems": true, "unevaluatedProperties": true,
+	"contentEncoding": true, "contentMediaType": true, "contentSchema": true,
+	"nullable": true, "discriminator": true, "xml": true, "externalDocs": true,
+}
+
+// specProblem is a problem of the spec, found at the line of its location.
+type specProblem struct {
+	location string
+	line     int
+	message  string
+}
+
+func (p specProblem) String() string {
+	return fmt.Sprintf("%s (line %d): %s", p.location, p.line, p.message)
+}
+
+// specValidator collects the problems of the schemas and examples of a model, visiting each schema once.
+type specValidator struct {
+	visited         map[*yaml.Node]bool
+	invalidExamples []specProblem
+	unknownKeywords []specProblem
+}
+
+// validateSpec checks the model for the classes of problems not ignored by the options, logging the ones
+// to warn about and returning the ones failing generation, wrapped in ErrInvalidSpec.
+func validateSpec(model *v3high.Document, opts SpecValidationOptions) error {
+	if isSeverityIgnored(opts.InvalidExamples) && isSeverityIgnored(opts.UnknownKeywords) {
+		return nil
+	}
+
+	v := &specValidator{visited: map[*yaml.Node]bool{}}
+	v.walkModel(model)
+
+	var errs []error
+	for _, class := range []struct {
+		name     string
+		severity Severity
+		problems []specProblem
+	}{
+		{"invalid example", opts.InvalidExamples, v.invalidExamples},
+		{"unknown keyword", opts.UnknownKeywords, v.unknownKeywords},
+	} {
+		for _, problem := range class.problems {
+			switch class.severity {
+			case SeverityWarn:
+				slog.Warn(fmt.Sprintf("%s at %s", class.name, problem))
+			case SeverityError:
+				errs = append(errs, fmt.Errorf("%s at %s", class.name, problem))
+			}
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w:\n%w", ErrInvalidSpec, errors.Join(errs...))
+}
+
+func isSeverityIgnored(severity Severity) bool {
+	return severity == "" || severity == SeverityIgnore
+}
+
+// walkModel visits the components first, so that the problems of shared schemas are reported at their definition.
+func (v *specValidator) walkModel(model *v3high.Document) {
+	if components := model.Components; components != nil {
+		for name, proxy := range orderedMapItems(components.Schemas) {
+			v.walkSchema(proxy, "#/components/schemas/"+escapeJSONPointer(name))
+		}
+		for name, param := range orderedMapItems(components.Parameters) {
+			v.walkParameter(param, "#/components/parameters/"+escapeJSONPointer(name))
+		}
+		for name, header := range orderedMapItems(components.Headers) {
+			v.walkHeader(header, "#/components/headers/"+escapeJSONPointer(name))
+		}
+		for name, body := range orderedMapItems(components.RequestBodies) {
+			v.walkContent(body.Content, "#/components/requestBodies/"+escapeJSONPointer(name))
+		}
+		for name, response := range orderedMapItems(components.Responses) {
+			v.walkResponse(response, "#/components/responses/"+escapeJSONPointer(name))
+		}
+	}
+
+	if model.Paths != nil {
+		for path, pathItem := range orderedMapItems(model.Paths.PathItems) {
+			v.walkPathItem(pathItem, "#/paths/"+escapeJSONPointer(path))
+		}
+	}
+	for name, pathItem := range orderedMapItems(model.Webhooks) {
+		v.walkPathItem(pathItem, "#/webhooks/"+escapeJSONPointer(name))
+	}
+}
+
+func (v *specValidator) walkPathItem(pathItem *v3high.PathItem, location string) {
+	for i, param := range pathItem.Parameters {
+		v.walkParameter(param, fmt.Sprintf("%s/parameters/%d", location, i))
+	}
+	for method, op := range pathItem.GetOperations().FromOldest() {
+		opLocation := location + "/" + method
+		for i, param := range op.Parameters {
+			v.walkParameter(param, fmt.Sprintf("%s/parameters/%d", opLocation, i))
+		}
+		if op.RequestBody != nil {
+			v.walkContent(op.RequestBody.Content, opLocation+"/requestBody")
+		}
+		if op.Responses == nil {
+			continue
+		}
+		if op.Responses.Default != nil {
+			v.walkResponse(op.Responses.Default, opLocation+"/responses/default")
+		}
+		for code, response := range orderedMapItems(op.Responses.Codes) {
+			v.walkResponse(response, opLocation+"/responses/"+escapeJSONPointer(code))
+		}
+	}
+}
+
+func (v *specValidator) walkParameter(param *v3high.Parameter, location string) {
+	if low := param.GoLow(); low == nil || !v.visit(low.RootNode) {
+		return
+	}
+	v.walkSchema(param.Schema, location+"/schema")
+	v.checkExamples(param.Schema, param.Example, param.Examples, location)
+	v.walkContent(param.Content, location)
+}
+
+func (v *specValidator) walkHeader(header *v3high.Header, location string) {
+	if low := header.GoLow(); low == nil || !v.visit(low.RootNode) {
+		return
+	}
+	v.walkSchema(header.Schema, location+"/schema")
+	v.checkExamples(header.Schema, header.Example, header.Examples, location)
+	v.walkContent(header.Content, location)
+}
+
+func (v *specValidator) walkResponse(response *v3high.Response, location string) {
+	if low := response.GoLow(); low == nil || !v.visit(low.RootNode) {
+		return
+	}
+	for name, header := range orderedMapItems(response.Headers) {
+		v.walkHeader(header, location+"/headers/"+escapeJSONPointer(name))
+	}
+	v.walkContent(response.Content, location)
+}
+
+// walkContent checks the examples of the JSON media types only, the others being usually serialized as strings.
+func (v *specValidator) walkContent(content *orderedmap.Map[string, *v3high.MediaType], location string) {
+	for contentType, mediaType := range orderedMapItems(content) {
+		mediaLocation := location + "/content/" + escapeJSONPointer(contentType)
+		v.walkSchema(mediaType.Schema, mediaLocation+"/schema")
+		if isMediaTypeJson(contentType) {
+			v.checkExamples(mediaType.Schema, mediaType.Example, mediaType.Examples, mediaLocation)
+		}
+	}
+}
+
+// visit marks the node visited, and reports whether it wasn't already, so that shared definitions are walked once.
+func (v *specValidator) visit(node *yaml.Node) bool {
+	if node == nil || v.visited[node] {
+		return false
+	}
+	v.visited[node] = true
+	return true
+}
+
+// walkSchema reports the unknown keywords and invalid examples of the schema and of its subschemas.
+func (v *specValidator) walkSchema(proxy *base.SchemaProxy, location string) {
+	if proxy != nil && proxy.IsReference() && strings.HasPrefix(proxy.GetReference(), "#/components/schemas/") {
+		// walked at its definition
+		return
+	}
+	schema := proxySchema(proxy)
+	if schema == nil || !v.visit(schemaNode(schema)) {
+		return
+	}
+	node := schemaNode(schema)
+
+	if node.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := node.Content[i]
+			if !schemaKeywords[key.Value] && !strings.HasPrefix(key.Value, "x-") {
+				v.unknownKeywords = append(v.unknownKeywords, specProblem{
+					location: location,
+					line:     key.Line,
+					message:  fmt.Sprintf("%q is not a schema keyword", key.Value),
+				})
+			}
+		}
+	}
+
+	if schema.Example != nil {
+		v.checkExample(schema, schema.Example, location+"/example")
+	}
+	for i, example := range schema.Examples {
+		v.checkExample(schema, example, fmt.Sprintf("%s/examples/%d", location, i))
+	}
+
+	for name, property := range orderedMapItems(schema.Properties) {
+		v.walkSchema(property, location+"/properties/"+escapeJSONPointer(name))
+	}
+	for name, property := range orderedMapItems(schema.PatternProperties) {
+		v.walkSchema(property, location+"/patternProperties/"+escapeJSONPointer(name))
+	}
+	if schema.Items != nil && schema.Items.IsA() {
+		v.walkSchema(schema.Items.A, location+"/items")
+	}
+	if schema.AdditionalProperties != nil && schema.AdditionalProperties.IsA() {
+		v.walkSchema(schema.AdditionalProperties.A, location+"/additionalProperties")
+	}
+	if schema.UnevaluatedProperties != nil && schema.UnevaluatedProperties.IsA() {
+		v.walkSchema(schema.UnevaluatedProperties.A, location+"/unevaluatedProperties")
+	}
+	for name, dependent := range orderedMapItems(schema.DependentSchemas) {
+		v.walkSchema(dependent, location+"/dependentSchemas/"+escapeJSONPointer(name))
+	}
+	for _, keyword := range []keyValue[string, []*base.SchemaProxy]{
+		{"allOf", schema.AllOf}, {"anyOf", schema.AnyOf}, {"oneOf", schema.OneOf}, {"prefixItems", schema.PrefixItems},
+	} {
+		for i, sub := range keyword.value {
+			v.walkSchema(sub, fmt.Sprintf("%s/%s/%d", location, keyword.key, i))
+		}
+	}
+	for _, keyword := range []keyValue[string, *base.SchemaProxy]{
+		{"not", schema.Not}, {"if", schema.If}, {"then", schema.Then}, {"else", schema.Else},
+		{"contains", schema.Contains}, {"propertyNames", schema.PropertyNames},
+		{"unevaluatedItems", schema.UnevaluatedItems}, {"contentSchema", schema.ContentSchema},
+	} {
+		v.walkSchema(keyword.value, location+"/"+keyword.key)
+	}
+}
+
+// checkExamples checks the example and the examples of a parameter, header or media type against its schema.
+func (v *specValidator) checkExamples(proxy *base.SchemaProxy, example *yaml.Node, examples *orderedmap.Map[string, *base.Example], location string) {
+	schema := proxySchema(proxy)
+	if schema == nil {
+		return
+	}
+	if example != nil {
+		v.checkExample(schema, example, location+"/example")
+	}
+	for name, ex := range orderedMapItems(examples) {
+		if ex != nil && ex.Value != nil {
+			v.checkExample(schema, ex.Value, location+"/examples/"+escapeJSONPointer(name)+"/value")
+		}
+	}
+}
+
+func (v *specValidator) checkExample(schema *base.Schema, example *yaml.Node, location string) {
+	if problem := matchExample(schema, example, "", 0); problem != "" {
+		v.invalidExamples = append(v.invalidExamples, specProblem{location: location, line: example.Line, message: problem})
+	}
+}
+
+// maxExampleDepth bounds the checks of the examples of recursive schemas.
+const maxExampleDepth = 32
+
+// matchExample returns why the value doesn't match the schema, at its path in the example, or an empty string.
+// It checks the types, enums, required properties, properties and items, the other constraints being left to
+// the Validate methods of the generated types. The value must match one anyOf schema at least, and exactly one
+// oneOf schema, unless a discriminator picks it.
+func matchExample(schema *base.Schema, value *yaml.Node, path string, depth int) string {
+	if schema == nil || value == nil || depth > maxExampleDepth {
+		return ""
+	}
+	for value.Kind == yaml.DocumentNode && len(value.Content) == 1 {
+		value = value.Content[0]
+	}
+	if value.Kind == yaml.AliasNode {
+		value = value.Alias
+	}
+	at := func(format string, args ...any) string {
+		if path == "" {
+			return fmt.Sprintf(format, args...)
+		}
+		return path + ": " + fmt.Sprintf(format, args...)
+	}
+
+	if value.Kind == yaml.ScalarNode && value.Tag == "!!null" {
+		if len(schema.Type) == 0 || slices.Contains(schema.Type, "null") || (schema.Nullable != nil && *schema.Nullable) {
+			return ""
+		}
+		return at("null is not allowed, expected %s", strings.Join(schema.Type, " or "))
+	}
+
+	if len(schema.Type) > 0 && !slices.ContainsFunc(schema.Type, func(typ string) bool { return exampleHasType(value, typ) }) {
+		return at("%s is not %s", describeExample(value), strings.Join(schema.Type, " or "))
+	}
+
+	if len(schema.Enum) > 0 && !slices.ContainsFunc(schema.Enum, func(enum *yaml.Node) bool { return sameExampleValue(enum, value) }) {
+		return at("%s is not one of the enum values", describeExample(value))
+	}
+
+	for _, sub := range schema.AllOf {
+		if problem := matchExample(proxySchema(sub), value, path, depth+1); problem != "" {
+			return problem
+		}
+	}
+	for _, alternatives := range []keyValue[string, []*base.SchemaProxy]{{"anyOf", schema.AnyOf}, {"oneOf", schema.OneOf}} {
+		if len(alternatives.value) == 0 {
+			continue
+		}
+		var first string
+		matches := 0
+		for i, sub := range alternatives.value {
+			problem := matchExample(proxySchema(sub), value, path, depth+1)
+			if problem == "" {
+				matches++
+			} else if i == 0 {
+				first = problem
+			}
+		}
+		switch {
+		case matches == 0:
+			return first
+		case matches > 1 && alternatives.key == "oneOf" && schema.Discriminator == nil:
+			return at("%s matches %d oneOf schemas, expected exactly one", describeExample(value), matches)
+		}
+	}
+
+	switch value.Kind {
+	case yaml.MappingNode:
+		present := map[string]*yaml.Node{}
+		for i := 0; i+1 < len(value.Content); i += 2 {
+			present[value.Content[i].Value] = value.Content[i+1]
+		}
+		for _, name := range schema.Required {
+			if _, ok := present[name]; !ok {
+				return at("missing required property %q", name)
+			}
+		}
+		for name, property := range orderedMapItems(schema.Properties) {
+			if field, ok := present[name]; ok {
+				if problem := matchExample(proxySchema(property), field, joinExamplePath(path, name), depth+1); problem != "" {
+					return problem
+				}
+			}
+		}
+	case yaml.SequenceNode:
+		if schema.Items != nil && schema.Items.IsA() {
+			items := proxySchema(schema.Items.A)
+			for i, item := range value.Content {
+				if problem := matchExample(items, item, fmt.Sprintf("%s[%d]", path, i), depth+1); problem != "" {
+					return problem
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// exampleHasType reports whether the YAML value is of the JSON Schema type, dates being strings in JSON.
+func exampleHasType(value *yaml.Node, typ string) bool {
+	switch typ {
+	case "object":
+		return value.Kind == yaml.MappingNode
+	case "array":
+		return value.Kind == yaml.SequenceNode
+	case "string":
+		return value.Kind == yaml.ScalarNode && (value.Tag == "!!str" || value.Tag == "!!timestamp" || value.Tag == "!!binary")
+	case "boolean":
+		return value.Kind == yaml.ScalarNode && value.Tag == "!!bool"
+	case "integer":
+		if value.Kind != yaml.ScalarNode {
+			return false
+		}
+		if value.Tag == "!!int" {
+			return true
+		}
+		f, err := strconv.ParseFloat(value.Value, 64)
+		return value.Tag == "!!float" && err == nil && f == math.Trunc(f)
+	case "number":
+		return value.Kind == yaml.ScalarNode && (value.Tag == "!!int" || value.Tag == "!!float")
+	case "null":
+		return value.Kind == yaml.ScalarNode && value.Tag == "!!null"
+	}
+	return true
+}
+
+func sameExampleValue(a, b *yaml.Node) bool {
+	var av, bv any
+	if a.Decode(&av) != nil || b.Decode(&bv) != nil {
+		return false
+	}
+	return reflect.DeepEqual(av, bv)
+}
+
+func describeExample(value *yaml.Node) string {
+	switch value.Kind {
+	case yaml.MappingNode:
+		return "object"
+	case yaml.SequenceNode:
+		return "array"
+	}
+	if value.Tag == "!!str" {
+		return strconv.Quote(value.Value)
+	}
+	return value.Value
+}
+
+func joinExamplePath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func proxySchema(proxy *base.SchemaProxy) *base.Schema {
+	if proxy == nil {
+		return nil
+	}
+	return proxy.Schema()
+}
+
+func schemaNode(schema *base.Schema) *yaml.Node {
+	low := schema.GoLow()
+	if low == nil {
+		return nil
+	}
+	return low.RootNode
+}
+
+// orderedMapItems ranges over a possibly nil ordered map in the order of the spec.
+func orderedMapItems[K comparable, V any](m *orderedmap.Map[K, V]) func(yield func(K, V) bool) {
+	return func(yield func(K, V) bool) {
+		if m == nil {
+			return
+		}
+		for k, v := range m.FromOldest() {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
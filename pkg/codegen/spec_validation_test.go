@@ -0,0 +1,129 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.yaml.in/yaml/v4"
+)
+
+func TestCreateDocument_specValidation(t *testing.T) {
+	spec := []byte(readTestdata(t, "spec-validation.yml"))
+
+	t.Run("ignored by default", func(t *testing.T) {
+		_, err := CreateDocument(spec, Configuration{})
+		require.NoError(t, err)
+	})
+
+	t.Run("warnings", func(t *testing.T) {
+		cfg := Configuration{SpecValidation: SpecValidationOptions{InvalidExamples: SeverityWarn, UnknownKeywords: SeverityWarn}}
+		_, err := CreateDocument(spec, cfg)
+		require.NoError(t, err)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		cfg := Configuration{SpecValidation: SpecValidationOptions{InvalidExamples: SeverityError, UnknownKeywords: SeverityError}}
+		_, err := CreateDocument(spec, cfg)
+		require.ErrorIs(t, err, ErrInvalidSpec)
+
+		assert.Contains(t, err.Error(), `invalid example at #/components/schemas/Pet/example (line 60): missing required property "id"`)
+		assert.Contains(t, err.Error(), `invalid example at #/paths/~1pets/get/parameters/0/example (line 15): "ten" is not integer`)
+		assert.Contains(t, err.Error(), `invalid example at #/paths/~1pets/get/responses/200/content/application~1json/examples/wrong-status/value (line 33): [0].status: "lost" is not one of the enum values`)
+		assert.NotContains(t, err.Error(), "examples/valid")
+		assert.Contains(t, err.Error(), `unknown keyword at #/components/schemas/Pet (line 62): "requried" is not a schema keyword`)
+		assert.Contains(t, err.Error(), `unknown keyword at #/paths/~1pets/get/parameters/0/schema (line 14): "maximun" is not a schema keyword`)
+		assert.NotContains(t, err.Error(), "x-go-name")
+		assert.Contains(t, err.Error(), `unknown keyword at #/components/schemas/Shipping/if (line 69): "requried" is not a schema keyword`)
+		assert.Contains(t, err.Error(), `unknown keyword at #/components/schemas/Shipping/dependentSchemas/zip/properties/zip (line 77): "pattren" is not a schema keyword`)
+		assert.Contains(t, err.Error(), `invalid example at #/components/responses/RateLimited/headers/X-Rate~1Limit/example (line 85): "many" is not integer`)
+		assert.NotContains(t, err.Error(), "responses/429/headers")
+		assert.NotContains(t, err.Error(), "properties/shipping")
+	})
+
+	t.Run("errors of a single class", func(t *testing.T) {
+		cfg := Configuration{SpecValidation: SpecValidationOptions{InvalidExamples: SeverityWarn, UnknownKeywords: SeverityError}}
+		_, err := CreateDocument(spec, cfg)
+		require.ErrorIs(t, err, ErrInvalidSpec)
+		assert.NotContains(t, err.Error(), "invalid example")
+	})
+
+	t.Run("filtered out operations", func(t *testing.T) {
+		cfg := Configuration{
+			SpecValidation: SpecValidationOptions{InvalidExamples: SeverityError, UnknownKeywords: SeverityError},
+			Filter:         FilterConfig{Exclude: FilterParamsConfig{Paths: []string{"/pets"}}},
+		}
+		_, err := CreateDocument(spec, cfg)
+		require.NoError(t, err)
+	})
+}
+
+func TestMatchExample(t *testing.T) {
+	tests := []struct {
+		name    string
+		schema  string
+		example string
+		problem string
+	}{
+		{name: "string", schema: "type: string", example: "rex"},
+		{name: "date", schema: "{type: string, format: date}", example: "2025-01-02"},
+		{name: "string number", schema: "type: string", example: "1", problem: "1 is not string"},
+		{name: "integral float", schema: "type: integer", example: "2.0"},
+		{name: "float", schema: "type: integer", example: "2.5", problem: "2.5 is not integer"},
+		{name: "null", schema: "type: string", example: "null", problem: "null is not allowed, expected string"},
+		{name: "nullable", schema: "{type: string, nullable: true}", example: "null"},
+		{name: "null type", schema: "{type: [string, 'null']}", example: "null"},
+		{name: "enum", schema: "{type: integer, enum: [1, 2]}", example: "3", problem: "3 is not one of the enum values"},
+		{
+			name:    "nested property",
+			schema:  "{type: object, properties: {tags: {type: array, items: {type: string}}}}",
+			example: "{tags: [a, 2]}",
+			problem: "tags[1]: 2 is not string",
+		},
+		{name: "any of", schema: "anyOf: [{type: string}, {type: integer}]", example: "1"},
+		{name: "one of", schema: "oneOf: [{type: string}, {type: integer}]", example: "true", problem: "true is not string"},
+		{name: "any of several", schema: "anyOf: [{type: number}, {type: integer}]", example: "1"},
+		{
+			name:    "one of several",
+			schema:  "oneOf: [{type: number}, {type: integer}]",
+			example: "1",
+			problem: "1 matches 2 oneOf schemas, expected exactly one",
+		},
+		{
+			name:    "one of discriminated",
+			schema:  "{oneOf: [{type: object}, {type: object}], discriminator: {propertyName: kind}}",
+			example: "{kind: cat}",
+		},
+		{
+			name:    "all of",
+			schema:  "allOf: [{type: object, required: [id]}, {type: object, required: [name]}]",
+			example: "{id: 1}",
+			problem: `missing required property "name"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			spec := "openapi: 3.1.0\ninfo: {title: t, version: '1'}\npaths: {}\ncomponents:\n  schemas:\n    S:\n      " + tc.schema + "\n"
+			doc, err := LoadDocumentFromContents([]byte(spec))
+			require.NoError(t, err)
+			model, err := doc.BuildV3Model()
+			require.NoError(t, err)
+			schema := model.Model.Components.Schemas.GetOrZero("S").Schema()
+
+			var example yaml.Node
+			require.NoError(t, yaml.Unmarshal([]byte(tc.example), &example))
+			assert.Equal(t, tc.problem, matchExample(schema, &example, "", 0))
+		})
+	}
+}
@@ -0,0 +1,352 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"maps"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// The files of the output split by concern.
+const (
+	TypesFile      = "types.gen.go"
+	ClientFile     = "client.gen.go"
+	ServerFile     = "server.gen.go"
+	ValidationFile = "validation.gen.go"
+)
+
+var (
+	// clientFiles are the generated files of the client concern.
+	clientFiles = []string{"client", "client_options"}
+
+	// sharedFiles declare the unexported helpers used by both the types and the client,
+	// copied to the client package.
+	sharedFiles = []string{"json", "strings"}
+)
+
+// concernFile is a Go file of the output split by concern, assembled from the declarations of generated files.
+type concernFile struct {
+	imports []string
+	decls   []string
+}
+
+func (c *concernFile) add(other concernFile) {
+	c.imports = append(c.imports, other.imports...)
+	c.decls = append(c.decls, other.decls...)
+}
+
+// source returns the code of the file, in the given package, formatted and importing only the packages it uses.
+func (c *concernFile) source(header, pkg string) (string, error) {
+	var b strings.Builder
+	b.WriteString("// " + header + "\n\npackage " + pkg + "\n\n")
+	if len(c.imports) > 0 {
+		b.WriteString("import (\n" + strings.Join(slices.Compact(slices.Sorted(slices.Values(c.imports))), "\n") + "\n)\n\n")
+	}
+	b.WriteString(strings.Join(c.decls, "\n\n"))
+	return FormatCode(b.String())
+}
+
+// splitByConcern replaces the generated Go files, named without extension, with types.gen.go, client.gen.go,
+// server.gen.go and validation.gen.go. The Validate methods of the types are moved to validation.gen.go.
+// With a client package, client.gen.go is generated in its directory and qualifies the names of the types.
+func splitByConcern(files map[string]string, cfg Configuration) error {
+	clientPkg := cfg.Output.ClientPackage
+	if clientPkg != "" && cfg.Output.ImportPath == "" {
+		return fmt.Errorf("client-package %q requires the import-path of the generated package", clientPkg)
+	}
+
+	header := generatedHeader(cfg)
+
+	var types, client, server, validation, shared concernFile
+	for _, name := range sortedMapKeys(files) {
+		if path.Ext(name) != "" {
+			continue
+		}
+		file, err := splitGoFile(name, files[name])
+		if err != nil {
+			return err
+		}
+		delete(files, name)
+
+		switch {
+		case slices.Contains(clientFiles, name):
+			// the request options are validated in the client package
+			client.add(file.concernFile)
+			client.decls = append(client.decls, file.validateMethods...)
+		case name == "server":
+			// the bound requests are validated next to their binders
+			server.add(file.concernFile)
+			server.decls = append(server.decls, file.validateMethods...)
+		case slices.Contains(sharedFiles, name):
+			shared.add(file.concernFile)
+		case name == "common":
+			validation.add(file.concernFile)
+		default:
+			types.add(file.concernFile)
+			validation.decls = append(validation.decls, file.validateMethods...)
+		}
+	}
+
+	clientName := ClientFile
+	if clientPkg == "" {
+		types.add(shared)
+	} else {
+		if len(client.decls) > 0 {
+			typesSrc, err := types.source(header, cfg.PackageName)
+			if err != nil {
+				return err
+			}
+			if client, err = qualifyClient(client, shared, typesSrc, cfg.PackageName, cfg.Output.ImportPath); err != nil {
+				return err
+			}
+		}
+		types.add(shared)
+		clientName = path.Join(clientPkg, ClientFile)
+	}
+
+	for _, f := range []struct {
+		name string
+		pkg  string
+		file concernFile
+	}{
+		{TypesFile, cfg.PackageName, types},
+		{clientName, cmp.Or(clientPkg, cfg.PackageName), client},
+		{ServerFile, cfg.PackageName, server},
+		{ValidationFile, cfg.PackageName, validation},
+	} {
+		if len(f.file.decls) == 0 {
+			continue
+		}
+		src, err := f.file.source(header, f.pkg)
+		if err != nil {
+			return fmt.Errorf("error generating %s: %w", f.name, err)
+		}
+		files[f.name] = src
+	}
+	return nil
+}
+
+// operationsByTag groups the operations by the package of their first tag, the untagged ones under "".
+func operationsByTag(operations []OperationDefinition, pkg string) (map[string][]OperationDefinition, error) {
+	res := make(map[string][]OperationDefinition)
+	for _, op := range operations {
+		var tagPkg string
+		if len(op.Tags) > 0 {
+			tagPkg = tagPackageName(op.Tags[0])
+			if !token.IsIdentifier(tagPkg) || tagPkg == pkg {
+				return nil, fmt.Errorf("tag %q of operation %s is not a valid package name", op.Tags[0], op.ID)
+			}
+		}
+		res[tagPkg] = append(res[tagPkg], op)
+	}
+	return res, nil
+}
+
+// tagPackageName returns the package name of a tag, its lowercase letters and digits, e.g. "Order Items" -> orderitems.
+func tagPackageName(tag string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, strings.ToLower(tag))
+}
+
+// splitByTag replaces the client files generated per tag, named after the package of the tag, e.g. "payments/client",
+// with the client.gen.go of that package, qualifying the names of the types of the generated package.
+func splitByTag(files map[string]string, cfg Configuration) error {
+	if cfg.Output.ImportPath == "" {
+		return fmt.Errorf("split-by-tag requires the import-path of the generated package")
+	}
+
+	var types, shared concernFile
+	clients := make(map[string]concernFile)
+	for _, name := range sortedMapKeys(files) {
+		if path.Ext(name) != "" {
+			continue
+		}
+		file, err := splitGoFile(name, files[name])
+		if err != nil {
+			return err
+		}
+		file.decls = append(file.decls, file.validateMethods...)
+
+		if pkg := path.Dir(name); pkg != "." {
+			client := clients[pkg]
+			client.add(file.concernFile)
+			clients[pkg] = client
+			delete(files, name)
+			continue
+		}
+		if slices.Contains(sharedFiles, name) {
+			shared.add(file.concernFile)
+		}
+		types.add(file.concernFile)
+	}
+	if len(clients) == 0 {
+		return nil
+	}
+
+	header := generatedHeader(cfg)
+	typesSrc, err := types.source(header, cfg.PackageName)
+	if err != nil {
+		return err
+	}
+	for _, pkg := range slices.Sorted(maps.Keys(clients)) {
+		client, err := qualifyClient(clients[pkg], shared, typesSrc, cfg.PackageName, cfg.Output.ImportPath)
+		if err != nil {
+			return fmt.Errorf("error generating package %s: %w", pkg, err)
+		}
+		name := path.Join(pkg, ClientFile)
+		src, err := client.source(header, pkg)
+		if err != nil {
+			return fmt.Errorf("error generating %s: %w", name, err)
+		}
+		files[name] = src
+	}
+	return nil
+}
+
+// generatedHeader returns the comment starting the generated files.
+func generatedHeader(cfg Configuration) string {
+	return cmp.Or(cfg.CopyrightHeader, "Code generated by oapi-codegen. DO NOT EDIT.")
+}
+
+// splitFile is a generated Go file, with its Validate methods apart.
+type splitFile struct {
+	concernFile
+	validateMethods []string
+}
+
+// splitGoFile returns the imports and declarations of a generated Go file, keeping their comments.
+func splitGoFile(name, src string) (splitFile, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, name+".go", src, parser.ParseComments)
+	if err != nil {
+		return splitFile{}, fmt.Errorf("error parsing %s: %w", name, err)
+	}
+
+	var res splitFile
+	for _, imp := range f.Imports {
+		spec := imp.Path.Value
+		if imp.Name != nil {
+			spec = imp.Name.Name + " " + spec
+		}
+		res.imports = append(res.imports, spec)
+	}
+	for _, decl := range f.Decls {
+		if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.IMPORT {
+			continue
+		}
+		var b bytes.Buffer
+		if err := printer.Fprint(&b, fset, &printer.CommentedNode{Node: decl, Comments: f.Comments}); err != nil {
+			return splitFile{}, fmt.Errorf("error printing %s: %w", name, err)
+		}
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv != nil && isValidateMethod(fn.Name.Name) {
+			res.validateMethods = append(res.validateMethods, b.String())
+			continue
+		}
+		res.decls = append(res.decls, b.String())
+	}
+	return res, nil
+}
+
+// isValidateMethod returns whether a method of a generated type validates it.
+func isValidateMethod(name string) bool {
+	return name == "Validate" || strings.HasPrefix(name, "validate")
+}
+
+// qualifyClient returns the client declarations referring to the types of the generated package,
+// imported from importPath, with their package name, as the client is generated in its own package.
+// The helpers shared by the types and the client are copied to the client package.
+func qualifyClient(client, shared concernFile, typesSrc, pkg, importPath string) (concernFile, error) {
+	fset := token.NewFileSet()
+	typesFile, err := parser.ParseFile(fset, TypesFile, typesSrc, 0)
+	if err != nil {
+		return concernFile{}, err
+	}
+	typeNames := make(map[string]bool)
+	for name := range typesFile.Scope.Objects {
+		typeNames[name] = true
+	}
+
+	// the types can't refer back to the client, which imports them
+	clientOnly, err := parser.ParseFile(fset, ClientFile, "package client\n\n"+strings.Join(client.decls, "\n\n"), 0)
+	if err != nil {
+		return concernFile{}, fmt.Errorf("error parsing the client: %w", err)
+	}
+	for _, ident := range typesFile.Unresolved {
+		if clientOnly.Scope.Lookup(ident.Name) != nil {
+			return concernFile{}, fmt.Errorf("the types refer to %s of the client, which can't be generated in its own package", ident.Name)
+		}
+	}
+
+	merged := client
+	merged.add(shared)
+	f, err := parser.ParseFile(fset, ClientFile, "package client\n\n"+strings.Join(merged.decls, "\n\n"), parser.ParseComments)
+	if err != nil {
+		return concernFile{}, fmt.Errorf("error parsing the client: %w", err)
+	}
+
+	for _, decl := range f.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv != nil {
+			if name := receiverTypeName(fn.Recv.List[0].Type); typeNames[name] && f.Scope.Lookup(name) == nil {
+				return concernFile{}, fmt.Errorf("the client declares method %s of %s, which must be in the package of the type", fn.Name.Name, name)
+			}
+		}
+	}
+	for _, ident := range f.Unresolved {
+		if !typeNames[ident.Name] {
+			continue
+		}
+		if !ast.IsExported(ident.Name) {
+			return concernFile{}, fmt.Errorf("the client refers to %s, which is unexported in package %s", ident.Name, pkg)
+		}
+		ident.Name = pkg + "." + ident.Name
+	}
+
+	imp := strconv.Quote(importPath)
+	if path.Base(importPath) != pkg {
+		imp = pkg + " " + imp
+	}
+	res := concernFile{imports: append(merged.imports, imp)}
+	for _, decl := range f.Decls {
+		var b bytes.Buffer
+		if err := printer.Fprint(&b, fset, &printer.CommentedNode{Node: decl, Comments: f.Comments}); err != nil {
+			return concernFile{}, err
+		}
+		res.decls = append(res.decls, b.String())
+	}
+	return res, nil
+}
+
+// receiverTypeName returns the name of the type of a method receiver.
+func receiverTypeName(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return receiverTypeName(t.X)
+	case *ast.IndexExpr:
+		return receiverTypeName(t.X)
+	case *ast.Ident:
+		return t.Name
+	}
+	return ""
+}
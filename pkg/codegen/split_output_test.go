@@ -0,0 +1,164 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"go/format"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitByConcern(t *testing.T) {
+	spec := []byte(readTestdata(t, "train-travel-api.yml"))
+	cfg := Configuration{
+		PackageName: "api",
+		Generate:    &GenerateOptions{Client: true},
+		Output:      &Output{SplitByConcern: true},
+	}
+
+	t.Run("same package", func(t *testing.T) {
+		codes, err := Generate(spec, cfg)
+		require.NoError(t, err)
+		assert.Equal(t, []string{ClientFile, TypesFile, ValidationFile}, slices.Sorted(maps.Keys(codes)))
+
+		types := codes[TypesFile]
+		assert.Contains(t, types, "package api")
+		assert.Contains(t, types, "type Booking struct {")
+		assert.NotContains(t, types, ") Validate() error")
+		assert.NotContains(t, types, "validator")
+
+		validation := codes[ValidationFile]
+		assert.Contains(t, validation, "var typesValidator *validator.Validate")
+		assert.Contains(t, validation, "func (b Booking) Validate() error {")
+		assert.NotContains(t, validation, `"time"`)
+
+		client := codes[ClientFile]
+		assert.Contains(t, client, "package api")
+		assert.Contains(t, client, "func (c *Client) GetStations(")
+		assert.Contains(t, client, "func (o *GetStationsRequestOptions) Validate() error {")
+		assert.NotContains(t, client, "type Booking struct {")
+		assert.Contains(t, client, "(*GetStationsResponse, error)")
+
+		for name, code := range codes {
+			_, err := format.Source([]byte(code))
+			assert.NoError(t, err, name)
+		}
+	})
+
+	t.Run("client package", func(t *testing.T) {
+		cfg := cfg
+		cfg.Output = &Output{SplitByConcern: true, ClientPackage: "apiclient", ImportPath: "github.com/acme/trains/api"}
+
+		codes, err := Generate(spec, cfg)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"apiclient/" + ClientFile, TypesFile, ValidationFile}, slices.Sorted(maps.Keys(codes)))
+
+		client := codes["apiclient/"+ClientFile]
+		assert.Contains(t, client, "package apiclient")
+		assert.Contains(t, client, `"github.com/acme/trains/api"`)
+		assert.Contains(t, client, "(*api.GetStationsResponse, error)")
+		assert.Contains(t, client, "PathParams *api.GetBookingPath")
+		assert.Contains(t, client, "type GetStationsRequestOptions struct {")
+		assert.Contains(t, client, "func (o *GetStationsRequestOptions) Validate() error {")
+		assert.NotContains(t, client, "api.GetStationsRequestOptions")
+
+		_, err = format.Source([]byte(client))
+		require.NoError(t, err)
+	})
+
+	t.Run("client package without import path", func(t *testing.T) {
+		cfg := cfg
+		cfg.Output = &Output{SplitByConcern: true, ClientPackage: "apiclient"}
+
+		_, err := Generate(spec, cfg)
+		assert.ErrorContains(t, err, `client-package "apiclient" requires the import-path of the generated package`)
+	})
+}
+
+func TestSplitByTag(t *testing.T) {
+	spec := []byte(readTestdata(t, "train-travel-api.yml"))
+	cfg := Configuration{
+		PackageName: "api",
+		Generate:    &GenerateOptions{Client: true},
+		Output:      &Output{SplitByTag: true, ImportPath: "github.com/acme/trains/api"},
+	}
+
+	t.Run("package per tag", func(t *testing.T) {
+		codes, err := Generate(spec, cfg)
+		require.NoError(t, err)
+
+		keys := slices.Sorted(maps.Keys(codes))
+		assert.Subset(t, keys, []string{
+			"bookings/" + ClientFile, "payments/" + ClientFile, "stations/" + ClientFile, "trips/" + ClientFile,
+			"types", "common",
+		})
+		assert.NotContains(t, keys, "client")
+		assert.NotContains(t, keys, "client_options")
+
+		stations := codes["stations/"+ClientFile]
+		assert.Contains(t, stations, "package stations")
+		assert.Contains(t, stations, `"github.com/acme/trains/api"`)
+		assert.Contains(t, stations, "func (c *Client) GetStations(")
+		assert.Contains(t, stations, "(*api.GetStationsResponse, error)")
+		assert.Contains(t, stations, "func (o *GetStationsRequestOptions) Validate() error {")
+		assert.NotContains(t, stations, "GetTrips")
+
+		bookings := codes["bookings/"+ClientFile]
+		assert.Contains(t, bookings, "package bookings")
+		assert.Contains(t, bookings, "func (c *Client) GetBookings(")
+		assert.Contains(t, bookings, "func (c *Client) DeleteBooking(")
+		assert.NotContains(t, bookings, "CreateBookingPayment")
+
+		for name, code := range codes {
+			_, err := format.Source([]byte(code))
+			assert.NoError(t, err, name)
+		}
+	})
+
+	t.Run("with split by concern", func(t *testing.T) {
+		cfg := cfg
+		cfg.Output = &Output{SplitByTag: true, SplitByConcern: true, ImportPath: "github.com/acme/trains/api"}
+
+		codes, err := Generate(spec, cfg)
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"bookings/" + ClientFile, "payments/" + ClientFile, "stations/" + ClientFile, "trips/" + ClientFile,
+			TypesFile, ValidationFile,
+		}, slices.Sorted(maps.Keys(codes)))
+	})
+
+	t.Run("without import path", func(t *testing.T) {
+		cfg := cfg
+		cfg.Output = &Output{SplitByTag: true}
+
+		_, err := Generate(spec, cfg)
+		assert.ErrorContains(t, err, "split-by-tag requires the import-path of the generated package")
+	})
+
+	t.Run("single file", func(t *testing.T) {
+		cfg := cfg
+		cfg.Output = &Output{SplitByTag: true, UseSingleFile: true, ImportPath: "github.com/acme/trains/api"}
+
+		_, err := Generate(spec, cfg)
+		assert.ErrorContains(t, err, "split-by-tag requires use-single-file: false")
+	})
+}
+
+func TestTagPackageName(t *testing.T) {
+	assert.Equal(t, "payments", tagPackageName("Payments"))
+	assert.Equal(t, "orderitems", tagPackageName("Order Items"))
+	assert.Equal(t, "identityv2", tagPackageName("identity-v2"))
+	assert.Equal(t, "", tagPackageName("🚀"))
+}
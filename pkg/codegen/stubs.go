@@ -0,0 +1,161 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/ast/astutil"
+	"golang.org/x/tools/imports"
+)
+
+// stubFile is the file of a hand-written type the stubs of its missing methods are added to.
+type stubFile struct {
+	// name is the file name, desc names the file in errors.
+	name, desc string
+	// src is the contents of the file, empty if it doesn't exist yet, pkgName the package of a new file.
+	src, pkgName string
+	typeName     string
+	// typeDoc documents the type declared as an empty struct when the package is missing it.
+	// The type must be declared when it's empty.
+	typeDoc string
+	// others maps the names of the other files of the package to their contents,
+	// searched for the type and its methods too.
+	others map[string]string
+	// importPaths maps the names of the packages the stubs may use to the paths the file imports them from.
+	importPaths map[string]string
+}
+
+// stubReceiver is the receiver of the stubs, following the existing methods of the type.
+type stubReceiver struct {
+	name, typ string
+}
+
+// stubMethod is a method added to a hand-written type.
+type stubMethod struct {
+	name, doc string
+	// params is the signature after the method name, body the statements of the method.
+	params, body string
+	// packages are the names of the packages the method uses.
+	packages []string
+}
+
+// addStubs returns the contents of the file with stubs appended for the methods the type is missing.
+// The methods are given by stubs, from the parsed file and the receiver of the stubs.
+// The existing code is kept as is, and the contents are returned unchanged when no method is missing.
+func addStubs(f stubFile, stubs func(file *ast.File, recv stubReceiver) ([]stubMethod, error)) (string, error) {
+	src := f.src
+	if src == "" {
+		src = "package " + f.pkgName + "\n"
+	}
+	file, err := parser.ParseFile(token.NewFileSet(), f.name, src, parser.SkipObjectResolution)
+	if err != nil {
+		return "", fmt.Errorf("error parsing %s: %w", f.desc, err)
+	}
+	files := []*ast.File{file}
+	for name, contents := range f.others {
+		other, err := parser.ParseFile(token.NewFileSet(), name, contents, parser.SkipObjectResolution)
+		if err != nil {
+			return "", fmt.Errorf("error parsing %s: %w", name, err)
+		}
+		files = append(files, other)
+	}
+
+	var (
+		declared    bool
+		implemented = make(map[string]bool)
+		recv        = stubReceiver{name: strings.ToLower(f.typeName[:1]), typ: "*" + f.typeName}
+	)
+	for i, file := range files {
+		for _, decl := range file.Decls {
+			switch d := decl.(type) {
+			case *ast.FuncDecl:
+				if d.Recv == nil || len(d.Recv.List) == 0 || receiverName(d.Recv.List[0].Type) != f.typeName {
+					continue
+				}
+				implemented[d.Name.Name] = true
+				// follow the receiver of the existing methods of the file
+				if r := d.Recv.List[0]; i == 0 && len(r.Names) > 0 && r.Names[0].Name != "_" {
+					recv = stubReceiver{name: r.Names[0].Name, typ: types.ExprString(r.Type)}
+				}
+			case *ast.GenDecl:
+				for _, spec := range d.Specs {
+					if ts, ok := spec.(*ast.TypeSpec); ok && ts.Name.Name == f.typeName {
+						declared = true
+					}
+				}
+			}
+		}
+	}
+	if !declared && f.typeDoc == "" {
+		return "", fmt.Errorf("type %s not found in %s", f.typeName, f.desc)
+	}
+
+	methods, err := stubs(file, recv)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	b.WriteString(src)
+	if !declared {
+		fmt.Fprintf(&b, "\n// %s\ntype %s struct{}\n", f.typeDoc, f.typeName)
+	}
+	usedPkgs := make(map[string]bool)
+	missing := 0
+	for _, m := range methods {
+		if implemented[m.name] {
+			continue
+		}
+		missing++
+		for _, pkg := range m.packages {
+			usedPkgs[pkg] = true
+		}
+		fmt.Fprintf(&b, "\n// %s\nfunc (%s %s) %s%s {\n\t%s\n}\n", m.doc, recv.name, recv.typ, m.name, m.params, m.body)
+	}
+	if declared && missing == 0 {
+		return f.src, nil
+	}
+
+	fset := token.NewFileSet()
+	if file, err = parser.ParseFile(fset, f.name, b.String(), parser.ParseComments); err != nil {
+		return "", fmt.Errorf("error parsing the stubs of %s: %w", f.desc, err)
+	}
+	for pkg := range usedPkgs {
+		path, ok := f.importPaths[pkg]
+		if !ok {
+			continue
+		}
+		if path[strings.LastIndex(path, "/")+1:] == pkg {
+			astutil.AddImport(fset, file, path)
+		} else {
+			astutil.AddNamedImport(fset, file, pkg, path)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err = format.Node(&buf, fset, file); err != nil {
+		return "", fmt.Errorf("error formatting %s: %w", f.desc, err)
+	}
+	// group the standard library imports apart
+	res, err := imports.Process(f.name, buf.Bytes(), &imports.Options{FormatOnly: true, Comments: true, TabIndent: true, TabWidth: 8})
+	if err != nil {
+		return "", fmt.Errorf("error formatting %s: %w", f.desc, err)
+	}
+	return string(res), nil
+}
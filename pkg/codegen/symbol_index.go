@@ -0,0 +1,194 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"slices"
+)
+
+// SymbolIndex maps the components and operations of the spec to the Go symbols generated for them,
+// for IDE plugins and tools navigating from the spec to the generated code.
+type SymbolIndex struct {
+	// Components maps the refs of the generated components, e.g. #/components/schemas/Pet, to their types.
+	Components map[string]Symbol `json:"components"`
+
+	// Operations maps the operationIds, synthesized for the operations without one, to their symbols.
+	Operations map[string]OperationSymbols `json:"operations"`
+}
+
+// Symbol is a generated Go symbol, namespaced by the name of its package. Methods are named <Receiver>.<Method>.
+// File is relative to the directory of the index.
+type Symbol struct {
+	Package string `json:"package"`
+	Name    string `json:"name"`
+	File    string `json:"file"`
+}
+
+// OperationSymbols are the client methods, request options, parameter, body and response types of an operation.
+type OperationSymbols struct {
+	Method  string   `json:"method"`
+	Path    string   `json:"path"`
+	Symbols []Symbol `json:"symbols"`
+}
+
+// operationMethodSuffixes are the suffixes of the client methods generated for an operation,
+// after the ID of the operation.
+var operationMethodSuffixes = []string{"", "Events", "Lines", "Resume", "IsEnabled", "RequestHash", "Result", "Pages"}
+
+// NewSymbolIndex builds the symbol index of the code generated from ctx, locating the symbols in its Go files.
+// singleFile is the name of the file of the single file output, whose code is named "all".
+func NewSymbolIndex(ctx *ParseContext, code map[string]string, singleFile string) (SymbolIndex, error) {
+	symbols, err := declaredSymbols(code, singleFile)
+	if err != nil {
+		return SymbolIndex{}, err
+	}
+
+	res := SymbolIndex{
+		Components: make(map[string]Symbol),
+		Operations: make(map[string]OperationSymbols, len(ctx.Operations)),
+	}
+	if ctx.TypeTracker != nil {
+		for ref, name := range ctx.TypeTracker.Refs() {
+			// pruned and filtered components are registered, but not generated
+			if found := symbols.types[name]; len(found) > 0 {
+				res.Components[ref] = found[0]
+			}
+		}
+	}
+
+	for _, op := range ctx.Operations {
+		var found []Symbol
+		for _, name := range operationTypeNames(op) {
+			found = append(found, symbols.types[name]...)
+		}
+		for _, suffix := range operationMethodSuffixes {
+			found = append(found, symbols.methods[op.ID+suffix]...)
+		}
+		slices.SortFunc(found, func(a, b Symbol) int {
+			return cmp.Or(cmp.Compare(a.Package, b.Package), cmp.Compare(a.Name, b.Name))
+		})
+		if found == nil {
+			found = []Symbol{}
+		}
+
+		res.Operations[op.SpecID] = OperationSymbols{
+			Method:  op.Method,
+			Path:    op.Path,
+			Symbols: slices.Compact(found),
+		}
+	}
+	return res, nil
+}
+
+// operationTypeNames returns the names of the types and variables generated for op, some of which may not be.
+func operationTypeNames(op OperationDefinition) []string {
+	names := []string{
+		UppercaseFirstCharacter(op.ID) + "RequestOptions",
+		op.FeatureFlagName,
+		op.LogSampleRateName,
+		op.SensitiveParametersName,
+		op.Response.UnionName,
+	}
+	if op.PathParams != nil {
+		names = append(names, op.PathParams.Name)
+	}
+	if op.Header != nil {
+		names = append(names, op.Header.Name)
+	}
+	if op.Query != nil {
+		names = append(names, op.Query.TypeDef.Name)
+	}
+	if op.Body != nil {
+		names = append(names, op.Body.Name)
+	}
+	for _, resp := range op.Response.Cases() {
+		names = append(names, resp.ResponseName)
+	}
+	return slices.Compact(slices.Sorted(slices.Values(names)))
+}
+
+// generatedSymbols are the top-level declarations of the generated Go files.
+type generatedSymbols struct {
+	// types maps the names of the types, functions, constants and variables to their symbols.
+	types map[string][]Symbol
+
+	// methods maps the names of the methods to their symbols, named <Receiver>.<Method>.
+	methods map[string][]Symbol
+}
+
+// declaredSymbols parses the Go files of code, named without extension, and returns their exported declarations.
+func declaredSymbols(code map[string]string, singleFile string) (generatedSymbols, error) {
+	res := generatedSymbols{
+		types:   make(map[string][]Symbol),
+		methods: make(map[string][]Symbol),
+	}
+	for _, name := range sortedMapKeys(code) {
+		if filepath.Ext(name) != "" {
+			continue
+		}
+		file := name + ".go"
+		if name == "all" && singleFile != "" {
+			file = singleFile
+		}
+
+		fset := token.NewFileSet()
+		f, err := parser.ParseFile(fset, file, code[name], parser.SkipObjectResolution)
+		if err != nil {
+			return generatedSymbols{}, fmt.Errorf("error parsing %s: %w", file, err)
+		}
+		add := func(m map[string][]Symbol, key, name string) {
+			if ast.IsExported(key) {
+				m[key] = append(m[key], Symbol{Package: f.Name.Name, Name: name, File: filepath.ToSlash(file)})
+			}
+		}
+
+		for _, decl := range f.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if decl.Recv == nil {
+					add(res.types, decl.Name.Name, decl.Name.Name)
+					continue
+				}
+				if recv := receiverName(decl.Recv.List[0].Type); recv != "" {
+					add(res.methods, decl.Name.Name, recv+"."+decl.Name.Name)
+				}
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					switch spec := spec.(type) {
+					case *ast.TypeSpec:
+						add(res.types, spec.Name.Name, spec.Name.Name)
+					case *ast.ValueSpec:
+						for _, ident := range spec.Names {
+							add(res.types, ident.Name, ident.Name)
+						}
+					}
+				}
+			}
+		}
+	}
+	return res, nil
+}
+
+// JSON returns the indented JSON encoding of the index.
+func (s SymbolIndex) JSON() (string, error) {
+	out, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(out) + "\n", nil
+}
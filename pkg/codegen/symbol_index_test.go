@@ -0,0 +1,102 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSymbolIndex(t *testing.T) {
+	spec := []byte(readTestdata(t, "symbol-index.yml"))
+
+	t.Run("single file", func(t *testing.T) {
+		cfg := Configuration{
+			PackageName: "api",
+			Generate:    &GenerateOptions{Client: true},
+			Output:      &Output{UseSingleFile: true, Filename: "api.gen.go", SymbolIndex: "index.gen.json"},
+		}
+		codes, err := Generate(spec, cfg)
+		require.NoError(t, err)
+
+		assert.JSONEq(t, `{
+			"components": {
+				"#/components/schemas/Pet": {"package": "api", "name": "Pet", "file": "api.gen.go"},
+				"#/components/schemas/PetKind": {"package": "api", "name": "PetKind", "file": "api.gen.go"}
+			},
+			"operations": {
+				"createPet": {
+					"method": "POST",
+					"path": "/pets",
+					"symbols": [
+						{"package": "api", "name": "Client.CreatePet", "file": "api.gen.go"},
+						{"package": "api", "name": "CreatePetBody", "file": "api.gen.go"},
+						{"package": "api", "name": "CreatePetRequestOptions", "file": "api.gen.go"},
+						{"package": "api", "name": "CreatePetResponse", "file": "api.gen.go"}
+					]
+				},
+				"getPet": {
+					"method": "GET",
+					"path": "/pets/{id}",
+					"symbols": [
+						{"package": "api", "name": "Client.GetPet", "file": "api.gen.go"},
+						{"package": "api", "name": "GetPetPath", "file": "api.gen.go"},
+						{"package": "api", "name": "GetPetQuery", "file": "api.gen.go"},
+						{"package": "api", "name": "GetPetRequestOptions", "file": "api.gen.go"},
+						{"package": "api", "name": "GetPetResponse", "file": "api.gen.go"}
+					]
+				}
+			}
+		}`, codes["index.gen.json"])
+	})
+
+	t.Run("multiple files", func(t *testing.T) {
+		cfg := Configuration{
+			PackageName: "api",
+			Generate:    &GenerateOptions{Client: true},
+			Output:      &Output{SymbolIndex: "index.gen.json"},
+		}
+		codes, err := Generate(spec, cfg)
+		require.NoError(t, err)
+
+		index := codes["index.gen.json"]
+		assert.Contains(t, index, `"name": "PetKind",
+      "file": "enums.go"`)
+		assert.Contains(t, index, `"name": "Client.GetPet",
+          "file": "client.go"`)
+		assert.Contains(t, index, `"name": "GetPetQuery",
+          "file": "queries.go"`)
+	})
+
+	t.Run("filtered operations", func(t *testing.T) {
+		cfg := Configuration{
+			PackageName: "api",
+			Output:      &Output{UseSingleFile: true, SymbolIndex: "index.gen.json"},
+			Filter:      FilterConfig{Include: FilterParamsConfig{OperationIDs: []string{"getPet"}}},
+		}
+		codes, err := Generate(spec, cfg)
+		require.NoError(t, err)
+
+		assert.Contains(t, codes["index.gen.json"], `"getPet"`)
+		assert.NotContains(t, codes["index.gen.json"], `"createPet"`)
+	})
+
+	t.Run("file name without extension", func(t *testing.T) {
+		cfg := Configuration{
+			PackageName: "api",
+			Output:      &Output{UseSingleFile: true, SymbolIndex: "index"},
+		}
+		_, err := Generate(spec, cfg)
+		assert.EqualError(t, err, `symbol index file name "index" must have an extension`)
+	})
+}
@@ -147,6 +147,11 @@ func (r *TypeTracker) AsMap() map[string]*TypeDefinition {
 	return r.byName
 }
 
+// Refs returns the internal map of OpenAPI reference paths to Go type names.
+func (r *TypeTracker) Refs() map[string]string {
+	return r.byRef
+}
+
 // Size returns the number of registered types.
 func (r *TypeTracker) Size() int {
 	return len(r.byName)
@@ -21,6 +21,7 @@ const (
 	SpecLocationPath     SpecLocation = "path"
 	SpecLocationQuery    SpecLocation = "query"
 	SpecLocationHeader   SpecLocation = "header"
+	SpecLocationCookie   SpecLocation = "cookie"
 	SpecLocationBody     SpecLocation = "body"
 	SpecLocationResponse SpecLocation = "response"
 	SpecLocationSchema   SpecLocation = "schema"
@@ -35,6 +36,9 @@ const (
 // SpecLocation indicates where in the OpenAPI spec this type was defined.
 // NeedsMarshaler indicates whether this type needs a custom marshaler/unmarshaler.
 // HasSensitiveData indicates whether this type has any properties marked as sensitive.
+// ReadWriteOnly lists the properties omitted when marshaling this type as a request or response body.
+// MergePatchOf is the type patched by this merge patch body, if it is a named type.
+// KnownFields are the JSON fields of a union variant rejecting unknown ones, see setKnownFields.
 type TypeDefinition struct {
 	Name             string
 	JsonName         string
@@ -42,6 +46,9 @@ type TypeDefinition struct {
 	SpecLocation     SpecLocation
 	NeedsMarshaler   bool
 	HasSensitiveData bool
+	ReadWriteOnly    *ReadWriteOnlyViews
+	MergePatchOf     string
+	KnownFields      []string
 }
 
 func (t TypeDefinition) IsAlias() bool {
@@ -11,6 +11,7 @@
 package codegen
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -50,15 +51,18 @@ func preRegisterSchemaNames(schemas *orderedmap.Map[string, *base.SchemaProxy],
 
 // generateSchemaDefinitions generates full type definitions for schemas using
 // pre-registered names. This is the second pass after preRegisterSchemaNames.
+// Schemas failing to generate are skipped and reported together.
 func generateSchemaDefinitions(schemas *orderedmap.Map[string, *base.SchemaProxy], schemaNames map[string]string, options ParseOptions) ([]TypeDefinition, error) {
 	types := make([]TypeDefinition, 0)
+	var errs []error
 
 	for schemaName, schemaRef := range schemas.FromOldest() {
 		ref := schemaRef.GoLow().GetReference()
 		opts := options.WithReference(ref).WithPath([]string{schemaName})
 		goSchema, err := GenerateGoSchema(schemaRef, opts)
 		if err != nil {
-			return nil, fmt.Errorf("error converting GoSchema %s to Go type: %w", schemaName, err)
+			errs = append(errs, newSpecError(fmt.Errorf("error converting GoSchema %s to Go type: %w", schemaName, err), "components", "schemas", schemaName))
+			continue
 		}
 		if goSchema.IsZero() {
 			continue
@@ -82,7 +86,7 @@ func generateSchemaDefinitions(schemas *orderedmap.Map[string, *base.SchemaProxy
 		types = append(types, goSchema.AdditionalTypes...)
 	}
 
-	return types, nil
+	return types, errors.Join(errs...)
 }
 
 // getComponentParameters generates type definitions for any custom types defined in the
@@ -116,8 +120,10 @@ func getComponentParameters(params *orderedmap.Map[string, *v3high.Parameter], o
 		// For inline schemas (no $ref), we need to create a type alias
 		// For referenced schemas, we use the referenced type name
 		if ref == "" {
-			// Inline schema - create a type alias
-			goType.DefineViaAlias = true
+			// Inline schema - create a type alias, except for arrays of enums,
+			// defined so that their Validate method validates the items
+			isEnumArray := goType.ArrayType != nil && len(goType.ArrayType.EnumValues) > 0
+			goType.DefineViaAlias = !isEnumArray
 		}
 
 		// If the parameter's schema references a component schema, use that schema's type name
@@ -150,6 +156,8 @@ func getComponentParameters(params *orderedmap.Map[string, *v3high.Parameter], o
 		options.typeTracker.register(typeDef, paramRef)
 
 		types = append(types, typeDef)
+		// the types of the inline schemas, e.g. the enums of array items
+		types = append(types, goType.AdditionalTypes...)
 	}
 	return types, nil
 }
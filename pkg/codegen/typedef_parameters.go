@@ -12,6 +12,7 @@ package codegen
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 	"unicode"
 
@@ -27,6 +28,23 @@ type RequestParametersDefinition struct {
 	TypeDef  TypeDefinition
 }
 
+// EnumArrays returns the properties of the parameters that are arrays of enums,
+// whose items are validated before the parameters are encoded.
+func (r RequestParametersDefinition) EnumArrays() []Property {
+	var res []Property
+	for _, param := range r.Params {
+		if !param.IsEnumArray() {
+			continue
+		}
+		for _, p := range r.TypeDef.Schema.Properties {
+			if p.JsonFieldName == param.ParamName && !p.IsPointerType() && !p.NullableWrapper {
+				res = append(res, p)
+			}
+		}
+	}
+	return res
+}
+
 // ParameterEncoding describes the encoding options for a request body.
 // @see https://spec.openapis.org/oas/v3.1.0#style-examples
 type ParameterEncoding struct {
@@ -34,6 +52,7 @@ type ParameterEncoding struct {
 	Explode       *bool
 	Required      bool
 	AllowReserved bool
+	TimeFormat    string
 }
 
 // ParameterDefinition is a struct that represents a parameter in an operation.
@@ -42,12 +61,14 @@ type ParameterEncoding struct {
 // Required is whether the parameter is required
 // Spec is the parsed openapi3.Parameter object
 // GoSchema is the GoSchema object
+// TimeFormat is the format of date-time query values, set with x-go-time-format
 type ParameterDefinition struct {
-	ParamName string
-	In        string
-	Required  bool
-	Spec      *v3high.Parameter
-	Schema    GoSchema
+	ParamName  string
+	In         string
+	Required   bool
+	Spec       *v3high.Parameter
+	Schema     GoSchema
+	TimeFormat string
 }
 
 // TypeDef is here as an adapter after a large refactoring so that I don't
@@ -58,6 +79,41 @@ func (pd ParameterDefinition) TypeDef() string {
 	return typeDecl
 }
 
+// IsEnumArray tells whether the schema of the parameter is an array of enums, inline or referenced.
+func (pd ParameterDefinition) IsEnumArray() bool {
+	if pd.Spec == nil || pd.Spec.Schema == nil {
+		return false
+	}
+	schema := pd.Spec.Schema.Schema()
+	if schema == nil || schema.Items == nil || !schema.Items.IsA() {
+		return false
+	}
+	items := schema.Items.A.Schema()
+	return items != nil && len(items.Enum) > 0
+}
+
+// binding returns the fields of the runtime.ParameterBinding reading the parameter on the server,
+// empty for the defaults.
+func (pd ParameterDefinition) binding() string {
+	var fields []string
+	if pd.Spec.Style != "" {
+		fields = append(fields, fmt.Sprintf("Style: %q", pd.Spec.Style))
+	}
+	if pd.Spec.Explode != nil {
+		fields = append(fields, fmt.Sprintf("Explode: runtime.Ptr(%t)", *pd.Spec.Explode))
+	}
+	if pd.Required {
+		fields = append(fields, "Required: true")
+	}
+	if pd.TimeFormat != "" {
+		fields = append(fields, fmt.Sprintf("TimeFormat: %q", pd.TimeFormat))
+	}
+	if pd.Spec.Content != nil && pd.IsJson() {
+		fields = append(fields, "JSON: true")
+	}
+	return strings.Join(fields, ", ")
+}
+
 func (pd ParameterDefinition) IsJson() bool {
 	p := pd.Spec
 	if p.Content.Len() == 1 {
@@ -171,12 +227,18 @@ func describeOperationParameters(params []*v3high.Parameter, options ParseOption
 			required = *param.Required
 		}
 
+		timeFormat, err := paramTimeFormat(param)
+		if err != nil {
+			return nil, fmt.Errorf("error in param (%s): %w", param.Name, err)
+		}
+
 		pd := ParameterDefinition{
-			ParamName: param.Name,
-			In:        param.In,
-			Required:  required,
-			Spec:      param,
-			Schema:    goSchema,
+			ParamName:  param.Name,
+			In:         param.In,
+			Required:   required,
+			Spec:       param,
+			Schema:     goSchema,
+			TimeFormat: timeFormat,
 		}
 
 		// If the parameter references a component parameter, use the registered type name
@@ -200,6 +262,29 @@ func describeOperationParameters(params []*v3high.Parameter, options ParseOption
 	return outParams, nil
 }
 
+// paramTimeFormat returns the x-go-time-format of a query parameter, set on the parameter or its schema.
+// It is unix, unixmilli, rfc3339 or a time.Format layout, and empty if not set.
+func paramTimeFormat(param *v3high.Parameter) (string, error) {
+	value, ok := extractExtensions(param.Extensions)[extGoTimeFormat]
+	if !ok && param.Schema != nil {
+		if schema := param.Schema.Schema(); schema != nil {
+			value, ok = extractExtensions(schema.Extensions)[extGoTimeFormat]
+		}
+	}
+	if !ok {
+		return "", nil
+	}
+	if param.In != "query" {
+		return "", fmt.Errorf("%s is only supported on query parameters", extGoTimeFormat)
+	}
+
+	format, err := parseString(value)
+	if err != nil {
+		return "", fmt.Errorf("invalid value for %q: %w", extGoTimeFormat, err)
+	}
+	return format, nil
+}
+
 // combineOperationParameters combines the Parameters defined at a global level (Parameters defined for all methods on a given path) with the Parameters defined at a local level (Parameters defined for a specific path), preferring the locally defined parameter over the global one
 func combineOperationParameters(globalParams []ParameterDefinition, localParams []ParameterDefinition) ([]ParameterDefinition, error) {
 	allParams := make([]ParameterDefinition, 0, len(globalParams)+len(localParams))
@@ -283,16 +368,21 @@ func generateParamsTypes(objectParams []ParameterDefinition, typeName string, op
 			goFieldNames[baseGoName] = 0
 		}
 
+		constraints := newConstraints(oapiSchema, ConstraintsContext{
+			required:     param.Required,
+			specLocation: specLocation,
+			formatTags:   options.FormatTags,
+			decimalType:  options.DecimalType,
+		})
+		constraints.ValidationTags = appendArrayParamValidationTags(constraints.ValidationTags, pSchema, oapiSchema, param.Required)
+
 		properties = append(properties, Property{
 			GoName:        goName,
 			Description:   param.Spec.Description,
 			JsonFieldName: param.ParamName,
 			Schema:        pSchema,
 			Extensions:    exts,
-			Constraints: newConstraints(oapiSchema, ConstraintsContext{
-				required:     param.Required,
-				specLocation: specLocation,
-			}),
+			Constraints:   constraints,
 		})
 		imports = append(imports, pSchema)
 		encodings[param.ParamName] = ParameterEncoding{
@@ -300,13 +390,15 @@ func generateParamsTypes(objectParams []ParameterDefinition, typeName string, op
 			Explode:       param.Spec.Explode,
 			Required:      param.Required,
 			AllowReserved: param.Spec.AllowReserved,
+			TimeFormat:    param.TimeFormat,
 		}
 	}
 
 	s := GoSchema{
-		Properties: properties,
+		Properties:     properties,
+		OmitValidation: options.omitValidation,
 	}
-	fields := genFieldsFromProperties(properties, options)
+	fields := genFieldsFromProperties(properties, options.WithSpecLocation(specLocation))
 	s.GoType = s.createGoStruct(fields)
 
 	td := TypeDefinition{
@@ -326,6 +418,39 @@ func generateParamsTypes(objectParams []ParameterDefinition, typeName string, op
 	return res, append(typeDefs, td), imports
 }
 
+// appendArrayParamValidationTags adds minItems, maxItems and uniqueItems checks for inline array parameters.
+// Array constraints are only enforced by the Validate() of a named array type, so for parameters,
+// which are fields of the generated parameters struct, they have to be expressed as validator tags.
+func appendArrayParamValidationTags(tags []string, pSchema GoSchema, oapiSchema *base.Schema, required bool) []string {
+	if oapiSchema == nil || pSchema.ArrayType == nil || !strings.HasPrefix(pSchema.TypeDecl(), "[]") {
+		return tags
+	}
+
+	var arrayTags []string
+	if oapiSchema.MinItems != nil {
+		arrayTags = append(arrayTags, fmt.Sprintf("min=%d", *oapiSchema.MinItems))
+	}
+	if oapiSchema.MaxItems != nil {
+		arrayTags = append(arrayTags, fmt.Sprintf("max=%d", *oapiSchema.MaxItems))
+	}
+	// unique compares items by value, so it's only safe for primitive item types
+	if deref(oapiSchema.UniqueItems) && isPrimitiveType(pSchema.ArrayType.TypeDecl()) {
+		arrayTags = append(arrayTags, "unique")
+	}
+	if len(arrayTags) == 0 {
+		return tags
+	}
+
+	// optional parameters must still accept an absent (nil) array
+	if !required && !slices.Contains(tags, "omitempty") {
+		tags = append(tags, "omitempty")
+	}
+	tags = append(tags, arrayTags...)
+	sortValidationTags(tags)
+
+	return tags
+}
+
 // This constructs a Go type for a parameter, looking at either the schema or
 // the content, whichever is available
 func paramToGoType(param *v3high.Parameter, options ParseOptions) (GoSchema, error) {
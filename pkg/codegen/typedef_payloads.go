@@ -12,6 +12,7 @@ package codegen
 
 import (
 	"fmt"
+	"mime"
 	"strings"
 
 	"github.com/pb33f/libopenapi/datamodel/high/base"
@@ -118,9 +119,31 @@ func createBodyDefinition(operationID string, body *v3high.RequestBody, options
 		optsForBody = opts.WithReference("")
 	}
 
-	bodySchema, err := GenerateGoSchema(schemaProxy, optsForBody)
-	if err != nil {
-		return nil, nil, fmt.Errorf("error generating request body definition: %w", err)
+	patch := patchBodyKind(contentType, schemaProxy.Schema(), options)
+
+	// A merge patch is a new struct of runtime.Nullable properties,
+	// computed from two values of the referenced type.
+	var mergePatchOf string
+	if patch == mergePatchBody {
+		if ref != "" {
+			resource, err := GenerateGoSchema(schemaProxy, opts)
+			if err != nil {
+				return nil, nil, fmt.Errorf("error generating request body definition: %w", err)
+			}
+			mergePatchOf = resource.TypeDecl()
+		}
+		optsForBody = opts.WithReference("").WithMergePatch(true)
+	}
+
+	var bodySchema GoSchema
+	if patch == jsonPatchBody {
+		bodySchema = GoSchema{GoType: "runtime.JSONPatch", DefineViaAlias: true}
+	} else {
+		var err error
+		bodySchema, err = GenerateGoSchema(schemaProxy, optsForBody)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error generating request body definition: %w", err)
+		}
 	}
 
 	td := TypeDefinition{
@@ -129,6 +152,7 @@ func createBodyDefinition(operationID string, body *v3high.RequestBody, options
 		SpecLocation:     SpecLocationBody,
 		NeedsMarshaler:   needsMarshaler(bodySchema),
 		HasSensitiveData: hasSensitiveData(bodySchema),
+		MergePatchOf:     mergePatchOf,
 	}
 	options.typeTracker.register(td, "")
 
@@ -165,6 +189,33 @@ func createBodyDefinition(operationID string, body *v3high.RequestBody, options
 	return bd, &td, nil
 }
 
+type patchBody int
+
+const (
+	noPatchBody patchBody = iota
+	mergePatchBody
+	jsonPatchBody
+)
+
+// patchBodyKind returns how a request body of the content type is generated if PatchBodies is set.
+// Merge patches need an object with properties to declare them as runtime.Nullable.
+func patchBodyKind(contentType string, schema *base.Schema, options ParseOptions) patchBody {
+	if !options.PatchBodies {
+		return noPatchBody
+	}
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch mediaType {
+	case "application/json-patch+json":
+		return jsonPatchBody
+	case "application/merge-patch+json":
+		if schema != nil && schema.Properties != nil && schema.Properties.Len() > 0 &&
+			len(schema.AllOf) == 0 && len(schema.AnyOf) == 0 && len(schema.OneOf) == 0 {
+			return mergePatchBody
+		}
+	}
+	return noPatchBody
+}
+
 // filterReadOnlyFromRequired removes readOnly properties from the required list
 // in request body schemas. ReadOnly properties should only be required in responses,
 // not in requests. Returns true if any readOnly required fields were found and filtered.
@@ -0,0 +1,61 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPatchBodies(t *testing.T) {
+	spec := []byte(readTestdata(t, "patch-bodies.yml"))
+
+	t.Run("disabled", func(t *testing.T) {
+		codes, err := Generate(spec, Configuration{PackageName: "api"})
+		require.NoError(t, err)
+
+		code := codes.GetCombined()
+		assert.Contains(t, code, "type UpdatePetBody = Pet")
+		assert.NotContains(t, code, "runtime.JSONPatch")
+		assert.NotContains(t, code, "runtime.Nullable")
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		codes, err := Generate(spec, Configuration{
+			PackageName: "api",
+			Generate:    &GenerateOptions{PatchBodies: true},
+			Output:      &Output{UseSingleFile: true},
+		})
+		require.NoError(t, err)
+
+		code := codes.GetCombined()
+		// readOnly properties are left out, required ones become optional
+		assert.Contains(t, code, `type UpdatePetBody struct {
+	Name    runtime.Nullable[string]   `+"`json:\"name,omitzero\"`"+`
+	Tag     runtime.Nullable[string]   `+"`json:\"tag,omitzero\"`"+`
+	Address runtime.Nullable[Address]  `+"`json:\"address,omitzero\"`"+`
+	Tags    runtime.Nullable[[]string] `+"`json:\"tags,omitzero\"`"+`
+}`)
+		assert.Contains(t, code, `func NewUpdatePetBody(from, to Pet) (*UpdatePetBody, error) {
+	return runtime.NewMergePatch[UpdatePetBody](from, to)
+}`)
+		assert.Contains(t, code, "type PatchPetBody = runtime.JSONPatch")
+
+		// nested objects keep their declared types
+		assert.Contains(t, code, "City   string  `json:\"city\" validate:\"required\"`")
+
+		// inline schemas have no named type to compute the patch from
+		assert.Contains(t, code, "Name runtime.Nullable[string] `json:\"name,omitzero\"`")
+		assert.NotContains(t, code, "func NewUpdateOwnerBody")
+	})
+}
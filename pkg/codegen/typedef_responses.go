@@ -13,18 +13,204 @@ package codegen
 import (
 	"fmt"
 	"iter"
+	"maps"
+	"net/http"
+	"slices"
 	"strconv"
 	"strings"
 
 	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
 )
 
 // ResponseDefinition describes a response.
+// Default is the default response, also set as Success for operations without a success response,
+// in which case SuccessStatusCode is 200 and any 2xx status code is a success, or as Error for operations without
+// an error response.
+// UnionName is the name of the sealed result interface, set only when response unions are generated.
 type ResponseDefinition struct {
 	SuccessStatusCode int
 	Success           *ResponseContentDefinition
 	Error             *ResponseContentDefinition
+	Default           *ResponseContentDefinition
 	All               map[int]*ResponseContentDefinition
+	UnionName         string
+}
+
+// Cases returns all responses ordered by status code, the default response last.
+func (r ResponseDefinition) Cases() []*ResponseContentDefinition {
+	codes := slices.Sorted(maps.Keys(r.All))
+	res := make([]*ResponseContentDefinition, 0, len(codes)+1)
+	for _, code := range codes {
+		res = append(res, r.All[code])
+	}
+	if r.Default != nil {
+		res = append(res, r.Default)
+	}
+	return res
+}
+
+// UnexpectedStatusCondition returns the Go condition matching the status codes that are not a success.
+func (r ResponseDefinition) UnexpectedStatusCondition(variable string) string {
+	if r.Success != nil && r.Success.StatusPattern == "DEFAULT" {
+		return fmt.Sprintf("%s < 200 || %s >= 300", variable, variable)
+	}
+	return fmt.Sprintf("%s != %d", variable, r.SuccessStatusCode)
+}
+
+// UnionCases returns the responses of the result interface: single status codes first, then ranges and default,
+// in the order they are matched.
+func (r ResponseDefinition) UnionCases() []*ResponseContentDefinition {
+	return slices.SortedStableFunc(slices.Values(r.Cases()), func(a, b *ResponseContentDefinition) int {
+		return statusPatternRank(a.StatusPattern) - statusPatternRank(b.StatusPattern)
+	})
+}
+
+// JSONCases returns the union cases with a JSON body that is read in full, the responses servers can validate.
+func (r ResponseDefinition) JSONCases() []*ResponseContentDefinition {
+	var res []*ResponseContentDefinition
+	for _, c := range r.UnionCases() {
+		if c.HasBody() && !c.IsStream && isMediaTypeJson(c.ContentType) {
+			res = append(res, c)
+		}
+	}
+	return res
+}
+
+func statusPatternRank(pattern string) int {
+	switch pattern {
+	case "":
+		return 0
+	case "DEFAULT":
+		return 2
+	default:
+		return 1
+	}
+}
+
+// CaseName returns the suffix naming the response in the result interface, e.g. 404, 4XX or Default.
+func (r ResponseContentDefinition) CaseName() string {
+	switch r.StatusPattern {
+	case "":
+		return strconv.Itoa(r.StatusCode)
+	case "DEFAULT":
+		return "Default"
+	default:
+		return r.StatusPattern
+	}
+}
+
+// StatusCondition returns the Go condition matching a status code to the response, true for the default response.
+func (r ResponseContentDefinition) StatusCondition(variable string) string {
+	switch r.StatusPattern {
+	case "":
+		return fmt.Sprintf("%s == %d", variable, r.StatusCode)
+	case "DEFAULT":
+		return "true"
+	default:
+		low := int(r.StatusPattern[0]-'0') * 100
+		return fmt.Sprintf("%s >= %d && %s < %d", variable, low, variable, low+100)
+	}
+}
+
+// HasBody returns true if the response has content to decode.
+func (r ResponseContentDefinition) HasBody() bool {
+	return r.ResponseName != "" && r.ResponseName != "struct{}"
+}
+
+// IsNoContent returns true if the response has no body to read: 204 No Content and 205 Reset Content
+// responses never have one, other responses when they declare no content.
+func (r ResponseContentDefinition) IsNoContent() bool {
+	return r.StatusCode == http.StatusNoContent || r.StatusCode == http.StatusResetContent || !r.HasBody()
+}
+
+// IsRedirect returns true if the response is a redirect with a Location, a 3xx status code other than 304 Not Modified.
+func (r ResponseContentDefinition) IsRedirect() bool {
+	return r.StatusPattern == "" && isRedirectStatus(r.StatusCode)
+}
+
+func isRedirectStatus(status int) bool {
+	return status >= 300 && status < 400 && status != http.StatusNotModified
+}
+
+// HasETag returns true if the response declares an ETag header.
+func (r ResponseContentDefinition) HasETag() bool {
+	for name := range r.Headers {
+		if strings.EqualFold(name, "ETag") {
+			return true
+		}
+	}
+	return false
+}
+
+// ResponseHeaderDefinition is a header declared by a response, read with a typed accessor
+// of the response in the result interface.
+type ResponseHeaderDefinition struct {
+	// MethodName is the name of the accessor, like XRateLimitRemaining.
+	MethodName string
+	// Name is the name of the header.
+	Name string
+	// GoType is the type the value is parsed into.
+	GoType string
+	// Validate is the validation tag the value is checked against, empty if none.
+	Validate string
+}
+
+// headerAccessorTypes are the types of the headers with a typed accessor.
+var headerAccessorTypes = map[string]bool{
+	"string": true, "bool": true, "float32": true, "float64": true, "time.Time": true, "runtime.Date": true,
+	"int": true, "int8": true, "int16": true, "int32": true, "int64": true,
+	"uint": true, "uint8": true, "uint16": true, "uint32": true, "uint64": true,
+}
+
+// reservedHeaderAccessors are the methods and fields of the responses in the result interface.
+var reservedHeaderAccessors = map[string]bool{
+	"Body": true, "Headers": true, "Status": true, "StatusCode": true, "Visit": true, "ETag": true, "Location": true,
+}
+
+// HeaderAccessors returns the headers of the response with a typed accessor, sorted by name.
+// Headers of other types, like arrays and objects, or whose accessor would clash with a method or field
+// of the response, are only read from Headers.
+func (r ResponseContentDefinition) HeaderAccessors() []ResponseHeaderDefinition {
+	var res []ResponseHeaderDefinition
+	methods := make(map[string]bool)
+	for _, name := range slices.Sorted(maps.Keys(r.Headers)) {
+		schema := r.Headers[name]
+		method := schemaNameToTypeName(name)
+		if schema.IsRef() || !headerAccessorTypes[schema.GoType] || reservedHeaderAccessors[method] || methods[method] {
+			continue
+		}
+		methods[method] = true
+
+		var tags []string
+		for _, tag := range schema.Constraints.ValidationTags {
+			if tag != "required" && tag != "omitempty" {
+				tags = append(tags, tag)
+			}
+		}
+		res = append(res, ResponseHeaderDefinition{
+			MethodName: method,
+			Name:       name,
+			GoType:     schema.GoType,
+			Validate:   strings.Join(tags, ","),
+		})
+	}
+	return res
+}
+
+// IsEventStream returns true if the response is a text/event-stream with a schema for the event data.
+func (r ResponseContentDefinition) IsEventStream() bool {
+	return r.IsStream && r.ContentType == "text/event-stream" && r.HasBody()
+}
+
+// IsJSONLines returns true if the response is a JSON Lines stream with a schema for every line.
+func (r ResponseContentDefinition) IsJSONLines() bool {
+	return r.IsStream && isJSONLinesContentType(r.ContentType) && r.HasBody()
+}
+
+// IsDownload returns true if the response is a streamed download that can be fetched in byte ranges.
+func (r ResponseContentDefinition) IsDownload() bool {
+	return r.IsStream && r.ContentType != "text/event-stream" && !isJSONLinesContentType(r.ContentType)
 }
 
 // ResponseContentDefinition describes Operation response.
@@ -35,6 +221,7 @@ type ResponseDefinition struct {
 // Description is the description of the response.
 // Ref is the reference to the response.
 // IsSuccess is true if the response is a success response.
+// IsStream is true if the success response body is returned to the caller unread, as io.ReadCloser.
 type ResponseContentDefinition struct {
 	Schema      GoSchema
 	ContentType string
@@ -46,6 +233,14 @@ type ResponseContentDefinition struct {
 	IsSuccess    bool
 	StatusCode   int
 	Headers      map[string]GoSchema
+	IsStream     bool
+
+	// StatusPattern is the status code range, like 4XX, or DEFAULT the response is declared with in the spec,
+	// empty for a single status code.
+	StatusPattern string
+
+	// links are the links of a success response, followed by the client, nil otherwise.
+	links *orderedmap.Map[string, *v3high.Link]
 }
 
 func getOperationResponses(operationID string, responses *v3high.Responses, options ParseOptions) (*ResponseDefinition, []TypeDefinition, error) {
@@ -109,14 +304,19 @@ func getOperationResponses(operationID string, responses *v3high.Responses, opti
 		}
 
 		status, err := strconv.Atoi(statusCode)
+		statusPattern := ""
 		if err != nil {
-			if statusCode == "default" || strings.ToLower(statusCode) == "2xx" {
+			switch strings.ToLower(statusCode) {
+			case "default", "2xx":
 				status = 200
-			} else if strings.ToLower(statusCode) == "4xx" || strings.ToLower(statusCode) == "5xx" {
+			case "4xx":
 				status = 400
-			} else {
+			case "5xx":
+				status = 500
+			default:
 				return nil, nil, fmt.Errorf("error parsing status code %s: %w", statusCode, err)
 			}
+			statusPattern = strings.ToUpper(statusCode)
 		}
 
 		if status >= 200 && status < 300 {
@@ -155,16 +355,23 @@ func getOperationResponses(operationID string, responses *v3high.Responses, opti
 			}
 		}
 
+		isStream := isSuccess && isStreamResponse(response, contentType, content)
+		// The links of success responses are followed with methods of their types
+		hasLinks := isSuccess && !isStream && options.ResponseLinks && response.Links != nil && response.Links.Len() > 0
+
 		if content == nil || content.Schema == nil {
-			if isSuccess {
-				successDefinition := &ResponseContentDefinition{
+			// Redirects without content are kept for the result interface, which returns them with their Location
+			if isSuccess || statusPattern == "" && isRedirectStatus(status) {
+				all[status] = &ResponseContentDefinition{
 					IsSuccess:    isSuccess,
 					Description:  response.Description,
 					ResponseName: "struct{}",
 					StatusCode:   status,
 					Headers:      headers,
+					IsStream:     isStream,
+
+					StatusPattern: statusPattern,
 				}
-				all[status] = successDefinition
 			}
 			continue
 		}
@@ -239,9 +446,9 @@ func getOperationResponses(operationID string, responses *v3high.Responses, opti
 				// and we need to generate an Error() method for error-mapped types.
 				hasErrorMapping := len(options.ErrorMapping) > 0 && options.ErrorMapping[aliasName] != ""
 
-				if hasErrorMapping {
-					// Error mapping is configured - generate a full struct instead of alias
-					// so we can attach the Error() method
+				if hasErrorMapping || hasLinks {
+					// Error mapping or links are configured - generate a full struct instead of alias
+					// so we can attach the Error() or link methods
 					responseName = aliasName
 					// Don't set componentTypeExists to false - we still want to use the component schema
 					// but we need to generate a new type definition with the full schema
@@ -324,12 +531,15 @@ func getOperationResponses(operationID string, responses *v3high.Responses, opti
 			// If so and the schema is an alias, we need to look up the original type
 			// and copy its schema to generate a full struct (aliases don't support methods).
 			hasErrorMapping := len(options.ErrorMapping) > 0 && options.ErrorMapping[responseName] != ""
-			if hasErrorMapping && contentSchema.DefineViaAlias {
+			if (hasErrorMapping || hasLinks) && contentSchema.DefineViaAlias {
 				// Look up the original type by name (GoType contains the type name)
 				if originalTd, exists := options.typeTracker.LookupByName(contentSchema.GoType); exists {
 					// Copy the original schema but clear DefineViaAlias
 					contentSchema = originalTd.Schema
 					contentSchema.DefineViaAlias = false
+				} else if hasLinks {
+					// Other aliases, like map[string]any, are defined as new types
+					contentSchema.DefineViaAlias = false
 				}
 			}
 
@@ -361,10 +571,40 @@ func getOperationResponses(operationID string, responses *v3high.Responses, opti
 			NameTag:      tag,
 			StatusCode:   status,
 			Headers:      headers,
+			IsStream:     isStream,
+
+			StatusPattern: statusPattern,
+		}
+		if hasLinks {
+			rcd.links = response.Links
 		}
 		all[status] = rcd
 	}
 
+	// The default response matches the status codes without a response of their own: it is the success response
+	// of operations declaring none, the error response of operations declaring no error,
+	// and a case of the result interface otherwise.
+	var defaultDefinition *ResponseContentDefinition
+	if defaultResponse != nil {
+		isSuccess := successCode == 0
+		typeSuffix := "DefaultResponse"
+		switch {
+		case isSuccess:
+			typeSuffix = "Response"
+		case errorCode == 0:
+			typeSuffix = "ErrorResponse"
+		}
+		def, defaultTypes, err := getDefaultResponse(operationID, typeSuffix, isSuccess, defaultResponse, options)
+		if err != nil {
+			return nil, nil, err
+		}
+		typeDefinitions = append(typeDefinitions, defaultTypes...)
+		defaultDefinition = def
+		if isSuccess {
+			successCode = http.StatusOK
+		}
+	}
+
 	if successCode == 0 {
 		successCode = 204
 		successDefinition := &ResponseContentDefinition{
@@ -377,93 +617,131 @@ func getOperationResponses(operationID string, responses *v3high.Responses, opti
 		all[successCode] = successDefinition
 	}
 
-	if errorCode == 0 && defaultResponse != nil {
-		errorCode = 500
-		fstErrorCode = 500
-		typeSuffix := "ErrorResponse"
-		content := defaultResponse.Content.First()
+	res := &ResponseDefinition{
+		SuccessStatusCode: successCode,
+		Success:           all[successCode],
+		Error:             all[fstErrorCode],
+		Default:           defaultDefinition,
+		All:               all,
+	}
+	switch {
+	case defaultDefinition != nil && defaultDefinition.IsSuccess:
+		res.Success = defaultDefinition
+	case defaultDefinition != nil && errorCode == 0:
+		res.Error = defaultDefinition
+	}
+	if res.Error != nil && !res.Error.HasBody() {
+		// Redirects and default responses without content are not decoded as an error
+		res.Error = nil
+	}
 
-		ref := ""
-		contentType := "application/json"
-		var (
-			contentSchema GoSchema
-			err           error
-			refType       string
-			contentVal    *v3high.MediaType
-		)
+	return res, typeDefinitions, nil
+}
 
-		if content != nil {
-			contentType, contentVal = content.Key(), content.Value()
-			if contentVal.Schema != nil {
-				ref = contentVal.Schema.GetReference()
+// getDefaultResponse returns the definition of the default response of an operation, matching the status codes
+// without a response of their own, with its type named with the suffix.
+func getDefaultResponse(operationID, typeSuffix string, isSuccess bool, response *v3high.Response, options ParseOptions) (*ResponseContentDefinition, []TypeDefinition, error) {
+	headers, err := generateResponseHeadersSchema(response.Headers.FromOldest(), operationID, options)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error generating response headers schema: %w", err)
+	}
 
-				opts := options.WithReference(ref).WithPath([]string{operationID, typeSuffix})
-				contentSchema, err = GenerateGoSchema(contentVal.Schema, opts)
-				if err != nil {
-					return nil, nil, fmt.Errorf("error generating request body definition: %w", err)
-				}
-			}
-		}
+	def := &ResponseContentDefinition{
+		ResponseName: "struct{}",
+		IsSuccess:    isSuccess,
+		Description:  response.Description,
+		Headers:      headers,
 
-		if ref != "" {
-			refType, err = refPathToGoType(ref)
-			if err != nil {
-				return nil, nil, fmt.Errorf("error turning reference (%s) into a Go type: %w", ref, err)
-			}
+		StatusPattern: "DEFAULT",
+	}
+
+	content := response.Content.First()
+	if content == nil || content.Value().Schema == nil {
+		return def, nil, nil
+	}
+
+	contentType, contentVal := content.Key(), content.Value()
+	ref := contentVal.Schema.GetReference()
+	opts := options.WithReference(ref).WithPath([]string{operationID, typeSuffix})
+	contentSchema, err := GenerateGoSchema(contentVal.Schema, opts)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error generating request body definition: %w", err)
+	}
+	if contentSchema.IsZero() {
+		return def, nil, nil
+	}
+
+	refType := ""
+	if ref != "" {
+		refType, err = refPathToGoType(ref)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error turning reference (%s) into a Go type: %w", ref, err)
 		}
+		contentSchema.RefType = refType
+	}
 
-		if !contentSchema.IsZero() {
-			if refType != "" {
-				contentSchema.RefType = refType
-			}
-			responseName := operationID + typeSuffix
-			if contentSchema.ArrayType != nil {
-				contentSchema, _ = replaceInlineTypes(contentSchema, options)
-			}
-			td := TypeDefinition{
-				Name:           responseName,
-				Schema:         contentSchema,
-				SpecLocation:   SpecLocationResponse,
-				NeedsMarshaler: needsMarshaler(contentSchema),
-			}
-			options.typeTracker.register(td, "")
-			typeDefinitions = append(typeDefinitions, td)
+	responseName := options.typeTracker.generateUniqueName(operationID + typeSuffix)
+	if contentSchema.ArrayType != nil {
+		contentSchema, _ = replaceInlineTypes(contentSchema, options)
+	}
+	td := TypeDefinition{
+		Name:           responseName,
+		Schema:         contentSchema,
+		SpecLocation:   SpecLocationResponse,
+		NeedsMarshaler: needsMarshaler(contentSchema),
+	}
+	options.typeTracker.register(td, "")
+	typeDefinitions := []TypeDefinition{td}
+
+	// Filter out AdditionalTypes that already exist in the type tracker
+	for _, additionalType := range contentSchema.AdditionalTypes {
+		if _, exists := options.typeTracker.LookupByName(additionalType.Name); !exists {
+			typeDefinitions = append(typeDefinitions, additionalType)
+			options.typeTracker.register(additionalType, "")
+		}
+	}
 
-			// Filter out AdditionalTypes that already exist in the type tracker
-			for _, additionalType := range contentSchema.AdditionalTypes {
-				if _, exists := options.typeTracker.LookupByName(additionalType.Name); !exists {
-					typeDefinitions = append(typeDefinitions, additionalType)
-					options.typeTracker.register(additionalType, "")
-				}
-			}
+	def.ResponseName = responseName
+	def.Schema = contentSchema
+	def.Ref = refType
+	def.ContentType = contentType
+	return def, typeDefinitions, nil
+}
 
-			errHeaders, err := generateResponseHeadersSchema(defaultResponse.Headers.FromOldest(), operationID, options)
-			if err != nil {
-				return nil, nil, fmt.Errorf("error generating response headers schema: %w", err)
-			}
+// isStreamResponse checks if the response body should be streamed instead of buffered.
+// Binary and event-stream content is always streamed, other content types can opt in with x-stream.
+func isStreamResponse(response *v3high.Response, contentType string, content *v3high.MediaType) bool {
+	switch contentType {
+	case "application/octet-stream", "text/event-stream":
+		return true
+	}
+	if isJSONLinesContentType(contentType) {
+		return true
+	}
 
-			errorDefinition := &ResponseContentDefinition{
-				ResponseName: responseName,
-				IsSuccess:    false,
-				Description:  defaultResponse.Description,
-				Schema:       contentSchema,
-				Ref:          refType,
-				ContentType:  contentType,
-				StatusCode:   errorCode,
-				Headers:      errHeaders,
+	if content != nil {
+		if v, ok := extractExtensions(content.Extensions)[extStream]; ok {
+			if stream, err := parseBooleanValue(v); err == nil {
+				return stream
 			}
-			all[errorCode] = errorDefinition
 		}
 	}
 
-	res := &ResponseDefinition{
-		SuccessStatusCode: successCode,
-		Success:           all[successCode],
-		Error:             all[fstErrorCode],
-		All:               all,
+	if v, ok := extractExtensions(response.Extensions)[extStream]; ok {
+		if stream, err := parseBooleanValue(v); err == nil {
+			return stream
+		}
 	}
+	return false
+}
 
-	return res, typeDefinitions, nil
+// isJSONLinesContentType returns true for the media types of newline-delimited JSON, one value per line.
+func isJSONLinesContentType(contentType string) bool {
+	switch contentType {
+	case "application/jsonl", "application/jsonlines", "application/x-jsonlines", "application/x-ndjson":
+		return true
+	}
+	return false
 }
 
 func generateResponseHeadersSchema(headers iter.Seq2[string, *v3high.Header], operationID string, options ParseOptions) (map[string]GoSchema, error) {
@@ -0,0 +1,51 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"go/format"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnionRequiredFields(t *testing.T) {
+	spec := []byte(readTestdata(t, "union-required-fields.yml"))
+	cfg := Configuration{
+		PackageName: "api",
+		Generate:    &GenerateOptions{Client: true, TypedUnions: true},
+	}
+
+	codes, err := Generate(spec, cfg)
+	require.NoError(t, err)
+	code := codes.GetCombined()
+
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+
+	t.Run("oneOf matched by required fields", func(t *testing.T) {
+		assert.Contains(t, code, "func (a *Account_OneOf) UnmarshalJSON(data []byte) error {")
+		assert.Contains(t, code, `runtime.UnionVariant{Name: "Person", Required: []string{"name"}, Properties: []string{"name", "age"}},`)
+		assert.Contains(t, code, `runtime.UnionVariant{Name: "Company", Required: []string{"registrationNumber", "name"}, Properties: []string{"registrationNumber", "name"}},`)
+		assert.Contains(t, code, `runtime.UnionVariant{Name: "Account_OneOf_2", Required: []string{"ticker"}, Properties: []string{"ticker"}},`)
+		assert.Contains(t, code, "return a.OneOf3.UnmarshalJSON(data)")
+	})
+
+	t.Run("no required fields", func(t *testing.T) {
+		assert.NotContains(t, code, "func (c *Contact_OneOf) UnmarshalJSON(")
+		assert.NotContains(t, code, "func (l *Labels_OneOf) UnmarshalJSON(")
+	})
+
+	t.Run("anyOf", func(t *testing.T) {
+		assert.NotContains(t, code, "func (p *Payload_AnyOf) UnmarshalJSON(")
+	})
+}
@@ -0,0 +1,75 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+)
+
+// KnownFieldsArgs returns the known fields of the type as Go string literals.
+func (t TypeDefinition) KnownFieldsArgs() string {
+	names := make([]string, len(t.KnownFields))
+	for i, name := range t.KnownFields {
+		names[i] = fmt.Sprintf("%q", name)
+	}
+	return strings.Join(names, ", ")
+}
+
+// setKnownFields sets the known fields of the struct types used as union variants whose schema,
+// merged with its allOf elements, doesn't allow additional properties. This keeps a union from picking
+// such a variant for an object with fields of another one. Decoding the types on their own ignores unknown fields.
+func setKnownFields(typeDefs []TypeDefinition) {
+	variants := make(map[string]bool)
+	for _, td := range typeDefs {
+		for _, element := range td.Schema.UnionElements {
+			variants[element.TypeName] = true
+		}
+	}
+
+	for i, td := range typeDefs {
+		schema := td.Schema.OpenAPISchema
+		if !isPlainStruct(td) || td.Schema.HasAdditionalProperties || schema == nil {
+			continue
+		}
+		if !variants[td.Name] || !disallowsAdditionalProperties(schema) {
+			continue
+		}
+		if fields, ok := namedFields(td.Schema.Properties); ok {
+			typeDefs[i].KnownFields = fields
+		}
+	}
+}
+
+// disallowsAdditionalProperties returns whether the schema, merged with its allOf elements,
+// sets additionalProperties to false.
+func disallowsAdditionalProperties(schema *base.Schema) bool {
+	if len(schema.AllOf) == 0 {
+		return isAdditionalPropertiesExplicitFalse(schema)
+	}
+	merged, err := mergeAllOf(schema.AllOf)
+	return err == nil && merged != nil && isAdditionalPropertiesExplicitFalse(merged)
+}
+
+// namedFields returns the JSON names of the properties,
+// or false if some are embedded, whose fields are not known here.
+func namedFields(properties []Property) ([]string, bool) {
+	fields := make([]string, 0, len(properties))
+	for _, p := range properties {
+		if p.JsonFieldName == "" {
+			return nil, false
+		}
+		fields = append(fields, p.JsonFieldName)
+	}
+	return fields, true
+}
@@ -0,0 +1,55 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"go/format"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKnownFields(t *testing.T) {
+	spec := []byte(readTestdata(t, "strict-decoding.yml"))
+	cfg := Configuration{
+		PackageName: "api",
+		Generate:    &GenerateOptions{Client: true},
+	}
+
+	codes, err := Generate(spec, cfg)
+	require.NoError(t, err)
+	code := codes.GetCombined()
+
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+
+	t.Run("union variant", func(t *testing.T) {
+		assert.Contains(t, code, "func (c Cat) KnownFields() []string {\n\treturn []string{\"name\", \"meows\"}\n}")
+		assert.NotContains(t, code, "func (c *Cat) UnmarshalJSON(")
+		assert.NotContains(t, code, "func (d Dog) KnownFields(")
+	})
+
+	t.Run("inline union variant", func(t *testing.T) {
+		assert.Contains(t, code, "func (s Shape_OneOf_0) KnownFields() []string {\n\treturn []string{\"radius\"}\n}")
+		assert.NotContains(t, code, "func (s Shape_OneOf_1) KnownFields(")
+	})
+
+	t.Run("allOf merge", func(t *testing.T) {
+		assert.Contains(t, code, "func (b Bird) KnownFields() []string {\n\treturn []string{\"name\", \"wings\"}\n}")
+		assert.NotContains(t, code, "func (b *Bird) UnmarshalJSON(")
+	})
+
+	t.Run("allOf merge outside unions", func(t *testing.T) {
+		assert.NotContains(t, code, "func (p Pet) KnownFields(")
+		assert.NotContains(t, code, "func (p *Pet) UnmarshalJSON(")
+	})
+}
@@ -0,0 +1,96 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"runtime/debug"
+	"strings"
+)
+
+const (
+	generatorModulePath = "github.com/doordash-oss/oapi-codegen-dd/v3"
+	generatorName       = "oapi-codegen-dd"
+	develVersion        = "devel"
+)
+
+// SpecInfo holds the metadata of the spec the code is generated from.
+// Checksum is the SHA-256 checksum of the spec document, e.g. "sha256:9f86d0...", and Size its size in bytes.
+type SpecInfo struct {
+	Title    string
+	Version  string
+	Checksum string
+	Size     int
+}
+
+// specChecksum returns the SHA-256 checksum of the spec document.
+func specChecksum(contents []byte) string {
+	sum := sha256.Sum256(contents)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// generatorVersion returns the version of this module from the build info of the running binary.
+// It returns "devel" when the version is not known, e.g. when running from a local checkout.
+func generatorVersion() string {
+	bi, ok := debug.ReadBuildInfo()
+	if !ok {
+		return develVersion
+	}
+
+	version := ""
+	if bi.Main.Path == generatorModulePath {
+		version = bi.Main.Version
+	}
+	for _, dep := range bi.Deps {
+		if dep.Path == generatorModulePath {
+			version = dep.Version
+		}
+	}
+
+	if version == "" || version == "(devel)" {
+		return develVersion
+	}
+	return version
+}
+
+// defaultUserAgent composes the User-Agent of the generated client from the spec title and version
+// and the generator version, e.g. "Pet-Store/1.0.0 oapi-codegen-dd/v3.1.0".
+func defaultUserAgent(info SpecInfo, generatorVersion string) string {
+	generator := generatorName + "/" + generatorVersion
+
+	title := userAgentToken(info.Title)
+	if title == "" {
+		return generator
+	}
+
+	product := title
+	if version := userAgentToken(info.Version); version != "" {
+		product += "/" + version
+	}
+	return product + " " + generator
+}
+
+// userAgentToken replaces all characters not allowed in a User-Agent product token with "-".
+// @see https://www.rfc-editor.org/rfc/rfc9110#name-tokens
+func userAgentToken(s string) string {
+	s = strings.TrimSpace(s)
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		case strings.ContainsRune("!#$%&'*+-.^_`|~", r):
+			return r
+		default:
+			return '-'
+		}
+	}, s)
+}
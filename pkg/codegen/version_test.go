@@ -0,0 +1,57 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultUserAgent(t *testing.T) {
+	tests := []struct {
+		name     string
+		info     SpecInfo
+		expected string
+	}{
+		{
+			name:     "title and version",
+			info:     SpecInfo{Title: "petstore", Version: "1.0.0"},
+			expected: "petstore/1.0.0 oapi-codegen-dd/v3.1.0",
+		},
+		{
+			name:     "title with spaces and special characters",
+			info:     SpecInfo{Title: " Pet Store (beta) ", Version: "1.0.0 rc1"},
+			expected: "Pet-Store--beta-/1.0.0-rc1 oapi-codegen-dd/v3.1.0",
+		},
+		{
+			name:     "title without version",
+			info:     SpecInfo{Title: "petstore"},
+			expected: "petstore oapi-codegen-dd/v3.1.0",
+		},
+		{
+			name:     "no title",
+			info:     SpecInfo{Version: "1.0.0"},
+			expected: "oapi-codegen-dd/v3.1.0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, defaultUserAgent(tt.info, "v3.1.0"))
+		})
+	}
+}
+
+func TestGeneratorVersion(t *testing.T) {
+	// tests run inside this module, so the version is not known
+	assert.Equal(t, "devel", generatorVersion())
+}
@@ -0,0 +1,115 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"slices"
+)
+
+// UnmarshalAnyOf unmarshals data into every variant of an anyOf union it is valid for.
+// Each variant is a pointer to a pointer field, e.g. &u.Cat for a Cat *Cat field, which is set to a new value
+// if data matches the variant and to nil otherwise. Variants implementing Validator only match if they validate,
+// unless no variant does, in which case every variant data decodes into is set, for Validate to report why.
+// It returns ErrFailedToUnmarshalUnion if data decodes into none of them.
+func UnmarshalAnyOf(data []byte, variants ...any) error {
+	values := make([]reflect.Value, len(variants))
+	fits := make([]variantFit, len(variants))
+	for i, variant := range variants {
+		values[i] = reflect.New(reflect.TypeOf(variant).Elem().Elem())
+		fits[i] = anyOfVariantFit(data, values[i].Interface())
+	}
+
+	var candidates []int
+	for i, fit := range fits {
+		if fit.decoded {
+			candidates = append(candidates, i)
+		}
+	}
+	if len(candidates) == 0 {
+		return ErrFailedToUnmarshalUnion
+	}
+	candidates = preferVariants(candidates, func(i int) bool { return fits[i].valid })
+
+	for i, variant := range variants {
+		dst := reflect.ValueOf(variant).Elem()
+		if slices.Contains(candidates, i) {
+			dst.Set(values[i])
+		} else {
+			dst.SetZero()
+		}
+	}
+	return nil
+}
+
+// MarshalAnyOf marshals the variants set in an anyOf union over raw, the data the union was unmarshaled from.
+// Variants are pointers, nil ones are skipped. Objects are merged in order, so the properties of raw that none of
+// the variants know about are kept, otherwise the first variant set is marshaled. Without variants, raw is returned.
+func MarshalAnyOf(raw json.RawMessage, variants ...any) ([]byte, error) {
+	var res json.RawMessage
+	if isJSONObject(raw) {
+		res = raw
+	}
+
+	set := false
+	for _, variant := range variants {
+		if v := reflect.ValueOf(variant); !v.IsValid() || (v.Kind() == reflect.Pointer && v.IsNil()) {
+			continue
+		}
+
+		bts, err := json.Marshal(variant)
+		if err != nil {
+			return nil, err
+		}
+		if !isJSONObject(bts) {
+			if !set {
+				return bts, nil
+			}
+			continue
+		}
+
+		set = true
+		if res == nil {
+			res = bts
+			continue
+		}
+		if res, err = JSONMerge(res, bts); err != nil {
+			return nil, err
+		}
+	}
+
+	if !set {
+		if raw == nil {
+			return []byte("null"), nil
+		}
+		return raw, nil
+	}
+	return res, nil
+}
+
+// anyOfVariantFit decodes data into dst, a pointer to a new variant, and reports how well it fits.
+func anyOfVariantFit(data []byte, dst any) variantFit {
+	if err := unmarshalUnionVariant(data, dst); err != nil {
+		return variantFit{}
+	}
+	fit := variantFit{decoded: true, valid: true}
+	if v, ok := dst.(Validator); ok {
+		fit.valid = v.Validate() == nil
+	}
+	return fit
+}
+
+func isJSONObject(data []byte) bool {
+	trim := bytes.TrimSpace(data)
+	return len(trim) > 0 && trim[0] == '{'
+}
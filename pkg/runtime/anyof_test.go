@@ -0,0 +1,126 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type anyOfIdentity struct {
+	Issuer string `json:"issuer"`
+}
+
+func (a anyOfIdentity) Validate() error {
+	if a.Issuer == "" {
+		return errors.New("issuer is required")
+	}
+	return nil
+}
+
+type anyOfVerification struct {
+	Verifier *string `json:"verifier,omitempty"`
+}
+
+type anyOfClient struct {
+	Identity     *anyOfIdentity
+	Verification *anyOfVerification
+}
+
+func TestUnmarshalAnyOf(t *testing.T) {
+	t.Run("sets every matching variant", func(t *testing.T) {
+		var res anyOfClient
+		require.NoError(t, UnmarshalAnyOf([]byte(`{"issuer":"acme","verifier":"bob"}`), &res.Identity, &res.Verification))
+		assert.Equal(t, &anyOfIdentity{Issuer: "acme"}, res.Identity)
+		assert.Equal(t, &anyOfVerification{Verifier: Ptr("bob")}, res.Verification)
+	})
+
+	t.Run("skips invalid variants", func(t *testing.T) {
+		res := anyOfClient{Identity: &anyOfIdentity{Issuer: "previous"}}
+		require.NoError(t, UnmarshalAnyOf([]byte(`{"verifier":"bob"}`), &res.Identity, &res.Verification))
+		assert.Nil(t, res.Identity)
+		assert.Equal(t, &anyOfVerification{Verifier: Ptr("bob")}, res.Verification)
+	})
+
+	t.Run("falls back to decoded variants", func(t *testing.T) {
+		var identity *anyOfIdentity
+		var name *string
+		require.NoError(t, UnmarshalAnyOf([]byte(`{"issuer":""}`), &identity, &name))
+		assert.Equal(t, &anyOfIdentity{}, identity)
+		assert.Nil(t, name)
+	})
+
+	t.Run("primitives", func(t *testing.T) {
+		var (
+			s *string
+			i *int
+			f *float64
+		)
+		require.NoError(t, UnmarshalAnyOf([]byte(`5`), &s, &i, &f))
+		assert.Nil(t, s)
+		assert.Equal(t, Ptr(5), i)
+		assert.Equal(t, Ptr(5.0), f)
+	})
+
+	t.Run("no variant", func(t *testing.T) {
+		var (
+			s *string
+			i *int
+		)
+		err := UnmarshalAnyOf([]byte(`true`), &s, &i)
+		assert.ErrorIs(t, err, ErrFailedToUnmarshalUnion)
+	})
+}
+
+func TestMarshalAnyOf(t *testing.T) {
+	t.Run("merges variants over raw data", func(t *testing.T) {
+		raw := json.RawMessage(`{"issuer":"acme","verifier":"bob","extra":1}`)
+		data, err := MarshalAnyOf(raw, &anyOfIdentity{Issuer: "other"}, (*anyOfVerification)(nil))
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"issuer":"other","verifier":"bob","extra":1}`, string(data))
+	})
+
+	t.Run("without raw data", func(t *testing.T) {
+		data, err := MarshalAnyOf(nil, &anyOfIdentity{Issuer: "acme"}, &anyOfVerification{Verifier: Ptr("bob")})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"issuer":"acme","verifier":"bob"}`, string(data))
+	})
+
+	t.Run("primitive", func(t *testing.T) {
+		data, err := MarshalAnyOf(json.RawMessage(`5`), (*string)(nil), Ptr(6), Ptr(6.0))
+		require.NoError(t, err)
+		assert.Equal(t, `6`, string(data))
+	})
+
+	t.Run("no variant", func(t *testing.T) {
+		data, err := MarshalAnyOf(json.RawMessage(`{"a":1}`), (*anyOfIdentity)(nil))
+		require.NoError(t, err)
+		assert.Equal(t, `{"a":1}`, string(data))
+
+		data, err = MarshalAnyOf(nil, (*anyOfIdentity)(nil))
+		require.NoError(t, err)
+		assert.Equal(t, `null`, string(data))
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		input := `{"issuer":"acme","verifier":"bob","extra":{"nested":true}}`
+		var res anyOfClient
+		require.NoError(t, UnmarshalAnyOf([]byte(input), &res.Identity, &res.Verification))
+
+		data, err := MarshalAnyOf(json.RawMessage(input), res.Identity, res.Verification)
+		require.NoError(t, err)
+		assert.JSONEq(t, input, string(data))
+	})
+}
@@ -0,0 +1,428 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"bytes"
+	"cmp"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParameterBinding describes how a parameter is read from a request by the generated request binders.
+type ParameterBinding struct {
+	Style    string
+	Explode  *bool
+	Required bool
+
+	// TimeFormat parses the date-time values of the parameter, RFC 3339 by default:
+	// unix for epoch seconds, unixmilli for epoch milliseconds, or a time.Parse layout such as 2006-01-02.
+	TimeFormat string
+
+	// JSON unmarshals the value, for parameters with an application/json content.
+	JSON bool
+}
+
+// BindError is returned by the generated request binders for a parameter or body that can't be read from the request.
+type BindError struct {
+	// In is where the value is in the request: path, query, header, cookie or body.
+	In string
+	// Name is the name of the parameter, empty for the body.
+	Name string
+	Err  error
+}
+
+// Error implements the error interface.
+func (e *BindError) Error() string {
+	if e.Name == "" {
+		return fmt.Sprintf("invalid %s: %s", e.In, e.Err)
+	}
+	return fmt.Sprintf("invalid %s parameter %q: %s", e.In, e.Name, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *BindError) Unwrap() error {
+	return e.Err
+}
+
+// BindErrorHandler writes the response to a request that failed to bind or validate.
+type BindErrorHandler func(w http.ResponseWriter, r *http.Request, err error)
+
+// DefaultBindErrorHandler responds with 400 Bad Request and the error message.
+func DefaultBindErrorHandler(w http.ResponseWriter, _ *http.Request, err error) {
+	http.Error(w, err.Error(), http.StatusBadRequest)
+}
+
+// BindPathParams sets the fields of dst, a pointer to a path parameters struct, from the path values of the request,
+// set by the router matching the request, e.g. http.ServeMux.
+func BindPathParams(r *http.Request, dst any, bindings map[string]ParameterBinding) error {
+	return bindParameters(dst, bindings, parameterSource{
+		in: "path",
+		values: func(name string, b ParameterBinding, multi bool) ([]string, bool) {
+			value := r.PathValue(name)
+			switch strings.ToLower(b.Style) {
+			case "label":
+				value = strings.TrimPrefix(value, ".")
+				if multi && b.Explode != nil && *b.Explode {
+					value = strings.ReplaceAll(value, ".", ",")
+				}
+			case "matrix":
+				value = strings.TrimPrefix(value, ";"+name+"=")
+				if multi && b.Explode != nil && *b.Explode {
+					value = strings.ReplaceAll(value, ";"+name+"=", ",")
+				}
+			}
+			if value == "" {
+				return nil, false
+			}
+			if multi {
+				return strings.Split(value, ","), true
+			}
+			return []string{value}, true
+		},
+	})
+}
+
+// BindQuery sets the fields of dst, a pointer to a query parameters struct, from the query of the request.
+// Objects are only supported with the deepObject style, one level deep.
+func BindQuery(r *http.Request, dst any, bindings map[string]ParameterBinding) error {
+	query := r.URL.Query()
+	return bindParameters(dst, bindings, parameterSource{
+		in: "query",
+		values: func(name string, b ParameterBinding, multi bool) ([]string, bool) {
+			values := nonEmpty(query[name])
+			if len(values) == 0 {
+				return nil, false
+			}
+			style := cmp.Or(strings.ToLower(b.Style), "form")
+			if !multi || defaultExplode(style, b.Explode) {
+				return values, true
+			}
+			delimiter := ","
+			switch style {
+			case "spacedelimited":
+				delimiter = " "
+			case "pipedelimited":
+				delimiter = "|"
+			}
+			return splitValues(values, delimiter), true
+		},
+		object: func(name string) (map[string]string, bool) {
+			res := make(map[string]string)
+			for key, values := range query {
+				prop, ok := strings.CutPrefix(key, name+"[")
+				if !ok || !strings.HasSuffix(prop, "]") || len(values) == 0 || values[0] == "" {
+					continue
+				}
+				res[strings.TrimSuffix(prop, "]")] = values[0]
+			}
+			return res, len(res) > 0
+		},
+	})
+}
+
+// BindHeader sets the fields of dst, a pointer to a header parameters struct, from the headers of the request.
+func BindHeader(r *http.Request, dst any, bindings map[string]ParameterBinding) error {
+	return bindParameters(dst, bindings, parameterSource{
+		in: "header",
+		values: func(name string, _ ParameterBinding, multi bool) ([]string, bool) {
+			values := nonEmpty(r.Header.Values(name))
+			if len(values) == 0 {
+				return nil, false
+			}
+			if multi {
+				return splitValues(values, ","), true
+			}
+			return values, true
+		},
+	})
+}
+
+// BindCookies sets the fields of dst, a pointer to a cookie parameters struct, from the cookies of the request.
+func BindCookies(r *http.Request, dst any, bindings map[string]ParameterBinding) error {
+	return bindParameters(dst, bindings, parameterSource{
+		in: "cookie",
+		values: func(name string, _ ParameterBinding, multi bool) ([]string, bool) {
+			cookie, err := r.Cookie(name)
+			if err != nil || cookie.Value == "" {
+				return nil, false
+			}
+			if multi {
+				return strings.Split(cookie.Value, ","), true
+			}
+			return []string{cookie.Value}, true
+		},
+	})
+}
+
+// parameterSource reads the parameters of a request location.
+type parameterSource struct {
+	in string
+
+	// values returns the values of a parameter, split into the items of an array if multi is set,
+	// and false if it's missing.
+	values func(name string, b ParameterBinding, multi bool) ([]string, bool)
+
+	// object returns the properties of a deepObject parameter, nil where the style isn't supported.
+	object func(name string) (map[string]string, bool)
+}
+
+// IsEmptyBody reports whether a JSON request body is absent: empty, only whitespace, or null.
+// Generated request binders leave absent optional bodies nil, and reject absent required ones.
+func IsEmptyBody(body []byte) bool {
+	body = bytes.TrimSpace(body)
+	return len(body) == 0 || bytes.Equal(body, []byte("null"))
+}
+
+// bindParameters sets the fields of dst from the parameters named after their JSON tag,
+// returning a *BindError for every missing required or invalid parameter.
+func bindParameters(dst any, bindings map[string]ParameterBinding, source parameterSource) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("cannot bind %s parameters to %T, expected a pointer to a struct", source.in, dst)
+	}
+	rv = rv.Elem()
+
+	var errs []error
+	for i := range rv.NumField() {
+		field := rv.Type().Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if !field.IsExported() || name == "" || name == "-" {
+			continue
+		}
+		b := bindings[name]
+
+		var err error
+		if source.object != nil && strings.EqualFold(b.Style, "deepObject") {
+			props, ok := source.object(name)
+			if !ok {
+				if b.Required {
+					errs = append(errs, &BindError{In: source.in, Name: name, Err: ErrMissingValue})
+				}
+				continue
+			}
+			err = setObjectParameter(rv.Field(i), props)
+		} else {
+			values, ok := source.values(name, b, isMultiValue(field.Type) && !b.JSON)
+			if !ok {
+				if b.Required {
+					errs = append(errs, &BindError{In: source.in, Name: name, Err: ErrMissingValue})
+				}
+				continue
+			}
+			err = setParameter(rv.Field(i), values, b)
+		}
+		if err != nil {
+			errs = append(errs, &BindError{In: source.in, Name: name, Err: err})
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// setParameter sets v, allocating pointers, from the values of a parameter: all of them for arrays, the first otherwise.
+func setParameter(v reflect.Value, values []string, b ParameterBinding) error {
+	if v.Kind() == reflect.Pointer {
+		elem := reflect.New(v.Type().Elem())
+		if err := setParameter(elem.Elem(), values, b); err != nil {
+			return err
+		}
+		v.Set(elem)
+		return nil
+	}
+
+	if !b.JSON && isMultiValue(v.Type()) {
+		items := reflect.MakeSlice(v.Type(), len(values), len(values))
+		for i, value := range values {
+			if err := setParameterValue(items.Index(i), value, b); err != nil {
+				return fmt.Errorf("item %d: %w", i, err)
+			}
+		}
+		v.Set(items)
+		return nil
+	}
+	return setParameterValue(v, values[0], b)
+}
+
+// setParameterValue parses a single value into v.
+func setParameterValue(v reflect.Value, value string, b ParameterBinding) error {
+	if v.Kind() == reflect.Pointer {
+		elem := reflect.New(v.Type().Elem())
+		if err := setParameterValue(elem.Elem(), value, b); err != nil {
+			return err
+		}
+		v.Set(elem)
+		return nil
+	}
+
+	target := v.Addr().Interface()
+	if b.JSON {
+		return json.Unmarshal([]byte(value), target)
+	}
+	if t, ok := target.(*time.Time); ok && b.TimeFormat != "" {
+		parsed, err := parseQueryTime(value, b.TimeFormat)
+		if err != nil {
+			return err
+		}
+		*t = parsed
+		return nil
+	}
+	if u, ok := target.(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(value))
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(value)
+		return nil
+	case reflect.Bool:
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		v.SetBool(parsed)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		parsed, err := strconv.ParseInt(value, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(parsed)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		parsed, err := strconv.ParseUint(value, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(parsed)
+		return nil
+	case reflect.Float32, reflect.Float64:
+		parsed, err := strconv.ParseFloat(value, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(parsed)
+		return nil
+	}
+
+	// wrappers such as Nullable and Sensitive unmarshal numbers and booleans as they are, and strings quoted
+	if _, ok := target.(json.Unmarshaler); ok {
+		if err := json.Unmarshal([]byte(value), target); err == nil {
+			return nil
+		}
+		return json.Unmarshal([]byte(strconv.Quote(value)), target)
+	}
+	return fmt.Errorf("unsupported type %s", v.Type())
+}
+
+// setObjectParameter sets v, a map or struct, from the properties of a deepObject parameter.
+// Struct fields are matched by their JSON tag.
+func setObjectParameter(v reflect.Value, props map[string]string) error {
+	if v.Kind() == reflect.Pointer {
+		elem := reflect.New(v.Type().Elem())
+		if err := setObjectParameter(elem.Elem(), props); err != nil {
+			return err
+		}
+		v.Set(elem)
+		return nil
+	}
+
+	switch {
+	case v.Kind() == reflect.Map && v.Type().Key().Kind() == reflect.String:
+		m := reflect.MakeMapWithSize(v.Type(), len(props))
+		for key, value := range props {
+			elem := reflect.New(v.Type().Elem()).Elem()
+			if err := setParameterValue(elem, value, ParameterBinding{}); err != nil {
+				return fmt.Errorf("property %q: %w", key, err)
+			}
+			m.SetMapIndex(reflect.ValueOf(key).Convert(v.Type().Key()), elem)
+		}
+		v.Set(m)
+		return nil
+	case v.Kind() == reflect.Struct:
+		for i := range v.NumField() {
+			name, _, _ := strings.Cut(v.Type().Field(i).Tag.Get("json"), ",")
+			value, ok := props[name]
+			if !ok || name == "" || !v.Type().Field(i).IsExported() {
+				continue
+			}
+			if err := setParameter(v.Field(i), []string{value}, ParameterBinding{}); err != nil {
+				return fmt.Errorf("property %q: %w", name, err)
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("unsupported type %s for a deepObject parameter", v.Type())
+}
+
+// isMultiValue returns true for the array types bound from several values, leaving out types parsing the value
+// themselves and []byte.
+func isMultiValue(t reflect.Type) bool {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Slice || t.Elem().Kind() == reflect.Uint8 {
+		return false
+	}
+	ptr := reflect.PointerTo(t)
+	return !ptr.Implements(reflect.TypeFor[encoding.TextUnmarshaler]()) && !ptr.Implements(reflect.TypeFor[json.Unmarshaler]())
+}
+
+// parseQueryTime parses a date-time value written with formatQueryTime.
+func parseQueryTime(value, format string) (time.Time, error) {
+	switch format {
+	case "unix":
+		seconds, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return time.Unix(seconds, 0).UTC(), nil
+	case "unixmilli":
+		millis, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return time.UnixMilli(millis).UTC(), nil
+	case "rfc3339":
+		return time.Parse(time.RFC3339, value)
+	}
+	return time.Parse(format, value)
+}
+
+// splitValues splits every value by the delimiter, trimming the spaces around the items.
+func splitValues(values []string, delimiter string) []string {
+	var res []string
+	for _, value := range values {
+		for item := range strings.SplitSeq(value, delimiter) {
+			if delimiter != " " {
+				item = strings.TrimSpace(item)
+			}
+			res = append(res, item)
+		}
+	}
+	return res
+}
+
+// nonEmpty returns the values, or nil if they are all empty.
+func nonEmpty(values []string) []string {
+	for _, value := range values {
+		if value != "" {
+			return values
+		}
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type bindColor string
+
+func (c *bindColor) UnmarshalText(data []byte) error {
+	switch s := bindColor(data); s {
+	case "red", "green":
+		*c = s
+		return nil
+	}
+	return ErrUnknownEnumValue
+}
+
+func TestBindPathParams(t *testing.T) {
+	type pathParams struct {
+		ID     int64       `json:"id"`
+		Colors []bindColor `json:"colors"`
+	}
+
+	var got pathParams
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /pets/{id}/{colors}", func(w http.ResponseWriter, r *http.Request) {
+		err := BindPathParams(r, &got, map[string]ParameterBinding{"id": {Required: true}, "colors": {Required: true}})
+		require.NoError(t, err)
+	})
+	mux.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/pets/42/red,green", nil))
+
+	assert.Equal(t, pathParams{ID: 42, Colors: []bindColor{"red", "green"}}, got)
+
+	t.Run("label and matrix styles", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.SetPathValue("colors", ".red.green")
+		r.SetPathValue("id", ";id=7")
+
+		var got pathParams
+		err := BindPathParams(r, &got, map[string]ParameterBinding{
+			"id":     {Style: "matrix"},
+			"colors": {Style: "label", Explode: ptr(true)},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, pathParams{ID: 7, Colors: []bindColor{"red", "green"}}, got)
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.SetPathValue("id", "abc")
+		r.SetPathValue("colors", "blue")
+
+		err := BindPathParams(r, &pathParams{}, nil)
+		var bindErr *BindError
+		require.ErrorAs(t, err, &bindErr)
+		assert.Equal(t, "path", bindErr.In)
+		assert.Equal(t, "id", bindErr.Name)
+		assert.ErrorIs(t, err, ErrUnknownEnumValue)
+	})
+}
+
+func TestBindQuery(t *testing.T) {
+	type filter struct {
+		Color *bindColor `json:"color,omitempty"`
+		Min   *int       `json:"min,omitempty"`
+	}
+	type queryParams struct {
+		Limit  int               `json:"limit"`
+		Tags   []string          `json:"tags,omitempty"`
+		IDs    []int             `json:"ids,omitempty"`
+		Words  []string          `json:"words,omitempty"`
+		Active *bool             `json:"active,omitempty"`
+		Since  *time.Time        `json:"since,omitempty"`
+		Day    *Date             `json:"day,omitempty"`
+		Filter *filter           `json:"filter,omitempty"`
+		Labels map[string]string `json:"labels,omitempty"`
+		Meta   *map[string]any   `json:"meta,omitempty"`
+		Name   Nullable[string]  `json:"name,omitzero"`
+		Ignore string            `json:"-"`
+	}
+	bindings := map[string]ParameterBinding{
+		"limit":  {Required: true},
+		"tags":   {Style: "form"},
+		"ids":    {Style: "form", Explode: ptr(false)},
+		"words":  {Style: "pipeDelimited"},
+		"since":  {TimeFormat: "unix"},
+		"filter": {Style: "deepObject"},
+		"labels": {Style: "deepObject"},
+		"meta":   {JSON: true},
+	}
+
+	r := httptest.NewRequest(http.MethodGet,
+		`/pets?limit=10&tags=a&tags=b&ids=1,2,3&words=x|y&active=true&since=1700000000&day=2024-01-02`+
+			`&filter[color]=red&filter[min]=3&labels[team]=pets&meta={"a":1}&name=rex&-=ignored`, nil)
+
+	var got queryParams
+	require.NoError(t, BindQuery(r, &got, bindings))
+
+	assert.Equal(t, 10, got.Limit)
+	assert.Equal(t, []string{"a", "b"}, got.Tags)
+	assert.Equal(t, []int{1, 2, 3}, got.IDs)
+	assert.Equal(t, []string{"x", "y"}, got.Words)
+	assert.Equal(t, ptr(true), got.Active)
+	assert.Equal(t, time.Unix(1700000000, 0).UTC(), *got.Since)
+	assert.Equal(t, "2024-01-02", got.Day.String())
+	assert.Equal(t, &filter{Color: ptr(bindColor("red")), Min: ptr(3)}, got.Filter)
+	assert.Equal(t, map[string]string{"team": "pets"}, got.Labels)
+	assert.Equal(t, &map[string]any{"a": float64(1)}, got.Meta)
+	assert.Equal(t, ptr("rex"), got.Name.Ptr())
+	assert.Empty(t, got.Ignore)
+
+	t.Run("missing required", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/pets?limit=&ids=1,x", nil)
+
+		err := BindQuery(r, &queryParams{}, bindings)
+		require.ErrorIs(t, err, ErrMissingValue)
+
+		var bindErr *BindError
+		require.ErrorAs(t, err, &bindErr)
+		assert.Equal(t, "limit", bindErr.Name)
+		assert.Contains(t, err.Error(), `invalid query parameter "ids": item 1:`)
+	})
+
+	t.Run("not a struct", func(t *testing.T) {
+		var limit int
+		assert.Error(t, BindQuery(r, &limit, bindings))
+	})
+}
+
+func TestBindHeaderAndCookies(t *testing.T) {
+	type params struct {
+		RequestID string   `json:"X-Request-Id"`
+		Versions  []int    `json:"X-Versions,omitempty"`
+		Session   *string  `json:"session,omitempty"`
+		Scores    []string `json:"scores,omitempty"`
+	}
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("X-Request-Id", "abc")
+	r.Header.Add("X-Versions", "1, 2")
+	r.Header.Add("X-Versions", "3")
+	r.AddCookie(&http.Cookie{Name: "session", Value: "s1"})
+	r.AddCookie(&http.Cookie{Name: "scores", Value: "a,b"})
+
+	var header params
+	require.NoError(t, BindHeader(r, &header, map[string]ParameterBinding{"X-Request-Id": {Required: true}}))
+	assert.Equal(t, params{RequestID: "abc", Versions: []int{1, 2, 3}}, header)
+
+	var cookies params
+	err := BindCookies(r, &cookies, map[string]ParameterBinding{"X-Request-Id": {Required: true}})
+	var bindErr *BindError
+	require.ErrorAs(t, err, &bindErr)
+	assert.Equal(t, `invalid cookie parameter "X-Request-Id": missing required value`, bindErr.Error())
+	assert.Equal(t, ptr("s1"), cookies.Session)
+	assert.Equal(t, []string{"a", "b"}, cookies.Scores)
+}
+
+func TestIsEmptyBody(t *testing.T) {
+	assert.True(t, IsEmptyBody(nil))
+	assert.True(t, IsEmptyBody([]byte(" \n")))
+	assert.True(t, IsEmptyBody([]byte(" null\n")))
+	assert.False(t, IsEmptyBody([]byte("{}")))
+	assert.False(t, IsEmptyBody([]byte(`"null"`)))
+}
+
+func TestDefaultBindErrorHandler(t *testing.T) {
+	w := httptest.NewRecorder()
+	DefaultBindErrorHandler(w, httptest.NewRequest(http.MethodGet, "/", nil), &BindError{In: "body", Err: errors.New("unexpected EOF")})
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "invalid body: unexpected EOF\n", w.Body.String())
+}
@@ -0,0 +1,87 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+)
+
+// maxDrainBytes is the maximum number of unread bytes discarded when a streamed body is closed.
+// Draining small remainders lets the transport reuse the connection,
+// larger ones are cheaper to abandon with the connection.
+const maxDrainBytes = 64 << 10
+
+// readBody reads r until EOF and closes it.
+// The body is closed as soon as ctx is done, so a read blocked on a Doer that ignores
+// the context still returns promptly, with the context error.
+func readBody(ctx context.Context, r io.ReadCloser) ([]byte, error) {
+	stop := context.AfterFunc(ctx, func() { _ = r.Close() })
+	data, err := io.ReadAll(r)
+	if stop() {
+		_ = r.Close()
+	}
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, err
+	}
+	return data, nil
+}
+
+// streamBody is the body of a streamed response.
+// Reads fail with the context error once ctx is done, even if the Doer ignores the context.
+// Close releases the per-call context and is safe to call more than once.
+// It drains the unread rest of the body first if its Content-Length says it is at most maxDrainBytes,
+// bodies of unknown length such as event streams are never drained, as that could block.
+type streamBody struct {
+	io.ReadCloser
+	ctx       context.Context
+	stop      func() bool
+	cancel    context.CancelFunc
+	remaining int64
+
+	once sync.Once
+	err  error
+}
+
+func newStreamBody(ctx context.Context, body io.ReadCloser, contentLength int64, cancel context.CancelFunc) *streamBody {
+	b := &streamBody{ReadCloser: body, ctx: ctx, cancel: cancel, remaining: contentLength}
+	b.stop = context.AfterFunc(ctx, func() { _ = body.Close() })
+	return b
+}
+
+func (b *streamBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.remaining -= int64(n)
+	if err != nil && !errors.Is(err, io.EOF) && b.ctx.Err() != nil {
+		return n, b.ctx.Err()
+	}
+	return n, err
+}
+
+func (b *streamBody) Close() error {
+	b.once.Do(func() {
+		if b.stop() {
+			if b.remaining > 0 && b.remaining <= maxDrainBytes {
+				_, _ = io.Copy(io.Discard, io.LimitReader(b.ReadCloser, b.remaining))
+			}
+			b.err = b.ReadCloser.Close()
+		}
+		if b.cancel != nil {
+			b.cancel()
+		}
+	})
+	return b.err
+}
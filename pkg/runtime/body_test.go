@@ -0,0 +1,177 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingBody records how much of the body was read and how often it was closed.
+type countingBody struct {
+	io.Reader
+	read   int
+	closes atomic.Int32
+}
+
+func (b *countingBody) Read(p []byte) (int, error) {
+	n, err := b.Reader.Read(p)
+	b.read += n
+	return n, err
+}
+
+func (b *countingBody) Close() error {
+	b.closes.Add(1)
+	return nil
+}
+
+// blockingBody returns a body whose reads block until it is closed.
+func blockingBody() io.ReadCloser {
+	r, _ := io.Pipe()
+	return r
+}
+
+func cancelAfter(d time.Duration) context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(d, cancel)
+	return ctx
+}
+
+func TestReadBody(t *testing.T) {
+	t.Run("reads and closes the body", func(t *testing.T) {
+		body := &countingBody{Reader: strings.NewReader("ok")}
+
+		data, err := readBody(context.Background(), body)
+		require.NoError(t, err)
+		assert.Equal(t, "ok", string(data))
+		assert.Equal(t, int32(1), body.closes.Load())
+	})
+
+	t.Run("returns read errors", func(t *testing.T) {
+		r, w := io.Pipe()
+		_ = w.CloseWithError(errors.New("connection reset"))
+
+		_, err := readBody(context.Background(), r)
+		assert.EqualError(t, err, "connection reset")
+	})
+
+	t.Run("aborts a blocked read when the context is done", func(t *testing.T) {
+		done := make(chan error, 1)
+		go func() {
+			_, err := readBody(cancelAfter(10*time.Millisecond), blockingBody())
+			done <- err
+		}()
+
+		select {
+		case err := <-done:
+			assert.ErrorIs(t, err, context.Canceled)
+		case <-time.After(5 * time.Second):
+			t.Fatal("read was not aborted")
+		}
+	})
+}
+
+func TestClient_ExecuteRequest_cancellation(t *testing.T) {
+	client := &Client{httpClient: &MockHttpRequestDoer{response: &http.Response{StatusCode: http.StatusOK, Body: blockingBody()}}}
+	req, _ := http.NewRequest(http.MethodGet, "http://example.com", nil)
+
+	_, err := client.ExecuteRequest(cancelAfter(10*time.Millisecond), req, "/")
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestClient_ExecuteStreamRequest_cleanup(t *testing.T) {
+	newClient := func(body io.ReadCloser, contentLength int64) *Client {
+		return &Client{httpClient: &MockHttpRequestDoer{response: &http.Response{
+			StatusCode:    http.StatusOK,
+			Body:          body,
+			ContentLength: contentLength,
+		}}}
+	}
+	req, _ := http.NewRequest(http.MethodGet, "http://example.com", nil)
+
+	t.Run("reads fail with the context error once it is done", func(t *testing.T) {
+		resp, err := newClient(blockingBody(), -1).ExecuteStreamRequest(cancelAfter(10*time.Millisecond), req, "/")
+		require.NoError(t, err)
+
+		_, err = io.ReadAll(resp.Body)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.NoError(t, resp.Close())
+	})
+
+	t.Run("close drains a small remainder", func(t *testing.T) {
+		body := &countingBody{Reader: strings.NewReader("0123456789")}
+		resp, err := newClient(body, 10).ExecuteStreamRequest(context.Background(), req, "/")
+		require.NoError(t, err)
+
+		_, err = resp.Body.Read(make([]byte, 4))
+		require.NoError(t, err)
+		require.NoError(t, resp.Close())
+		assert.Equal(t, 10, body.read)
+		assert.Equal(t, int32(1), body.closes.Load())
+	})
+
+	t.Run("close does not drain a large remainder", func(t *testing.T) {
+		body := &countingBody{Reader: strings.NewReader(strings.Repeat("x", maxDrainBytes+1))}
+		resp, err := newClient(body, maxDrainBytes+1).ExecuteStreamRequest(context.Background(), req, "/")
+		require.NoError(t, err)
+
+		require.NoError(t, resp.Close())
+		assert.Equal(t, 0, body.read)
+		assert.Equal(t, int32(1), body.closes.Load())
+	})
+
+	t.Run("close does not drain a body of unknown length", func(t *testing.T) {
+		body := &countingBody{Reader: strings.NewReader("data: 1\n\n")}
+		resp, err := newClient(body, -1).ExecuteStreamRequest(context.Background(), req, "/")
+		require.NoError(t, err)
+
+		require.NoError(t, resp.Close())
+		assert.Equal(t, 0, body.read)
+	})
+
+	t.Run("close is idempotent", func(t *testing.T) {
+		body := &countingBody{Reader: strings.NewReader("ok")}
+		resp, err := newClient(body, 2).ExecuteStreamRequest(context.Background(), req, "/")
+		require.NoError(t, err)
+
+		require.NoError(t, resp.Close())
+		require.NoError(t, resp.Body.Close())
+		require.NoError(t, resp.Close())
+		assert.Equal(t, int32(1), body.closes.Load())
+	})
+
+	t.Run("body closed by cancellation is not closed again", func(t *testing.T) {
+		body := &countingBody{Reader: strings.NewReader("ok")}
+		ctx, cancel := context.WithCancel(context.Background())
+		resp, err := newClient(body, 2).ExecuteStreamRequest(ctx, req, "/")
+		require.NoError(t, err)
+
+		cancel()
+		require.Eventually(t, func() bool { return body.closes.Load() == 1 }, 5*time.Second, time.Millisecond)
+		require.NoError(t, resp.Close())
+		assert.Equal(t, int32(1), body.closes.Load())
+	})
+}
+
+func TestResponse_Close(t *testing.T) {
+	var nilResponse *Response
+	assert.NoError(t, nilResponse.Close())
+	assert.NoError(t, (&Response{Content: []byte("ok")}).Close())
+}
@@ -13,13 +13,14 @@ package runtime
 import (
 	"bytes"
 	"context"
-	"encoding/json"
+	"crypto/rand"
 	"fmt"
 	"io"
 	"net/http"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 )
 
 type RequestOptions interface {
@@ -37,6 +38,19 @@ type RequestOptionsParameters struct {
 	ContentType   string
 	BodyEncoding  map[string]FieldEncoding
 	QueryEncoding map[string]QueryEncoding
+
+	// IdempotencyKeyHeader is set to a generated idempotency key unless the request already has it.
+	IdempotencyKeyHeader string
+
+	// FeatureFlag gates the operation, see WithFlagChecker.
+	FeatureFlag string
+
+	// LogSampleRate is the fraction of the requests of the operation passed to the RequestLogger,
+	// see WithRequestLogger. Zero passes all of them.
+	LogSampleRate float64
+
+	// SensitiveParameters are masked in the requests passed to the RequestLogger and in the errors sending them.
+	SensitiveParameters SensitiveParameters
 }
 
 // RequestEditorFn is the function signature for the RequestEditor callback function
@@ -46,27 +60,122 @@ type HttpRequestDoer interface {
 	Do(context context.Context, req *http.Request) (*http.Response, error)
 }
 
+// Response is the response of an executed request.
+// Content holds the buffered body. Body is only set for streamed responses,
+// in which case Content is empty and the caller must close Body, or the Response.
 type Response struct {
 	Content    []byte
+	Body       io.ReadCloser
 	StatusCode int
 	Headers    http.Header
 	Raw        *http.Response
 }
 
+// Close releases the resources of a streamed response: a small unread rest of the body is drained,
+// so the connection can be reused, and the body is closed. It is safe to call more than once
+// and is a no-op for buffered responses, whose body is already closed.
+func (r *Response) Close() error {
+	if r == nil || r.Body == nil {
+		return nil
+	}
+	return r.Body.Close()
+}
+
 type APIClient interface {
 	GetBaseURL() string
 	CreateRequest(ctx context.Context, params RequestOptionsParameters, reqEditors ...RequestEditorFn) (*http.Request, error)
 	ExecuteRequest(ctx context.Context, req *http.Request, operationPath string) (*Response, error)
 }
 
+// ExecuteStreamRequest executes the request with the ExecuteStreamRequest method of the API client, for generated
+// methods of streamed responses. Clients not implementing ExecuteStreamRequest(ctx, req, operationPath) read the body
+// with ExecuteRequest, returned in Response.Body as well as Content, a *PartialBody for 206 Partial Content responses
+// like ExecuteStreamRequest returns, so that ResumeDownload knows where the content starts.
+func ExecuteStreamRequest(ctx context.Context, apiClient APIClient, req *http.Request, operationPath string) (*Response, error) {
+	if c, ok := apiClient.(interface {
+		ExecuteStreamRequest(ctx context.Context, req *http.Request, operationPath string) (*Response, error)
+	}); ok {
+		return c.ExecuteStreamRequest(ctx, req, operationPath)
+	}
+
+	res, err := apiClient.ExecuteRequest(ctx, req, operationPath)
+	if res != nil && res.Body == nil {
+		res.Body = partialBody(io.NopCloser(bytes.NewReader(res.Content)), res.StatusCode, res.Headers)
+	}
+	return res, err
+}
+
+// hasHeader reports whether the header has a value for name in any case, including the names written
+// as-is with WithPreserveHeaderCase.
+func hasHeader(header http.Header, name string) bool {
+	if header.Get(name) != "" {
+		return true
+	}
+	for k, v := range header {
+		if strings.EqualFold(k, name) && len(v) > 0 && v[0] != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// setHeader replaces the values of the header for name in any case, writing name as-is if preserveCase is set.
+func setHeader(header http.Header, name, value string, preserveCase bool) {
+	for k := range header {
+		if strings.EqualFold(k, name) {
+			delete(header, k)
+		}
+	}
+	if preserveCase {
+		header[name] = []string{value}
+		return
+	}
+	header.Set(name, value)
+}
+
+// partialBody returns the body of a 206 Partial Content response with a valid Content-Range header
+// as a *PartialBody, and other bodies as-is.
+func partialBody(body io.ReadCloser, statusCode int, header http.Header) io.ReadCloser {
+	if statusCode != http.StatusPartialContent {
+		return body
+	}
+	rng, err := ParseContentRange(header.Get("Content-Range"))
+	if err != nil {
+		return body
+	}
+	return &PartialBody{ReadCloser: body, Range: rng}
+}
+
 // Client is a client for making API requests.
 // BaseURL is the base URL for the API.
 // httpClient is the HTTP client to use for making requests.
 // requestEditors is a list of callbacks for modifying requests which are generated before sending over the network.
+// preserveHeaderCase disables canonicalization of the header names coming from the request options.
+// userAgent is sent as User-Agent header unless the request already has one.
+// idempotencyKey generates the keys for operations sending an idempotency key.
+// specVersion is sent in the SpecVersionHeader, specVersionCheck is validated against the server's.
+// validateRequests validates the request options before a request is created.
+// flagChecker gates the operations with a feature flag.
+// jsonCodec encodes the JSON request bodies, encoding/json when nil.
+// etagCache stores the responses of GET requests revalidated with If-None-Match.
+// strictNoContent checks that the no-content responses have no body instead of closing it unread.
+// noRedirects has the paths of the operations whose redirects are not followed.
 type Client struct {
-	baseURL        string
-	httpClient     HttpRequestDoer
-	requestEditors []RequestEditorFn
+	baseURL            string
+	httpClient         HttpRequestDoer
+	requestEditors     []RequestEditorFn
+	preserveHeaderCase bool
+	userAgent          string
+	idempotencyKey     func() string
+	specVersion        string
+	specVersionCheck   string
+	validateRequests   bool
+	flagChecker        FlagChecker
+	jsonCodec          JSONCodec
+	requestLogger      RequestLogger
+	etagCache          ETagCache
+	strictNoContent    bool
+	noRedirects        map[string]bool
 }
 
 // GetBaseURL returns the base URL of the API client.
@@ -77,24 +186,70 @@ func (c *Client) GetBaseURL() string {
 // CreateRequest creates a new HTTP request with the given parameters and applies any request editors.
 // It returns the created request or an error if the request could not be created.
 func (c *Client) CreateRequest(ctx context.Context, params RequestOptionsParameters, reqEditors ...RequestEditorFn) (*http.Request, error) {
-	req, err := createRequest(ctx, params)
+	if !c.IsFeatureEnabled(ctx, params.FeatureFlag) {
+		return nil, &FeatureDisabledError{Flag: params.FeatureFlag}
+	}
+
+	if c.validateRequests {
+		if err := validateRequestOptions(params.Options); err != nil {
+			return nil, fmt.Errorf("invalid request: %w", err)
+		}
+	}
+
+	req, err := createRequest(ctx, params, c.preserveHeaderCase, c.jsonCodec)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %w", err)
 	}
 
+	// the headers of the options are not canonicalized with WithPreserveHeaderCase, so they're looked up case-insensitively
+	if c.userAgent != "" && !hasHeader(req.Header, "User-Agent") {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+
+	if c.specVersion != "" && !hasHeader(req.Header, SpecVersionHeader) {
+		req.Header.Set(SpecVersionHeader, c.specVersion)
+	}
+
+	if h := params.IdempotencyKeyHeader; h != "" && !hasHeader(req.Header, h) {
+		generate := c.idempotencyKey
+		if generate == nil {
+			generate = NewIdempotencyKey
+		}
+		req.Header.Set(h, generate())
+	}
+
 	if err = c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, fmt.Errorf("error applying request editors: %w", err)
 	}
+	withLogSampleRate(req, params.LogSampleRate)
+	withSensitiveParameters(req, params.SensitiveParameters)
 
 	return req, nil
 }
 
-// ExecuteRequest sends the HTTP request and returns the response.
+// ExecuteRequest sends the HTTP request and returns the response with the body read and closed.
+// The body of 204 No Content and 205 Reset Content responses is not read, see WithStrictNoContent.
 // It records the HTTP call with latency if an HTTPCallRecorder is set.
-func (c *Client) ExecuteRequest(ctx context.Context, req *http.Request, operationPath string) (*Response, error) {
+// With WithETagCache, GET requests revalidate the cached responses.
+// Per-call options such as WithTimeout apply until the body has been read.
+// Cancelling ctx aborts reading the body, even if the HttpRequestDoer ignores the context.
+func (c *Client) ExecuteRequest(ctx context.Context, req *http.Request, operationPath string) (res *Response, err error) {
+	if c.requestLogger != nil && sampleRequestLog(req) {
+		logCtx, start := ctx, time.Now()
+		defer func() { c.logRequest(logCtx, req, operationPath, start, res, err) }()
+	}
+
+	ctx, cancel := withCallOptions(ctx, req)
+	if cancel != nil {
+		defer cancel()
+		req = req.WithContext(ctx)
+	}
+	ctx, req = c.withRedirectPolicy(ctx, req, operationPath)
+
+	cached := c.revalidateRequest(ctx, req)
 	resp, err := c.httpClient.Do(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("error sending request: %w", err)
+		return nil, fmt.Errorf("error sending request: %w", requestSensitiveParameters(req).MaskError(err))
 	}
 
 	if resp == nil {
@@ -102,25 +257,82 @@ func (c *Client) ExecuteRequest(ctx context.Context, req *http.Request, operatio
 	}
 
 	var bodyBytes []byte
-	if resp.Body != nil {
-		defer func() { _ = resp.Body.Close() }()
-		var err error
-		bodyBytes, err = io.ReadAll(resp.Body)
-		if err != nil {
+	switch {
+	case resp.Body == nil:
+	case isNoContentStatus(resp.StatusCode):
+		if err = c.closeNoContentBody(ctx, resp); err != nil {
+			return nil, err
+		}
+	default:
+		if bodyBytes, err = readBody(ctx, resp.Body); err != nil {
 			return nil, fmt.Errorf("error reading response body: %w", err)
 		}
 	}
 
-	return &Response{
+	if err = c.checkSpecVersion(resp.Header); err != nil {
+		return nil, err
+	}
+
+	return c.cacheResponse(ctx, req, cached, &Response{
 		Content:    bodyBytes,
 		StatusCode: resp.StatusCode,
 		Headers:    resp.Header,
 		Raw:        resp,
+	}), nil
+}
+
+// ExecuteStreamRequest sends the HTTP request and returns the response without reading the body.
+// The body is available in Response.Body and must be closed by the caller, directly or with Response.Close.
+// Closing it drains what is left of a body with a small Content-Length for connection reuse and is safe to repeat.
+// Once ctx is done, reading the body fails with the context error.
+// For 206 Partial Content responses with a valid Content-Range header, the body is a *PartialBody.
+// Per-call options such as WithTimeout apply until the body is closed.
+func (c *Client) ExecuteStreamRequest(ctx context.Context, req *http.Request, operationPath string) (res *Response, err error) {
+	if c.requestLogger != nil && sampleRequestLog(req) {
+		logCtx, start := ctx, time.Now()
+		defer func() { c.logRequest(logCtx, req, operationPath, start, res, err) }()
+	}
+
+	ctx, cancel := withCallOptions(ctx, req)
+	if cancel != nil {
+		req = req.WithContext(ctx)
+	}
+	ctx, req = c.withRedirectPolicy(ctx, req, operationPath)
+
+	resp, err := c.httpClient.Do(ctx, req)
+	if err != nil || resp == nil {
+		if cancel != nil {
+			cancel()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error sending request: %w", requestSensitiveParameters(req).MaskError(err))
+		}
+		return nil, nil
+	}
+
+	body := resp.Body
+	if body == nil {
+		body = http.NoBody
+	}
+	body = newStreamBody(ctx, body, resp.ContentLength, cancel)
+	if err = c.checkSpecVersion(resp.Header); err != nil {
+		_ = body.Close()
+		return nil, err
+	}
+	return &Response{
+		Body:       partialBody(body, resp.StatusCode, resp.Header),
+		StatusCode: resp.StatusCode,
+		Headers:    resp.Header,
+		Raw:        resp,
 	}, nil
 }
 
 // applyEditors applies all the request editors to the request.
 func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	if c.preserveHeaderCase {
+		getCallOptions(req).preserveHeaderCase = true
+	}
+
 	for _, r := range c.requestEditors {
 		if err := r(ctx, req); err != nil {
 			return err
@@ -172,8 +384,62 @@ func WithRequestEditorFn(fn RequestEditorFn) APIClientOption {
 	}
 }
 
+// WithPreserveHeaderCase keeps header names exactly as they are defined in the spec
+// instead of canonicalizing them (e.g. "x-api-KEY" is not sent as "X-Api-Key").
+// Use it only for backends that require non-canonical header casing.
+// The headers of the request options are written to the http.Header map directly, bypassing its canonicalization,
+// so http.Header.Get and Set don't see them: request editors must look them up by their exact name.
+// The default User-Agent, spec version and idempotency key headers are only added when missing in any case.
+func WithPreserveHeaderCase() APIClientOption {
+	return func(c *Client) error {
+		c.preserveHeaderCase = true
+		return nil
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+// A User-Agent set via the request options or request editors takes precedence.
+// Generated clients created with NewDefault<Client> use the User-Agent derived from the spec,
+// passing this option afterwards overrides it.
+func WithUserAgent(userAgent string) APIClientOption {
+	return func(c *Client) error {
+		c.userAgent = userAgent
+		return nil
+	}
+}
+
+// WithIdempotencyKeyGenerator sets the function generating the keys for operations sending an idempotency key.
+// It is called once per request, so retries of the same request reuse its key. Defaults to NewIdempotencyKey.
+func WithIdempotencyKeyGenerator(generate func() string) APIClientOption {
+	return func(c *Client) error {
+		c.idempotencyKey = generate
+		return nil
+	}
+}
+
+// WithRequestValidation validates the request options of every call before it is sent.
+// Invalid requests fail with the ValidationErrors returned by the options' Validate method,
+// which generated options have unless validation is skipped in the generator configuration.
+func WithRequestValidation() APIClientOption {
+	return func(c *Client) error {
+		c.validateRequests = true
+		return nil
+	}
+}
+
+// NewIdempotencyKey returns a random (version 4) UUID.
+func NewIdempotencyKey() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
 // createRequest creates a new POST request with the given URL, payload and headers.
-func createRequest(ctx context.Context, params RequestOptionsParameters) (*http.Request, error) {
+// If preserveHeaderCase is set, header names are written as-is, bypassing http.Header canonicalization.
+// JSON bodies are encoded with codec, if set.
+func createRequest(ctx context.Context, params RequestOptionsParameters, preserveHeaderCase bool, codec JSONCodec) (*http.Request, error) {
 	options := params.Options
 
 	var (
@@ -219,9 +485,11 @@ func createRequest(ctx context.Context, params RequestOptionsParameters) (*http.
 		contentType = params.ContentType
 	}
 
-	// if header exists, prefer that value as contentType for encoding decision
-	if _, ok := headers["Content-Type"]; ok {
-		contentType = headers["Content-Type"]
+	// if header exists in any case, prefer that value as contentType for encoding decision
+	for k, v := range headers {
+		if strings.EqualFold(k, "Content-Type") {
+			contentType = v
+		}
 	}
 
 	if contentType == "" {
@@ -242,7 +510,7 @@ func createRequest(ctx context.Context, params RequestOptionsParameters) (*http.
 	}
 	sort.Strings(keys)
 	for _, k := range keys {
-		httpHeaders.Set(k, headers[k])
+		setHeader(httpHeaders, k, headers[k], preserveHeaderCase)
 	}
 
 	var (
@@ -261,8 +529,8 @@ func createRequest(ctx context.Context, params RequestOptionsParameters) (*http.
 			}
 			bodyBytes = []byte(encodedPayload)
 		default:
-			// Default: treat as JSON
-			bodyBytes, err = json.Marshal(payload)
+			// Default: treat as JSON, omitting readOnly properties of generated types
+			bodyBytes, err = marshalRequestBody(payload, codec)
 			if err != nil {
 				return nil, err
 			}
@@ -278,7 +546,9 @@ func createRequest(ctx context.Context, params RequestOptionsParameters) (*http.
 		return nil, err
 	}
 
-	httpHeaders.Set("Content-Type", contentType)
+	if !hasHeader(httpHeaders, "Content-Type") {
+		httpHeaders.Set("Content-Type", contentType)
+	}
 	req.Header = httpHeaders
 
 	if bodyBytes != nil {
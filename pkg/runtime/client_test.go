@@ -177,6 +177,261 @@ func TestClient_CreateRequest_no_options_passed(t *testing.T) {
 	assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
 }
 
+func TestClient_CreateRequest_header_case(t *testing.T) {
+	params := RequestOptionsParameters{
+		Options: mockRequestOptions{
+			header: map[string]string{"x-api-KEY": "secret"},
+		},
+		RequestURL: "https://api.example.com/users",
+		Method:     "GET",
+	}
+
+	t.Run("canonicalizes header names by default", func(t *testing.T) {
+		client := &Client{}
+		req, err := client.CreateRequest(context.Background(), params)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"secret"}, req.Header["X-Api-Key"])
+		assert.NotContains(t, req.Header, "x-api-KEY")
+	})
+
+	t.Run("preserves header names", func(t *testing.T) {
+		client, err := NewAPIClient("https://api.example.com", WithPreserveHeaderCase())
+		require.NoError(t, err)
+
+		req, err := client.CreateRequest(context.Background(), params)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"secret"}, req.Header["x-api-KEY"])
+		assert.NotContains(t, req.Header, "X-Api-Key")
+		assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+	})
+
+	t.Run("doesn't duplicate default headers set in another case", func(t *testing.T) {
+		client, err := NewAPIClient("https://api.example.com",
+			WithPreserveHeaderCase(), WithUserAgent("petstore/1.0.0"), WithSpecVersion("1.0.0"))
+		require.NoError(t, err)
+
+		req, err := client.CreateRequest(context.Background(), RequestOptionsParameters{
+			Options: mockRequestOptions{header: map[string]string{
+				"user-agent":      "custom/2.0",
+				"x-spec-version":  "0.9.0",
+				"idempotency-key": "key-1",
+			}},
+			RequestURL:           "https://api.example.com/users",
+			Method:               "POST",
+			IdempotencyKeyHeader: "Idempotency-Key",
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"custom/2.0"}, req.Header["user-agent"])
+		assert.NotContains(t, req.Header, "User-Agent")
+		assert.NotContains(t, req.Header, "Idempotency-Key")
+		assert.NotContains(t, req.Header, SpecVersionHeader)
+		assert.Len(t, req.Header, 4)
+	})
+
+	t.Run("doesn't duplicate the content type set in another case", func(t *testing.T) {
+		client, err := NewAPIClient("https://api.example.com", WithPreserveHeaderCase())
+		require.NoError(t, err)
+
+		req, err := client.CreateRequest(context.Background(), RequestOptionsParameters{
+			Options: mockRequestOptions{
+				header: map[string]string{"content-type": "application/x-www-form-urlencoded"},
+				body:   map[string]any{"name": "Rex"},
+			},
+			RequestURL: "https://api.example.com/pets",
+			Method:     "POST",
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"application/x-www-form-urlencoded"}, req.Header["content-type"])
+		assert.NotContains(t, req.Header, "Content-Type")
+
+		body, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "name=Rex", string(body))
+	})
+}
+
+func TestClient_CreateRequest_user_agent(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     []APIClientOption
+		header   map[string]string
+		expected string
+	}{
+		{
+			name:     "no user agent",
+			expected: "",
+		},
+		{
+			name:     "sets user agent",
+			opts:     []APIClientOption{WithUserAgent("petstore/1.0.0")},
+			expected: "petstore/1.0.0",
+		},
+		{
+			name:     "last option wins",
+			opts:     []APIClientOption{WithUserAgent("petstore/1.0.0"), WithUserAgent("custom/2.0")},
+			expected: "custom/2.0",
+		},
+		{
+			name:     "request header takes precedence",
+			opts:     []APIClientOption{WithUserAgent("petstore/1.0.0")},
+			header:   map[string]string{"User-Agent": "from-options"},
+			expected: "from-options",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := NewAPIClient("https://api.example.com", tt.opts...)
+			require.NoError(t, err)
+
+			req, err := client.CreateRequest(context.Background(), RequestOptionsParameters{
+				Options:    mockRequestOptions{header: tt.header},
+				RequestURL: "https://api.example.com/users",
+				Method:     "GET",
+			})
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, req.Header.Get("User-Agent"))
+		})
+	}
+}
+
+func TestClient_CreateRequest_idempotency_key(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      []APIClientOption
+		keyHeader string
+		header    map[string]string
+		expected  string
+	}{
+		{
+			name:     "not requested",
+			opts:     []APIClientOption{WithIdempotencyKeyGenerator(func() string { return "key-1" })},
+			expected: "",
+		},
+		{
+			name:      "custom generator",
+			opts:      []APIClientOption{WithIdempotencyKeyGenerator(func() string { return "key-1" })},
+			keyHeader: "Idempotency-Key",
+			expected:  "key-1",
+		},
+		{
+			name:      "request header takes precedence",
+			opts:      []APIClientOption{WithIdempotencyKeyGenerator(func() string { return "key-1" })},
+			keyHeader: "Idempotency-Key",
+			header:    map[string]string{"Idempotency-Key": "from-options"},
+			expected:  "from-options",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := NewAPIClient("https://api.example.com", tt.opts...)
+			require.NoError(t, err)
+
+			req, err := client.CreateRequest(context.Background(), RequestOptionsParameters{
+				Options:              mockRequestOptions{header: tt.header},
+				RequestURL:           "https://api.example.com/payments",
+				Method:               "POST",
+				IdempotencyKeyHeader: tt.keyHeader,
+			})
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, req.Header.Get("Idempotency-Key"))
+		})
+	}
+
+	t.Run("default generator creates a key per request", func(t *testing.T) {
+		client, err := NewAPIClient("https://api.example.com")
+		require.NoError(t, err)
+
+		params := RequestOptionsParameters{RequestURL: "https://api.example.com/payments", Method: "POST", IdempotencyKeyHeader: "X-Request-Key"}
+		req1, err := client.CreateRequest(context.Background(), params)
+		require.NoError(t, err)
+		req2, err := client.CreateRequest(context.Background(), params)
+		require.NoError(t, err)
+
+		assert.Regexp(t, `^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`, req1.Header.Get("X-Request-Key"))
+		assert.NotEqual(t, req1.Header.Get("X-Request-Key"), req2.Header.Get("X-Request-Key"))
+	})
+}
+
+type validatingRequestOptions struct {
+	mockRequestOptions
+	err error
+}
+
+func (v *validatingRequestOptions) Validate() error { return v.err }
+
+func TestClient_CreateRequest_validation(t *testing.T) {
+	invalid := NewValidationErrorsFromString("PathParams.ID", "is required")
+
+	tests := []struct {
+		name          string
+		opts          []APIClientOption
+		options       RequestOptions
+		expectedError bool
+	}{
+		{
+			name:    "validation disabled",
+			options: &validatingRequestOptions{err: invalid},
+		},
+		{
+			name:          "invalid options",
+			opts:          []APIClientOption{WithRequestValidation()},
+			options:       &validatingRequestOptions{err: invalid},
+			expectedError: true,
+		},
+		{
+			name:    "valid options",
+			opts:    []APIClientOption{WithRequestValidation()},
+			options: &validatingRequestOptions{},
+		},
+		{
+			name:    "options without validation",
+			opts:    []APIClientOption{WithRequestValidation()},
+			options: mockRequestOptions{},
+		},
+		{
+			name: "no options",
+			opts: []APIClientOption{WithRequestValidation()},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := NewAPIClient("https://api.example.com", tt.opts...)
+			require.NoError(t, err)
+
+			req, err := client.CreateRequest(context.Background(), RequestOptionsParameters{
+				Options:    tt.options,
+				RequestURL: "https://api.example.com/users",
+				Method:     "GET",
+			})
+
+			if !tt.expectedError {
+				require.NoError(t, err)
+				assert.NotNil(t, req)
+				return
+			}
+
+			assert.Nil(t, req)
+			var validationErrs ValidationErrors
+			require.ErrorAs(t, err, &validationErrs)
+			assert.Equal(t, "PathParams.ID", validationErrs[0].Field)
+			assert.EqualError(t, err, "invalid request: PathParams.ID is required")
+		})
+	}
+
+	t.Run("typed nil options", func(t *testing.T) {
+		assert.NoError(t, validateRequestOptions((*validatingRequestOptions)(nil)))
+	})
+}
+
 func TestClient_ExecuteRequest(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -226,6 +481,162 @@ func TestClient_ExecuteRequest(t *testing.T) {
 	}
 }
 
+func TestClient_ExecuteStreamRequest(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockResponse   *http.Response
+		mockError      error
+		expectedError  bool
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "returns unread body",
+			mockResponse: &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader("binary content")),
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "binary content",
+		},
+		{
+			name: "nil body",
+			mockResponse: &http.Response{
+				StatusCode: http.StatusNoContent,
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:          "failed request",
+			mockError:     fmt.Errorf("network error"),
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &Client{
+				httpClient: &MockHttpRequestDoer{
+					response: tt.mockResponse,
+					err:      tt.mockError,
+				},
+			}
+
+			req, _ := http.NewRequest(http.MethodGet, "http://example.com", nil)
+			resp, err := client.ExecuteStreamRequest(context.Background(), req, "/test/{id}")
+
+			if tt.expectedError {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+			assert.Nil(t, resp.Content)
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedBody, string(body))
+			assert.NoError(t, resp.Body.Close())
+		})
+	}
+
+	t.Run("partial content", func(t *testing.T) {
+		client := &Client{httpClient: &MockHttpRequestDoer{response: &http.Response{
+			StatusCode: http.StatusPartialContent,
+			Header:     http.Header{"Content-Range": []string{"bytes 5-9/10"}},
+			Body:       io.NopCloser(strings.NewReader("12345")),
+		}}}
+		req, _ := http.NewRequest(http.MethodGet, "http://example.com", nil)
+
+		resp, err := client.ExecuteStreamRequest(context.Background(), req, "/test")
+		require.NoError(t, err)
+
+		partial, ok := resp.Body.(*PartialBody)
+		require.True(t, ok)
+		assert.Equal(t, ContentRange{Start: 5, End: 9, Size: 10}, partial.Range)
+	})
+
+	t.Run("partial content without range", func(t *testing.T) {
+		client := &Client{httpClient: &MockHttpRequestDoer{response: &http.Response{
+			StatusCode: http.StatusPartialContent,
+			Body:       io.NopCloser(strings.NewReader("12345")),
+		}}}
+		req, _ := http.NewRequest(http.MethodGet, "http://example.com", nil)
+
+		resp, err := client.ExecuteStreamRequest(context.Background(), req, "/test")
+		require.NoError(t, err)
+
+		_, ok := resp.Body.(*PartialBody)
+		assert.False(t, ok)
+	})
+
+	t.Run("nil response", func(t *testing.T) {
+		client := &Client{httpClient: &MockHttpRequestDoer{}}
+		req, _ := http.NewRequest(http.MethodGet, "http://example.com", nil)
+
+		resp, err := client.ExecuteStreamRequest(context.Background(), req, "/test")
+		assert.NoError(t, err)
+		assert.Nil(t, resp)
+	})
+}
+
+// bufferingAPIClient is an APIClient implemented before ExecuteStreamRequest.
+type bufferingAPIClient struct {
+	APIClient
+}
+
+func TestExecuteStreamRequest(t *testing.T) {
+	newClient := func() *Client {
+		return &Client{httpClient: &MockHttpRequestDoer{response: &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader("binary content")),
+		}}}
+	}
+	req, _ := http.NewRequest(http.MethodGet, "http://example.com", nil)
+
+	t.Run("streamed", func(t *testing.T) {
+		resp, err := ExecuteStreamRequest(context.Background(), newClient(), req, "/files")
+		require.NoError(t, err)
+		assert.Nil(t, resp.Content)
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "binary content", string(body))
+	})
+
+	t.Run("buffered without ExecuteStreamRequest", func(t *testing.T) {
+		resp, err := ExecuteStreamRequest(context.Background(), bufferingAPIClient{newClient()}, req, "/files")
+		require.NoError(t, err)
+		assert.Equal(t, "binary content", string(resp.Content))
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "binary content", string(body))
+		assert.NoError(t, resp.Close())
+	})
+
+	t.Run("buffered partial content", func(t *testing.T) {
+		client := &Client{httpClient: &MockHttpRequestDoer{response: &http.Response{
+			StatusCode: http.StatusPartialContent,
+			Header:     http.Header{"Content-Range": []string{"bytes 7-13/14"}},
+			Body:       io.NopCloser(strings.NewReader("content")),
+		}}}
+		resp, err := ExecuteStreamRequest(context.Background(), bufferingAPIClient{client}, req, "/files")
+		require.NoError(t, err)
+
+		partial, ok := resp.Body.(*PartialBody)
+		require.True(t, ok, "body is %T", resp.Body)
+		assert.Equal(t, ContentRange{Start: 7, End: 13, Size: 14}, partial.Range)
+
+		dst := &memoryFile{data: []byte("binary ")}
+		offset, err := ResumeDownload(dst, 7, func(RequestEditorFn) (io.ReadCloser, error) { return resp.Body, nil })
+		require.NoError(t, err)
+		assert.Equal(t, int64(14), offset)
+		assert.Equal(t, "binary content", string(dst.data))
+	})
+}
+
 func TestNewAPIClient(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -277,6 +688,22 @@ func TestWithRequestEditorFn(t *testing.T) {
 	assert.Len(t, client.requestEditors, 1)
 }
 
+func TestWithPreserveHeaderCase(t *testing.T) {
+	client := &Client{}
+
+	err := WithPreserveHeaderCase()(client)
+	assert.NoError(t, err)
+	assert.True(t, client.preserveHeaderCase)
+}
+
+func TestWithUserAgent(t *testing.T) {
+	client := &Client{}
+
+	err := WithUserAgent("petstore/1.0.0")(client)
+	assert.NoError(t, err)
+	assert.Equal(t, "petstore/1.0.0", client.userAgent)
+}
+
 func TestReplacePathPlaceholders(t *testing.T) {
 	tests := []struct {
 		name           string
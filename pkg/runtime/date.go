@@ -53,3 +53,8 @@ func (d *Date) UnmarshalText(data []byte) error {
 	d.Time = parsed
 	return nil
 }
+
+func (d Date) MarshalText() ([]byte, error) {
+	// nolint:staticcheck
+	return []byte(d.Time.Format(DateFormat)), nil
+}
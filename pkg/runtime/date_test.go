@@ -73,3 +73,14 @@ func TestDate_UnmarshalText(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, testDate, date.Time)
 }
+
+func TestDate_MarshalText(t *testing.T) {
+	d := Date{time.Date(2019, 4, 1, 0, 0, 0, 0, time.UTC)}
+	out, err := d.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "2019-04-01", string(out))
+
+	var parsed Date
+	assert.NoError(t, parsed.UnmarshalText(out))
+	assert.Equal(t, d, parsed)
+}
@@ -0,0 +1,55 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// DecimalNumber encodes a decimal type as a JSON number instead of a string.
+// It is generated for decimals with x-decimal-encoding: number, or format decimal on number schemas.
+// D is any decimal type whose String method returns its exact value, such as decimal.Decimal
+// from github.com/shopspring/decimal, and which unmarshals from JSON numbers.
+type DecimalNumber[D fmt.Stringer] struct {
+	Decimal D
+}
+
+// NewDecimalNumber wraps a decimal to be encoded as a JSON number.
+func NewDecimalNumber[D fmt.Stringer](d D) DecimalNumber[D] {
+	return DecimalNumber[D]{Decimal: d}
+}
+
+// String returns the string representation of the decimal.
+func (n DecimalNumber[D]) String() string {
+	return n.Decimal.String()
+}
+
+// MarshalJSON encodes the decimal as a JSON number.
+func (n DecimalNumber[D]) MarshalJSON() ([]byte, error) {
+	data := []byte(n.Decimal.String())
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil || dec.More() {
+		return nil, fmt.Errorf("decimal %q is not a JSON number", data)
+	}
+	if _, ok := v.(json.Number); !ok {
+		return nil, fmt.Errorf("decimal %q is not a JSON number", data)
+	}
+	return data, nil
+}
+
+// UnmarshalJSON decodes the decimal from a JSON number or string.
+func (n *DecimalNumber[D]) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &n.Decimal)
+}
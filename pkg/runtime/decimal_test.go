@@ -0,0 +1,60 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testDecimal keeps the exact text of a decimal, like arbitrary precision decimal types.
+type testDecimal struct {
+	value string
+}
+
+func (d testDecimal) String() string {
+	return d.value
+}
+
+func (d *testDecimal) UnmarshalJSON(data []byte) error {
+	d.value = strings.Trim(string(data), `"`)
+	return nil
+}
+
+func TestDecimalNumber(t *testing.T) {
+	t.Run("marshal as number", func(t *testing.T) {
+		res, err := json.Marshal(struct {
+			Amount DecimalNumber[testDecimal] `json:"amount"`
+		}{Amount: NewDecimalNumber(testDecimal{value: "12345678901234567890.123456789"})})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"amount":12345678901234567890.123456789}`, string(res))
+	})
+
+	t.Run("unmarshal number and string", func(t *testing.T) {
+		var n DecimalNumber[testDecimal]
+		require.NoError(t, json.Unmarshal([]byte(`0.1`), &n))
+		assert.Equal(t, "0.1", n.String())
+
+		require.NoError(t, json.Unmarshal([]byte(`"0.2"`), &n))
+		assert.Equal(t, "0.2", n.String())
+	})
+
+	t.Run("invalid number", func(t *testing.T) {
+		for _, value := range []string{"", "NaN", `"1"`, "1 2"} {
+			_, err := json.Marshal(NewDecimalNumber(testDecimal{value: value}))
+			assert.Error(t, err, value)
+		}
+	})
+}
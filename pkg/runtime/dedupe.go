@@ -0,0 +1,112 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// VolatileHeaders are the headers HashRequest leaves out, because they differ between submissions
+// of the same request: transport details, tracing and the generated idempotency key.
+var VolatileHeaders = []string{
+	"Content-Length",
+	"Date",
+	"Idempotency-Key",
+	"Traceparent",
+	"Tracestate",
+	"User-Agent",
+	"X-Request-Id",
+	SpecVersionHeader,
+}
+
+// HashRequest returns the canonical hash of a request, a hex encoded SHA-256 of the method, the path,
+// the query sorted by name, the headers sorted by name and the SHA-256 of the body.
+// VolatileHeaders and ignoreHeaders are left out, so two submissions of the same request hash the same
+// and middleware can use the hash to suppress duplicates.
+// The body is read through GetBody when set, otherwise it is buffered and restored.
+func HashRequest(req *http.Request, ignoreHeaders ...string) (string, error) {
+	body, err := requestBodyBytes(req)
+	if err != nil {
+		return "", fmt.Errorf("error reading request body: %w", err)
+	}
+	bodyHash := sha256.Sum256(body)
+
+	ignored := make(map[string]bool, len(VolatileHeaders)+len(ignoreHeaders))
+	for _, h := range append(slices.Clone(VolatileHeaders), ignoreHeaders...) {
+		ignored[http.CanonicalHeaderKey(h)] = true
+	}
+
+	names := make([]string, 0, len(req.Header))
+	values := make(map[string][]string, len(req.Header))
+	for k, v := range req.Header {
+		name := http.CanonicalHeaderKey(k)
+		if ignored[name] {
+			continue
+		}
+		if _, ok := values[name]; !ok {
+			names = append(names, name)
+		}
+		values[name] = append(values[name], v...)
+	}
+	slices.Sort(names)
+
+	var b strings.Builder
+	b.WriteString(strings.ToUpper(req.Method))
+	b.WriteByte('\n')
+	if req.URL != nil {
+		b.WriteString(req.URL.EscapedPath())
+		b.WriteByte('\n')
+		b.WriteString(req.URL.Query().Encode())
+	} else {
+		b.WriteByte('\n')
+	}
+	b.WriteByte('\n')
+	for _, name := range names {
+		b.WriteString(strings.ToLower(name))
+		b.WriteByte(':')
+		b.WriteString(strings.Join(values[name], ","))
+		b.WriteByte('\n')
+	}
+	b.WriteString(hex.EncodeToString(bodyHash[:]))
+
+	sum := sha256.Sum256([]byte(b.String()))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// requestBodyBytes returns the body of req without consuming it.
+func requestBodyBytes(req *http.Request) ([]byte, error) {
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer func() { _ = body.Close() }()
+		return io.ReadAll(body)
+	}
+
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	data, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
@@ -0,0 +1,124 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashRequest(t *testing.T) {
+	newRequest := func(t *testing.T, method, url, body string, header http.Header) *http.Request {
+		t.Helper()
+		var r io.Reader
+		if body != "" {
+			r = strings.NewReader(body)
+		}
+		req, err := http.NewRequest(method, url, r)
+		require.NoError(t, err)
+		for k, v := range header {
+			req.Header[k] = v
+		}
+		return req
+	}
+	hash := func(t *testing.T, req *http.Request, ignore ...string) string {
+		t.Helper()
+		h, err := HashRequest(req, ignore...)
+		require.NoError(t, err)
+		return h
+	}
+
+	base := newRequest(t, http.MethodPost, "https://api.example.com/orders?b=2&a=1", `{"id":1}`, http.Header{
+		"Content-Type": {"application/json"},
+	})
+	baseHash := hash(t, base)
+	assert.Len(t, baseHash, 64)
+
+	t.Run("same request hashes the same", func(t *testing.T) {
+		req := newRequest(t, http.MethodPost, "http://localhost/orders?a=1&b=2", `{"id":1}`, http.Header{
+			"Content-Type":    {"application/json"},
+			"Idempotency-Key": {"key-2"},
+			"User-Agent":      {"test"},
+			"X-Request-Id":    {"abc"},
+			"Traceparent":     {"00-abc-def-01"},
+		})
+		assert.Equal(t, baseHash, hash(t, req))
+	})
+
+	t.Run("ignored headers", func(t *testing.T) {
+		req := newRequest(t, http.MethodPost, "/orders?a=1&b=2", `{"id":1}`, http.Header{
+			"Content-Type":  {"application/json"},
+			"Authorization": {"Bearer token"},
+		})
+		assert.NotEqual(t, baseHash, hash(t, req))
+		assert.Equal(t, baseHash, hash(t, req, "authorization"))
+	})
+
+	t.Run("differences", func(t *testing.T) {
+		ct := http.Header{"Content-Type": {"application/json"}}
+		for name, req := range map[string]*http.Request{
+			"method": newRequest(t, http.MethodPut, "/orders?a=1&b=2", `{"id":1}`, ct),
+			"path":   newRequest(t, http.MethodPost, "/orders/1?a=1&b=2", `{"id":1}`, ct),
+			"query":  newRequest(t, http.MethodPost, "/orders?a=1&b=3", `{"id":1}`, ct),
+			"body":   newRequest(t, http.MethodPost, "/orders?a=1&b=2", `{"id":2}`, ct),
+			"header": newRequest(t, http.MethodPost, "/orders?a=1&b=2", `{"id":1}`, http.Header{"Content-Type": {"text/plain"}}),
+		} {
+			t.Run(name, func(t *testing.T) {
+				assert.NotEqual(t, baseHash, hash(t, req))
+			})
+		}
+	})
+
+	t.Run("body is kept", func(t *testing.T) {
+		req := newRequest(t, http.MethodPost, "/orders?a=1&b=2", "", http.Header{"Content-Type": {"application/json"}})
+		req.Body = io.NopCloser(strings.NewReader(`{"id":1}`))
+		assert.Equal(t, baseHash, hash(t, req))
+
+		data, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		assert.Equal(t, `{"id":1}`, string(data))
+
+		data, err = io.ReadAll(base.Body)
+		require.NoError(t, err)
+		assert.Equal(t, `{"id":1}`, string(data))
+	})
+
+	t.Run("no body", func(t *testing.T) {
+		req := newRequest(t, http.MethodGet, "/orders", "", nil)
+		assert.Equal(t, hash(t, newRequest(t, http.MethodGet, "/orders", "", nil)), hash(t, req))
+	})
+
+	t.Run("body error", func(t *testing.T) {
+		req := newRequest(t, http.MethodPost, "/orders", "", nil)
+		req.GetBody = func() (io.ReadCloser, error) {
+			return nil, errors.New("boom")
+		}
+		_, err := HashRequest(req)
+		assert.ErrorContains(t, err, "error reading request body: boom")
+
+		req = newRequest(t, http.MethodPost, "/orders", "", nil)
+		req.Body = io.NopCloser(io.MultiReader(strings.NewReader("partial"), &errorReader{}))
+		_, err = HashRequest(req)
+		require.Error(t, err)
+	})
+}
+
+type errorReader struct{}
+
+func (errorReader) Read([]byte) (int, error) {
+	return 0, errors.New("read failed")
+}
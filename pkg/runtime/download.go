@@ -0,0 +1,117 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// ContentRange is the byte range carried by the Content-Range header of a 206 Partial Content response.
+// Size is -1 when the server doesn't know the complete length.
+type ContentRange struct {
+	Start int64
+	End   int64
+	Size  int64
+}
+
+// ParseContentRange parses a Content-Range header value such as "bytes 200-999/1000".
+func ParseContentRange(s string) (ContentRange, error) {
+	spec, ok := strings.CutPrefix(s, "bytes ")
+	if !ok {
+		return ContentRange{}, fmt.Errorf("invalid content range %q: unsupported unit", s)
+	}
+
+	rng, size, ok := strings.Cut(spec, "/")
+	if !ok {
+		return ContentRange{}, fmt.Errorf("invalid content range %q: missing size", s)
+	}
+	first, last, ok := strings.Cut(rng, "-")
+	if !ok {
+		return ContentRange{}, fmt.Errorf("invalid content range %q: missing range", s)
+	}
+
+	var (
+		res ContentRange
+		err error
+	)
+	if res.Start, err = strconv.ParseInt(first, 10, 64); err != nil {
+		return ContentRange{}, fmt.Errorf("invalid content range %q: %w", s, err)
+	}
+	if res.End, err = strconv.ParseInt(last, 10, 64); err != nil {
+		return ContentRange{}, fmt.Errorf("invalid content range %q: %w", s, err)
+	}
+	if res.End < res.Start {
+		return ContentRange{}, fmt.Errorf("invalid content range %q: end before start", s)
+	}
+
+	res.Size = -1
+	if size != "*" {
+		if res.Size, err = strconv.ParseInt(size, 10, 64); err != nil {
+			return ContentRange{}, fmt.Errorf("invalid content range %q: %w", s, err)
+		}
+	}
+	return res, nil
+}
+
+// PartialBody is the body returned by streamed operations for 206 Partial Content responses.
+// Range tells which part of the resource the body holds.
+type PartialBody struct {
+	io.ReadCloser
+	Range ContentRange
+}
+
+// WithRange returns a RequestEditorFn requesting the bytes from start to end, inclusive.
+// A negative end requests everything from start to the end of the resource.
+func WithRange(start, end int64) RequestEditorFn {
+	return func(_ context.Context, req *http.Request) error {
+		value := "bytes=" + strconv.FormatInt(start, 10) + "-"
+		if end >= 0 {
+			value += strconv.FormatInt(end, 10)
+		}
+		req.Header.Set("Range", value)
+		return nil
+	}
+}
+
+// ResumeDownload continues a download into dst from offset.
+// download is called once with a Range editor that must be passed to the streamed operation.
+// If the server ignores the range and sends the whole resource, it is written from the beginning.
+// A 416 Range Not Satisfiable response means there is nothing left to download.
+// It returns the offset to resume from, which is also set when copying the body fails midway,
+// so an interrupted download can be continued by calling ResumeDownload again.
+func ResumeDownload(dst io.WriterAt, offset int64, download func(rangeEditor RequestEditorFn) (io.ReadCloser, error)) (int64, error) {
+	body, err := download(WithRange(offset, -1))
+	if err != nil {
+		var apiErr *ClientAPIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode() == http.StatusRequestedRangeNotSatisfiable {
+			return offset, nil
+		}
+		return offset, err
+	}
+	defer func() { _ = body.Close() }()
+
+	var start int64
+	if partial, ok := body.(*PartialBody); ok {
+		start = partial.Range.Start
+	}
+
+	n, err := io.Copy(io.NewOffsetWriter(dst, start), body)
+	if err != nil {
+		return start + n, fmt.Errorf("error copying download: %w", err)
+	}
+	return start + n, nil
+}
@@ -0,0 +1,162 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryFile is an in-memory io.WriterAt.
+type memoryFile struct {
+	data []byte
+}
+
+func (f *memoryFile) WriteAt(p []byte, off int64) (int, error) {
+	if end := int(off) + len(p); end > len(f.data) {
+		f.data = append(f.data, make([]byte, end-len(f.data))...)
+	}
+	return copy(f.data[off:], p), nil
+}
+
+// interruptedReader returns its content, then fails.
+type interruptedReader struct {
+	r io.Reader
+}
+
+func (r *interruptedReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if errors.Is(err, io.EOF) {
+		return n, errors.New("connection reset")
+	}
+	return n, err
+}
+
+func TestParseContentRange(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected ContentRange
+		err      string
+	}{
+		{name: "known size", value: "bytes 200-999/1000", expected: ContentRange{Start: 200, End: 999, Size: 1000}},
+		{name: "unknown size", value: "bytes 0-9/*", expected: ContentRange{Start: 0, End: 9, Size: -1}},
+		{name: "unsupported unit", value: "items 0-9/10", err: "unsupported unit"},
+		{name: "missing size", value: "bytes 0-9", err: "missing size"},
+		{name: "missing range", value: "bytes */10", err: "missing range"},
+		{name: "invalid start", value: "bytes a-9/10", err: "invalid syntax"},
+		{name: "invalid end", value: "bytes 0-b/10", err: "invalid syntax"},
+		{name: "end before start", value: "bytes 9-0/10", err: "end before start"},
+		{name: "invalid size", value: "bytes 0-9/c", err: "invalid syntax"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseContentRange(tt.value)
+			if tt.err != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestWithRange(t *testing.T) {
+	tests := []struct {
+		name       string
+		start, end int64
+		expected   string
+	}{
+		{name: "open ended", start: 100, end: -1, expected: "bytes=100-"},
+		{name: "closed", start: 0, end: 499, expected: "bytes=0-499"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodGet, "http://example.com", nil)
+			require.NoError(t, WithRange(tt.start, tt.end)(context.Background(), req))
+			assert.Equal(t, tt.expected, req.Header.Get("Range"))
+		})
+	}
+}
+
+func TestResumeDownload(t *testing.T) {
+	rangeOf := func(t *testing.T, editor RequestEditorFn) string {
+		t.Helper()
+		req, _ := http.NewRequest(http.MethodGet, "http://example.com", nil)
+		require.NoError(t, editor(context.Background(), req))
+		return req.Header.Get("Range")
+	}
+
+	t.Run("appends partial content at its range start", func(t *testing.T) {
+		dst := &memoryFile{data: []byte("hello")}
+		next, err := ResumeDownload(dst, 5, func(editor RequestEditorFn) (io.ReadCloser, error) {
+			assert.Equal(t, "bytes=5-", rangeOf(t, editor))
+			return &PartialBody{
+				ReadCloser: io.NopCloser(strings.NewReader(" world")),
+				Range:      ContentRange{Start: 5, End: 10, Size: 11},
+			}, nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(11), next)
+		assert.Equal(t, "hello world", string(dst.data))
+	})
+
+	t.Run("rewrites from the start when the range is ignored", func(t *testing.T) {
+		dst := &memoryFile{data: []byte("HELLO")}
+		next, err := ResumeDownload(dst, 5, func(RequestEditorFn) (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader("hello world")), nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(11), next)
+		assert.Equal(t, "hello world", string(dst.data))
+	})
+
+	t.Run("range not satisfiable means complete", func(t *testing.T) {
+		next, err := ResumeDownload(&memoryFile{}, 11, func(RequestEditorFn) (io.ReadCloser, error) {
+			return nil, NewClientAPIError(errors.New("range not satisfiable"), WithStatusCode(http.StatusRequestedRangeNotSatisfiable))
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(11), next)
+	})
+
+	t.Run("request error keeps the offset", func(t *testing.T) {
+		next, err := ResumeDownload(&memoryFile{}, 3, func(RequestEditorFn) (io.ReadCloser, error) {
+			return nil, errors.New("network error")
+		})
+
+		assert.EqualError(t, err, "network error")
+		assert.Equal(t, int64(3), next)
+	})
+
+	t.Run("interrupted copy returns the next offset", func(t *testing.T) {
+		dst := &memoryFile{}
+		next, err := ResumeDownload(dst, 0, func(RequestEditorFn) (io.ReadCloser, error) {
+			return io.NopCloser(&interruptedReader{r: strings.NewReader("hello")}), nil
+		})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "connection reset")
+		assert.Equal(t, int64(5), next)
+		assert.Equal(t, "hello", string(dst.data))
+	})
+}
@@ -0,0 +1,152 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidDuration is returned for values that are not ISO 8601 durations, or use years or months,
+// which have no fixed length.
+var ErrInvalidDuration = errors.New("invalid ISO 8601 duration")
+
+// Duration is a string with format duration, an ISO 8601 duration such as "PT1H30M" or "P2D".
+// Days are 24 hours and weeks 7 days.
+type Duration struct {
+	time.Duration
+}
+
+// ParseDuration parses an ISO 8601 duration, with an optional leading sign.
+func ParseDuration(s string) (Duration, error) {
+	in := s
+	neg := false
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		neg = s[0] == '-'
+		s = s[1:]
+	}
+	if !strings.HasPrefix(s, "P") || len(s) < 2 || strings.HasSuffix(s, "T") {
+		return Duration{}, fmt.Errorf("%w: %q", ErrInvalidDuration, in)
+	}
+	s = s[1:]
+
+	var (
+		total  float64
+		inTime bool
+		units  = "WD"
+	)
+	for s != "" {
+		if s[0] == 'T' {
+			if inTime {
+				return Duration{}, fmt.Errorf("%w: %q", ErrInvalidDuration, in)
+			}
+			inTime = true
+			units = "HMS"
+			s = s[1:]
+			continue
+		}
+
+		i := strings.IndexFunc(s, func(r rune) bool {
+			return (r < '0' || r > '9') && r != '.' && r != ','
+		})
+		if i <= 0 {
+			return Duration{}, fmt.Errorf("%w: %q", ErrInvalidDuration, in)
+		}
+		n, err := strconv.ParseFloat(strings.Replace(s[:i], ",", ".", 1), 64)
+		if err != nil {
+			return Duration{}, fmt.Errorf("%w: %q", ErrInvalidDuration, in)
+		}
+
+		// units must be in order and appear once
+		unit := s[i]
+		pos := strings.IndexByte(units, unit)
+		if pos < 0 {
+			return Duration{}, fmt.Errorf("%w: %q", ErrInvalidDuration, in)
+		}
+		units = units[pos+1:]
+
+		switch {
+		case !inTime && unit == 'W':
+			total += n * float64(7*24*time.Hour)
+		case !inTime && unit == 'D':
+			total += n * float64(24*time.Hour)
+		case unit == 'H':
+			total += n * float64(time.Hour)
+		case unit == 'M':
+			total += n * float64(time.Minute)
+		case unit == 'S':
+			total += n * float64(time.Second)
+		}
+		s = s[i+1:]
+	}
+
+	if neg {
+		total = -total
+	}
+	return Duration{Duration: time.Duration(math.Round(total))}, nil
+}
+
+// String formats the duration in hours, minutes and seconds, e.g. "PT36H0.5S".
+func (d Duration) String() string {
+	v := d.Duration
+	if v == 0 {
+		return "PT0S"
+	}
+
+	var b strings.Builder
+	if v < 0 {
+		b.WriteByte('-')
+		v = -v
+	}
+	b.WriteString("PT")
+	if h := v / time.Hour; h > 0 {
+		b.WriteString(strconv.FormatInt(int64(h), 10) + "H")
+		v -= h * time.Hour
+	}
+	if m := v / time.Minute; m > 0 {
+		b.WriteString(strconv.FormatInt(int64(m), 10) + "M")
+		v -= m * time.Minute
+	}
+	if v > 0 {
+		b.WriteString(strconv.FormatFloat(v.Seconds(), 'f', -1, 64) + "S")
+	}
+	return b.String()
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return d.UnmarshalText([]byte(s))
+}
+
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *Duration) UnmarshalText(data []byte) error {
+	parsed, err := ParseDuration(string(data))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
@@ -0,0 +1,99 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		in   string
+		want time.Duration
+	}{
+		{in: "PT0S", want: 0},
+		{in: "PT1H30M", want: 90 * time.Minute},
+		{in: "P2D", want: 48 * time.Hour},
+		{in: "P1W", want: 7 * 24 * time.Hour},
+		{in: "P1DT12H", want: 36 * time.Hour},
+		{in: "PT0.5S", want: 500 * time.Millisecond},
+		{in: "PT1,5S", want: 1500 * time.Millisecond},
+		{in: "PT0.000000001S", want: time.Nanosecond},
+		{in: "-PT5M", want: -5 * time.Minute},
+		{in: "+PT5M", want: 5 * time.Minute},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			d, err := ParseDuration(tt.in)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, d.Duration)
+		})
+	}
+
+	for _, in := range []string{"", "P", "PT", "P1DT", "1H", "P1Y", "P1M", "PT1M1H", "PT1H1H", "PTH", "P1.2.3D", "PT1HT1M"} {
+		t.Run("invalid "+in, func(t *testing.T) {
+			_, err := ParseDuration(in)
+			assert.ErrorIs(t, err, ErrInvalidDuration)
+		})
+	}
+}
+
+func TestDuration_String(t *testing.T) {
+	tests := []struct {
+		in   time.Duration
+		want string
+	}{
+		{in: 0, want: "PT0S"},
+		{in: 90 * time.Minute, want: "PT1H30M"},
+		{in: 36*time.Hour + 500*time.Millisecond, want: "PT36H0.5S"},
+		{in: -5 * time.Second, want: "-PT5S"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			d := Duration{tt.in}
+			assert.Equal(t, tt.want, d.String())
+
+			parsed, err := ParseDuration(d.String())
+			require.NoError(t, err)
+			assert.Equal(t, d, parsed)
+		})
+	}
+}
+
+func TestDuration_JSON(t *testing.T) {
+	b := struct {
+		Timeout Duration `json:"timeout"`
+	}{}
+	require.NoError(t, json.Unmarshal([]byte(`{"timeout":"P1DT2H"}`), &b))
+	assert.Equal(t, 26*time.Hour, b.Timeout.Duration)
+
+	out, err := json.Marshal(b)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"timeout":"PT26H"}`, string(out))
+
+	assert.ErrorIs(t, json.Unmarshal([]byte(`{"timeout":"1h"}`), &b), ErrInvalidDuration)
+	assert.Error(t, json.Unmarshal([]byte(`{"timeout":60}`), &b))
+}
+
+func TestDuration_Text(t *testing.T) {
+	var d Duration
+	require.NoError(t, d.UnmarshalText([]byte("PT15M")))
+	assert.Equal(t, 15*time.Minute, d.Duration)
+
+	out, err := d.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "PT15M", string(out))
+}
@@ -73,10 +73,10 @@ func (t *Either[A, B]) UnmarshalJSON(data []byte) error {
 	}
 
 	var a A
-	errA := json.Unmarshal(data, &a)
+	errA := unmarshalUnionVariant(data, &a)
 
 	var b B
-	errB := json.Unmarshal(data, &b)
+	errB := unmarshalUnionVariant(data, &b)
 
 	switch {
 	case errA == nil && errB != nil:
@@ -141,6 +141,17 @@ func (t *Either[A, B]) UnmarshalJSON(data []byte) error {
 	}
 }
 
+// UnmarshalVariant decodes data as the variant at the 1-based position n and holds it.
+func (t *Either[A, B]) UnmarshalVariant(n int, data []byte) error {
+	var res Either[A, B]
+	if err := unmarshalVariantAt(n, data, &res.A, &res.B); err != nil {
+		return err
+	}
+	res.N = n
+	*t = res
+	return nil
+}
+
 func (t *Either[A, B]) Validate() error {
 	if t.IsA() {
 		// Check if A implements Validate() error
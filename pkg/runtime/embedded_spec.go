@@ -0,0 +1,181 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"html/template"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// EmbeddedSpec is the JSON of a spec embedded in generated code with generate.embed-spec, gzipped and base64 encoded
+// in parts. It is decoded once, the first time it is used.
+type EmbeddedSpec struct {
+	parts  []string
+	decode func() ([]byte, error)
+}
+
+// NewEmbeddedSpec returns the spec encoded in parts.
+func NewEmbeddedSpec(parts []string) *EmbeddedSpec {
+	s := &EmbeddedSpec{parts: parts}
+	s.decode = sync.OnceValues(s.decodeParts)
+	return s
+}
+
+// JSON returns a copy of the JSON of the spec.
+func (s *EmbeddedSpec) JSON() ([]byte, error) {
+	spec, err := s.decode()
+	if err != nil {
+		return nil, err
+	}
+	return bytes.Clone(spec), nil
+}
+
+// ServeHTTP serves the JSON of the spec, answering conditional and range requests.
+func (s *EmbeddedSpec) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	spec, err := s.decode()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(spec))
+}
+
+func (s *EmbeddedSpec) decodeParts() ([]byte, error) {
+	compressed, err := base64.StdEncoding.DecodeString(strings.Join(s.parts, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error decoding embedded spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing embedded spec: %w", err)
+	}
+	spec, err := io.ReadAll(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing embedded spec: %w", err)
+	}
+	return spec, nil
+}
+
+// DefaultSpecUIAssetsURL is the CDN the assets of the documentation pages are loaded from,
+// serving the files of npm packages under <package>@<version>/.
+const DefaultSpecUIAssetsURL = "https://unpkg.com"
+
+// specUIAssets are the paths of the assets of the documentation pages by name, pinned to exact versions
+// so that pages don't change with new releases.
+var specUIAssets = map[string][]string{
+	"swagger-ui": {"swagger-ui-dist@5.17.14/swagger-ui.css", "swagger-ui-dist@5.17.14/swagger-ui-bundle.js"},
+	"redoc":      {"redoc@2.1.5/bundles/redoc.standalone.js"},
+}
+
+// specUIPages are the documentation pages of SpecUIHandler by name.
+var specUIPages = map[string]*template.Template{
+	"swagger-ui": template.Must(template.New("swagger-ui").Parse(`<!DOCTYPE html>
+<html>
+<head>
+  <meta charset="utf-8">
+  <title>{{.Title}}</title>
+  {{with index .Assets 0}}<link rel="stylesheet" href="{{.URL}}"{{with .Integrity}} integrity="{{.}}"{{end}} crossorigin="anonymous">{{end}}
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  {{with index .Assets 1}}<script src="{{.URL}}"{{with .Integrity}} integrity="{{.}}"{{end}} crossorigin="anonymous"></script>{{end}}
+  <script>
+    window.ui = SwaggerUIBundle({url: {{.SpecURL}}, dom_id: "#swagger-ui"});
+  </script>
+</body>
+</html>
+`)),
+	"redoc": template.Must(template.New("redoc").Parse(`<!DOCTYPE html>
+<html>
+<head>
+  <meta charset="utf-8">
+  <title>{{.Title}}</title>
+</head>
+<body>
+  <redoc spec-url="{{.SpecURL}}"></redoc>
+  {{with index .Assets 0}}<script src="{{.URL}}"{{with .Integrity}} integrity="{{.}}"{{end}} crossorigin="anonymous"></script>{{end}}
+</body>
+</html>
+`)),
+}
+
+// specUIAsset is a stylesheet or script of a documentation page.
+type specUIAsset struct {
+	URL       string
+	Integrity string
+}
+
+// specUIConfig is configured by the SpecUIOptions of SpecUIHandler.
+type specUIConfig struct {
+	assetsURL string
+	integrity map[string]string
+}
+
+// SpecUIOption configures the documentation page of SpecUIHandler.
+type SpecUIOption func(*specUIConfig)
+
+// WithSpecUIAssetsURL loads the assets of the page from baseURL instead of DefaultSpecUIAssetsURL,
+// e.g. an internal mirror of the npm packages.
+func WithSpecUIAssetsURL(baseURL string) SpecUIOption {
+	return func(c *specUIConfig) {
+		c.assetsURL = strings.TrimSuffix(baseURL, "/")
+	}
+}
+
+// WithSpecUIIntegrity sets the Subresource Integrity hashes of the assets of the page by path,
+// e.g. "swagger-ui-dist@5.17.14/swagger-ui-bundle.js": "sha384-...", so browsers refuse modified files.
+func WithSpecUIIntegrity(integrity map[string]string) SpecUIOption {
+	return func(c *specUIConfig) {
+		c.integrity = integrity
+	}
+}
+
+// SpecUIHandler returns an http.Handler serving a documentation page of the spec served at specURL:
+// "swagger-ui" for Swagger UI, or "redoc" for Redoc. Its assets are loaded from DefaultSpecUIAssetsURL
+// unless set otherwise with WithSpecUIAssetsURL.
+func SpecUIHandler(ui, title, specURL string, opts ...SpecUIOption) http.Handler {
+	tmpl, ok := specUIPages[ui]
+	if !ok {
+		panic(fmt.Sprintf("unknown spec UI %q, expected swagger-ui or redoc", ui))
+	}
+
+	cfg := specUIConfig{assetsURL: DefaultSpecUIAssetsURL}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	var assets []specUIAsset
+	for _, path := range specUIAssets[ui] {
+		assets = append(assets, specUIAsset{URL: cfg.assetsURL + "/" + path, Integrity: cfg.integrity[path]})
+	}
+
+	var page bytes.Buffer
+	data := struct {
+		Title, SpecURL string
+		Assets         []specUIAsset
+	}{title, specURL, assets}
+	if err := tmpl.Execute(&page, data); err != nil {
+		panic(fmt.Sprintf("error rendering the %s page: %v", ui, err))
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = w.Write(page.Bytes())
+	})
+}
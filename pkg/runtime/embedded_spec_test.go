@@ -0,0 +1,98 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encodeSpec(t *testing.T, spec string) []string {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	_, err := zw.Write([]byte(spec))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	encoded := base64.StdEncoding.EncodeToString(buf.Bytes())
+	return []string{encoded[:10], encoded[10:]}
+}
+
+func TestEmbeddedSpec(t *testing.T) {
+	spec := NewEmbeddedSpec(encodeSpec(t, `{"openapi":"3.0.0"}`))
+
+	res, err := spec.JSON()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"openapi":"3.0.0"}`, string(res))
+
+	// the decoded spec is not shared with callers
+	res[0] = '['
+	res, err = spec.JSON()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"openapi":"3.0.0"}`, string(res))
+
+	w := httptest.NewRecorder()
+	spec.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"openapi":"3.0.0"}`, w.Body.String())
+
+	t.Run("invalid", func(t *testing.T) {
+		spec := NewEmbeddedSpec([]string{"not base64!"})
+
+		_, err := spec.JSON()
+		assert.ErrorContains(t, err, "error decoding embedded spec")
+
+		w := httptest.NewRecorder()
+		spec.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestSpecUIHandler(t *testing.T) {
+	for _, ui := range []string{"swagger-ui", "redoc"} {
+		t.Run(ui, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			SpecUIHandler(ui, "Pets <API>", "/openapi.json").ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/docs", nil))
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, "text/html; charset=utf-8", w.Header().Get("Content-Type"))
+			assert.Contains(t, w.Body.String(), "<title>Pets &lt;API&gt;</title>")
+			assert.Contains(t, w.Body.String(), "/openapi.json")
+		})
+	}
+
+	t.Run("pinned assets", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		SpecUIHandler("swagger-ui", "Pets", "/openapi.json").ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/docs", nil))
+
+		assert.Contains(t, w.Body.String(), `<script src="https://unpkg.com/swagger-ui-dist@5.17.14/swagger-ui-bundle.js" crossorigin="anonymous"></script>`)
+	})
+
+	t.Run("mirror and integrity", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		SpecUIHandler("redoc", "Pets", "/openapi.json",
+			WithSpecUIAssetsURL("https://npm.example.com/"),
+			WithSpecUIIntegrity(map[string]string{"redoc@2.1.5/bundles/redoc.standalone.js": "sha384-abc"}),
+		).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/docs", nil))
+
+		assert.Contains(t, w.Body.String(), `<script src="https://npm.example.com/redoc@2.1.5/bundles/redoc.standalone.js" integrity="sha384-abc" crossorigin="anonymous"></script>`)
+	})
+
+	assert.Panics(t, func() { SpecUIHandler("rapidoc", "Pets", "/openapi.json") })
+}
@@ -30,7 +30,7 @@ func EncodeFormFields(data any, encoding map[string]FieldEncoding) (string, erro
 	values := url.Values{}
 
 	// Marshal input to map[string]any
-	b, err := json.Marshal(data)
+	b, err := MarshalJSONForRequest(data)
 	if err != nil {
 		return "", err
 	}
@@ -12,14 +12,23 @@ package runtime
 
 import (
 	"fmt"
+	"maps"
 	"net/url"
+	"reflect"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
 )
 
 type QueryEncoding struct {
 	Style   string
 	Explode *bool
+
+	// TimeFormat formats the date-time values of the parameter, written in RFC 3339 by default:
+	// unix for epoch seconds, unixmilli for epoch milliseconds, or a time.Format layout such as 2006-01-02.
+	TimeFormat string
 }
 
 // queryPair represents a key-value pair for query string encoding.
@@ -34,11 +43,13 @@ type queryPair struct {
 // EncodeQueryFields builds a query string for query params per OAS 3.1 style matrix.
 //
 // Arrays (name=expand, vals=[a,b]):
-// - form, explode=true     => expand=a&expand=b
-// - form, explode=false    => expand=a,b
-// - spaceDelimited         => expand=a%20b
-// - pipeDelimited          => expand=a%7Cb
-// - deepObject (custom)    => expand%5B%5D=a&expand%5B%5D=b
+// - form, explode=true           => expand=a&expand=b
+// - form, explode=false          => expand=a,b
+// - spaceDelimited               => expand=a%20b
+// - spaceDelimited, explode=true => expand=a&expand=b
+// - pipeDelimited                => expand=a%7Cb
+// - pipeDelimited, explode=true  => expand=a&expand=b
+// - deepObject (custom)          => expand%5B%5D=a&expand%5B%5D=b
 //
 // Objects (name=color, vals={R:100,G:200,B:150}):
 // - form, explode=true     => R=100&G=200&B=150
@@ -47,27 +58,24 @@ type queryPair struct {
 // - pipeDelimited          => color=R%7C100%7CG%7C200%7CB%7C150
 // - deepObject (spec)      => color%5BR%5D=100&color%5BG%5D=200&color%5BB%5D=150
 //
+// deepObject also serializes nested values: objects add another bracketed key
+// (filter[color][R]=100), arrays of scalars repeat a [] suffixed key (filter[ids][]=1)
+// and containers inside arrays are keyed by their index (filter[items][0][id]=1).
+// Other styles return ErrNestedQueryValue for nested arrays and objects.
+//
 // Scalars (name=x, val=v): always x=v (style choice irrelevant).
+// Numbers are written in plain decimal notation, so 1000000 never becomes 1e+06.
+// Date-time values, and arrays of them, are reformatted with the TimeFormat of the parameter.
 func EncodeQueryFields(data any, encoding map[string]QueryEncoding) (string, error) {
 	m, ok := data.(map[string]any)
 	if !ok {
 		return "", ErrMustBeMap
 	}
 
-	keys := make([]string, 0, len(m))
-	for k := range m {
-		keys = append(keys, k)
-	}
-	sort.Strings(keys)
-
 	var pairs []queryPair
 
-	for _, name := range keys {
-		val := m[name]
-		enc := QueryEncoding{}
-		if encoding != nil {
-			enc = encoding[name]
-		}
+	for _, name := range slices.Sorted(maps.Keys(m)) {
+		enc := encoding[name]
 
 		style := strings.ToLower(enc.Style)
 		if style == "" {
@@ -75,118 +83,124 @@ func EncodeQueryFields(data any, encoding map[string]QueryEncoding) (string, err
 		}
 		explode := defaultExplode(style, enc.Explode)
 
-		if obj, isObj, err := toStringMap(val); err != nil {
-			return "", fmt.Errorf("param %q: %w", name, err)
-		} else if isObj {
-			propKeys := make([]string, 0, len(obj))
-			for k := range obj {
-				propKeys = append(propKeys, k)
-			}
-			sort.Strings(propKeys)
-
-			switch style {
-			case "form":
-				if explode {
-					for _, k := range propKeys {
-						pairs = append(pairs, queryPair{key: k, value: obj[k]})
-					}
-				} else {
-					// For explode=false, we need to encode each value individually,
-					// then join with unescaped comma delimiter.
-					// This ensures commas within values are escaped, but delimiter commas are not.
-					pairs = append(pairs, queryPair{
-						key:        name,
-						value:      joinWithDelimiter(flattenMap(propKeys, obj), ","),
-						preEncoded: true,
-					})
-				}
-			case "spacedelimited":
-				// Space delimiter should be encoded as %20
-				pairs = append(pairs, queryPair{
-					key:        name,
-					value:      joinWithDelimiter(flattenMap(propKeys, obj), "%20"),
-					preEncoded: true,
-				})
-			case "pipedelimited":
-				// Pipe delimiter should be encoded as %7C
-				pairs = append(pairs, queryPair{
-					key:        name,
-					value:      joinWithDelimiter(flattenMap(propKeys, obj), "%7C"),
-					preEncoded: true,
-				})
-			case "deepobject":
-				for _, k := range propKeys {
-					pairs = append(pairs, queryPair{key: name + "[" + k + "]", value: obj[k]})
-				}
-			default:
-				return "", fmt.Errorf("param %q: unsupported style %q for object", name, style)
+		val := m[name]
+		if enc.TimeFormat != "" {
+			formatted, err := formatQueryTimes(val, enc.TimeFormat)
+			if err != nil {
+				return "", fmt.Errorf("param %q: %w", name, err)
 			}
-			continue
+			val = formatted
 		}
 
-		ss, isArray, err := toStringSlice(val)
+		var (
+			encoded []queryPair
+			err     error
+		)
+		if style == "deepobject" {
+			encoded = encodeQueryDeepObject(name, val)
+		} else {
+			encoded, err = encodeDelimited(name, val, style, explode)
+		}
 		if err != nil {
 			return "", fmt.Errorf("param %q: %w", name, err)
 		}
+		pairs = append(pairs, encoded...)
+	}
 
-		switch style {
-		case "form":
-			if isArray {
-				if explode {
-					for _, v := range ss {
-						pairs = append(pairs, queryPair{key: name, value: v})
-					}
-				} else {
-					// For explode=false, we need to encode each value individually,
-					// then join with unescaped comma delimiter.
-					// This ensures commas within values are escaped, but delimiter commas are not.
-					pairs = append(pairs, queryPair{
-						key:        name,
-						value:      joinWithDelimiter(ss, ","),
-						preEncoded: true,
-					})
-				}
-			} else {
-				pairs = append(pairs, queryPair{key: name, value: ss[0]})
-			}
-		case "spacedelimited":
-			if isArray {
-				// Space delimiter should be encoded as %20
-				pairs = append(pairs, queryPair{
-					key:        name,
-					value:      joinWithDelimiter(ss, "%20"),
-					preEncoded: true,
-				})
-			} else {
-				pairs = append(pairs, queryPair{key: name, value: ss[0]})
-			}
-		case "pipedelimited":
-			if isArray {
-				// Pipe delimiter should be encoded as %7C
-				pairs = append(pairs, queryPair{
-					key:        name,
-					value:      joinWithDelimiter(ss, "%7C"),
-					preEncoded: true,
-				})
-			} else {
-				pairs = append(pairs, queryPair{key: name, value: ss[0]})
+	return buildQueryString(pairs), nil
+}
+
+// queryDelimiters maps the delimited styles to their already encoded delimiter.
+// Commas are left unescaped per OpenAPI spec, space and pipe are encoded as %20 and %7C.
+var queryDelimiters = map[string]string{
+	"form":           ",",
+	"spacedelimited": "%20",
+	"pipedelimited":  "%7C",
+}
+
+// encodeDelimited encodes a parameter using the form, spaceDelimited or pipeDelimited style.
+func encodeDelimited(name string, val any, style string, explode bool) ([]queryPair, error) {
+	delimiter, ok := queryDelimiters[style]
+	if !ok {
+		return nil, fmt.Errorf("unsupported style %q", style)
+	}
+
+	obj, isObj, err := toStringMap(val)
+	if err != nil {
+		return nil, err
+	}
+	if isObj {
+		propKeys := slices.Sorted(maps.Keys(obj))
+		if style == "form" && explode {
+			pairs := make([]queryPair, 0, len(propKeys))
+			for _, k := range propKeys {
+				pairs = append(pairs, queryPair{key: k, value: obj[k]})
 			}
-		case "deepobject":
-			// Not defined for arrays in OAS. Custom bracketed repeat as discussed.
-			br := name + "[]"
-			if isArray {
-				for _, v := range ss {
-					pairs = append(pairs, queryPair{key: br, value: v})
-				}
+			return pairs, nil
+		}
+		// Each value is encoded individually and joined with the unescaped delimiter.
+		// This ensures delimiters within values are escaped, but the delimiter itself is not.
+		return []queryPair{{
+			key:        name,
+			value:      joinWithDelimiter(flattenMap(propKeys, obj), delimiter),
+			preEncoded: true,
+		}}, nil
+	}
+
+	ss, isArray, err := toStringSlice(val)
+	if err != nil {
+		return nil, err
+	}
+	if !isArray {
+		return []queryPair{{key: name, value: ss[0]}}, nil
+	}
+	if explode {
+		pairs := make([]queryPair, 0, len(ss))
+		for _, v := range ss {
+			pairs = append(pairs, queryPair{key: name, value: v})
+		}
+		return pairs, nil
+	}
+	return []queryPair{{key: name, value: joinWithDelimiter(ss, delimiter), preEncoded: true}}, nil
+}
+
+// encodeQueryDeepObject encodes a parameter using the deepObject style, recursing into nested values.
+// Arrays are not defined for deepObject in OAS, so scalars in arrays and top-level scalars
+// are written with a custom [] suffix.
+func encodeQueryDeepObject(prefix string, val any) []queryPair {
+	if s, ok := scalarString(val); ok {
+		return []queryPair{{key: prefix + "[]", value: s}}
+	}
+
+	var pairs []queryPair
+	rv := reflect.Indirect(reflect.ValueOf(val))
+
+	if rv.Kind() == reflect.Map {
+		keys := make(map[string]reflect.Value, rv.Len())
+		for _, k := range rv.MapKeys() {
+			keys[fmt.Sprint(k.Interface())] = k
+		}
+		for _, k := range slices.Sorted(maps.Keys(keys)) {
+			v := rv.MapIndex(keys[k]).Interface()
+			key := prefix + "[" + k + "]"
+			if s, ok := scalarString(v); ok {
+				pairs = append(pairs, queryPair{key: key, value: s})
 			} else {
-				pairs = append(pairs, queryPair{key: br, value: ss[0]})
+				pairs = append(pairs, encodeQueryDeepObject(key, v)...)
 			}
-		default:
-			return "", fmt.Errorf("param %q: unsupported style %q", name, style)
 		}
+		return pairs
 	}
 
-	return buildQueryString(pairs), nil
+	for i := range rv.Len() {
+		v := rv.Index(i).Interface()
+		if s, ok := scalarString(v); ok {
+			pairs = append(pairs, queryPair{key: prefix + "[]", value: s})
+		} else {
+			pairs = append(pairs, encodeQueryDeepObject(prefix+"["+strconv.Itoa(i)+"]", v)...)
+		}
+	}
+	return pairs
 }
 
 // joinWithDelimiter encodes each value individually and joins them with the given delimiter.
@@ -238,63 +252,120 @@ func defaultExplode(style string, ptr *bool) bool {
 	return style == "form"
 }
 
-func toStringSlice(v any) ([]string, bool, error) {
+// scalarString formats a scalar value for a query string.
+// It reports false for arrays and objects, which need a style-specific encoding.
+func scalarString(v any) (string, bool) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return "", true
+		}
+		return scalarString(rv.Elem().Interface())
+	}
+
 	switch t := v.(type) {
 	case nil:
-		return []string{""}, false, nil
+		return "", true
 	case string:
-		return []string{t}, false, nil
+		return t, true
 	case fmt.Stringer:
-		return []string{t.String()}, false, nil
-	case bool:
-		if t {
-			return []string{"true"}, false, nil
-		}
-		return []string{"false"}, false, nil
-	case int, int8, int16, int32, int64:
-		return []string{fmt.Sprintf("%d", t)}, false, nil
-	case uint, uint8, uint16, uint32, uint64:
-		return []string{fmt.Sprintf("%d", t)}, false, nil
-	case float32, float64:
-		return []string{fmt.Sprintf("%v", t)}, false, nil
-	case []string:
-		cp := make([]string, len(t))
-		copy(cp, t)
-		return cp, true, nil
-	case []any:
-		out := make([]string, len(t))
-		for i, e := range t {
-			ss, _, err := toStringSlice(e)
-			if err != nil {
-				return nil, false, err
-			}
-			out[i] = ss[0]
-		}
-		return out, true, nil
+		return t.String(), true
+	}
+
+	switch rv.Kind() {
+	case reflect.String:
+		return rv.String(), true
+	case reflect.Bool:
+		return strconv.FormatBool(rv.Bool()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10), true
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'f', -1, rv.Type().Bits()), true
+	case reflect.Map, reflect.Slice, reflect.Array:
+		return "", false
 	default:
-		return []string{fmt.Sprintf("%v", t)}, false, nil
+		return fmt.Sprintf("%v", v), true
+	}
+}
+
+// toStringSlice formats a scalar or an array of scalars, reporting whether v is an array.
+// Objects must be handled by toStringMap first.
+func toStringSlice(v any) ([]string, bool, error) {
+	if s, ok := scalarString(v); ok {
+		return []string{s}, false, nil
 	}
+
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	out := make([]string, rv.Len())
+	for i := range out {
+		s, ok := scalarString(rv.Index(i).Interface())
+		if !ok {
+			return nil, false, ErrNestedQueryValue
+		}
+		out[i] = s
+	}
+	return out, true, nil
 }
 
+// toStringMap formats an object of scalars, reporting whether v is an object.
 func toStringMap(v any) (map[string]string, bool, error) {
-	switch t := v.(type) {
-	case map[string]string:
-		cp := make(map[string]string, len(t))
-		for k, v := range t {
-			cp[k] = v
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Map {
+		return nil, false, nil
+	}
+
+	out := make(map[string]string, rv.Len())
+	for _, k := range rv.MapKeys() {
+		s, ok := scalarString(rv.MapIndex(k).Interface())
+		if !ok {
+			return nil, false, ErrNestedQueryValue
 		}
-		return cp, true, nil
-	case map[string]any:
-		out := make(map[string]string, len(t))
-		for k, vv := range t {
-			ss, _, err := toStringSlice(vv)
+		out[fmt.Sprint(k.Interface())] = s
+	}
+	return out, true, nil
+}
+
+// formatQueryTimes formats a date-time value, or each element of an array of them, with a TimeFormat.
+// Values are RFC 3339 strings when the parameters are converted with AsMap.
+func formatQueryTimes(val any, format string) (any, error) {
+	switch v := val.(type) {
+	case []any:
+		out := make([]any, len(v))
+		for i, elem := range v {
+			formatted, err := formatQueryTimes(elem, format)
 			if err != nil {
-				return nil, false, err
+				return nil, err
 			}
-			out[k] = ss[0]
+			out[i] = formatted
 		}
-		return out, true, nil
-	default:
-		return nil, false, nil
+		return out, nil
+	case string:
+		t, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing date-time %q: %w", v, err)
+		}
+		return formatQueryTime(t, format), nil
+	case time.Time:
+		return formatQueryTime(v, format), nil
+	case *time.Time:
+		if v == nil {
+			return val, nil
+		}
+		return formatQueryTime(*v, format), nil
+	}
+	return val, nil
+}
+
+func formatQueryTime(t time.Time, format string) string {
+	switch format {
+	case "unix":
+		return strconv.FormatInt(t.Unix(), 10)
+	case "unixmilli":
+		return strconv.FormatInt(t.UnixMilli(), 10)
+	case "rfc3339":
+		return t.Format(time.RFC3339)
 	}
+	return t.Format(format)
 }
@@ -11,7 +11,10 @@
 package runtime
 
 import (
+	"errors"
+	"strings"
 	"testing"
+	"time"
 )
 
 func b(v bool) *bool { return &v }
@@ -182,3 +185,217 @@ func TestEncodeQueryFields_UnsupportedStyle(t *testing.T) {
 		t.Fatalf("expected error")
 	}
 }
+
+type queryStatus int
+
+type queryColor string
+
+type queryShape string
+
+func (c queryColor) String() string { return "color-" + string(c) }
+
+type queryPoint struct{ X, Y int }
+
+func TestEncodeQueryFields_Explode(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     map[string]any
+		enc      map[string]QueryEncoding
+		expected string
+	}{
+		{
+			name:     "spaceDelimited explode=true repeats key",
+			data:     map[string]any{"expand": []any{"a", "b"}},
+			enc:      map[string]QueryEncoding{"expand": {Style: "spaceDelimited", Explode: b(true)}},
+			expected: "expand=a&expand=b",
+		},
+		{
+			name:     "pipeDelimited explode=true repeats key",
+			data:     map[string]any{"expand": []any{"a", "b"}},
+			enc:      map[string]QueryEncoding{"expand": {Style: "pipeDelimited", Explode: b(true)}},
+			expected: "expand=a&expand=b",
+		},
+		{
+			name:     "pipeDelimited scalar",
+			data:     map[string]any{"x": "v"},
+			enc:      map[string]QueryEncoding{"x": {Style: "pipeDelimited"}},
+			expected: "x=v",
+		},
+		{
+			name:     "pipeDelimited with pipe in value",
+			data:     map[string]any{"expand": []any{"a|b", "c"}},
+			enc:      map[string]QueryEncoding{"expand": {Style: "pipeDelimited"}},
+			expected: "expand=a%7Cb%7Cc",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EncodeQueryFields(tt.data, tt.enc)
+			if err != nil {
+				t.Fatalf("unexpected err: %v", err)
+			}
+			if got != tt.expected {
+				t.Fatalf("%s: got %q, want %q", tt.name, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestEncodeQueryFields_DeepObjectNested(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     map[string]any
+		expected string
+	}{
+		{
+			name: "nested object",
+			data: map[string]any{"filter": map[string]any{
+				"color": map[string]any{"R": 100.0, "G": 200.0},
+				"name":  "x",
+			}},
+			expected: "filter%5Bcolor%5D%5BG%5D=200&filter%5Bcolor%5D%5BR%5D=100&filter%5Bname%5D=x",
+		},
+		{
+			name:     "array of scalars in object",
+			data:     map[string]any{"filter": map[string]any{"ids": []any{1.0, 2.0}}},
+			expected: "filter%5Bids%5D%5B%5D=1&filter%5Bids%5D%5B%5D=2",
+		},
+		{
+			name: "array of objects in object",
+			data: map[string]any{"filter": map[string]any{"items": []any{
+				map[string]any{"id": 1.0},
+				map[string]any{"id": 2.0},
+			}}},
+			expected: "filter%5Bitems%5D%5B0%5D%5Bid%5D=1&filter%5Bitems%5D%5B1%5D%5Bid%5D=2",
+		},
+		{
+			name:     "typed map",
+			data:     map[string]any{"filter": map[string][]int{"ids": {3, 4}}},
+			expected: "filter%5Bids%5D%5B%5D=3&filter%5Bids%5D%5B%5D=4",
+		},
+		{
+			name:     "empty object",
+			data:     map[string]any{"filter": map[string]any{}},
+			expected: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EncodeQueryFields(tt.data, map[string]QueryEncoding{"filter": {Style: "deepObject", Explode: b(true)}})
+			if err != nil {
+				t.Fatalf("unexpected err: %v", err)
+			}
+			if got != tt.expected {
+				t.Fatalf("%s: got %q, want %q", tt.name, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestEncodeQueryFields_ScalarFormatting(t *testing.T) {
+	status := queryStatus(3)
+	var nilStatus *queryStatus
+
+	tests := []struct {
+		name     string
+		value    any
+		expected string
+	}{
+		{name: "nil", value: nil, expected: "x="},
+		{name: "large float64", value: 1000000.0, expected: "x=1000000"},
+		{name: "fractional float64", value: 2.5, expected: "x=2.5"},
+		{name: "float32", value: float32(0.1), expected: "x=0.1"},
+		{name: "int", value: -7, expected: "x=-7"},
+		{name: "uint", value: uint8(7), expected: "x=7"},
+		{name: "bool", value: true, expected: "x=true"},
+		{name: "named int", value: status, expected: "x=3"},
+		{name: "pointer", value: &status, expected: "x=3"},
+		{name: "nil pointer", value: nilStatus, expected: "x="},
+		{name: "named string", value: queryShape("circle"), expected: "x=circle"},
+		{name: "stringer", value: queryColor("red"), expected: "x=color-red"},
+		{name: "struct", value: queryPoint{X: 1, Y: 2}, expected: "x=%7B1+2%7D"},
+		{name: "typed slice", value: []int{1, 2}, expected: "x=1&x=2"},
+		{name: "array", value: [2]bool{true, false}, expected: "x=false&x=true"},
+		{name: "typed map", value: map[string]int{"a": 1}, expected: "a=1"},
+		{name: "non-string keys", value: map[int]string{1: "a"}, expected: "1=a"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EncodeQueryFields(map[string]any{"x": tt.value}, nil)
+			if err != nil {
+				t.Fatalf("unexpected err: %v", err)
+			}
+			if got != tt.expected {
+				t.Fatalf("%s: got %q, want %q", tt.name, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestEncodeQueryFields_TimeFormat(t *testing.T) {
+	at := time.Date(2025, 3, 4, 5, 6, 7, 800_000_000, time.UTC)
+	tests := []struct {
+		name     string
+		value    any
+		enc      QueryEncoding
+		expected string
+	}{
+		{name: "unix", value: "2025-03-04T05:06:07.8Z", enc: QueryEncoding{TimeFormat: "unix"}, expected: "x=1741064767"},
+		{name: "unixmilli", value: "2025-03-04T05:06:07.8Z", enc: QueryEncoding{TimeFormat: "unixmilli"}, expected: "x=1741064767800"},
+		{name: "rfc3339", value: "2025-03-04T07:06:07.8+02:00", enc: QueryEncoding{TimeFormat: "rfc3339"}, expected: "x=2025-03-04T07%3A06%3A07%2B02%3A00"},
+		{name: "layout", value: at, enc: QueryEncoding{TimeFormat: "2006-01-02"}, expected: "x=2025-03-04"},
+		{name: "pointer", value: &at, enc: QueryEncoding{TimeFormat: "unix"}, expected: "x=1741064767"},
+		{
+			name:     "array",
+			value:    []any{"2025-03-04T05:06:07Z", "2025-03-05T05:06:07Z"},
+			enc:      QueryEncoding{TimeFormat: "2006-01-02", Explode: b(false)},
+			expected: "x=2025-03-04,2025-03-05",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EncodeQueryFields(map[string]any{"x": tt.value}, map[string]QueryEncoding{"x": tt.enc})
+			if err != nil {
+				t.Fatalf("unexpected err: %v", err)
+			}
+			if got != tt.expected {
+				t.Fatalf("got %q, want %q", got, tt.expected)
+			}
+		})
+	}
+
+	t.Run("invalid date-time", func(t *testing.T) {
+		_, err := EncodeQueryFields(map[string]any{"x": "yesterday"}, map[string]QueryEncoding{"x": {TimeFormat: "unix"}})
+		if err == nil || !strings.Contains(err.Error(), `param "x": error parsing date-time "yesterday"`) {
+			t.Fatalf("unexpected err: %v", err)
+		}
+	})
+}
+
+func TestEncodeQueryFields_NestedValues(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+		enc   QueryEncoding
+	}{
+		{name: "object in object", value: map[string]any{"a": map[string]any{"b": "c"}}, enc: QueryEncoding{Style: "form"}},
+		{name: "array in object", value: map[string]any{"a": []any{"b"}}, enc: QueryEncoding{Style: "pipeDelimited"}},
+		{name: "object in array", value: []any{map[string]any{"a": "b"}}, enc: QueryEncoding{Style: "form"}},
+		{name: "array in array", value: [][]string{{"a"}}, enc: QueryEncoding{Style: "spaceDelimited"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := EncodeQueryFields(map[string]any{"x": tt.value}, map[string]QueryEncoding{"x": tt.enc})
+			if !errors.Is(err, ErrNestedQueryValue) {
+				t.Fatalf("expected ErrNestedQueryValue, got %v", err)
+			}
+		})
+	}
+}
+
+func TestEncodeQueryFields_NotMap(t *testing.T) {
+	_, err := EncodeQueryFields([]string{"x"}, nil)
+	if !errors.Is(err, ErrMustBeMap) {
+		t.Fatalf("expected ErrMustBeMap, got %v", err)
+	}
+}
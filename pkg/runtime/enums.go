@@ -0,0 +1,142 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// enum is implemented by the generated enum types.
+type enum interface {
+	IsValid() bool
+	Name() string
+}
+
+// UnmarshalEnumText parses the wire value of an integer, number or boolean enum into dst,
+// for the UnmarshalText methods of the generated enums. Values missing from the spec are kept.
+func UnmarshalEnumText[T ~bool | ~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~float32 | ~float64](text []byte, dst *T) error {
+	v := reflect.ValueOf(dst).Elem()
+	s := string(text)
+	switch v.Kind() {
+	case reflect.Bool:
+		parsed, err := strconv.ParseBool(s)
+		if err != nil {
+			return fmt.Errorf("invalid %s value %q: %w", v.Type().Name(), s, err)
+		}
+		v.SetBool(parsed)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		parsed, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid %s value %q: %w", v.Type().Name(), s, err)
+		}
+		v.SetInt(parsed)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		parsed, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid %s value %q: %w", v.Type().Name(), s, err)
+		}
+		v.SetUint(parsed)
+	default:
+		parsed, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid %s value %q: %w", v.Type().Name(), s, err)
+		}
+		v.SetFloat(parsed)
+	}
+	return nil
+}
+
+// CheckEnums fails with ErrUnknownEnumValue for the first enum value of v missing from the spec,
+// looking into its exported struct fields, pointers, slices, arrays, maps and Nullable values.
+// It backs the generate.validation.strict-enums checks of generated server bindings and client requests,
+// the generated enums keep unknown values when unmarshaling.
+func CheckEnums(v any) error {
+	return checkEnums(reflect.ValueOf(v), "")
+}
+
+func checkEnums(v reflect.Value, path string) error {
+	switch v.Kind() {
+	case reflect.Invalid:
+		return nil
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return checkEnums(v.Elem(), path)
+	}
+
+	if v.CanInterface() {
+		switch value := v.Interface().(type) {
+		case enum:
+			if value.IsValid() {
+				return nil
+			}
+			if path == "" {
+				return fmt.Errorf("%w: %v", ErrUnknownEnumValue, value)
+			}
+			return fmt.Errorf("%w for '%s': %v", ErrUnknownEnumValue, path, value)
+		case nullable:
+			if nested, ok := value.nullableValue(); ok {
+				return checkEnums(reflect.ValueOf(nested), path)
+			}
+			return nil
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		for i := range v.NumField() {
+			field := v.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			fieldPath := path
+			if !field.Anonymous {
+				name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+				if name == "" || name == "-" {
+					name = field.Name
+				}
+				fieldPath = joinPath(path, name)
+			}
+			if err := checkEnums(v.Field(i), fieldPath); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := range v.Len() {
+			if err := checkEnums(v.Index(i), fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			if err := checkEnums(iter.Value(), joinPath(path, fmt.Sprint(iter.Key()))); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
@@ -0,0 +1,94 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testShade string
+
+func (c testShade) IsValid() bool { return c == "red" || c == "blue" }
+func (c testShade) Name() string  { return string(c) }
+
+func TestCheckEnums(t *testing.T) {
+	type item struct {
+		Color testShade `json:"color"`
+	}
+	type Tinted struct {
+		Tint *testShade `json:"tint,omitempty"`
+	}
+	type body struct {
+		Tinted
+		Primary  testShade            `json:"primary"`
+		Items    []item               `json:"items,omitempty"`
+		ByName   map[string]testShade `json:"byName,omitempty"`
+		Nullable Nullable[testShade]  `json:"nullable,omitzero"`
+		Addr     netip.Addr           `json:"addr"`
+		color    testShade
+	}
+
+	tests := []struct {
+		name     string
+		value    any
+		expected string
+	}{
+		{name: "nil", value: nil},
+		{name: "nil pointer", value: (*body)(nil)},
+		{name: "known values", value: &body{Primary: "red", Items: []item{{Color: "blue"}}, color: "green"}},
+		{name: "null", value: body{Primary: "red", Nullable: Null[testShade]()}},
+		{name: "enum", value: testShade("green"), expected: "unknown enum value: green"},
+		{name: "field", value: body{Primary: "green"}, expected: "unknown enum value for 'primary': green"},
+		{name: "embedded", value: body{Primary: "red", Tinted: Tinted{Tint: Ptr[testShade]("green")}}, expected: "unknown enum value for 'tint': green"},
+		{name: "slice", value: body{Primary: "red", Items: []item{{Color: "red"}, {Color: "green"}}}, expected: "unknown enum value for 'items[1].color': green"},
+		{name: "map", value: body{Primary: "red", ByName: map[string]testShade{"main": "green"}}, expected: "unknown enum value for 'byName.main': green"},
+		{name: "nullable", value: body{Primary: "red", Nullable: NewNullable[testShade]("green")}, expected: "unknown enum value for 'nullable': green"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckEnums(tt.value)
+			if tt.expected == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, ErrUnknownEnumValue)
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
+}
+
+func TestUnmarshalEnumText(t *testing.T) {
+	type priority int32
+	type ratio float32
+	type flag bool
+
+	var p priority
+	assert.NoError(t, UnmarshalEnumText([]byte("42"), &p))
+	assert.Equal(t, priority(42), p)
+	assert.ErrorContains(t, UnmarshalEnumText([]byte("high"), &p), `invalid priority value "high"`)
+	assert.Error(t, UnmarshalEnumText([]byte("4294967296"), &p))
+
+	var r ratio
+	assert.NoError(t, UnmarshalEnumText([]byte("0.5"), &r))
+	assert.Equal(t, ratio(0.5), r)
+
+	var f flag
+	assert.NoError(t, UnmarshalEnumText([]byte("true"), &f))
+	assert.Equal(t, flag(true), f)
+}
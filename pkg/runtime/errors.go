@@ -22,7 +22,36 @@ import (
 var (
 	ErrValidationEmail         = errors.New("email: failed to pass regex validation")
 	ErrFailedToUnmarshalAsAOrB = errors.New("failed to unmarshal as either A or B")
+	ErrFailedToUnmarshalUnion  = errors.New("failed to unmarshal as any of the union variants")
 	ErrMustBeMap               = errors.New("value must be map[string]any")
+	ErrNestedQueryValue        = errors.New("nested arrays and objects are only supported with deepObject style")
+
+	// ErrEncodeRequest is wrapped by the errors of generated clients failing to create or encode a request.
+	ErrEncodeRequest = errors.New("error creating request")
+	// ErrDecodeResponse is wrapped by the errors of generated clients failing to decode a response body.
+	ErrDecodeResponse = errors.New("error decoding response")
+	// ErrUnexpectedStatus is wrapped by the ClientAPIError of generated clients for a response
+	// with a status code that is not in the spec.
+	ErrUnexpectedStatus = errors.New("unexpected status code")
+	// ErrFeatureDisabled is wrapped by the errors of generated clients calling an operation
+	// whose feature flag is disabled.
+	ErrFeatureDisabled = errors.New("feature disabled")
+	// ErrUnknownEnumValue is wrapped by the errors of generated enums parsing or unmarshaling
+	// a value missing from the spec.
+	ErrUnknownEnumValue = errors.New("unknown enum value")
+	// ErrUnknownField is wrapped by the errors of DisallowUnknownFields, and rules out the union variants
+	// implementing ClosedObject for an object with a field they don't know.
+	ErrUnknownField = errors.New("unknown field")
+	// ErrMissingValue is wrapped by the *BindError of generated request binders for a missing required parameter
+	// or body, by the errors of generated clients called without a required body, and by the errors
+	// of links to values missing from the response.
+	ErrMissingValue = errors.New("missing required value")
+	// ErrInvalidResponse is wrapped by the errors of ValidationMiddleware for a response body failing to decode
+	// or validate.
+	ErrInvalidResponse = errors.New("invalid response")
+	// ErrUnexpectedBody is wrapped by the errors of clients created with WithStrictNoContent
+	// for a 204 No Content or 205 Reset Content response with a body.
+	ErrUnexpectedBody = errors.New("unexpected response body")
 )
 
 type ClientAPIErrorOption func(*ClientAPIError)
@@ -65,6 +94,17 @@ func WithStatusCode(code int) ClientAPIErrorOption {
 	}
 }
 
+// UnknownDiscriminatorError is returned by discriminated unions holding data
+// whose discriminator property has a value missing from the mapping.
+type UnknownDiscriminatorError struct {
+	Property string
+	Value    string
+}
+
+func (e *UnknownDiscriminatorError) Error() string {
+	return "unknown discriminator value: " + e.Value
+}
+
 type ValidationError struct {
 	Field   string `json:"field"`
 	Message string `json:"message"`
@@ -239,6 +279,16 @@ func convertFieldErrorMessage(fe validator.FieldError) string {
 		return "is required"
 	case "email":
 		return "must be a valid email"
+	case "uuid", "uri", "ipv4", "ipv6":
+		return "must be a valid " + fe.Tag()
+	case "hostname_rfc1123":
+		return "must be a valid hostname"
+	case "datetime":
+		return fmt.Sprintf("must be a valid date/time in the layout %s", fe.Param())
+	case FormatValidationTag:
+		return "must be a valid " + fe.Param()
+	case MultipleOfValidationTag:
+		return fmt.Sprintf("must be a multiple of %s", fe.Param())
 	case "gt":
 		return fmt.Sprintf("must be greater than %s", fe.Param())
 	case "gte":
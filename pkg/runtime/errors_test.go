@@ -12,6 +12,7 @@ package runtime
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/go-playground/validator/v10"
@@ -38,6 +39,17 @@ func TestNewClientAPIError(t *testing.T) {
 		require.True(t, errors.As(err, &apiErr))
 		assert.Equal(t, 400, apiErr.StatusCode())
 	})
+
+	t.Run("unexpected status", func(t *testing.T) {
+		err := NewClientAPIError(fmt.Errorf("%w: %d", ErrUnexpectedStatus, 418), WithStatusCode(418))
+		assert.EqualError(t, err, "unexpected status code: 418")
+		assert.ErrorIs(t, err, ErrUnexpectedStatus)
+	})
+}
+
+func TestUnknownDiscriminatorError(t *testing.T) {
+	var err error = &UnknownDiscriminatorError{Property: "type", Value: "fish"}
+	assert.EqualError(t, err, "unknown discriminator value: fish")
 }
 
 func TestNewValidationError(t *testing.T) {
@@ -253,6 +265,9 @@ func TestNewValidationErrorFromError(t *testing.T) {
 		}{
 			{"required", "", "required", "is required"},
 			{"email", "invalid", "email", "must be a valid email"},
+			{"uuid", "invalid", "uuid", "must be a valid uuid"},
+			{"hostname", "-invalid", "hostname_rfc1123", "must be a valid hostname"},
+			{"datetime", "2025-13-01", "datetime=2006-01-02", "must be a valid date/time in the layout 2006-01-02"},
 			{"gt", 5, "gt=10", "must be greater than 10"},
 			{"gte", 5, "gte=10", "must be greater than or equal to 10"},
 			{"lt", 15, "lt=10", "must be less than 10"},
@@ -0,0 +1,118 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"sync"
+)
+
+// ETag returns the ETag header of the response, empty if not set.
+func (r *Response) ETag() string {
+	if r == nil {
+		return ""
+	}
+	return r.Headers.Get("ETag")
+}
+
+// CachedResponse is a response stored in an ETagCache, returned again when the server answers
+// a request revalidating it with 304 Not Modified.
+type CachedResponse struct {
+	ETag       string
+	StatusCode int
+	Headers    http.Header
+	Content    []byte
+}
+
+// ETagCache stores the successful responses of GET requests with an ETag, by request URL.
+type ETagCache interface {
+	Get(ctx context.Context, key string) (*CachedResponse, bool)
+	Set(ctx context.Context, key string, resp *CachedResponse)
+}
+
+// MemoryETagCache is an ETagCache keeping the responses in memory, safe for concurrent use.
+// It is not bounded, so it suits clients requesting a known set of resources.
+type MemoryETagCache struct {
+	mu        sync.RWMutex
+	responses map[string]*CachedResponse
+}
+
+// NewMemoryETagCache returns an empty MemoryETagCache.
+func NewMemoryETagCache() *MemoryETagCache {
+	return &MemoryETagCache{responses: map[string]*CachedResponse{}}
+}
+
+// Get implements ETagCache.
+func (m *MemoryETagCache) Get(_ context.Context, key string) (*CachedResponse, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	resp, ok := m.responses[key]
+	return resp, ok
+}
+
+// Set implements ETagCache.
+func (m *MemoryETagCache) Set(_ context.Context, key string, resp *CachedResponse) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.responses[key] = resp
+}
+
+// WithETagCache revalidates the responses of GET requests stored in the cache, sending their ETag
+// in the If-None-Match header, and returns the stored response when the server answers 304 Not Modified.
+// Successful responses with an ETag are stored by request URL, so clients sending different credentials
+// must not share a cache. Requests setting If-None-Match themselves are sent as they are.
+func WithETagCache(cache ETagCache) APIClientOption {
+	return func(c *Client) error {
+		c.etagCache = cache
+		return nil
+	}
+}
+
+// revalidateRequest sets If-None-Match to the ETag of the cached response of a GET request,
+// returning the response to serve if it is not modified, nil if there is none.
+func (c *Client) revalidateRequest(ctx context.Context, req *http.Request) *CachedResponse {
+	if c.etagCache == nil || req.Method != http.MethodGet || hasHeader(req.Header, "If-None-Match") {
+		return nil
+	}
+	cached, ok := c.etagCache.Get(ctx, req.URL.String())
+	if !ok || cached == nil || cached.ETag == "" {
+		return nil
+	}
+	req.Header.Set("If-None-Match", cached.ETag)
+	return cached
+}
+
+// cacheResponse returns the cached response for a 304 Not Modified response to a revalidated request,
+// and stores the successful responses of GET requests with an ETag.
+func (c *Client) cacheResponse(ctx context.Context, req *http.Request, cached *CachedResponse, resp *Response) *Response {
+	if cached != nil && resp.StatusCode == http.StatusNotModified {
+		return &Response{
+			Content:    bytes.Clone(cached.Content),
+			StatusCode: cached.StatusCode,
+			Headers:    cached.Headers.Clone(),
+			Raw:        resp.Raw,
+		}
+	}
+
+	etag := resp.ETag()
+	if c.etagCache != nil && req.Method == http.MethodGet && etag != "" &&
+		resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		c.etagCache.Set(ctx, req.URL.String(), &CachedResponse{
+			ETag:       etag,
+			StatusCode: resp.StatusCode,
+			Headers:    resp.Headers.Clone(),
+			Content:    bytes.Clone(resp.Content),
+		})
+	}
+	return resp
+}
@@ -0,0 +1,84 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponse_ETag(t *testing.T) {
+	assert.Equal(t, `"v1"`, (&Response{Headers: http.Header{"Etag": {`"v1"`}}}).ETag())
+	assert.Empty(t, (&Response{}).ETag())
+	assert.Empty(t, (*Response)(nil).ETag())
+}
+
+func TestClient_etagCache(t *testing.T) {
+	ctx := context.Background()
+
+	var conditions []string
+	version := `"v1"`
+	doer := doerFunc(func(_ context.Context, req *http.Request) (*http.Response, error) {
+		conditions = append(conditions, req.Header.Get("If-None-Match"))
+		if req.Method == http.MethodGet && req.Header.Get("If-None-Match") == version {
+			return &http.Response{StatusCode: http.StatusNotModified, Body: http.NoBody, Header: http.Header{"Etag": {version}}}, nil
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Etag": {version}, "Content-Type": {"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"version":` + version + `}`)),
+		}, nil
+	})
+	cache := NewMemoryETagCache()
+	client, err := NewAPIClient("https://api.example.com", WithHTTPClient(doer), WithETagCache(cache))
+	require.NoError(t, err)
+
+	execute := func(method string, editors ...RequestEditorFn) *Response {
+		req, err := client.CreateRequest(ctx, RequestOptionsParameters{
+			RequestURL: client.GetBaseURL() + "/pets/1",
+			Method:     method,
+		}, editors...)
+		require.NoError(t, err)
+		resp, err := client.ExecuteRequest(ctx, req, "/pets/{id}")
+		require.NoError(t, err)
+		return resp
+	}
+
+	first := execute(http.MethodGet)
+	assert.Equal(t, http.StatusOK, first.StatusCode)
+
+	cached := execute(http.MethodGet)
+	assert.Equal(t, http.StatusOK, cached.StatusCode)
+	assert.JSONEq(t, `{"version":"v1"}`, string(cached.Content))
+	assert.Equal(t, "application/json", cached.Headers.Get("Content-Type"))
+	assert.Equal(t, http.StatusNotModified, cached.Raw.StatusCode)
+
+	explicit := execute(http.MethodGet, WithHeader("If-None-Match", `"v0"`))
+	assert.Equal(t, http.StatusOK, explicit.StatusCode)
+
+	execute(http.MethodPut)
+
+	version = `"v2"`
+	changed := execute(http.MethodGet)
+	assert.JSONEq(t, `{"version":"v2"}`, string(changed.Content))
+
+	assert.Equal(t, []string{"", `"v1"`, `"v0"`, "", `"v1"`}, conditions)
+
+	stored, ok := cache.Get(ctx, "https://api.example.com/pets/1")
+	require.True(t, ok)
+	assert.Equal(t, `"v2"`, stored.ETag)
+}
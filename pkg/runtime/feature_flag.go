@@ -0,0 +1,87 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// FlagChecker reports whether a feature flag, set with x-feature-flag on operations, is enabled.
+type FlagChecker interface {
+	IsEnabled(ctx context.Context, flag string) bool
+}
+
+// FlagCheckerFunc adapts a function to a FlagChecker.
+type FlagCheckerFunc func(ctx context.Context, flag string) bool
+
+// IsEnabled implements FlagChecker.
+func (f FlagCheckerFunc) IsEnabled(ctx context.Context, flag string) bool {
+	return f(ctx, flag)
+}
+
+// FeatureDisabledError is returned by clients for calls to an operation whose feature flag is disabled.
+// It wraps ErrFeatureDisabled.
+type FeatureDisabledError struct {
+	Flag string
+}
+
+// Error implements the error interface.
+func (e *FeatureDisabledError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrFeatureDisabled, e.Flag)
+}
+
+// Unwrap returns ErrFeatureDisabled.
+func (e *FeatureDisabledError) Unwrap() error {
+	return ErrFeatureDisabled
+}
+
+// WithFlagChecker makes calls to operations with a disabled feature flag fail with a *FeatureDisabledError
+// without sending the request. Without a checker all flags are enabled.
+func WithFlagChecker(checker FlagChecker) APIClientOption {
+	return func(c *Client) error {
+		c.flagChecker = checker
+		return nil
+	}
+}
+
+// IsFeatureEnabled reports whether the flag is enabled by the FlagChecker of the client.
+// An empty flag, or a client without a checker, is always enabled.
+func (c *Client) IsFeatureEnabled(ctx context.Context, flag string) bool {
+	return flag == "" || c.flagChecker == nil || c.flagChecker.IsEnabled(ctx, flag)
+}
+
+// IsFeatureEnabled reports whether the flag is enabled for the API client, for generated <Operation>IsEnabled methods.
+// Clients not implementing IsFeatureEnabled(ctx, flag) have all flags enabled.
+func IsFeatureEnabled(ctx context.Context, apiClient APIClient, flag string) bool {
+	if c, ok := apiClient.(interface {
+		IsFeatureEnabled(ctx context.Context, flag string) bool
+	}); ok {
+		return c.IsFeatureEnabled(ctx, flag)
+	}
+	return true
+}
+
+// RequireFeatureFlag returns middleware serving an operation only while its feature flag is enabled.
+// Requests are answered with the status, e.g. http.StatusNotFound to hide the operation
+// or http.StatusServiceUnavailable, while the flag is disabled.
+func RequireFeatureFlag(checker FlagChecker, flag string, status int) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !checker.IsEnabled(r.Context(), flag) {
+				http.Error(w, http.StatusText(status), status)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func enabledFlags(flags ...string) FlagCheckerFunc {
+	return func(_ context.Context, flag string) bool {
+		return slices.Contains(flags, flag)
+	}
+}
+
+func TestClient_IsFeatureEnabled(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := NewAPIClient("https://api.example.com")
+	require.NoError(t, err)
+	assert.True(t, client.IsFeatureEnabled(ctx, "new-checkout"), "all flags are enabled without a checker")
+
+	client, err = NewAPIClient("https://api.example.com", WithFlagChecker(enabledFlags("new-checkout")))
+	require.NoError(t, err)
+	assert.True(t, client.IsFeatureEnabled(ctx, "new-checkout"))
+	assert.False(t, client.IsFeatureEnabled(ctx, "beta"))
+	assert.True(t, client.IsFeatureEnabled(ctx, ""))
+
+	assert.True(t, IsFeatureEnabled(ctx, client, "new-checkout"))
+	assert.False(t, IsFeatureEnabled(ctx, client, "beta"))
+	assert.True(t, IsFeatureEnabled(ctx, nil, "beta"), "API clients without flag support have all flags enabled")
+}
+
+func TestClient_CreateRequest_featureFlag(t *testing.T) {
+	ctx := context.Background()
+	client, err := NewAPIClient("https://api.example.com", WithFlagChecker(enabledFlags("new-checkout")))
+	require.NoError(t, err)
+
+	req, err := client.CreateRequest(ctx, RequestOptionsParameters{
+		RequestURL:  client.GetBaseURL() + "/orders",
+		Method:      http.MethodPost,
+		FeatureFlag: "new-checkout",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "/orders", req.URL.Path)
+
+	_, err = client.CreateRequest(ctx, RequestOptionsParameters{
+		RequestURL:  client.GetBaseURL() + "/orders",
+		Method:      http.MethodPost,
+		FeatureFlag: "beta",
+	})
+	require.ErrorIs(t, err, ErrFeatureDisabled)
+	var disabled *FeatureDisabledError
+	require.True(t, errors.As(err, &disabled))
+	assert.Equal(t, "beta", disabled.Flag)
+	assert.Equal(t, "feature disabled: beta", err.Error())
+}
+
+func TestRequireFeatureFlag(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	tests := []struct {
+		name     string
+		flag     string
+		status   int
+		expected int
+	}{
+		{name: "enabled", flag: "new-checkout", status: http.StatusNotFound, expected: http.StatusNoContent},
+		{name: "disabled as not found", flag: "beta", status: http.StatusNotFound, expected: http.StatusNotFound},
+		{name: "disabled as unavailable", flag: "beta", status: http.StatusServiceUnavailable, expected: http.StatusServiceUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := RequireFeatureFlag(enabledFlags("new-checkout"), tt.flag, tt.status)(next)
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/orders", nil))
+			assert.Equal(t, tt.expected, rec.Code)
+		})
+	}
+}
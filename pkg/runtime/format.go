@@ -0,0 +1,49 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"sync"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// FormatValidationTag is the validation tag checking custom string formats, e.g. `validate:"format=sku"`.
+const FormatValidationTag = "format"
+
+var formats sync.Map
+
+// RegisterFormat registers fn to validate strings of the custom OpenAPI format name.
+// Formats are looked up when values are validated, so they can be registered after the
+// generated code is initialized, e.g. in main. Registering a format again replaces it.
+func RegisterFormat(name string, fn func(string) bool) {
+	formats.Store(name, fn)
+}
+
+// RegisterFormatValidation registers the "format" validation tag with v.
+// Values fail validation if their format has not been registered with RegisterFormat.
+func RegisterFormatValidation(v *validator.Validate) {
+	// The tag name is valid and the function non-nil, so registration can't fail.
+	_ = v.RegisterValidation(FormatValidationTag, validateFormat)
+}
+
+// validateFormat checks the field with the function registered for the tag's format.
+func validateFormat(fl validator.FieldLevel) bool {
+	fn, ok := formats.Load(fl.Param())
+	if !ok {
+		return false
+	}
+	return fn.(func(string) bool)(fl.Field().String())
+}
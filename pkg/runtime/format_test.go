@@ -0,0 +1,56 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatValidation(t *testing.T) {
+	v := validator.New(validator.WithRequiredStructEnabled())
+	RegisterValidations(v)
+
+	type Item struct {
+		SKU string `validate:"omitempty,format=test-sku"`
+	}
+
+	t.Run("unregistered format fails", func(t *testing.T) {
+		err := v.Struct(Item{SKU: "SKU-1"})
+		require.Error(t, err)
+	})
+
+	RegisterFormat("test-sku", func(s string) bool {
+		return strings.HasPrefix(s, "SKU-")
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		assert.NoError(t, v.Struct(Item{SKU: "SKU-1"}))
+		assert.NoError(t, v.Struct(Item{}))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		err := v.Struct(Item{SKU: "1"})
+		require.Error(t, err)
+
+		validationErrs := NewValidationErrorsFromError(err)
+		require.Len(t, validationErrs, 1)
+		assert.Equal(t, "must be a valid test-sku", validationErrs[0].Message)
+	})
+}
@@ -0,0 +1,70 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// JSONCodec marshals and unmarshals JSON, e.g. with a faster library than encoding/json.
+type JSONCodec interface {
+	Marshal(v any) ([]byte, error)
+	Unmarshal(data []byte, v any) error
+}
+
+// WithJSONCodec encodes JSON request bodies, and decodes the events and lines of streamed responses, with codec
+// instead of encoding/json. Bodies omitting readOnly properties are still encoded with their MarshalJSONForRequest method.
+// Generated clients created with NewDefault<Client> use the json-library of the generator configuration.
+func WithJSONCodec(codec JSONCodec) APIClientOption {
+	return func(c *Client) error {
+		c.jsonCodec = codec
+		return nil
+	}
+}
+
+// JSONCodec returns the codec set with WithJSONCodec, nil for encoding/json.
+func (c *Client) JSONCodec() JSONCodec {
+	return c.jsonCodec
+}
+
+// ClientJSONCodec returns the codec of the API client, for generated methods decoding streamed responses,
+// or fallback when the client has none or doesn't implement JSONCodec().
+func ClientJSONCodec(apiClient APIClient, fallback JSONCodec) JSONCodec {
+	if c, ok := apiClient.(interface{ JSONCodec() JSONCodec }); ok && c.JSONCodec() != nil {
+		return c.JSONCodec()
+	}
+	return fallback
+}
+
+// marshalWithCodec marshals v with codec, encoding/json when nil.
+func marshalWithCodec(v any, codec JSONCodec) ([]byte, error) {
+	if codec == nil {
+		return json.Marshal(v)
+	}
+	return codec.Marshal(v)
+}
+
+// unmarshalWithCodec unmarshals data with codec, encoding/json when nil.
+func unmarshalWithCodec(data []byte, v any, codec JSONCodec) error {
+	if codec == nil {
+		return json.Unmarshal(data, v)
+	}
+	return codec.Unmarshal(data, v)
+}
+
+// marshalRequestBody marshals a JSON request body with codec, unless it may omit readOnly properties.
+func marshalRequestBody(payload any, codec JSONCodec) ([]byte, error) {
+	if codec == nil || mayImplement(reflect.TypeOf(payload), requestMarshalerType, nil) {
+		return MarshalJSONForRequest(payload)
+	}
+	return codec.Marshal(payload)
+}
@@ -0,0 +1,108 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingCodec is encoding/json counting the values it marshals and unmarshals.
+type countingCodec struct {
+	marshaled   int
+	unmarshaled int
+}
+
+func (c *countingCodec) Marshal(v any) ([]byte, error) {
+	c.marshaled++
+	return json.Marshal(v)
+}
+
+func (c *countingCodec) Unmarshal(data []byte, v any) error {
+	c.unmarshaled++
+	return json.Unmarshal(data, v)
+}
+
+func TestWithJSONCodec(t *testing.T) {
+	codec := &countingCodec{}
+	client, err := NewAPIClient("https://api.example.com", WithJSONCodec(codec))
+	require.NoError(t, err)
+
+	createBody := func(body any) string {
+		req, err := client.CreateRequest(context.Background(), RequestOptionsParameters{
+			Options:    mockRequestOptions{body: body},
+			RequestURL: "https://api.example.com/users",
+			Method:     "POST",
+		})
+		require.NoError(t, err)
+		b, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		return string(b)
+	}
+
+	t.Run("encodes json bodies", func(t *testing.T) {
+		assert.JSONEq(t, `{"name":"John"}`, createBody(map[string]string{"name": "John"}))
+		assert.Equal(t, 1, codec.marshaled)
+	})
+
+	t.Run("omits readOnly properties", func(t *testing.T) {
+		codec.marshaled = 0
+		assert.JSONEq(t, `{"members":[{"name":"Jane"}]}`, createBody(rwOrg{Members: []rwAccount{{ID: "1", Name: "Jane"}}}))
+		assert.Equal(t, 0, codec.marshaled)
+	})
+}
+
+func TestClientJSONCodec(t *testing.T) {
+	codec, fallback := &countingCodec{}, &countingCodec{}
+
+	client, err := NewAPIClient("https://api.example.com", WithJSONCodec(codec))
+	require.NoError(t, err)
+	assert.Same(t, codec, ClientJSONCodec(client, fallback))
+
+	client, err = NewAPIClient("https://api.example.com")
+	require.NoError(t, err)
+	assert.Same(t, fallback, ClientJSONCodec(client, fallback))
+	assert.Nil(t, ClientJSONCodec(client, nil))
+
+	assert.Same(t, fallback, ClientJSONCodec(bufferingAPIClient{client}, fallback))
+}
+
+func BenchmarkCreateRequest(b *testing.B) {
+	body := map[string]any{"name": "John", "tags": []string{"a", "b", "c"}, "age": 42}
+	for _, bm := range []struct {
+		name string
+		opts []APIClientOption
+	}{
+		{name: "encoding/json"},
+		{name: "codec", opts: []APIClientOption{WithJSONCodec(&countingCodec{})}},
+	} {
+		b.Run(bm.name, func(b *testing.B) {
+			client, err := NewAPIClient("https://api.example.com", bm.opts...)
+			require.NoError(b, err)
+			params := RequestOptionsParameters{
+				Options:    mockRequestOptions{body: body},
+				RequestURL: "https://api.example.com/users",
+				Method:     "POST",
+			}
+			b.ReportAllocs()
+			for b.Loop() {
+				if _, err := client.CreateRequest(context.Background(), params); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+)
+
+// JSONLines opens a JSON Lines (application/jsonl, application/x-ndjson) body and yields every line decoded as T
+// with codec, encoding/json when nil.
+// Blank lines are skipped, and a line that fails to decode yields an error carrying its line number
+// before iteration continues with the next line.
+// The body is closed when the stream ends or the caller stops iterating.
+func JSONLines[T any](open func() (io.ReadCloser, error), codec JSONCodec) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		body, err := open()
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		defer func() { _ = body.Close() }()
+
+		reader := bufio.NewReader(body)
+		for number := 1; ; number++ {
+			line, err := reader.ReadBytes('\n')
+			if err != nil && !errors.Is(err, io.EOF) {
+				yield(zero, fmt.Errorf("error reading line %d: %w", number, err))
+				return
+			}
+			last := err != nil
+
+			if line = bytes.TrimSpace(line); len(line) > 0 {
+				var target T
+				if err := unmarshalWithCodec(line, &target, codec); err != nil {
+					if !yield(zero, fmt.Errorf("error decoding line %d: %w", number, err)) {
+						return
+					}
+				} else if !yield(target, nil) {
+					return
+				}
+			}
+
+			if last {
+				return
+			}
+		}
+	}
+}
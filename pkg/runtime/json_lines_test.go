@@ -0,0 +1,89 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONLines(t *testing.T) {
+	open := func(body io.ReadCloser) func() (io.ReadCloser, error) {
+		return func() (io.ReadCloser, error) { return body, nil }
+	}
+
+	t.Run("decodes every line", func(t *testing.T) {
+		body := &trackingBody{Reader: strings.NewReader("{\"text\":\"a\"}\n\n{\"text\":\"b\"}\r\n{\"text\":\"c\"}")}
+
+		var messages []sseMessage
+		for msg, err := range JSONLines[sseMessage](open(body), nil) {
+			require.NoError(t, err)
+			messages = append(messages, msg)
+		}
+
+		assert.Equal(t, []sseMessage{{Text: "a"}, {Text: "b"}, {Text: "c"}}, messages)
+		assert.True(t, body.closed)
+	})
+
+	t.Run("decodes with the codec", func(t *testing.T) {
+		codec := &countingCodec{}
+		for _, err := range JSONLines[sseMessage](open(&trackingBody{Reader: strings.NewReader("{\"text\":\"a\"}\n")}), codec) {
+			require.NoError(t, err)
+		}
+		assert.Equal(t, 1, codec.unmarshaled)
+	})
+
+	t.Run("open error", func(t *testing.T) {
+		var errs []error
+		for _, err := range JSONLines[sseMessage](func() (io.ReadCloser, error) { return nil, errors.New("boom") }, nil) {
+			errs = append(errs, err)
+		}
+
+		require.Len(t, errs, 1)
+		assert.EqualError(t, errs[0], "boom")
+	})
+
+	t.Run("decode error continues with the next line", func(t *testing.T) {
+		body := &trackingBody{Reader: strings.NewReader("{\"text\":\"a\"}\nnope\n{\"text\":\"c\"}\n")}
+
+		var messages []sseMessage
+		var errs []error
+		for msg, err := range JSONLines[sseMessage](open(body), nil) {
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			messages = append(messages, msg)
+		}
+
+		require.Len(t, errs, 1)
+		assert.ErrorContains(t, errs[0], "error decoding line 2")
+		assert.Equal(t, []sseMessage{{Text: "a"}, {Text: "c"}}, messages)
+	})
+
+	t.Run("stops when the caller breaks", func(t *testing.T) {
+		body := &trackingBody{Reader: strings.NewReader("{\"text\":\"a\"}\n{\"text\":\"b\"}\n")}
+
+		count := 0
+		for range JSONLines[sseMessage](open(body), nil) {
+			count++
+			break
+		}
+
+		assert.Equal(t, 1, count)
+		assert.True(t, body.closed)
+	})
+}
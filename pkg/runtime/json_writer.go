@@ -0,0 +1,66 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// JSONObjectWriter writes a JSON object one field at a time.
+// Generated MarshalJSON methods use it instead of building a map[string]json.RawMessage
+// and marshaling it again, which re-validates and re-sorts every value.
+// The zero value marshals the fields with encoding/json.
+type JSONObjectWriter struct {
+	buf    bytes.Buffer
+	fields int
+	codec  JSONCodec
+}
+
+// NewJSONObjectWriter returns a JSONObjectWriter marshaling the fields with codec, encoding/json if nil.
+func NewJSONObjectWriter(codec JSONCodec) *JSONObjectWriter {
+	return &JSONObjectWriter{codec: codec}
+}
+
+// WriteField marshals value and writes it under name.
+func (w *JSONObjectWriter) WriteField(name string, value any) error {
+	b, err := marshalWithCodec(value, w.codec)
+	if err != nil {
+		return err
+	}
+	w.WriteRawField(name, b)
+	return nil
+}
+
+// WriteRawField writes already encoded JSON under name.
+func (w *JSONObjectWriter) WriteRawField(name string, value []byte) {
+	// marshaling a string can't fail
+	key, _ := json.Marshal(name)
+
+	if w.fields == 0 {
+		w.buf.WriteByte('{')
+	} else {
+		w.buf.WriteByte(',')
+	}
+	w.buf.Write(key)
+	w.buf.WriteByte(':')
+	w.buf.Write(value)
+	w.fields++
+}
+
+// Bytes returns a copy of the encoded object, so fields can still be written.
+func (w *JSONObjectWriter) Bytes() []byte {
+	if w.fields == 0 {
+		return []byte("{}")
+	}
+	// the capacity is capped so the brace is appended to a copy, not to the buffer
+	return append(w.buf.Bytes()[:w.buf.Len():w.buf.Len()], '}')
+}
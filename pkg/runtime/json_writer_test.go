@@ -0,0 +1,67 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONObjectWriter(t *testing.T) {
+	t.Run("empty object", func(t *testing.T) {
+		var w JSONObjectWriter
+		assert.Equal(t, "{}", string(w.Bytes()))
+	})
+
+	t.Run("fields keep their order", func(t *testing.T) {
+		var w JSONObjectWriter
+		require.NoError(t, w.WriteField("b", 1))
+		require.NoError(t, w.WriteField("a", []string{"x"}))
+		w.WriteRawField("c", []byte(`{"nested":true}`))
+
+		assert.Equal(t, `{"b":1,"a":["x"],"c":{"nested":true}}`, string(w.Bytes()))
+		assert.Equal(t, `{"b":1,"a":["x"],"c":{"nested":true}}`, string(w.Bytes()))
+	})
+
+	t.Run("bytes are a copy", func(t *testing.T) {
+		var w JSONObjectWriter
+		require.NoError(t, w.WriteField("a", 1))
+		first := w.Bytes()
+		require.NoError(t, w.WriteField("b", 2))
+
+		assert.Equal(t, `{"a":1}`, string(first))
+		assert.Equal(t, `{"a":1,"b":2}`, string(w.Bytes()))
+	})
+
+	t.Run("codec", func(t *testing.T) {
+		codec := &countingCodec{}
+		w := NewJSONObjectWriter(codec)
+		require.NoError(t, w.WriteField("a", 1))
+		require.NoError(t, w.WriteField("b", "x"))
+
+		assert.Equal(t, `{"a":1,"b":"x"}`, string(w.Bytes()))
+		assert.Equal(t, 2, codec.marshaled)
+	})
+
+	t.Run("keys are escaped", func(t *testing.T) {
+		var w JSONObjectWriter
+		require.NoError(t, w.WriteField(`say "hi"`, "ok"))
+		assert.Equal(t, `{"say \"hi\"":"ok"}`, string(w.Bytes()))
+	})
+
+	t.Run("marshal error", func(t *testing.T) {
+		var w JSONObjectWriter
+		assert.Error(t, w.WriteField("ch", make(chan int)))
+		assert.Equal(t, "{}", string(w.Bytes()))
+	})
+}
@@ -0,0 +1,98 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync/atomic"
+)
+
+// jsonRPCIDs numbers the JSON-RPC requests of the process.
+var jsonRPCIDs atomic.Uint64
+
+// JSONRPCRequest is a JSON-RPC 2.0 request object, sent by the client methods of operations with x-jsonrpc.
+// It implements RequestOptions, sending itself as the body.
+type JSONRPCRequest struct {
+	JSONRPC string `json:"jsonrpc"`
+	Method  string `json:"method"`
+	Params  any    `json:"params,omitempty"`
+	ID      uint64 `json:"id"`
+}
+
+// NewJSONRPCRequest returns a request calling method with params, which are left out if nil, with a new id.
+func NewJSONRPCRequest(method string, params any) *JSONRPCRequest {
+	return &JSONRPCRequest{JSONRPC: "2.0", Method: method, Params: params, ID: jsonRPCIDs.Add(1)}
+}
+
+// GetPathParams implements RequestOptions.
+func (r *JSONRPCRequest) GetPathParams() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetQuery implements RequestOptions.
+func (r *JSONRPCRequest) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody implements RequestOptions.
+func (r *JSONRPCRequest) GetBody() any {
+	return r
+}
+
+// GetHeader implements RequestOptions.
+func (r *JSONRPCRequest) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+// JSONRPCResponse is a JSON-RPC 2.0 response object, with either a result or an error.
+type JSONRPCResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *JSONRPCError   `json:"error,omitempty"`
+	ID      json.RawMessage `json:"id"`
+}
+
+// JSONRPCError is the error object of a JSON-RPC response, returned by generated clients wrapped
+// in a ClientAPIError.
+type JSONRPCError struct {
+	Code    int             `json:"code"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data,omitempty"`
+
+	// Details is the data of the error decoded as the error response of the operation in the spec,
+	// a pointer to its type. Nil if the spec has none, or the data doesn't decode into it.
+	Details any `json:"-"`
+}
+
+// Error implements the error interface.
+func (e *JSONRPCError) Error() string {
+	return fmt.Sprintf("json-rpc error %d: %s", e.Code, e.Message)
+}
+
+// ParseJSONRPCResponse decodes the JSON-RPC response object of resp, whatever its status code.
+// Responses without one are returned as a ClientAPIError wrapping ErrUnexpectedStatus for status codes
+// other than 2xx, and an error wrapping ErrDecodeResponse otherwise.
+func ParseJSONRPCResponse(resp *Response) (*JSONRPCResponse, error) {
+	var res JSONRPCResponse
+	err := json.Unmarshal(resp.Content, &res)
+	if err == nil && res.Result == nil && res.Error == nil {
+		err = fmt.Errorf("response has neither a result nor an error")
+	}
+	if err == nil {
+		return &res, nil
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, NewClientAPIError(fmt.Errorf("%w: %d", ErrUnexpectedStatus, resp.StatusCode), WithStatusCode(resp.StatusCode))
+	}
+	return nil, fmt.Errorf("%w: %w", ErrDecodeResponse, err)
+}
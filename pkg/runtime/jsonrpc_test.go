@@ -0,0 +1,69 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewJSONRPCRequest(t *testing.T) {
+	first := NewJSONRPCRequest("pets.get", map[string]int{"id": 1})
+	second := NewJSONRPCRequest("pets.count", nil)
+	assert.Greater(t, second.ID, first.ID)
+
+	body, err := json.Marshal(first.GetBody())
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"jsonrpc":"2.0","method":"pets.get","params":{"id":1},"id":`+strconv.FormatUint(first.ID, 10)+`}`, string(body))
+
+	body, err = json.Marshal(second.GetBody())
+	require.NoError(t, err)
+	assert.NotContains(t, string(body), "params")
+}
+
+func TestParseJSONRPCResponse(t *testing.T) {
+	t.Run("result", func(t *testing.T) {
+		res, err := ParseJSONRPCResponse(&Response{StatusCode: 200, Content: []byte(`{"jsonrpc":"2.0","result":{"name":"rex"},"id":1}`)})
+		require.NoError(t, err)
+		assert.Nil(t, res.Error)
+		assert.JSONEq(t, `{"name":"rex"}`, string(res.Result))
+	})
+
+	t.Run("null result", func(t *testing.T) {
+		res, err := ParseJSONRPCResponse(&Response{StatusCode: 200, Content: []byte(`{"jsonrpc":"2.0","result":null,"id":1}`)})
+		require.NoError(t, err)
+		assert.Equal(t, "null", string(res.Result))
+	})
+
+	t.Run("error object", func(t *testing.T) {
+		res, err := ParseJSONRPCResponse(&Response{StatusCode: 500, Content: []byte(`{"jsonrpc":"2.0","error":{"code":-32601,"message":"Method not found"},"id":1}`)})
+		require.NoError(t, err)
+		assert.EqualError(t, res.Error, "json-rpc error -32601: Method not found")
+	})
+
+	t.Run("unexpected status", func(t *testing.T) {
+		_, err := ParseJSONRPCResponse(&Response{StatusCode: 502, Content: []byte(`Bad Gateway`)})
+		require.ErrorIs(t, err, ErrUnexpectedStatus)
+
+		var apiErr *ClientAPIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, 502, apiErr.StatusCode())
+	})
+
+	t.Run("not a response object", func(t *testing.T) {
+		_, err := ParseJSONRPCResponse(&Response{StatusCode: 200, Content: []byte(`{"jsonrpc":"2.0","id":1}`)})
+		assert.ErrorIs(t, err, ErrDecodeResponse)
+	})
+}
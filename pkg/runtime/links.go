@@ -0,0 +1,117 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ResolveLinkParameters decodes into dst, like the PathParams or Query of request options, the parameters
+// of an OpenAPI link: a JSON object of their values by name, where the strings starting with $ are runtime
+// expressions evaluated against the response body.
+func ResolveLinkParameters(response any, parameters string, dst any) error {
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(parameters), &values); err != nil {
+		return fmt.Errorf("error decoding link parameters: %w", err)
+	}
+
+	var body any
+	resolved := make(map[string]json.RawMessage, len(values))
+	for name, value := range values {
+		v, err := evaluateLinkValue(response, &body, value)
+		if err != nil {
+			return fmt.Errorf("parameter %s: %w", name, err)
+		}
+		resolved[name] = v
+	}
+
+	data, err := json.Marshal(resolved)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, dst)
+}
+
+// ResolveLinkBody decodes into dst the request body of an OpenAPI link, a JSON value,
+// or a runtime expression evaluated against the response body if it is a string starting with $.
+func ResolveLinkBody(response any, requestBody string, dst any) error {
+	var body any
+	v, err := evaluateLinkValue(response, &body, json.RawMessage(requestBody))
+	if err != nil {
+		return fmt.Errorf("request body: %w", err)
+	}
+	return json.Unmarshal(v, dst)
+}
+
+// evaluateLinkValue returns the value of a link, evaluating runtime expressions against the response,
+// decoded into body on first use.
+func evaluateLinkValue(response any, body *any, value json.RawMessage) (json.RawMessage, error) {
+	var expr string
+	if err := json.Unmarshal(value, &expr); err != nil || !strings.HasPrefix(expr, "$") {
+		return value, nil
+	}
+
+	pointer, ok := strings.CutPrefix(expr, "$response.body")
+	if !ok || pointer != "" && !strings.HasPrefix(pointer, "#") {
+		return nil, fmt.Errorf("runtime expression %s can't be evaluated against the response body", expr)
+	}
+
+	if *body == nil {
+		data, err := json.Marshal(response)
+		if err != nil {
+			return nil, fmt.Errorf("error encoding response: %w", err)
+		}
+		if err = json.Unmarshal(data, body); err != nil {
+			return nil, fmt.Errorf("error decoding response: %w", err)
+		}
+	}
+
+	v, err := jsonPointerValue(*body, strings.TrimPrefix(pointer, "#"))
+	if err != nil {
+		return nil, fmt.Errorf("runtime expression %s: %w", expr, err)
+	}
+	return json.Marshal(v)
+}
+
+// jsonPointerValue returns the value a JSON pointer (RFC 6901) refers to in a decoded JSON document.
+func jsonPointerValue(doc any, pointer string) (any, error) {
+	if pointer == "" {
+		return doc, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("invalid JSON pointer %q", pointer)
+	}
+
+	v := doc
+	for _, token := range strings.Split(pointer[1:], "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		switch node := v.(type) {
+		case map[string]any:
+			child, ok := node[token]
+			if !ok {
+				return nil, fmt.Errorf("%w: %s", ErrMissingValue, token)
+			}
+			v = child
+		case []any:
+			i, err := strconv.Atoi(token)
+			if err != nil || i < 0 || i >= len(node) {
+				return nil, fmt.Errorf("%w: index %s", ErrMissingValue, token)
+			}
+			v = node[i]
+		default:
+			return nil, fmt.Errorf("%w: %s", ErrMissingValue, token)
+		}
+	}
+	return v, nil
+}
@@ -0,0 +1,84 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type linkedPet struct {
+	ID    string   `json:"id"`
+	Owner *string  `json:"owner,omitempty"`
+	Tags  []string `json:"tags,omitempty"`
+}
+
+func TestResolveLinkParameters(t *testing.T) {
+	response := linkedPet{ID: "p1", Owner: Ptr("ann/b~c"), Tags: []string{"dog", "small"}}
+
+	type query struct {
+		PetID string  `json:"petId"`
+		Tag   *string `json:"tag,omitempty"`
+		Limit *int    `json:"limit,omitempty"`
+	}
+
+	t.Run("expressions and constants", func(t *testing.T) {
+		var dst *query
+		err := ResolveLinkParameters(response, `{"petId":"$response.body#/id","tag":"$response.body#/tags/1","limit":10}`, &dst)
+		require.NoError(t, err)
+		assert.Equal(t, &query{PetID: "p1", Tag: Ptr("small"), Limit: Ptr(10)}, dst)
+	})
+
+	t.Run("missing value", func(t *testing.T) {
+		var dst *query
+		err := ResolveLinkParameters(linkedPet{ID: "p1"}, `{"tag":"$response.body#/owner"}`, &dst)
+		assert.ErrorIs(t, err, ErrMissingValue)
+		assert.ErrorContains(t, err, "parameter tag")
+	})
+
+	t.Run("unsupported expression", func(t *testing.T) {
+		var dst *query
+		err := ResolveLinkParameters(response, `{"petId":"$request.path.id"}`, &dst)
+		assert.ErrorContains(t, err, "runtime expression $request.path.id can't be evaluated against the response body")
+	})
+}
+
+func TestResolveLinkBody(t *testing.T) {
+	response := linkedPet{ID: "p1", Owner: Ptr("ann")}
+
+	var whole *linkedPet
+	require.NoError(t, ResolveLinkBody(&response, `"$response.body"`, &whole))
+	assert.Equal(t, &response, whole)
+
+	var constant *linkedPet
+	require.NoError(t, ResolveLinkBody(&response, `{"id":"$response.body#/id"}`, &constant))
+	assert.Equal(t, &linkedPet{ID: "$response.body#/id"}, constant)
+}
+
+func TestJSONPointerValue(t *testing.T) {
+	doc := map[string]any{"a/b": map[string]any{"m~n": []any{"x", "y"}}}
+
+	v, err := jsonPointerValue(doc, "/a~1b/m~0n/1")
+	require.NoError(t, err)
+	assert.Equal(t, "y", v)
+
+	v, err = jsonPointerValue(doc, "")
+	require.NoError(t, err)
+	assert.Equal(t, doc, v)
+
+	_, err = jsonPointerValue(doc, "/a~1b/m~0n/2")
+	assert.ErrorIs(t, err, ErrMissingValue)
+
+	_, err = jsonPointerValue(doc, "a")
+	assert.ErrorContains(t, err, `invalid JSON pointer "a"`)
+}
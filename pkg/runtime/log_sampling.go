@@ -0,0 +1,170 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// RequestLog describes a completed request, passed to a RequestLogger.
+type RequestLog struct {
+	Method string
+
+	// Path is the path of the operation in the spec for clients, e.g. /pets/{id},
+	// and the route pattern, or the URL path without one, for servers.
+	Path string
+
+	// URL is the URL of the request, with the values of sensitive parameters masked, see SensitiveParameters.
+	URL string
+
+	// Header is the header of the request, with the values of sensitive parameters masked.
+	Header http.Header
+
+	// StatusCode is zero if no response was received.
+	StatusCode int
+
+	// Duration is the time until the response was received, without reading a streamed body.
+	Duration time.Duration
+
+	// Err is the error sending the request or reading the response, if any.
+	Err error
+}
+
+// RequestLogger logs completed requests, sampled by the rate of their operation set with x-log-sample-rate.
+type RequestLogger func(ctx context.Context, entry RequestLog)
+
+// WithRequestLogger passes the requests executed by the client to logger once they complete.
+// Operations with x-log-sample-rate only pass that fraction of their requests, picked at random.
+func WithRequestLogger(logger RequestLogger) APIClientOption {
+	return func(c *Client) error {
+		c.requestLogger = logger
+		return nil
+	}
+}
+
+// sampleFloat returns a random number in [0, 1), replaced in tests.
+var sampleFloat = rand.Float64
+
+// SampleLog reports whether to log an event sampled at rate: always from 1 and never from 0,
+// at random with probability rate in between.
+func SampleLog(rate float64) bool {
+	return rate >= 1 || (rate > 0 && sampleFloat() < rate)
+}
+
+// SampleRequestLogs returns middleware passing the fraction rate of the requests of an operation to logger,
+// for servers sampling the logs of operations with x-log-sample-rate like generated clients do.
+// The rate is the <Operation>LogSampleRate constant of the generated code.
+// Wrap logger with MaskRequestLogs for operations with sensitive parameters.
+func SampleRequestLogs(logger RequestLogger, rate float64) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !SampleLog(rate) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			start := time.Now()
+			rec := &statusRecorder{ResponseWriter: w}
+			next.ServeHTTP(rec, r)
+			logger(r.Context(), RequestLog{
+				Method:     r.Method,
+				Path:       routePath(r),
+				URL:        r.URL.String(),
+				Header:     r.Header,
+				StatusCode: rec.statusCode(),
+				Duration:   time.Since(start),
+			})
+		})
+	}
+}
+
+// logSampleRateKey is the context key of the log sample rate of a request's operation.
+type logSampleRateKey struct{}
+
+// withLogSampleRate attaches the log sample rate of the operation to req, if set.
+func withLogSampleRate(req *http.Request, rate float64) {
+	if rate > 0 {
+		*req = *req.WithContext(context.WithValue(req.Context(), logSampleRateKey{}, rate))
+	}
+}
+
+// sampleRequestLog reports whether to log req, sampled at the rate of its operation.
+// Requests without a rate are always logged.
+func sampleRequestLog(req *http.Request) bool {
+	rate, ok := req.Context().Value(logSampleRateKey{}).(float64)
+	return !ok || SampleLog(rate)
+}
+
+// logRequest passes the request executed by the client to its RequestLogger, with its sensitive parameters masked.
+func (c *Client) logRequest(ctx context.Context, req *http.Request, operationPath string, start time.Time, resp *Response, err error) {
+	// the errors of the client are masked already
+	sensitive := requestSensitiveParameters(req)
+	entry := RequestLog{
+		Method:   req.Method,
+		Path:     operationPath,
+		URL:      sensitive.MaskURL(req.URL).String(),
+		Header:   sensitive.MaskHeader(req.Header),
+		Duration: time.Since(start),
+		Err:      err,
+	}
+	if resp != nil {
+		entry.StatusCode = resp.StatusCode
+	}
+	c.requestLogger(ctx, entry)
+}
+
+// routePath returns the path of the route pattern matching r, or its URL path without one.
+func routePath(r *http.Request) string {
+	if r.Pattern == "" {
+		return r.URL.Path
+	}
+	if _, path, ok := strings.Cut(r.Pattern, " "); ok {
+		return path
+	}
+	return r.Pattern
+}
+
+// statusRecorder records the status code written to a http.ResponseWriter.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	return r.ResponseWriter.Write(b)
+}
+
+// Unwrap returns the underlying http.ResponseWriter, for http.ResponseController.
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+// statusCode returns the status code written, http.StatusOK if none was.
+func (r *statusRecorder) statusCode() int {
+	if r.status == 0 {
+		return http.StatusOK
+	}
+	return r.status
+}
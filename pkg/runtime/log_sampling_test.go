@@ -0,0 +1,150 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// withSampleFloat makes sampling draw v for the duration of the test.
+func withSampleFloat(t *testing.T, v float64) {
+	t.Helper()
+	orig := sampleFloat
+	sampleFloat = func() float64 { return v }
+	t.Cleanup(func() { sampleFloat = orig })
+}
+
+type doerFunc func(ctx context.Context, req *http.Request) (*http.Response, error)
+
+func (f doerFunc) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return f(ctx, req)
+}
+
+func TestSampleLog(t *testing.T) {
+	withSampleFloat(t, 0.5)
+
+	assert.True(t, SampleLog(1))
+	assert.True(t, SampleLog(0.6))
+	assert.False(t, SampleLog(0.5))
+	assert.False(t, SampleLog(0.1))
+	assert.False(t, SampleLog(0))
+}
+
+func TestClient_requestLogger(t *testing.T) {
+	ctx := context.Background()
+	withSampleFloat(t, 0.5)
+
+	var logs []RequestLog
+	doer := doerFunc(func(_ context.Context, req *http.Request) (*http.Response, error) {
+		if req.URL.Path == "/down" {
+			return nil, errors.New("connection refused")
+		}
+		return &http.Response{StatusCode: http.StatusAccepted, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+	})
+	client, err := NewAPIClient("https://api.example.com", WithHTTPClient(doer),
+		WithRequestLogger(func(_ context.Context, entry RequestLog) {
+			logs = append(logs, entry)
+		}))
+	require.NoError(t, err)
+
+	execute := func(path string, rate float64) {
+		req, err := client.CreateRequest(ctx, RequestOptionsParameters{
+			RequestURL:    client.GetBaseURL() + path,
+			Method:        http.MethodPost,
+			LogSampleRate: rate,
+		})
+		require.NoError(t, err)
+		_, _ = client.ExecuteRequest(ctx, req, path)
+	}
+
+	t.Run("without sample rate", func(t *testing.T) {
+		logs = nil
+		execute("/orders", 0)
+		require.Len(t, logs, 1)
+		assert.Equal(t, http.MethodPost, logs[0].Method)
+		assert.Equal(t, "/orders", logs[0].Path)
+		assert.Equal(t, http.StatusAccepted, logs[0].StatusCode)
+		assert.NoError(t, logs[0].Err)
+	})
+
+	t.Run("sampled in", func(t *testing.T) {
+		logs = nil
+		execute("/orders", 0.9)
+		assert.Len(t, logs, 1)
+	})
+
+	t.Run("sampled out", func(t *testing.T) {
+		logs = nil
+		execute("/orders", 0.1)
+		assert.Empty(t, logs)
+	})
+
+	t.Run("failed request", func(t *testing.T) {
+		logs = nil
+		execute("/down", 0)
+		require.Len(t, logs, 1)
+		assert.Zero(t, logs[0].StatusCode)
+		assert.ErrorContains(t, logs[0].Err, "connection refused")
+	})
+
+	t.Run("stream request", func(t *testing.T) {
+		logs = nil
+		req, err := client.CreateRequest(ctx, RequestOptionsParameters{
+			RequestURL: client.GetBaseURL() + "/events",
+			Method:     http.MethodGet,
+		})
+		require.NoError(t, err)
+		resp, err := client.ExecuteStreamRequest(ctx, req, "/events")
+		require.NoError(t, err)
+		require.NoError(t, resp.Close())
+		require.Len(t, logs, 1)
+		assert.Equal(t, "/events", logs[0].Path)
+	})
+}
+
+func TestSampleRequestLogs(t *testing.T) {
+	withSampleFloat(t, 0.5)
+
+	var logs []RequestLog
+	logger := func(_ context.Context, entry RequestLog) {
+		logs = append(logs, entry)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /pets/{id}", SampleRequestLogs(logger, 0.9)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})))
+	mux.Handle("GET /health", SampleRequestLogs(logger, 0.1)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	})))
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/pets/1", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health", nil))
+	assert.Equal(t, "ok", rec.Body.String())
+
+	require.Len(t, logs, 1)
+	assert.Equal(t, http.MethodGet, logs[0].Method)
+	assert.Equal(t, "/pets/{id}", logs[0].Path)
+	assert.Equal(t, http.StatusNotFound, logs[0].StatusCode)
+}
@@ -0,0 +1,87 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"strings"
+	"sync"
+)
+
+// MaskFunc masks a sensitive value, formatted as a string, for x-sensitive-data with mask: custom.
+type MaskFunc func(value string) string
+
+// MaskingPolicy is the process-wide default of the masks that don't configure their own.
+type MaskingPolicy struct {
+	// Char is the character of the fixed masks and of the characters masked by regex. Defaults to *.
+	Char rune
+
+	// Algorithm is the hash algorithm of the hash masks without one: sha256 or sha512. Defaults to sha256.
+	Algorithm string
+}
+
+var (
+	maskingMu     sync.RWMutex
+	maskers       = make(map[string]MaskFunc)
+	maskingPolicy MaskingPolicy
+)
+
+// RegisterMasker registers fn as the custom mask name, referenced by x-sensitive-data: {mask: custom, name: <name>}.
+// Registering a name again replaces its function. Values of unregistered custom masks are masked fully.
+func RegisterMasker(name string, fn MaskFunc) {
+	maskingMu.Lock()
+	defer maskingMu.Unlock()
+	maskers[name] = fn
+}
+
+// masker returns the custom mask function registered as name.
+func masker(name string) (MaskFunc, bool) {
+	maskingMu.RLock()
+	defer maskingMu.RUnlock()
+	fn, ok := maskers[name]
+	return fn, ok
+}
+
+// SetMaskingPolicy sets the default of the masks of the process, e.g. while constructing a server.
+// Clients set it with WithMaskingPolicy.
+func SetMaskingPolicy(policy MaskingPolicy) {
+	maskingMu.Lock()
+	defer maskingMu.Unlock()
+	maskingPolicy = policy
+}
+
+// WithMaskingPolicy sets the default of the masks of the process when the client is created, see SetMaskingPolicy.
+// Masks apply to the types and errors of every client, so clients of a process should agree on it.
+func WithMaskingPolicy(policy MaskingPolicy) APIClientOption {
+	return func(*Client) error {
+		SetMaskingPolicy(policy)
+		return nil
+	}
+}
+
+// currentMaskingPolicy returns the masking policy with its defaults filled.
+func currentMaskingPolicy() MaskingPolicy {
+	maskingMu.RLock()
+	policy := maskingPolicy
+	maskingMu.RUnlock()
+
+	if policy.Char == 0 {
+		policy.Char = '*'
+	}
+	if policy.Algorithm == "" {
+		policy.Algorithm = "sha256"
+	}
+	return policy
+}
+
+// maskReplacement returns the fixed mask of the masking policy, as long as defaultMaskReplacement.
+func maskReplacement() string {
+	return strings.Repeat(string(currentMaskingPolicy().Char), len(defaultMaskReplacement))
+}
@@ -0,0 +1,78 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// withMaskingPolicy sets the masking policy for the duration of the test.
+func withMaskingPolicy(t *testing.T, policy MaskingPolicy) {
+	t.Helper()
+	SetMaskingPolicy(policy)
+	t.Cleanup(func() { SetMaskingPolicy(MaskingPolicy{}) })
+}
+
+func TestRegisterMasker(t *testing.T) {
+	RegisterMasker("last4", func(value string) string {
+		return "…" + value[len(value)-4:]
+	})
+	config := SensitiveDataConfig{Type: MaskTypeCustom, Name: "last4"}
+
+	assert.Equal(t, "…6789", MaskSensitiveValue("123-45-6789", config))
+	assert.Equal(t, "…1234", MaskSensitiveValue(991234, config))
+
+	t.Run("unregistered", func(t *testing.T) {
+		assert.Equal(t, defaultMaskReplacement, MaskSensitiveValue("secret", SensitiveDataConfig{Type: MaskTypeCustom, Name: "unknown"}))
+	})
+}
+
+func TestSensitiveDataConfig_Unmarshal_custom(t *testing.T) {
+	var config SensitiveDataConfig
+	require.NoError(t, config.Unmarshal(map[string]any{"type": "custom", "name": "last4"}))
+	assert.Equal(t, SensitiveDataConfig{Type: MaskTypeCustom, Name: "last4"}, config)
+
+	config = SensitiveDataConfig{}
+	require.NoError(t, config.Unmarshal(map[string]any{"mask": "custom", "name": "last4"}))
+	assert.Equal(t, SensitiveDataConfig{Type: MaskTypeCustom, Name: "last4"}, config)
+
+	config = SensitiveDataConfig{}
+	assert.ErrorContains(t, config.Unmarshal(map[string]any{"mask": "custom"}), "requires the name of a mask function")
+	assert.ErrorContains(t, config.Unmarshal("custom"), "requires the name of a mask function")
+}
+
+func TestSetMaskingPolicy(t *testing.T) {
+	withMaskingPolicy(t, MaskingPolicy{Char: '#', Algorithm: "sha512"})
+
+	assert.Equal(t, "########", MaskSensitiveValue("secret", SensitiveDataConfig{Type: MaskTypeFull}))
+	assert.Equal(t, "#####-6789", MaskSensitiveValue("12-34-6789", SensitiveDataConfig{Type: MaskTypeRegex, Pattern: `^\d+-\d+`}))
+	assert.Equal(t, "########6789", MaskSensitiveValue("123-45-6789", SensitiveDataConfig{Type: MaskTypePartial, KeepSuffix: 4}))
+	assert.Len(t, MaskSensitiveValue("secret", SensitiveDataConfig{Type: MaskTypeHash}), 128)
+	assert.Len(t, MaskSensitiveValue("secret", SensitiveDataConfig{Type: MaskTypeHash, Algorithm: "sha256"}), 64)
+
+	// the masks configured by the spec win
+	assert.Equal(t, "[hidden]", MaskSensitiveValue("secret", SensitiveDataConfig{Type: MaskTypeFull, Replacement: "[hidden]"}))
+
+	assert.Equal(t, "########", fmt.Sprint(NewSensitive("secret")))
+}
+
+func TestWithMaskingPolicy(t *testing.T) {
+	t.Cleanup(func() { SetMaskingPolicy(MaskingPolicy{}) })
+
+	_, err := NewAPIClient("https://api.example.com", WithMaskingPolicy(MaskingPolicy{Char: 'x'}))
+	require.NoError(t, err)
+
+	assert.Equal(t, "xxxxxxxx", MaskSensitiveValue("secret", SensitiveDataConfig{Type: MaskTypeFull}))
+}
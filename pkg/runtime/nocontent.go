@@ -0,0 +1,53 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// isNoContentStatus returns true for the status codes of responses without a body:
+// 204 No Content and 205 Reset Content.
+func isNoContentStatus(code int) bool {
+	return code == http.StatusNoContent || code == http.StatusResetContent
+}
+
+// WithStrictNoContent makes ExecuteRequest fail with ErrUnexpectedBody when a 204 No Content
+// or 205 Reset Content response has a body. By default the body of these responses is closed unread.
+func WithStrictNoContent() APIClientOption {
+	return func(c *Client) error {
+		c.strictNoContent = true
+		return nil
+	}
+}
+
+// closeNoContentBody closes the body of a no-content response. It is only read in strict mode,
+// to check that it is empty.
+func (c *Client) closeNoContentBody(ctx context.Context, resp *http.Response) error {
+	if !c.strictNoContent {
+		return resp.Body.Close()
+	}
+
+	data, err := readBody(ctx, struct {
+		io.Reader
+		io.Closer
+	}{io.LimitReader(resp.Body, 1), resp.Body})
+	if err != nil {
+		return fmt.Errorf("error reading response body: %w", err)
+	}
+	if len(data) > 0 {
+		return fmt.Errorf("%w: %d response", ErrUnexpectedBody, resp.StatusCode)
+	}
+	return nil
+}
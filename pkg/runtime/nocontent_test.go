@@ -0,0 +1,83 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type trackedBody struct {
+	io.Reader
+	read   bool
+	closed bool
+}
+
+func (b *trackedBody) Read(p []byte) (int, error) {
+	b.read = true
+	return b.Reader.Read(p)
+}
+
+func (b *trackedBody) Close() error {
+	b.closed = true
+	return nil
+}
+
+func TestClient_ExecuteRequest_noContent(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		strict  bool
+		read    bool
+		content string
+		err     error
+	}{
+		{name: "204 is not read", status: http.StatusNoContent, body: "ignored"},
+		{name: "205 is not read", status: http.StatusResetContent, body: "ignored"},
+		{name: "200 is read", status: http.StatusOK, body: "{}", read: true, content: "{}"},
+		{name: "strict empty body", status: http.StatusNoContent, strict: true, read: true},
+		{name: "strict body", status: http.StatusResetContent, body: "{}", strict: true, read: true, err: ErrUnexpectedBody},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := &trackedBody{Reader: strings.NewReader(tt.body)}
+			doer := doerFunc(func(_ context.Context, _ *http.Request) (*http.Response, error) {
+				return &http.Response{StatusCode: tt.status, Body: body}, nil
+			})
+			opts := []APIClientOption{WithHTTPClient(doer)}
+			if tt.strict {
+				opts = append(opts, WithStrictNoContent())
+			}
+			client, err := NewAPIClient("https://api.example.com", opts...)
+			require.NoError(t, err)
+
+			req, _ := http.NewRequest(http.MethodPost, "https://api.example.com/pets/1/reset", nil)
+			resp, err := client.ExecuteRequest(context.Background(), req, "/pets/{id}/reset")
+			assert.True(t, body.closed)
+			assert.Equal(t, tt.read, body.read)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.status, resp.StatusCode)
+			assert.Equal(t, tt.content, string(resp.Content))
+		})
+	}
+}
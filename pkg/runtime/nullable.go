@@ -0,0 +1,114 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Nullable is an optional and nullable value, telling apart an absent property from an explicit null.
+// The zero value is absent: with the `omitzero` JSON tag option it is left out when marshaling,
+// e.g. to send only the changed properties of a PATCH request, and Null() sends an explicit null.
+type Nullable[T any] struct {
+	value     T
+	specified bool
+	null      bool
+}
+
+// NewNullable returns a Nullable set to v.
+func NewNullable[T any](v T) Nullable[T] {
+	return Nullable[T]{value: v, specified: true}
+}
+
+// Null returns a Nullable set to null.
+func Null[T any]() Nullable[T] {
+	return Nullable[T]{specified: true, null: true}
+}
+
+// Get returns the value and true if it is set, i.e. neither absent nor null.
+func (n Nullable[T]) Get() (T, bool) {
+	return n.value, n.specified && !n.null
+}
+
+// nullable is implemented by Nullable to look into its value without knowing T.
+type nullable interface {
+	nullableValue() (any, bool)
+}
+
+func (n Nullable[T]) nullableValue() (any, bool) {
+	return n.Get()
+}
+
+// Set sets the value to v.
+func (n *Nullable[T]) Set(v T) {
+	*n = NewNullable(v)
+}
+
+// SetNull sets the value to null.
+func (n *Nullable[T]) SetNull() {
+	*n = Null[T]()
+}
+
+// Unset makes the value absent.
+func (n *Nullable[T]) Unset() {
+	*n = Nullable[T]{}
+}
+
+// IsNull returns true if the value is an explicit null.
+func (n Nullable[T]) IsNull() bool {
+	return n.specified && n.null
+}
+
+// IsSpecified returns true if the value is present, either set or null.
+func (n Nullable[T]) IsSpecified() bool {
+	return n.specified
+}
+
+// IsZero returns true if the value is absent, so the `omitzero` JSON tag option leaves it out.
+func (n Nullable[T]) IsZero() bool {
+	return !n.specified
+}
+
+// Ptr returns a pointer to the value, or nil if it is absent or null.
+func (n Nullable[T]) Ptr() *T {
+	if v, ok := n.Get(); ok {
+		return &v
+	}
+	return nil
+}
+
+// MarshalJSON marshals the value, or null if it is absent or null.
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	if v, ok := n.Get(); ok {
+		return json.Marshal(v)
+	}
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON sets the value, or null, as the property is present.
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		n.SetNull()
+		return nil
+	}
+
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	n.Set(v)
+	return nil
+}
@@ -0,0 +1,97 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNullable(t *testing.T) {
+	t.Run("absent", func(t *testing.T) {
+		var n Nullable[string]
+		_, ok := n.Get()
+		assert.False(t, ok)
+		assert.False(t, n.IsSpecified())
+		assert.False(t, n.IsNull())
+		assert.True(t, n.IsZero())
+		assert.Nil(t, n.Ptr())
+	})
+
+	t.Run("null", func(t *testing.T) {
+		n := Null[string]()
+		_, ok := n.Get()
+		assert.False(t, ok)
+		assert.True(t, n.IsSpecified())
+		assert.True(t, n.IsNull())
+		assert.False(t, n.IsZero())
+		assert.Nil(t, n.Ptr())
+	})
+
+	t.Run("value", func(t *testing.T) {
+		n := NewNullable("a")
+		v, ok := n.Get()
+		assert.True(t, ok)
+		assert.Equal(t, "a", v)
+		assert.False(t, n.IsNull())
+		assert.Equal(t, "a", *n.Ptr())
+	})
+
+	t.Run("setters", func(t *testing.T) {
+		var n Nullable[int]
+		n.Set(1)
+		assert.Equal(t, NewNullable(1), n)
+		n.SetNull()
+		assert.Equal(t, Null[int](), n)
+		n.Unset()
+		assert.Equal(t, Nullable[int]{}, n)
+	})
+}
+
+func TestNullable_JSON(t *testing.T) {
+	type patch struct {
+		Name  Nullable[string] `json:"name,omitzero"`
+		Age   Nullable[int]    `json:"age,omitzero"`
+		Email Nullable[string] `json:"email,omitzero"`
+	}
+
+	t.Run("marshal", func(t *testing.T) {
+		data, err := json.Marshal(patch{Name: NewNullable("Ann"), Age: Null[int]()})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"name":"Ann","age":null}`, string(data))
+	})
+
+	t.Run("marshal without omitzero", func(t *testing.T) {
+		data, err := json.Marshal(struct {
+			Name Nullable[string] `json:"name"`
+		}{})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"name":null}`, string(data))
+	})
+
+	t.Run("unmarshal", func(t *testing.T) {
+		var res patch
+		require.NoError(t, json.Unmarshal([]byte(`{"name":"Ann","age": null }`), &res))
+		assert.Equal(t, patch{Name: NewNullable("Ann"), Age: Null[int]()}, res)
+	})
+
+	t.Run("unmarshal error", func(t *testing.T) {
+		var res patch
+		assert.Error(t, json.Unmarshal([]byte(`{"age":"x"}`), &res))
+	})
+}
@@ -0,0 +1,292 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// OneOf3 holds one of three union variants, A, B or C, with N set to its position.
+// Unmarshaling picks the variant the same way as Either.
+type OneOf3[A, B, C any] struct {
+	A A `validate:"-"`
+	B B `validate:"-"`
+	C C `validate:"-"`
+
+	N int
+}
+
+func NewOneOf3FromA[A, B, C any](a A) OneOf3[A, B, C] {
+	return OneOf3[A, B, C]{A: a, N: 1}
+}
+
+func NewOneOf3FromB[A, B, C any](b B) OneOf3[A, B, C] {
+	return OneOf3[A, B, C]{B: b, N: 2}
+}
+
+func NewOneOf3FromC[A, B, C any](c C) OneOf3[A, B, C] {
+	return OneOf3[A, B, C]{C: c, N: 3}
+}
+
+func (t *OneOf3[A, B, C]) IsA() bool {
+	return t.N == 1
+}
+
+func (t *OneOf3[A, B, C]) IsB() bool {
+	return t.N == 2
+}
+
+func (t *OneOf3[A, B, C]) IsC() bool {
+	return t.N == 3
+}
+
+// Value returns the held variant, nil if there is none.
+func (t *OneOf3[A, B, C]) Value() any {
+	switch t.N {
+	case 1:
+		return t.A
+	case 2:
+		return t.B
+	case 3:
+		return t.C
+	default:
+		return nil
+	}
+}
+
+// MarshalJSON implements json.Marshaler interface
+func (t OneOf3[A, B, C]) MarshalJSON() ([]byte, error) {
+	return marshalVariant(t.Value())
+}
+
+func (t *OneOf3[A, B, C]) UnmarshalJSON(data []byte) error {
+	var res OneOf3[A, B, C]
+	if isJSONNull(data) {
+		*t = res
+		return nil
+	}
+
+	n, err := pickVariant(
+		unmarshalVariant(data, &res.A),
+		unmarshalVariant(data, &res.B),
+		unmarshalVariant(data, &res.C),
+	)
+	if err != nil {
+		return err
+	}
+
+	*t = OneOf3[A, B, C]{N: n}
+	switch n {
+	case 1:
+		t.A = res.A
+	case 2:
+		t.B = res.B
+	case 3:
+		t.C = res.C
+	}
+	return nil
+}
+
+// UnmarshalVariant decodes data as the variant at the 1-based position n and holds it.
+func (t *OneOf3[A, B, C]) UnmarshalVariant(n int, data []byte) error {
+	var res OneOf3[A, B, C]
+	if err := unmarshalVariantAt(n, data, &res.A, &res.B, &res.C); err != nil {
+		return err
+	}
+	res.N = n
+	*t = res
+	return nil
+}
+
+func (t *OneOf3[A, B, C]) Validate() error {
+	return validateVariant(t.Value())
+}
+
+// OneOf4 holds one of four union variants, A, B, C or D, with N set to its position.
+// Unmarshaling picks the variant the same way as Either.
+type OneOf4[A, B, C, D any] struct {
+	A A `validate:"-"`
+	B B `validate:"-"`
+	C C `validate:"-"`
+	D D `validate:"-"`
+
+	N int
+}
+
+func NewOneOf4FromA[A, B, C, D any](a A) OneOf4[A, B, C, D] {
+	return OneOf4[A, B, C, D]{A: a, N: 1}
+}
+
+func NewOneOf4FromB[A, B, C, D any](b B) OneOf4[A, B, C, D] {
+	return OneOf4[A, B, C, D]{B: b, N: 2}
+}
+
+func NewOneOf4FromC[A, B, C, D any](c C) OneOf4[A, B, C, D] {
+	return OneOf4[A, B, C, D]{C: c, N: 3}
+}
+
+func NewOneOf4FromD[A, B, C, D any](d D) OneOf4[A, B, C, D] {
+	return OneOf4[A, B, C, D]{D: d, N: 4}
+}
+
+func (t *OneOf4[A, B, C, D]) IsA() bool {
+	return t.N == 1
+}
+
+func (t *OneOf4[A, B, C, D]) IsB() bool {
+	return t.N == 2
+}
+
+func (t *OneOf4[A, B, C, D]) IsC() bool {
+	return t.N == 3
+}
+
+func (t *OneOf4[A, B, C, D]) IsD() bool {
+	return t.N == 4
+}
+
+// Value returns the held variant, nil if there is none.
+func (t *OneOf4[A, B, C, D]) Value() any {
+	switch t.N {
+	case 1:
+		return t.A
+	case 2:
+		return t.B
+	case 3:
+		return t.C
+	case 4:
+		return t.D
+	default:
+		return nil
+	}
+}
+
+// MarshalJSON implements json.Marshaler interface
+func (t OneOf4[A, B, C, D]) MarshalJSON() ([]byte, error) {
+	return marshalVariant(t.Value())
+}
+
+func (t *OneOf4[A, B, C, D]) UnmarshalJSON(data []byte) error {
+	var res OneOf4[A, B, C, D]
+	if isJSONNull(data) {
+		*t = res
+		return nil
+	}
+
+	n, err := pickVariant(
+		unmarshalVariant(data, &res.A),
+		unmarshalVariant(data, &res.B),
+		unmarshalVariant(data, &res.C),
+		unmarshalVariant(data, &res.D),
+	)
+	if err != nil {
+		return err
+	}
+
+	*t = OneOf4[A, B, C, D]{N: n}
+	switch n {
+	case 1:
+		t.A = res.A
+	case 2:
+		t.B = res.B
+	case 3:
+		t.C = res.C
+	case 4:
+		t.D = res.D
+	}
+	return nil
+}
+
+// UnmarshalVariant decodes data as the variant at the 1-based position n and holds it.
+func (t *OneOf4[A, B, C, D]) UnmarshalVariant(n int, data []byte) error {
+	var res OneOf4[A, B, C, D]
+	if err := unmarshalVariantAt(n, data, &res.A, &res.B, &res.C, &res.D); err != nil {
+		return err
+	}
+	res.N = n
+	*t = res
+	return nil
+}
+
+func (t *OneOf4[A, B, C, D]) Validate() error {
+	return validateVariant(t.Value())
+}
+
+// variantFit describes how well data fits a union variant.
+type variantFit struct {
+	decoded bool
+	valid   bool
+	nonZero bool
+}
+
+// unmarshalVariant decodes data into dst and reports how well it fits.
+func unmarshalVariant[T any](data []byte, dst *T) variantFit {
+	if err := unmarshalUnionVariant(data, dst); err != nil {
+		return variantFit{}
+	}
+	fit := variantFit{decoded: true, valid: true, nonZero: isNonZero(*dst)}
+	if v, ok := any(*dst).(Validator); ok {
+		fit.valid = v.Validate() == nil
+	}
+	return fit
+}
+
+// pickVariant returns the 1-based position of the variant data fits best: the only decoded one,
+// otherwise the first that validates and looks non-zero, preferring validation over non-zero.
+func pickVariant(fits ...variantFit) (int, error) {
+	candidates := make([]int, 0, len(fits))
+	for i, fit := range fits {
+		if fit.decoded {
+			candidates = append(candidates, i)
+		}
+	}
+	if len(candidates) == 0 {
+		return 0, ErrFailedToUnmarshalUnion
+	}
+
+	candidates = preferVariants(candidates, func(i int) bool { return fits[i].valid })
+	candidates = preferVariants(candidates, func(i int) bool { return fits[i].nonZero })
+	return candidates[0] + 1, nil
+}
+
+// preferVariants returns the candidates matching pred, or all of them if none does.
+func preferVariants(candidates []int, pred func(int) bool) []int {
+	var res []int
+	for _, i := range candidates {
+		if pred(i) {
+			res = append(res, i)
+		}
+	}
+	if len(res) == 0 {
+		return candidates
+	}
+	return res
+}
+
+func marshalVariant(v any) ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(v)
+}
+
+func validateVariant(v any) error {
+	if v, ok := v.(Validator); ok {
+		return v.Validate()
+	}
+	return nil
+}
+
+func isJSONNull(data []byte) bool {
+	trim := bytes.TrimSpace(data)
+	return len(trim) == 0 || bytes.Equal(trim, []byte("null"))
+}
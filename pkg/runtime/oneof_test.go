@@ -0,0 +1,168 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOneOf3(t *testing.T) {
+	a := NewOneOf3FromA[string, int, bool]("test")
+	assert.True(t, a.IsA())
+	assert.False(t, a.IsB())
+	assert.False(t, a.IsC())
+	assert.Equal(t, "test", a.Value())
+
+	b := NewOneOf3FromB[string, int, bool](10)
+	assert.True(t, b.IsB())
+	assert.Equal(t, 10, b.Value())
+
+	c := NewOneOf3FromC[string, int, bool](true)
+	assert.True(t, c.IsC())
+	assert.Equal(t, true, c.Value())
+
+	var zero OneOf3[string, int, bool]
+	assert.Nil(t, zero.Value())
+}
+
+func TestNewOneOf4(t *testing.T) {
+	a := NewOneOf4FromA[string, int, bool, []string]("test")
+	assert.True(t, a.IsA())
+	assert.Equal(t, "test", a.Value())
+
+	b := NewOneOf4FromB[string, int, bool, []string](10)
+	assert.True(t, b.IsB())
+	assert.Equal(t, 10, b.Value())
+
+	c := NewOneOf4FromC[string, int, bool, []string](true)
+	assert.True(t, c.IsC())
+	assert.Equal(t, true, c.Value())
+
+	d := NewOneOf4FromD[string, int, bool, []string]([]string{"x"})
+	assert.True(t, d.IsD())
+	assert.False(t, d.IsA())
+	assert.Equal(t, []string{"x"}, d.Value())
+
+	var zero OneOf4[string, int, bool, []string]
+	assert.Nil(t, zero.Value())
+}
+
+func TestOneOf3_JSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected OneOf3[string, int, bool]
+	}{
+		{name: "string", input: `"test"`, expected: NewOneOf3FromA[string, int, bool]("test")},
+		{name: "int", input: `10`, expected: NewOneOf3FromB[string, int, bool](10)},
+		{name: "bool", input: `true`, expected: NewOneOf3FromC[string, int, bool](true)},
+		{name: "null", input: `null`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := NewOneOf3FromA[string, int, bool]("previous")
+			require.NoError(t, json.Unmarshal([]byte(tt.input), &res))
+			assert.Equal(t, tt.expected, res)
+
+			data, err := json.Marshal(res)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.input, string(data))
+		})
+	}
+
+	t.Run("no variant fits", func(t *testing.T) {
+		var res OneOf3[string, int, bool]
+		err := json.Unmarshal([]byte(`{"a":1}`), &res)
+		assert.ErrorIs(t, err, ErrFailedToUnmarshalUnion)
+	})
+}
+
+func TestOneOf4_JSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected OneOf4[string, int, bool, []string]
+	}{
+		{name: "string", input: `"test"`, expected: NewOneOf4FromA[string, int, bool, []string]("test")},
+		{name: "int", input: `10`, expected: NewOneOf4FromB[string, int, bool, []string](10)},
+		{name: "bool", input: `false`, expected: NewOneOf4FromC[string, int, bool, []string](false)},
+		{name: "array", input: `["x","y"]`, expected: NewOneOf4FromD[string, int, bool, []string]([]string{"x", "y"})},
+		{name: "null", input: `null`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var res OneOf4[string, int, bool, []string]
+			require.NoError(t, json.Unmarshal([]byte(tt.input), &res))
+			assert.Equal(t, tt.expected, res)
+
+			data, err := json.Marshal(res)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.input, string(data))
+		})
+	}
+
+	t.Run("no variant fits", func(t *testing.T) {
+		var res OneOf4[string, int, bool, []string]
+		err := json.Unmarshal([]byte(`{"a":1}`), &res)
+		assert.ErrorIs(t, err, ErrFailedToUnmarshalUnion)
+	})
+}
+
+func TestOneOf3_UnmarshalJSON_Disambiguation(t *testing.T) {
+	type union = OneOf3[PersonWithRequired, PersonWithoutRequired, map[string]any]
+
+	t.Run("prefers the variant that validates", func(t *testing.T) {
+		var res union
+		require.NoError(t, json.Unmarshal([]byte(`{"age":30}`), &res))
+		assert.True(t, res.IsB())
+		assert.Equal(t, PersonWithoutRequired{Age: 30}, res.B)
+	})
+
+	t.Run("ties go to the first variant", func(t *testing.T) {
+		var res union
+		require.NoError(t, json.Unmarshal([]byte(`{"name":"John"}`), &res))
+		assert.True(t, res.IsA())
+		assert.Equal(t, PersonWithRequired{Name: "John"}, res.A)
+	})
+
+	t.Run("prefers the non-zero variant", func(t *testing.T) {
+		var res OneOf3[PersonWithoutRequired, struct{}, map[string]any]
+		require.NoError(t, json.Unmarshal([]byte(`{"other":true}`), &res))
+		assert.True(t, res.IsC())
+		assert.Equal(t, map[string]any{"other": true}, res.C)
+	})
+}
+
+func TestOneOf_Validate(t *testing.T) {
+	valid := ValidatableStruct{Name: "John", Age: 30}
+	invalid := ValidatableStruct{}
+
+	three := NewOneOf3FromB[string, ValidatableStruct, int](valid)
+	assert.NoError(t, three.Validate())
+	three = NewOneOf3FromB[string, ValidatableStruct, int](invalid)
+	assert.Error(t, three.Validate())
+	three = NewOneOf3FromA[string, ValidatableStruct, int]("")
+	assert.NoError(t, three.Validate())
+
+	four := NewOneOf4FromD[string, int, bool, ValidatableStruct](invalid)
+	assert.Error(t, four.Validate())
+	four = NewOneOf4FromC[string, int, bool, ValidatableStruct](false)
+	assert.NoError(t, four.Validate())
+
+	var zero OneOf4[string, int, bool, ValidatableStruct]
+	assert.NoError(t, zero.Validate())
+}
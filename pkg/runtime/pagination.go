@@ -0,0 +1,83 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// NextLink returns the URL of the next page in the Link headers of a response (RFC 8288), the target of
+// the link with the next relation type, resolved against the URL of the request. Empty if there is none.
+func NextLink(requestURL *url.URL, header http.Header) string {
+	for _, value := range header.Values("Link") {
+		for value != "" {
+			start := strings.IndexByte(value, '<')
+			end := strings.IndexByte(value, '>')
+			if start < 0 || end < start {
+				break
+			}
+			target := value[start+1 : end]
+
+			var params string
+			params, value = cutLinkParams(value[end+1:])
+			if !isNextLink(params) {
+				continue
+			}
+
+			next, err := url.Parse(strings.TrimSpace(target))
+			if err != nil {
+				continue
+			}
+			if requestURL != nil {
+				next = requestURL.ResolveReference(next)
+			}
+			return next.String()
+		}
+	}
+	return ""
+}
+
+// cutLinkParams returns the parameters of a link value, up to the comma separating it from the next
+// one outside quoted strings, and the rest of the header value.
+func cutLinkParams(s string) (string, string) {
+	quoted := false
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '"':
+			quoted = !quoted
+		case '\\':
+			i++
+		case ',':
+			if !quoted {
+				return s[:i], s[i+1:]
+			}
+		}
+	}
+	return s, ""
+}
+
+// isNextLink reports whether the rel parameter of a link lists the next relation type.
+func isNextLink(params string) bool {
+	for _, param := range strings.Split(params, ";") {
+		name, value, _ := strings.Cut(param, "=")
+		if !strings.EqualFold(strings.TrimSpace(name), "rel") {
+			continue
+		}
+		for _, rel := range strings.Fields(strings.Trim(strings.TrimSpace(value), `"`)) {
+			if strings.EqualFold(rel, "next") {
+				return true
+			}
+		}
+	}
+	return false
+}
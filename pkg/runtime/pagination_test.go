@@ -0,0 +1,65 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNextLink(t *testing.T) {
+	requestURL, _ := url.Parse("https://api.example.com/pets?page=1")
+
+	tests := []struct {
+		name  string
+		links []string
+		want  string
+	}{
+		{name: "no link"},
+		{
+			name:  "next",
+			links: []string{`<https://api.example.com/pets?page=2>; rel="next", <https://api.example.com/pets?page=5>; rel="last"`},
+			want:  "https://api.example.com/pets?page=2",
+		},
+		{
+			name:  "relative",
+			links: []string{`</pets?page=2>; rel=next`},
+			want:  "https://api.example.com/pets?page=2",
+		},
+		{
+			name:  "relation types",
+			links: []string{`<https://api.example.com/pets?page=1>; rel="prev first"`, `<https://api.example.com/pets?page=2>; title="a, b"; rel="last next"`},
+			want:  "https://api.example.com/pets?page=2",
+		},
+		{
+			name:  "comma in target",
+			links: []string{`<https://api.example.com/pets?ids=1,2>; rel="next"`},
+			want:  "https://api.example.com/pets?ids=1,2",
+		},
+		{
+			name:  "no next",
+			links: []string{`<https://api.example.com/pets?page=1>; rel="prev"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			for _, link := range tt.links {
+				header.Add("Link", link)
+			}
+			assert.Equal(t, tt.want, NextLink(requestURL, header))
+		})
+	}
+}
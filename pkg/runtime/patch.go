@@ -0,0 +1,300 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// JSONPatchOp is the operation of a JSON Patch (RFC 6902) operation.
+type JSONPatchOp string
+
+const (
+	JSONPatchAdd     JSONPatchOp = "add"
+	JSONPatchRemove  JSONPatchOp = "remove"
+	JSONPatchReplace JSONPatchOp = "replace"
+	JSONPatchMove    JSONPatchOp = "move"
+	JSONPatchCopy    JSONPatchOp = "copy"
+	JSONPatchTest    JSONPatchOp = "test"
+)
+
+// JSONPatchOperation is a single operation of a JSON Patch document.
+// Path and From are JSON Pointers (RFC 6901), From is only used by move and copy,
+// Value only by add, replace and test, which always send it, even if it is nil.
+type JSONPatchOperation struct {
+	Op    JSONPatchOp
+	Path  string
+	From  string
+	Value any
+}
+
+// JSONPatch is a JSON Patch document, the body of application/json-patch+json requests.
+type JSONPatch []JSONPatchOperation
+
+// MarshalJSON writes the members used by the operation.
+func (o JSONPatchOperation) MarshalJSON() ([]byte, error) {
+	res := struct {
+		Op    JSONPatchOp `json:"op"`
+		Path  string      `json:"path"`
+		From  *string     `json:"from,omitempty"`
+		Value *any        `json:"value,omitempty"`
+	}{Op: o.Op, Path: o.Path}
+
+	switch o.Op {
+	case JSONPatchMove, JSONPatchCopy:
+		res.From = &o.From
+	case JSONPatchAdd, JSONPatchReplace, JSONPatchTest:
+		res.Value = &o.Value
+	}
+	return json.Marshal(res)
+}
+
+// UnmarshalJSON reads an operation of a JSON Patch document.
+func (o *JSONPatchOperation) UnmarshalJSON(data []byte) error {
+	var res struct {
+		Op    JSONPatchOp `json:"op"`
+		Path  string      `json:"path"`
+		From  string      `json:"from"`
+		Value any         `json:"value"`
+	}
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+	*o = JSONPatchOperation{Op: res.Op, Path: res.Path, From: res.From, Value: res.Value}
+	return nil
+}
+
+// Validate checks that every operation is known and has valid JSON Pointers.
+func (p JSONPatch) Validate() error {
+	var errs ValidationErrors
+	for i, o := range p {
+		field := "[" + strconv.Itoa(i) + "]"
+		switch o.Op {
+		case JSONPatchAdd, JSONPatchRemove, JSONPatchReplace, JSONPatchTest:
+		case JSONPatchMove, JSONPatchCopy:
+			if !isJSONPointer(o.From) {
+				errs = errs.Add(field+".From", fmt.Sprintf("invalid JSON pointer %q", o.From))
+			}
+		default:
+			errs = errs.Add(field+".Op", fmt.Sprintf("unknown operation %q", o.Op))
+		}
+		if !isJSONPointer(o.Path) {
+			errs = errs.Add(field+".Path", fmt.Sprintf("invalid JSON pointer %q", o.Path))
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+func isJSONPointer(s string) bool {
+	return s == "" || strings.HasPrefix(s, "/")
+}
+
+// CreateJSONPatch returns the JSON Patch turning from into to.
+// Both values are compared by their JSON representation as request bodies, so readOnly properties are ignored.
+// Object members are added, removed and replaced one by one,
+// arrays of the same length element by element, other arrays are replaced as a whole.
+func CreateJSONPatch(from, to any) (JSONPatch, error) {
+	fromValue, toValue, err := decodePatchValues(from, to)
+	if err != nil {
+		return nil, err
+	}
+	return diffJSONPatch(nil, "", fromValue, toValue), nil
+}
+
+func diffJSONPatch(patch JSONPatch, path string, from, to any) JSONPatch {
+	fromObject, fromIsObject := from.(map[string]any)
+	toObject, toIsObject := to.(map[string]any)
+	if fromIsObject && toIsObject {
+		for _, key := range sortedKeys(fromObject) {
+			if _, ok := toObject[key]; !ok {
+				patch = append(patch, JSONPatchOperation{Op: JSONPatchRemove, Path: path + "/" + escapeJSONPointer(key)})
+			}
+		}
+		for _, key := range sortedKeys(toObject) {
+			keyPath := path + "/" + escapeJSONPointer(key)
+			if fromValue, ok := fromObject[key]; ok {
+				patch = diffJSONPatch(patch, keyPath, fromValue, toObject[key])
+				continue
+			}
+			patch = append(patch, JSONPatchOperation{Op: JSONPatchAdd, Path: keyPath, Value: toObject[key]})
+		}
+		return patch
+	}
+
+	fromArray, fromIsArray := from.([]any)
+	toArray, toIsArray := to.([]any)
+	if fromIsArray && toIsArray && len(fromArray) == len(toArray) {
+		for i := range toArray {
+			patch = diffJSONPatch(patch, path+"/"+strconv.Itoa(i), fromArray[i], toArray[i])
+		}
+		return patch
+	}
+
+	if !reflect.DeepEqual(from, to) {
+		patch = append(patch, JSONPatchOperation{Op: JSONPatchReplace, Path: path, Value: to})
+	}
+	return patch
+}
+
+// CreateMergePatch returns the JSON Merge Patch (RFC 7386) turning from into to.
+// Both values are compared by their JSON representation as request bodies, so readOnly properties are ignored.
+// Removed object members are set to null, so null members of to can't be expressed and are left out.
+func CreateMergePatch(from, to any) (json.RawMessage, error) {
+	fromValue, toValue, err := decodePatchValues(from, to)
+	if err != nil {
+		return nil, err
+	}
+	patch, _ := diffMergePatch(fromValue, toValue)
+	return json.Marshal(patch)
+}
+
+// diffMergePatch returns the merge patch turning from into to and whether there is any difference.
+func diffMergePatch(from, to any) (any, bool) {
+	toObject, toIsObject := to.(map[string]any)
+	if !toIsObject {
+		return to, !reflect.DeepEqual(from, to)
+	}
+
+	fromObject, fromIsObject := from.(map[string]any)
+	patch := map[string]any{}
+	for key, fromValue := range fromObject {
+		if toValue, ok := toObject[key]; (!ok || toValue == nil) && fromValue != nil {
+			patch[key] = nil
+		}
+	}
+	for key, toValue := range toObject {
+		if toValue == nil {
+			continue
+		}
+		if value, changed := diffMergePatch(fromObject[key], toValue); changed {
+			patch[key] = value
+		}
+	}
+	return patch, !fromIsObject || len(patch) > 0
+}
+
+// NewMergePatch returns the merge patch turning from into to, decoded into the patch type P,
+// usually a struct of runtime.Nullable properties: removed properties are null,
+// changed ones are set to their new value and unchanged ones are left out.
+// Unlike CreateMergePatch, changed nested objects are sent in full,
+// so properties removed from a nested object are not removed by the patch.
+func NewMergePatch[P any](from, to any) (*P, error) {
+	fromObject, err := marshalPatchObject(from)
+	if err != nil {
+		return nil, err
+	}
+	toObject, err := marshalPatchObject(to)
+	if err != nil {
+		return nil, err
+	}
+
+	patch := map[string]json.RawMessage{}
+	for key := range fromObject {
+		if _, ok := toObject[key]; !ok {
+			patch[key] = json.RawMessage("null")
+		}
+	}
+	for key, toValue := range toObject {
+		if fromValue, ok := fromObject[key]; !ok || !jsonEqual(fromValue, toValue) {
+			patch[key] = toValue
+		}
+	}
+
+	data, err := json.Marshal(patch)
+	if err != nil {
+		return nil, err
+	}
+	res := new(P)
+	if err = json.Unmarshal(data, res); err != nil {
+		return nil, fmt.Errorf("error decoding merge patch: %w", err)
+	}
+	return res, nil
+}
+
+// decodePatchValues decodes the request body JSON of from and to into generic values.
+func decodePatchValues(from, to any) (any, any, error) {
+	fromValue, err := decodePatchValue(from)
+	if err != nil {
+		return nil, nil, err
+	}
+	toValue, err := decodePatchValue(to)
+	if err != nil {
+		return nil, nil, err
+	}
+	return fromValue, toValue, nil
+}
+
+func decodePatchValue(v any) (any, error) {
+	data, err := MarshalJSONForRequest(v)
+	if err != nil {
+		return nil, err
+	}
+	return decodeJSONNumbers(data)
+}
+
+func decodeJSONNumbers(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var res any
+	if err := decoder.Decode(&res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// marshalPatchObject returns the members of the request body JSON of v, which must be an object.
+func marshalPatchObject(v any) (map[string]json.RawMessage, error) {
+	data, err := MarshalJSONForRequest(v)
+	if err != nil {
+		return nil, err
+	}
+	var res map[string]json.RawMessage
+	if err = json.Unmarshal(data, &res); err != nil {
+		return nil, fmt.Errorf("error creating merge patch: %w", err)
+	}
+	if res == nil {
+		return nil, errors.New("error creating merge patch: value is not an object")
+	}
+	return res, nil
+}
+
+func jsonEqual(a, b json.RawMessage) bool {
+	aValue, errA := decodeJSONNumbers(a)
+	bValue, errB := decodeJSONNumbers(b)
+	if errA != nil || errB != nil {
+		return bytes.Equal(a, b)
+	}
+	return reflect.DeepEqual(aValue, bValue)
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// escapeJSONPointer escapes a reference token of a JSON Pointer.
+func escapeJSONPointer(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, "~", "~0"), "/", "~1")
+}
@@ -0,0 +1,250 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type patchAddress struct {
+	City   string  `json:"city"`
+	Street *string `json:"street,omitempty"`
+}
+
+type patchPet struct {
+	Name    string        `json:"name"`
+	Tag     *string       `json:"tag,omitempty"`
+	Tags    []string      `json:"tags,omitempty"`
+	Address *patchAddress `json:"address,omitempty"`
+}
+
+type patchPetMergePatch struct {
+	Name    Nullable[string]       `json:"name,omitzero"`
+	Tag     Nullable[string]       `json:"tag,omitzero"`
+	Tags    Nullable[[]string]     `json:"tags,omitzero"`
+	Address Nullable[patchAddress] `json:"address,omitzero"`
+}
+
+func TestJSONPatchOperation_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		op       JSONPatchOperation
+		expected string
+	}{
+		{name: "add", op: JSONPatchOperation{Op: JSONPatchAdd, Path: "/a", Value: 1}, expected: `{"op":"add","path":"/a","value":1}`},
+		{name: "add null", op: JSONPatchOperation{Op: JSONPatchAdd, Path: "/a"}, expected: `{"op":"add","path":"/a","value":null}`},
+		{name: "remove", op: JSONPatchOperation{Op: JSONPatchRemove, Path: "/a", Value: 1}, expected: `{"op":"remove","path":"/a"}`},
+		{name: "move", op: JSONPatchOperation{Op: JSONPatchMove, Path: "/a", From: "/b"}, expected: `{"op":"move","path":"/a","from":"/b"}`},
+		{name: "copy from root", op: JSONPatchOperation{Op: JSONPatchCopy, Path: "/a"}, expected: `{"op":"copy","path":"/a","from":""}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.op)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.expected, string(data))
+		})
+	}
+}
+
+func TestJSONPatch_UnmarshalJSON(t *testing.T) {
+	var patch JSONPatch
+	err := json.Unmarshal([]byte(`[{"op":"replace","path":"/name","value":"Rex"},{"op":"move","path":"/a","from":"/b"}]`), &patch)
+	require.NoError(t, err)
+	assert.Equal(t, JSONPatch{
+		{Op: JSONPatchReplace, Path: "/name", Value: "Rex"},
+		{Op: JSONPatchMove, Path: "/a", From: "/b"},
+	}, patch)
+
+	assert.Error(t, json.Unmarshal([]byte(`[{"op":1}]`), &patch))
+}
+
+func TestJSONPatch_Validate(t *testing.T) {
+	valid := JSONPatch{
+		{Op: JSONPatchTest, Path: "/name", Value: "Rex"},
+		{Op: JSONPatchCopy, Path: "/b", From: "/a"},
+		{Op: JSONPatchReplace, Path: "", Value: map[string]any{}},
+	}
+	assert.NoError(t, valid.Validate())
+
+	invalid := JSONPatch{
+		{Op: "rename", Path: "/name"},
+		{Op: JSONPatchMove, Path: "a", From: "b"},
+	}
+	var errs ValidationErrors
+	require.ErrorAs(t, invalid.Validate(), &errs)
+	assert.Equal(t, []string{"[0].Op", "[1].From", "[1].Path"}, fieldsOf(errs))
+}
+
+func fieldsOf(errs ValidationErrors) []string {
+	var res []string
+	for _, err := range errs {
+		res = append(res, err.Field)
+	}
+	return res
+}
+
+func TestCreateJSONPatch(t *testing.T) {
+	from := patchPet{
+		Name:    "Rex",
+		Tag:     ptr("dog"),
+		Tags:    []string{"a", "b"},
+		Address: &patchAddress{City: "Berlin"},
+	}
+	to := patchPet{
+		Name:    "Rex",
+		Tags:    []string{"a", "c"},
+		Address: &patchAddress{City: "Paris", Street: ptr("a/b~c")},
+	}
+
+	patch, err := CreateJSONPatch(from, to)
+	require.NoError(t, err)
+	assert.Equal(t, JSONPatch{
+		{Op: JSONPatchRemove, Path: "/tag"},
+		{Op: JSONPatchReplace, Path: "/address/city", Value: "Paris"},
+		{Op: JSONPatchAdd, Path: "/address/street", Value: "a/b~c"},
+		{Op: JSONPatchReplace, Path: "/tags/1", Value: "c"},
+	}, patch)
+
+	t.Run("escapes keys", func(t *testing.T) {
+		patch, err := CreateJSONPatch(map[string]int{}, map[string]int{"a/b~c": 1})
+		require.NoError(t, err)
+		assert.Equal(t, JSONPatch{{Op: JSONPatchAdd, Path: "/a~1b~0c", Value: json.Number("1")}}, patch)
+	})
+
+	t.Run("replaces arrays of different length", func(t *testing.T) {
+		patch, err := CreateJSONPatch(patchPet{Tags: []string{"a"}}, patchPet{Tags: []string{"a", "b"}})
+		require.NoError(t, err)
+		assert.Equal(t, JSONPatch{{Op: JSONPatchReplace, Path: "/tags", Value: []any{"a", "b"}}}, patch)
+	})
+
+	t.Run("replaces the root", func(t *testing.T) {
+		patch, err := CreateJSONPatch([]int{1}, "a")
+		require.NoError(t, err)
+		assert.Equal(t, JSONPatch{{Op: JSONPatchReplace, Path: "", Value: "a"}}, patch)
+	})
+
+	t.Run("no changes", func(t *testing.T) {
+		patch, err := CreateJSONPatch(from, from)
+		require.NoError(t, err)
+		assert.Empty(t, patch)
+	})
+
+	t.Run("marshal error", func(t *testing.T) {
+		_, err := CreateJSONPatch(func() {}, from)
+		assert.Error(t, err)
+		_, err = CreateJSONPatch(from, func() {})
+		assert.Error(t, err)
+	})
+}
+
+func TestCreateMergePatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     any
+		to       any
+		expected string
+	}{
+		{
+			name:     "changed and removed properties",
+			from:     patchPet{Name: "Rex", Tag: ptr("dog"), Address: &patchAddress{City: "Berlin", Street: ptr("Main")}},
+			to:       patchPet{Name: "Max", Address: &patchAddress{City: "Berlin"}},
+			expected: `{"name":"Max","tag":null,"address":{"street":null}}`,
+		},
+		{
+			name:     "added object",
+			from:     map[string]any{"a": 1},
+			to:       map[string]any{"a": map[string]any{"b": 1, "c": nil}},
+			expected: `{"a":{"b":1}}`,
+		},
+		{
+			name:     "added empty object",
+			from:     map[string]any{"a": 1},
+			to:       map[string]any{"a": map[string]any{}},
+			expected: `{"a":{}}`,
+		},
+		{
+			name:     "null members",
+			from:     map[string]any{"a": nil, "b": 1},
+			to:       map[string]any{"b": nil, "c": nil},
+			expected: `{"b":null}`,
+		},
+		{
+			name:     "arrays are replaced",
+			from:     patchPet{Tags: []string{"a", "b"}},
+			to:       patchPet{Tags: []string{"a"}},
+			expected: `{"tags":["a"]}`,
+		},
+		{
+			name:     "no changes",
+			from:     patchPet{Name: "Rex"},
+			to:       patchPet{Name: "Rex"},
+			expected: `{}`,
+		},
+		{
+			name:     "not an object",
+			from:     patchPet{Name: "Rex"},
+			to:       []int{1},
+			expected: `[1]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patch, err := CreateMergePatch(tt.from, tt.to)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.expected, string(patch))
+		})
+	}
+
+	t.Run("marshal error", func(t *testing.T) {
+		_, err := CreateMergePatch(patchPet{}, func() {})
+		assert.Error(t, err)
+	})
+}
+
+func TestNewMergePatch(t *testing.T) {
+	from := patchPet{Name: "Rex", Tag: ptr("dog"), Address: &patchAddress{City: "Berlin", Street: ptr("Main")}}
+	to := patchPet{Name: "Rex", Tags: []string{"a"}, Address: &patchAddress{City: "Paris"}}
+
+	patch, err := NewMergePatch[patchPetMergePatch](from, to)
+	require.NoError(t, err)
+	assert.Equal(t, &patchPetMergePatch{
+		Tag:     Null[string](),
+		Tags:    NewNullable([]string{"a"}),
+		Address: NewNullable(patchAddress{City: "Paris"}),
+	}, patch)
+
+	data, err := json.Marshal(patch)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"tag":null,"tags":["a"],"address":{"city":"Paris"}}`, string(data))
+
+	t.Run("not an object", func(t *testing.T) {
+		_, err := NewMergePatch[patchPetMergePatch](from, []int{1})
+		assert.ErrorContains(t, err, "error creating merge patch")
+		_, err = NewMergePatch[patchPetMergePatch](nil, to)
+		assert.ErrorContains(t, err, "value is not an object")
+	})
+
+	t.Run("marshal error", func(t *testing.T) {
+		_, err := NewMergePatch[patchPetMergePatch](func() {}, to)
+		assert.Error(t, err)
+		_, err = NewMergePatch[patchPetMergePatch](from, func() {})
+		assert.Error(t, err)
+	})
+
+	t.Run("decode error", func(t *testing.T) {
+		_, err := NewMergePatch[patchPetMergePatch](from, map[string]any{"name": 1})
+		assert.ErrorContains(t, err, "error decoding merge patch")
+	})
+}
@@ -0,0 +1,162 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// RequestMarshaler is implemented by generated types with readOnly properties,
+// which are omitted when the type is sent in a request body.
+type RequestMarshaler interface {
+	MarshalJSONForRequest() ([]byte, error)
+}
+
+// ResponseMarshaler is implemented by generated types with writeOnly properties,
+// which are omitted when the type is sent in a response body.
+type ResponseMarshaler interface {
+	MarshalJSONForResponse() ([]byte, error)
+}
+
+var (
+	requestMarshalerType  = reflect.TypeFor[RequestMarshaler]()
+	responseMarshalerType = reflect.TypeFor[ResponseMarshaler]()
+)
+
+// MarshalJSONForRequest marshals v as a request body, omitting the readOnly properties
+// of v and of the values nested in its slices, arrays and maps.
+func MarshalJSONForRequest(v any) ([]byte, error) {
+	if m, ok := v.(RequestMarshaler); ok && !isNilPointer(v) {
+		return m.MarshalJSONForRequest()
+	}
+	return marshalElements(v, requestMarshalerType, MarshalJSONForRequest)
+}
+
+// MarshalJSONForResponse marshals v as a response body, omitting the writeOnly properties
+// of v and of the values nested in its slices, arrays and maps.
+func MarshalJSONForResponse(v any) ([]byte, error) {
+	if m, ok := v.(ResponseMarshaler); ok && !isNilPointer(v) {
+		return m.MarshalJSONForResponse()
+	}
+	return marshalElements(v, responseMarshalerType, MarshalJSONForResponse)
+}
+
+// MarshalJSONOmitting marshals v as a JSON object without the omitted properties,
+// marshaling the nested property values with marshal.
+// It backs the generated MarshalJSONForRequest and MarshalJSONForResponse methods.
+func MarshalJSONOmitting(v any, omit []string, nested map[string]any, marshal func(any) ([]byte, error)) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, err
+	}
+
+	for _, name := range omit {
+		delete(object, name)
+	}
+	for name, value := range nested {
+		if _, ok := object[name]; !ok {
+			continue
+		}
+		if object[name], err = marshal(value); err != nil {
+			return nil, fmt.Errorf("error marshaling property '%s': %w", name, err)
+		}
+	}
+
+	return json.Marshal(object)
+}
+
+// marshalElements marshals v, using marshal for the elements of its slices, arrays and maps
+// when they may implement iface.
+func marshalElements(v any, iface reflect.Type, marshal func(any) ([]byte, error)) ([]byte, error) {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() || !mayImplement(rv.Type(), iface, nil) {
+		return json.Marshal(v)
+	}
+	if _, ok := v.(json.Marshaler); ok {
+		return json.Marshal(v)
+	}
+
+	switch rv.Kind() {
+	case reflect.Pointer:
+		if rv.IsNil() {
+			return json.Marshal(v)
+		}
+		return marshal(rv.Elem().Interface())
+	case reflect.Slice, reflect.Array:
+		if rv.Kind() == reflect.Slice && rv.IsNil() {
+			return json.Marshal(v)
+		}
+		items := make([]json.RawMessage, rv.Len())
+		for i := range items {
+			item, err := marshal(rv.Index(i).Interface())
+			if err != nil {
+				return nil, fmt.Errorf("error marshaling item %d: %w", i, err)
+			}
+			items[i] = item
+		}
+		return json.Marshal(items)
+	case reflect.Map:
+		if rv.IsNil() || rv.Type().Key().Kind() != reflect.String {
+			return json.Marshal(v)
+		}
+		values := make(map[string]json.RawMessage, rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			key := iter.Key().String()
+			value, err := marshal(iter.Value().Interface())
+			if err != nil {
+				return nil, fmt.Errorf("error marshaling key '%s': %w", key, err)
+			}
+			values[key] = value
+		}
+		return json.Marshal(values)
+	}
+	return json.Marshal(v)
+}
+
+// mayImplement reports whether values of type t, or the values nested in them, may implement iface.
+func mayImplement(t reflect.Type, iface reflect.Type, seen map[reflect.Type]bool) bool {
+	if t.Implements(iface) || reflect.PointerTo(t).Implements(iface) {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.Interface:
+		return true
+	case reflect.Pointer, reflect.Slice, reflect.Array, reflect.Map:
+		// recursive types like `type Tree []Tree` are checked once
+		if seen[t] {
+			return false
+		}
+		if seen == nil {
+			seen = make(map[reflect.Type]bool)
+		}
+		seen[t] = true
+		return mayImplement(t.Elem(), iface, seen)
+	}
+	return false
+}
+
+// isNilPointer reports whether v is a nil pointer, whose value methods can't be called.
+func isNilPointer(v any) bool {
+	rv := reflect.ValueOf(v)
+	return rv.Kind() == reflect.Pointer && rv.IsNil()
+}
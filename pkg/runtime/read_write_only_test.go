@@ -0,0 +1,148 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type rwAccount struct {
+	ID       string  `json:"id,omitempty"`
+	Name     string  `json:"name"`
+	Password *string `json:"password,omitempty"`
+}
+
+func (a rwAccount) MarshalJSONForRequest() ([]byte, error) {
+	return MarshalJSONOmitting(a, []string{"id"}, nil, MarshalJSONForRequest)
+}
+
+func (a rwAccount) MarshalJSONForResponse() ([]byte, error) {
+	return MarshalJSONOmitting(a, []string{"password"}, nil, MarshalJSONForResponse)
+}
+
+type rwOrg struct {
+	Owner    *rwAccount            `json:"owner,omitempty"`
+	Members  []rwAccount           `json:"members,omitempty"`
+	ByRole   map[string]*rwAccount `json:"byRole,omitempty"`
+	Archived bool                  `json:"archived"`
+}
+
+func (o rwOrg) MarshalJSONForRequest() ([]byte, error) {
+	return MarshalJSONOmitting(o, []string{"archived"}, map[string]any{
+		"owner": o.Owner, "members": o.Members, "byRole": o.ByRole,
+	}, MarshalJSONForRequest)
+}
+
+type rwTree []rwTree
+
+type rwFailing struct{}
+
+func (rwFailing) MarshalJSONForRequest() ([]byte, error) {
+	return nil, errors.New("boom")
+}
+
+func TestMarshalJSONForRequest(t *testing.T) {
+	password := "secret"
+	account := rwAccount{ID: "1", Name: "Ann", Password: &password}
+
+	tests := []struct {
+		name  string
+		value any
+		want  string
+	}{
+		{name: "value", value: account, want: `{"name":"Ann","password":"secret"}`},
+		{name: "pointer", value: &account, want: `{"name":"Ann","password":"secret"}`},
+		{name: "nil pointer", value: (*rwAccount)(nil), want: `null`},
+		{name: "nil", value: nil, want: `null`},
+		{name: "slice", value: []rwAccount{account}, want: `[{"name":"Ann","password":"secret"}]`},
+		{name: "array", value: [1]*rwAccount{&account}, want: `[{"name":"Ann","password":"secret"}]`},
+		{name: "nil slice", value: []rwAccount(nil), want: `null`},
+		{name: "map", value: map[string]rwAccount{"a": account}, want: `{"a":{"name":"Ann","password":"secret"}}`},
+		{name: "nil map", value: map[string]rwAccount(nil), want: `null`},
+		{name: "any slice", value: []any{account, 1}, want: `[{"name":"Ann","password":"secret"},1]`},
+		{name: "int keyed map", value: map[int]rwAccount{1: account}, want: `{"1":{"id":"1","name":"Ann","password":"secret"}}`},
+		{name: "plain value", value: map[string]int{"a": 1}, want: `{"a":1}`},
+		{name: "recursive type", value: rwTree{{}}, want: `[[]]`},
+		{name: "json marshaler", value: json.RawMessage(`{"id":"1"}`), want: `{"id":"1"}`},
+		{
+			name: "nested",
+			value: rwOrg{
+				Owner:   &account,
+				Members: []rwAccount{account},
+				ByRole:  map[string]*rwAccount{"admin": &account},
+			},
+			want: `{"byRole":{"admin":{"name":"Ann","password":"secret"}},` +
+				`"members":[{"name":"Ann","password":"secret"}],"owner":{"name":"Ann","password":"secret"}}`,
+		},
+		{name: "nested omitted", value: rwOrg{}, want: `{}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := MarshalJSONForRequest(tt.value)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, string(data))
+		})
+	}
+}
+
+func TestMarshalJSONForResponse(t *testing.T) {
+	password := "secret"
+	account := rwAccount{ID: "1", Name: "Ann", Password: &password}
+
+	data, err := MarshalJSONForResponse(account)
+	require.NoError(t, err)
+	assert.Equal(t, `{"id":"1","name":"Ann"}`, string(data))
+
+	data, err = MarshalJSONForResponse([]*rwAccount{&account, nil})
+	require.NoError(t, err)
+	assert.Equal(t, `[{"id":"1","name":"Ann"},null]`, string(data))
+
+	// rwOrg has no response view, so its nested accounts keep their passwords
+	data, err = MarshalJSONForResponse(rwOrg{Owner: &account})
+	require.NoError(t, err)
+	assert.Equal(t, `{"owner":{"id":"1","name":"Ann","password":"secret"},"archived":false}`, string(data))
+}
+
+func TestMarshalJSONOmitting(t *testing.T) {
+	t.Run("not an object", func(t *testing.T) {
+		_, err := MarshalJSONOmitting([]int{1}, []string{"id"}, nil, MarshalJSONForRequest)
+		require.Error(t, err)
+	})
+
+	t.Run("unsupported value", func(t *testing.T) {
+		_, err := MarshalJSONOmitting(func() {}, nil, nil, MarshalJSONForRequest)
+		require.Error(t, err)
+	})
+
+	t.Run("nested error", func(t *testing.T) {
+		_, err := MarshalJSONOmitting(map[string]any{"a": 1}, nil, map[string]any{"a": rwFailing{}}, MarshalJSONForRequest)
+		assert.EqualError(t, err, "error marshaling property 'a': boom")
+	})
+
+	t.Run("item error", func(t *testing.T) {
+		_, err := MarshalJSONForRequest([]rwFailing{{}})
+		assert.EqualError(t, err, "error marshaling item 0: boom")
+	})
+
+	t.Run("map value error", func(t *testing.T) {
+		_, err := MarshalJSONForRequest(map[string]rwFailing{"a": {}})
+		assert.EqualError(t, err, "error marshaling key 'a': boom")
+	})
+}
@@ -0,0 +1,92 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// maxRedirects is the number of redirects CheckRedirect follows, like the default policy of http.Client.
+const maxRedirects = 10
+
+// noRedirectsKey is the context key marking the calls whose redirects are not followed.
+type noRedirectsKey struct{}
+
+// WithoutRedirects returns the redirect response of a single call instead of following it,
+// e.g. to read the Location of an OAuth authorize endpoint.
+// The HttpRequestDoer must honor it, see CheckRedirect.
+func WithoutRedirects() RequestEditorFn {
+	return func(_ context.Context, req *http.Request) error {
+		getCallOptions(req).noRedirects = true
+		return nil
+	}
+}
+
+// WithoutRedirectsFor returns the redirect responses of the operations with the given paths,
+// as they are in the spec like /oauth/authorize, instead of following them, for every method of the paths.
+// The HttpRequestDoer must honor it, see CheckRedirect.
+func WithoutRedirectsFor(operationPaths ...string) APIClientOption {
+	return func(c *Client) error {
+		if c.noRedirects == nil {
+			c.noRedirects = make(map[string]bool, len(operationPaths))
+		}
+		for _, path := range operationPaths {
+			c.noRedirects[path] = true
+		}
+		return nil
+	}
+}
+
+// RedirectsDisabled returns true if the redirects of the call sent with ctx must not be followed,
+// for HttpRequestDoers that follow redirects without an http.Client.
+func RedirectsDisabled(ctx context.Context) bool {
+	disabled, _ := ctx.Value(noRedirectsKey{}).(bool)
+	return disabled
+}
+
+// CheckRedirect is the redirect policy of the http.Client of an HttpRequestDoer honoring WithoutRedirects
+// and WithoutRedirectsFor: the redirect response of these calls is returned, other redirects are followed
+// up to 10 times, like with the default policy.
+//
+//	httpClient := &http.Client{CheckRedirect: runtime.CheckRedirect}
+func CheckRedirect(req *http.Request, via []*http.Request) error {
+	if RedirectsDisabled(req.Context()) {
+		return http.ErrUseLastResponse
+	}
+	if len(via) >= maxRedirects {
+		return fmt.Errorf("stopped after %d redirects", maxRedirects)
+	}
+	return nil
+}
+
+// Location returns the Location header of the response resolved against the request URL, nil if not set.
+func (r *Response) Location() (*url.URL, error) {
+	if r == nil || r.Headers.Get("Location") == "" {
+		return nil, nil
+	}
+	location := r.Headers.Get("Location")
+	if r.Raw != nil && r.Raw.Request != nil && r.Raw.Request.URL != nil {
+		return r.Raw.Request.URL.Parse(location)
+	}
+	return url.Parse(location)
+}
+
+// withRedirectPolicy marks the calls of the operations set up with WithoutRedirectsFor.
+func (c *Client) withRedirectPolicy(ctx context.Context, req *http.Request, operationPath string) (context.Context, *http.Request) {
+	if !c.noRedirects[operationPath] || RedirectsDisabled(ctx) {
+		return ctx, req
+	}
+	ctx = context.WithValue(ctx, noRedirectsKey{}, true)
+	return ctx, req.WithContext(ctx)
+}
@@ -0,0 +1,93 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_redirects(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/authorize", "/login":
+			http.Redirect(w, r, "/callback?code=abc", http.StatusFound)
+		case "/loop":
+			http.Redirect(w, r, "/loop", http.StatusFound)
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	httpClient := &http.Client{CheckRedirect: CheckRedirect}
+	doer := doerFunc(func(ctx context.Context, req *http.Request) (*http.Response, error) {
+		return httpClient.Do(req.WithContext(ctx))
+	})
+	client, err := NewAPIClient(server.URL, WithHTTPClient(doer), WithoutRedirectsFor("/authorize"))
+	require.NoError(t, err)
+
+	execute := func(path string, editors ...RequestEditorFn) (*Response, error) {
+		req, err := client.CreateRequest(ctx, RequestOptionsParameters{
+			RequestURL: client.GetBaseURL() + path,
+			Method:     http.MethodGet,
+		}, editors...)
+		require.NoError(t, err)
+		return client.ExecuteRequest(ctx, req, path)
+	}
+
+	t.Run("operation", func(t *testing.T) {
+		resp, err := execute("/authorize")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusFound, resp.StatusCode)
+
+		location, err := resp.Location()
+		require.NoError(t, err)
+		assert.Equal(t, server.URL+"/callback?code=abc", location.String())
+	})
+
+	t.Run("call", func(t *testing.T) {
+		resp, err := execute("/login", WithoutRedirects())
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusFound, resp.StatusCode)
+	})
+
+	t.Run("followed", func(t *testing.T) {
+		resp, err := execute("/login")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "/callback", resp.Raw.Request.URL.Path)
+	})
+
+	t.Run("too many", func(t *testing.T) {
+		_, err := execute("/loop")
+		assert.ErrorContains(t, err, "stopped after 10 redirects")
+	})
+}
+
+func TestResponse_Location(t *testing.T) {
+	location, err := (&Response{Headers: http.Header{"Location": {"https://example.com/next"}}}).Location()
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com/next", location.String())
+
+	location, err = (&Response{Headers: http.Header{}}).Location()
+	require.NoError(t, err)
+	assert.Nil(t, location)
+
+	_, err = (&Response{Headers: http.Header{"Location": {"%zz"}}}).Location()
+	assert.Error(t, err)
+}
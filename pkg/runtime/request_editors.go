@@ -0,0 +1,152 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// callOptionsKey is the context key of the per-call options attached to a request.
+type callOptionsKey struct{}
+
+// callOptions are collected by per-call request editors and applied when the request is executed,
+// so they also cover the time spent reading the response.
+type callOptions struct {
+	timeout     time.Duration
+	deadline    time.Time
+	values      []contextValue
+	noRedirects bool
+
+	// preserveHeaderCase is set by clients created with WithPreserveHeaderCase before applying the request editors.
+	preserveHeaderCase bool
+}
+
+type contextValue struct {
+	key   any
+	value any
+}
+
+// WithTimeout limits a single call, including reading the response body, to d.
+func WithTimeout(d time.Duration) RequestEditorFn {
+	return func(_ context.Context, req *http.Request) error {
+		getCallOptions(req).timeout = d
+		return nil
+	}
+}
+
+// WithDeadline cancels a single call, including reading the response body, at t.
+func WithDeadline(t time.Time) RequestEditorFn {
+	return func(_ context.Context, req *http.Request) error {
+		getCallOptions(req).deadline = t
+		return nil
+	}
+}
+
+// WithContextValue attaches a value to the context the request is sent with,
+// e.g. metadata for tracing or logging in a custom HttpRequestDoer.
+func WithContextValue(key, value any) RequestEditorFn {
+	return func(_ context.Context, req *http.Request) error {
+		opts := getCallOptions(req)
+		opts.values = append(opts.values, contextValue{key: key, value: value})
+		return nil
+	}
+}
+
+// WithHeader sets a header on a single call, replacing any value set by the request options in any case.
+// The name is written as-is by clients created with WithPreserveHeaderCase.
+func WithHeader(key, value string) RequestEditorFn {
+	return func(_ context.Context, req *http.Request) error {
+		opts, _ := req.Context().Value(callOptionsKey{}).(*callOptions)
+		setHeader(req.Header, key, value, opts != nil && opts.preserveHeaderCase)
+		return nil
+	}
+}
+
+// WithRequestURL sends a single call to rawURL instead of the URL built from the request options,
+// e.g. to request the next page of a Link header.
+func WithRequestURL(rawURL string) RequestEditorFn {
+	return func(_ context.Context, req *http.Request) error {
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			return err
+		}
+		req.URL = u
+		req.Host = u.Host
+		return nil
+	}
+}
+
+// WithQueryParam sets a query parameter on a single call, replacing any value set by the request options.
+// Other parameters are kept as they were encoded.
+func WithQueryParam(key, value string) RequestEditorFn {
+	return func(_ context.Context, req *http.Request) error {
+		var parts []string
+		if req.URL.RawQuery != "" {
+			for _, part := range strings.Split(req.URL.RawQuery, "&") {
+				name, _, _ := strings.Cut(part, "=")
+				if unescaped, err := url.QueryUnescape(name); err == nil && unescaped == key {
+					continue
+				}
+				parts = append(parts, part)
+			}
+		}
+		parts = append(parts, url.QueryEscape(key)+"="+url.QueryEscape(value))
+		req.URL.RawQuery = strings.Join(parts, "&")
+		return nil
+	}
+}
+
+// getCallOptions returns the per-call options of req, attaching them to its context the first time.
+func getCallOptions(req *http.Request) *callOptions {
+	if opts, ok := req.Context().Value(callOptionsKey{}).(*callOptions); ok {
+		return opts
+	}
+	opts := &callOptions{}
+	*req = *req.WithContext(context.WithValue(req.Context(), callOptionsKey{}, opts))
+	return opts
+}
+
+// withCallOptions applies the per-call options of req to ctx.
+// The returned cancel func is nil if req has no per-call options.
+func withCallOptions(ctx context.Context, req *http.Request) (context.Context, context.CancelFunc) {
+	opts, ok := req.Context().Value(callOptionsKey{}).(*callOptions)
+	if !ok {
+		return ctx, nil
+	}
+
+	for _, v := range opts.values {
+		ctx = context.WithValue(ctx, v.key, v.value)
+	}
+	if opts.noRedirects {
+		ctx = context.WithValue(ctx, noRedirectsKey{}, true)
+	}
+
+	var cancels []context.CancelFunc
+	if opts.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.timeout)
+		cancels = append(cancels, cancel)
+	}
+	if !opts.deadline.IsZero() {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithDeadline(ctx, opts.deadline)
+		cancels = append(cancels, cancel)
+	}
+	return ctx, func() {
+		for _, cancel := range cancels {
+			cancel()
+		}
+	}
+}
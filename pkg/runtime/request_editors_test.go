@@ -0,0 +1,188 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type traceIDKey struct{}
+
+// contextRecordingDoer records the context the request was sent with.
+type contextRecordingDoer struct {
+	ctx      context.Context
+	req      *http.Request
+	response *http.Response
+	err      error
+}
+
+func (d *contextRecordingDoer) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	d.ctx = ctx
+	d.req = req
+	return d.response, d.err
+}
+
+func newEditedRequest(t *testing.T, rawURL string, editors ...RequestEditorFn) *http.Request {
+	t.Helper()
+	client := &Client{requestEditors: editors}
+	req, err := client.CreateRequest(context.Background(), RequestOptionsParameters{RequestURL: rawURL, Method: http.MethodGet})
+	require.NoError(t, err)
+	return req
+}
+
+func TestWithHeader(t *testing.T) {
+	req := newEditedRequest(t, "http://example.com", WithHeader("X-Request-ID", "1"), WithHeader("X-Request-ID", "2"))
+	assert.Equal(t, "2", req.Header.Get("X-Request-ID"))
+
+	t.Run("replaces the option header in another case", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, "http://example.com", nil)
+		require.NoError(t, err)
+		req.Header = http.Header{"x-request-id": {"1"}}
+		require.NoError(t, WithHeader("X-Request-ID", "2")(context.Background(), req))
+		assert.Equal(t, http.Header{"X-Request-Id": {"2"}}, req.Header)
+	})
+
+	t.Run("preserves the header case", func(t *testing.T) {
+		client := &Client{preserveHeaderCase: true, requestEditors: []RequestEditorFn{WithHeader("x-request-ID", "2")}}
+		req, err := client.CreateRequest(context.Background(), RequestOptionsParameters{
+			Options:    mockRequestOptions{header: map[string]string{"X-Request-Id": "1"}},
+			RequestURL: "http://example.com",
+			Method:     http.MethodGet,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"2"}, req.Header["x-request-ID"])
+		assert.NotContains(t, req.Header, "X-Request-Id")
+	})
+}
+
+func TestWithRequestURL(t *testing.T) {
+	req := newEditedRequest(t, "http://example.com/pets?limit=10", WithRequestURL("http://api.example.com/pets?cursor=abc"))
+	assert.Equal(t, "http://api.example.com/pets?cursor=abc", req.URL.String())
+	assert.Equal(t, "api.example.com", req.Host)
+
+	client := &Client{requestEditors: []RequestEditorFn{WithRequestURL("http://[::1")}}
+	_, err := client.CreateRequest(context.Background(), RequestOptionsParameters{RequestURL: "http://example.com", Method: http.MethodGet})
+	assert.Error(t, err)
+}
+
+func TestWithQueryParam(t *testing.T) {
+	tests := []struct {
+		name     string
+		url      string
+		key      string
+		value    string
+		expected string
+	}{
+		{name: "adds to empty query", url: "http://example.com", key: "page", value: "2", expected: "page=2"},
+		{name: "replaces existing value", url: "http://example.com?page=1&limit=10", key: "page", value: "2", expected: "limit=10&page=2"},
+		{name: "replaces repeated values", url: "http://example.com?tag=a&tag=b", key: "tag", value: "c", expected: "tag=c"},
+		{name: "keeps encoded delimiters", url: "http://example.com?ids=1,2&page=1", key: "page", value: "2", expected: "ids=1,2&page=2"},
+		{name: "escapes key and value", url: "http://example.com?filter%5Bname%5D=x", key: "filter[name]", value: "a b", expected: "filter%5Bname%5D=a+b"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := newEditedRequest(t, tt.url, WithQueryParam(tt.key, tt.value))
+			assert.Equal(t, tt.expected, req.URL.RawQuery)
+		})
+	}
+}
+
+func TestWithTimeout(t *testing.T) {
+	t.Run("applies to buffered requests", func(t *testing.T) {
+		doer := &contextRecordingDoer{response: &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("ok"))}}
+		client := &Client{httpClient: doer}
+		req := newEditedRequest(t, "http://example.com", WithTimeout(time.Minute))
+
+		resp, err := client.ExecuteRequest(context.Background(), req, "/")
+		require.NoError(t, err)
+		assert.Equal(t, "ok", string(resp.Content))
+
+		deadline, ok := doer.ctx.Deadline()
+		require.True(t, ok)
+		assert.WithinDuration(t, time.Now().Add(time.Minute), deadline, 5*time.Second)
+		assert.Equal(t, doer.ctx, doer.req.Context())
+		assert.ErrorIs(t, doer.ctx.Err(), context.Canceled, "the context is released once the body is read")
+	})
+
+	t.Run("applies to streamed requests until the body is closed", func(t *testing.T) {
+		doer := &contextRecordingDoer{response: &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("ok"))}}
+		client := &Client{httpClient: doer}
+		req := newEditedRequest(t, "http://example.com", WithTimeout(time.Minute))
+
+		resp, err := client.ExecuteStreamRequest(context.Background(), req, "/")
+		require.NoError(t, err)
+
+		_, ok := doer.ctx.Deadline()
+		require.True(t, ok)
+		require.NoError(t, doer.ctx.Err())
+
+		require.NoError(t, resp.Body.Close())
+		assert.ErrorIs(t, doer.ctx.Err(), context.Canceled)
+	})
+
+	t.Run("is released when the streamed request fails", func(t *testing.T) {
+		doer := &contextRecordingDoer{err: errors.New("network error")}
+		client := &Client{httpClient: doer}
+		req := newEditedRequest(t, "http://example.com", WithTimeout(time.Minute))
+
+		_, err := client.ExecuteStreamRequest(context.Background(), req, "/")
+		require.Error(t, err)
+		assert.ErrorIs(t, doer.ctx.Err(), context.Canceled)
+	})
+
+	t.Run("is released when there is no streamed response", func(t *testing.T) {
+		doer := &contextRecordingDoer{}
+		client := &Client{httpClient: doer}
+		req := newEditedRequest(t, "http://example.com", WithTimeout(time.Minute))
+
+		resp, err := client.ExecuteStreamRequest(context.Background(), req, "/")
+		require.NoError(t, err)
+		assert.Nil(t, resp)
+		assert.ErrorIs(t, doer.ctx.Err(), context.Canceled)
+	})
+}
+
+func TestWithDeadline(t *testing.T) {
+	deadline := time.Now().Add(time.Hour)
+	doer := &contextRecordingDoer{response: &http.Response{StatusCode: http.StatusOK}}
+	client := &Client{httpClient: doer}
+	req := newEditedRequest(t, "http://example.com", WithDeadline(deadline), WithTimeout(time.Minute))
+
+	_, err := client.ExecuteRequest(context.Background(), req, "/")
+	require.NoError(t, err)
+
+	got, ok := doer.ctx.Deadline()
+	require.True(t, ok)
+	assert.True(t, got.Before(deadline), "the earlier of timeout and deadline wins")
+}
+
+func TestWithContextValue(t *testing.T) {
+	doer := &contextRecordingDoer{response: &http.Response{StatusCode: http.StatusOK}}
+	client := &Client{httpClient: doer}
+	req := newEditedRequest(t, "http://example.com", WithContextValue(traceIDKey{}, "abc"))
+
+	_, err := client.ExecuteRequest(context.Background(), req, "/")
+	require.NoError(t, err)
+
+	assert.Equal(t, "abc", doer.ctx.Value(traceIDKey{}))
+	assert.Equal(t, "abc", doer.req.Context().Value(traceIDKey{}))
+	_, ok := doer.ctx.Deadline()
+	assert.False(t, ok)
+}
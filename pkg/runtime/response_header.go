@@ -0,0 +1,59 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// headerValidator checks the response headers parsed by the generated header accessors.
+var headerValidator = sync.OnceValue(func() *validator.Validate {
+	v := validator.New()
+	RegisterValidations(v)
+	return v
+})
+
+// ParseResponseHeader parses the header of a response for the generated header accessors, and checks it against
+// the validation tag if not empty. Strings are returned as they are, numbers and booleans are parsed with strconv,
+// date-times as RFC 3339 or HTTP dates like in Last-Modified, and other types with encoding.TextUnmarshaler.
+// A header that is not set wraps ErrMissingValue, values failing validation are returned with a ValidationErrors.
+func ParseResponseHeader[T any](headers http.Header, name, validate string) (T, error) {
+	var res T
+	value := headers.Get(name)
+	if value == "" {
+		return res, fmt.Errorf("header %s: %w", name, ErrMissingValue)
+	}
+
+	if t, ok := any(&res).(*time.Time); ok {
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			if parsed, err = http.ParseTime(value); err != nil {
+				return res, fmt.Errorf("header %s: %q is neither an RFC 3339 nor an HTTP date", name, value)
+			}
+		}
+		*t = parsed
+	} else if err := setParameterValue(reflect.ValueOf(&res).Elem(), value, ParameterBinding{}); err != nil {
+		return res, fmt.Errorf("header %s: %w", name, err)
+	}
+
+	if validate != "" {
+		if err := headerValidator().Var(res, validate); err != nil {
+			return res, NewValidationErrorFromError(name, err)
+		}
+	}
+	return res, nil
+}
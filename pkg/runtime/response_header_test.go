@@ -0,0 +1,75 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseResponseHeader(t *testing.T) {
+	headers := http.Header{}
+	headers.Set("X-Rate-Limit-Remaining", "42")
+	headers.Set("X-Rate-Limit-Reset", "2025-01-02T03:04:05Z")
+	headers.Set("Last-Modified", "Thu, 02 Jan 2025 03:04:05 GMT")
+	headers.Set("X-Expires-On", "2025-01-02")
+	headers.Set("X-Cached", "true")
+	headers.Set("X-Request-Id", "abc")
+
+	remaining, err := ParseResponseHeader[int](headers, "X-Rate-Limit-Remaining", "gte=0")
+	require.NoError(t, err)
+	assert.Equal(t, 42, remaining)
+
+	reset, err := ParseResponseHeader[time.Time](headers, "X-Rate-Limit-Reset", "")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC), reset)
+
+	modified, err := ParseResponseHeader[time.Time](headers, "Last-Modified", "")
+	require.NoError(t, err)
+	assert.True(t, reset.Equal(modified))
+
+	expires, err := ParseResponseHeader[Date](headers, "X-Expires-On", "")
+	require.NoError(t, err)
+	assert.Equal(t, "2025-01-02", expires.String())
+
+	cached, err := ParseResponseHeader[bool](headers, "X-Cached", "")
+	require.NoError(t, err)
+	assert.True(t, cached)
+
+	id, err := ParseResponseHeader[string](headers, "x-request-id", "")
+	require.NoError(t, err)
+	assert.Equal(t, "abc", id)
+
+	t.Run("missing", func(t *testing.T) {
+		_, err := ParseResponseHeader[int](headers, "X-Rate-Limit-Limit", "")
+		assert.ErrorIs(t, err, ErrMissingValue)
+		assert.ErrorContains(t, err, "X-Rate-Limit-Limit")
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := ParseResponseHeader[int](headers, "X-Request-Id", "")
+		assert.ErrorContains(t, err, "header X-Request-Id")
+
+		_, err = ParseResponseHeader[time.Time](headers, "X-Request-Id", "")
+		assert.ErrorContains(t, err, "neither an RFC 3339 nor an HTTP date")
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		_, err := ParseResponseHeader[int](headers, "X-Rate-Limit-Remaining", "lte=10")
+		var validationErrors ValidationErrors
+		require.ErrorAs(t, err, &validationErrors)
+		assert.ErrorContains(t, err, "X-Rate-Limit-Remaining")
+	})
+}
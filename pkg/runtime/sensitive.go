@@ -12,7 +12,9 @@ package runtime
 
 import (
 	"crypto/sha256"
+	"crypto/sha512"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"regexp"
 	"strings"
@@ -31,16 +33,18 @@ const (
 	MaskTypeRegex   MaskType = "regex"
 	MaskTypeHash    MaskType = "hash"
 	MaskTypePartial MaskType = "partial"
+	MaskTypeCustom  MaskType = "custom"
 )
 
 // SensitiveDataConfig holds configuration for masking sensitive data
 type SensitiveDataConfig struct {
-	Type        MaskType // masking type: full, regex, hash, or partial
+	Type        MaskType // masking type: full, regex, hash, partial or custom
 	Replacement string   // custom replacement string for "full" and "partial" masks (default: "********")
 	Pattern     string   // regex pattern for "regex" type
-	Algorithm   string   // hash algorithm for "hash" type (e.g., "sha256")
+	Algorithm   string   // hash algorithm for "hash" type: sha256 or sha512 (default: the MaskingPolicy's)
 	KeepPrefix  int      // number of characters to keep at start for "partial" type
 	KeepSuffix  int      // number of characters to keep at end for "partial" type
+	Name        string   // name of the mask function registered with RegisterMasker for "custom" type
 }
 
 // NewDefaultSensitiveDataConfig returns a SensitiveDataConfig with default settings (full masking)
@@ -53,6 +57,8 @@ func NewDefaultSensitiveDataConfig() *SensitiveDataConfig {
 // sensitiveDataYAML is a helper struct for unmarshaling the x-sensitive-data extension
 type sensitiveDataYAML struct {
 	Mask       string `yaml:"mask" json:"mask"`
+	Type       string `yaml:"type" json:"type"` // alias of mask
+	Name       string `yaml:"name" json:"name"`
 	Pattern    string `yaml:"pattern" json:"pattern"`
 	Algorithm  string `yaml:"algorithm" json:"algorithm"`
 	KeepPrefix int    `yaml:"keepPrefix" json:"keepPrefix"`
@@ -63,7 +69,7 @@ type sensitiveDataYAML struct {
 // Supports:
 // - boolean: true -> full masking
 // - string: "full", "hash", "regex", "partial" -> that masking type
-// - object: detailed configuration with mask type and parameters
+// - object: detailed configuration with mask type and parameters, the type set by mask or type
 func (s *SensitiveDataConfig) Unmarshal(value any) error {
 	// Handle simple boolean value (defaults to "full" masking)
 	if b, ok := value.(bool); ok {
@@ -76,7 +82,7 @@ func (s *SensitiveDataConfig) Unmarshal(value any) error {
 	// Handle simple string value
 	if str, ok := value.(string); ok {
 		s.Type = MaskType(str)
-		return nil
+		return s.validate()
 	}
 
 	// Handle object with detailed configuration - marshal to YAML and unmarshal to struct
@@ -94,11 +100,23 @@ func (s *SensitiveDataConfig) Unmarshal(value any) error {
 
 	// Populate the config
 	s.Type = MaskType(helper.Mask)
+	if helper.Type != "" {
+		s.Type = MaskType(helper.Type)
+	}
+	s.Name = helper.Name
 	s.Pattern = helper.Pattern
 	s.Algorithm = helper.Algorithm
 	s.KeepPrefix = helper.KeepPrefix
 	s.KeepSuffix = helper.KeepSuffix
 
+	return s.validate()
+}
+
+// validate checks that custom masks name their mask function.
+func (s *SensitiveDataConfig) validate() error {
+	if s.Type == MaskTypeCustom && s.Name == "" {
+		return fmt.Errorf("x-sensitive-data with mask custom requires the name of a mask function registered with runtime.RegisterMasker")
+	}
 	return nil
 }
 
@@ -129,10 +147,11 @@ func MaskSensitiveValue(value any, config SensitiveDataConfig) any {
 	// Convert value to string for masking
 	strValue := fmt.Sprintf("%v", value)
 
-	// Get replacement string (use default if not specified)
+	// Get replacement string (use the masking policy's if not specified)
+	policy := currentMaskingPolicy()
 	replacement := config.Replacement
 	if replacement == "" {
-		replacement = defaultMaskReplacement
+		replacement = maskReplacement()
 	}
 
 	switch config.Type {
@@ -142,13 +161,19 @@ func MaskSensitiveValue(value any, config SensitiveDataConfig) any {
 		if config.Pattern == "" {
 			return maskFull(strValue, replacement)
 		}
-		return maskRegex(strValue, config.Pattern)
+		return maskRegex(strValue, config.Pattern, policy.Char)
 	case MaskTypeHash:
 		algorithm := config.Algorithm
 		if algorithm == "" {
-			algorithm = "sha256"
+			algorithm = policy.Algorithm
 		}
 		return maskHash(strValue, algorithm)
+	case MaskTypeCustom:
+		// unregistered masks hide the whole value rather than leak it
+		if fn, ok := masker(config.Name); ok {
+			return fn(strValue)
+		}
+		return maskFull(strValue, replacement)
 	case MaskTypePartial:
 		return maskPartial(strValue, replacement, config.KeepPrefix, config.KeepSuffix)
 	default:
@@ -166,6 +191,23 @@ func MaskSensitiveString(value string, config SensitiveDataConfig) string {
 	return fmt.Sprintf("%v", result)
 }
 
+// UnmaskedMarshaler is implemented by the generated types with sensitive properties, and by Sensitive.
+// MarshalJSONUnmasked returns the JSON encoding of the value with its sensitive properties unmasked.
+type UnmaskedMarshaler interface {
+	MarshalJSONUnmasked() ([]byte, error)
+}
+
+// MarshalUnmasked returns the JSON encoding of v with its sensitive properties unmasked,
+// for trusted code persisting it, while MarshalJSON keeps masking them.
+// Values not implementing UnmaskedMarshaler are marshaled with json.Marshal.
+// Only the properties of v are unmasked: nested types with sensitive properties are still masked by their MarshalJSON.
+func MarshalUnmasked(v any) ([]byte, error) {
+	if m, ok := v.(UnmaskedMarshaler); ok {
+		return m.MarshalJSONUnmasked()
+	}
+	return json.Marshal(v)
+}
+
 // maskFull replaces the entire value with a fixed mask to hide the length
 func maskFull(value, replacement string) string {
 	if len(value) == 0 {
@@ -174,16 +216,16 @@ func maskFull(value, replacement string) string {
 	return replacement
 }
 
-// maskRegex masks parts of the value matching the regex pattern
-func maskRegex(value, pattern string) string {
+// maskRegex masks parts of the value matching the regex pattern with maskChar
+func maskRegex(value, pattern string, maskChar rune) string {
 	re, err := regexp.Compile(pattern)
 	if err != nil {
 		// If regex is invalid, fall back to full masking
-		return maskFull(value, defaultMaskReplacement)
+		return maskFull(value, maskReplacement())
 	}
 
 	return re.ReplaceAllStringFunc(value, func(match string) string {
-		return strings.Repeat("*", len(match))
+		return strings.Repeat(string(maskChar), len(match))
 	})
 }
 
@@ -193,6 +235,9 @@ func maskHash(value, algorithm string) string {
 	case "sha256":
 		hash := sha256.Sum256([]byte(value))
 		return hex.EncodeToString(hash[:])
+	case "sha512":
+		hash := sha512.Sum512([]byte(value))
+		return hex.EncodeToString(hash[:])
 	default:
 		// Default to sha256
 		hash := sha256.Sum256([]byte(value))
@@ -0,0 +1,206 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// SensitiveParameter is a path, query or header parameter marked with x-sensitive-data.
+type SensitiveParameter struct {
+	// In is the location of the parameter: path, query or header.
+	In string
+
+	// Name is the name of the parameter in the spec.
+	Name string
+
+	// Config is how the values of the parameter are masked.
+	Config SensitiveDataConfig
+}
+
+// SensitiveParameters are the sensitive parameters of an operation, masked in the URLs, headers and errors
+// of the requests passed to loggers. The generated code declares them as <Operation>SensitiveParameters.
+type SensitiveParameters struct {
+	// Path is the path of the operation in the spec, e.g. /users/{ssn}, locating the path parameters in URLs.
+	Path string
+
+	Parameters []SensitiveParameter
+}
+
+// MaskURL returns a copy of u with the values of the sensitive path and query parameters masked.
+// Path parameters are located by aligning the end of the URL path with Path, so URLs may have a base path.
+func (s SensitiveParameters) MaskURL(u *url.URL) *url.URL {
+	if u == nil || len(s.Parameters) == 0 {
+		return u
+	}
+	masked := *u
+
+	if params := s.in("path"); len(params) > 0 {
+		masked.Path, masked.RawPath = s.maskPath(u.EscapedPath(), params)
+	}
+
+	if params := s.in("query"); len(params) > 0 && u.RawQuery != "" {
+		query := u.Query()
+		for name, values := range query {
+			for _, p := range params {
+				// deepObject parameters are sent as name[key]
+				if name != p.Name && !strings.HasPrefix(name, p.Name+"[") {
+					continue
+				}
+				for i, v := range values {
+					values[i] = MaskSensitiveString(v, p.Config)
+				}
+			}
+		}
+		masked.RawQuery = keepMaskChars(query.Encode())
+	}
+	return &masked
+}
+
+// maskPath returns the unescaped and escaped path with the segments of the sensitive parameters masked.
+func (s SensitiveParameters) maskPath(escapedPath string, params []SensitiveParameter) (string, string) {
+	segments := strings.Split(escapedPath, "/")
+	templates := strings.Split(s.Path, "/")
+	offset := len(segments) - len(templates)
+	if offset < 0 {
+		return unescapePath(escapedPath), escapedPath
+	}
+
+	for i, tmpl := range templates {
+		for _, p := range params {
+			if !strings.Contains(tmpl, "{"+p.Name+"}") {
+				continue
+			}
+			// segments with more than the parameter, e.g. {id}.json, are masked whole
+			value := unescapePath(segments[offset+i])
+			segments[offset+i] = keepMaskChars(url.PathEscape(MaskSensitiveString(value, p.Config)))
+		}
+	}
+	escaped := strings.Join(segments, "/")
+	return unescapePath(escaped), escaped
+}
+
+// keepMaskChars unescapes the asterisks of masks, which are valid in paths and queries, to keep logs readable.
+func keepMaskChars(escaped string) string {
+	return strings.ReplaceAll(escaped, "%2A", "*")
+}
+
+// unescapePath returns the unescaped path, or the path itself if it isn't escaped correctly.
+func unescapePath(path string) string {
+	if unescaped, err := url.PathUnescape(path); err == nil {
+		return unescaped
+	}
+	return path
+}
+
+// MaskHeader returns a copy of h with the values of the sensitive header parameters masked.
+func (s SensitiveParameters) MaskHeader(h http.Header) http.Header {
+	if h == nil {
+		return nil
+	}
+	masked := h.Clone()
+	for _, p := range s.in("header") {
+		for name, values := range masked {
+			if !strings.EqualFold(name, p.Name) {
+				continue
+			}
+			for i, v := range values {
+				values[i] = MaskSensitiveString(v, p.Config)
+			}
+		}
+	}
+	return masked
+}
+
+// MaskError returns err with the URL of its *url.Error masked, as returned by http.Client for failed requests.
+func (s SensitiveParameters) MaskError(err error) error {
+	var urlErr *url.Error
+	if len(s.Parameters) == 0 || !errors.As(err, &urlErr) {
+		return err
+	}
+	u, parseErr := url.Parse(urlErr.URL)
+	if parseErr != nil {
+		return err
+	}
+	masked := *urlErr
+	masked.URL = s.MaskURL(u).String()
+	if urlErr == err {
+		return &masked
+	}
+	return &maskedError{error: err, message: strings.ReplaceAll(err.Error(), urlErr.Error(), masked.Error())}
+}
+
+// maskedError is an error wrapping a *url.Error, reported with the URL masked.
+type maskedError struct {
+	error
+	message string
+}
+
+func (e *maskedError) Error() string {
+	return e.message
+}
+
+func (e *maskedError) Unwrap() error {
+	return e.error
+}
+
+// in returns the sensitive parameters in the location.
+func (s SensitiveParameters) in(location string) []SensitiveParameter {
+	var res []SensitiveParameter
+	for _, p := range s.Parameters {
+		if p.In == location {
+			res = append(res, p)
+		}
+	}
+	return res
+}
+
+// MaskRequestLogs returns a RequestLogger passing the entries to logger with the sensitive parameters masked,
+// for servers logging operations with sensitive parameters, e.g. with SampleRequestLogs.
+// Generated clients mask the entries of their RequestLogger themselves.
+func MaskRequestLogs(logger RequestLogger, params SensitiveParameters) RequestLogger {
+	return func(ctx context.Context, entry RequestLog) {
+		logger(ctx, params.maskLog(entry))
+	}
+}
+
+// maskLog returns the entry with the sensitive parameters masked.
+func (s SensitiveParameters) maskLog(entry RequestLog) RequestLog {
+	if len(s.Parameters) == 0 {
+		return entry
+	}
+	if u, err := url.Parse(entry.URL); err == nil {
+		entry.URL = s.MaskURL(u).String()
+	}
+	entry.Header = s.MaskHeader(entry.Header)
+	entry.Err = s.MaskError(entry.Err)
+	return entry
+}
+
+// sensitiveParametersKey is the context key of the sensitive parameters of a request's operation.
+type sensitiveParametersKey struct{}
+
+// withSensitiveParameters attaches the sensitive parameters of the operation to req, if any.
+func withSensitiveParameters(req *http.Request, params SensitiveParameters) {
+	if len(params.Parameters) > 0 {
+		*req = *req.WithContext(context.WithValue(req.Context(), sensitiveParametersKey{}, params))
+	}
+}
+
+// requestSensitiveParameters returns the sensitive parameters of the operation of req.
+func requestSensitiveParameters(req *http.Request) SensitiveParameters {
+	params, _ := req.Context().Value(sensitiveParametersKey{}).(SensitiveParameters)
+	return params
+}
@@ -0,0 +1,172 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testSensitiveParameters = SensitiveParameters{
+	Path: "/users/{ssn}/cards/{card}.json",
+	Parameters: []SensitiveParameter{
+		{In: "path", Name: "ssn", Config: SensitiveDataConfig{Type: MaskTypePartial, KeepSuffix: 4}},
+		{In: "path", Name: "card", Config: SensitiveDataConfig{Type: MaskTypeFull}},
+		{In: "query", Name: "api_key", Config: SensitiveDataConfig{Type: MaskTypeFull}},
+		{In: "header", Name: "X-Auth-Token", Config: SensitiveDataConfig{Type: MaskTypeFull}},
+	},
+}
+
+func TestSensitiveParameters_MaskURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		url      string
+		expected string
+	}{
+		{
+			name:     "path and query",
+			url:      "https://api.example.com/users/123-45-6789/cards/4111.json?api_key=secret&page=2",
+			expected: "https://api.example.com/users/********6789/cards/********?api_key=********&page=2",
+		},
+		{
+			name:     "base path",
+			url:      "https://api.example.com/v1/users/123-45-6789/cards/4111.json",
+			expected: "https://api.example.com/v1/users/********6789/cards/********",
+		},
+		{
+			name:     "escaped value",
+			url:      "https://api.example.com/users/a%2Fb-6789/cards/1.json",
+			expected: "https://api.example.com/users/********6789/cards/********",
+		},
+		{
+			name:     "deep object",
+			url:      "https://api.example.com/users/1/cards/2.json?api_key[id]=secret",
+			expected: "https://api.example.com/users/********/cards/********?api_key%5Bid%5D=********",
+		},
+		{
+			name:     "shorter path",
+			url:      "https://api.example.com/users?api_key=secret",
+			expected: "https://api.example.com/users?api_key=********",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, err := url.Parse(tt.url)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, testSensitiveParameters.MaskURL(u).String())
+			assert.Equal(t, tt.url, u.String(), "the URL must not be modified")
+		})
+	}
+
+	t.Run("no parameters", func(t *testing.T) {
+		u, err := url.Parse("https://api.example.com/users/1?api_key=secret")
+		require.NoError(t, err)
+		assert.Same(t, u, SensitiveParameters{}.MaskURL(u))
+	})
+}
+
+func TestSensitiveParameters_MaskHeader(t *testing.T) {
+	header := http.Header{}
+	header.Set("X-Auth-Token", "secret")
+	header.Set("Accept", "application/json")
+
+	masked := testSensitiveParameters.MaskHeader(header)
+	assert.Equal(t, "********", masked.Get("X-Auth-Token"))
+	assert.Equal(t, "application/json", masked.Get("Accept"))
+	assert.Equal(t, "secret", header.Get("X-Auth-Token"), "the header must not be modified")
+
+	assert.Nil(t, testSensitiveParameters.MaskHeader(nil))
+}
+
+func TestSensitiveParameters_MaskError(t *testing.T) {
+	urlErr := &url.Error{Op: "Get", URL: "https://api.example.com/users/123-45-6789/cards/1.json", Err: errors.New("connection refused")}
+	expected := `Get "https://api.example.com/users/********6789/cards/********": connection refused`
+
+	t.Run("url error", func(t *testing.T) {
+		err := testSensitiveParameters.MaskError(urlErr)
+		assert.EqualError(t, err, expected)
+		assert.Contains(t, urlErr.URL, "123-45-6789", "the error must not be modified")
+	})
+
+	t.Run("wrapped url error", func(t *testing.T) {
+		err := testSensitiveParameters.MaskError(fmt.Errorf("retrying: %w", urlErr))
+		assert.EqualError(t, err, "retrying: "+expected)
+		assert.ErrorIs(t, err, urlErr)
+	})
+
+	t.Run("other error", func(t *testing.T) {
+		err := errors.New("boom")
+		assert.Same(t, err, testSensitiveParameters.MaskError(err))
+	})
+}
+
+func TestMaskRequestLogs(t *testing.T) {
+	var logs []RequestLog
+	logger := MaskRequestLogs(func(_ context.Context, entry RequestLog) {
+		logs = append(logs, entry)
+	}, testSensitiveParameters)
+	handler := SampleRequestLogs(logger, 1)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/users/123-45-6789/cards/1.json?api_key=secret", nil)
+	req.Header.Set("X-Auth-Token", "secret")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	require.Len(t, logs, 1)
+	assert.Equal(t, "/users/********6789/cards/********?api_key=********", logs[0].URL)
+	assert.Equal(t, "********", logs[0].Header.Get("X-Auth-Token"))
+	assert.Equal(t, "secret", req.Header.Get("X-Auth-Token"))
+}
+
+func TestClient_sensitiveParameters(t *testing.T) {
+	ctx := context.Background()
+
+	var logs []RequestLog
+	doer := doerFunc(func(_ context.Context, req *http.Request) (*http.Response, error) {
+		return nil, &url.Error{Op: "Get", URL: req.URL.String(), Err: errors.New("connection refused")}
+	})
+	client, err := NewAPIClient("https://api.example.com", WithHTTPClient(doer),
+		WithRequestLogger(func(_ context.Context, entry RequestLog) {
+			logs = append(logs, entry)
+		}))
+	require.NoError(t, err)
+
+	req, err := client.CreateRequest(ctx, RequestOptionsParameters{
+		RequestURL:          client.GetBaseURL() + "/users/123-45-6789/cards/1.json?api_key=secret",
+		Method:              http.MethodGet,
+		SensitiveParameters: testSensitiveParameters,
+	}, func(_ context.Context, req *http.Request) error {
+		req.Header.Set("X-Auth-Token", "secret")
+		return nil
+	})
+	require.NoError(t, err)
+
+	_, err = client.ExecuteRequest(ctx, req, "/users/{ssn}/cards/{card}.json")
+	require.Error(t, err)
+	assert.NotContains(t, err.Error(), "123-45-6789")
+	assert.NotContains(t, err.Error(), "secret")
+
+	require.Len(t, logs, 1)
+	assert.Equal(t, "https://api.example.com/users/********6789/cards/********?api_key=********", logs[0].URL)
+	assert.Equal(t, "********", logs[0].Header.Get("X-Auth-Token"))
+	assert.Equal(t, err, logs[0].Err)
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // Use the same constant as the implementation for consistency
@@ -100,7 +101,7 @@ func TestMaskRegex(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := maskRegex(tt.input, tt.pattern)
+			result := maskRegex(tt.input, tt.pattern, '*')
 			assert.Equal(t, tt.expected, result)
 		})
 	}
@@ -304,6 +305,32 @@ func TestMaskSensitiveString(t *testing.T) {
 	}
 }
 
+type unmaskedCard struct {
+	Number string `json:"number"`
+}
+
+func (c unmaskedCard) MarshalJSON() ([]byte, error) {
+	return []byte(`{"number":"********"}`), nil
+}
+
+func (c unmaskedCard) MarshalJSONUnmasked() ([]byte, error) {
+	return []byte(`{"number":"` + c.Number + `"}`), nil
+}
+
+func TestMarshalUnmasked(t *testing.T) {
+	t.Run("unmasked marshaler", func(t *testing.T) {
+		data, err := MarshalUnmasked(unmaskedCard{Number: "4111"})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"number":"4111"}`, string(data))
+	})
+
+	t.Run("other values", func(t *testing.T) {
+		data, err := MarshalUnmasked(map[string]any{"number": unmaskedCard{Number: "4111"}})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"number":{"number":"********"}}`, string(data))
+	})
+}
+
 func TestMaskPartial(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -0,0 +1,100 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+)
+
+// Sensitive holds a sensitive value that is masked wherever it is printed: fmt verbs, including %v and %#v
+// of the structs declaring it, text and JSON marshaling, and slog. Only Value returns the value.
+// Properties with x-sensitive-data are declared as Sensitive with output.prefer-sensitive, and their types
+// mask them in MarshalJSON with the configured mask, like the other sensitive properties, see Masked.
+type Sensitive[T any] struct {
+	value T
+
+	// masked is the JSON value of Masked, nil for the fixed mask
+	masked any
+
+	// unmasked tells whether MarshalJSON writes the value, see Unmasked
+	unmasked bool
+}
+
+// NewSensitive returns a Sensitive holding value.
+func NewSensitive[T any](value T) Sensitive[T] {
+	return Sensitive[T]{value: value}
+}
+
+// Value returns the value, unmasked.
+func (s Sensitive[T]) Value() T {
+	return s.value
+}
+
+// Masked returns a copy of s marshaled to JSON as the value masked with config, instead of the fixed mask.
+func (s Sensitive[T]) Masked(config SensitiveDataConfig) Sensitive[T] {
+	s.masked = MaskSensitiveValue(s.value, config)
+	s.unmasked = false
+	return s
+}
+
+// Unmasked returns a copy of s marshaled to JSON as its value, for the MarshalJSONUnmasked methods of the types.
+// Fmt, text marshaling and slog still print the mask.
+func (s Sensitive[T]) Unmasked() Sensitive[T] {
+	s.masked = nil
+	s.unmasked = true
+	return s
+}
+
+// String returns the fixed mask of the MaskingPolicy.
+func (s Sensitive[T]) String() string {
+	return maskReplacement()
+}
+
+// Format writes the mask, whatever the verb.
+func (s Sensitive[T]) Format(f fmt.State, _ rune) {
+	_, _ = io.WriteString(f, maskReplacement())
+}
+
+// MarshalText returns the mask.
+func (s Sensitive[T]) MarshalText() ([]byte, error) {
+	return []byte(maskReplacement()), nil
+}
+
+// MarshalJSON returns the mask as a JSON string, the masked value of Masked, or the value of Unmasked.
+func (s Sensitive[T]) MarshalJSON() ([]byte, error) {
+	if s.unmasked {
+		return json.Marshal(s.value)
+	}
+	if s.masked != nil {
+		return json.Marshal(s.masked)
+	}
+	return json.Marshal(maskReplacement())
+}
+
+// MarshalJSONUnmasked returns the JSON encoding of the value, see MarshalUnmasked.
+func (s Sensitive[T]) MarshalJSONUnmasked() ([]byte, error) {
+	return json.Marshal(s.value)
+}
+
+// UnmarshalJSON decodes the value.
+func (s *Sensitive[T]) UnmarshalJSON(data []byte) error {
+	s.masked = nil
+	s.unmasked = false
+	return json.Unmarshal(data, &s.value)
+}
+
+// LogValue returns the mask, for slog handlers.
+func (s Sensitive[T]) LogValue() slog.Value {
+	return slog.StringValue(maskReplacement())
+}
@@ -0,0 +1,100 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSensitive(t *testing.T) {
+	type user struct {
+		Name string
+		SSN  Sensitive[string]
+		PIN  *Sensitive[int]
+	}
+	pin := NewSensitive(1234)
+	u := user{Name: "jane", SSN: NewSensitive("123-45-6789"), PIN: &pin}
+
+	t.Run("value", func(t *testing.T) {
+		assert.Equal(t, "123-45-6789", u.SSN.Value())
+		assert.Equal(t, 1234, u.PIN.Value())
+	})
+
+	t.Run("fmt", func(t *testing.T) {
+		assert.Equal(t, "{jane ******** ********}", fmt.Sprintf("%v", u))
+		assert.Equal(t, "{Name:jane SSN:******** PIN:********}", fmt.Sprintf("%+v", u))
+		assert.Equal(t, `runtime.user{Name:"jane", SSN:********, PIN:********}`, fmt.Sprintf("%#v", u))
+		assert.Equal(t, "******** ********", fmt.Sprintf("%s %d", u.SSN, u.PIN))
+		assert.Equal(t, "********", u.SSN.String())
+	})
+
+	t.Run("text", func(t *testing.T) {
+		text, err := u.SSN.MarshalText()
+		require.NoError(t, err)
+		assert.Equal(t, "********", string(text))
+	})
+
+	t.Run("json", func(t *testing.T) {
+		data, err := json.Marshal(u)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"Name":"jane","SSN":"********","PIN":"********"}`, string(data))
+
+		var decoded user
+		require.NoError(t, json.Unmarshal([]byte(`{"SSN":"123-45-6789","PIN":42}`), &decoded))
+		assert.Equal(t, "123-45-6789", decoded.SSN.Value())
+		assert.Equal(t, 42, decoded.PIN.Value())
+	})
+
+	t.Run("masked json", func(t *testing.T) {
+		masked := u.SSN.Masked(SensitiveDataConfig{Type: MaskTypePartial, KeepSuffix: 4})
+		data, err := json.Marshal(masked)
+		require.NoError(t, err)
+		assert.Equal(t, `"********6789"`, string(data))
+		assert.Equal(t, "********", masked.String())
+		assert.Equal(t, "123-45-6789", masked.Value())
+
+		require.NoError(t, json.Unmarshal([]byte(`"987-65-4321"`), &masked))
+		data, err = json.Marshal(masked)
+		require.NoError(t, err)
+		assert.Equal(t, `"********"`, string(data))
+	})
+
+	t.Run("unmasked json", func(t *testing.T) {
+		unmasked := u.SSN.Masked(SensitiveDataConfig{Type: MaskTypeFull}).Unmasked()
+		data, err := json.Marshal(unmasked)
+		require.NoError(t, err)
+		assert.Equal(t, `"123-45-6789"`, string(data))
+		assert.Equal(t, "********", unmasked.String())
+
+		data, err = MarshalUnmasked(u.PIN)
+		require.NoError(t, err)
+		assert.Equal(t, `1234`, string(data))
+
+		require.NoError(t, json.Unmarshal([]byte(`"987-65-4321"`), &unmasked))
+		data, err = json.Marshal(unmasked)
+		require.NoError(t, err)
+		assert.Equal(t, `"********"`, string(data))
+	})
+
+	t.Run("slog", func(t *testing.T) {
+		var buf bytes.Buffer
+		slog.New(slog.NewJSONHandler(&buf, nil)).Info("user", "ssn", u.SSN)
+		assert.Contains(t, buf.String(), `"ssn":"********"`)
+		assert.NotContains(t, buf.String(), "6789")
+	})
+}
@@ -0,0 +1,99 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// SpecVersionHeader is the header carrying the version of the spec a client or server was generated from.
+const SpecVersionHeader = "X-Spec-Version"
+
+// SpecVersionMismatchError is returned when the server reports a spec version incompatible with the client's.
+type SpecVersionMismatchError struct {
+	ClientVersion string
+	ServerVersion string
+}
+
+// Error implements the error interface.
+func (e *SpecVersionMismatchError) Error() string {
+	return fmt.Sprintf("server spec version %q is not compatible with client spec version %q", e.ServerVersion, e.ClientVersion)
+}
+
+// WithSpecVersion sends version in the SpecVersionHeader of every request, so servers can tell
+// which contract the client was generated against. Use the generated SpecVersion constant.
+func WithSpecVersion(version string) APIClientOption {
+	return func(c *Client) error {
+		c.specVersion = version
+		return nil
+	}
+}
+
+// WithSpecVersionCheck makes every call fail with a *SpecVersionMismatchError when the server
+// reports an incompatible version in the SpecVersionHeader, see CompatibleSpecVersions.
+// Responses without the header are accepted.
+func WithSpecVersionCheck(version string) APIClientOption {
+	return func(c *Client) error {
+		c.specVersionCheck = version
+		return nil
+	}
+}
+
+// CompatibleSpecVersions reports whether two spec versions are compatible under semantic versioning:
+// they share the major version, or the minor version too for 0.x versions.
+// Versions that are not dot-separated numbers are only compatible if they are equal.
+func CompatibleSpecVersions(a, b string) bool {
+	pa, okA := parseSpecVersion(a)
+	pb, okB := parseSpecVersion(b)
+	if !okA || !okB {
+		return a == b
+	}
+	if pa[0] != pb[0] {
+		return false
+	}
+	if pa[0] == "0" {
+		return len(pa) > 1 && len(pb) > 1 && pa[1] == pb[1]
+	}
+	return true
+}
+
+// parseSpecVersion splits a version like "v1.4.2" into its numeric parts, without leading zeros.
+func parseSpecVersion(version string) ([]string, bool) {
+	version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+	if version == "" {
+		return nil, false
+	}
+
+	parts := strings.Split(version, ".")
+	for i, part := range parts {
+		if part == "" || strings.Trim(part, "0123456789") != "" {
+			return nil, false
+		}
+		if parts[i] = strings.TrimLeft(part, "0"); parts[i] == "" {
+			parts[i] = "0"
+		}
+	}
+	return parts, true
+}
+
+// checkSpecVersion validates the spec version reported by the server, if WithSpecVersionCheck is set.
+func (c *Client) checkSpecVersion(headers http.Header) error {
+	if c.specVersionCheck == "" {
+		return nil
+	}
+	server := headers.Get(SpecVersionHeader)
+	if server == "" || CompatibleSpecVersions(c.specVersionCheck, server) {
+		return nil
+	}
+	return &SpecVersionMismatchError{ClientVersion: c.specVersionCheck, ServerVersion: server}
+}
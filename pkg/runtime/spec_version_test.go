@@ -0,0 +1,183 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompatibleSpecVersions(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected bool
+	}{
+		{"1.4.2", "1.4.2", true},
+		{"1.4.2", "1.9.0", true},
+		{"v1.4.2", "1.0", true},
+		{"1.4.2", "2.0.0", false},
+		{"01.2", "1.2", true},
+		{"0.3.1", "0.3.7", true},
+		{"0.3.1", "0.4.0", false},
+		{"0", "0.1", false},
+		{"00.1", "0.1.5", true},
+		{"2024-01-01", "2024-01-01", true},
+		{"2024-01-01", "2024-02-01", false},
+		{"1.2", "1.x", false},
+		{"", "", true},
+		{"1..2", "1.2", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"_"+tt.b, func(t *testing.T) {
+			assert.Equal(t, tt.expected, CompatibleSpecVersions(tt.a, tt.b))
+			assert.Equal(t, tt.expected, CompatibleSpecVersions(tt.b, tt.a))
+		})
+	}
+}
+
+func TestSpecVersionMismatchError(t *testing.T) {
+	err := &SpecVersionMismatchError{ClientVersion: "1.4.2", ServerVersion: "2.0.0"}
+	assert.Equal(t, `server spec version "2.0.0" is not compatible with client spec version "1.4.2"`, err.Error())
+}
+
+func TestWithSpecVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     []APIClientOption
+		header   map[string]string
+		expected string
+	}{
+		{
+			name:     "no spec version",
+			expected: "",
+		},
+		{
+			name:     "sets spec version",
+			opts:     []APIClientOption{WithSpecVersion("1.4.2")},
+			expected: "1.4.2",
+		},
+		{
+			name:     "request header takes precedence",
+			opts:     []APIClientOption{WithSpecVersion("1.4.2")},
+			header:   map[string]string{SpecVersionHeader: "1.5.0"},
+			expected: "1.5.0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := NewAPIClient("https://api.example.com", tt.opts...)
+			require.NoError(t, err)
+
+			req, err := client.CreateRequest(context.Background(), RequestOptionsParameters{
+				Options:    mockRequestOptions{header: tt.header},
+				RequestURL: "https://api.example.com/users",
+				Method:     "GET",
+			})
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, req.Header.Get(SpecVersionHeader))
+		})
+	}
+}
+
+func TestWithSpecVersionCheck(t *testing.T) {
+	tests := []struct {
+		name          string
+		check         string
+		serverVersion string
+		expectedError bool
+	}{
+		{
+			name:          "no check",
+			serverVersion: "2.0.0",
+		},
+		{
+			name:  "no server version",
+			check: "1.4.2",
+		},
+		{
+			name:          "compatible",
+			check:         "1.4.2",
+			serverVersion: "1.6.0",
+		},
+		{
+			name:          "incompatible",
+			check:         "1.4.2",
+			serverVersion: "2.0.0",
+			expectedError: true,
+		},
+	}
+
+	newResponse := func(version string) *http.Response {
+		resp := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(`{}`)),
+		}
+		if version != "" {
+			resp.Header.Set(SpecVersionHeader, version)
+		}
+		return resp
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var opts []APIClientOption
+			if tt.check != "" {
+				opts = append(opts, WithSpecVersionCheck(tt.check))
+			}
+
+			for _, stream := range []bool{false, true} {
+				client, err := NewAPIClient("https://api.example.com", opts...)
+				require.NoError(t, err)
+				client.httpClient = &MockHttpRequestDoer{response: newResponse(tt.serverVersion)}
+
+				req, _ := http.NewRequest(http.MethodGet, "http://example.com", nil)
+				if stream {
+					_, err = client.ExecuteStreamRequest(context.Background(), req, "/test")
+				} else {
+					_, err = client.ExecuteRequest(context.Background(), req, "/test")
+				}
+
+				if !tt.expectedError {
+					assert.NoError(t, err)
+					continue
+				}
+
+				var mismatch *SpecVersionMismatchError
+				require.ErrorAs(t, err, &mismatch)
+				assert.Equal(t, tt.check, mismatch.ClientVersion)
+				assert.Equal(t, tt.serverVersion, mismatch.ServerVersion)
+			}
+		})
+	}
+
+	t.Run("cancels call options", func(t *testing.T) {
+		client, err := NewAPIClient("https://api.example.com", WithSpecVersionCheck("1.0.0"))
+		require.NoError(t, err)
+		client.httpClient = &MockHttpRequestDoer{response: newResponse("2.0.0")}
+
+		req, _ := http.NewRequest(http.MethodGet, "http://example.com", nil)
+		require.NoError(t, WithTimeout(time.Minute)(context.Background(), req))
+
+		_, err = client.ExecuteStreamRequest(context.Background(), req, "/test")
+		assert.ErrorAs(t, err, new(*SpecVersionMismatchError))
+	})
+}
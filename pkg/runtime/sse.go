@@ -0,0 +1,160 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SSEEvent is a single event read from a text/event-stream body.
+type SSEEvent struct {
+	ID    string
+	Event string
+	Data  []byte
+	Retry time.Duration
+}
+
+// SSEDecoder reads server-sent events from a text/event-stream body,
+// following the parsing rules of the HTML Living Standard.
+type SSEDecoder struct {
+	reader *bufio.Reader
+	lastID string
+
+	// skipLF is set after a carriage return, so that the line feed of a CRLF doesn't end another line.
+	skipLF bool
+}
+
+// NewSSEDecoder creates a new SSEDecoder reading from r.
+func NewSSEDecoder(r io.Reader) *SSEDecoder {
+	return &SSEDecoder{reader: bufio.NewReader(r)}
+}
+
+// Next returns the next event with data, carrying the last event ID seen on the stream.
+// It returns io.EOF when the stream ends, an incomplete trailing event is discarded.
+func (d *SSEDecoder) Next() (*SSEEvent, error) {
+	event := &SSEEvent{}
+	var data bytes.Buffer
+
+	for {
+		line, err := d.readLine()
+		if err != nil {
+			return nil, err
+		}
+
+		if line == "" {
+			if data.Len() == 0 {
+				event = &SSEEvent{}
+				continue
+			}
+			event.ID = d.lastID
+			event.Data = bytes.TrimSuffix(data.Bytes(), []byte("\n"))
+			return event, nil
+		}
+
+		if strings.HasPrefix(line, ":") {
+			continue
+		}
+
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+
+		switch field {
+		case "data":
+			data.WriteString(value)
+			data.WriteByte('\n')
+		case "event":
+			event.Event = value
+		case "id":
+			if !strings.ContainsRune(value, 0) {
+				d.lastID = value
+			}
+		case "retry":
+			if ms, err := strconv.ParseUint(value, 10, 63); err == nil {
+				event.Retry = time.Duration(ms) * time.Millisecond
+			}
+		}
+	}
+}
+
+// readLine returns the next line without its ending, which is a CRLF pair, a single LF or a single CR.
+func (d *SSEDecoder) readLine() (string, error) {
+	var line []byte
+	for {
+		b, err := d.reader.ReadByte()
+		if err != nil {
+			return "", err
+		}
+		if d.skipLF {
+			d.skipLF = false
+			if b == '\n' {
+				continue
+			}
+		}
+		switch b {
+		case '\r':
+			d.skipLF = true
+			return string(line), nil
+		case '\n':
+			return string(line), nil
+		}
+		line = append(line, b)
+	}
+}
+
+// SSEEvents opens a text/event-stream body and yields the data of every event decoded as T.
+// JSON data is unmarshalled into T with codec, encoding/json when nil, string targets receive the raw data.
+// The body is closed when the stream ends or the caller stops iterating.
+func SSEEvents[T any](open func() (io.ReadCloser, error), codec JSONCodec) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		body, err := open()
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		defer func() { _ = body.Close() }()
+
+		decoder := NewSSEDecoder(body)
+		for {
+			event, err := decoder.Next()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if err != nil {
+				yield(zero, fmt.Errorf("error reading event: %w", err))
+				return
+			}
+
+			var target T
+			if s, ok := any(&target).(*string); ok {
+				*s = string(event.Data)
+			} else if err = unmarshalWithCodec(event.Data, &target, codec); err != nil {
+				if !yield(zero, fmt.Errorf("error decoding event: %w", err)) {
+					return
+				}
+				continue
+			}
+
+			if !yield(target, nil) {
+				return
+			}
+		}
+	}
+}
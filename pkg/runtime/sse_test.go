@@ -0,0 +1,254 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) { return 0, errors.New("connection reset") }
+
+type trackingBody struct {
+	io.Reader
+	closed bool
+}
+
+func (b *trackingBody) Close() error {
+	b.closed = true
+	return nil
+}
+
+func readAllEvents(t *testing.T, stream string) []*SSEEvent {
+	t.Helper()
+	decoder := NewSSEDecoder(strings.NewReader(stream))
+
+	var events []*SSEEvent
+	for {
+		event, err := decoder.Next()
+		if errors.Is(err, io.EOF) {
+			return events
+		}
+		require.NoError(t, err)
+		events = append(events, event)
+	}
+}
+
+func TestSSEDecoder_Next(t *testing.T) {
+	tests := []struct {
+		name     string
+		stream   string
+		expected []*SSEEvent
+	}{
+		{
+			name:     "single data line",
+			stream:   "data: hello\n\n",
+			expected: []*SSEEvent{{Data: []byte("hello")}},
+		},
+		{
+			name:     "multiple data lines are joined",
+			stream:   "data: first\ndata: second\n\n",
+			expected: []*SSEEvent{{Data: []byte("first\nsecond")}},
+		},
+		{
+			name:   "event type, id and retry",
+			stream: "event: update\nid: 42\nretry: 1500\ndata: {}\n\n",
+			expected: []*SSEEvent{
+				{Event: "update", ID: "42", Retry: 1500 * time.Millisecond, Data: []byte("{}")},
+			},
+		},
+		{
+			name:   "last event id carries over",
+			stream: "id: 1\ndata: a\n\ndata: b\n\n",
+			expected: []*SSEEvent{
+				{ID: "1", Data: []byte("a")},
+				{ID: "1", Data: []byte("b")},
+			},
+		},
+		{
+			name:     "comments and unknown fields are ignored",
+			stream:   ": keep-alive\nfoo: bar\ndata: x\n\n",
+			expected: []*SSEEvent{{Data: []byte("x")}},
+		},
+		{
+			name:     "events without data are skipped",
+			stream:   "event: ping\n\ndata: x\n\n",
+			expected: []*SSEEvent{{Data: []byte("x")}},
+		},
+		{
+			name:     "crlf line endings",
+			stream:   "data: x\r\ndata: y\r\n\r\n",
+			expected: []*SSEEvent{{Data: []byte("x\ny")}},
+		},
+		{
+			name:     "lf line endings",
+			stream:   "data: x\ndata: y\n\n",
+			expected: []*SSEEvent{{Data: []byte("x\ny")}},
+		},
+		{
+			name:     "cr line endings",
+			stream:   "data: x\rdata: y\r\rdata: z\r\r",
+			expected: []*SSEEvent{{Data: []byte("x\ny")}, {Data: []byte("z")}},
+		},
+		{
+			name:     "mixed line endings",
+			stream:   "data: x\rdata: y\r\n\ndata: z\n\r",
+			expected: []*SSEEvent{{Data: []byte("x\ny")}, {Data: []byte("z")}},
+		},
+		{
+			name:     "value without leading space",
+			stream:   "data:x\n\n",
+			expected: []*SSEEvent{{Data: []byte("x")}},
+		},
+		{
+			name:     "invalid id and retry are ignored",
+			stream:   "id: a\x00b\nretry: soon\ndata: x\n\n",
+			expected: []*SSEEvent{{Data: []byte("x")}},
+		},
+		{
+			name:     "incomplete trailing event is discarded",
+			stream:   "data: x\n\ndata: y\n",
+			expected: []*SSEEvent{{Data: []byte("x")}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, readAllEvents(t, tt.stream))
+		})
+	}
+}
+
+func TestSSEDecoder_Next_ReadError(t *testing.T) {
+	_, err := NewSSEDecoder(failingReader{}).Next()
+	assert.EqualError(t, err, "connection reset")
+}
+
+type sseMessage struct {
+	Text string `json:"text"`
+}
+
+func TestSSEEvents(t *testing.T) {
+	t.Run("decodes json payloads", func(t *testing.T) {
+		body := &trackingBody{Reader: strings.NewReader("data: {\"text\":\"a\"}\n\ndata: {\"text\":\"b\"}\n\n")}
+
+		var messages []sseMessage
+		for msg, err := range SSEEvents[sseMessage](func() (io.ReadCloser, error) { return body, nil }, nil) {
+			require.NoError(t, err)
+			messages = append(messages, msg)
+		}
+
+		assert.Equal(t, []sseMessage{{Text: "a"}, {Text: "b"}}, messages)
+		assert.True(t, body.closed)
+	})
+
+	t.Run("decodes json payloads with the codec", func(t *testing.T) {
+		body := &trackingBody{Reader: strings.NewReader("data: {\"text\":\"a\"}\n\n")}
+		codec := &countingCodec{}
+
+		var messages []sseMessage
+		for msg, err := range SSEEvents[sseMessage](func() (io.ReadCloser, error) { return body, nil }, codec) {
+			require.NoError(t, err)
+			messages = append(messages, msg)
+		}
+
+		assert.Equal(t, []sseMessage{{Text: "a"}}, messages)
+		assert.Equal(t, 1, codec.unmarshaled)
+	})
+
+	t.Run("string payloads receive raw data", func(t *testing.T) {
+		body := &trackingBody{Reader: strings.NewReader("data: hello\n\n")}
+
+		var messages []string
+		for msg, err := range SSEEvents[string](func() (io.ReadCloser, error) { return body, nil }, nil) {
+			require.NoError(t, err)
+			messages = append(messages, msg)
+		}
+
+		assert.Equal(t, []string{"hello"}, messages)
+	})
+
+	t.Run("open error", func(t *testing.T) {
+		var errs []error
+		for _, err := range SSEEvents[sseMessage](func() (io.ReadCloser, error) { return nil, errors.New("boom") }, nil) {
+			errs = append(errs, err)
+		}
+
+		require.Len(t, errs, 1)
+		assert.EqualError(t, errs[0], "boom")
+	})
+
+	t.Run("decode error continues with the next event", func(t *testing.T) {
+		body := &trackingBody{Reader: strings.NewReader("data: nope\n\ndata: {\"text\":\"b\"}\n\n")}
+
+		var messages []sseMessage
+		var errs []error
+		for msg, err := range SSEEvents[sseMessage](func() (io.ReadCloser, error) { return body, nil }, nil) {
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			messages = append(messages, msg)
+		}
+
+		require.Len(t, errs, 1)
+		assert.ErrorContains(t, errs[0], "error decoding event")
+		assert.Equal(t, []sseMessage{{Text: "b"}}, messages)
+	})
+
+	t.Run("decode error stops when the caller breaks", func(t *testing.T) {
+		body := &trackingBody{Reader: strings.NewReader("data: nope\n\ndata: {\"text\":\"b\"}\n\n")}
+
+		count := 0
+		for _, err := range SSEEvents[sseMessage](func() (io.ReadCloser, error) { return body, nil }, nil) {
+			count++
+			if err != nil {
+				break
+			}
+		}
+
+		assert.Equal(t, 1, count)
+		assert.True(t, body.closed)
+	})
+
+	t.Run("read error", func(t *testing.T) {
+		body := &trackingBody{Reader: failingReader{}}
+
+		var errs []error
+		for _, err := range SSEEvents[sseMessage](func() (io.ReadCloser, error) { return body, nil }, nil) {
+			errs = append(errs, err)
+		}
+
+		require.Len(t, errs, 1)
+		assert.EqualError(t, errs[0], "error reading event: connection reset")
+	})
+
+	t.Run("caller stops iterating", func(t *testing.T) {
+		body := &trackingBody{Reader: strings.NewReader("data: {\"text\":\"a\"}\n\ndata: {\"text\":\"b\"}\n\n")}
+
+		var messages []sseMessage
+		for msg := range SSEEvents[sseMessage](func() (io.ReadCloser, error) { return body, nil }, nil) {
+			messages = append(messages, msg)
+			break
+		}
+
+		assert.Equal(t, []sseMessage{{Text: "a"}}, messages)
+		assert.True(t, body.closed)
+	})
+}
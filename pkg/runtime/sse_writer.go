@@ -0,0 +1,209 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrEventWriterClosed is returned when sending on a closed EventWriter.
+	ErrEventWriterClosed = errors.New("event writer is closed")
+
+	// ErrInvalidEventField is returned when writing an event whose Event or ID has a line break,
+	// which would end the field and start another one.
+	ErrInvalidEventField = errors.New("invalid event field")
+)
+
+// EventWriterOption configures an EventWriter.
+type EventWriterOption func(*eventWriterConfig)
+
+type eventWriterConfig struct {
+	keepAlive time.Duration
+	codec     JSONCodec
+}
+
+// WithKeepAlive sends a comment line every interval while no events are written,
+// so proxies and load balancers don't drop idle connections.
+func WithKeepAlive(interval time.Duration) EventWriterOption {
+	return func(c *eventWriterConfig) {
+		c.keepAlive = interval
+	}
+}
+
+// WithEventJSONCodec encodes the events with codec instead of encoding/json.
+// Generated New<Op>EventWriter functions use the json-library of the generator configuration.
+func WithEventJSONCodec(codec JSONCodec) EventWriterOption {
+	return func(c *eventWriterConfig) {
+		c.codec = codec
+	}
+}
+
+// EventWriter writes server-sent events of type T to a text/event-stream response.
+// It is the server-side counterpart of SSEDecoder and SSEEvents.
+type EventWriter[T any] struct {
+	w          http.ResponseWriter
+	controller *http.ResponseController
+	codec      JSONCodec
+
+	mu      sync.Mutex
+	closed  bool
+	written chan struct{}
+	done    chan struct{}
+	wg      sync.WaitGroup
+}
+
+// NewEventWriter writes the text/event-stream headers to w and returns an EventWriter for it.
+// Close must be called before the handler returns.
+func NewEventWriter[T any](w http.ResponseWriter, opts ...EventWriterOption) *EventWriter[T] {
+	cfg := &eventWriterConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+
+	ew := &EventWriter[T]{
+		w:          w,
+		controller: http.NewResponseController(w),
+		codec:      cfg.codec,
+		written:    make(chan struct{}, 1),
+		done:       make(chan struct{}),
+	}
+	_ = ew.controller.Flush()
+
+	if cfg.keepAlive > 0 {
+		ew.wg.Add(1)
+		go ew.keepAlive(cfg.keepAlive)
+	}
+	return ew
+}
+
+// Send writes event as the data of a new event.
+// String events are written as-is, other types are encoded as JSON, with the codec of WithEventJSONCodec when set.
+func (ew *EventWriter[T]) Send(event T) error {
+	return ew.SendEvent(SSEEvent{}, event)
+}
+
+// SendEvent writes event with the ID, Event and Retry fields of meta, whose Data is ignored.
+func (ew *EventWriter[T]) SendEvent(meta SSEEvent, event T) error {
+	var data []byte
+	if s, ok := any(event).(string); ok {
+		data = []byte(s)
+	} else {
+		var err error
+		if data, err = marshalWithCodec(event, ew.codec); err != nil {
+			return fmt.Errorf("error encoding event: %w", err)
+		}
+	}
+	meta.Data = data
+	return ew.WriteEvent(meta)
+}
+
+// WriteEvent writes a raw event and flushes it to the client.
+// Events whose Event or ID has a line break are rejected with ErrInvalidEventField.
+// Data is split into data lines on "\r\n", "\r" and "\n", which all end lines in an event stream.
+func (ew *EventWriter[T]) WriteEvent(event SSEEvent) error {
+	if strings.ContainsAny(event.Event, "\r\n") {
+		return fmt.Errorf("%w: line break in event %q", ErrInvalidEventField, event.Event)
+	}
+	if strings.ContainsAny(event.ID, "\r\n") {
+		return fmt.Errorf("%w: line break in id %q", ErrInvalidEventField, event.ID)
+	}
+
+	var b strings.Builder
+	if event.Event != "" {
+		b.WriteString("event: " + event.Event + "\n")
+	}
+	if event.ID != "" {
+		b.WriteString("id: " + event.ID + "\n")
+	}
+	if event.Retry > 0 {
+		b.WriteString("retry: " + strconv.FormatInt(event.Retry.Milliseconds(), 10) + "\n")
+	}
+	data := strings.ReplaceAll(string(event.Data), "\r\n", "\n")
+	data = strings.ReplaceAll(data, "\r", "\n")
+	for _, line := range strings.Split(data, "\n") {
+		b.WriteString("data: " + line + "\n")
+	}
+	b.WriteString("\n")
+
+	if err := ew.write(b.String()); err != nil {
+		return err
+	}
+
+	select {
+	case ew.written <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Close stops the keep-alive loop. It is safe to call Close more than once.
+func (ew *EventWriter[T]) Close() error {
+	ew.mu.Lock()
+	if ew.closed {
+		ew.mu.Unlock()
+		return nil
+	}
+	ew.closed = true
+	close(ew.done)
+	ew.mu.Unlock()
+
+	ew.wg.Wait()
+	return nil
+}
+
+func (ew *EventWriter[T]) write(s string) error {
+	ew.mu.Lock()
+	defer ew.mu.Unlock()
+
+	if ew.closed {
+		return ErrEventWriterClosed
+	}
+	if _, err := ew.w.Write([]byte(s)); err != nil {
+		return fmt.Errorf("error writing event: %w", err)
+	}
+	if err := ew.controller.Flush(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return fmt.Errorf("error flushing event: %w", err)
+	}
+	return nil
+}
+
+func (ew *EventWriter[T]) keepAlive(interval time.Duration) {
+	defer ew.wg.Done()
+
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ew.done:
+			return
+		case <-ew.written:
+			timer.Reset(interval)
+		case <-timer.C:
+			if err := ew.write(": keep-alive\n\n"); err != nil {
+				return
+			}
+			timer.Reset(interval)
+		}
+	}
+}
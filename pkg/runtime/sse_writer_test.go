@@ -0,0 +1,175 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// plainResponseWriter does not support flushing.
+type plainResponseWriter struct {
+	header http.Header
+	body   strings.Builder
+	err    error
+}
+
+func (w *plainResponseWriter) Header() http.Header { return w.header }
+
+func (w *plainResponseWriter) WriteHeader(int) {}
+
+func (w *plainResponseWriter) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	return w.body.Write(p)
+}
+
+// failingFlushWriter fails every flush.
+type failingFlushWriter struct {
+	*httptest.ResponseRecorder
+}
+
+func (w failingFlushWriter) FlushError() error { return errors.New("flush failed") }
+
+func TestEventWriter_Send(t *testing.T) {
+	rec := httptest.NewRecorder()
+	ew := NewEventWriter[sseMessage](rec)
+
+	require.NoError(t, ew.Send(sseMessage{Text: "hi"}))
+	require.NoError(t, ew.SendEvent(SSEEvent{ID: "2", Event: "message", Retry: 3 * time.Second}, sseMessage{Text: "hello"}))
+	require.NoError(t, ew.Close())
+
+	assert.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+	assert.Equal(t, "no-cache", rec.Header().Get("Cache-Control"))
+	assert.True(t, rec.Flushed)
+	assert.Equal(t, "data: {\"text\":\"hi\"}\n\nevent: message\nid: 2\nretry: 3000\ndata: {\"text\":\"hello\"}\n\n", rec.Body.String())
+
+	events := readAllEvents(t, rec.Body.String())
+	require.Len(t, events, 2)
+	assert.Equal(t, "2", events[1].ID)
+	assert.Equal(t, 3*time.Second, events[1].Retry)
+}
+
+func TestEventWriter_SendString(t *testing.T) {
+	rec := httptest.NewRecorder()
+	ew := NewEventWriter[string](rec)
+
+	require.NoError(t, ew.Send("line one\r\nline two\rline three\nline four"))
+	require.NoError(t, ew.Close())
+
+	assert.Equal(t, "data: line one\ndata: line two\ndata: line three\ndata: line four\n\n", rec.Body.String())
+	events := readAllEvents(t, rec.Body.String())
+	require.Len(t, events, 1)
+	assert.Equal(t, "line one\nline two\nline three\nline four", string(events[0].Data))
+}
+
+func TestEventWriter_JSONCodec(t *testing.T) {
+	rec := httptest.NewRecorder()
+	codec := &countingCodec{}
+	ew := NewEventWriter[sseMessage](rec, WithEventJSONCodec(codec))
+
+	require.NoError(t, ew.Send(sseMessage{Text: "hi"}))
+	require.NoError(t, ew.Close())
+
+	assert.Equal(t, 1, codec.marshaled)
+	assert.Equal(t, "data: {\"text\":\"hi\"}\n\n", rec.Body.String())
+}
+
+func TestEventWriter_Errors(t *testing.T) {
+	t.Run("encoding error", func(t *testing.T) {
+		ew := NewEventWriter[any](httptest.NewRecorder())
+		defer func() { _ = ew.Close() }()
+
+		err := ew.Send(make(chan int))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "error encoding event")
+	})
+
+	t.Run("line break in fields", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		ew := NewEventWriter[string](rec)
+		defer func() { _ = ew.Close() }()
+
+		assert.ErrorIs(t, ew.SendEvent(SSEEvent{Event: "message\ndata: injected"}, "hi"), ErrInvalidEventField)
+		assert.ErrorIs(t, ew.SendEvent(SSEEvent{ID: "1\r\nevent: admin"}, "hi"), ErrInvalidEventField)
+		assert.ErrorIs(t, ew.SendEvent(SSEEvent{Event: "message\rid: 2"}, "hi"), ErrInvalidEventField)
+		assert.ErrorIs(t, ew.SendEvent(SSEEvent{ID: "1\revent: admin"}, "hi"), ErrInvalidEventField)
+		assert.Empty(t, rec.Body.String())
+	})
+
+	t.Run("send after close", func(t *testing.T) {
+		ew := NewEventWriter[string](httptest.NewRecorder())
+		require.NoError(t, ew.Close())
+		require.NoError(t, ew.Close())
+
+		assert.ErrorIs(t, ew.Send("late"), ErrEventWriterClosed)
+	})
+
+	t.Run("write error", func(t *testing.T) {
+		w := &plainResponseWriter{header: http.Header{}, err: errors.New("broken pipe")}
+		ew := NewEventWriter[string](w)
+		defer func() { _ = ew.Close() }()
+
+		err := ew.Send("hi")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "broken pipe")
+	})
+
+	t.Run("flush error", func(t *testing.T) {
+		ew := NewEventWriter[string](failingFlushWriter{httptest.NewRecorder()})
+		defer func() { _ = ew.Close() }()
+
+		err := ew.Send("hi")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "flush failed")
+	})
+
+	t.Run("flushing not supported", func(t *testing.T) {
+		w := &plainResponseWriter{header: http.Header{}}
+		ew := NewEventWriter[string](w)
+
+		require.NoError(t, ew.Send("hi"))
+		require.NoError(t, ew.Close())
+		assert.Equal(t, "data: hi\n\n", w.body.String())
+	})
+}
+
+func TestEventWriter_KeepAlive(t *testing.T) {
+	t.Run("writes comments while idle", func(t *testing.T) {
+		w := &plainResponseWriter{header: http.Header{}}
+		ew := NewEventWriter[string](w, WithKeepAlive(5*time.Millisecond))
+
+		require.NoError(t, ew.Send("hi"))
+		time.Sleep(30 * time.Millisecond)
+		require.NoError(t, ew.Close())
+
+		body := w.body.String()
+		assert.True(t, strings.HasPrefix(body, "data: hi\n\n"))
+		assert.Contains(t, body, ": keep-alive\n\n")
+		assert.Len(t, readAllEvents(t, body), 1)
+	})
+
+	t.Run("stops on write error", func(t *testing.T) {
+		w := &plainResponseWriter{header: http.Header{}, err: errors.New("broken pipe")}
+		ew := NewEventWriter[string](w, WithKeepAlive(time.Millisecond))
+
+		time.Sleep(10 * time.Millisecond)
+		require.NoError(t, ew.Close())
+	})
+}
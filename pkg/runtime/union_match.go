@@ -0,0 +1,111 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// UnionVariant describes the JSON properties of a oneOf union variant.
+// Variants that aren't objects only have a Name.
+type UnionVariant struct {
+	Name       string
+	Required   []string
+	Properties []string
+}
+
+// AmbiguousUnionError is returned by oneOf unions holding data
+// that matches several variants equally well.
+type AmbiguousUnionError struct {
+	Variants []string
+}
+
+func (e *AmbiguousUnionError) Error() string {
+	return "data matches more than one union variant: " + strings.Join(e.Variants, ", ")
+}
+
+// MatchRequiredFields returns the 1-based position of the variant whose required properties best match
+// the JSON object data. Variants missing one of their required properties are ruled out,
+// then the variants with the most required properties present win, then the ones knowing the most
+// properties of data. Variants still tied are reported with an *AmbiguousUnionError.
+// It returns 0 when data isn't an object or no variant requires any of its properties,
+// leaving the choice to the union's UnmarshalJSON.
+func MatchRequiredFields(data []byte, variants ...UnionVariant) (int, error) {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(data, &obj); err != nil || obj == nil {
+		return 0, nil
+	}
+
+	var candidates []int
+	best := 0
+	for i, v := range variants {
+		if !hasAllKeys(obj, v.Required) {
+			continue
+		}
+		switch n := len(v.Required); {
+		case n > best:
+			best, candidates = n, []int{i}
+		case n == best:
+			candidates = append(candidates, i)
+		}
+	}
+	if best == 0 {
+		return 0, nil
+	}
+
+	if len(candidates) > 1 {
+		known := make(map[int]int, len(candidates))
+		most := 0
+		for _, i := range candidates {
+			known[i] = countKeys(obj, variants[i].Properties)
+			most = max(most, known[i])
+		}
+		candidates = preferVariants(candidates, func(i int) bool { return known[i] == most })
+	}
+
+	if len(candidates) > 1 {
+		names := make([]string, len(candidates))
+		for j, i := range candidates {
+			names[j] = variants[i].Name
+		}
+		return 0, &AmbiguousUnionError{Variants: names}
+	}
+	return candidates[0] + 1, nil
+}
+
+func hasAllKeys(obj map[string]json.RawMessage, keys []string) bool {
+	for _, k := range keys {
+		if _, ok := obj[k]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func countKeys(obj map[string]json.RawMessage, keys []string) int {
+	n := 0
+	for _, k := range keys {
+		if _, ok := obj[k]; ok {
+			n++
+		}
+	}
+	return n
+}
+
+// unmarshalVariantAt decodes data into the variant at the 1-based position n of dsts.
+func unmarshalVariantAt(n int, data []byte, dsts ...any) error {
+	if n < 1 || n > len(dsts) {
+		return fmt.Errorf("union has no variant %d", n)
+	}
+	return json.Unmarshal(data, dsts[n-1])
+}
@@ -0,0 +1,104 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	userVariant  = UnionVariant{Name: "User", Required: []string{"id", "name"}, Properties: []string{"id", "name", "email"}}
+	errorVariant = UnionVariant{Name: "Error", Required: []string{"code"}, Properties: []string{"code", "message"}}
+	petVariant   = UnionVariant{Name: "Pet", Required: []string{"name"}, Properties: []string{"name", "tag"}}
+	tagVariant   = UnionVariant{Name: "Tag", Required: []string{"name"}, Properties: []string{"name", "tag"}}
+)
+
+func TestMatchRequiredFields(t *testing.T) {
+	t.Run("variant with all its required fields", func(t *testing.T) {
+		n, err := MatchRequiredFields([]byte(`{"code":"E1","message":"boom"}`), userVariant, errorVariant)
+		require.NoError(t, err)
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("most required fields present wins", func(t *testing.T) {
+		n, err := MatchRequiredFields([]byte(`{"id":1,"name":"Jane"}`), petVariant, userVariant)
+		require.NoError(t, err)
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("most known fields breaks ties", func(t *testing.T) {
+		pet := UnionVariant{Name: "Pet", Required: []string{"name"}, Properties: []string{"name"}}
+		n, err := MatchRequiredFields([]byte(`{"name":"Rex","tag":"dog"}`), pet, tagVariant)
+		require.NoError(t, err)
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("ambiguous variants", func(t *testing.T) {
+		_, err := MatchRequiredFields([]byte(`{"name":"Rex"}`), userVariant, petVariant, tagVariant)
+		var ambiguous *AmbiguousUnionError
+		require.ErrorAs(t, err, &ambiguous)
+		assert.Equal(t, []string{"Pet", "Tag"}, ambiguous.Variants)
+		assert.Equal(t, "data matches more than one union variant: Pet, Tag", err.Error())
+	})
+
+	t.Run("no required field present", func(t *testing.T) {
+		n, err := MatchRequiredFields([]byte(`{"email":"jane@example.com"}`), userVariant, errorVariant)
+		require.NoError(t, err)
+		assert.Equal(t, 0, n)
+	})
+
+	t.Run("not an object", func(t *testing.T) {
+		for _, data := range []string{`"Rex"`, `[1]`, `null`, `42`} {
+			n, err := MatchRequiredFields([]byte(data), petVariant, UnionVariant{Name: "string"})
+			require.NoError(t, err)
+			assert.Equal(t, 0, n)
+		}
+	})
+}
+
+func TestUnmarshalVariant(t *testing.T) {
+	type pet struct {
+		Name string `json:"name"`
+	}
+	type user struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	}
+
+	t.Run("either", func(t *testing.T) {
+		var e Either[pet, user]
+		require.NoError(t, e.UnmarshalVariant(2, []byte(`{"id":1,"name":"Jane"}`)))
+		assert.True(t, e.IsB())
+		assert.Equal(t, user{ID: 1, Name: "Jane"}, e.B)
+	})
+
+	t.Run("oneOf3", func(t *testing.T) {
+		var o OneOf3[pet, user, string]
+		require.NoError(t, o.UnmarshalVariant(1, []byte(`{"name":"Rex"}`)))
+		assert.True(t, o.IsA())
+		assert.Equal(t, pet{Name: "Rex"}, o.A)
+	})
+
+	t.Run("oneOf4", func(t *testing.T) {
+		var o OneOf4[pet, user, string, int]
+		require.NoError(t, o.UnmarshalVariant(4, []byte(`42`)))
+		assert.True(t, o.IsD())
+		assert.Equal(t, 42, o.D)
+	})
+
+	t.Run("unknown variant", func(t *testing.T) {
+		var e Either[pet, user]
+		assert.EqualError(t, e.UnmarshalVariant(3, []byte(`{}`)), "union has no variant 3")
+	})
+}
@@ -0,0 +1,57 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+// ClosedObject is implemented by the generated union variants whose schema doesn't allow additional properties.
+// Unions only pick such a variant for an object without fields other than the known ones,
+// while decoding the variant on its own ignores unknown fields like any other type.
+type ClosedObject interface {
+	KnownFields() []string
+}
+
+// unmarshalUnionVariant unmarshals data into dst, a pointer to a variant of the union being unmarshaled,
+// failing with an error wrapping ErrUnknownField if the variant is a ClosedObject and data has other fields.
+func unmarshalUnionVariant(data []byte, dst any) error {
+	if c, ok := dst.(ClosedObject); ok {
+		if err := DisallowUnknownFields(data, c.KnownFields()...); err != nil {
+			return err
+		}
+	}
+	return json.Unmarshal(data, dst)
+}
+
+// DisallowUnknownFields returns an error wrapping ErrUnknownField if data is a JSON object
+// with a field other than the known ones.
+// Anything else than an object is left to the decoding of the value to report.
+func DisallowUnknownFields(data []byte, known ...string) error {
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil
+	}
+
+	var unknown []string
+	for name := range object {
+		if !slices.Contains(known, name) {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) == 0 {
+		return nil
+	}
+	slices.Sort(unknown)
+	return fmt.Errorf("%w %q", ErrUnknownField, unknown[0])
+}
@@ -0,0 +1,79 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type strictCat struct {
+	Name  string `json:"name"`
+	Meows bool   `json:"meows"`
+}
+
+func (strictCat) KnownFields() []string {
+	return []string{"name", "meows"}
+}
+
+type looseDog struct {
+	Name  string `json:"name"`
+	Barks bool   `json:"barks"`
+}
+
+func TestDisallowUnknownFields(t *testing.T) {
+	t.Run("known fields", func(t *testing.T) {
+		assert.NoError(t, DisallowUnknownFields([]byte(`{"name":"Tom","meows":true}`), "name", "meows"))
+		assert.NoError(t, DisallowUnknownFields([]byte(`{}`), "name"))
+	})
+
+	t.Run("unknown fields", func(t *testing.T) {
+		err := DisallowUnknownFields([]byte(`{"name":"Tom","z":1,"barks":true}`), "name", "meows")
+		require.ErrorIs(t, err, ErrUnknownField)
+		assert.EqualError(t, err, `unknown field "barks"`)
+	})
+
+	t.Run("not an object", func(t *testing.T) {
+		assert.NoError(t, DisallowUnknownFields([]byte(`"Tom"`), "name"))
+		assert.NoError(t, DisallowUnknownFields([]byte(`{`), "name"))
+	})
+
+	t.Run("disambiguates union variants", func(t *testing.T) {
+		var pet Either[strictCat, looseDog]
+		require.NoError(t, json.Unmarshal([]byte(`{"name":"Rex","barks":true}`), &pet))
+		assert.True(t, pet.IsB())
+		assert.Equal(t, looseDog{Name: "Rex", Barks: true}, pet.B)
+
+		require.NoError(t, json.Unmarshal([]byte(`{"name":"Tom","meows":true}`), &pet))
+		assert.True(t, pet.IsA())
+	})
+
+	t.Run("disambiguates oneOf and anyOf variants", func(t *testing.T) {
+		var pet OneOf3[strictCat, looseDog, string]
+		require.NoError(t, json.Unmarshal([]byte(`{"name":"Rex","barks":true}`), &pet))
+		assert.Equal(t, 2, pet.N)
+
+		var cat *strictCat
+		var dog *looseDog
+		require.NoError(t, UnmarshalAnyOf([]byte(`{"name":"Rex","barks":true}`), &cat, &dog))
+		assert.Nil(t, cat)
+		assert.Equal(t, &looseDog{Name: "Rex", Barks: true}, dog)
+	})
+
+	t.Run("ignored outside unions", func(t *testing.T) {
+		var cat strictCat
+		require.NoError(t, json.Unmarshal([]byte(`{"name":"Tom","barks":true}`), &cat))
+		assert.Equal(t, strictCat{Name: "Tom"}, cat)
+	})
+}
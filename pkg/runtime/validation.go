@@ -15,12 +15,20 @@
 package runtime
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
+	"math"
 	"reflect"
+	"strconv"
 
 	"github.com/go-playground/validator/v10"
 )
 
+// MultipleOfValidationTag is the validation tag checking that numbers are multiples of its parameter,
+// e.g. `validate:"multiple_of=0.01"`.
+const MultipleOfValidationTag = "multiple_of"
+
 // Validator is an interface for types that can validate themselves.
 type Validator interface {
 	Validate() error
@@ -60,6 +68,91 @@ func RegisterCustomTypeFunc(v *validator.Validate) {
 	})
 }
 
+// RegisterValidations registers the validation tags used by the generated code that the validator doesn't provide:
+// "format" for custom string formats and "multiple_of" for the multipleOf constraint.
+func RegisterValidations(v *validator.Validate) {
+	RegisterFormatValidation(v)
+	// The tag name is valid and the function non-nil, so registration can't fail.
+	_ = v.RegisterValidation(MultipleOfValidationTag, validateMultipleOf)
+}
+
+// validateMultipleOf checks that the field is a multiple of the tag's parameter,
+// parsed as float32 for float32 fields like the builtin validations do.
+// Floats are compared with a tolerance matching their precision.
+func validateMultipleOf(fl validator.FieldLevel) bool {
+	field := fl.Field()
+	bitSize := 64
+	if field.Kind() == reflect.Float32 {
+		bitSize = 32
+	}
+	multipleOf, err := strconv.ParseFloat(fl.Param(), bitSize)
+	if err != nil || multipleOf <= 0 {
+		return false
+	}
+
+	switch field.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if multipleOf == math.Trunc(multipleOf) {
+			return field.Int()%int64(multipleOf) == 0
+		}
+		return isMultipleOf(float64(field.Int()), multipleOf, 1e-9)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if multipleOf == math.Trunc(multipleOf) {
+			return field.Uint()%uint64(multipleOf) == 0
+		}
+		return isMultipleOf(float64(field.Uint()), multipleOf, 1e-9)
+	case reflect.Float32:
+		return isMultipleOf(field.Float(), multipleOf, 1e-6)
+	case reflect.Float64:
+		return isMultipleOf(field.Float(), multipleOf, 1e-9)
+	default:
+		return false
+	}
+}
+
+// isMultipleOf reports whether value divided by multipleOf is an integer, within the relative tolerance.
+func isMultipleOf(value, multipleOf, tolerance float64) bool {
+	quotient := value / multipleOf
+	return math.Abs(quotient-math.Round(quotient)) <= tolerance*math.Max(1, math.Abs(quotient))
+}
+
+// DuplicateItem returns the index of the first item equal to a previous one, as for the uniqueItems constraint.
+// Items are compared by their JSON encoding, items that can't be encoded are skipped.
+func DuplicateItem[T any](items []T) (int, bool) {
+	seen := make(map[string]struct{}, len(items))
+	for i, item := range items {
+		data, err := json.Marshal(item)
+		if err != nil {
+			continue
+		}
+		if _, ok := seen[string(data)]; ok {
+			return i, true
+		}
+		seen[string(data)] = struct{}{}
+	}
+	return 0, false
+}
+
+// ValidateEach validates the items implementing Validator, e.g. the enums of array query parameters,
+// keyed by their index. Nil items are valid.
+func ValidateEach[S ~[]E, E any](items S) error {
+	var errs ValidationErrors
+	for i, item := range items {
+		v, ok := any(item).(Validator)
+		if !ok {
+			continue
+		}
+		if rv := reflect.ValueOf(v); rv.Kind() == reflect.Pointer && rv.IsNil() {
+			continue
+		}
+		errs = errs.Append(fmt.Sprintf("[%d]", i), v.Validate())
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
 // ConvertValidatorError converts a validator.ValidationErrors to our ValidationErrors type.
 // This provides a consistent error format across all validation errors.
 func ConvertValidatorError(err error) error {
@@ -76,3 +169,16 @@ func ConvertValidatorError(err error) error {
 	// Use the existing NewValidationErrorsFromError which handles validator errors properly
 	return NewValidationErrorsFromError(err)
 }
+
+// validateRequestOptions validates the request options if they implement Validator.
+// Nil options, including typed nil pointers, are valid.
+func validateRequestOptions(opts RequestOptions) error {
+	v, ok := opts.(Validator)
+	if !ok {
+		return nil
+	}
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return nil
+	}
+	return v.Validate()
+}
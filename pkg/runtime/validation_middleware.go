@@ -0,0 +1,135 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// OperationValidator validates the requests and responses of an operation, generated per route
+// in the OperationValidators of servers with generate.server-binding.
+type OperationValidator struct {
+	// Request validates a request, returning a *BindError or ValidationErrors. Its body can be read,
+	// it is restored for the handler.
+	Request func(r *http.Request) error
+
+	// Response validates a response body by status code. Nil if no response of the operation has a JSON body.
+	Response func(status int, body []byte) error
+}
+
+// ValidationOptions configures the middleware returned by ValidationMiddleware.
+type ValidationOptions struct {
+	// ValidateResponses buffers the responses until the handler returns, and validates them before they are sent.
+	// Defaults to false.
+	ValidateResponses bool
+
+	// ErrorHandler handles the invalid requests, DefaultBindErrorHandler if nil.
+	ErrorHandler BindErrorHandler
+
+	// ResponseErrorHandler handles the invalid responses instead of sending them, an error wrapping
+	// ErrInvalidResponse. DefaultResponseErrorHandler if nil.
+	ResponseErrorHandler BindErrorHandler
+}
+
+// DefaultResponseErrorHandler responds with 500 Internal Server Error to an invalid response.
+func DefaultResponseErrorHandler(w http.ResponseWriter, _ *http.Request, _ error) {
+	http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+}
+
+// ValidationMiddleware returns a Middleware validating the requests, and optionally the responses, with the validator
+// of the http.ServeMux pattern that routed them, r.Pattern. It must run inside the mux, e.g. as one of the
+// Middlewares of the generated ServerOptions. Requests of patterns without a validator are passed through.
+func ValidationMiddleware(validators map[string]OperationValidator, options ValidationOptions) Middleware {
+	onError := options.ErrorHandler
+	if onError == nil {
+		onError = DefaultBindErrorHandler
+	}
+	onResponseError := options.ResponseErrorHandler
+	if onResponseError == nil {
+		onResponseError = DefaultResponseErrorHandler
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			v, ok := validators[r.Pattern]
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if v.Request != nil {
+				if err := validateRequest(r, v.Request); err != nil {
+					onError(w, r, err)
+					return
+				}
+			}
+
+			if !options.ValidateResponses || v.Response == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			rec := &responseRecorder{ResponseWriter: w}
+			next.ServeHTTP(rec, r)
+			if rec.status == 0 {
+				rec.status = http.StatusOK
+			}
+
+			if err := v.Response(rec.status, rec.body.Bytes()); err != nil {
+				onResponseError(w, r, fmt.Errorf("%w: %d: %w", ErrInvalidResponse, rec.status, err))
+				return
+			}
+			w.WriteHeader(rec.status)
+			_, _ = w.Write(rec.body.Bytes())
+		})
+	}
+}
+
+// validateRequest reads the body of r once, so that both validate and the handler can read it.
+func validateRequest(r *http.Request, validate func(r *http.Request) error) error {
+	if r.Body == nil || r.Body == http.NoBody {
+		return validate(r)
+	}
+
+	body, err := io.ReadAll(r.Body)
+	_ = r.Body.Close()
+	if err != nil {
+		return &BindError{In: "body", Err: err}
+	}
+
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	err = validate(r)
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	return err
+}
+
+// responseRecorder buffers the status and body of a response, its headers are written to the ResponseWriter.
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+}
+
+func (r *responseRecorder) Write(p []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	return r.body.Write(p)
+}
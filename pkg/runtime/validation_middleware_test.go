@@ -0,0 +1,118 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidationMiddleware(t *testing.T) {
+	validators := map[string]OperationValidator{
+		"POST /pets": {
+			Request: func(r *http.Request) error {
+				body, err := io.ReadAll(r.Body)
+				if err != nil {
+					return err
+				}
+				if !strings.Contains(string(body), "name") {
+					return &BindError{In: "body", Err: ErrMissingValue}
+				}
+				return nil
+			},
+			Response: func(status int, body []byte) error {
+				if status == http.StatusCreated && !strings.Contains(string(body), "id") {
+					return errors.New("missing id")
+				}
+				return nil
+			},
+		},
+	}
+
+	newHandler := func(options ValidationOptions, response string) http.Handler {
+		mux := http.NewServeMux()
+		handle := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			w.Header().Set("X-Request-Body", string(body))
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(response))
+		})
+		mux.Handle("POST /pets", ValidationMiddleware(validators, options)(handle))
+		mux.Handle("GET /pets", ValidationMiddleware(validators, options)(handle))
+		return mux
+	}
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		newHandler(ValidationOptions{}, `{}`).ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/pets", strings.NewReader(`{"name":"rex"}`)))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, `{"name":"rex"}`, w.Header().Get("X-Request-Body"))
+		assert.Equal(t, `{}`, w.Body.String())
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		newHandler(ValidationOptions{}, `{}`).ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/pets", strings.NewReader(`{}`)))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, "invalid body: missing required value\n", w.Body.String())
+	})
+
+	t.Run("pattern without validator", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		newHandler(ValidationOptions{ValidateResponses: true}, `{}`).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/pets", nil))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("valid response", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		newHandler(ValidationOptions{ValidateResponses: true}, `{"id":1}`).
+			ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/pets", strings.NewReader(`{"name":"rex"}`)))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, `{"id":1}`, w.Body.String())
+		assert.Equal(t, `{"name":"rex"}`, w.Header().Get("X-Request-Body"))
+	})
+
+	t.Run("invalid response", func(t *testing.T) {
+		var got error
+		options := ValidationOptions{
+			ValidateResponses: true,
+			ResponseErrorHandler: func(w http.ResponseWriter, r *http.Request, err error) {
+				got = err
+				DefaultResponseErrorHandler(w, r, err)
+			},
+		}
+		w := httptest.NewRecorder()
+		newHandler(options, `{}`).ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/pets", strings.NewReader(`{"name":"rex"}`)))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		require.ErrorIs(t, got, ErrInvalidResponse)
+		assert.Equal(t, "invalid response: 201: missing id", got.Error())
+	})
+
+	t.Run("responses not validated by default", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		newHandler(ValidationOptions{}, `{}`).ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/pets", strings.NewReader(`{"name":"rex"}`)))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, `{}`, w.Body.String())
+	})
+}
@@ -229,3 +229,100 @@ func TestConvertValidatorError(t *testing.T) {
 		assert.Len(t, unwrapped, 2)
 	})
 }
+
+func TestMultipleOfValidation(t *testing.T) {
+	v := validator.New(validator.WithRequiredStructEnabled())
+	RegisterValidations(v)
+
+	tests := []struct {
+		name  string
+		value any
+		tag   string
+		valid bool
+	}{
+		{"int multiple", 10, "multiple_of=5", true},
+		{"int not multiple", 11, "multiple_of=5", false},
+		{"int fractional multiple", int32(3), "multiple_of=1.5", true},
+		{"uint multiple", uint8(9), "multiple_of=3", true},
+		{"uint not multiple", uint16(10), "multiple_of=3", false},
+		{"uint fractional multiple", uint(2), "multiple_of=0.5", true},
+		{"float32 multiple", float32(0.3), "multiple_of=0.1", true},
+		{"float32 not multiple", float32(0.35), "multiple_of=0.1", false},
+		{"float32 bound at float32 precision", float32(0.01), "gte=0.01", true},
+		{"float32 exclusive bound at float32 precision", float32(0.1), "gt=0.1", false},
+		{"float64 multiple", 19.99, "multiple_of=0.01", true},
+		{"float64 not multiple", 19.995, "multiple_of=0.01", false},
+		{"negative", -15, "multiple_of=5", true},
+		{"invalid param", 10, "multiple_of=x", false},
+		{"zero param", 10, "multiple_of=0", false},
+		{"not a number", "10", "multiple_of=5", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.valid {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+		})
+	}
+
+	t.Run("message", func(t *testing.T) {
+		err := v.Var(11, "multiple_of=5")
+		validationErrs := NewValidationErrorsFromError(err)
+		require.Len(t, validationErrs, 1)
+		assert.Equal(t, "must be a multiple of 5", validationErrs[0].Message)
+	})
+}
+
+func TestDuplicateItem(t *testing.T) {
+	type item struct {
+		Name string
+		Tags []string
+	}
+
+	i, ok := DuplicateItem([]string{"a", "b", "a"})
+	assert.True(t, ok)
+	assert.Equal(t, 2, i)
+
+	_, ok = DuplicateItem([]item{{Name: "a", Tags: []string{"x"}}, {Name: "a", Tags: []string{"y"}}})
+	assert.False(t, ok)
+
+	i, ok = DuplicateItem([]item{{Name: "a", Tags: []string{"x"}}, {Name: "b"}, {Name: "a", Tags: []string{"x"}}})
+	assert.True(t, ok)
+	assert.Equal(t, 2, i)
+
+	// items that can't be encoded are skipped
+	_, ok = DuplicateItem([]any{func() {}, func() {}})
+	assert.False(t, ok)
+
+	_, ok = DuplicateItem[int](nil)
+	assert.False(t, ok)
+}
+
+type testColor string
+
+func (c testColor) Validate() error {
+	if c != "red" && c != "green" {
+		return NewValidationErrorsFromString("Enum", "must be a valid testColor value, got: "+string(c))
+	}
+	return nil
+}
+
+func TestValidateEach(t *testing.T) {
+	type colors []testColor
+
+	assert.NoError(t, ValidateEach(colors{"red", "green"}))
+	assert.NoError(t, ValidateEach([]string{"blue"}))
+	assert.NoError(t, ValidateEach[[]testColor](nil))
+
+	red := testColor("red")
+	assert.NoError(t, ValidateEach([]*testColor{&red, nil}))
+
+	err := ValidateEach(colors{"red", "blue"})
+	var errs ValidationErrors
+	require.ErrorAs(t, err, &errs)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "[1].Enum", errs[0].Field)
+}
@@ -0,0 +1,27 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import "reflect"
+
+// IsZero reports whether v is left out when marshaled with the `omitzero` JSON tag option:
+// its IsZero method returns true, or it is the zero value of its type.
+// The generated code skips validating the absent values of optional `omitzero` properties with it.
+func IsZero[T any](v T) bool {
+	if z, ok := any(v).(isZeroer); ok {
+		return z.IsZero()
+	}
+	return reflect.ValueOf(&v).Elem().IsZero()
+}
@@ -0,0 +1,51 @@
+// Copyright 2025 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsZero(t *testing.T) {
+	type location struct {
+		City string `json:"city"`
+	}
+
+	assert.True(t, IsZero(location{}))
+	assert.False(t, IsZero(location{City: "Paris"}))
+	assert.True(t, IsZero(time.Time{}))
+	assert.False(t, IsZero(time.Now()))
+	assert.True(t, IsZero(Date{}))
+	assert.True(t, IsZero(0))
+	assert.True(t, IsZero[any](nil))
+	assert.True(t, IsZero(Nullable[string]{}))
+	assert.False(t, IsZero(Null[string]()))
+
+	t.Run("agrees with omitzero", func(t *testing.T) {
+		type event struct {
+			At time.Time `json:"at,omitzero"`
+		}
+		for _, at := range []time.Time{{}, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Time{}.In(time.UTC)} {
+			data, err := json.Marshal(event{At: at})
+			require.NoError(t, err)
+			assert.Equal(t, IsZero(at), string(data) == "{}")
+		}
+	})
+}